@@ -114,6 +114,7 @@ import (
 	bep3keeper "github.com/kava-labs/kava/x/bep3/keeper"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	"github.com/kava-labs/kava/x/cdp"
+	cdpclient "github.com/kava-labs/kava/x/cdp/client"
 	cdpkeeper "github.com/kava-labs/kava/x/cdp/keeper"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee"
@@ -159,6 +160,7 @@ import (
 	savingskeeper "github.com/kava-labs/kava/x/savings/keeper"
 	savingstypes "github.com/kava-labs/kava/x/savings/types"
 	"github.com/kava-labs/kava/x/swap"
+	swapclient "github.com/kava-labs/kava/x/swap/client"
 	swapkeeper "github.com/kava-labs/kava/x/swap/keeper"
 	swaptypes "github.com/kava-labs/kava/x/swap/types"
 	validatorvesting "github.com/kava-labs/kava/x/validator-vesting"
@@ -195,6 +197,8 @@ var (
 			earnclient.WithdrawProposalHandler,
 			communityclient.LendDepositProposalHandler,
 			communityclient.LendWithdrawProposalHandler,
+			swapclient.ProtocolFeeTransferProposalHandler,
+			cdpclient.GlobalSettlementProposalHandler,
 		}),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -236,28 +240,31 @@ var (
 	// If these are changed, the permissions stored in accounts
 	// must also be migrated during a chain upgrade.
 	mAccPerms = map[string][]string{
-		authtypes.FeeCollectorName:      nil,
-		distrtypes.ModuleName:           nil,
-		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:             {authtypes.Burner},
-		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:             {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		evmutiltypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-		kavadisttypes.KavaDistMacc:      {authtypes.Minter},
-		auctiontypes.ModuleName:         nil,
-		issuancetypes.ModuleAccountName: {authtypes.Minter, authtypes.Burner},
-		bep3types.ModuleName:            {authtypes.Burner, authtypes.Minter},
-		swaptypes.ModuleName:            nil,
-		cdptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-		cdptypes.LiquidatorMacc:         {authtypes.Minter, authtypes.Burner},
-		hardtypes.ModuleAccountName:     {authtypes.Minter},
-		savingstypes.ModuleAccountName:  nil,
-		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
-		kavadisttypes.FundModuleAccount: nil,
-		minttypes.ModuleName:            {authtypes.Minter},
-		communitytypes.ModuleName:       nil,
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:              {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		evmutiltypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		kavadisttypes.KavaDistMacc:       {authtypes.Minter},
+		auctiontypes.ModuleName:          nil,
+		issuancetypes.ModuleAccountName:  {authtypes.Minter, authtypes.Burner},
+		bep3types.ModuleName:             {authtypes.Burner, authtypes.Minter},
+		swaptypes.ModuleName:             nil,
+		swaptypes.ProtocolFeeAccountName: nil,
+		swaptypes.OrderAccountName:       nil,
+		cdptypes.ModuleName:              {authtypes.Minter, authtypes.Burner},
+		cdptypes.LiquidatorMacc:          {authtypes.Minter, authtypes.Burner},
+		hardtypes.ModuleAccountName:      {authtypes.Minter, authtypes.Burner},
+		savingstypes.ModuleAccountName:   nil,
+		liquidtypes.ModuleAccountName:    {authtypes.Minter, authtypes.Burner},
+		earntypes.ModuleAccountName:      nil,
+		kavadisttypes.FundModuleAccount:  nil,
+		minttypes.ModuleName:             {authtypes.Minter},
+		communitytypes.ModuleName:        nil,
+		committeetypes.ModuleName:        {authtypes.Burner},
 	}
 )
 
@@ -459,13 +466,16 @@ func NewApp(
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
 		govAuthAddrStr,
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
+	baseBankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.accountKeeper,
 		app.loadBlockedMaccAddrs(),
 		govAuthAddrStr,
 	)
+	// hard receipt token rewards are synced by the bank keeper whenever receipt tokens are sent
+	receiptBankKeeper := hardkeeper.NewReceiptBankKeeper(baseBankKeeper)
+	app.bankKeeper = receiptBankKeeper
 	app.stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -630,6 +640,7 @@ func NewApp(
 		appCodec,
 		keys[pricefeedtypes.StoreKey],
 		pricefeedSubspace,
+		&app.swapKeeper,
 	)
 	swapKeeper := swapkeeper.NewKeeper(
 		appCodec,
@@ -637,16 +648,7 @@ func NewApp(
 		swapSubspace,
 		app.accountKeeper,
 		app.bankKeeper,
-	)
-	cdpKeeper := cdpkeeper.NewKeeper(
-		appCodec,
-		keys[cdptypes.StoreKey],
-		cdpSubspace,
-		app.pricefeedKeeper,
-		app.auctionKeeper,
-		app.bankKeeper,
-		app.accountKeeper,
-		mAccPerms,
+		&app.communityKeeper,
 	)
 	hardKeeper := hardkeeper.NewKeeper(
 		appCodec,
@@ -656,6 +658,7 @@ func NewApp(
 		app.bankKeeper,
 		app.pricefeedKeeper,
 		app.auctionKeeper,
+		app.MsgServiceRouter(),
 	)
 	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
@@ -672,6 +675,17 @@ func NewApp(
 		app.bankKeeper,
 		app.liquidKeeper,
 	)
+	cdpKeeper := cdpkeeper.NewKeeper(
+		appCodec,
+		keys[cdptypes.StoreKey],
+		cdpSubspace,
+		app.pricefeedKeeper,
+		app.auctionKeeper,
+		app.bankKeeper,
+		app.accountKeeper,
+		&savingsKeeper,
+		mAccPerms,
+	)
 	earnKeeper := earnkeeper.NewKeeper(
 		appCodec,
 		keys[earntypes.StoreKey],
@@ -771,6 +785,7 @@ func NewApp(
 	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
 	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
 	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
+	receiptBankKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
 	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
 	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
 
@@ -785,6 +800,8 @@ func NewApp(
 		AddRoute(kavadisttypes.RouterKey, kavadist.NewCommunityPoolMultiSpendProposalHandler(app.kavadistKeeper)).
 		AddRoute(earntypes.RouterKey, earn.NewCommunityPoolProposalHandler(app.earnKeeper)).
 		AddRoute(communitytypes.RouterKey, community.NewCommunityPoolProposalHandler(app.communityKeeper)).
+		AddRoute(swaptypes.RouterKey, swap.NewProtocolFeeProposalHandler(app.swapKeeper)).
+		AddRoute(cdptypes.RouterKey, cdp.NewGlobalSettlementProposalHandler(app.cdpKeeper)).
 		AddRoute(committeetypes.RouterKey, committee.NewProposalHandler(app.committeeKeeper))
 
 	govConfig := govtypes.DefaultConfig()
@@ -813,7 +830,7 @@ func NewApp(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts, authSubspace),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper, bankSubspace),
+		newBankModule(appCodec, app.bankKeeper, baseBankKeeper, app.accountKeeper, bankSubspace),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper, false), // todo: confirm if this is okay to not be sealed
 		staking.NewAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper, stakingSubspace),
 		distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper, distrSubspace),
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankModule is the bank module with its services registered on a wrapped bank keeper, so bank sends go through the
+// wrapper. The bank module's migrations still run on the underlying base keeper.
+type bankModule struct {
+	bank.AppModule
+	keeper         bankkeeper.Keeper
+	baseKeeper     bankkeeper.BaseKeeper
+	legacySubspace exported.Subspace
+}
+
+// newBankModule creates the bank module for a bank keeper that wraps a base keeper
+func newBankModule(
+	cdc codec.Codec, keeper bankkeeper.Keeper, baseKeeper bankkeeper.BaseKeeper,
+	accountKeeper banktypes.AccountKeeper, legacySubspace exported.Subspace,
+) bankModule {
+	return bankModule{
+		AppModule:      bank.NewAppModule(cdc, keeper, accountKeeper, legacySubspace),
+		keeper:         keeper,
+		baseKeeper:     baseKeeper,
+		legacySubspace: legacySubspace,
+	}
+}
+
+// RegisterServices registers the bank msg server on the wrapped keeper and the migrations on the base keeper
+func (am bankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 3 to 4: %v", err))
+	}
+}
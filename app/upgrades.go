@@ -2,11 +2,14 @@ package app
 
 import (
 	"fmt"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -63,6 +66,48 @@ func upgradeHandler(
 
 		logger.Info("completed store migrations")
 
+		updateModuleAccountPermissions(ctx, app.accountKeeper, mAccPerms)
+
+		logger.Info("updated module account permissions")
+
 		return versionMap, nil
 	}
 }
+
+// updateModuleAccountPermissions sets the permissions of stored module accounts to the given permissions, as module
+// accounts keep the permissions they were created with.
+func updateModuleAccountPermissions(ctx sdk.Context, ak authkeeper.AccountKeeper, perms map[string][]string) {
+	names := make([]string, 0, len(perms))
+	for name := range perms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		acc := ak.GetAccount(ctx, authtypes.NewModuleAddress(name))
+		macc, ok := acc.(*authtypes.ModuleAccount)
+		if !ok || equalPermissions(macc.Permissions, perms[name]) {
+			continue
+		}
+		macc.Permissions = perms[name]
+		ak.SetModuleAccount(ctx, macc)
+	}
+}
+
+// equalPermissions returns whether two lists of permissions are the same, ignoring order.
+func equalPermissions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, perm := range a {
+		counts[perm]++
+	}
+	for _, perm := range b {
+		counts[perm]--
+		if counts[perm] < 0 {
+			return false
+		}
+	}
+	return true
+}
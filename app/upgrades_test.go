@@ -0,0 +1,60 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+func TestUpdateModuleAccountPermissions(t *testing.T) {
+	tApp := NewTestApp()
+	depositor := sdk.AccAddress("depositor___________")
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("usdx", 100_000_000))
+
+	usdxMarket := hardtypes.NewMoneyMarket("usdx",
+		hardtypes.NewBorrowLimit(false, sdk.NewDec(100_000_000_000_000), sdk.MustNewDecFromStr("0.8")),
+		"usdx:usd",
+		sdkmath.NewInt(1_000_000),
+		hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+		sdk.MustNewDecFromStr("0.05"),
+		sdk.ZeroDec(),
+	)
+	usdxMarket.ReceiptTokensEnabled = true
+	hardGS := hardtypes.DefaultGenesisState()
+	hardGS.Params.MoneyMarkets = hardtypes.MoneyMarkets{usdxMarket}
+
+	tApp.InitializeFromGenesisStates(
+		NewFundedGenStateWithCoins(tApp.AppCodec(), []sdk.Coins{deposit}, []sdk.AccAddress{depositor}),
+		GenesisState{hardtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+	ctx := tApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	ak := tApp.GetAccountKeeper()
+	hardKeeper := tApp.GetHardKeeper()
+
+	// The hard module account was created on live chains with only the minter permission
+	macc := ak.GetModuleAccount(ctx, hardtypes.ModuleAccountName).(*authtypes.ModuleAccount)
+	macc.Permissions = []string{authtypes.Minter}
+	ak.SetModuleAccount(ctx, macc)
+
+	receiptTokens, err := hardKeeper.DepositReceiptTokens(ctx, depositor, deposit)
+	require.NoError(t, err)
+	cacheCtx, _ := ctx.CacheContext()
+	require.Panics(t, func() {
+		_, _ = hardKeeper.RedeemReceiptTokens(cacheCtx, depositor, receiptTokens)
+	})
+
+	updateModuleAccountPermissions(ctx, ak, mAccPerms)
+
+	macc = ak.GetModuleAccount(ctx, hardtypes.ModuleAccountName).(*authtypes.ModuleAccount)
+	require.ElementsMatch(t, mAccPerms[hardtypes.ModuleAccountName], macc.Permissions)
+	redeemed, err := hardKeeper.RedeemReceiptTokens(ctx, depositor, receiptTokens)
+	require.NoError(t, err)
+	require.Equal(t, deposit, redeemed)
+}
@@ -27,6 +27,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryAuction(),
 		GetCmdQueryAuctions(),
+		GetCmdQuerySealedBids(),
+		GetCmdQueryAuctionBids(),
+		GetCmdQueryClosedAuctions(),
+		GetCmdQueryBidderStats(),
 	}
 
 	for _, cmd := range cmds {
@@ -98,10 +102,12 @@ func GetCmdQueryAuction() *cobra.Command {
 
 // Query auction flags
 const (
-	flagType  = "type"
-	flagDenom = "denom"
-	flagPhase = "phase"
-	flagOwner = "owner"
+	flagType   = "type"
+	flagDenom  = "denom"
+	flagPhase  = "phase"
+	flagOwner  = "owner"
+	flagWinner = "winner"
+	flagBidder = "bidder"
 )
 
 // GetCmdQueryAuctions queries the auctions in the store
@@ -111,10 +117,11 @@ func GetCmdQueryAuctions() *cobra.Command {
 		Short: "query auctions with optional filters",
 		Long:  "Query for all paginated auctions that match optional filters.",
 		Example: strings.Join([]string{
-			fmt.Sprintf("  $ %s q %s auctions --type=(collateral|surplus|debt)", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --type=(collateral|surplus|debt|dutch)", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --owner=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --denom=bnb", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --phase=(forward|reverse)", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --phase=(commit|reveal)", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --page=2 --limit=100", version.AppName, types.ModuleName),
 		}, "\n"),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -145,13 +152,14 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 				if auctionType != types.CollateralAuctionType &&
 					auctionType != types.SurplusAuctionType &&
-					auctionType != types.DebtAuctionType {
+					auctionType != types.DebtAuctionType &&
+					auctionType != types.DutchAuctionType {
 					return fmt.Errorf("invalid auction type %s", auctionType)
 				}
 			}
 
 			if len(owner) != 0 {
-				if auctionType != types.CollateralAuctionType {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchAuctionType {
 					return fmt.Errorf("cannot apply owner flag to non-collateral auction type")
 				}
 				_, err := sdk.AccAddressFromBech32(owner)
@@ -170,10 +178,16 @@ func GetCmdQueryAuctions() *cobra.Command {
 			if len(phase) != 0 {
 				phase = strings.ToLower(phase)
 
-				if len(auctionType) > 0 && auctionType != types.CollateralAuctionType {
-					return fmt.Errorf("cannot apply phase flag to non-collateral auction type")
-				}
-				if phase != types.ForwardAuctionPhase && phase != types.ReverseAuctionPhase {
+				switch phase {
+				case types.ForwardAuctionPhase, types.ReverseAuctionPhase:
+					if len(auctionType) > 0 && auctionType != types.CollateralAuctionType {
+						return fmt.Errorf("cannot apply phase flag to non-collateral auction type")
+					}
+				case types.CommitAuctionPhase, types.RevealAuctionPhase:
+					if len(auctionType) > 0 && auctionType != types.SurplusAuctionType && auctionType != types.DebtAuctionType {
+						return fmt.Errorf("cannot apply sealed bid phase flag to non-surplus or non-debt auction type")
+					}
+				default:
 					return fmt.Errorf("invalid auction phase %s", phase)
 				}
 			}
@@ -203,10 +217,203 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 	flags.AddPaginationFlagsToCmd(cmd, "auctions")
 
-	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus")
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus, dutch")
 	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral auction owner")
 	cmd.Flags().String(flagDenom, "", "(optional) filter by auction denom")
-	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse")
+	cmd.Flags().String(flagPhase, "", "(optional) filter by auction phase, phase: forward/reverse for collateral auctions, commit/reveal for sealed bid auctions")
+
+	return cmd
+}
+
+// GetCmdQuerySealedBids queries the sealed bids committed to an auction
+func GetCmdQuerySealedBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sealed-bids [auction-id]",
+		Short:   "query the sealed bids committed to an auction",
+		Example: fmt.Sprintf("  $ %s q %s sealed-bids 34", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SealedBids(context.Background(), &types.QuerySealedBidsRequest{
+				AuctionId:  auctionID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "sealed bids")
+
+	return cmd
+}
+
+// GetCmdQueryAuctionBids queries the bid history of an auction
+func GetCmdQueryAuctionBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bids [auction-id]",
+		Short:   "query the bid history of an auction",
+		Long:    "Query the latest bids placed on an auction. Bid history is only kept when enabled in the module params.",
+		Example: fmt.Sprintf("  $ %s q %s bids 34", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AuctionBids(context.Background(), &types.QueryAuctionBidsRequest{
+				AuctionId:  auctionID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bids")
+
+	return cmd
+}
+
+// GetCmdQueryClosedAuctions queries the records of closed auctions
+func GetCmdQueryClosedAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "closed-auctions",
+		Short: "query closed auction records with optional filters",
+		Long:  "Query for all paginated closed auction records that match optional filters.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  $ %s q %s closed-auctions --type=(collateral|surplus|debt|dutch)", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s closed-auctions --winner=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
+		}, "\n"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auctionType, err := cmd.Flags().GetString(flagType)
+			if err != nil {
+				return err
+			}
+			winner, err := cmd.Flags().GetString(flagWinner)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if len(winner) != 0 {
+				if _, err := sdk.AccAddressFromBech32(winner); err != nil {
+					return fmt.Errorf("cannot parse address from auction winner %s", winner)
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClosedAuctions(context.Background(), &types.QueryClosedAuctionsRequest{
+				Type:       strings.ToLower(auctionType),
+				Winner:     winner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "closed auctions")
+
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus, dutch")
+	cmd.Flags().String(flagWinner, "", "(optional) filter by auction winner")
+
+	return cmd
+}
+
+// GetCmdQueryBidderStats queries the statistics of bidders
+func GetCmdQueryBidderStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bidder-stats",
+		Short: "query bidder statistics",
+		Long:  "Query the bids placed and auctions won by all bidders, or by a single bidder.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  $ %s q %s bidder-stats", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s bidder-stats --bidder=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
+		}, "\n"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bidder, err := cmd.Flags().GetString(flagBidder)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if len(bidder) != 0 {
+				if _, err := sdk.AccAddressFromBech32(bidder); err != nil {
+					return fmt.Errorf("cannot parse address from bidder %s", bidder)
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BidderStats(context.Background(), &types.QueryBidderStatsRequest{
+				Bidder:     bidder,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bidder stats")
+
+	cmd.Flags().String(flagBidder, "", "(optional) filter by bidder")
 
 	return cmd
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,11 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPlaceBid(),
+		GetCmdBuyCollateral(),
+		GetCmdCommitBid(),
+		GetCmdRevealBid(),
+		GetCmdPlaceBids(),
+		GetCmdMergeAuctions(),
 	}
 
 	for _, cmd := range cmds {
@@ -68,3 +74,196 @@ func GetCmdPlaceBid() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdBuyCollateral cli command for buying collateral from dutch auctions
+func GetCmdBuyCollateral() *cobra.Command {
+	return &cobra.Command{
+		Use:     "buy-collateral [auction-id] [amount] [max-price]",
+		Short:   "buy collateral from a dutch auction",
+		Long:    "Buy [amount] of lot from a dutch auction at the current auction price. The purchase fails if the current price per unit of lot is greater than [max-price].",
+		Example: fmt.Sprintf("  $ %s tx %s buy-collateral 34 100000000btc 0.0002 --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			amt, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyCollateral(id, clientCtx.GetFromAddress().String(), amt, maxPrice)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdCommitBid cli command for committing sealed bids on auctions
+func GetCmdCommitBid() *cobra.Command {
+	return &cobra.Command{
+		Use:     "commit-bid [auction-id] [amount] [salt] [deposit]",
+		Short:   "commit a sealed bid on an auction",
+		Long:    "Commit a sealed bid of [amount] on a surplus or debt auction in its commit phase. Only the hash of the bid and [salt] is submitted, backed by [deposit]. The same [amount] and [salt] must be revealed during the reveal phase, otherwise the deposit is forfeited.",
+		Example: fmt.Sprintf("  $ %s tx %s commit-bid 34 1000ukava mySecretSalt 1500ukava --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			amt, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			bidHash := types.SealedBidHash(id, clientCtx.GetFromAddress(), amt, args[2])
+			msg := types.NewMsgCommitBid(id, clientCtx.GetFromAddress().String(), bidHash, deposit)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdRevealBid cli command for revealing sealed bids on auctions
+func GetCmdRevealBid() *cobra.Command {
+	return &cobra.Command{
+		Use:     "reveal-bid [auction-id] [amount] [salt]",
+		Short:   "reveal a sealed bid on an auction",
+		Long:    "Reveal a previously committed sealed bid on an auction in its reveal phase. [amount] and [salt] must match the values the bid was committed with.",
+		Example: fmt.Sprintf("  $ %s tx %s reveal-bid 34 1000ukava mySecretSalt --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			amt, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBid(id, clientCtx.GetFromAddress().String(), amt, args[2])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdPlaceBids cli command for placing bids on several auctions at once
+func GetCmdPlaceBids() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bids [auction-id:amount,...] [max-total-spend]",
+		Short: "place bids on several auctions atomically",
+		Long: "Place a bid on each listed auction, as in the bid command. Either all bids are placed or none are. " +
+			"The bids fail if the bidder would spend more than [max-total-spend] across all auctions.",
+		Example: fmt.Sprintf("  $ %s tx %s bids 34:1000usdx,35:2000usdx 3000usdx --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var bids []types.BidEntry
+			for _, entry := range strings.Split(args[0], ",") {
+				parts := strings.SplitN(entry, ":", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("bid '%s' must be of the form auction-id:amount", entry)
+				}
+				id, err := strconv.ParseUint(parts[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("auction-id '%s' not a valid uint", parts[0])
+				}
+				amt, err := sdk.ParseCoinNormalized(parts[1])
+				if err != nil {
+					return err
+				}
+				bids = append(bids, types.NewBidEntry(id, amt))
+			}
+
+			maxTotalSpend, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBids(clientCtx.GetFromAddress().String(), bids, maxTotalSpend)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdMergeAuctions cli command for merging collateral auctions
+func GetCmdMergeAuctions() *cobra.Command {
+	return &cobra.Command{
+		Use:     "merge-auctions [auction-id] [auction-id]...",
+		Short:   "merge consecutive collateral auctions that have no bids",
+		Long:    "Merge consecutive collateral auctions with the same initiator and denoms, that have not received any bids, into the first auction.",
+		Example: fmt.Sprintf("  $ %s tx %s merge-auctions 34 35 36 --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ids := make([]uint64, len(args))
+			for i, arg := range args {
+				id, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("auction-id '%s' not a valid uint", arg)
+				}
+				ids[i] = id
+			}
+
+			msg := types.NewMsgMergeAuctions(clientCtx.GetFromAddress().String(), ids)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -31,6 +31,19 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, bankKeeper types.BankKee
 		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 	}
 
+	for _, b := range gs.SealedBids {
+		keeper.SetSealedBid(ctx, b)
+	}
+	for _, r := range gs.BidRecords {
+		keeper.SetBidRecord(ctx, r)
+	}
+	for _, c := range gs.ClosedAuctions {
+		keeper.SetClosedAuction(ctx, c)
+	}
+	for _, stats := range gs.BidderStats {
+		keeper.SetBidderStats(ctx, stats)
+	}
+
 	// check if the module account exists
 	moduleAcc := accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
@@ -65,7 +78,15 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
-	gs, err := types.NewGenesisState(nextAuctionID, params, genAuctions)
+	gs, err := types.NewGenesisState(
+		nextAuctionID,
+		params,
+		genAuctions,
+		keeper.GetAllSealedBids(ctx),
+		keeper.GetAllBidRecords(ctx),
+		keeper.GetAllClosedAuctions(ctx),
+		keeper.GetAllBidderStats(ctx),
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -47,6 +47,10 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			types.DefaultParams(),
 			[]types.GenesisAuction{testAuction},
+			types.SealedBids{},
+			types.BidRecords{},
+			types.ClosedAuctions{},
+			types.BidderStatsList{},
 		)
 		require.NoError(t, err)
 
@@ -94,6 +98,10 @@ func TestInitGenesis(t *testing.T) {
 			0, // next id < testAuction ID
 			types.DefaultParams(),
 			[]types.GenesisAuction{testAuction},
+			types.SealedBids{},
+			types.BidRecords{},
+			types.ClosedAuctions{},
+			types.BidderStatsList{},
 		)
 		require.NoError(t, err)
 
@@ -114,6 +122,10 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			types.DefaultParams(),
 			[]types.GenesisAuction{testAuction},
+			types.SealedBids{},
+			types.BidRecords{},
+			types.ClosedAuctions{},
+			types.BidderStatsList{},
 		)
 		require.NoError(t, err)
 
@@ -14,6 +14,7 @@ import (
 )
 
 // StartSurplusAuction starts a new surplus (forward) auction.
+// When sealed bids are enabled the auction is started as a sealed-bid auction in its commit phase.
 func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, error) {
 	auction := types.NewSurplusAuction(
 		seller,
@@ -21,6 +22,16 @@ func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin
 		bidDenom,
 		types.DistantFuture,
 	)
+	if params := k.GetParams(ctx); params.SealedBidEnabled {
+		commitEndTime := ctx.BlockTime().Add(params.SealedBidCommitDuration)
+		auction = types.NewSealedBidSurplusAuction(
+			seller,
+			lot,
+			bidDenom,
+			commitEndTime,
+			commitEndTime.Add(params.SealedBidRevealDuration),
+		)
+	}
 
 	// NOTE: for the duration of the auction the auction module account holds the lot
 	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
@@ -46,6 +57,7 @@ func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin
 }
 
 // StartDebtAuction starts a new debt (reverse) auction.
+// When sealed bids are enabled the auction is started as a sealed-bid auction in its commit phase.
 func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, initialLot sdk.Coin, debt sdk.Coin) (uint64, error) {
 	auction := types.NewDebtAuction(
 		buyer,
@@ -54,6 +66,17 @@ func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, in
 		types.DistantFuture,
 		debt,
 	)
+	if params := k.GetParams(ctx); params.SealedBidEnabled {
+		commitEndTime := ctx.BlockTime().Add(params.SealedBidCommitDuration)
+		auction = types.NewSealedBidDebtAuction(
+			buyer,
+			bid,
+			initialLot,
+			commitEndTime,
+			commitEndTime.Add(params.SealedBidRevealDuration),
+			debt,
+		)
+	}
 
 	// This auction type mints coins at close. Need to check module account has minting privileges to avoid potential err in endblocker.
 	macc := k.accountKeeper.GetModuleAccount(ctx, buyer)
@@ -84,11 +107,18 @@ func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, in
 	return auctionID, nil
 }
 
-// StartCollateralAuction starts a new collateral (2-phase) auction.
+// StartCollateralAuction starts a new collateral (2-phase) auction. The lot price is the market price of one unit of
+// lot denominated in units of the max bid denom, it is recorded with the auction and may be zero if unknown.
 func (k Keeper) StartCollateralAuction(
 	ctx sdk.Context, seller string, lot, maxBid sdk.Coin,
-	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin, lotPrice sdk.Dec,
 ) (uint64, error) {
+	if lotPrice.IsNil() {
+		lotPrice = sdk.ZeroDec()
+	}
+	if lotPrice.IsNegative() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidStartPrice, "%s", lotPrice)
+	}
 	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
 	if err != nil {
 		return 0, err
@@ -101,6 +131,7 @@ func (k Keeper) StartCollateralAuction(
 		weightedAddresses,
 		debt,
 	)
+	auction.MarketPrice = lotPrice
 
 	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
 	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
@@ -130,6 +161,61 @@ func (k Keeper) StartCollateralAuction(
 	return auctionID, nil
 }
 
+// StartDutchAuction starts a new dutch (descending price) auction. The lot price is the market price of one unit of
+// lot denominated in units of the max bid denom, the auction starts at this price plus the dutch price premium.
+func (k Keeper) StartDutchAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin, lotPrice sdk.Dec,
+) (uint64, error) {
+	if lotPrice.IsNil() || !lotPrice.IsPositive() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidStartPrice, "%s", lotPrice)
+	}
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	params := k.GetParams(ctx)
+	auction := types.NewDutchAuction(
+		seller,
+		lot,
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(params.DutchAuctionDuration),
+		maxBid,
+		weightedAddresses,
+		debt,
+		lotPrice.Mul(sdk.OneDec().Add(params.DutchPricePremium)),
+		lotPrice,
+	)
+
+	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, &auction)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyStartPrice, auction.StartPrice.String()),
+		),
+	)
+	return auctionID, nil
+}
+
 // PlaceBid places a bid on any auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 	auction, found := k.GetAuction(ctx, auctionID)
@@ -141,6 +227,9 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 	if ctx.BlockTime().After(auction.GetEndTime()) {
 		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
 	}
+	if types.IsSealedBid(auction) {
+		return errorsmod.Wrapf(types.ErrSealedBidAuction, "%d", auctionID)
+	}
 
 	// move coins and return updated auction
 	var (
@@ -158,6 +247,8 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		} else {
 			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
 		}
+	case *types.DutchAuction:
+		err = errorsmod.Wrapf(types.ErrUnrecognizedAuctionType, "cannot bid on %s auctions, buy collateral instead", auctionType.GetType())
 	default:
 		err = errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
 	}
@@ -167,6 +258,7 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 	}
 
 	k.SetAuction(ctx, updatedAuction)
+	k.recordBid(ctx, auctionID, bidder, updatedAuction.GetBid(), updatedAuction.GetLot())
 
 	return nil
 }
@@ -463,6 +555,119 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 	return auction, nil
 }
 
+// BuyCollateral buys some amount of lot from a dutch auction at the current auction price.
+// If the purchase would raise more than the remaining max bid, only enough lot to raise the max bid is bought.
+// The auction is closed once its max bid has been raised or its lot has sold out.
+func (k Keeper) BuyCollateral(ctx sdk.Context, auctionID uint64, buyer sdk.AccAddress, amount sdk.Coin, maxPrice sdk.Dec) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	dutchAuction, ok := auction.(*types.DutchAuction)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrNotDutchAuction, "%d", auctionID)
+	}
+	if ctx.BlockTime().After(dutchAuction.GetEndTime()) {
+		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+	}
+
+	// Validate purchase
+	if amount.Denom != dutchAuction.Lot.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", amount.Denom, dutchAuction.Lot.Denom)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrLotTooSmall, "%s ≤ 0%s", amount, dutchAuction.Lot.Denom)
+	}
+	if dutchAuction.Lot.IsLT(amount) {
+		return errorsmod.Wrapf(types.ErrLotTooLarge, "%s > %s", amount, dutchAuction.Lot)
+	}
+	price := dutchAuction.CurrentPrice(k.GetParams(ctx), ctx.BlockTime())
+	if !price.IsPositive() {
+		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+	}
+	if price.GT(maxPrice) {
+		return errorsmod.Wrapf(types.ErrPriceTooHigh, "%s > %s", price, maxPrice)
+	}
+
+	// Purchases are capped at the amount of bid still needed to raise the max bid.
+	// When capped the lot bought is rounded up, so the buyer is never charged more than the current price.
+	remainingBid := dutchAuction.MaxBid.Sub(dutchAuction.Bid)
+	cost := sdk.NewDecFromInt(amount.Amount).Mul(price).Ceil().TruncateInt()
+	if cost.GT(remainingBid.Amount) {
+		cost = remainingBid.Amount
+		lotAmount := sdk.NewDecFromInt(cost).Quo(price).Ceil().TruncateInt()
+		amount = sdk.NewCoin(amount.Denom, sdk.MinInt(lotAmount, amount.Amount))
+	}
+	payment := sdk.NewCoin(dutchAuction.Bid.Denom, cost)
+
+	// Payment is sent to auction initiator
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, buyer, dutchAuction.Initiator, sdk.NewCoins(payment))
+	if err != nil {
+		return err
+	}
+	// Debt coins are sent to initiator (until there is no CorrespondingDebt left). Amount sent is equal to payment (or whatever is left if < payment).
+	if dutchAuction.CorrespondingDebt.IsPositive() {
+		debtAmountToReturn := sdk.MinInt(payment.Amount, dutchAuction.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(dutchAuction.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, dutchAuction.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return err
+		}
+		dutchAuction.CorrespondingDebt = dutchAuction.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ auction.CorrespondingDebt from the MinInt above
+	}
+	// Purchased lot is sent to buyer
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	// Update Auction
+	dutchAuction.Bidder = buyer
+	dutchAuction.Bid = dutchAuction.Bid.Add(payment)
+	dutchAuction.Lot = dutchAuction.Lot.Sub(amount)
+	dutchAuction.HasReceivedBids = true
+	if dutchAuction.LotSold.Denom == "" { // auctions started before lot sold was tracked
+		dutchAuction.LotSold = amount
+	} else {
+		dutchAuction.LotSold = dutchAuction.LotSold.Add(amount)
+	}
+	k.recordBid(ctx, auctionID, buyer, payment, amount)
+	k.recordPurchase(ctx, buyer, payment, amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBuy,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", dutchAuction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBuyer, buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		),
+	)
+
+	if !dutchAuction.IsComplete() {
+		k.SetAuction(ctx, dutchAuction)
+		return nil
+	}
+
+	// close the auction as soon as it has raised its max bid or sold out
+	if err := k.PayoutDutchAuction(ctx, dutchAuction); err != nil {
+		return err
+	}
+	k.recordClosedAuction(ctx, dutchAuction)
+	k.DeleteAuction(ctx, auctionID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionClose,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyCloseBlock, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	return nil
+}
+
 // CloseAuction closes an auction and distributes funds to the highest bidder.
 func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	auction, found := k.GetAuction(ctx, auctionID)
@@ -473,6 +678,9 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	if ctx.BlockTime().Before(auction.GetEndTime()) {
 		return errorsmod.Wrapf(types.ErrAuctionHasNotExpired, "block time %s, auction end time %s", ctx.BlockTime().UTC(), auction.GetEndTime().UTC())
 	}
+	if types.IsSealedBid(auction) {
+		return errorsmod.Wrapf(types.ErrInvalidSealedBidPhase, "sealed-bid auction %d must be settled before closing", auctionID)
+	}
 
 	// payout to the last bidder
 	var err error
@@ -483,6 +691,8 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		err = k.PayoutDebtAuction(ctx, auc)
 	case *types.CollateralAuction:
 		err = k.PayoutCollateralAuction(ctx, auc)
+	case *types.DutchAuction:
+		err = k.PayoutDutchAuction(ctx, auc)
 	default:
 		err = errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auc.GetType())
 	}
@@ -491,6 +701,7 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		return err
 	}
 
+	k.recordClosedAuction(ctx, auction)
 	k.DeleteAuction(ctx, auctionID)
 
 	ctx.EventManager().EmitEvent(
@@ -545,23 +756,110 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction *types.Collater
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
 }
 
+// PayoutDutchAuction settles the unsold lot of a dutch auction. If the auction has not raised its max bid, the unsold
+// lot is put up for sale in a collateral auction, otherwise it is returned to the lot returns addresses.
+// Purchased lot is paid out as it is bought, so there is nothing left to send to buyers.
+func (k Keeper) PayoutDutchAuction(ctx sdk.Context, auction *types.DutchAuction) error {
+	remainingBid := auction.MaxBid.Sub(auction.Bid)
+	if auction.Lot.IsPositive() && remainingBid.IsPositive() {
+		return k.startCollateralAuctionForUnsoldLot(ctx, auction, remainingBid)
+	}
+
+	if auction.Lot.IsPositive() {
+		// Note: splitting an integer amount across weighted buckets results in small errors.
+		lotPayouts, err := splitCoinIntoWeightedBuckets(auction.Lot, auction.LotReturns.Weights)
+		if err != nil {
+			return err
+		}
+		for i, payout := range lotPayouts {
+			// if the payout amount is 0, don't send 0 coins
+			if !payout.IsPositive() {
+				continue
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.LotReturns.Addresses[i], sdk.NewCoins(payout))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// if there is remaining debt after the auction, send it back to the initiating module for management
+	if !auction.CorrespondingDebt.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
+// startCollateralAuctionForUnsoldLot starts a collateral auction for the unsold lot and the remaining debt of a dutch
+// auction, so that the lot is not returned while its debt is uncovered. The auction module account already holds the
+// lot and the debt, so no coins are moved.
+func (k Keeper) startCollateralAuctionForUnsoldLot(ctx sdk.Context, auction *types.DutchAuction, maxBid sdk.Coin) error {
+	collateralAuction := types.NewCollateralAuction(
+		auction.Initiator,
+		auction.Lot,
+		types.DistantFuture,
+		maxBid,
+		auction.LotReturns,
+		auction.CorrespondingDebt,
+	)
+	collateralAuction.MarketPrice = auction.MarketPrice
+
+	auctionID, err := k.StoreNewAuction(ctx, &collateralAuction)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, collateralAuction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, collateralAuction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, collateralAuction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, collateralAuction.MaxBid.String()),
+		),
+	)
+	return nil
+}
+
 // CloseExpiredAuctions iterates over all the auctions stored by until the current
 // block timestamp and that are past (or at) their ending times and closes them,
-// paying out to the highest bidder.
+// paying out to the highest bidder. Sealed-bid auctions past the end of their commit
+// phase move to their reveal phase, and are settled at the end of their reveal phase.
 func (k Keeper) CloseExpiredAuctions(ctx sdk.Context) error {
-	var err error
+	// expired auctions are collected before closing them, as sealed-bid auctions are re-indexed when changing phase
+	var auctionIDs []uint64
 	k.IterateAuctionsByTime(ctx, ctx.BlockTime(), func(id uint64) (stop bool) {
-		err = k.CloseAuction(ctx, id)
-		if err != nil && !errors.Is(err, types.ErrAuctionNotFound) {
-			// stop iteration
-			return true
-		}
-		// reset error in case the last element had an ErrAuctionNotFound
-		err = nil
+		auctionIDs = append(auctionIDs, id)
 		return false
 	})
 
-	return err
+	for _, id := range auctionIDs {
+		err := k.closeExpiredAuction(ctx, id)
+		if err != nil && !errors.Is(err, types.ErrAuctionNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// closeExpiredAuction closes an expired auction, or moves an expired sealed-bid auction on from its current phase.
+func (k Keeper) closeExpiredAuction(ctx sdk.Context, auctionID uint64) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+
+	if sealedAuction, ok := auction.(types.SealedBidAuction); ok {
+		switch sealedAuction.GetSealedBidPhase() {
+		case types.SEALED_BID_PHASE_COMMIT:
+			return k.StartSealedBidRevealPhase(ctx, auctionID)
+		case types.SEALED_BID_PHASE_REVEAL:
+			return k.SettleSealedBidAuction(ctx, auctionID)
+		}
+	}
+	return k.CloseAuction(ctx, auctionID)
 }
 
 // earliestTime returns the earliest of two times.
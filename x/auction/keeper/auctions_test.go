@@ -121,7 +121,7 @@ func (suite *auctionTestSuite) TestCollateralAuctionBasic() {
 	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
 
 	// Start auction
-	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), d("2.5"))
 	suite.NoError(err)
 	// Check seller's coins have decreased
 	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
@@ -166,7 +166,7 @@ func (suite *auctionTestSuite) TestCollateralAuctionDebtRemaining() {
 	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
 
 	// Start auction
-	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), d("2.5"))
 	suite.NoError(err)
 	// Check seller's coins have decreased
 	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
@@ -194,6 +194,125 @@ func (suite *auctionTestSuite) TestCollateralAuctionDebtRemaining() {
 	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func (suite *auctionTestSuite) TestDutchAuctionBasic() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction at a market price of 2 token2 per token1, the auction price starts at a 20% premium
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), d("2"))
+	suite.NoError(err)
+	// Check seller's coins have decreased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+
+	// Bidding is not supported on dutch auctions
+	suite.Error(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 10)))
+	// Buying above the max price fails
+	err = suite.Keeper.BuyCollateral(suite.Ctx, auctionID, buyer, c("token1", 10), d("2.3"))
+	suite.ErrorIs(err, types.ErrPriceTooHigh)
+
+	// Buy half the lot at the start price
+	suite.NoError(suite.Keeper.BuyCollateral(suite.Ctx, auctionID, buyer, c("token1", 10), d("2.4")))
+	// Check buyer's coins have changed
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 110), c("token2", 76)))
+	// Check seller's coins have increased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 124), c("debt", 84)))
+
+	// Buy the rest of the lot after the price has decayed by half
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration / 2))
+	suite.NoError(suite.Keeper.BuyCollateral(ctx, auctionID, buyer, c("token1", 10), d("1.2")))
+	// Check buyer's coins have changed
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 120), c("token2", 64)))
+	// Check seller's coins have increased and the remaining debt has been returned
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 136), c("debt", 100)))
+	// Check return addresses have not received coins
+	for _, ra := range suite.Addrs[1:] {
+		suite.CheckAccountBalanceEqual(ra, cs(c("token1", 100), c("token2", 100)))
+	}
+
+	// Check the sold out auction has been closed
+	_, found := suite.Keeper.GetAuction(ctx, auctionID)
+	suite.False(found)
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionMaxBid() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:2]
+	returnWeights := is(1)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 30), returnAddrs, returnWeights, c("debt", 40), d("2"))
+	suite.NoError(err)
+
+	// Buy the whole lot, only enough lot to raise the max bid is bought
+	suite.NoError(suite.Keeper.BuyCollateral(suite.Ctx, auctionID, buyer, c("token1", 20), d("2.4")))
+	// Check buyer's coins have changed
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 113), c("token2", 70)))
+	// Check unsold lot has been sent to the return address
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 107), c("token2", 100)))
+	// Check seller's coins have increased and the remaining debt has been returned
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 130), c("debt", 100)))
+
+	// Check the auction has been closed
+	_, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.False(found)
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionExpired() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:2]
+	returnWeights := is(1)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), d("2"))
+	suite.NoError(err)
+	suite.NoError(suite.Keeper.BuyCollateral(suite.Ctx, auctionID, buyer, c("token1", 5), d("2.4")))
+
+	// Buying after the auction has ended fails
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration).Add(1))
+	err = suite.Keeper.BuyCollateral(ctx, auctionID, buyer, c("token1", 5), d("2.4"))
+	suite.ErrorIs(err, types.ErrAuctionHasExpired)
+
+	// Close auction after expiry
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	_, found := suite.Keeper.GetAuction(ctx, auctionID)
+	suite.False(found)
+	// Check unsold lot has not been sent to the return address
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 100), c("token2", 100)))
+	// Check seller received the purchase and the debt it covers
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 112), c("debt", 72)))
+
+	// The unsold lot and remaining debt are put up for sale in a collateral auction
+	auction, found := suite.Keeper.GetAuction(ctx, auctionID+1)
+	suite.True(found)
+	collateralAuction, ok := auction.(*types.CollateralAuction)
+	suite.True(ok)
+	suite.Equal(sellerModName, collateralAuction.Initiator)
+	suite.Equal(c("token1", 15), collateralAuction.Lot)
+	suite.Equal(c("token2", 38), collateralAuction.MaxBid)
+	suite.Equal(c("debt", 28), collateralAuction.CorrespondingDebt)
+	suite.Equal(returnAddrs, collateralAuction.LotReturns.Addresses)
+	suite.Equal(d("2"), collateralAuction.MarketPrice)
+	suite.NoError(collateralAuction.Validate())
+
+	// The collateral auction is settled like any other
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID+1, buyer, c("token2", 38)))
+	suite.NoError(suite.Keeper.CloseAuction(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultForwardBidDuration)), auctionID+1))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 150), c("debt", 100)))
+}
+
 func (suite *auctionTestSuite) TestStartSurplusAuction() {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
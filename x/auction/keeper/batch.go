@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// PlaceBids places a batch of bids atomically. Each bid is placed as in PlaceBid, so its amount is the limit for that
+// auction. If any bid fails, or the coins spent by the bidder across all bids exceed the max total spend, no bids are placed.
+func (k Keeper) PlaceBids(ctx sdk.Context, bidder sdk.AccAddress, bids []types.BidEntry, maxTotalSpend sdk.Coins) error {
+	balanceBefore := k.bankKeeper.GetAllBalances(ctx, bidder)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, bid := range bids {
+		if err := k.PlaceBid(cacheCtx, bid.AuctionId, bidder, bid.Amount); err != nil {
+			return errorsmod.Wrapf(err, "bid on auction %d", bid.AuctionId)
+		}
+	}
+
+	// refunds from outbidding themselves can only lower the amount spent by the bidder
+	spent := sdk.NewCoins()
+	balanceAfter := k.bankKeeper.GetAllBalances(cacheCtx, bidder)
+	for _, coin := range balanceBefore {
+		if amountAfter := balanceAfter.AmountOf(coin.Denom); amountAfter.LT(coin.Amount) {
+			spent = spent.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(amountAfter)))
+		}
+	}
+	if !maxTotalSpend.IsAllGTE(spent) {
+		return errorsmod.Wrapf(types.ErrTotalSpendExceeded, "%s > %s", spent, maxTotalSpend)
+	}
+
+	writeCache()
+	return nil
+}
+
+// MergeCollateralAuctions merges consecutive collateral auctions that have not received any bids into the first auction.
+// The auctions must share an initiator and denoms, and the merged lot cannot exceed the max merged lot param for its
+// denom. Lots, max bids, debt and lot returns are combined, and the merged auction keeps the earliest end time.
+func (k Keeper) MergeCollateralAuctions(ctx sdk.Context, auctionIDs []uint64) (uint64, error) {
+	if len(auctionIDs) < 2 {
+		return 0, errorsmod.Wrap(types.ErrCannotMergeAuctions, "at least two auctions must be merged")
+	}
+
+	var merged *types.CollateralAuction
+	for i, auctionID := range auctionIDs {
+		if i > 0 && auctionID != auctionIDs[i-1]+1 {
+			return 0, errorsmod.Wrap(types.ErrCannotMergeAuctions, "auction ids must be consecutive")
+		}
+		auction, found := k.GetAuction(ctx, auctionID)
+		if !found {
+			return 0, errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+		}
+		collateralAuction, ok := auction.(*types.CollateralAuction)
+		if !ok {
+			return 0, errorsmod.Wrapf(types.ErrCannotMergeAuctions, "auction %d is a %s auction", auctionID, auction.GetType())
+		}
+		if collateralAuction.HasReceivedBids {
+			return 0, errorsmod.Wrapf(types.ErrCannotMergeAuctions, "auction %d has received bids", auctionID)
+		}
+		if ctx.BlockTime().After(collateralAuction.GetEndTime()) {
+			return 0, errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+		}
+
+		if merged == nil {
+			merged = collateralAuction
+			merged.LotReturns = scaleWeightedAddresses(merged.LotReturns, merged.Lot.Amount)
+			continue
+		}
+		if err := validateMergeable(merged, collateralAuction); err != nil {
+			return 0, err
+		}
+		merged.Lot = merged.Lot.Add(collateralAuction.Lot)
+		merged.MaxBid = merged.MaxBid.Add(collateralAuction.MaxBid)
+		merged.CorrespondingDebt = merged.CorrespondingDebt.Add(collateralAuction.CorrespondingDebt)
+		merged.LotReturns = mergeWeightedAddresses(
+			merged.LotReturns, scaleWeightedAddresses(collateralAuction.LotReturns, collateralAuction.Lot.Amount),
+		)
+		merged.EndTime = earliestTime(merged.EndTime, collateralAuction.EndTime)
+		merged.MaxEndTime = earliestTime(merged.MaxEndTime, collateralAuction.MaxEndTime)
+	}
+
+	maxLot := k.GetParams(ctx).MaxMergedLots.AmountOf(merged.Lot.Denom)
+	if merged.Lot.Amount.GT(maxLot) {
+		return 0, errorsmod.Wrapf(types.ErrCannotMergeAuctions, "merged lot %s exceeds max merged lot %s%s", merged.Lot, maxLot, merged.Lot.Denom)
+	}
+
+	// the lot and debt of all auctions are already held in the module account, so no coins are moved
+	for _, auctionID := range auctionIDs[1:] {
+		k.DeleteAuction(ctx, auctionID)
+	}
+	k.SetAuction(ctx, merged)
+
+	mergedIDs := make([]string, len(auctionIDs))
+	for i, auctionID := range auctionIDs {
+		mergedIDs[i] = fmt.Sprintf("%d", auctionID)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionMerge,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", merged.ID)),
+			sdk.NewAttribute(types.AttributeKeyMergedIDs, strings.Join(mergedIDs, ",")),
+			sdk.NewAttribute(types.AttributeKeyLot, merged.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, merged.MaxBid.String()),
+		),
+	)
+	return merged.ID, nil
+}
+
+// validateMergeable checks that an auction can be merged into another.
+func validateMergeable(into, auction *types.CollateralAuction) error {
+	if auction.Initiator != into.Initiator {
+		return errorsmod.Wrapf(types.ErrCannotMergeAuctions, "auction %d initiator %s ≠ %s", auction.ID, auction.Initiator, into.Initiator)
+	}
+	if auction.Lot.Denom != into.Lot.Denom {
+		return errorsmod.Wrapf(types.ErrCannotMergeAuctions, "auction %d lot denom %s ≠ %s", auction.ID, auction.Lot.Denom, into.Lot.Denom)
+	}
+	if auction.MaxBid.Denom != into.MaxBid.Denom {
+		return errorsmod.Wrapf(types.ErrCannotMergeAuctions, "auction %d bid denom %s ≠ %s", auction.ID, auction.MaxBid.Denom, into.MaxBid.Denom)
+	}
+	if auction.CorrespondingDebt.Denom != into.CorrespondingDebt.Denom {
+		return errorsmod.Wrapf(types.ErrCannotMergeAuctions, "auction %d debt denom %s ≠ %s", auction.ID, auction.CorrespondingDebt.Denom, into.CorrespondingDebt.Denom)
+	}
+	return nil
+}
+
+// scaleWeightedAddresses returns lot returns with weights in units of the lot of their auction, so that the lot returns
+// of auctions with different lots can be merged. Weights are rounded down, and the remainder of the lot is given to
+// the addresses with the largest remainders.
+func scaleWeightedAddresses(wa types.WeightedAddresses, lot sdkmath.Int) types.WeightedAddresses {
+	total := sdk.ZeroInt()
+	for _, w := range wa.Weights {
+		total = total.Add(w)
+	}
+
+	scaled := types.WeightedAddresses{
+		Addresses: append([]sdk.AccAddress{}, wa.Addresses...),
+		Weights:   make([]sdkmath.Int, len(wa.Weights)),
+	}
+	remainders := make([]sdkmath.Int, len(wa.Weights))
+	unallocated := lot
+	for i, w := range wa.Weights {
+		scaled.Weights[i] = w.Mul(lot).Quo(total)
+		remainders[i] = w.Mul(lot).Mod(total)
+		unallocated = unallocated.Sub(scaled.Weights[i])
+	}
+
+	order := make([]int, len(remainders))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return remainders[order[a]].GT(remainders[order[b]]) })
+	for _, i := range order {
+		if !unallocated.IsPositive() {
+			break
+		}
+		scaled.Weights[i] = scaled.Weights[i].Add(sdk.OneInt())
+		unallocated = unallocated.Sub(sdk.OneInt())
+	}
+	return scaled
+}
+
+// mergeWeightedAddresses combines two sets of lot returns, summing the weights of addresses that appear in both.
+func mergeWeightedAddresses(wa1, wa2 types.WeightedAddresses) types.WeightedAddresses {
+	merged := types.WeightedAddresses{
+		Addresses: append([]sdk.AccAddress{}, wa1.Addresses...),
+		Weights:   append([]sdkmath.Int{}, wa1.Weights...),
+	}
+	for i, addr := range wa2.Addresses {
+		found := false
+		for j := range merged.Addresses {
+			if merged.Addresses[j].Equals(addr) {
+				merged.Weights[j] = merged.Weights[j].Add(wa2.Weights[i])
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged.Addresses = append(merged.Addresses, addr)
+			merged.Weights = append(merged.Weights, wa2.Weights[i])
+		}
+	}
+	return merged
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/auction/testutil"
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+type batchTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *batchTestSuite) SetupTest() {
+	suite.Suite.SetupTest(4)
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.MaxMergedLots = cs(c("token1", 40))
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+func TestBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(batchTestSuite))
+}
+
+func (suite *batchTestSuite) startCollateralAuctions(lots ...int64) []uint64 {
+	var ids []uint64
+	for i, lot := range lots {
+		id, err := suite.Keeper.StartCollateralAuction(
+			suite.Ctx, suite.ModAcc.Name, c("token1", lot), c("token2", lot*2), suite.Addrs[i:i+1], is(lot), c("debt", lot*2), d("2"),
+		)
+		suite.Require().NoError(err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (suite *batchTestSuite) TestPlaceBids() {
+	bidder := suite.Addrs[3]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	ids := suite.startCollateralAuctions(10, 20)
+	bids := []types.BidEntry{
+		types.NewBidEntry(ids[0], c("token2", 10)),
+		types.NewBidEntry(ids[1], c("token2", 20)),
+	}
+
+	// Bids exceeding the max total spend are not placed
+	err := suite.Keeper.PlaceBids(suite.Ctx, bidder, bids, cs(c("token2", 29)))
+	suite.ErrorIs(err, types.ErrTotalSpendExceeded)
+	for _, id := range ids {
+		auction, found := suite.Keeper.GetAuction(suite.Ctx, id)
+		suite.True(found)
+		suite.False(auction.(*types.CollateralAuction).HasReceivedBids)
+	}
+
+	// A failing bid fails the batch
+	invalidBids := append(bids, types.NewBidEntry(ids[1]+1, c("token2", 1)))
+	err = suite.Keeper.PlaceBids(suite.Ctx, bidder, invalidBids, cs(c("token2", 100)))
+	suite.ErrorIs(err, types.ErrAuctionNotFound)
+	suite.CheckAccountBalanceEqual(bidder, cs(c("token1", 100), c("token2", 100)))
+
+	suite.NoError(suite.Keeper.PlaceBids(suite.Ctx, bidder, bids, cs(c("token2", 30))))
+	suite.CheckAccountBalanceEqual(bidder, cs(c("token1", 100), c("token2", 70)))
+	for i, id := range ids {
+		auction, found := suite.Keeper.GetAuction(suite.Ctx, id)
+		suite.True(found)
+		suite.Equal(bidder, auction.GetBidder())
+		suite.Equal(bids[i].Amount, auction.GetBid())
+	}
+
+	// Raising their own bids only spends the increase
+	raisedBids := []types.BidEntry{
+		types.NewBidEntry(ids[0], c("token2", 15)),
+		types.NewBidEntry(ids[1], c("token2", 25)),
+	}
+	suite.NoError(suite.Keeper.PlaceBids(suite.Ctx, bidder, raisedBids, cs(c("token2", 10))))
+	suite.CheckAccountBalanceEqual(bidder, cs(c("token1", 100), c("token2", 60)))
+}
+
+func (suite *batchTestSuite) TestMergeCollateralAuctions() {
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	ids := suite.startCollateralAuctions(10, 20, 5)
+	auctionModAccBefore := suite.BankKeeper.GetAllBalances(suite.Ctx, suite.AccountKeeper.GetModuleAddress(types.ModuleName))
+
+	mergedID, err := suite.Keeper.MergeCollateralAuctions(suite.Ctx, ids)
+	suite.NoError(err)
+	suite.Equal(ids[0], mergedID)
+
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, mergedID)
+	suite.True(found)
+	merged := auction.(*types.CollateralAuction)
+	suite.Equal(c("token1", 35), merged.Lot)
+	suite.Equal(c("token2", 70), merged.MaxBid)
+	suite.Equal(c("debt", 70), merged.CorrespondingDebt)
+	suite.Equal(suite.Addrs[0:3], merged.LotReturns.Addresses)
+	suite.Equal(is(10, 20, 5), merged.LotReturns.Weights)
+	suite.NoError(merged.Validate())
+
+	for _, id := range ids[1:] {
+		_, found := suite.Keeper.GetAuction(suite.Ctx, id)
+		suite.False(found)
+	}
+	// No coins are moved
+	suite.Equal(auctionModAccBefore, suite.BankKeeper.GetAllBalances(suite.Ctx, suite.AccountKeeper.GetModuleAddress(types.ModuleName)))
+}
+
+func (suite *batchTestSuite) TestMergeCollateralAuctions_ScalesLotReturns() {
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	// Lot returns with flat weights, as used by hard liquidations
+	var ids []uint64
+	for _, lot := range []int64{10, 30} {
+		id, err := suite.Keeper.StartCollateralAuction(
+			suite.Ctx, suite.ModAcc.Name, c("token1", lot), c("token2", lot*2), suite.Addrs[0:2], is(100, 100), c("debt", lot*2), d("2"),
+		)
+		suite.Require().NoError(err)
+		ids = append(ids, id)
+	}
+
+	mergedID, err := suite.Keeper.MergeCollateralAuctions(suite.Ctx, ids)
+	suite.NoError(err)
+
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, mergedID)
+	suite.True(found)
+	merged := auction.(*types.CollateralAuction)
+	suite.Equal(suite.Addrs[0:2], merged.LotReturns.Addresses)
+	suite.Equal(is(20, 20), merged.LotReturns.Weights)
+}
+
+func (suite *batchTestSuite) TestMergeCollateralAuctions_MaxMergedLot() {
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 200)))
+	ids := suite.startCollateralAuctions(20, 25)
+	smallIDs := suite.startCollateralAuctions(5, 5)
+
+	// Merged lot exceeds the max merged lot
+	_, err := suite.Keeper.MergeCollateralAuctions(suite.Ctx, ids)
+	suite.ErrorIs(err, types.ErrCannotMergeAuctions)
+
+	// Denoms without a max merged lot cannot be merged
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.MaxMergedLots = cs(c("token2", 100))
+	suite.Keeper.SetParams(suite.Ctx, params)
+	_, err = suite.Keeper.MergeCollateralAuctions(suite.Ctx, smallIDs)
+	suite.ErrorIs(err, types.ErrCannotMergeAuctions)
+}
+
+func (suite *batchTestSuite) TestMergeCollateralAuctions_Invalid() {
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	ids := suite.startCollateralAuctions(10, 20, 5)
+
+	// Auctions with bids cannot be merged
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, ids[1], suite.Addrs[3], c("token2", 1)))
+	_, err := suite.Keeper.MergeCollateralAuctions(suite.Ctx, ids)
+	suite.ErrorIs(err, types.ErrCannotMergeAuctions)
+
+	// Auctions must be consecutive
+	_, err = suite.Keeper.MergeCollateralAuctions(suite.Ctx, []uint64{ids[0], ids[2]})
+	suite.ErrorIs(err, types.ErrCannotMergeAuctions)
+
+	// Auctions must have the same denoms
+	otherDenomID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, suite.ModAcc.Name, c("token2", 10), c("token1", 20), suite.Addrs[0:1], is(10), c("debt", 20), d("2"))
+	suite.NoError(err)
+	_, err = suite.Keeper.MergeCollateralAuctions(suite.Ctx, []uint64{ids[2], otherDenomID})
+	suite.ErrorIs(err, types.ErrCannotMergeAuctions)
+
+	// Only collateral auctions can be merged
+	surplusID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 10), "token2")
+	suite.NoError(err)
+	_, err = suite.Keeper.MergeCollateralAuctions(suite.Ctx, []uint64{otherDenomID, surplusID})
+	suite.ErrorIs(err, types.ErrCannotMergeAuctions)
+
+	// Auctions must exist
+	_, err = suite.Keeper.MergeCollateralAuctions(suite.Ctx, []uint64{surplusID + 1, surplusID + 2})
+	suite.ErrorIs(err, types.ErrAuctionNotFound)
+
+	// Failed merges leave the auctions unchanged
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, ids[2])
+	suite.True(found)
+	suite.Equal(c("token1", 5), auction.GetLot())
+}
@@ -462,9 +462,21 @@ func TestAuctionBidding(t *testing.T) {
 				types.DefaultIncrement,
 				types.DefaultIncrement,
 				types.DefaultIncrement,
+				types.DefaultDutchAuctionDuration,
+				types.DefaultDutchPricePremium,
+				types.DefaultDutchDecayCurve,
+				types.DefaultDutchStepDuration,
+				types.DefaultDutchStepDecay,
+				types.DefaultSealedBidEnabled,
+				types.DefaultSealedBidCommitDuration,
+				types.DefaultSealedBidRevealDuration,
+				types.DefaultBidHistoryEnabled,
+				types.DefaultMaxBidHistoryLength,
+				types.DefaultHistoryRetentionDuration,
+				types.DefaultMaxMergedLots,
 			)
 
-			auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
+			auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{}, types.SealedBids{}, types.BidRecords{}, types.ClosedAuctions{}, types.BidderStatsList{})
 			require.NoError(t, err)
 
 			moduleGs := tApp.AppCodec().MustMarshalJSON(auctionGs)
@@ -492,7 +504,7 @@ func TestAuctionBidding(t *testing.T) {
 			case Debt:
 				id, err = keeper.StartDebtAuction(ctx, tc.auctionArgs.seller, tc.auctionArgs.bid, tc.auctionArgs.lot, tc.auctionArgs.debt)
 			case Collateral:
-				id, err = keeper.StartCollateralAuction(ctx, tc.auctionArgs.seller, tc.auctionArgs.lot, tc.auctionArgs.bid, tc.auctionArgs.addresses, tc.auctionArgs.weights, tc.auctionArgs.debt) // seller, lot, maxBid, otherPerson
+				id, err = keeper.StartCollateralAuction(ctx, tc.auctionArgs.seller, tc.auctionArgs.lot, tc.auctionArgs.bid, tc.auctionArgs.addresses, tc.auctionArgs.weights, tc.auctionArgs.debt, sdk.ZeroDec()) // seller, lot, maxBid, otherPerson
 			default:
 				t.Fail()
 			}
@@ -76,9 +76,25 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 
 	var auctions []*codectypes.Any
 	auctionStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.AuctionKeyPrefix)
+	unmarshalAuction := s.keeper.UnmarshalAuction
+
+	// sealed-bid auctions in a phase are read from the bySealedBidPhase index instead of iterating over all auctions
+	if phase, ok := sealedBidPhases[req.Phase]; ok {
+		auctionStore = prefix.NewStore(
+			prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.AuctionBySealedBidPhaseKeyPrefix),
+			types.GetAuctionBySealedBidPhasePrefix(phase),
+		)
+		unmarshalAuction = func(value []byte) (types.Auction, error) {
+			auction, found := s.keeper.GetAuction(ctx, types.Uint64FromBytes(value))
+			if !found {
+				return nil, status.Errorf(codes.NotFound, "auction %d", types.Uint64FromBytes(value))
+			}
+			return auction, nil
+		}
+	}
 
 	pageRes, err := query.FilteredPaginate(auctionStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		result, err := s.keeper.UnmarshalAuction(value)
+		result, err := unmarshalAuction(value)
 		if err != nil {
 			return false, err
 		}
@@ -86,12 +102,17 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 		// True if empty owner, otherwise check if auction contains owner
 		ownerIsMatch := req.Owner == ""
 		if req.Owner != "" {
-			if cAuc, ok := result.(*types.CollateralAuction); ok {
-				for _, addr := range cAuc.GetLotReturns().Addresses {
-					if addr.String() == req.Owner {
-						ownerIsMatch = true
-						break
-					}
+			var lotReturns types.WeightedAddresses
+			switch auc := result.(type) {
+			case *types.CollateralAuction:
+				lotReturns = auc.GetLotReturns()
+			case *types.DutchAuction:
+				lotReturns = auc.GetLotReturns()
+			}
+			for _, addr := range lotReturns.Addresses {
+				if addr.String() == req.Owner {
+					ownerIsMatch = true
+					break
 				}
 			}
 		}
@@ -129,6 +150,12 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 	}, nil
 }
 
+// sealedBidPhases maps the auction phases of sealed-bid auctions to their sealed bid phase.
+var sealedBidPhases = map[string]types.SealedBidPhase{
+	types.CommitAuctionPhase: types.SEALED_BID_PHASE_COMMIT,
+	types.RevealAuctionPhase: types.SEALED_BID_PHASE_REVEAL,
+}
+
 // NextAuctionID implements the gRPC service handler for querying x/auction next auction ID.
 func (s queryServer) NextAuctionID(ctx context.Context, req *types.QueryNextAuctionIDRequest) (*types.QueryNextAuctionIDResponse, error) {
 	if req == nil {
@@ -143,3 +170,152 @@ func (s queryServer) NextAuctionID(ctx context.Context, req *types.QueryNextAuct
 
 	return &types.QueryNextAuctionIDResponse{Id: nextAuctionID}, nil
 }
+
+// SealedBids implements the Query/SealedBids gRPC method
+func (s queryServer) SealedBids(c context.Context, req *types.QuerySealedBidsRequest) (*types.QuerySealedBidsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sealedBids := types.SealedBids{}
+	sealedBidStore := prefix.NewStore(
+		prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.SealedBidKeyPrefix),
+		types.Uint64ToBytes(req.AuctionId),
+	)
+
+	pageRes, err := query.Paginate(sealedBidStore, req.Pagination, func(key []byte, value []byte) error {
+		var sealedBid types.SealedBid
+		if err := s.keeper.cdc.Unmarshal(value, &sealedBid); err != nil {
+			return err
+		}
+		sealedBids = append(sealedBids, sealedBid)
+		return nil
+	})
+	if err != nil {
+		return &types.QuerySealedBidsResponse{}, err
+	}
+
+	return &types.QuerySealedBidsResponse{
+		SealedBids: sealedBids,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AuctionBids implements the Query/AuctionBids gRPC method
+func (s queryServer) AuctionBids(c context.Context, req *types.QueryAuctionBidsRequest) (*types.QueryAuctionBidsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bids := types.BidRecords{}
+	bidRecordStore := prefix.NewStore(
+		prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.BidRecordKeyPrefix),
+		types.Uint64ToBytes(req.AuctionId),
+	)
+
+	pageRes, err := query.Paginate(bidRecordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.BidRecord
+		if err := s.keeper.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		bids = append(bids, record)
+		return nil
+	})
+	if err != nil {
+		return &types.QueryAuctionBidsResponse{}, err
+	}
+
+	return &types.QueryAuctionBidsResponse{
+		Bids:       bids,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ClosedAuctions implements the Query/ClosedAuctions gRPC method
+func (s queryServer) ClosedAuctions(c context.Context, req *types.QueryClosedAuctionsRequest) (*types.QueryClosedAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var winner sdk.AccAddress
+	if req.Winner != "" {
+		var err error
+		winner, err = sdk.AccAddressFromBech32(req.Winner)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid winner address: %s", err)
+		}
+	}
+
+	closedAuctions := types.ClosedAuctions{}
+	closedAuctionStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.ClosedAuctionKeyPrefix)
+
+	pageRes, err := query.FilteredPaginate(closedAuctionStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var closedAuction types.ClosedAuction
+		if err := s.keeper.cdc.Unmarshal(value, &closedAuction); err != nil {
+			return false, err
+		}
+
+		typeIsMatch := req.Type == "" || req.Type == closedAuction.AuctionType
+		winnerIsMatch := winner.Empty() || winner.Equals(closedAuction.Winner)
+
+		if typeIsMatch && winnerIsMatch {
+			if accumulate {
+				closedAuctions = append(closedAuctions, closedAuction)
+			}
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return &types.QueryClosedAuctionsResponse{}, err
+	}
+
+	return &types.QueryClosedAuctionsResponse{
+		ClosedAuctions: closedAuctions,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// BidderStats implements the Query/BidderStats gRPC method
+func (s queryServer) BidderStats(c context.Context, req *types.QueryBidderStatsRequest) (*types.QueryBidderStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Bidder != "" {
+		bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bidder address: %s", err)
+		}
+		allStats := types.BidderStatsList{}
+		if stats, found := s.keeper.GetBidderStats(ctx, bidder); found {
+			allStats = append(allStats, stats)
+		}
+		return &types.QueryBidderStatsResponse{BidderStats: allStats}, nil
+	}
+
+	allStats := types.BidderStatsList{}
+	bidderStatsStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.BidderStatsKeyPrefix)
+
+	pageRes, err := query.Paginate(bidderStatsStore, req.Pagination, func(key []byte, value []byte) error {
+		var stats types.BidderStats
+		if err := s.keeper.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+		allStats = append(allStats, stats)
+		return nil
+	})
+	if err != nil {
+		return &types.QueryBidderStatsResponse{}, err
+	}
+
+	return &types.QueryBidderStatsResponse{
+		BidderStats: allStats,
+		Pagination:  pageRes,
+	}, nil
+}
@@ -0,0 +1,353 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// recordBid adds a bid to the bid history of an auction and the statistics of its bidder, if bid history is enabled.
+// The oldest bids of the auction are pruned once it has more than the max bid history length.
+func (k Keeper) recordBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, bid, lot sdk.Coin) {
+	params := k.GetParams(ctx)
+	if !params.BidHistoryEnabled {
+		return
+	}
+
+	sequence := k.nextBidSequence(ctx, auctionID)
+	k.SetBidRecord(ctx, types.NewBidRecord(auctionID, sequence, bidder, bid, lot, ctx.BlockTime(), ctx.BlockHeight()))
+
+	var records []types.BidRecord
+	k.IterateBidRecords(ctx, auctionID, func(record types.BidRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	for i := 0; uint64(len(records)-i) > params.MaxBidHistoryLength; i++ {
+		k.DeleteBidRecord(ctx, auctionID, records[i].Sequence)
+	}
+
+	stats, found := k.GetBidderStats(ctx, bidder)
+	if !found {
+		stats = types.NewBidderStats(bidder)
+	}
+	stats.BidCount++
+	k.SetBidderStats(ctx, stats)
+}
+
+// recordPurchase adds a purchase from a dutch auction to the statistics of its buyer, if bid history is enabled. The
+// lot of a dutch auction is sold over many purchases, so each buyer is credited with what they bought and paid.
+func (k Keeper) recordPurchase(ctx sdk.Context, buyer sdk.AccAddress, payment, lot sdk.Coin) {
+	if !k.GetParams(ctx).BidHistoryEnabled {
+		return
+	}
+
+	stats, found := k.GetBidderStats(ctx, buyer)
+	if !found {
+		stats = types.NewBidderStats(buyer)
+	}
+	if lot.IsPositive() {
+		stats.TotalLotWon = stats.TotalLotWon.Add(lot)
+	}
+	if payment.IsPositive() {
+		stats.TotalBidPaid = stats.TotalBidPaid.Add(payment)
+	}
+	k.SetBidderStats(ctx, stats)
+}
+
+// nextBidSequence returns the sequence of the next bid recorded in the bid history of an auction.
+func (k Keeper) nextBidSequence(ctx sdk.Context, auctionID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.Uint64ToBytes(auctionID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1
+	}
+	var record types.BidRecord
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record.Sequence + 1
+}
+
+// recordClosedAuction stores a record of a closed auction and updates the statistics of its winner, if bid history
+// is enabled. Closed auction records are kept for the history retention duration. The buyers of a dutch auction are
+// credited as they buy, so only the auction count of its last buyer is updated when it closes.
+func (k Keeper) recordClosedAuction(ctx sdk.Context, auction types.Auction) {
+	if !k.GetParams(ctx).BidHistoryEnabled {
+		return
+	}
+
+	lot := auction.GetLot()
+	oraclePrice := sdk.ZeroDec()
+	isDutchAuction := false
+	switch a := auction.(type) {
+	case *types.CollateralAuction:
+		if !a.MarketPrice.IsNil() {
+			oraclePrice = a.MarketPrice
+		}
+	case *types.DutchAuction:
+		isDutchAuction = true
+		// the lot of a dutch auction is sold over many purchases, the remaining lot is returned
+		lot = a.LotSold
+		if lot.Denom == "" {
+			lot = sdk.NewInt64Coin(a.Lot.Denom, 0)
+		}
+		if !a.MarketPrice.IsNil() {
+			oraclePrice = a.MarketPrice
+		}
+	}
+
+	closedAuction := types.NewClosedAuction(
+		auction.GetID(),
+		auction.GetType(),
+		auction.GetInitiator(),
+		auction.GetBidder(),
+		lot,
+		auction.GetBid(),
+		oraclePrice,
+		ctx.BlockTime(),
+		ctx.BlockHeight(),
+	)
+	k.SetClosedAuction(ctx, closedAuction)
+
+	if closedAuction.Winner.Empty() {
+		return
+	}
+	stats, found := k.GetBidderStats(ctx, closedAuction.Winner)
+	if !found {
+		stats = types.NewBidderStats(closedAuction.Winner)
+	}
+	stats.AuctionsWon++
+	if isDutchAuction {
+		k.SetBidderStats(ctx, stats)
+		return
+	}
+	if closedAuction.Lot.IsPositive() {
+		stats.TotalLotWon = stats.TotalLotWon.Add(closedAuction.Lot)
+	}
+	if closedAuction.Bid.IsPositive() {
+		stats.TotalBidPaid = stats.TotalBidPaid.Add(closedAuction.Bid)
+	}
+	k.SetBidderStats(ctx, stats)
+}
+
+// PruneAuctionHistory deletes the closed auction records, and their bid history, that have been kept for longer than
+// the history retention duration.
+func (k Keeper) PruneAuctionHistory(ctx sdk.Context) {
+	retention := k.GetParams(ctx).HistoryRetentionDuration
+	if retention <= 0 {
+		return
+	}
+
+	var auctionIDs []uint64
+	k.IterateClosedAuctionsByTime(ctx, ctx.BlockTime().Add(-retention), func(auctionID uint64) bool {
+		auctionIDs = append(auctionIDs, auctionID)
+		return false
+	})
+
+	for _, id := range auctionIDs {
+		k.DeleteClosedAuction(ctx, id)
+
+		var sequences []uint64
+		k.IterateBidRecords(ctx, id, func(record types.BidRecord) bool {
+			sequences = append(sequences, record.Sequence)
+			return false
+		})
+		for _, sequence := range sequences {
+			k.DeleteBidRecord(ctx, id, sequence)
+		}
+	}
+}
+
+// SetBidRecord puts a bid record into the store.
+func (k Keeper) SetBidRecord(ctx sdk.Context, record types.BidRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+	store.Set(types.GetBidRecordKey(record.AuctionID, record.Sequence), k.cdc.MustMarshal(&record))
+}
+
+// DeleteBidRecord removes a bid record from the store.
+func (k Keeper) DeleteBidRecord(ctx sdk.Context, auctionID, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+	store.Delete(types.GetBidRecordKey(auctionID, sequence))
+}
+
+// IterateBidRecords provides an iterator over the bid history of an auction, ordered by sequence.
+// For each bid record cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateBidRecords(ctx sdk.Context, auctionID uint64, cb func(record types.BidRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.Uint64ToBytes(auctionID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BidRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// IterateAllBidRecords provides an iterator over the bid history of all auctions.
+// For each bid record cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAllBidRecords(ctx sdk.Context, cb func(record types.BidRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BidRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetBidRecords returns the bid history of an auction
+func (k Keeper) GetBidRecords(ctx sdk.Context, auctionID uint64) types.BidRecords {
+	records := types.BidRecords{}
+	k.IterateBidRecords(ctx, auctionID, func(record types.BidRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// GetAllBidRecords returns the bid history of all auctions
+func (k Keeper) GetAllBidRecords(ctx sdk.Context) types.BidRecords {
+	records := types.BidRecords{}
+	k.IterateAllBidRecords(ctx, func(record types.BidRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// SetClosedAuction puts a closed auction record into the store, and indexes it by close time.
+func (k Keeper) SetClosedAuction(ctx sdk.Context, closedAuction types.ClosedAuction) {
+	existing, found := k.GetClosedAuction(ctx, closedAuction.AuctionID)
+	if found {
+		k.removeFromClosedAuctionByTimeIndex(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionKeyPrefix)
+	store.Set(types.GetAuctionKey(closedAuction.AuctionID), k.cdc.MustMarshal(&closedAuction))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionByTimeKeyPrefix)
+	indexStore.Set(types.GetClosedAuctionByTimeKey(closedAuction.CloseTime, closedAuction.AuctionID), types.Uint64ToBytes(closedAuction.AuctionID))
+}
+
+// GetClosedAuction gets a closed auction record from the store.
+func (k Keeper) GetClosedAuction(ctx sdk.Context, auctionID uint64) (types.ClosedAuction, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionKeyPrefix)
+	bz := store.Get(types.GetAuctionKey(auctionID))
+	if bz == nil {
+		return types.ClosedAuction{}, false
+	}
+	var closedAuction types.ClosedAuction
+	k.cdc.MustUnmarshal(bz, &closedAuction)
+	return closedAuction, true
+}
+
+// DeleteClosedAuction removes a closed auction record from the store, and the close time index.
+func (k Keeper) DeleteClosedAuction(ctx sdk.Context, auctionID uint64) {
+	closedAuction, found := k.GetClosedAuction(ctx, auctionID)
+	if found {
+		k.removeFromClosedAuctionByTimeIndex(ctx, closedAuction)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionKeyPrefix)
+	store.Delete(types.GetAuctionKey(auctionID))
+}
+
+// removeFromClosedAuctionByTimeIndex removes a closed auction record from the close time index.
+func (k Keeper) removeFromClosedAuctionByTimeIndex(ctx sdk.Context, closedAuction types.ClosedAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionByTimeKeyPrefix)
+	store.Delete(types.GetClosedAuctionByTimeKey(closedAuction.CloseTime, closedAuction.AuctionID))
+}
+
+// IterateClosedAuctionsByTime provides an iterator over closed auction records ordered by close time.
+// For each record closed at or before the cutoff time cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateClosedAuctionsByTime(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(auctionID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionByTimeKeyPrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)), // include any keys with times equal to inclusiveCutoffTime
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.Uint64FromBytes(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// IterateClosedAuctions provides an iterator over all closed auction records, ordered by auction ID.
+// For each record cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateClosedAuctions(ctx sdk.Context, cb func(closedAuction types.ClosedAuction) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClosedAuctionKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var closedAuction types.ClosedAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &closedAuction)
+		if cb(closedAuction) {
+			break
+		}
+	}
+}
+
+// GetAllClosedAuctions returns all closed auction records from the store
+func (k Keeper) GetAllClosedAuctions(ctx sdk.Context) types.ClosedAuctions {
+	closedAuctions := types.ClosedAuctions{}
+	k.IterateClosedAuctions(ctx, func(closedAuction types.ClosedAuction) bool {
+		closedAuctions = append(closedAuctions, closedAuction)
+		return false
+	})
+	return closedAuctions
+}
+
+// SetBidderStats puts the statistics of a bidder into the store.
+func (k Keeper) SetBidderStats(ctx sdk.Context, stats types.BidderStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidderStatsKeyPrefix)
+	store.Set(stats.Bidder, k.cdc.MustMarshal(&stats))
+}
+
+// GetBidderStats gets the statistics of a bidder from the store.
+func (k Keeper) GetBidderStats(ctx sdk.Context, bidder sdk.AccAddress) (types.BidderStats, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidderStatsKeyPrefix)
+	bz := store.Get(bidder)
+	if bz == nil {
+		return types.BidderStats{}, false
+	}
+	var stats types.BidderStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// IterateBidderStats provides an iterator over the statistics of all bidders.
+// For each bidder cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateBidderStats(ctx sdk.Context, cb func(stats types.BidderStats) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BidderStatsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.BidderStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllBidderStats returns the statistics of all bidders from the store
+func (k Keeper) GetAllBidderStats(ctx sdk.Context) types.BidderStatsList {
+	allStats := types.BidderStatsList{}
+	k.IterateBidderStats(ctx, func(stats types.BidderStats) bool {
+		allStats = append(allStats, stats)
+		return false
+	})
+	return allStats
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/auction/testutil"
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+type historyTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *historyTestSuite) SetupTest() {
+	suite.Suite.SetupTest(4)
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.BidHistoryEnabled = true
+	params.MaxBidHistoryLength = 2
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+func TestHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(historyTestSuite))
+}
+
+func (suite *historyTestSuite) TestSurplusAuctionHistory() {
+	bidder1, bidder2 := suite.Addrs[0], suite.Addrs[1]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100)))
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.NoError(err)
+
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bidder1, c("token2", 10)))
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bidder2, c("token2", 20)))
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bidder1, c("token2", 30)))
+
+	// Only the latest bids are kept
+	bids := suite.Keeper.GetBidRecords(suite.Ctx, auctionID)
+	suite.Len(bids, 2)
+	suite.Equal(uint64(2), bids[0].Sequence)
+	suite.Equal(bidder2, bids[0].Bidder)
+	suite.Equal(c("token2", 20), bids[0].Bid)
+	suite.Equal(uint64(3), bids[1].Sequence)
+	suite.Equal(bidder1, bids[1].Bidder)
+	suite.Equal(c("token2", 30), bids[1].Bid)
+	suite.Equal(c("token1", 20), bids[1].Lot)
+
+	// Close the auction
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	closeCtx := suite.Ctx.WithBlockTime(auction.GetEndTime())
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(closeCtx))
+
+	closedAuction, found := suite.Keeper.GetClosedAuction(closeCtx, auctionID)
+	suite.True(found)
+	suite.Equal(types.SurplusAuctionType, closedAuction.AuctionType)
+	suite.Equal(bidder1, closedAuction.Winner)
+	suite.Equal(c("token1", 20), closedAuction.Lot)
+	suite.Equal(c("token2", 30), closedAuction.Bid)
+	suite.Equal(d("1.5"), closedAuction.FinalPrice)
+	suite.Equal(d("0"), closedAuction.Discount)
+
+	stats1, found := suite.Keeper.GetBidderStats(closeCtx, bidder1)
+	suite.True(found)
+	suite.Equal(uint64(2), stats1.BidCount)
+	suite.Equal(uint64(1), stats1.AuctionsWon)
+	suite.Equal(cs(c("token1", 20)), stats1.TotalLotWon)
+	suite.Equal(cs(c("token2", 30)), stats1.TotalBidPaid)
+	stats2, found := suite.Keeper.GetBidderStats(closeCtx, bidder2)
+	suite.True(found)
+	suite.Equal(uint64(1), stats2.BidCount)
+	suite.Equal(uint64(0), stats2.AuctionsWon)
+
+	// History is kept until the retention duration has passed
+	suite.Keeper.PruneAuctionHistory(closeCtx.WithBlockTime(auction.GetEndTime().Add(types.DefaultHistoryRetentionDuration - 1)))
+	_, found = suite.Keeper.GetClosedAuction(closeCtx, auctionID)
+	suite.True(found)
+
+	suite.Keeper.PruneAuctionHistory(closeCtx.WithBlockTime(auction.GetEndTime().Add(types.DefaultHistoryRetentionDuration)))
+	_, found = suite.Keeper.GetClosedAuction(closeCtx, auctionID)
+	suite.False(found)
+	suite.Empty(suite.Keeper.GetBidRecords(closeCtx, auctionID))
+	// Bidder statistics are not pruned
+	_, found = suite.Keeper.GetBidderStats(closeCtx, bidder1)
+	suite.True(found)
+}
+
+func (suite *historyTestSuite) TestCollateralAuctionHistory() {
+	bidder := suite.Addrs[0]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction at a market price of 2.5 token2 per token1
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), suite.Addrs[1:2], is(1), c("debt", 40), d("2.5"))
+	suite.NoError(err)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bidder, c("token2", 40)))
+
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	closeCtx := suite.Ctx.WithBlockTime(auction.GetEndTime())
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(closeCtx))
+
+	// The lot sold 20% below the market price when the auction started
+	closedAuction, found := suite.Keeper.GetClosedAuction(closeCtx, auctionID)
+	suite.True(found)
+	suite.Equal(types.CollateralAuctionType, closedAuction.AuctionType)
+	suite.Equal(d("2"), closedAuction.FinalPrice)
+	suite.Equal(d("2.5"), closedAuction.OraclePrice)
+	suite.Equal(d("0.2"), closedAuction.Discount)
+}
+
+func (suite *historyTestSuite) TestDutchAuctionHistory() {
+	buyer1, buyer2 := suite.Addrs[0], suite.Addrs[2]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction at a market price of 2 token2 per token1
+	auctionID, err := suite.Keeper.StartDutchAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 50), suite.Addrs[1:2], is(1), c("debt", 40), d("2"))
+	suite.NoError(err)
+
+	// Buy half the lot at the start price, and the rest after the price has decayed by half
+	suite.NoError(suite.Keeper.BuyCollateral(suite.Ctx, auctionID, buyer1, c("token1", 10), d("2.4")))
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration / 2))
+	suite.NoError(suite.Keeper.BuyCollateral(ctx, auctionID, buyer2, c("token1", 10), d("1.2")))
+
+	bids := suite.Keeper.GetBidRecords(ctx, auctionID)
+	suite.Len(bids, 2)
+	suite.Equal(c("token2", 24), bids[0].Bid)
+	suite.Equal(c("token1", 10), bids[0].Lot)
+	suite.Equal(c("token2", 12), bids[1].Bid)
+
+	// The sold out auction is recorded at the average price paid, 10% below the market price
+	closedAuction, found := suite.Keeper.GetClosedAuction(ctx, auctionID)
+	suite.True(found)
+	suite.Equal(buyer2, closedAuction.Winner)
+	suite.Equal(c("token1", 20), closedAuction.Lot)
+	suite.Equal(c("token2", 36), closedAuction.Bid)
+	suite.Equal(d("1.8"), closedAuction.FinalPrice)
+	suite.Equal(d("2"), closedAuction.OraclePrice)
+	suite.Equal(d("0.1"), closedAuction.Discount)
+
+	// Each buyer is credited with their own purchase
+	stats1, found := suite.Keeper.GetBidderStats(ctx, buyer1)
+	suite.True(found)
+	suite.Equal(uint64(0), stats1.AuctionsWon)
+	suite.Equal(cs(c("token1", 10)), stats1.TotalLotWon)
+	suite.Equal(cs(c("token2", 24)), stats1.TotalBidPaid)
+	stats2, found := suite.Keeper.GetBidderStats(ctx, buyer2)
+	suite.True(found)
+	suite.Equal(uint64(1), stats2.AuctionsWon)
+	suite.Equal(cs(c("token1", 10)), stats2.TotalLotWon)
+	suite.Equal(cs(c("token2", 12)), stats2.TotalBidPaid)
+}
+
+func (suite *historyTestSuite) TestHistoryDisabled() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.BidHistoryEnabled = false
+	suite.Keeper.SetParams(suite.Ctx, params)
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100)))
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.NoError(err)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 10)))
+
+	auction, _ := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	closeCtx := suite.Ctx.WithBlockTime(auction.GetEndTime())
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(closeCtx))
+
+	suite.Empty(suite.Keeper.GetBidRecords(closeCtx, auctionID))
+	suite.Empty(suite.Keeper.GetAllClosedAuctions(closeCtx))
+	suite.Empty(suite.Keeper.GetAllBidderStats(closeCtx))
+}
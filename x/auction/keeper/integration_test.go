@@ -7,6 +7,7 @@ import (
 
 func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
 func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
+func d(amount string) sdk.Dec               { return sdk.MustNewDecFromStr(amount) }
 func is(ns ...int64) (is []sdkmath.Int) {
 	for _, n := range ns {
 		is = append(is, sdkmath.NewInt(n))
@@ -128,12 +128,14 @@ func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
 	existingAuction, found := k.GetAuction(ctx, auction.GetID())
 	if found {
 		k.removeFromByTimeIndex(ctx, existingAuction.GetEndTime(), existingAuction.GetID())
+		k.removeFromBySealedBidPhaseIndex(ctx, existingAuction)
 	}
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
 
 	store.Set(types.GetAuctionKey(auction.GetID()), k.MustMarshalAuction(auction))
 	k.InsertIntoByTimeIndex(ctx, auction.GetEndTime(), auction.GetID())
+	k.insertIntoBySealedBidPhaseIndex(ctx, auction)
 }
 
 // GetAuction gets an auction from the store.
@@ -154,6 +156,7 @@ func (k Keeper) DeleteAuction(ctx sdk.Context, auctionID uint64) {
 	auction, found := k.GetAuction(ctx, auctionID)
 	if found {
 		k.removeFromByTimeIndex(ctx, auction.GetEndTime(), auctionID)
+		k.removeFromBySealedBidPhaseIndex(ctx, auction)
 	}
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
@@ -172,6 +175,40 @@ func (k Keeper) removeFromByTimeIndex(ctx sdk.Context, endTime time.Time, auctio
 	store.Delete(types.GetAuctionByTimeKey(endTime, auctionID))
 }
 
+// insertIntoBySealedBidPhaseIndex adds a sealed-bid auction into the bySealedBidPhase index, open auctions are not indexed.
+func (k Keeper) insertIntoBySealedBidPhaseIndex(ctx sdk.Context, auction types.Auction) {
+	sealedAuction, ok := auction.(types.SealedBidAuction)
+	if !ok || !types.IsSealedBid(auction) {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionBySealedBidPhaseKeyPrefix)
+	store.Set(types.GetAuctionBySealedBidPhaseKey(sealedAuction.GetSealedBidPhase(), auction.GetID()), types.Uint64ToBytes(auction.GetID()))
+}
+
+// removeFromBySealedBidPhaseIndex removes a sealed-bid auction from the bySealedBidPhase index.
+func (k Keeper) removeFromBySealedBidPhaseIndex(ctx sdk.Context, auction types.Auction) {
+	sealedAuction, ok := auction.(types.SealedBidAuction)
+	if !ok || !types.IsSealedBid(auction) {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionBySealedBidPhaseKeyPrefix)
+	store.Delete(types.GetAuctionBySealedBidPhaseKey(sealedAuction.GetSealedBidPhase(), auction.GetID()))
+}
+
+// IterateAuctionsBySealedBidPhase provides an iterator over the sealed-bid auctions in a phase, ordered by auction ID.
+// For each auction cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAuctionsBySealedBidPhase(ctx sdk.Context, phase types.SealedBidPhase, cb func(auctionID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionBySealedBidPhaseKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAuctionBySealedBidPhasePrefix(phase))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.Uint64FromBytes(iterator.Value())) {
+			break
+		}
+	}
+}
+
 // IterateAuctionsByTime provides an iterator over auctions ordered by auction.EndTime.
 // For each auction cb will be callled. If cb returns true the iterator will close and stop.
 func (k Keeper) IterateAuctionsByTime(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(auctionID uint64) (stop bool)) {
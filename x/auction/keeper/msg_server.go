@@ -41,3 +41,108 @@ func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*typ
 	)
 	return &types.MsgPlaceBidResponse{}, nil
 }
+
+func (k msgServer) BuyCollateral(goCtx context.Context, msg *types.MsgBuyCollateral) (*types.MsgBuyCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.BuyCollateral(ctx, msg.AuctionId, buyer, msg.Amount, msg.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer),
+		),
+	)
+	return &types.MsgBuyCollateralResponse{}, nil
+}
+
+func (k msgServer) CommitBid(goCtx context.Context, msg *types.MsgCommitBid) (*types.MsgCommitBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.CommitBid(ctx, msg.AuctionId, bidder, msg.BidHash, msg.Deposit)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder),
+		),
+	)
+	return &types.MsgCommitBidResponse{}, nil
+}
+
+func (k msgServer) RevealBid(goCtx context.Context, msg *types.MsgRevealBid) (*types.MsgRevealBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RevealBid(ctx, msg.AuctionId, bidder, msg.Amount, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder),
+		),
+	)
+	return &types.MsgRevealBidResponse{}, nil
+}
+
+func (k msgServer) PlaceBids(goCtx context.Context, msg *types.MsgPlaceBids) (*types.MsgPlaceBidsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.PlaceBids(ctx, bidder, msg.Bids, msg.MaxTotalSpend)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder),
+		),
+	)
+	return &types.MsgPlaceBidsResponse{}, nil
+}
+
+func (k msgServer) MergeAuctions(goCtx context.Context, msg *types.MsgMergeAuctions) (*types.MsgMergeAuctionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auctionID, err := k.keeper.MergeCollateralAuctions(ctx, msg.AuctionIds)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgMergeAuctionsResponse{AuctionId: auctionID}, nil
+}
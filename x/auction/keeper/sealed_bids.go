@@ -0,0 +1,398 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// CommitBid commits a hashed bid to a sealed-bid auction in its commit phase, escrowing the deposit in the auction
+// module account. A bidder committing again replaces their previous bid, and their previous deposit is refunded.
+func (k Keeper) CommitBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, bidHash []byte, deposit sdk.Coin) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	sealedAuction, ok := auction.(types.SealedBidAuction)
+	if !ok || !types.IsSealedBid(auction) {
+		return errorsmod.Wrapf(types.ErrNotSealedBidAuction, "%d", auctionID)
+	}
+	if sealedAuction.GetSealedBidPhase() != types.SEALED_BID_PHASE_COMMIT {
+		return errorsmod.Wrapf(types.ErrInvalidSealedBidPhase, "cannot commit bids to auction %d in %s phase", auctionID, auction.GetPhase())
+	}
+	if ctx.BlockTime().After(auction.GetEndTime()) {
+		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+	}
+
+	// Validate deposit
+	if deposit.Denom != auction.GetBid().Denom {
+		return errorsmod.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", deposit.Denom, auction.GetBid().Denom)
+	}
+	if _, isDebt := auction.(*types.DebtAuction); isDebt && deposit.IsLT(auction.GetBid()) {
+		// debt auction bidders all pay the same bid, so the deposit must cover it
+		return errorsmod.Wrapf(types.ErrInsufficientDeposit, "%s < %s", deposit, auction.GetBid())
+	}
+
+	// Refund the deposit of a previous commitment before escrowing the new one
+	deposits := sealedAuction.GetSealedDeposits()
+	if existingBid, found := k.GetSealedBid(ctx, auctionID, bidder); found {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(existingBid.Deposit))
+		if err != nil {
+			return err
+		}
+		deposits = deposits.Sub(existingBid.Deposit)
+	}
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(deposit))
+	if err != nil {
+		return err
+	}
+
+	k.SetSealedBid(ctx, types.NewSealedBid(auctionID, bidder, bidHash, deposit))
+	setSealedBidState(auction, types.SEALED_BID_PHASE_COMMIT, deposits.Add(deposit))
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionCommitBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBidHash, hex.EncodeToString(bidHash)),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+		),
+	)
+	return nil
+}
+
+// RevealBid reveals a bid committed to a sealed-bid auction in its reveal phase. The amount is the bid for surplus
+// auctions and the lot for debt auctions. The best revealed bid is recorded on the auction, earlier reveals win ties.
+func (k Keeper) RevealBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, amount sdk.Coin, salt string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	sealedAuction, ok := auction.(types.SealedBidAuction)
+	if !ok || !types.IsSealedBid(auction) {
+		return errorsmod.Wrapf(types.ErrNotSealedBidAuction, "%d", auctionID)
+	}
+	if sealedAuction.GetSealedBidPhase() != types.SEALED_BID_PHASE_REVEAL {
+		return errorsmod.Wrapf(types.ErrInvalidSealedBidPhase, "cannot reveal bids on auction %d in %s phase", auctionID, auction.GetPhase())
+	}
+	if ctx.BlockTime().After(auction.GetEndTime()) {
+		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+	}
+
+	sealedBid, found := k.GetSealedBid(ctx, auctionID, bidder)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSealedBidNotFound, "auction %d, bidder %s", auctionID, bidder)
+	}
+	if sealedBid.Revealed {
+		return errorsmod.Wrapf(types.ErrSealedBidAlreadyRevealed, "auction %d, bidder %s", auctionID, bidder)
+	}
+	if !bytes.Equal(types.SealedBidHash(auctionID, bidder, amount, salt), sealedBid.BidHash) {
+		return errorsmod.Wrapf(types.ErrInvalidBidHash, "auction %d, bidder %s", auctionID, bidder)
+	}
+
+	var (
+		attribute sdk.Attribute
+		bid, lot  sdk.Coin
+	)
+	switch auc := auction.(type) {
+	case *types.SurplusAuction:
+		if amount.Denom != auc.Bid.Denom {
+			return errorsmod.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", amount.Denom, auc.Bid.Denom)
+		}
+		if !amount.IsPositive() {
+			return errorsmod.Wrapf(types.ErrBidTooSmall, "%s ≤ 0%s", amount, auc.Bid.Denom)
+		}
+		if sealedBid.Deposit.IsLT(amount) {
+			return errorsmod.Wrapf(types.ErrInsufficientDeposit, "%s < %s", sealedBid.Deposit, amount)
+		}
+		// the highest bid wins
+		if amount.Amount.GT(auc.Bid.Amount) {
+			auc.Bidder = bidder
+			auc.Bid = amount
+			auc.HasReceivedBids = true
+		}
+		attribute = sdk.NewAttribute(types.AttributeKeyBid, amount.String())
+		bid, lot = amount, auc.Lot
+	case *types.DebtAuction:
+		if amount.Denom != auc.Lot.Denom {
+			return errorsmod.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", amount.Denom, auc.Lot.Denom)
+		}
+		// the lowest lot wins, the first winning lot can be at most the initial lot
+		if amount.Amount.LT(auc.Lot.Amount) || (!auc.HasReceivedBids && amount.Amount.Equal(auc.Lot.Amount)) {
+			auc.Bidder = bidder
+			auc.Lot = amount
+			auc.HasReceivedBids = true
+		}
+		attribute = sdk.NewAttribute(types.AttributeKeyLot, amount.String())
+		bid, lot = auc.Bid, amount
+	default:
+		return errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
+	}
+
+	sealedBid.Revealed = true
+	sealedBid.Amount = amount
+	k.SetSealedBid(ctx, sealedBid)
+	k.SetAuction(ctx, auction)
+	k.recordBid(ctx, auctionID, bidder, bid, lot)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionRevealBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			attribute,
+		),
+	)
+	return nil
+}
+
+// StartSealedBidRevealPhase moves a sealed-bid auction past the end of its commit phase into its reveal phase,
+// which lasts until the auction's max end time.
+func (k Keeper) StartSealedBidRevealPhase(ctx sdk.Context, auctionID uint64) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	sealedAuction, ok := auction.(types.SealedBidAuction)
+	if !ok || sealedAuction.GetSealedBidPhase() != types.SEALED_BID_PHASE_COMMIT {
+		return errorsmod.Wrapf(types.ErrInvalidSealedBidPhase, "auction %d is not in commit phase", auctionID)
+	}
+	if ctx.BlockTime().Before(auction.GetEndTime()) {
+		return errorsmod.Wrapf(types.ErrAuctionHasNotExpired, "block time %s, commit end time %s", ctx.BlockTime().UTC(), auction.GetEndTime().UTC())
+	}
+
+	setSealedBidState(auction, types.SEALED_BID_PHASE_REVEAL, sealedAuction.GetSealedDeposits())
+	setEndTimes(auction, auction.GetMaxEndTime(), auction.GetMaxEndTime())
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionRevealStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.GetEndTime().Unix())),
+		),
+	)
+	return nil
+}
+
+// SettleSealedBidAuction settles a sealed-bid auction past the end of its reveal phase. Unrevealed deposits are
+// forfeited to the auction initiator, losing deposits are refunded, and the winner pays their bid out of their deposit
+// before the auction is closed. If no bids were revealed the auction is reopened as an open auction.
+func (k Keeper) SettleSealedBidAuction(ctx sdk.Context, auctionID uint64) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	sealedAuction, ok := auction.(types.SealedBidAuction)
+	if !ok || sealedAuction.GetSealedBidPhase() != types.SEALED_BID_PHASE_REVEAL {
+		return errorsmod.Wrapf(types.ErrInvalidSealedBidPhase, "auction %d is not in reveal phase", auctionID)
+	}
+	if ctx.BlockTime().Before(auction.GetEndTime()) {
+		return errorsmod.Wrapf(types.ErrAuctionHasNotExpired, "block time %s, auction end time %s", ctx.BlockTime().UTC(), auction.GetEndTime().UTC())
+	}
+
+	var winner sdk.AccAddress
+	if hasReceivedBids(auction) {
+		winner = auction.GetBidder()
+	}
+
+	for _, sealedBid := range k.GetSealedBids(ctx, auctionID) {
+		var err error
+		switch {
+		case !sealedBid.Revealed:
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.GetInitiator(), sdk.NewCoins(sealedBid.Deposit))
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAuctionForfeitDeposit,
+					sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+					sdk.NewAttribute(types.AttributeKeyBidder, sealedBid.Bidder.String()),
+					sdk.NewAttribute(types.AttributeKeyDeposit, sealedBid.Deposit.String()),
+				),
+			)
+		case sealedBid.Bidder.Equals(winner):
+			err = k.payoutSealedBidWinner(ctx, auction, sealedBid.Deposit)
+		default:
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sealedBid.Bidder, sdk.NewCoins(sealedBid.Deposit))
+		}
+		if err != nil {
+			return err
+		}
+		k.DeleteSealedBid(ctx, auctionID, sealedBid.Bidder)
+	}
+
+	setSealedBidState(auction, types.SEALED_BID_PHASE_UNSPECIFIED, nil)
+
+	if winner.Empty() {
+		// with no bids revealed, the auction is reopened to open bids as if it had just started
+		setEndTimes(auction, types.DistantFuture, types.DistantFuture)
+		k.SetAuction(ctx, auction)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuctionReopen,
+				sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			),
+		)
+		return nil
+	}
+
+	k.SetAuction(ctx, auction)
+	return k.CloseAuction(ctx, auctionID)
+}
+
+// payoutSealedBidWinner pays the winning bid of a sealed-bid auction out of the winner's deposit, in the same way
+// bids are paid on open auctions, and refunds the rest of the deposit.
+func (k Keeper) payoutSealedBidWinner(ctx sdk.Context, auction types.Auction, deposit sdk.Coin) error {
+	switch auc := auction.(type) {
+	case *types.SurplusAuction:
+		// Received bid amount is burned from the initiator module account
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auc.Initiator, sdk.NewCoins(auc.Bid))
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.BurnCoins(ctx, auc.Initiator, sdk.NewCoins(auc.Bid))
+		if err != nil {
+			return err
+		}
+	case *types.DebtAuction:
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auc.Initiator, sdk.NewCoins(auc.Bid))
+		if err != nil {
+			return err
+		}
+		// Debt coins are sent to the initiator. Amount sent is equal to min of Bid and amount of debt.
+		debtToReturn := sdk.NewCoin(auc.CorrespondingDebt.Denom, sdk.MinInt(auc.Bid.Amount, auc.CorrespondingDebt.Amount))
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auc.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return err
+		}
+		auc.CorrespondingDebt = auc.CorrespondingDebt.Sub(debtToReturn)
+	default:
+		return errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
+	}
+
+	refund := deposit.Sub(auction.GetBid())
+	if !refund.IsPositive() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.GetBidder(), sdk.NewCoins(refund))
+}
+
+// setSealedBidState updates the sealed bid phase and deposits of a sealed-bid auction.
+func setSealedBidState(auction types.Auction, phase types.SealedBidPhase, deposits sdk.Coins) {
+	switch auc := auction.(type) {
+	case *types.SurplusAuction:
+		auc.SealedBidPhase = phase
+		auc.SealedDeposits = deposits
+	case *types.DebtAuction:
+		auc.SealedBidPhase = phase
+		auc.SealedDeposits = deposits
+	}
+}
+
+// setEndTimes updates the end time and max end time of a sealed-bid auction.
+func setEndTimes(auction types.Auction, endTime, maxEndTime time.Time) {
+	switch auc := auction.(type) {
+	case *types.SurplusAuction:
+		auc.EndTime = endTime
+		auc.MaxEndTime = maxEndTime
+	case *types.DebtAuction:
+		auc.EndTime = endTime
+		auc.MaxEndTime = maxEndTime
+	}
+}
+
+// hasReceivedBids returns whether a surplus or debt auction has received a bid.
+func hasReceivedBids(auction types.Auction) bool {
+	switch auc := auction.(type) {
+	case *types.SurplusAuction:
+		return auc.HasReceivedBids
+	case *types.DebtAuction:
+		return auc.HasReceivedBids
+	default:
+		return false
+	}
+}
+
+// SetSealedBid puts a sealed bid into the store.
+func (k Keeper) SetSealedBid(ctx sdk.Context, sealedBid types.SealedBid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SealedBidKeyPrefix)
+	store.Set(types.GetSealedBidKey(sealedBid.AuctionID, sealedBid.Bidder), k.cdc.MustMarshal(&sealedBid))
+}
+
+// GetSealedBid gets a bidder's sealed bid on an auction from the store.
+func (k Keeper) GetSealedBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) (types.SealedBid, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SealedBidKeyPrefix)
+	bz := store.Get(types.GetSealedBidKey(auctionID, bidder))
+	if bz == nil {
+		return types.SealedBid{}, false
+	}
+	var sealedBid types.SealedBid
+	k.cdc.MustUnmarshal(bz, &sealedBid)
+	return sealedBid, true
+}
+
+// DeleteSealedBid removes a bidder's sealed bid on an auction from the store.
+func (k Keeper) DeleteSealedBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SealedBidKeyPrefix)
+	store.Delete(types.GetSealedBidKey(auctionID, bidder))
+}
+
+// IterateSealedBids provides an iterator over the sealed bids on an auction.
+// For each sealed bid, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateSealedBids(ctx sdk.Context, auctionID uint64, cb func(sealedBid types.SealedBid) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SealedBidKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.Uint64ToBytes(auctionID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sealedBid types.SealedBid
+		k.cdc.MustUnmarshal(iterator.Value(), &sealedBid)
+		if cb(sealedBid) {
+			break
+		}
+	}
+}
+
+// IterateAllSealedBids provides an iterator over the sealed bids on all auctions.
+// For each sealed bid, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAllSealedBids(ctx sdk.Context, cb func(sealedBid types.SealedBid) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SealedBidKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sealedBid types.SealedBid
+		k.cdc.MustUnmarshal(iterator.Value(), &sealedBid)
+		if cb(sealedBid) {
+			break
+		}
+	}
+}
+
+// GetSealedBids returns the sealed bids on an auction
+func (k Keeper) GetSealedBids(ctx sdk.Context, auctionID uint64) types.SealedBids {
+	sealedBids := types.SealedBids{}
+	k.IterateSealedBids(ctx, auctionID, func(sealedBid types.SealedBid) bool {
+		sealedBids = append(sealedBids, sealedBid)
+		return false
+	})
+	return sealedBids
+}
+
+// GetAllSealedBids returns the sealed bids on all auctions
+func (k Keeper) GetAllSealedBids(ctx sdk.Context) types.SealedBids {
+	sealedBids := types.SealedBids{}
+	k.IterateAllSealedBids(ctx, func(sealedBid types.SealedBid) bool {
+		sealedBids = append(sealedBids, sealedBid)
+		return false
+	})
+	return sealedBids
+}
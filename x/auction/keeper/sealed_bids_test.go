@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kava-labs/kava/x/auction/testutil"
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+type sealedBidTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *sealedBidTestSuite) SetupTest() {
+	suite.Suite.SetupTest(4)
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.SealedBidEnabled = true
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+func TestSealedBidTestSuite(t *testing.T) {
+	suite.Run(t, new(sealedBidTestSuite))
+}
+
+func (suite *sealedBidTestSuite) commitBid(auctionID uint64, bidder sdk.AccAddress, amount sdk.Coin, salt string, deposit sdk.Coin) error {
+	return suite.Keeper.CommitBid(suite.Ctx, auctionID, bidder, types.SealedBidHash(auctionID, bidder, amount, salt), deposit)
+}
+
+func (suite *sealedBidTestSuite) auctionIDsInPhase(phase types.SealedBidPhase) []uint64 {
+	var ids []uint64
+	suite.Keeper.IterateAuctionsBySealedBidPhase(suite.Ctx, phase, func(id uint64) bool {
+		ids = append(ids, id)
+		return false
+	})
+	return ids
+}
+
+func (suite *sealedBidTestSuite) TestSurplusAuctionSealedBids() {
+	winner, loser, absentee := suite.Addrs[0], suite.Addrs[1], suite.Addrs[2]
+	sellerAddr := authtypes.NewModuleAddress(suite.ModAcc.Name)
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100)))
+	startTime := suite.Ctx.BlockTime()
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.NoError(err)
+	suite.Equal([]uint64{auctionID}, suite.auctionIDsInPhase(types.SEALED_BID_PHASE_COMMIT))
+
+	// Open bids are rejected
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, winner, c("token2", 10)), types.ErrSealedBidAuction)
+
+	// Commit bids, deposits are escrowed
+	suite.ErrorIs(suite.commitBid(auctionID, winner, c("token2", 80), "salt", c("token1", 90)), types.ErrInvalidBidDenom)
+	suite.NoError(suite.commitBid(auctionID, winner, c("token2", 50), "salt", c("token2", 60)))
+	suite.NoError(suite.commitBid(auctionID, winner, c("token2", 80), "salt", c("token2", 90))) // replaces the previous commitment
+	suite.NoError(suite.commitBid(auctionID, loser, c("token2", 60), "pepper", c("token2", 60)))
+	suite.NoError(suite.commitBid(auctionID, absentee, c("token2", 30), "secret", c("token2", 30)))
+	suite.CheckAccountBalanceEqual(winner, cs(c("token1", 100), c("token2", 10)))
+	suite.CheckAccountBalanceEqual(loser, cs(c("token1", 100), c("token2", 40)))
+	suite.Len(suite.Keeper.GetSealedBids(suite.Ctx, auctionID), 3)
+
+	// Bids can't be revealed during the commit phase
+	suite.ErrorIs(suite.Keeper.RevealBid(suite.Ctx, auctionID, winner, c("token2", 80), "salt"), types.ErrInvalidSealedBidPhase)
+
+	// The reveal phase starts at the end of the commit phase
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.DefaultSealedBidCommitDuration))
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(suite.Ctx))
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	suite.Equal(types.RevealAuctionPhase, auction.GetPhase())
+	suite.Empty(suite.auctionIDsInPhase(types.SEALED_BID_PHASE_COMMIT))
+	suite.Equal([]uint64{auctionID}, suite.auctionIDsInPhase(types.SEALED_BID_PHASE_REVEAL))
+	suite.ErrorIs(suite.commitBid(auctionID, winner, c("token2", 90), "salt", c("token2", 90)), types.ErrInvalidSealedBidPhase)
+
+	// Reveal bids
+	suite.ErrorIs(suite.Keeper.RevealBid(suite.Ctx, auctionID, winner, c("token2", 80), "wrong salt"), types.ErrInvalidBidHash)
+	suite.NoError(suite.Keeper.RevealBid(suite.Ctx, auctionID, loser, c("token2", 60), "pepper"))
+	suite.NoError(suite.Keeper.RevealBid(suite.Ctx, auctionID, winner, c("token2", 80), "salt"))
+	suite.ErrorIs(suite.Keeper.RevealBid(suite.Ctx, auctionID, winner, c("token2", 80), "salt"), types.ErrSealedBidAlreadyRevealed)
+	auction, _ = suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Equal(winner, auction.GetBidder())
+	suite.Equal(c("token2", 80), auction.GetBid())
+
+	// The auction settles at the end of the reveal phase
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.DefaultSealedBidCommitDuration + types.DefaultSealedBidRevealDuration))
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(suite.Ctx))
+	_, found = suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.False(found)
+	suite.Empty(suite.Keeper.GetSealedBids(suite.Ctx, auctionID))
+	suite.Empty(suite.auctionIDsInPhase(types.SEALED_BID_PHASE_REVEAL))
+
+	// Winner receives the lot and pays their bid, the rest of their deposit is refunded
+	suite.CheckAccountBalanceEqual(winner, cs(c("token1", 120), c("token2", 20)))
+	// Losing deposits are refunded
+	suite.CheckAccountBalanceEqual(loser, cs(c("token1", 100), c("token2", 100)))
+	// Unrevealed deposits are forfeited to the seller, while the winning bid is burned
+	suite.CheckAccountBalanceEqual(absentee, cs(c("token1", 100), c("token2", 70)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 130)))
+	suite.True(suite.BankKeeper.GetAllBalances(suite.Ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+}
+
+func (suite *sealedBidTestSuite) TestDebtAuctionSealedBids() {
+	winner, loser := suite.Addrs[0], suite.Addrs[1]
+	buyerAddr := authtypes.NewModuleAddress(suite.ModAcc.Name)
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("debt", 100)))
+	startTime := suite.Ctx.BlockTime()
+
+	auctionID, err := suite.Keeper.StartDebtAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 99999), c("debt", 20))
+	suite.NoError(err)
+
+	// Deposits must cover the bid
+	suite.ErrorIs(suite.commitBid(auctionID, winner, c("token2", 40), "salt", c("token1", 10)), types.ErrInsufficientDeposit)
+	suite.NoError(suite.commitBid(auctionID, winner, c("token2", 40), "salt", c("token1", 25)))
+	suite.NoError(suite.commitBid(auctionID, loser, c("token2", 50), "pepper", c("token1", 20)))
+	suite.CheckAccountBalanceEqual(buyerAddr, cs(c("debt", 80)))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.DefaultSealedBidCommitDuration))
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(suite.Ctx))
+
+	suite.NoError(suite.Keeper.RevealBid(suite.Ctx, auctionID, loser, c("token2", 50), "pepper"))
+	suite.NoError(suite.Keeper.RevealBid(suite.Ctx, auctionID, winner, c("token2", 40), "salt"))
+	auction, _ := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Equal(winner, auction.GetBidder())
+	suite.Equal(c("token2", 40), auction.GetLot())
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.DefaultSealedBidCommitDuration + types.DefaultSealedBidRevealDuration))
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(suite.Ctx))
+	_, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.False(found)
+
+	// Winner receives the minted lot and pays the bid, the rest of their deposit is refunded
+	suite.CheckAccountBalanceEqual(winner, cs(c("token1", 80), c("token2", 140)))
+	suite.CheckAccountBalanceEqual(loser, cs(c("token1", 100), c("token2", 100)))
+	// Buyer receives the bid and the corresponding debt
+	suite.CheckAccountBalanceEqual(buyerAddr, cs(c("token1", 20), c("debt", 100)))
+	suite.True(suite.BankKeeper.GetAllBalances(suite.Ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+}
+
+func (suite *sealedBidTestSuite) TestSealedBidAuctionReopensWithoutReveals() {
+	bidder, absentee := suite.Addrs[0], suite.Addrs[1]
+	sellerAddr := authtypes.NewModuleAddress(suite.ModAcc.Name)
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100)))
+	startTime := suite.Ctx.BlockTime()
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.NoError(err)
+	suite.NoError(suite.commitBid(auctionID, absentee, c("token2", 30), "secret", c("token2", 30)))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.DefaultSealedBidCommitDuration))
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(suite.Ctx))
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.DefaultSealedBidCommitDuration + types.DefaultSealedBidRevealDuration))
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(suite.Ctx))
+
+	// The unrevealed deposit is forfeited and the auction reopens to open bids
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 130)))
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	suite.Equal(types.ForwardAuctionPhase, auction.GetPhase())
+	suite.Equal(types.DistantFuture, auction.GetEndTime())
+	suite.Empty(suite.Keeper.GetSealedBids(suite.Ctx, auctionID))
+
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bidder, c("token2", 10)))
+}
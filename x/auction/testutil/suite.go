@@ -61,9 +61,21 @@ func (suite *Suite) SetupTest(numAddrs int) {
 		types.DefaultIncrement,
 		types.DefaultIncrement,
 		types.DefaultIncrement,
+		types.DefaultDutchAuctionDuration,
+		types.DefaultDutchPricePremium,
+		types.DefaultDutchDecayCurve,
+		types.DefaultDutchStepDuration,
+		types.DefaultDutchStepDecay,
+		types.DefaultSealedBidEnabled,
+		types.DefaultSealedBidCommitDuration,
+		types.DefaultSealedBidRevealDuration,
+		types.DefaultBidHistoryEnabled,
+		types.DefaultMaxBidHistoryLength,
+		types.DefaultHistoryRetentionDuration,
+		types.DefaultMaxMergedLots,
 	)
 
-	auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
+	auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{}, types.SealedBids{}, types.BidRecords{}, types.ClosedAuctions{}, types.BidderStatsList{})
 	suite.Require().NoError(err)
 
 	moduleGs := tApp.AppCodec().MustMarshalJSON(auctionGs)
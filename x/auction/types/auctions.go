@@ -16,8 +16,12 @@ const (
 	CollateralAuctionType = "collateral"
 	SurplusAuctionType    = "surplus"
 	DebtAuctionType       = "debt"
+	DutchAuctionType      = "dutch"
 	ForwardAuctionPhase   = "forward"
 	ReverseAuctionPhase   = "reverse"
+	DutchAuctionPhase     = "descending"
+	CommitAuctionPhase    = "commit"
+	RevealAuctionPhase    = "reveal"
 )
 
 // DistantFuture is a very large time value to use as initial the ending time for auctions.
@@ -32,6 +36,11 @@ var (
 	_ GenesisAuction = &DebtAuction{}
 	_ Auction        = &CollateralAuction{}
 	_ GenesisAuction = &CollateralAuction{}
+	_ Auction        = &DutchAuction{}
+	_ GenesisAuction = &DutchAuction{}
+
+	_ SealedBidAuction = &SurplusAuction{}
+	_ SealedBidAuction = &DebtAuction{}
 )
 
 // --------------- Shared auction functionality ---------------
@@ -54,6 +63,49 @@ type Auction interface {
 	GetPhase() string
 }
 
+// SealedBidAuction is an interface for auctions that can be run as sealed-bid auctions.
+type SealedBidAuction interface {
+	Auction
+
+	GetSealedBidPhase() SealedBidPhase
+	GetSealedDeposits() sdk.Coins
+}
+
+// IsSealedBid returns whether an auction is running as a sealed-bid auction.
+func IsSealedBid(a Auction) bool {
+	sa, ok := a.(SealedBidAuction)
+	return ok && sa.GetSealedBidPhase() != SEALED_BID_PHASE_UNSPECIFIED
+}
+
+// sealedBidAuctionPhase returns the auction phase of a sealed-bid auction, or the open auction phase if it is not sealed.
+func sealedBidAuctionPhase(phase SealedBidPhase, openPhase string) string {
+	switch phase {
+	case SEALED_BID_PHASE_COMMIT:
+		return CommitAuctionPhase
+	case SEALED_BID_PHASE_REVEAL:
+		return RevealAuctionPhase
+	default:
+		return openPhase
+	}
+}
+
+// validateSealedBidState checks the sealed bid phase and deposits of an auction are consistent.
+func validateSealedBidState(phase SealedBidPhase, deposits sdk.Coins) error {
+	switch phase {
+	case SEALED_BID_PHASE_UNSPECIFIED:
+		if !deposits.IsZero() {
+			return fmt.Errorf("open auction cannot hold sealed deposits: %s", deposits)
+		}
+	case SEALED_BID_PHASE_COMMIT, SEALED_BID_PHASE_REVEAL:
+		if !deposits.IsValid() {
+			return fmt.Errorf("invalid sealed deposits: %s", deposits)
+		}
+	default:
+		return fmt.Errorf("invalid sealed bid phase: %s", phase)
+	}
+	return nil
+}
+
 // --------------- BaseAuction ---------------
 
 func (a BaseAuction) GetID() uint64 { return a.ID }
@@ -110,13 +162,30 @@ func NewSurplusAuction(seller string, lot sdk.Coin, bidDenom string, endTime tim
 	return auction
 }
 
+// NewSealedBidSurplusAuction returns a new surplus auction that accepts sealed bids until the commit end time
+// and reveals of those bids until the reveal end time.
+func NewSealedBidSurplusAuction(seller string, lot sdk.Coin, bidDenom string, commitEndTime, revealEndTime time.Time) SurplusAuction {
+	auction := NewSurplusAuction(seller, lot, bidDenom, commitEndTime)
+	auction.MaxEndTime = revealEndTime
+	auction.SealedBidPhase = SEALED_BID_PHASE_COMMIT
+	return auction
+}
+
 func (a SurplusAuction) WithID(id uint64) Auction {
 	a.ID = id
 	return Auction(&a)
 }
 
-// GetPhase returns the direction of a surplus auction, which never changes.
-func (a SurplusAuction) GetPhase() string { return ForwardAuctionPhase }
+// GetPhase returns the direction of a surplus auction, or the current phase of a sealed-bid surplus auction.
+func (a SurplusAuction) GetPhase() string {
+	return sealedBidAuctionPhase(a.SealedBidPhase, ForwardAuctionPhase)
+}
+
+// GetSealedBidPhase returns the sealed bid phase of the auction, unspecified for open auctions.
+func (a SurplusAuction) GetSealedBidPhase() SealedBidPhase { return a.SealedBidPhase }
+
+// GetSealedDeposits returns the total deposit escrowed by sealed bids on the auction.
+func (a SurplusAuction) GetSealedDeposits() sdk.Coins { return a.SealedDeposits }
 
 // GetType returns the auction type. Used to identify auctions in event attributes.
 func (a SurplusAuction) GetType() string { return SurplusAuctionType }
@@ -125,10 +194,13 @@ func (a SurplusAuction) GetType() string { return SurplusAuctionType }
 // It is used in genesis initialize the module account correctly.
 func (a SurplusAuction) GetModuleAccountCoins() sdk.Coins {
 	// a.Bid is paid out on bids, so is never stored in the module account
-	return sdk.NewCoins(a.Lot)
+	return sdk.NewCoins(a.Lot).Add(a.SealedDeposits...)
 }
 
 func (a SurplusAuction) Validate() error {
+	if err := validateSealedBidState(a.SealedBidPhase, a.SealedDeposits); err != nil {
+		return err
+	}
 	return ValidateAuction(&a)
 }
 
@@ -155,13 +227,32 @@ func NewDebtAuction(buyerModAccName string, bid sdk.Coin, initialLot sdk.Coin, e
 	return auction
 }
 
+// NewSealedBidDebtAuction returns a new debt auction that accepts sealed bids until the commit end time
+// and reveals of those bids until the reveal end time.
+func NewSealedBidDebtAuction(
+	buyerModAccName string, bid sdk.Coin, initialLot sdk.Coin, commitEndTime, revealEndTime time.Time, debt sdk.Coin,
+) DebtAuction {
+	auction := NewDebtAuction(buyerModAccName, bid, initialLot, commitEndTime, debt)
+	auction.MaxEndTime = revealEndTime
+	auction.SealedBidPhase = SEALED_BID_PHASE_COMMIT
+	return auction
+}
+
 func (a DebtAuction) WithID(id uint64) Auction {
 	a.ID = id
 	return Auction(&a)
 }
 
-// GetPhase returns the direction of a debt auction, which never changes.
-func (a DebtAuction) GetPhase() string { return ReverseAuctionPhase }
+// GetPhase returns the direction of a debt auction, or the current phase of a sealed-bid debt auction.
+func (a DebtAuction) GetPhase() string {
+	return sealedBidAuctionPhase(a.SealedBidPhase, ReverseAuctionPhase)
+}
+
+// GetSealedBidPhase returns the sealed bid phase of the auction, unspecified for open auctions.
+func (a DebtAuction) GetSealedBidPhase() SealedBidPhase { return a.SealedBidPhase }
+
+// GetSealedDeposits returns the total deposit escrowed by sealed bids on the auction.
+func (a DebtAuction) GetSealedDeposits() sdk.Coins { return a.SealedDeposits }
 
 // GetType returns the auction type. Used to identify auctions in event attributes.
 func (a DebtAuction) GetType() string { return DebtAuctionType }
@@ -171,7 +262,7 @@ func (a DebtAuction) GetType() string { return DebtAuctionType }
 func (a DebtAuction) GetModuleAccountCoins() sdk.Coins {
 	// a.Lot is minted at auction close, so is never stored in the module account
 	// a.Bid is paid out on bids, so is never stored in the module account
-	return sdk.NewCoins(a.CorrespondingDebt)
+	return sdk.NewCoins(a.CorrespondingDebt).Add(a.SealedDeposits...)
 }
 
 // Validate validates the DebtAuction fields values.
@@ -179,6 +270,9 @@ func (a DebtAuction) Validate() error {
 	if !a.CorrespondingDebt.IsValid() {
 		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
 	}
+	if err := validateSealedBidState(a.SealedBidPhase, a.SealedDeposits); err != nil {
+		return err
+	}
 	return ValidateAuction(&a)
 }
 
@@ -200,6 +294,7 @@ func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid
 		CorrespondingDebt: debt,
 		MaxBid:            maxBid,
 		LotReturns:        lotReturns,
+		MarketPrice:       sdk.ZeroDec(),
 	}
 	return auction
 }
@@ -247,6 +342,121 @@ func (a CollateralAuction) Validate() error {
 	if err := a.LotReturns.Validate(); err != nil {
 		return fmt.Errorf("invalid lot returns: %w", err)
 	}
+	if !a.MarketPrice.IsNil() && a.MarketPrice.IsNegative() {
+		return fmt.Errorf("market price cannot be negative: %s", a.MarketPrice)
+	}
+	return ValidateAuction(&a)
+}
+
+// --------------- DutchAuction ---------------
+
+// NewDutchAuction returns a new dutch auction.
+func NewDutchAuction(
+	seller string, lot sdk.Coin, startTime, endTime time.Time, maxBid sdk.Coin,
+	lotReturns WeightedAddresses, debt sdk.Coin, startPrice, marketPrice sdk.Dec,
+) DutchAuction {
+	auction := DutchAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0), // total amount raised from purchases
+			HasReceivedBids: false,                             // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime,
+		},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		StartPrice:        startPrice,
+		StartTime:         startTime,
+		MarketPrice:       marketPrice,
+		LotSold:           sdk.NewInt64Coin(lot.Denom, 0),
+	}
+	return auction
+}
+
+func (a DutchAuction) WithID(id uint64) Auction {
+	a.ID = id
+	return Auction(&a)
+}
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchAuction) GetType() string { return DutchAuctionType }
+
+// GetPhase returns the direction of a dutch auction, which never changes.
+func (a DutchAuction) GetPhase() string { return DutchAuctionPhase }
+
+// GetLotReturns returns the auction's lot returns as weighted addresses
+func (a DutchAuction) GetLotReturns() WeightedAddresses { return a.LotReturns }
+
+// IsComplete returns whether the auction has raised its max bid or sold all of its lot.
+func (a DutchAuction) IsComplete() bool {
+	return !a.Bid.IsLT(a.MaxBid) || !a.Lot.IsPositive()
+}
+
+// CurrentPrice returns the price of one unit of lot at the given time, decayed from the start price along the
+// decay curve set in params. The price never increases and reaches zero once the auction has ended.
+func (a DutchAuction) CurrentPrice(params Params, blockTime time.Time) sdk.Dec {
+	if !blockTime.After(a.StartTime) {
+		return a.StartPrice
+	}
+	if !blockTime.Before(a.EndTime) {
+		return sdk.ZeroDec()
+	}
+
+	elapsed := blockTime.Sub(a.StartTime)
+	switch params.DutchDecayCurve {
+	case DECAY_CURVE_LINEAR:
+		duration := a.EndTime.Sub(a.StartTime)
+		remaining := sdk.NewDec(int64(duration - elapsed)).QuoInt64(int64(duration))
+		return a.StartPrice.Mul(remaining)
+	case DECAY_CURVE_EXPONENTIAL:
+		steps := uint64(elapsed / params.DutchStepDuration)
+		return a.StartPrice.Mul(params.DutchStepDecay.Power(steps))
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on purchases, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+}
+
+// Validate validates the DutchAuction fields values.
+func (a DutchAuction) Validate() error {
+	if !a.CorrespondingDebt.IsValid() {
+		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
+	}
+	if !a.MaxBid.IsValid() {
+		return fmt.Errorf("invalid max bid: %s", a.MaxBid)
+	}
+	if a.Bid.Denom != a.MaxBid.Denom {
+		return fmt.Errorf("bid denom does not match max bid denom: %s ≠ %s", a.Bid.Denom, a.MaxBid.Denom)
+	}
+	if a.StartPrice.IsNil() || !a.StartPrice.IsPositive() {
+		return fmt.Errorf("start price must be positive: %s", a.StartPrice)
+	}
+	if a.StartTime.Unix() <= 0 {
+		return errors.New("start time cannot be zero")
+	}
+	// auctions started before the market price and lot sold were tracked leave them empty
+	if !a.MarketPrice.IsNil() && a.MarketPrice.IsNegative() {
+		return fmt.Errorf("market price cannot be negative: %s", a.MarketPrice)
+	}
+	if a.LotSold.Denom != "" && (!a.LotSold.IsValid() || a.LotSold.Denom != a.Lot.Denom) {
+		return fmt.Errorf("invalid lot sold: %s", a.LotSold)
+	}
+	if !a.StartTime.Before(a.EndTime) {
+		return fmt.Errorf("EndTime ≤ StartTime (%s ≤ %s)", a.EndTime, a.StartTime)
+	}
+	if err := a.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returns: %w", err)
+	}
 	return ValidateAuction(&a)
 }
 
@@ -144,6 +144,58 @@ func TestDebtAuctionValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid sealed-bid auction",
+			DebtAuction{
+				BaseAuction: BaseAuction{
+					ID:         1,
+					Initiator:  testAccAddress1,
+					Lot:        c("kava", 1),
+					Bidder:     addr1,
+					Bid:        c("usdx", 1),
+					EndTime:    now,
+					MaxEndTime: now.Add(time.Hour),
+				},
+				CorrespondingDebt: c("debt", 1),
+				SealedBidPhase:    SEALED_BID_PHASE_REVEAL,
+				SealedDeposits:    sdk.NewCoins(c("usdx", 3)),
+			},
+			true,
+		},
+		{
+			"invalid sealed deposits on open auction",
+			DebtAuction{
+				BaseAuction: BaseAuction{
+					ID:         1,
+					Initiator:  testAccAddress1,
+					Lot:        c("kava", 1),
+					Bidder:     addr1,
+					Bid:        c("usdx", 1),
+					EndTime:    now,
+					MaxEndTime: now,
+				},
+				CorrespondingDebt: c("debt", 1),
+				SealedDeposits:    sdk.NewCoins(c("usdx", 3)),
+			},
+			false,
+		},
+		{
+			"invalid sealed bid phase",
+			DebtAuction{
+				BaseAuction: BaseAuction{
+					ID:         1,
+					Initiator:  testAccAddress1,
+					Lot:        c("kava", 1),
+					Bidder:     addr1,
+					Bid:        c("usdx", 1),
+					EndTime:    now,
+					MaxEndTime: now,
+				},
+				CorrespondingDebt: c("debt", 1),
+				SealedBidPhase:    SealedBidPhase(3),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -191,6 +243,29 @@ func TestCollateralAuctionValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"negative market price",
+			CollateralAuction{
+				BaseAuction: BaseAuction{
+					ID:              1,
+					Initiator:       testAccAddress1,
+					Lot:             c("kava", 1),
+					Bidder:          addr1,
+					Bid:             c("kava", 1),
+					EndTime:         now,
+					MaxEndTime:      now,
+					HasReceivedBids: true,
+				},
+				CorrespondingDebt: c("kava", 1),
+				MaxBid:            c("kava", 1),
+				LotReturns: WeightedAddresses{
+					Addresses: []sdk.AccAddress{addr1},
+					Weights:   []sdkmath.Int{sdkmath.NewInt(1)},
+				},
+				MarketPrice: sdk.MustNewDecFromStr("-1"),
+			},
+			false,
+		},
 		{
 			"invalid corresponding debt",
 			CollateralAuction{
@@ -262,6 +337,174 @@ func TestCollateralAuctionValidate(t *testing.T) {
 	}
 }
 
+func TestDutchAuctionValidate(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	validAuction := DutchAuction{
+		BaseAuction: BaseAuction{
+			ID:              1,
+			Initiator:       testAccAddress1,
+			Lot:             c("kava", 1),
+			Bidder:          addr1,
+			Bid:             c("usdx", 1),
+			EndTime:         now,
+			MaxEndTime:      now,
+			HasReceivedBids: true,
+		},
+		CorrespondingDebt: c("debt", 1),
+		MaxBid:            c("usdx", 2),
+		LotReturns: WeightedAddresses{
+			Addresses: []sdk.AccAddress{addr1},
+			Weights:   []sdkmath.Int{sdkmath.NewInt(1)},
+		},
+		StartPrice:  d("1.5"),
+		StartTime:   now.Add(-time.Hour),
+		MarketPrice: d("1.25"),
+		LotSold:     c("kava", 1),
+	}
+
+	tests := []struct {
+		msg     string
+		auction func() DutchAuction
+		expPass bool
+	}{
+		{
+			"valid auction",
+			func() DutchAuction { return validAuction },
+			true,
+		},
+		{
+			"invalid max bid",
+			func() DutchAuction {
+				a := validAuction
+				a.MaxBid = sdk.Coin{Denom: "DENOM", Amount: sdkmath.NewInt(1)}
+				return a
+			},
+			false,
+		},
+		{
+			"mismatched bid denom",
+			func() DutchAuction {
+				a := validAuction
+				a.Bid = c("kava", 1)
+				return a
+			},
+			false,
+		},
+		{
+			"zero start price",
+			func() DutchAuction {
+				a := validAuction
+				a.StartPrice = sdk.ZeroDec()
+				return a
+			},
+			false,
+		},
+		{
+			"untracked market price and lot sold",
+			func() DutchAuction {
+				a := validAuction
+				a.MarketPrice = sdk.Dec{}
+				a.LotSold = sdk.Coin{}
+				return a
+			},
+			true,
+		},
+		{
+			"negative market price",
+			func() DutchAuction {
+				a := validAuction
+				a.MarketPrice = d("-1")
+				return a
+			},
+			false,
+		},
+		{
+			"mismatched lot sold denom",
+			func() DutchAuction {
+				a := validAuction
+				a.LotSold = c("usdx", 1)
+				return a
+			},
+			false,
+		},
+		{
+			"start time after end time",
+			func() DutchAuction {
+				a := validAuction
+				a.StartTime = now.Add(time.Hour)
+				return a
+			},
+			false,
+		},
+		{
+			"invalid lot returns",
+			func() DutchAuction {
+				a := validAuction
+				a.LotReturns = WeightedAddresses{
+					Addresses: []sdk.AccAddress{nil},
+					Weights:   []sdkmath.Int{sdkmath.NewInt(1)},
+				}
+				return a
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+
+		err := tc.auction().Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestDutchAuctionCurrentPrice(t *testing.T) {
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	auction := DutchAuction{
+		BaseAuction: BaseAuction{EndTime: startTime.Add(100 * time.Minute)},
+		StartPrice:  d("10"),
+		StartTime:   startTime,
+	}
+
+	linear := DefaultParams()
+	linear.DutchDecayCurve = DECAY_CURVE_LINEAR
+
+	exponential := DefaultParams()
+	exponential.DutchDecayCurve = DECAY_CURVE_EXPONENTIAL
+	exponential.DutchStepDuration = 10 * time.Minute
+	exponential.DutchStepDecay = d("0.5")
+
+	tests := []struct {
+		name      string
+		params    Params
+		blockTime time.Time
+		expPrice  sdk.Dec
+	}{
+		{"linear at start", linear, startTime, d("10")},
+		{"linear before start", linear, startTime.Add(-time.Minute), d("10")},
+		{"linear part way", linear, startTime.Add(25 * time.Minute), d("7.5")},
+		{"linear at end", linear, startTime.Add(100 * time.Minute), d("0")},
+		{"exponential within first step", exponential, startTime.Add(9 * time.Minute), d("10")},
+		{"exponential after one step", exponential, startTime.Add(10 * time.Minute), d("5")},
+		{"exponential after three steps", exponential, startTime.Add(35 * time.Minute), d("1.25")},
+		{"exponential after end", exponential, startTime.Add(101 * time.Minute), d("0")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPrice, auction.CurrentPrice(tc.params, tc.blockTime))
+		})
+	}
+}
+
 func TestBaseAuctionGetters(t *testing.T) {
 	endTime := time.Now().Add(TestExtraEndTime)
 
@@ -320,6 +563,66 @@ func TestNewDebtAuction(t *testing.T) {
 	require.Equal(t, debtAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount1))
 }
 
+func TestNewSealedBidSurplusAuction(t *testing.T) {
+	commitEndTime := time.Now().Add(TestExtraEndTime)
+	revealEndTime := commitEndTime.Add(TestExtraEndTime)
+
+	// Create a new sealed-bid SurplusAuction
+	surplusAuction := NewSealedBidSurplusAuction(
+		TestInitiatorModuleName,
+		c(TestLotDenom, TestLotAmount),
+		TestBidDenom, commitEndTime, revealEndTime,
+	)
+
+	require.Equal(t, surplusAuction.Initiator, TestInitiatorModuleName)
+	require.Equal(t, surplusAuction.Lot, c(TestLotDenom, TestLotAmount))
+	require.Equal(t, surplusAuction.Bid, c(TestBidDenom, 0))
+	require.Equal(t, surplusAuction.EndTime, commitEndTime)
+	require.Equal(t, surplusAuction.MaxEndTime, revealEndTime)
+	require.Equal(t, SEALED_BID_PHASE_COMMIT, surplusAuction.SealedBidPhase)
+	require.Equal(t, CommitAuctionPhase, surplusAuction.GetPhase())
+	require.True(t, IsSealedBid(&surplusAuction))
+	require.NoError(t, surplusAuction.Validate())
+
+	surplusAuction.SealedBidPhase = SEALED_BID_PHASE_REVEAL
+	surplusAuction.SealedDeposits = sdk.NewCoins(c(TestBidDenom, 50))
+	require.Equal(t, RevealAuctionPhase, surplusAuction.GetPhase())
+	require.Equal(t, sdk.NewCoins(c(TestLotDenom, TestLotAmount), c(TestBidDenom, 50)), surplusAuction.GetModuleAccountCoins())
+
+	surplusAuction.SealedBidPhase = SEALED_BID_PHASE_UNSPECIFIED
+	surplusAuction.SealedDeposits = nil
+	require.Equal(t, ForwardAuctionPhase, surplusAuction.GetPhase())
+	require.False(t, IsSealedBid(&surplusAuction))
+}
+
+func TestNewSealedBidDebtAuction(t *testing.T) {
+	commitEndTime := time.Now().Add(TestExtraEndTime)
+	revealEndTime := commitEndTime.Add(TestExtraEndTime)
+
+	// Create a new sealed-bid DebtAuction
+	debtAuction := NewSealedBidDebtAuction(
+		TestInitiatorModuleName,
+		c(TestBidDenom, TestBidAmount),
+		c(TestLotDenom, TestLotAmount),
+		commitEndTime,
+		revealEndTime,
+		c(TestDebtDenom, TestDebtAmount1),
+	)
+
+	require.Equal(t, debtAuction.Initiator, TestInitiatorModuleName)
+	require.Equal(t, debtAuction.Lot, c(TestLotDenom, TestLotAmount))
+	require.Equal(t, debtAuction.Bid, c(TestBidDenom, TestBidAmount))
+	require.Equal(t, debtAuction.EndTime, commitEndTime)
+	require.Equal(t, debtAuction.MaxEndTime, revealEndTime)
+	require.Equal(t, debtAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount1))
+	require.Equal(t, SEALED_BID_PHASE_COMMIT, debtAuction.SealedBidPhase)
+	require.Equal(t, CommitAuctionPhase, debtAuction.GetPhase())
+	require.True(t, IsSealedBid(&debtAuction))
+
+	debtAuction.SealedDeposits = sdk.NewCoins(c(TestBidDenom, TestBidAmount))
+	require.Equal(t, sdk.NewCoins(c(TestDebtDenom, TestDebtAmount1), c(TestBidDenom, TestBidAmount)), debtAuction.GetModuleAccountCoins())
+}
+
 func TestNewCollateralAuction(t *testing.T) {
 	// Set up WeightedAddresses
 	addresses := []sdk.AccAddress{
@@ -353,4 +656,41 @@ func TestNewCollateralAuction(t *testing.T) {
 	require.Equal(t, collateralAuction.MaxBid, c(TestBidDenom, TestBidAmount))
 	require.Equal(t, collateralAuction.LotReturns, weightedAddresses)
 	require.Equal(t, collateralAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
+	require.Equal(t, collateralAuction.MarketPrice, sdk.ZeroDec())
+}
+
+func TestNewDutchAuction(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress([]byte(testAccAddress1)),
+	}
+	weightedAddresses, _ := NewWeightedAddresses(addresses, []sdkmath.Int{sdkmath.NewInt(1)})
+
+	startTime := time.Now()
+	endTime := startTime.Add(TestExtraEndTime)
+
+	dutchAuction := NewDutchAuction(
+		TestInitiatorModuleName,
+		c(TestLotDenom, TestLotAmount),
+		startTime,
+		endTime,
+		c(TestBidDenom, TestBidAmount),
+		weightedAddresses,
+		c(TestDebtDenom, TestDebtAmount2),
+		d("1.2"),
+		d("1"),
+	)
+
+	require.Equal(t, dutchAuction.Initiator, TestInitiatorModuleName)
+	require.Equal(t, dutchAuction.Lot, c(TestLotDenom, TestLotAmount))
+	require.Equal(t, dutchAuction.Bid, c(TestBidDenom, 0))
+	require.Equal(t, dutchAuction.StartTime, startTime)
+	require.Equal(t, dutchAuction.EndTime, endTime)
+	require.Equal(t, dutchAuction.MaxEndTime, endTime)
+	require.Equal(t, dutchAuction.MaxBid, c(TestBidDenom, TestBidAmount))
+	require.Equal(t, dutchAuction.LotReturns, weightedAddresses)
+	require.Equal(t, dutchAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
+	require.Equal(t, dutchAuction.StartPrice, d("1.2"))
+	require.Equal(t, dutchAuction.MarketPrice, d("1"))
+	require.Equal(t, dutchAuction.LotSold, c(TestLotDenom, 0))
+	require.False(t, dutchAuction.IsComplete())
 }
@@ -13,17 +13,28 @@ import (
 // governance module.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPlaceBid{}, "auction/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(&MsgBuyCollateral{}, "auction/MsgBuyCollateral", nil)
+	cdc.RegisterConcrete(&MsgCommitBid{}, "auction/MsgCommitBid", nil)
+	cdc.RegisterConcrete(&MsgRevealBid{}, "auction/MsgRevealBid", nil)
+	cdc.RegisterConcrete(&MsgPlaceBids{}, "auction/MsgPlaceBids", nil)
+	cdc.RegisterConcrete(&MsgMergeAuctions{}, "auction/MsgMergeAuctions", nil)
 
 	cdc.RegisterInterface((*GenesisAuction)(nil), nil)
 	cdc.RegisterInterface((*Auction)(nil), nil)
 	cdc.RegisterConcrete(&SurplusAuction{}, "auction/SurplusAuction", nil)
 	cdc.RegisterConcrete(&DebtAuction{}, "auction/DebtAuction", nil)
 	cdc.RegisterConcrete(&CollateralAuction{}, "auction/CollateralAuction", nil)
+	cdc.RegisterConcrete(&DutchAuction{}, "auction/DutchAuction", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPlaceBid{},
+		&MsgBuyCollateral{},
+		&MsgCommitBid{},
+		&MsgRevealBid{},
+		&MsgPlaceBids{},
+		&MsgMergeAuctions{},
 	)
 
 	registry.RegisterInterface(
@@ -32,6 +43,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SurplusAuction{},
 		&DebtAuction{},
 		&CollateralAuction{},
+		&DutchAuction{},
 	)
 
 	registry.RegisterInterface(
@@ -40,6 +52,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SurplusAuction{},
 		&DebtAuction{},
 		&CollateralAuction{},
+		&DutchAuction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
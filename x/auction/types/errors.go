@@ -27,4 +27,28 @@ var (
 	ErrLotTooSmall = errorsmod.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = errorsmod.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrNotDutchAuction error for when collateral is bought from an auction that is not a dutch auction
+	ErrNotDutchAuction = errorsmod.Register(ModuleName, 13, "auction is not a dutch auction")
+	// ErrPriceTooHigh error for when the current auction price is greater than the buyer's max price
+	ErrPriceTooHigh = errorsmod.Register(ModuleName, 14, "auction price is greater than max price")
+	// ErrInvalidStartPrice error for when a dutch auction is started without a positive price
+	ErrInvalidStartPrice = errorsmod.Register(ModuleName, 15, "start price must be positive")
+	// ErrSealedBidAuction error for when an open bid is placed on a sealed-bid auction
+	ErrSealedBidAuction = errorsmod.Register(ModuleName, 16, "auction only accepts sealed bids")
+	// ErrNotSealedBidAuction error for when a sealed bid is placed on an auction that is not a sealed-bid auction
+	ErrNotSealedBidAuction = errorsmod.Register(ModuleName, 17, "auction is not a sealed-bid auction")
+	// ErrInvalidSealedBidPhase error for when a sealed bid is committed or revealed outside of the matching phase
+	ErrInvalidSealedBidPhase = errorsmod.Register(ModuleName, 18, "sealed-bid auction is not in the required phase")
+	// ErrSealedBidNotFound error for when a sealed bid is not found
+	ErrSealedBidNotFound = errorsmod.Register(ModuleName, 19, "sealed bid not found")
+	// ErrInvalidBidHash error for when a revealed bid does not match the committed bid hash
+	ErrInvalidBidHash = errorsmod.Register(ModuleName, 20, "revealed bid does not match committed bid hash")
+	// ErrSealedBidAlreadyRevealed error for when a sealed bid is revealed more than once
+	ErrSealedBidAlreadyRevealed = errorsmod.Register(ModuleName, 21, "sealed bid has already been revealed")
+	// ErrInsufficientDeposit error for when a sealed bid deposit does not cover the bid
+	ErrInsufficientDeposit = errorsmod.Register(ModuleName, 22, "sealed bid deposit does not cover the bid")
+	// ErrTotalSpendExceeded error for when a batch of bids would spend more than the bidder's max total spend
+	ErrTotalSpendExceeded = errorsmod.Register(ModuleName, 23, "bids exceed max total spend")
+	// ErrCannotMergeAuctions error for when auctions can't be merged
+	ErrCannotMergeAuctions = errorsmod.Register(ModuleName, 24, "auctions cannot be merged")
 )
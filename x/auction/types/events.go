@@ -5,6 +5,14 @@ const (
 	EventTypeAuctionStart = "auction_start"
 	EventTypeAuctionBid   = "auction_bid"
 	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionBuy   = "auction_buy"
+
+	EventTypeAuctionCommitBid      = "auction_commit_bid"
+	EventTypeAuctionRevealBid      = "auction_reveal_bid"
+	EventTypeAuctionRevealStart    = "auction_reveal_start"
+	EventTypeAuctionForfeitDeposit = "auction_forfeit_deposit"
+	EventTypeAuctionReopen         = "auction_reopen"
+	EventTypeAuctionMerge          = "auction_merge"
 
 	AttributeValueCategory  = ModuleName
 	AttributeKeyAuctionID   = "auction_id"
@@ -15,4 +23,10 @@ const (
 	AttributeKeyBid         = "bid"
 	AttributeKeyEndTime     = "end_time"
 	AttributeKeyCloseBlock  = "close_block"
+	AttributeKeyBuyer       = "buyer"
+	AttributeKeyPrice       = "price"
+	AttributeKeyStartPrice  = "start_price"
+	AttributeKeyBidHash     = "bid_hash"
+	AttributeKeyDeposit     = "deposit"
+	AttributeKeyMergedIDs   = "merged_auction_ids"
 )
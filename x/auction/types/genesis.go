@@ -58,16 +58,23 @@ func UnpackGenesisAuctions(genesisAuctionsAny []*types.Any) ([]GenesisAuction, e
 var _ types.UnpackInterfacesMessage = &GenesisState{}
 
 // NewGenesisState returns a new genesis state object for auctions module.
-func NewGenesisState(nextID uint64, ap Params, ga []GenesisAuction) (*GenesisState, error) {
+func NewGenesisState(
+	nextID uint64, ap Params, ga []GenesisAuction, sealedBids SealedBids,
+	bidRecords BidRecords, closedAuctions ClosedAuctions, bidderStats BidderStatsList,
+) (*GenesisState, error) {
 	packedGA, err := PackGenesisAuctions(ga)
 	if err != nil {
 		return &GenesisState{}, err
 	}
 
 	return &GenesisState{
-		NextAuctionId: nextID,
-		Params:        ap,
-		Auctions:      packedGA,
+		NextAuctionId:  nextID,
+		Params:         ap,
+		Auctions:       packedGA,
+		SealedBids:     sealedBids,
+		BidRecords:     bidRecords,
+		ClosedAuctions: closedAuctions,
+		BidderStats:    bidderStats,
 	}, nil
 }
 
@@ -77,6 +84,10 @@ func DefaultGenesisState() *GenesisState {
 		DefaultNextAuctionID,
 		DefaultParams(),
 		[]GenesisAuction{},
+		SealedBids{},
+		BidRecords{},
+		ClosedAuctions{},
+		BidderStatsList{},
 	)
 	if err != nil {
 		panic(fmt.Sprintf("could not create default genesis state: %v", err))
@@ -96,6 +107,7 @@ func (gs GenesisState) Validate() error {
 	}
 
 	ids := map[uint64]bool{}
+	sealedAuctions := map[uint64]SealedBidAuction{}
 	for _, a := range auctions {
 
 		if err := a.Validate(); err != nil {
@@ -110,8 +122,46 @@ func (gs GenesisState) Validate() error {
 		if a.GetID() >= gs.NextAuctionId {
 			return fmt.Errorf("found auction ID ≥ the nextAuctionID (%d ≥ %d)", a.GetID(), gs.NextAuctionId)
 		}
+
+		if IsSealedBid(a) {
+			sealedAuctions[a.GetID()] = a.(SealedBidAuction)
+		}
 	}
-	return nil
+
+	if err := gs.SealedBids.Validate(); err != nil {
+		return err
+	}
+
+	// the deposits escrowed by each sealed-bid auction must match the deposits of its sealed bids
+	deposits := map[uint64]sdk.Coins{}
+	for _, b := range gs.SealedBids {
+		if _, found := sealedAuctions[b.AuctionID]; !found {
+			return fmt.Errorf("found sealed bid for auction %d which is not a sealed-bid auction", b.AuctionID)
+		}
+		deposits[b.AuctionID] = deposits[b.AuctionID].Add(b.Deposit)
+	}
+	for id, a := range sealedAuctions {
+		sealedDeposits := a.GetSealedDeposits()
+		if !sealedDeposits.IsAllGTE(deposits[id]) || !deposits[id].IsAllGTE(sealedDeposits) {
+			return fmt.Errorf("sealed deposits of auction %d do not match its sealed bids (%s ≠ %s)", id, sealedDeposits, deposits[id])
+		}
+	}
+
+	if err := gs.BidRecords.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.ClosedAuctions.Validate(); err != nil {
+		return err
+	}
+
+	for _, c := range gs.ClosedAuctions {
+		if ids[c.AuctionID] {
+			return fmt.Errorf("found closed auction record for open auction %d", c.AuctionID)
+		}
+	}
+
+	return gs.BidderStats.Validate()
 }
 
 // UnpackInterfaces hooks into unmarshalling to unpack any interface types contained within the GenesisState.
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -30,12 +31,52 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DecayCurve enumerates the curves that dutch auction prices can decay along.
+type DecayCurve int32
+
+const (
+	// DECAY_CURVE_UNSPECIFIED defines an invalid decay curve.
+	DECAY_CURVE_UNSPECIFIED DecayCurve = 0
+	// DECAY_CURVE_LINEAR decreases the price linearly from the start price to zero at the auction end time.
+	DECAY_CURVE_LINEAR DecayCurve = 1
+	// DECAY_CURVE_EXPONENTIAL multiplies the price by the step decay once every step duration.
+	DECAY_CURVE_EXPONENTIAL DecayCurve = 2
+)
+
+var DecayCurve_name = map[int32]string{
+	0: "DECAY_CURVE_UNSPECIFIED",
+	1: "DECAY_CURVE_LINEAR",
+	2: "DECAY_CURVE_EXPONENTIAL",
+}
+
+var DecayCurve_value = map[string]int32{
+	"DECAY_CURVE_UNSPECIFIED": 0,
+	"DECAY_CURVE_LINEAR":      1,
+	"DECAY_CURVE_EXPONENTIAL": 2,
+}
+
+func (x DecayCurve) String() string {
+	return proto.EnumName(DecayCurve_name, int32(x))
+}
+
+func (DecayCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d0e5cb58293042f7, []int{0}
+}
+
 // GenesisState defines the auction module's genesis state.
 type GenesisState struct {
 	NextAuctionId uint64 `protobuf:"varint,1,opt,name=next_auction_id,json=nextAuctionId,proto3" json:"next_auction_id,omitempty"`
 	Params        Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// Genesis auctions
 	Auctions []*types.Any `protobuf:"bytes,3,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// Genesis sealed bids
+	SealedBids SealedBids `protobuf:"bytes,4,rep,name=sealed_bids,json=sealedBids,proto3,castrepeated=SealedBids" json:"sealed_bids"`
+	// Genesis bid history
+	BidRecords BidRecords `protobuf:"bytes,5,rep,name=bid_records,json=bidRecords,proto3,castrepeated=BidRecords" json:"bid_records"`
+	// Genesis closed auction records
+	ClosedAuctions ClosedAuctions `protobuf:"bytes,6,rep,name=closed_auctions,json=closedAuctions,proto3,castrepeated=ClosedAuctions" json:"closed_auctions"`
+	// Genesis bidder statistics
+	BidderStats BidderStatsList `protobuf:"bytes,7,rep,name=bidder_stats,json=bidderStats,proto3,castrepeated=BidderStatsList" json:"bidder_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +120,31 @@ type Params struct {
 	IncrementSurplus    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=increment_surplus,json=incrementSurplus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_surplus"`
 	IncrementDebt       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=increment_debt,json=incrementDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_debt"`
 	IncrementCollateral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=increment_collateral,json=incrementCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_collateral"`
+	// dutch_auction_duration is how long dutch auctions run before closing.
+	DutchAuctionDuration time.Duration `protobuf:"bytes,8,opt,name=dutch_auction_duration,json=dutchAuctionDuration,proto3,stdduration" json:"dutch_auction_duration"`
+	// dutch_price_premium is the premium over the market price of the lot that dutch auctions start at.
+	DutchPricePremium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=dutch_price_premium,json=dutchPricePremium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_price_premium"`
+	// dutch_decay_curve is the curve that dutch auction prices decay along.
+	DutchDecayCurve DecayCurve `protobuf:"varint,10,opt,name=dutch_decay_curve,json=dutchDecayCurve,proto3,enum=kava.auction.v1beta1.DecayCurve" json:"dutch_decay_curve,omitempty"`
+	// dutch_step_duration is the time between price decreases on an exponential decay curve.
+	DutchStepDuration time.Duration `protobuf:"bytes,11,opt,name=dutch_step_duration,json=dutchStepDuration,proto3,stdduration" json:"dutch_step_duration"`
+	// dutch_step_decay is the factor the price is multiplied by at each step of an exponential decay curve.
+	DutchStepDecay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=dutch_step_decay,json=dutchStepDecay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_step_decay"`
+	// sealed_bid_enabled sets whether new surplus and debt auctions are run as sealed-bid auctions.
+	SealedBidEnabled bool `protobuf:"varint,13,opt,name=sealed_bid_enabled,json=sealedBidEnabled,proto3" json:"sealed_bid_enabled,omitempty"`
+	// sealed_bid_commit_duration is how long sealed-bid auctions accept bid commitments.
+	SealedBidCommitDuration time.Duration `protobuf:"bytes,14,opt,name=sealed_bid_commit_duration,json=sealedBidCommitDuration,proto3,stdduration" json:"sealed_bid_commit_duration"`
+	// sealed_bid_reveal_duration is how long sealed-bid auctions accept bid reveals after the commit phase.
+	SealedBidRevealDuration time.Duration `protobuf:"bytes,15,opt,name=sealed_bid_reveal_duration,json=sealedBidRevealDuration,proto3,stdduration" json:"sealed_bid_reveal_duration"`
+	// bid_history_enabled sets whether bid history, closed auction records and bidder statistics are kept.
+	BidHistoryEnabled bool `protobuf:"varint,16,opt,name=bid_history_enabled,json=bidHistoryEnabled,proto3" json:"bid_history_enabled,omitempty"`
+	// max_bid_history_length is the maximum number of bids kept per auction, older bids are pruned first.
+	MaxBidHistoryLength uint64 `protobuf:"varint,17,opt,name=max_bid_history_length,json=maxBidHistoryLength,proto3" json:"max_bid_history_length,omitempty"`
+	// history_retention_duration is how long closed auction records and their bid history are kept after closing.
+	HistoryRetentionDuration time.Duration `protobuf:"bytes,18,opt,name=history_retention_duration,json=historyRetentionDuration,proto3,stdduration" json:"history_retention_duration"`
+	// max_merged_lots is the largest lot a merged collateral auction can have for each lot denom. Collateral auctions
+	// with a lot denom that is not listed cannot be merged.
+	MaxMergedLots github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=max_merged_lots,json=maxMergedLots,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_merged_lots"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -115,6 +181,7 @@ func (m *Params) XXX_DiscardUnknown() {
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("kava.auction.v1beta1.DecayCurve", DecayCurve_name, DecayCurve_value)
 	proto.RegisterType((*GenesisState)(nil), "kava.auction.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "kava.auction.v1beta1.Params")
 }
@@ -124,38 +191,70 @@ func init() {
 }
 
 var fileDescriptor_d0e5cb58293042f7 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x93, 0x35, 0x94, 0xca, 0xed, 0xc6, 0x30, 0x39, 0xa4, 0x13, 0x4a, 0xab, 0x1e, 0xa6,
-	0x72, 0xa8, 0xa3, 0x95, 0x1b, 0xb7, 0x85, 0x4a, 0x13, 0x9c, 0x50, 0xa6, 0x5d, 0xe0, 0x10, 0x39,
-	0x89, 0x17, 0xa2, 0x25, 0x71, 0x65, 0x3b, 0xa5, 0x7d, 0x0b, 0x8e, 0x3c, 0x08, 0x87, 0x3d, 0x42,
-	0xc5, 0x69, 0x47, 0xc4, 0x61, 0x40, 0xfb, 0x22, 0x28, 0x8e, 0x9b, 0x16, 0xd8, 0x65, 0x3b, 0xd5,
-	0xfe, 0xbe, 0xff, 0xf7, 0xfb, 0xff, 0xed, 0x3a, 0x60, 0x70, 0x85, 0x67, 0xd8, 0xc1, 0x45, 0x28,
-	0x12, 0x9a, 0x3b, 0xb3, 0x93, 0x80, 0x08, 0x7c, 0xe2, 0xc4, 0x24, 0x27, 0x3c, 0xe1, 0x68, 0xca,
-	0xa8, 0xa0, 0xd0, 0x2c, 0x35, 0x48, 0x69, 0x90, 0xd2, 0x1c, 0x75, 0x43, 0xca, 0x33, 0xca, 0x7d,
-	0xa9, 0x71, 0xaa, 0x4d, 0x35, 0x70, 0x64, 0xc6, 0x34, 0xa6, 0x55, 0xbd, 0x5c, 0xa9, 0x6a, 0x37,
-	0xa6, 0x34, 0x4e, 0x89, 0x23, 0x77, 0x41, 0x71, 0xe9, 0xe0, 0x7c, 0xa1, 0x5a, 0xf6, 0xbf, 0xad,
-	0xa8, 0x60, 0x58, 0xba, 0xc9, 0xca, 0xe0, 0x5a, 0x07, 0x9d, 0xb3, 0x2a, 0xd3, 0xb9, 0xc0, 0x82,
-	0xc0, 0x63, 0xf0, 0x24, 0x27, 0x73, 0xe1, 0xab, 0x50, 0x7e, 0x12, 0x59, 0x7a, 0x5f, 0x1f, 0x1a,
-	0xde, 0x7e, 0x59, 0x3e, 0xad, 0xaa, 0x6f, 0x22, 0xf8, 0x0a, 0x34, 0xa7, 0x98, 0xe1, 0x8c, 0x5b,
-	0x7b, 0x7d, 0x7d, 0xd8, 0x1e, 0x3f, 0x47, 0x77, 0x9d, 0x05, 0xbd, 0x93, 0x1a, 0xd7, 0x58, 0xde,
-	0xf6, 0x34, 0x4f, 0x4d, 0xc0, 0x09, 0x68, 0x29, 0x1d, 0xb7, 0x1a, 0xfd, 0xc6, 0xb0, 0x3d, 0x36,
-	0x51, 0x95, 0x13, 0x6d, 0x72, 0xa2, 0xd3, 0x7c, 0xe1, 0xc2, 0x6f, 0x5f, 0x47, 0x07, 0x2a, 0x9d,
-	0x72, 0xf6, 0xea, 0xc9, 0xc1, 0xb5, 0x01, 0x9a, 0x15, 0x1e, 0x5e, 0x00, 0x33, 0xc3, 0xf3, 0x3a,
-	0xf3, 0xe6, 0x8c, 0x32, 0x79, 0x7b, 0xdc, 0xfd, 0x0f, 0x3e, 0x51, 0x02, 0xb7, 0x55, 0xe6, 0xfa,
-	0xf2, 0xb3, 0xa7, 0x7b, 0x30, 0xc3, 0x73, 0xe5, 0xb1, 0xe9, 0x96, 0xd8, 0x4b, 0xca, 0x3e, 0x61,
-	0x16, 0xf9, 0x41, 0x12, 0x6d, 0xb1, 0xcd, 0x7b, 0x60, 0x15, 0xc0, 0x4d, 0xa2, 0x5d, 0x2c, 0x23,
-	0x33, 0xc2, 0x38, 0xf9, 0x1b, 0xfb, 0xf8, 0x1e, 0x58, 0x05, 0xd8, 0xc5, 0x7e, 0x00, 0x4f, 0x93,
-	0x3c, 0x64, 0x24, 0x23, 0xb9, 0xf0, 0x79, 0xc1, 0xa6, 0x69, 0x51, 0x5e, 0xaf, 0x3e, 0xec, 0xb8,
-	0xa8, 0x1c, 0xfc, 0x71, 0xdb, 0x3b, 0x8e, 0x13, 0xf1, 0xb1, 0x08, 0x50, 0x48, 0x33, 0xf5, 0xae,
-	0xd4, 0xcf, 0x88, 0x47, 0x57, 0x8e, 0x58, 0x4c, 0x09, 0x47, 0x13, 0x12, 0x7a, 0x87, 0x35, 0xe8,
-	0xbc, 0xe2, 0xc0, 0x0b, 0x70, 0xb0, 0x85, 0x47, 0x24, 0x10, 0x96, 0xf1, 0x20, 0xf2, 0x7e, 0x4d,
-	0x99, 0x90, 0x40, 0x40, 0x0c, 0xcc, 0x2d, 0x36, 0xa4, 0x69, 0x8a, 0x05, 0x61, 0x38, 0xb5, 0x1e,
-	0x3d, 0x08, 0xfe, 0xac, 0x66, 0xbd, 0xae, 0x51, 0x6f, 0x8d, 0xd6, 0xde, 0x61, 0xc3, 0xeb, 0xec,
-	0xde, 0xb4, 0x7b, 0xb6, 0xfc, 0x6d, 0x6b, 0xcb, 0x95, 0xad, 0xdf, 0xac, 0x6c, 0xfd, 0xd7, 0xca,
-	0xd6, 0x3f, 0xaf, 0x6d, 0xed, 0x66, 0x6d, 0x6b, 0xdf, 0xd7, 0xb6, 0xf6, 0xfe, 0xc5, 0x8e, 0x5d,
-	0xf9, 0xa8, 0x47, 0x29, 0x0e, 0xb8, 0x5c, 0x39, 0xf3, 0xfa, 0x83, 0x96, 0xae, 0x41, 0x53, 0xfe,
-	0x49, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x34, 0x3b, 0x9f, 0xed, 0x03, 0x00, 0x00,
+	// 1003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0xbd, 0xad, 0x9b, 0xe6, 0x37, 0x4e, 0x6c, 0x67, 0x62, 0x25, 0x9b, 0xfc, 0xd0, 0xc6,
+	0x04, 0xa9, 0x32, 0x88, 0xac, 0x69, 0x7a, 0xe3, 0x96, 0xb5, 0x4d, 0x89, 0x64, 0x42, 0xb4, 0x6e,
+	0x50, 0x0b, 0x82, 0x65, 0x76, 0x67, 0xea, 0xac, 0xba, 0xbb, 0x63, 0xcd, 0x8c, 0x83, 0xf3, 0x0e,
+	0x90, 0xb8, 0x70, 0xe4, 0xce, 0x09, 0xce, 0xbc, 0x88, 0x88, 0x53, 0x8f, 0x88, 0x43, 0x0b, 0xc9,
+	0x1b, 0x41, 0x33, 0x3b, 0x9e, 0x75, 0xd2, 0x80, 0x5c, 0x9f, 0xbc, 0xfb, 0xfc, 0xf9, 0x3c, 0xcf,
+	0x77, 0xe6, 0x99, 0xf1, 0x82, 0xdd, 0x17, 0xe8, 0x0c, 0xb5, 0xd1, 0x38, 0x12, 0x31, 0xcd, 0xda,
+	0x67, 0x0f, 0x43, 0x22, 0xd0, 0xc3, 0xf6, 0x90, 0x64, 0x84, 0xc7, 0xdc, 0x1d, 0x31, 0x2a, 0x28,
+	0x6c, 0xc8, 0x18, 0x57, 0xc7, 0xb8, 0x3a, 0x66, 0xdb, 0x89, 0x28, 0x4f, 0x29, 0x6f, 0x87, 0x88,
+	0x13, 0x93, 0x18, 0xd1, 0x38, 0xcb, 0xb3, 0xb6, 0xb7, 0x72, 0x7f, 0xa0, 0xde, 0xda, 0xf9, 0x8b,
+	0x76, 0x35, 0x86, 0x74, 0x48, 0x73, 0xbb, 0x7c, 0x9a, 0x26, 0x0c, 0x29, 0x1d, 0x26, 0xa4, 0xad,
+	0xde, 0xc2, 0xf1, 0xf3, 0x36, 0xca, 0xce, 0xb5, 0xcb, 0xb9, 0xe9, 0xc2, 0x63, 0x86, 0x54, 0x37,
+	0xb9, 0xff, 0x76, 0x15, 0xd3, 0x8e, 0x55, 0xcc, 0xee, 0x2f, 0x65, 0xb0, 0xf2, 0x38, 0xd7, 0x35,
+	0x10, 0x48, 0x10, 0xf8, 0x00, 0xd4, 0x32, 0x32, 0x11, 0x81, 0x0e, 0x0b, 0x62, 0x6c, 0x5b, 0x4d,
+	0xab, 0x55, 0xf6, 0x57, 0xa5, 0xf9, 0x20, 0xb7, 0x1e, 0x62, 0xf8, 0x31, 0x58, 0x1a, 0x21, 0x86,
+	0x52, 0x6e, 0xdf, 0x69, 0x5a, 0xad, 0xca, 0xfe, 0x3b, 0xee, 0x6d, 0xeb, 0xe1, 0x1e, 0xab, 0x18,
+	0xaf, 0x7c, 0xf1, 0x6a, 0xa7, 0xe4, 0xeb, 0x0c, 0xd8, 0x05, 0xcb, 0x3a, 0x8e, 0xdb, 0x77, 0x9b,
+	0x77, 0x5b, 0x95, 0xfd, 0x86, 0x9b, 0x6b, 0x71, 0xa7, 0x5a, 0xdc, 0x83, 0xec, 0xdc, 0x83, 0xbf,
+	0xff, 0xb6, 0x57, 0xd5, 0xdd, 0xe9, 0xca, 0xbe, 0xc9, 0x84, 0x4f, 0x40, 0x85, 0x13, 0x94, 0x10,
+	0x1c, 0x84, 0x31, 0xe6, 0x76, 0x59, 0x81, 0x76, 0x6e, 0x6f, 0x63, 0xa0, 0x02, 0xbd, 0x18, 0x7b,
+	0x50, 0x76, 0xf2, 0xeb, 0xeb, 0x1d, 0x60, 0x4c, 0xdc, 0x07, 0xdc, 0x3c, 0x4b, 0x6a, 0x18, 0xe3,
+	0x80, 0x91, 0x88, 0x32, 0xcc, 0xed, 0x7b, 0xff, 0x45, 0xf5, 0x62, 0xec, 0xab, 0xb8, 0x82, 0x6a,
+	0x4c, 0xdc, 0x07, 0xa1, 0x79, 0x86, 0x18, 0xd4, 0xa2, 0x84, 0x72, 0x82, 0x03, 0x23, 0x7c, 0x49,
+	0x91, 0xdf, 0xbb, 0x9d, 0xdc, 0x51, 0xc1, 0x5a, 0xb3, 0xb7, 0xa1, 0xe9, 0xd5, 0x6b, 0x66, 0xee,
+	0x57, 0xa3, 0x6b, 0xef, 0xf0, 0x6b, 0xb0, 0x12, 0xc6, 0x18, 0x13, 0x16, 0x70, 0x81, 0x04, 0xb7,
+	0xef, 0xab, 0x12, 0xef, 0xfe, 0x6b, 0xf3, 0x98, 0x30, 0xb9, 0xe9, 0xdc, 0xdb, 0xd4, 0x05, 0x6a,
+	0x33, 0xc6, 0x7e, 0xcc, 0x85, 0x5f, 0x09, 0x0b, 0xc3, 0xee, 0x0f, 0x2b, 0x60, 0x29, 0xdf, 0x4f,
+	0x78, 0x02, 0x1a, 0x29, 0x9a, 0x98, 0x21, 0x99, 0x0e, 0x9e, 0x1a, 0x95, 0xca, 0xfe, 0xd6, 0x1b,
+	0xbb, 0xd9, 0xd5, 0x01, 0xde, 0xb2, 0xac, 0xf4, 0xd3, 0xeb, 0x1d, 0xcb, 0x87, 0x29, 0x9a, 0xe8,
+	0xce, 0xa7, 0x5e, 0x89, 0x7d, 0x4e, 0xd9, 0x77, 0x88, 0xa9, 0x3d, 0x2d, 0xb0, 0x4b, 0x6f, 0x81,
+	0xd5, 0x00, 0x2f, 0xc6, 0xb3, 0x58, 0x46, 0xce, 0x08, 0xe3, 0xe4, 0x3a, 0xf6, 0xfe, 0x5b, 0x60,
+	0x35, 0x60, 0x16, 0xfb, 0x15, 0x58, 0x8b, 0xb3, 0x88, 0x91, 0x94, 0x64, 0x22, 0xe0, 0x63, 0x36,
+	0x4a, 0xc6, 0x72, 0x9e, 0xad, 0xd6, 0x8a, 0xe7, 0xca, 0xc4, 0x3f, 0x5f, 0xed, 0x3c, 0x18, 0xc6,
+	0xe2, 0x74, 0x1c, 0xba, 0x11, 0x4d, 0xf5, 0x61, 0xd7, 0x3f, 0x7b, 0x1c, 0xbf, 0x68, 0x8b, 0xf3,
+	0x11, 0xe1, 0x6e, 0x97, 0x44, 0x7e, 0xdd, 0x80, 0x06, 0x39, 0x07, 0x9e, 0x80, 0x6a, 0x01, 0xc7,
+	0x24, 0x14, 0x76, 0x79, 0x21, 0xf2, 0xaa, 0xa1, 0x74, 0x49, 0x28, 0x20, 0x02, 0x8d, 0x02, 0x1b,
+	0xd1, 0x24, 0x41, 0x82, 0x30, 0x94, 0xd8, 0xf7, 0x16, 0x82, 0xaf, 0x1b, 0x56, 0xc7, 0xa0, 0xe0,
+	0x33, 0xb0, 0x81, 0xc7, 0x22, 0x3a, 0x7d, 0x73, 0x3a, 0x96, 0xe7, 0x5f, 0xef, 0x86, 0x42, 0xdc,
+	0x9c, 0x8f, 0x6f, 0xc0, 0x7a, 0x8e, 0x1e, 0xb1, 0x38, 0x22, 0xc1, 0x88, 0x91, 0x34, 0x1e, 0xa7,
+	0xf6, 0xff, 0x16, 0x6a, 0x7e, 0x4d, 0xa1, 0x8e, 0x25, 0xe9, 0x38, 0x07, 0xc1, 0x3e, 0xc8, 0x8d,
+	0x01, 0x26, 0x11, 0x3a, 0x0f, 0xa2, 0x31, 0x3b, 0x23, 0x36, 0x68, 0x5a, 0xad, 0xea, 0x7e, 0xf3,
+	0xf6, 0x53, 0xd4, 0x95, 0x81, 0x1d, 0x19, 0xe7, 0xd7, 0x54, 0x6a, 0x61, 0x80, 0x83, 0x69, 0xb7,
+	0x5c, 0x90, 0x51, 0xb1, 0x0a, 0x95, 0xf9, 0x57, 0x21, 0xef, 0x66, 0x20, 0xc8, 0xc8, 0x2c, 0xc1,
+	0x53, 0x50, 0x9f, 0x85, 0xca, 0x6a, 0xf6, 0xca, 0x42, 0xfa, 0xab, 0x05, 0x5c, 0x52, 0xe0, 0x87,
+	0x00, 0x16, 0xf7, 0x69, 0x40, 0x32, 0x14, 0x26, 0x04, 0xdb, 0xab, 0x4d, 0xab, 0xb5, 0xec, 0xd7,
+	0xcd, 0x0d, 0xd9, 0xcb, 0xed, 0xf0, 0x5b, 0xb0, 0x3d, 0x13, 0x1d, 0xd1, 0x34, 0x8d, 0x45, 0xa1,
+	0xb1, 0x3a, 0xbf, 0xc6, 0x4d, 0x83, 0xee, 0x28, 0x88, 0x51, 0x7a, 0xbd, 0x82, 0x3c, 0x7f, 0x28,
+	0x29, 0x2a, 0xd4, 0x16, 0xa9, 0xe0, 0x2b, 0x88, 0xa9, 0xe0, 0x82, 0x75, 0x89, 0x3e, 0x8d, 0xb9,
+	0xa0, 0xec, 0xdc, 0x48, 0xae, 0x2b, 0xc9, 0x6b, 0x61, 0x8c, 0x3f, 0xcd, 0x3d, 0x53, 0xcd, 0x8f,
+	0xc0, 0x86, 0xbc, 0xf5, 0x66, 0x73, 0x12, 0x92, 0x0d, 0xc5, 0xa9, 0xbd, 0xa6, 0xfe, 0x22, 0xd7,
+	0x53, 0x34, 0xf1, 0x4c, 0x56, 0x5f, 0xb9, 0x20, 0x02, 0xdb, 0xd3, 0x60, 0x46, 0x04, 0xc9, 0xae,
+	0x1f, 0x09, 0x38, 0xbf, 0x0c, 0x5b, 0x63, 0xfc, 0x29, 0xc5, 0xe8, 0xe0, 0xa0, 0x26, 0xfb, 0x4a,
+	0x09, 0x1b, 0x12, 0x1c, 0x24, 0x54, 0x70, 0x7b, 0x5d, 0x5d, 0xfd, 0x5b, 0xae, 0xfe, 0xc2, 0x90,
+	0x9f, 0x23, 0xc5, 0x9f, 0x0b, 0x8d, 0x33, 0xef, 0x23, 0x7d, 0xe5, 0xb7, 0xe6, 0x98, 0x16, 0x99,
+	0xc0, 0xfd, 0xd5, 0x14, 0x4d, 0x3e, 0x53, 0x25, 0xfa, 0x54, 0xf0, 0x0f, 0x30, 0x00, 0x33, 0xb3,
+	0xfe, 0x7f, 0xb0, 0xd9, 0xed, 0x75, 0x0e, 0x9e, 0x05, 0x9d, 0x13, 0xff, 0x8b, 0x5e, 0x70, 0x72,
+	0x34, 0x38, 0xee, 0x75, 0x0e, 0x3f, 0x39, 0xec, 0x75, 0xeb, 0x25, 0xb8, 0x01, 0xe0, 0xac, 0xb3,
+	0x7f, 0x78, 0xd4, 0x3b, 0xf0, 0xeb, 0xd6, 0xcd, 0xa4, 0xde, 0xd3, 0xe3, 0xcf, 0x8f, 0x7a, 0x47,
+	0x4f, 0x0e, 0x0f, 0xfa, 0xf5, 0x3b, 0xdb, 0xe5, 0xef, 0x7f, 0x76, 0x4a, 0xde, 0xe3, 0x8b, 0xbf,
+	0x9d, 0xd2, 0xc5, 0xa5, 0x63, 0xbd, 0xbc, 0x74, 0xac, 0xbf, 0x2e, 0x1d, 0xeb, 0xc7, 0x2b, 0xa7,
+	0xf4, 0xf2, 0xca, 0x29, 0xfd, 0x71, 0xe5, 0x94, 0xbe, 0x7c, 0x7f, 0xa6, 0x79, 0x79, 0x3c, 0xf7,
+	0x12, 0x14, 0x72, 0xf5, 0xd4, 0x9e, 0x98, 0x0f, 0x1f, 0xa5, 0x21, 0x5c, 0x52, 0x4b, 0xfb, 0xe8,
+	0x9f, 0x01, 0x00, 0xea, 0xcf, 0x92, 0x6a, 0xdb, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +277,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BidderStats) > 0 {
+		for iNdEx := len(m.BidderStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ClosedAuctions) > 0 {
+		for iNdEx := len(m.ClosedAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClosedAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BidRecords) > 0 {
+		for iNdEx := len(m.BidRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SealedBids) > 0 {
+		for iNdEx := len(m.SealedBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SealedBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Auctions) > 0 {
 		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -230,21 +385,133 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	if len(m.MaxMergedLots) > 0 {
+		for iNdEx := len(m.MaxMergedLots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxMergedLots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HistoryRetentionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HistoryRetentionDuration):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x3a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.MaxBidHistoryLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBidHistoryLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.BidHistoryEnabled {
+		i--
+		if m.BidHistoryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SealedBidRevealDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SealedBidRevealDuration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x7a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SealedBidCommitDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SealedBidCommitDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x72
+	if m.SealedBidEnabled {
+		i--
+		if m.SealedBidEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.DutchStepDecay.Size()
+		i -= size
+		if _, err := m.DutchStepDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DutchStepDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DutchStepDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x5a
+	if m.DutchDecayCurve != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DutchDecayCurve))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.DutchPricePremium.Size()
+		i -= size
+		if _, err := m.DutchPricePremium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DutchAuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DutchAuctionDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGenesis(dAtA, i, uint64(n8))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.IncrementCollateral.Size()
@@ -276,12 +543,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAuctionDuration):])
-	if err4 != nil {
-		return 0, err4
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAuctionDuration):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n4
-	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -315,6 +582,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SealedBids) > 0 {
+		for _, e := range m.SealedBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidRecords) > 0 {
+		for _, e := range m.BidRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClosedAuctions) > 0 {
+		for _, e := range m.ClosedAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidderStats) > 0 {
+		for _, e := range m.BidderStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +627,38 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReverseBidDuration)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DutchAuctionDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchPricePremium.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DutchDecayCurve != 0 {
+		n += 1 + sovGenesis(uint64(m.DutchDecayCurve))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DutchStepDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchStepDecay.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.SealedBidEnabled {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SealedBidCommitDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SealedBidRevealDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BidHistoryEnabled {
+		n += 3
+	}
+	if m.MaxBidHistoryLength != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBidHistoryLength))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HistoryRetentionDuration)
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.MaxMergedLots) > 0 {
+		for _, e := range m.MaxMergedLots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -460,59 +783,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAuctionDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -539,48 +812,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncrementSurplus", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.IncrementSurplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SealedBids = append(m.SealedBids, SealedBid{})
+			if err := m.SealedBids[len(m.SealedBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncrementDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidRecords", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -590,30 +831,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncrementDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BidRecords = append(m.BidRecords, BidRecord{})
+			if err := m.BidRecords[len(m.BidRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncrementCollateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAuctions", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -623,28 +865,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncrementCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClosedAuctions = append(m.ClosedAuctions, ClosedAuction{})
+			if err := m.ClosedAuctions[len(m.ClosedAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardBidDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -671,7 +914,223 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ForwardBidDuration, dAtA[iNdEx:postIndex]); err != nil {
+			m.BidderStats = append(m.BidderStats, BidderStats{})
+			if err := m.BidderStats[len(m.BidderStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementSurplus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncrementSurplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementDebt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncrementDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncrementCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardBidDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ForwardBidDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -708,6 +1167,349 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DutchAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchPricePremium", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchPricePremium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchDecayCurve", wireType)
+			}
+			m.DutchDecayCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DutchDecayCurve |= DecayCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchStepDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DutchStepDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchStepDecay", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchStepDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SealedBidEnabled = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidCommitDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SealedBidCommitDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidRevealDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SealedBidRevealDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistoryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BidHistoryEnabled = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBidHistoryLength", wireType)
+			}
+			m.MaxBidHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBidHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HistoryRetentionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMergedLots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxMergedLots = append(m.MaxMergedLots, types1.Coin{})
+			if err := m.MaxMergedLots[len(m.MaxMergedLots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,27 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 	}
 
+	validSealedAuction := NewSealedBidSurplusAuction(
+		"seller mod account", sdk.NewInt64Coin("usdx", 1e9), "ukava", arbitraryTime, arbitraryTime.Add(time.Hour),
+	)
+	validSealedAuction.ID = 11
+	validSealedAuction.SealedDeposits = sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))
+	validSealedBid := NewSealedBid(
+		validSealedAuction.ID,
+		sdk.AccAddress("test bidder"),
+		SealedBidHash(validSealedAuction.ID, sdk.AccAddress("test bidder"), sdk.NewInt64Coin("ukava", 80), "salt"),
+		sdk.NewInt64Coin("ukava", 100),
+	)
+
+	validBidRecord := NewBidRecord(
+		validAuction.ID, 1, sdk.AccAddress("test bidder"), validAuction.Bid, validAuction.Lot, arbitraryTime, 1,
+	)
+	validClosedAuction := NewClosedAuction(
+		validAuction.ID-1, CollateralAuctionType, "seller mod account", sdk.AccAddress("test bidder"),
+		sdk.NewInt64Coin("btc", 1e8), sdk.NewInt64Coin("usdx", 5e4), sdk.ZeroDec(), arbitraryTime, 1,
+	)
+	validBidderStats := NewBidderStats(sdk.AccAddress("test bidder"))
+
 	testCases := []struct {
 		name       string
 		genesis    *GenesisState
@@ -61,6 +82,10 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				SealedBids{},
+				BidRecords{},
+				ClosedAuctions{},
+				BidderStatsList{},
 			},
 			false,
 		},
@@ -75,6 +100,132 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				SealedBids{},
+				BidRecords{},
+				ClosedAuctions{},
+				BidderStatsList{},
+			},
+			false,
+		},
+		{
+			"valid sealed bids",
+			&GenesisState{
+				validSealedAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						&validSealedAuction,
+					},
+				),
+				SealedBids{validSealedBid},
+				BidRecords{},
+				ClosedAuctions{},
+				BidderStatsList{},
+			},
+			true,
+		},
+		{
+			"invalid sealed bid on open auction",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				SealedBids{NewSealedBid(validAuction.ID, sdk.AccAddress("test bidder"), validSealedBid.BidHash, validSealedBid.Deposit)},
+				BidRecords{},
+				ClosedAuctions{},
+				BidderStatsList{},
+			},
+			false,
+		},
+		{
+			"valid auction history",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				SealedBids{},
+				BidRecords{validBidRecord},
+				ClosedAuctions{validClosedAuction},
+				BidderStatsList{validBidderStats},
+			},
+			true,
+		},
+		{
+			"invalid duplicate bid records",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				SealedBids{},
+				BidRecords{validBidRecord, validBidRecord},
+				ClosedAuctions{},
+				BidderStatsList{},
+			},
+			false,
+		},
+		{
+			"invalid closed auction record for open auction",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				SealedBids{},
+				BidRecords{},
+				ClosedAuctions{NewClosedAuction(
+					validAuction.ID, CollateralAuctionType, "seller mod account", nil,
+					validAuction.Lot, validAuction.Bid, sdk.ZeroDec(), arbitraryTime, 1,
+				)},
+				BidderStatsList{},
+			},
+			false,
+		},
+		{
+			"invalid duplicate bidder stats",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				SealedBids{},
+				BidRecords{},
+				ClosedAuctions{},
+				BidderStatsList{validBidderStats, validBidderStats},
+			},
+			false,
+		},
+		{
+			"invalid sealed bid deposits not matching auction",
+			&GenesisState{
+				validSealedAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						&validSealedAuction,
+					},
+				),
+				SealedBids{},
+				BidRecords{},
+				ClosedAuctions{},
+				BidderStatsList{},
 			},
 			false,
 		},
@@ -112,6 +263,7 @@ func TestGenesisState_UnmarshalAnys(t *testing.T) {
 			CorrespondingDebt: sdk.NewInt64Coin("debt", 1e9),
 			MaxBid:            sdk.NewInt64Coin("usdx", 5e4),
 			LotReturns:        WeightedAddresses{},
+			MarketPrice:       sdk.MustNewDecFromStr("0.0005"),
 		},
 		&DebtAuction{
 			BaseAuction: BaseAuction{
@@ -143,6 +295,10 @@ func TestGenesisState_UnmarshalAnys(t *testing.T) {
 		DefaultNextAuctionID,
 		DefaultParams(),
 		auctions,
+		SealedBids{},
+		BidRecords{},
+		ClosedAuctions{},
+		BidderStatsList{},
 	)
 	require.NoError(t, err)
 
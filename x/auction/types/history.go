@@ -0,0 +1,181 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBidRecord returns a new record of a bid placed on an auction.
+func NewBidRecord(auctionID, sequence uint64, bidder sdk.AccAddress, bid, lot sdk.Coin, blockTime time.Time, height int64) BidRecord {
+	return BidRecord{
+		AuctionID: auctionID,
+		Sequence:  sequence,
+		Bidder:    bidder,
+		Bid:       bid,
+		Lot:       lot,
+		Time:      blockTime,
+		Height:    height,
+	}
+}
+
+// Validate performs a basic validation of the bid record fields.
+func (r BidRecord) Validate() error {
+	if r.AuctionID == 0 {
+		return errors.New("bid record auction id cannot be 0")
+	}
+	if r.Bidder.Empty() {
+		return errors.New("bid record bidder cannot be empty")
+	}
+	if !r.Bid.IsValid() {
+		return fmt.Errorf("invalid bid record bid: %s", r.Bid)
+	}
+	if !r.Lot.IsValid() {
+		return fmt.Errorf("invalid bid record lot: %s", r.Lot)
+	}
+	return nil
+}
+
+// BidRecords a collection of BidRecord objects
+type BidRecords []BidRecord
+
+// Validate validates each bid record and checks that sequences are unique per auction
+func (rs BidRecords) Validate() error {
+	seen := make(map[string]bool)
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d:%d", r.AuctionID, r.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate bid record %d on auction %d", r.Sequence, r.AuctionID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// NewClosedAuction returns a new record of a closed auction. The final price is the bid paid per unit of lot, and the
+// discount is how far the final price is below the oracle price. Both are zero if they can't be calculated.
+func NewClosedAuction(
+	auctionID uint64, auctionType, initiator string, winner sdk.AccAddress, lot, bid sdk.Coin,
+	oraclePrice sdk.Dec, closeTime time.Time, closeHeight int64,
+) ClosedAuction {
+	finalPrice := sdk.ZeroDec()
+	if !winner.Empty() && lot.IsPositive() {
+		finalPrice = sdk.NewDecFromInt(bid.Amount).QuoInt(lot.Amount)
+	}
+	if oraclePrice.IsNil() {
+		oraclePrice = sdk.ZeroDec()
+	}
+	discount := sdk.ZeroDec()
+	if oraclePrice.IsPositive() && finalPrice.IsPositive() {
+		discount = sdk.OneDec().Sub(finalPrice.Quo(oraclePrice))
+	}
+
+	return ClosedAuction{
+		AuctionID:   auctionID,
+		AuctionType: auctionType,
+		Initiator:   initiator,
+		Winner:      winner,
+		Lot:         lot,
+		Bid:         bid,
+		FinalPrice:  finalPrice,
+		OraclePrice: oraclePrice,
+		Discount:    discount,
+		CloseTime:   closeTime,
+		CloseHeight: closeHeight,
+	}
+}
+
+// Validate performs a basic validation of the closed auction fields.
+func (c ClosedAuction) Validate() error {
+	if c.AuctionID == 0 {
+		return errors.New("closed auction id cannot be 0")
+	}
+	if c.AuctionType == "" {
+		return errors.New("closed auction type cannot be empty")
+	}
+	if !c.Lot.IsValid() {
+		return fmt.Errorf("invalid closed auction lot: %s", c.Lot)
+	}
+	if !c.Bid.IsValid() {
+		return fmt.Errorf("invalid closed auction bid: %s", c.Bid)
+	}
+	if c.FinalPrice.IsNil() || c.FinalPrice.IsNegative() {
+		return fmt.Errorf("closed auction final price cannot be negative: %s", c.FinalPrice)
+	}
+	if c.OraclePrice.IsNil() || c.OraclePrice.IsNegative() {
+		return fmt.Errorf("closed auction oracle price cannot be negative: %s", c.OraclePrice)
+	}
+	if c.Discount.IsNil() {
+		return errors.New("closed auction discount cannot be nil")
+	}
+	if c.CloseTime.Unix() <= 0 {
+		return errors.New("closed auction close time cannot be zero")
+	}
+	return nil
+}
+
+// ClosedAuctions a collection of ClosedAuction objects
+type ClosedAuctions []ClosedAuction
+
+// Validate validates each closed auction and checks that auction IDs are unique
+func (cs ClosedAuctions) Validate() error {
+	seen := make(map[uint64]bool)
+	for _, c := range cs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if seen[c.AuctionID] {
+			return fmt.Errorf("duplicate closed auction record %d", c.AuctionID)
+		}
+		seen[c.AuctionID] = true
+	}
+	return nil
+}
+
+// NewBidderStats returns empty statistics for a bidder.
+func NewBidderStats(bidder sdk.AccAddress) BidderStats {
+	return BidderStats{
+		Bidder:       bidder,
+		BidCount:     0,
+		AuctionsWon:  0,
+		TotalLotWon:  sdk.NewCoins(),
+		TotalBidPaid: sdk.NewCoins(),
+	}
+}
+
+// Validate performs a basic validation of the bidder statistics fields.
+func (s BidderStats) Validate() error {
+	if s.Bidder.Empty() {
+		return errors.New("bidder stats bidder cannot be empty")
+	}
+	if err := s.TotalLotWon.Validate(); err != nil {
+		return fmt.Errorf("invalid total lot won: %w", err)
+	}
+	if err := s.TotalBidPaid.Validate(); err != nil {
+		return fmt.Errorf("invalid total bid paid: %w", err)
+	}
+	return nil
+}
+
+// BidderStatsList a collection of BidderStats objects
+type BidderStatsList []BidderStats
+
+// Validate validates the statistics of each bidder and checks that bidders are unique
+func (ss BidderStatsList) Validate() error {
+	seen := make(map[string]bool)
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if seen[s.Bidder.String()] {
+			return fmt.Errorf("duplicate stats for bidder %s", s.Bidder)
+		}
+		seen[s.Bidder.String()] = true
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewClosedAuction(t *testing.T) {
+	closeTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	winner := sdk.AccAddress("test winner")
+
+	testCases := []struct {
+		name             string
+		winner           sdk.AccAddress
+		lot              sdk.Coin
+		bid              sdk.Coin
+		oraclePrice      sdk.Dec
+		expectFinalPrice sdk.Dec
+		expectDiscount   sdk.Dec
+	}{
+		{
+			"discount to oracle price",
+			winner,
+			c("btc", 10),
+			c("usdx", 80),
+			d("10"),
+			d("8"),
+			d("0.2"),
+		},
+		{
+			"premium to oracle price",
+			winner,
+			c("btc", 10),
+			c("usdx", 110),
+			d("10"),
+			d("11"),
+			d("-0.1"),
+		},
+		{
+			"unknown oracle price",
+			winner,
+			c("btc", 10),
+			c("usdx", 80),
+			sdk.Dec{},
+			d("8"),
+			d("0"),
+		},
+		{
+			"no winner",
+			nil,
+			c("btc", 10),
+			c("usdx", 0),
+			d("10"),
+			d("0"),
+			d("0"),
+		},
+		{
+			"no lot sold",
+			winner,
+			c("btc", 0),
+			c("usdx", 0),
+			d("10"),
+			d("0"),
+			d("0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			closedAuction := NewClosedAuction(1, DutchAuctionType, "seller", tc.winner, tc.lot, tc.bid, tc.oraclePrice, closeTime, 1)
+
+			require.NoError(t, closedAuction.Validate())
+			require.Equal(t, tc.expectFinalPrice, closedAuction.FinalPrice)
+			require.Equal(t, tc.expectDiscount, closedAuction.Discount)
+		})
+	}
+}
+
+func TestBidRecordsValidate(t *testing.T) {
+	blockTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	bidder := sdk.AccAddress("test bidder")
+	record := NewBidRecord(1, 1, bidder, c("usdx", 10), c("btc", 1), blockTime, 1)
+
+	require.NoError(t, BidRecords{record, NewBidRecord(1, 2, bidder, c("usdx", 20), c("btc", 1), blockTime, 1)}.Validate())
+	require.NoError(t, BidRecords{record, NewBidRecord(2, 1, bidder, c("usdx", 20), c("btc", 1), blockTime, 1)}.Validate())
+	require.Error(t, BidRecords{record, record}.Validate())
+	require.Error(t, BidRecords{NewBidRecord(0, 1, bidder, c("usdx", 10), c("btc", 1), blockTime, 1)}.Validate())
+	require.Error(t, BidRecords{NewBidRecord(1, 1, nil, c("usdx", 10), c("btc", 1), blockTime, 1)}.Validate())
+}
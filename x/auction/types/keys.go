@@ -27,6 +27,14 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	SealedBidKeyPrefix               = []byte{0x03} // prefix for keys that store sealed bids
+	AuctionBySealedBidPhaseKeyPrefix = []byte{0x04} // prefix for keys that are part of the auctionsBySealedBidPhase index
+
+	BidRecordKeyPrefix           = []byte{0x05} // prefix for keys that store the bid history of auctions
+	ClosedAuctionKeyPrefix       = []byte{0x06} // prefix for keys that store closed auction records
+	ClosedAuctionByTimeKeyPrefix = []byte{0x07} // prefix for keys that are part of the closedAuctionsByTime index
+	BidderStatsKeyPrefix         = []byte{0x08} // prefix for keys that store bidder statistics
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -39,6 +47,31 @@ func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetSealedBidKey returns the key of a sealed bid, ordered by auction
+func GetSealedBidKey(auctionID uint64, bidder sdk.AccAddress) []byte {
+	return append(Uint64ToBytes(auctionID), bidder...)
+}
+
+// GetAuctionBySealedBidPhasePrefix returns the prefix for iterating sealed-bid auctions in a phase
+func GetAuctionBySealedBidPhasePrefix(phase SealedBidPhase) []byte {
+	return []byte{byte(phase)}
+}
+
+// GetAuctionBySealedBidPhaseKey returns the key for iterating sealed-bid auctions by phase
+func GetAuctionBySealedBidPhaseKey(phase SealedBidPhase, auctionID uint64) []byte {
+	return append(GetAuctionBySealedBidPhasePrefix(phase), Uint64ToBytes(auctionID)...)
+}
+
+// GetBidRecordKey returns the key of a bid record, ordered by auction and then by sequence
+func GetBidRecordKey(auctionID, sequence uint64) []byte {
+	return append(Uint64ToBytes(auctionID), Uint64ToBytes(sequence)...)
+}
+
+// GetClosedAuctionByTimeKey returns the key for iterating closed auction records by close time
+func GetClosedAuctionByTimeKey(closeTime time.Time, auctionID uint64) []byte {
+	return append(sdk.FormatTimeBytes(closeTime), Uint64ToBytes(auctionID)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"errors"
 
 	errorsmod "cosmossdk.io/errors"
@@ -9,7 +10,21 @@ import (
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPlaceBid{}
+var (
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgBuyCollateral{}
+	_ sdk.Msg = &MsgCommitBid{}
+	_ sdk.Msg = &MsgRevealBid{}
+	_ sdk.Msg = &MsgPlaceBids{}
+	_ sdk.Msg = &MsgMergeAuctions{}
+)
+
+const (
+	// MaxBatchBids is the maximum number of bids that can be placed in a MsgPlaceBids
+	MaxBatchBids = 100
+	// MaxMergeAuctions is the maximum number of auctions that can be merged in a MsgMergeAuctions
+	MaxMergeAuctions = 100
+)
 
 // NewMsgPlaceBid returns a new MsgPlaceBid.
 func NewMsgPlaceBid(auctionID uint64, bidder string, amt sdk.Coin) MsgPlaceBid {
@@ -55,3 +70,271 @@ func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{bidder}
 }
+
+// NewMsgBuyCollateral returns a new MsgBuyCollateral.
+func NewMsgBuyCollateral(auctionID uint64, buyer string, amt sdk.Coin, maxPrice sdk.Dec) MsgBuyCollateral {
+	return MsgBuyCollateral{
+		AuctionId: auctionID,
+		Buyer:     buyer,
+		Amount:    amt,
+		MaxPrice:  maxPrice,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgBuyCollateral) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgBuyCollateral) Type() string { return "buy_collateral" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgBuyCollateral) ValidateBasic() error {
+	if msg.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "buyer address cannot be empty or invalid")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "buy amount %s", msg.Amount)
+	}
+	if msg.MaxPrice.IsNil() || !msg.MaxPrice.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max price must be positive: %s", msg.MaxPrice)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgBuyCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgBuyCollateral) GetSigners() []sdk.AccAddress {
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{buyer}
+}
+
+// NewMsgCommitBid returns a new MsgCommitBid.
+func NewMsgCommitBid(auctionID uint64, bidder string, bidHash []byte, deposit sdk.Coin) MsgCommitBid {
+	return MsgCommitBid{
+		AuctionId: auctionID,
+		Bidder:    bidder,
+		BidHash:   bidHash,
+		Deposit:   deposit,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCommitBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCommitBid) Type() string { return "commit_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgCommitBid) ValidateBasic() error {
+	if msg.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty or invalid")
+	}
+	if len(msg.BidHash) != sha256.Size {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bid hash must be %d bytes, is %d", sha256.Size, len(msg.BidHash))
+	}
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit %s", msg.Deposit)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCommitBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
+
+// NewMsgRevealBid returns a new MsgRevealBid.
+func NewMsgRevealBid(auctionID uint64, bidder string, amt sdk.Coin, salt string) MsgRevealBid {
+	return MsgRevealBid{
+		AuctionId: auctionID,
+		Bidder:    bidder,
+		Amount:    amt,
+		Salt:      salt,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevealBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevealBid) Type() string { return "reveal_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgRevealBid) ValidateBasic() error {
+	if msg.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty or invalid")
+	}
+	if !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", msg.Amount)
+	}
+	if len(msg.Salt) > MaxSaltLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "salt cannot be longer than %d characters", MaxSaltLength)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
+
+// NewBidEntry returns a new BidEntry.
+func NewBidEntry(auctionID uint64, amt sdk.Coin) BidEntry {
+	return BidEntry{
+		AuctionId: auctionID,
+		Amount:    amt,
+	}
+}
+
+// NewMsgPlaceBids returns a new MsgPlaceBids.
+func NewMsgPlaceBids(bidder string, bids []BidEntry, maxTotalSpend sdk.Coins) MsgPlaceBids {
+	return MsgPlaceBids{
+		Bidder:        bidder,
+		Bids:          bids,
+		MaxTotalSpend: maxTotalSpend,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceBids) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceBids) Type() string { return "place_bids" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlaceBids) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty or invalid")
+	}
+	if len(msg.Bids) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "bids cannot be empty")
+	}
+	if len(msg.Bids) > MaxBatchBids {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot place more than %d bids", MaxBatchBids)
+	}
+	seen := make(map[uint64]bool)
+	for _, bid := range msg.Bids {
+		if bid.AuctionId == 0 {
+			return errors.New("auction id cannot be zero")
+		}
+		if seen[bid.AuctionId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate bid on auction %d", bid.AuctionId)
+		}
+		seen[bid.AuctionId] = true
+		if !bid.Amount.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", bid.Amount)
+		}
+	}
+	if msg.MaxTotalSpend.Empty() || !msg.MaxTotalSpend.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "max total spend %s", msg.MaxTotalSpend)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceBids) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceBids) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
+
+// NewMsgMergeAuctions returns a new MsgMergeAuctions.
+func NewMsgMergeAuctions(sender string, auctionIDs []uint64) MsgMergeAuctions {
+	return MsgMergeAuctions{
+		Sender:     sender,
+		AuctionIds: auctionIDs,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgMergeAuctions) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgMergeAuctions) Type() string { return "merge_auctions" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgMergeAuctions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if len(msg.AuctionIds) < 2 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least two auctions must be merged")
+	}
+	if len(msg.AuctionIds) > MaxMergeAuctions {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot merge more than %d auctions", MaxMergeAuctions)
+	}
+	if msg.AuctionIds[0] == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	for i := 1; i < len(msg.AuctionIds); i++ {
+		if msg.AuctionIds[i] != msg.AuctionIds[i-1]+1 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "auction ids must be consecutive and in ascending order")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgMergeAuctions) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgMergeAuctions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
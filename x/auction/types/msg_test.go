@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -50,3 +51,245 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgBuyCollateral_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        MsgBuyCollateral
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgBuyCollateral(1, testAccAddress1, c("token", 10), d("1.5")),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgBuyCollateral(0, testAccAddress1, c("token", 10), d("1.5")),
+			false,
+		},
+		{
+			"empty address ",
+			NewMsgBuyCollateral(1, "", c("token", 10), d("1.5")),
+			false,
+		},
+		{
+			"zero amount",
+			NewMsgBuyCollateral(1, testAccAddress1, c("token", 0), d("1.5")),
+			false,
+		},
+		{
+			"zero max price",
+			NewMsgBuyCollateral(1, testAccAddress1, c("token", 10), sdk.ZeroDec()),
+			false,
+		},
+		{
+			"nil max price",
+			NewMsgBuyCollateral(1, testAccAddress1, c("token", 10), sdk.Dec{}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestMsgCommitBid_ValidateBasic(t *testing.T) {
+	bidHash := SealedBidHash(1, sdk.AccAddress("test bidder"), c("token", 10), "salt")
+
+	tests := []struct {
+		name       string
+		msg        MsgCommitBid
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgCommitBid(1, testAccAddress1, bidHash, c("token", 10)),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgCommitBid(0, testAccAddress1, bidHash, c("token", 10)),
+			false,
+		},
+		{
+			"empty address ",
+			NewMsgCommitBid(1, "", bidHash, c("token", 10)),
+			false,
+		},
+		{
+			"invalid bid hash",
+			NewMsgCommitBid(1, testAccAddress1, bidHash[:16], c("token", 10)),
+			false,
+		},
+		{
+			"zero deposit",
+			NewMsgCommitBid(1, testAccAddress1, bidHash, c("token", 0)),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestMsgRevealBid_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        MsgRevealBid
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgRevealBid(1, testAccAddress1, c("token", 10), "salt"),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgRevealBid(0, testAccAddress1, c("token", 10), "salt"),
+			false,
+		},
+		{
+			"empty address ",
+			NewMsgRevealBid(1, "", c("token", 10), "salt"),
+			false,
+		},
+		{
+			"negative amount",
+			NewMsgRevealBid(1, testAccAddress1, sdk.Coin{Denom: "token", Amount: sdkmath.NewInt(-10)}, "salt"),
+			false,
+		},
+		{
+			"zero amount",
+			NewMsgRevealBid(1, testAccAddress1, c("token", 0), "salt"),
+			true,
+		},
+		{
+			"salt too long",
+			NewMsgRevealBid(1, testAccAddress1, c("token", 10), strings.Repeat("a", MaxSaltLength+1)),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestMsgPlaceBids_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        MsgPlaceBids
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgPlaceBids(testAccAddress1, []BidEntry{NewBidEntry(1, c("token", 10)), NewBidEntry(2, c("token", 20))}, sdk.NewCoins(c("token", 30))),
+			true,
+		},
+		{
+			"empty address ",
+			NewMsgPlaceBids("", []BidEntry{NewBidEntry(1, c("token", 10))}, sdk.NewCoins(c("token", 30))),
+			false,
+		},
+		{
+			"no bids",
+			NewMsgPlaceBids(testAccAddress1, nil, sdk.NewCoins(c("token", 30))),
+			false,
+		},
+		{
+			"too many bids",
+			NewMsgPlaceBids(testAccAddress1, make([]BidEntry, MaxBatchBids+1), sdk.NewCoins(c("token", 30))),
+			false,
+		},
+		{
+			"zero id",
+			NewMsgPlaceBids(testAccAddress1, []BidEntry{NewBidEntry(0, c("token", 10))}, sdk.NewCoins(c("token", 30))),
+			false,
+		},
+		{
+			"duplicate id",
+			NewMsgPlaceBids(testAccAddress1, []BidEntry{NewBidEntry(1, c("token", 10)), NewBidEntry(1, c("token", 20))}, sdk.NewCoins(c("token", 30))),
+			false,
+		},
+		{
+			"negative amount",
+			NewMsgPlaceBids(testAccAddress1, []BidEntry{NewBidEntry(1, sdk.Coin{Denom: "token", Amount: sdkmath.NewInt(-10)})}, sdk.NewCoins(c("token", 30))),
+			false,
+		},
+		{
+			"empty max total spend",
+			NewMsgPlaceBids(testAccAddress1, []BidEntry{NewBidEntry(1, c("token", 10))}, nil),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestMsgMergeAuctions_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        MsgMergeAuctions
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgMergeAuctions(testAccAddress1, []uint64{1, 2, 3}),
+			true,
+		},
+		{
+			"empty address ",
+			NewMsgMergeAuctions("", []uint64{1, 2}),
+			false,
+		},
+		{
+			"single auction",
+			NewMsgMergeAuctions(testAccAddress1, []uint64{1}),
+			false,
+		},
+		{
+			"zero id",
+			NewMsgMergeAuctions(testAccAddress1, []uint64{0, 1}),
+			false,
+		},
+		{
+			"not consecutive",
+			NewMsgMergeAuctions(testAccAddress1, []uint64{1, 3}),
+			false,
+		},
+		{
+			"descending",
+			NewMsgMergeAuctions(testAccAddress1, []uint64{2, 1}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
@@ -19,18 +19,54 @@ const (
 	DefaultForwardBidDuration time.Duration = 24 * time.Hour
 	// DefaultReverseBidDuration how long an auction gets extended when someone bids for a reverse auction
 	DefaultReverseBidDuration time.Duration = 1 * time.Hour
+	// DefaultDutchAuctionDuration how long a dutch auction runs before closing
+	DefaultDutchAuctionDuration time.Duration = 6 * time.Hour
+	// DefaultDutchDecayCurve the curve dutch auction prices decay along
+	DefaultDutchDecayCurve = DECAY_CURVE_LINEAR
+	// DefaultDutchStepDuration how often the price of a dutch auction decreases on an exponential curve
+	DefaultDutchStepDuration time.Duration = 90 * time.Second
+	// DefaultSealedBidEnabled whether surplus and debt auctions are run as sealed-bid auctions
+	DefaultSealedBidEnabled = false
+	// DefaultSealedBidCommitDuration how long a sealed-bid auction accepts bid commitments
+	DefaultSealedBidCommitDuration time.Duration = 24 * time.Hour
+	// DefaultSealedBidRevealDuration how long a sealed-bid auction accepts bid reveals
+	DefaultSealedBidRevealDuration time.Duration = 6 * time.Hour
+	// DefaultBidHistoryEnabled whether bid history, closed auction records and bidder statistics are kept
+	DefaultBidHistoryEnabled = false
+	// DefaultMaxBidHistoryLength how many bids are kept per auction
+	DefaultMaxBidHistoryLength uint64 = 100
+	// DefaultHistoryRetentionDuration how long closed auction records and their bid history are kept
+	DefaultHistoryRetentionDuration time.Duration = 30 * 24 * time.Hour
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultDutchPricePremium is the premium over the market price that dutch auctions start at
+	DefaultDutchPricePremium sdk.Dec = sdk.MustNewDecFromStr("0.2")
+	// DefaultDutchStepDecay is the factor the dutch auction price is multiplied by at each step of an exponential curve
+	DefaultDutchStepDecay sdk.Dec = sdk.MustNewDecFromStr("0.99")
+	// DefaultMaxMergedLots is the largest lot a merged collateral auction can have for each lot denom, none can be merged
+	DefaultMaxMergedLots = sdk.Coins(nil)
 	// ParamStoreKeyParams Param store key for auction params
-	KeyForwardBidDuration  = []byte("ForwardBidDuration")
-	KeyReverseBidDuration  = []byte("ReverseBidDuration")
-	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
-	KeyIncrementSurplus    = []byte("IncrementSurplus")
-	KeyIncrementDebt       = []byte("IncrementDebt")
-	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyForwardBidDuration       = []byte("ForwardBidDuration")
+	KeyReverseBidDuration       = []byte("ReverseBidDuration")
+	KeyMaxAuctionDuration       = []byte("MaxAuctionDuration")
+	KeyIncrementSurplus         = []byte("IncrementSurplus")
+	KeyIncrementDebt            = []byte("IncrementDebt")
+	KeyIncrementCollateral      = []byte("IncrementCollateral")
+	KeyDutchAuctionDuration     = []byte("DutchAuctionDuration")
+	KeyDutchPricePremium        = []byte("DutchPricePremium")
+	KeyDutchDecayCurve          = []byte("DutchDecayCurve")
+	KeyDutchStepDuration        = []byte("DutchStepDuration")
+	KeyDutchStepDecay           = []byte("DutchStepDecay")
+	KeySealedBidEnabled         = []byte("SealedBidEnabled")
+	KeySealedBidCommitDuration  = []byte("SealedBidCommitDuration")
+	KeySealedBidRevealDuration  = []byte("SealedBidRevealDuration")
+	KeyBidHistoryEnabled        = []byte("BidHistoryEnabled")
+	KeyMaxBidHistoryLength      = []byte("MaxBidHistoryLength")
+	KeyHistoryRetentionDuration = []byte("HistoryRetentionDuration")
+	KeyMaxMergedLots            = []byte("MaxMergedLots")
 )
 
 // NewParams returns a new Params object.
@@ -39,14 +75,37 @@ func NewParams(
 	incrementSurplus,
 	incrementDebt,
 	incrementCollateral sdk.Dec,
+	dutchAuctionDuration time.Duration,
+	dutchPricePremium sdk.Dec,
+	dutchDecayCurve DecayCurve,
+	dutchStepDuration time.Duration,
+	dutchStepDecay sdk.Dec,
+	sealedBidEnabled bool,
+	sealedBidCommitDuration, sealedBidRevealDuration time.Duration,
+	bidHistoryEnabled bool,
+	maxBidHistoryLength uint64,
+	historyRetentionDuration time.Duration,
+	maxMergedLots sdk.Coins,
 ) Params {
 	return Params{
-		MaxAuctionDuration:  maxAuctionDuration,
-		ForwardBidDuration:  forwardBidDuration,
-		ReverseBidDuration:  reverseBidDuration,
-		IncrementSurplus:    incrementSurplus,
-		IncrementDebt:       incrementDebt,
-		IncrementCollateral: incrementCollateral,
+		MaxAuctionDuration:       maxAuctionDuration,
+		ForwardBidDuration:       forwardBidDuration,
+		ReverseBidDuration:       reverseBidDuration,
+		IncrementSurplus:         incrementSurplus,
+		IncrementDebt:            incrementDebt,
+		IncrementCollateral:      incrementCollateral,
+		DutchAuctionDuration:     dutchAuctionDuration,
+		DutchPricePremium:        dutchPricePremium,
+		DutchDecayCurve:          dutchDecayCurve,
+		DutchStepDuration:        dutchStepDuration,
+		DutchStepDecay:           dutchStepDecay,
+		SealedBidEnabled:         sealedBidEnabled,
+		SealedBidCommitDuration:  sealedBidCommitDuration,
+		SealedBidRevealDuration:  sealedBidRevealDuration,
+		BidHistoryEnabled:        bidHistoryEnabled,
+		MaxBidHistoryLength:      maxBidHistoryLength,
+		HistoryRetentionDuration: historyRetentionDuration,
+		MaxMergedLots:            maxMergedLots,
 	}
 }
 
@@ -59,6 +118,18 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultDutchAuctionDuration,
+		DefaultDutchPricePremium,
+		DefaultDutchDecayCurve,
+		DefaultDutchStepDuration,
+		DefaultDutchStepDecay,
+		DefaultSealedBidEnabled,
+		DefaultSealedBidCommitDuration,
+		DefaultSealedBidRevealDuration,
+		DefaultBidHistoryEnabled,
+		DefaultMaxBidHistoryLength,
+		DefaultHistoryRetentionDuration,
+		DefaultMaxMergedLots,
 	)
 }
 
@@ -76,6 +147,18 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		paramtypes.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		paramtypes.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		paramtypes.NewParamSetPair(KeyDutchAuctionDuration, &p.DutchAuctionDuration, validateDutchAuctionDurationParam),
+		paramtypes.NewParamSetPair(KeyDutchPricePremium, &p.DutchPricePremium, validateDutchPricePremiumParam),
+		paramtypes.NewParamSetPair(KeyDutchDecayCurve, &p.DutchDecayCurve, validateDutchDecayCurveParam),
+		paramtypes.NewParamSetPair(KeyDutchStepDuration, &p.DutchStepDuration, validateDutchStepDurationParam),
+		paramtypes.NewParamSetPair(KeyDutchStepDecay, &p.DutchStepDecay, validateDutchStepDecayParam),
+		paramtypes.NewParamSetPair(KeySealedBidEnabled, &p.SealedBidEnabled, validateSealedBidEnabledParam),
+		paramtypes.NewParamSetPair(KeySealedBidCommitDuration, &p.SealedBidCommitDuration, validateSealedBidDurationParam),
+		paramtypes.NewParamSetPair(KeySealedBidRevealDuration, &p.SealedBidRevealDuration, validateSealedBidDurationParam),
+		paramtypes.NewParamSetPair(KeyBidHistoryEnabled, &p.BidHistoryEnabled, validateBidHistoryEnabledParam),
+		paramtypes.NewParamSetPair(KeyMaxBidHistoryLength, &p.MaxBidHistoryLength, validateMaxBidHistoryLengthParam),
+		paramtypes.NewParamSetPair(KeyHistoryRetentionDuration, &p.HistoryRetentionDuration, validateHistoryRetentionDurationParam),
+		paramtypes.NewParamSetPair(KeyMaxMergedLots, &p.MaxMergedLots, validateMaxMergedLotsParam),
 	}
 }
 
@@ -109,7 +192,67 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	if err := validateDutchAuctionDurationParam(p.DutchAuctionDuration); err != nil {
+		return err
+	}
+
+	if err := validateDutchPricePremiumParam(p.DutchPricePremium); err != nil {
+		return err
+	}
+
+	if err := validateDutchDecayCurveParam(p.DutchDecayCurve); err != nil {
+		return err
+	}
+
+	if err := validateDutchStepDurationParam(p.DutchStepDuration); err != nil {
+		return err
+	}
+
+	if err := validateDutchStepDecayParam(p.DutchStepDecay); err != nil {
+		return err
+	}
+
+	if err := validateSealedBidEnabledParam(p.SealedBidEnabled); err != nil {
+		return err
+	}
+
+	if err := validateSealedBidDurationParam(p.SealedBidCommitDuration); err != nil {
+		return err
+	}
+
+	if err := validateSealedBidDurationParam(p.SealedBidRevealDuration); err != nil {
+		return err
+	}
+
+	if p.SealedBidEnabled && (p.SealedBidCommitDuration == 0 || p.SealedBidRevealDuration == 0) {
+		return errors.New("sealed bid commit and reveal durations must be positive when sealed bids are enabled")
+	}
+
+	if err := validateBidHistoryEnabledParam(p.BidHistoryEnabled); err != nil {
+		return err
+	}
+
+	if err := validateMaxBidHistoryLengthParam(p.MaxBidHistoryLength); err != nil {
+		return err
+	}
+
+	if err := validateHistoryRetentionDurationParam(p.HistoryRetentionDuration); err != nil {
+		return err
+	}
+
+	if p.BidHistoryEnabled && (p.MaxBidHistoryLength == 0 || p.HistoryRetentionDuration == 0) {
+		return errors.New("max bid history length and history retention duration must be positive when bid history is enabled")
+	}
+
+	if err := validateMaxMergedLotsParam(p.MaxMergedLots); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -188,3 +331,142 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateDutchAuctionDurationParam(i interface{}) error {
+	dutchAuctionDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchAuctionDuration <= 0 {
+		return fmt.Errorf("dutch auction duration must be positive %d", dutchAuctionDuration)
+	}
+
+	return nil
+}
+
+func validateDutchPricePremiumParam(i interface{}) error {
+	dutchPricePremium, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchPricePremium == emptyDec || dutchPricePremium.IsNil() {
+		return errors.New("dutch auction price premium cannot be nil or empty")
+	}
+
+	if dutchPricePremium.IsNegative() {
+		return fmt.Errorf("dutch auction price premium cannot be less than zero %s", dutchPricePremium)
+	}
+
+	return nil
+}
+
+func validateDutchDecayCurveParam(i interface{}) error {
+	dutchDecayCurve, ok := i.(DecayCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchDecayCurve != DECAY_CURVE_LINEAR && dutchDecayCurve != DECAY_CURVE_EXPONENTIAL {
+		return fmt.Errorf("invalid dutch auction decay curve %s", dutchDecayCurve)
+	}
+
+	return nil
+}
+
+func validateDutchStepDurationParam(i interface{}) error {
+	dutchStepDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchStepDuration <= 0 {
+		return fmt.Errorf("dutch auction step duration must be positive %d", dutchStepDuration)
+	}
+
+	return nil
+}
+
+func validateDutchStepDecayParam(i interface{}) error {
+	dutchStepDecay, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchStepDecay == emptyDec || dutchStepDecay.IsNil() {
+		return errors.New("dutch auction step decay cannot be nil or empty")
+	}
+
+	if !dutchStepDecay.IsPositive() || dutchStepDecay.GT(sdk.OneDec()) {
+		return fmt.Errorf("dutch auction step decay must be greater than zero and at most one %s", dutchStepDecay)
+	}
+
+	return nil
+}
+
+func validateSealedBidEnabledParam(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateSealedBidDurationParam(i interface{}) error {
+	sealedBidDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if sealedBidDuration < 0 {
+		return fmt.Errorf("sealed bid duration cannot be negative %d", sealedBidDuration)
+	}
+
+	return nil
+}
+
+func validateBidHistoryEnabledParam(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxBidHistoryLengthParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateHistoryRetentionDurationParam(i interface{}) error {
+	historyRetentionDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if historyRetentionDuration < 0 {
+		return fmt.Errorf("history retention duration cannot be negative %d", historyRetentionDuration)
+	}
+
+	return nil
+}
+
+func validateMaxMergedLotsParam(i interface{}) error {
+	maxMergedLots, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := maxMergedLots.Validate(); err != nil {
+		return fmt.Errorf("invalid max merged lots: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -114,6 +115,134 @@ func TestParams_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"unspecified decay curve",
+			func() Params {
+				p := DefaultParams()
+				p.DutchDecayCurve = DECAY_CURVE_UNSPECIFIED
+				return p
+			}(),
+			true,
+		},
+		{
+			"zero dutch auction duration",
+			func() Params {
+				p := DefaultParams()
+				p.DutchAuctionDuration = 0
+				return p
+			}(),
+			true,
+		},
+		{
+			"negative dutch price premium",
+			func() Params {
+				p := DefaultParams()
+				p.DutchPricePremium = d("-0.1")
+				return p
+			}(),
+			true,
+		},
+		{
+			"dutch step decay greater than one",
+			func() Params {
+				p := DefaultParams()
+				p.DutchStepDecay = d("1.01")
+				return p
+			}(),
+			true,
+		},
+		{
+			"zero dutch step decay",
+			func() Params {
+				p := DefaultParams()
+				p.DutchStepDecay = d("0")
+				return p
+			}(),
+			true,
+		},
+		{
+			"sealed bids enabled",
+			func() Params {
+				p := DefaultParams()
+				p.SealedBidEnabled = true
+				return p
+			}(),
+			false,
+		},
+		{
+			"negative sealed bid commit duration",
+			func() Params {
+				p := DefaultParams()
+				p.SealedBidCommitDuration = -1 * time.Hour
+				return p
+			}(),
+			true,
+		},
+		{
+			"zero sealed bid reveal duration when sealed bids disabled",
+			func() Params {
+				p := DefaultParams()
+				p.SealedBidRevealDuration = 0
+				return p
+			}(),
+			false,
+		},
+		{
+			"zero sealed bid reveal duration when sealed bids enabled",
+			func() Params {
+				p := DefaultParams()
+				p.SealedBidEnabled = true
+				p.SealedBidRevealDuration = 0
+				return p
+			}(),
+			true,
+		},
+		{
+			"bid history enabled",
+			func() Params {
+				p := DefaultParams()
+				p.BidHistoryEnabled = true
+				return p
+			}(),
+			false,
+		},
+		{
+			"negative history retention duration",
+			func() Params {
+				p := DefaultParams()
+				p.HistoryRetentionDuration = -1 * time.Hour
+				return p
+			}(),
+			true,
+		},
+		{
+			"zero max bid history length when bid history enabled",
+			func() Params {
+				p := DefaultParams()
+				p.BidHistoryEnabled = true
+				p.MaxBidHistoryLength = 0
+				return p
+			}(),
+			true,
+		},
+		{
+			"max merged lots",
+			func() Params {
+				p := DefaultParams()
+				p.MaxMergedLots = sdk.NewCoins(sdk.NewInt64Coin("btc", 100000000))
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid max merged lots",
+			func() Params {
+				p := DefaultParams()
+				p.MaxMergedLots = sdk.Coins{sdk.NewInt64Coin("btc", 0)}
+				return p
+			}(),
+			true,
+		},
 		{
 			"zero value",
 			Params{},
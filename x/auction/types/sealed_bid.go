@@ -0,0 +1,70 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSaltLength is the maximum length of the salt a sealed bid is hashed with.
+const MaxSaltLength = 128
+
+// NewSealedBid returns a new, unrevealed sealed bid.
+func NewSealedBid(auctionID uint64, bidder sdk.AccAddress, bidHash []byte, deposit sdk.Coin) SealedBid {
+	return SealedBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		BidHash:   bidHash,
+		Deposit:   deposit,
+		Revealed:  false,
+		Amount:    sdk.NewInt64Coin(deposit.Denom, 0),
+	}
+}
+
+// Validate performs a basic validation of the sealed bid fields.
+func (b SealedBid) Validate() error {
+	if b.AuctionID == 0 {
+		return errors.New("sealed bid auction id cannot be 0")
+	}
+	if b.Bidder.Empty() {
+		return errors.New("sealed bid bidder cannot be empty")
+	}
+	if len(b.BidHash) != sha256.Size {
+		return fmt.Errorf("sealed bid hash must be %d bytes, is %d", sha256.Size, len(b.BidHash))
+	}
+	if !b.Deposit.IsValid() || !b.Deposit.IsPositive() {
+		return fmt.Errorf("sealed bid deposit must be positive: %s", b.Deposit)
+	}
+	if !b.Amount.IsValid() {
+		return fmt.Errorf("invalid sealed bid amount: %s", b.Amount)
+	}
+	return nil
+}
+
+// SealedBids a collection of SealedBid objects
+type SealedBids []SealedBid
+
+// Validate validates each sealed bid and checks that each bidder has at most one sealed bid per auction
+func (bs SealedBids) Validate() error {
+	seen := make(map[string]bool)
+	for _, b := range bs {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d:%s", b.AuctionID, b.Bidder)
+		if seen[key] {
+			return fmt.Errorf("duplicate sealed bid from %s on auction %d", b.Bidder, b.AuctionID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// SealedBidHash returns the hash a bidder commits to when placing a sealed bid.
+// The amount is the bid for surplus auctions or the lot for debt auctions, and the salt is a secret chosen by the bidder.
+func SealedBidHash(auctionID uint64, bidder sdk.AccAddress, amount sdk.Coin, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d|%s|%s|%s", auctionID, bidder, amount, salt)))
+	return hash[:]
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSealedBidHash(t *testing.T) {
+	bidder := sdk.AccAddress("test bidder")
+	hash := SealedBidHash(1, bidder, c("ukava", 100), "salt")
+
+	require.Len(t, hash, 32)
+	require.Equal(t, hash, SealedBidHash(1, bidder, c("ukava", 100), "salt"))
+	require.NotEqual(t, hash, SealedBidHash(2, bidder, c("ukava", 100), "salt"))
+	require.NotEqual(t, hash, SealedBidHash(1, sdk.AccAddress("other bidder"), c("ukava", 100), "salt"))
+	require.NotEqual(t, hash, SealedBidHash(1, bidder, c("ukava", 101), "salt"))
+	require.NotEqual(t, hash, SealedBidHash(1, bidder, c("ukava", 100), "pepper"))
+}
+
+func TestSealedBidsValidate(t *testing.T) {
+	bidder := sdk.AccAddress("test bidder")
+	validBid := NewSealedBid(1, bidder, SealedBidHash(1, bidder, c("ukava", 100), "salt"), c("ukava", 100))
+
+	tests := []struct {
+		name       string
+		sealedBids SealedBids
+		expectPass bool
+	}{
+		{
+			"valid",
+			SealedBids{validBid},
+			true,
+		},
+		{
+			"valid revealed",
+			SealedBids{func() SealedBid {
+				b := validBid
+				b.Revealed = true
+				b.Amount = c("ukava", 100)
+				return b
+			}()},
+			true,
+		},
+		{
+			"zero auction id",
+			SealedBids{NewSealedBid(0, bidder, validBid.BidHash, c("ukava", 100))},
+			false,
+		},
+		{
+			"empty bidder",
+			SealedBids{NewSealedBid(1, nil, validBid.BidHash, c("ukava", 100))},
+			false,
+		},
+		{
+			"invalid bid hash",
+			SealedBids{NewSealedBid(1, bidder, []byte("hash"), c("ukava", 100))},
+			false,
+		},
+		{
+			"zero deposit",
+			SealedBids{NewSealedBid(1, bidder, validBid.BidHash, c("ukava", 0))},
+			false,
+		},
+		{
+			"duplicate bidder",
+			SealedBids{validBid, validBid},
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sealedBids.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -19,11 +19,27 @@ import (
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// after global settlement prices are frozen and no interest accrues, cdps are not liquidated and no surplus or
+	// debt auctions are started. Cdps are settled in batches until the settlement is complete, and debt asset received
+	// from auctions still running at settlement is burned.
+	if k.IsGloballySettled(ctx) {
+		if err := k.ContinueGlobalSettlement(ctx); err != nil {
+			panic(err)
+		}
+		if err := k.BurnSettlementSurplusAndDebt(ctx); err != nil {
+			panic(err)
+		}
+		return
+	}
+
 	params := k.GetParams(ctx)
 
 	// only run CDP liquidations every `LiquidationBlockInterval` blocks
 	skipSyncronizeAndLiquidations := ctx.BlockHeight()%params.LiquidationBlockInterval != 0
 
+	// cdp triggers of all collateral types share the gas limit of the block
+	triggerGasMeter := sdk.NewGasMeter(params.TriggerGasLimit)
+
 	for _, cp := range params.CollateralParams {
 		ok := k.UpdatePricefeedStatus(ctx, cp.SpotMarketID)
 		if !ok {
@@ -47,6 +63,9 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 
 		ctx.Logger().Debug(fmt.Sprintf("running x/cdp SynchronizeInterestForRiskyCDPs and LiquidateCdps for %s", cp.Type))
 
+		// triggers run before liquidations so owners can restore the collateralization of their cdps
+		k.RunCdpTriggers(ctx, cp, triggerGasMeter)
+
 		err = k.SynchronizeInterestForRiskyCDPs(ctx, sdk.MaxSortableDec, cp)
 		if err != nil {
 			panic(err)
@@ -56,9 +75,31 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
 			panic(err)
 		}
+
+	}
+
+	// multi-collateral cdps are checked once the prices and interest of every collateral type have been updated
+	if !skipSyncronizeAndLiquidations {
+		refreshCount := sdk.ZeroInt()
+		for _, cp := range params.CollateralParams {
+			refreshCount = refreshCount.Add(cp.CheckCollateralizationIndexCount)
+		}
+		k.RefreshMultiCollateralHealthIndex(ctx, refreshCount)
+
+		for _, cp := range params.CollateralParams {
+			err := k.LiquidateMultiCollateralCdps(ctx, cp.Type, cp.CheckCollateralizationIndexCount)
+			if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
+				panic(err)
+			}
+		}
+	}
+
+	err := k.DistributeSavingsRate(ctx)
+	if err != nil {
+		panic(err)
 	}
 
-	err := k.RunSurplusAndDebtAuctions(ctx)
+	err = k.RunSurplusAndDebtAuctions(ctx)
 	if err != nil {
 		panic(err)
 	}
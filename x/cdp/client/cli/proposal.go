@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// GetCmdSubmitGlobalSettlementProposal implements the command to submit a global settlement proposal
+func GetCmdSubmitGlobalSettlementProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cdp-global-settlement [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to globally settle all cdps and allow USDX to be redeemed for collateral",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a cdp global settlement proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Markets without a final price are settled at the current
+pricefeed price.
+Example:
+$ %s tx gov submit-proposal cdp-global-settlement <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "CDP Global Settlement",
+  "description": "Shut down the cdp system and settle USDX against its collateral",
+  "final_prices": [
+    {
+      "market_id": "bnb:usd:30",
+      "price": "17.250000000000000000"
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "uist",
+      "amount": "1000000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseGlobalSettlementProposalJSON(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewGlobalSettlementProposal(proposal.Title, proposal.Description, proposal.FinalPrices)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ParseGlobalSettlementProposalJSON reads and parses a GlobalSettlementProposalJSON from a file.
+func ParseGlobalSettlementProposalJSON(cdc codec.JSONCodec, proposalFile string) (types.GlobalSettlementProposalJSON, error) {
+	proposal := types.GlobalSettlementProposalJSON{}
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
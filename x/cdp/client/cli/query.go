@@ -38,6 +38,11 @@ func GetQueryCmd() *cobra.Command {
 		QueryCdpDepositsCmd(),
 		QueryParamsCmd(),
 		QueryGetAccounts(),
+		QueryMultiCollateralCdpCmd(),
+		QuerySavingsRateCmd(),
+		QueryGlobalSettlementCmd(),
+		QueryCdpManagersCmd(),
+		QueryCdpTriggersCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -270,3 +275,150 @@ func QueryGetAccounts() *cobra.Command {
 		},
 	}
 }
+
+// QueryMultiCollateralCdpCmd returns the command handler for querying the multi-collateral cdp of an owner
+func QueryMultiCollateralCdpCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "multi-collateral-cdp [owner-addr]",
+		Short: "get info about a multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the multi-collateral CDP of an owner address and its health factor.
+
+Example:
+$ %s query %s multi-collateral-cdp kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MultiCollateralCdp(context.Background(), &types.QueryMultiCollateralCdpRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QuerySavingsRateCmd returns the command handler for querying the savings rate
+func QuerySavingsRateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings-rate",
+		Short: "get the savings rate",
+		Long:  "get the share of stability fees distributed to savings depositors and the fees pending distribution.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SavingsRate(context.Background(), &types.QuerySavingsRateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryGlobalSettlementCmd returns the command handler for querying the global settlement
+func QueryGlobalSettlementCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "global-settlement",
+		Short: "get the global settlement",
+		Long:  "get whether the cdp system has been globally settled, the final prices, and the collateral available for usdx redemption.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GlobalSettlement(context.Background(), &types.QueryGlobalSettlementRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryCdpManagersCmd returns the command handler for querying the managers of a cdp
+func QueryCdpManagersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "managers [cdp-id]",
+		Short: "get the accounts authorized to manage a cdp",
+		Long:  "get the accounts authorized to operate a cdp on behalf of its owner, with their scopes and expiry.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdpID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CdpManagers(context.Background(), &types.QueryCdpManagersRequest{CdpId: cdpID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryCdpTriggersCmd returns the command handler for querying the triggers of a cdp
+func QueryCdpTriggersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "triggers [cdp-id]",
+		Short: "get the triggers registered for a cdp",
+		Long:  "get the actions run at begin block when the collateralization ratio of a cdp falls below their trigger ratio.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdpID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CdpTriggers(context.Background(), &types.QueryCdpTriggersRequest{CdpId: cdpID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
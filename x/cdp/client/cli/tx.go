@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -15,6 +17,8 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
+const flagAsManager = "as-manager"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cdpTxCmd := &cobra.Command{
@@ -29,6 +33,16 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDraw(),
 		GetCmdRepay(),
 		GetCmdLiquidate(),
+		GetCmdCreateMultiCollateralCdp(),
+		GetCmdDepositMultiCollateral(),
+		GetCmdWithdrawMultiCollateral(),
+		GetCmdDrawMultiCollateral(),
+		GetCmdRepayMultiCollateral(),
+		GetCmdRedeemUSDX(),
+		GetCmdGrantCdpManager(),
+		GetCmdRevokeCdpManager(),
+		GetCmdSetCdpTrigger(),
+		GetCmdRemoveCdpTrigger(),
 	}
 
 	for _, cmd := range cmds {
@@ -78,7 +92,7 @@ $ %s tx %s create 10000000uatom 1000usdx atom-a --from myKeyName
 
 // GetCmdDeposit cli command for depositing to a cdp.
 func GetCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [owner-addr] [collateral] [collateral-type]",
 		Short: "deposit collateral to an existing cdp",
 		Long: strings.TrimSpace(
@@ -103,6 +117,10 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 				return err
 			}
 			msg := types.NewMsgDeposit(owner, clientCtx.GetFromAddress(), collateral, args[2])
+			if asManager, _ := cmd.Flags().GetBool(flagAsManager); asManager {
+				msg.Depositor = msg.Owner
+				msg.Manager = clientCtx.GetFromAddress().String()
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -110,11 +128,15 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Bool(flagAsManager, false, "sign as an authorized cdp manager, operating the collateral of the owner")
+
+	return cmd
 }
 
 // GetCmdWithdraw cli command for withdrawing from a cdp.
 func GetCmdWithdraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "withdraw [owner-addr] [collateral] [collateral-type]",
 		Short: "withdraw collateral from an existing cdp",
 		Long: strings.TrimSpace(
@@ -139,6 +161,10 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom at
 				return err
 			}
 			msg := types.NewMsgWithdraw(owner, clientCtx.GetFromAddress(), collateral, args[2])
+			if asManager, _ := cmd.Flags().GetBool(flagAsManager); asManager {
+				msg.Depositor = msg.Owner
+				msg.Manager = clientCtx.GetFromAddress().String()
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -146,11 +172,15 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom at
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Bool(flagAsManager, false, "sign as an authorized cdp manager, operating the collateral of the owner")
+
+	return cmd
 }
 
 // GetCmdDraw cli command for depositing to a cdp.
 func GetCmdDraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "draw [collateral-type] [debt]",
 		Short: "draw debt off an existing cdp",
 		Long: strings.TrimSpace(
@@ -171,6 +201,10 @@ $ %s tx %s draw atom-a 1000usdx --from myKeyName
 				return err
 			}
 			msg := types.NewMsgDrawDebt(clientCtx.GetFromAddress(), args[0], debt)
+			if owner, _ := cmd.Flags().GetString(flagOwner); owner != "" {
+				msg.Sender = owner
+				msg.Manager = clientCtx.GetFromAddress().String()
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -178,11 +212,15 @@ $ %s tx %s draw atom-a 1000usdx --from myKeyName
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(flagOwner, "", "owner of the cdp, to sign as an authorized cdp manager")
+
+	return cmd
 }
 
 // GetCmdRepay cli command for depositing to a cdp.
 func GetCmdRepay() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "repay [collateral-name] [debt]",
 		Short: "repay debt to an existing cdp",
 		Long: strings.TrimSpace(
@@ -203,6 +241,10 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 				return err
 			}
 			msg := types.NewMsgRepayDebt(clientCtx.GetFromAddress(), args[0], payment)
+			if owner, _ := cmd.Flags().GetString(flagOwner); owner != "" {
+				msg.Sender = owner
+				msg.Manager = clientCtx.GetFromAddress().String()
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -210,6 +252,10 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(flagOwner, "", "owner of the cdp, to sign as an authorized cdp manager")
+
+	return cmd
 }
 
 // GetCmdLiquidate cli command for liquidating a cdp.
@@ -243,3 +289,406 @@ $ %s tx %s liquidate kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm  btcb-a --from
 		},
 	}
 }
+
+// GetCmdCreateMultiCollateralCdp returns the command handler for creating a multi-collateral cdp
+func GetCmdCreateMultiCollateralCdp() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-multi [collateral] [debt] [debt-type]",
+		Short: "create a new multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new cdp backed by several collateral types, depositing the collateral and drawing some debt.
+Collateral is a comma separated list of collateral-type:amount pairs. The debt accrues interest at the stability fee of the debt type.
+
+Example:
+$ %s tx %s create-multi atom-a:10000000uatom,bnb-a:50000000bnb 1000usdx atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var collateral types.CollateralBalances
+			for _, pair := range strings.Split(args[0], ",") {
+				parts := strings.SplitN(pair, ":", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid collateral %s, expected collateral-type:amount", pair)
+				}
+				amount, err := sdk.ParseCoinNormalized(parts[1])
+				if err != nil {
+					return err
+				}
+				collateral = append(collateral, types.NewCollateralBalance(parts[0], amount))
+			}
+			debt, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCreateMultiCollateralCDP(clientCtx.GetFromAddress(), collateral, debt, args[2])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdDepositMultiCollateral cli command for depositing to a multi-collateral cdp.
+func GetCmdDepositMultiCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-multi [collateral] [collateral-type]",
+		Short: "deposit collateral to an existing multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add collateral of a collateral type to your multi-collateral cdp.
+
+Example:
+$ %s tx %s deposit-multi 10000000uatom atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDepositMultiCollateral(clientCtx.GetFromAddress(), collateral, args[1])
+			if owner, _ := cmd.Flags().GetString(flagOwner); owner != "" {
+				msg.Sender = owner
+				msg.Manager = clientCtx.GetFromAddress().String()
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "owner of the cdp, to sign as an authorized cdp manager")
+
+	return cmd
+}
+
+// GetCmdWithdrawMultiCollateral cli command for withdrawing from a multi-collateral cdp.
+func GetCmdWithdrawMultiCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-multi [collateral] [collateral-type]",
+		Short: "withdraw collateral from an existing multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove collateral of a collateral type from your multi-collateral cdp.
+
+Example:
+$ %s tx %s withdraw-multi 10000000uatom atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawMultiCollateral(clientCtx.GetFromAddress(), collateral, args[1])
+			if owner, _ := cmd.Flags().GetString(flagOwner); owner != "" {
+				msg.Sender = owner
+				msg.Manager = clientCtx.GetFromAddress().String()
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "owner of the cdp, to sign as an authorized cdp manager")
+
+	return cmd
+}
+
+// GetCmdDrawMultiCollateral cli command for drawing debt from a multi-collateral cdp.
+func GetCmdDrawMultiCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draw-multi [debt]",
+		Short: "draw debt off an existing multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create debt in your multi-collateral cdp and send the newly minted asset to your account.
+
+Example:
+$ %s tx %s draw-multi 1000usdx --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			debt, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDrawMultiCollateralDebt(clientCtx.GetFromAddress(), debt)
+			if owner, _ := cmd.Flags().GetString(flagOwner); owner != "" {
+				msg.Sender = owner
+				msg.Manager = clientCtx.GetFromAddress().String()
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "owner of the cdp, to sign as an authorized cdp manager")
+
+	return cmd
+}
+
+// GetCmdRepayMultiCollateral cli command for repaying debt to a multi-collateral cdp.
+func GetCmdRepayMultiCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repay-multi [debt]",
+		Short: "repay debt to an existing multi-collateral cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel out debt in your multi-collateral cdp. Repaying all debt returns the collateral and closes the cdp.
+
+Example:
+$ %s tx %s repay-multi 1000usdx --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payment, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRepayMultiCollateralDebt(clientCtx.GetFromAddress(), payment)
+			if owner, _ := cmd.Flags().GetString(flagOwner); owner != "" {
+				msg.Sender = owner
+				msg.Manager = clientCtx.GetFromAddress().String()
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "owner of the cdp, to sign as an authorized cdp manager")
+
+	return cmd
+}
+
+// GetCmdRedeemUSDX cli command for redeeming USDX for collateral after global settlement.
+func GetCmdRedeemUSDX() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-usdx [amount]",
+		Short: "redeem usdx for collateral after global settlement",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn usdx in exchange for a pro-rata share of the collateral held by the system after global settlement.
+
+Example:
+$ %s tx %s redeem-usdx 1000000usdx --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRedeemUSDX(clientCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdGrantCdpManager cli command for authorizing an account to manage a cdp.
+func GetCmdGrantCdpManager() *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant-manager [cdp-id] [manager-addr] [scope] [expiry]",
+		Short: "authorize an account to manage your cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorize an account to operate your cdp until the expiry, an RFC3339 time. Funds are always moved to and
+from your account. The scope is one of:
+  repay      repay debt
+  rebalance  repay debt, deposit and withdraw collateral
+  full       all operations, including drawing debt
+
+A manager signs cdp transactions on your behalf with the --owner or --as-manager flag.
+
+Example:
+$ %s tx %s grant-manager 1 kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw rebalance 2025-01-01T00:00:00Z --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdpID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			manager, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			scope, err := types.ParseCdpManagerScope(args[2])
+			if err != nil {
+				return err
+			}
+			expiry, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgGrantCdpManager(clientCtx.GetFromAddress(), manager, cdpID, scope, expiry)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdRevokeCdpManager cli command for revoking the authorization of a cdp manager.
+func GetCmdRevokeCdpManager() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-manager [cdp-id] [manager-addr]",
+		Short: "revoke the authorization of an account to manage your cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization of an account to operate your cdp.
+
+Example:
+$ %s tx %s revoke-manager 1 kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdpID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			manager, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRevokeCdpManager(clientCtx.GetFromAddress(), manager, cdpID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdSetCdpTrigger cli command for registering a trigger for a cdp.
+func GetCmdSetCdpTrigger() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-trigger [collateral-type] [action] [trigger-ratio] [target-ratio] [max-amount]",
+		Short: "register an action run when your cdp nears liquidation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register an action run at begin block, before liquidations, when the collateralization ratio of your cdp
+falls below the trigger ratio. The action restores the ratio to the target ratio, spending at most the max amount each
+time it runs. A trigger replaces any existing trigger of your cdp with the same action. The action is one of:
+  repay-from-savings   repay debt from your savings deposit, max amount is in the debt denom
+  deposit-from-wallet  deposit collateral from your account, max amount is in the collateral denom
+
+Example:
+$ %s tx %s set-trigger atom-a deposit-from-wallet 1.6 2.0 100000000uatom --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			action, err := types.ParseCdpTriggerAction(args[1])
+			if err != nil {
+				return err
+			}
+			triggerRatio, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+			targetRatio, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+			maxAmount, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetCdpTrigger(clientCtx.GetFromAddress(), args[0], action, triggerRatio, targetRatio, maxAmount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdRemoveCdpTrigger cli command for removing a trigger from a cdp.
+func GetCmdRemoveCdpTrigger() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-trigger [collateral-type] [action]",
+		Short: "remove a trigger from your cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the trigger with the action, repay-from-savings or deposit-from-wallet, from your cdp.
+
+Example:
+$ %s tx %s remove-trigger atom-a deposit-from-wallet --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			action, err := types.ParseCdpTriggerAction(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRemoveCdpTrigger(clientCtx.GetFromAddress(), args[0], action)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
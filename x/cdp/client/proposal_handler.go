@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/kava-labs/kava/x/cdp/client/cli"
+)
+
+// GlobalSettlementProposalHandler is the global settlement proposal handler
+var GlobalSettlementProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitGlobalSettlementProposal)
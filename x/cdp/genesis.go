@@ -76,6 +76,32 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 		ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
 		k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
 	}
+	// add multi-collateral cdps
+	for _, cdp := range gs.MultiCollateralCDPs {
+		if cdp.ID == gs.StartingCdpID {
+			panic(fmt.Sprintf("starting cdp id is assigned to an existing multi-collateral cdp: %v", cdp))
+		}
+		k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+	}
+
+	if gs.PreviousSavingsDistributionTime.Unix() > 0 {
+		k.SetPreviousSavingsDistributionTime(ctx, gs.PreviousSavingsDistributionTime)
+	}
+	if !gs.PendingSavings.IsNil() {
+		k.SetPendingSavings(ctx, gs.PendingSavings)
+	}
+	if gs.GlobalSettlement != nil {
+		k.SetGlobalSettlement(ctx, *gs.GlobalSettlement)
+		if !gs.GlobalSettlement.Complete {
+			k.RestartGlobalSettlement(ctx)
+		}
+	}
+	for _, m := range gs.CdpManagers {
+		k.SetCdpManager(ctx, m)
+	}
+	for _, t := range gs.CdpTriggers {
+		k.SetCdpTrigger(ctx, t)
+	}
 
 	k.SetNextCdpID(ctx, gs.StartingCdpID)
 	k.SetDebtDenom(ctx, gs.DebtDenom)
@@ -102,9 +128,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		return false
 	})
 
+	var multiCdps types.MultiCollateralCDPs
+	k.IterateMultiCollateralCdps(ctx, func(cdp types.MultiCollateralCDP) (stop bool) {
+		multiCdps = append(multiCdps, k.SynchronizeMultiCollateralInterest(ctx, cdp))
+		return false
+	})
+
 	cdpID := k.GetNextCdpID(ctx)
 	debtDenom := k.GetDebtDenom(ctx)
 	govDenom := k.GetGovDenom(ctx)
+	prevSavingsDistributionTime, _ := k.GetPreviousSavingsDistributionTime(ctx)
+	pendingSavings := k.GetPendingSavings(ctx)
+	var globalSettlement *types.GlobalSettlement
+	if settlement, found := k.GetGlobalSettlement(ctx); found {
+		globalSettlement = &settlement
+	}
+	cdpManagers := k.GetAllCdpManagers(ctx)
+	cdpTriggers := k.GetAllCdpTriggers(ctx)
 
 	var previousAccumTimes types.GenesisAccumulationTimes
 	var totalPrincipals types.GenesisTotalPrincipals
@@ -129,5 +169,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		totalPrincipals = append(totalPrincipals, genTotalPrincipal)
 	}
 
-	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals, multiCdps,
+		prevSavingsDistributionTime, pendingSavings, globalSettlement, cdpManagers, cdpTriggers)
 }
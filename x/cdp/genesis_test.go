@@ -131,7 +131,7 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			gs := types.NewGenesisState(tc.args.params, tc.args.cdps, tc.args.deposits, tc.args.startingID,
-				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals)
+				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals, types.MultiCollateralCDPs{}, time.Time{}, sdk.ZeroInt(), nil, types.CdpManagers{}, types.CdpTriggers{})
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -164,6 +164,7 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 		suite.app.InitializeFromGenesisStates(
 			NewPricefeedGenStateMulti(cdc),
 			appGS,
+			NewCollateralGenState(cdc, gs.CDPs),
 		)
 	})
 }
@@ -225,6 +226,8 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 					KeeperRewardPercentage:           d("0.01"),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(6),
+					CloseFactor:                      sdk.ZeroDec(),
+					LiquidationTargetRatio:           sdk.ZeroDec(),
 				},
 				{
 					Denom:                            "btc",
@@ -239,6 +242,8 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 					KeeperRewardPercentage:           d("0.01"),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(8),
+					CloseFactor:                      sdk.ZeroDec(),
+					LiquidationTargetRatio:           sdk.ZeroDec(),
 				},
 			},
 			DebtParam: types.DebtParam{
@@ -246,6 +251,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 				ReferenceAsset:   "usd",
 				ConversionFactor: i(6),
 				DebtFloor:        i(10000000),
+				SavingsRate:      sdk.ZeroDec(),
 			},
 		},
 		StartingCdpID: types.DefaultCdpStartingID,
@@ -265,6 +271,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			suite.genTime,
 			NewPricefeedGenStateMulti(suite.app.AppCodec()),
 			app.GenesisState{types.ModuleName: suite.app.AppCodec().MustMarshalJSON(&cdpGenesis)},
+			NewCollateralGenState(suite.app.AppCodec(), cdps),
 		)
 	})
 
@@ -305,6 +312,10 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 	// Update CDPs
 	expectedGenesis.CDPs = suite.keeper.GetAllCdps(suite.ctx)
 
+	// Update savings rate distribution
+	expectedGenesis.PreviousSavingsDistributionTime, _ = suite.keeper.GetPreviousSavingsDistributionTime(suite.ctx)
+	expectedGenesis.PendingSavings = suite.keeper.GetPendingSavings(suite.ctx)
+
 	exportedGenesis := cdp.ExportGenesis(suite.ctx, suite.keeper)
 
 	// Sort TotalPrincipals in both genesis files so slice order matches
@@ -6,6 +6,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	tmtime "github.com/cometbft/cometbft/types/time"
 
@@ -172,6 +173,18 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 	return app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&cdpGenesis)}
 }
 
+// NewCollateralGenState creates an auth and bank genesis state with the cdp module account holding the collateral of
+// the cdps
+func NewCollateralGenState(cdc codec.JSONCodec, cdps types.CDPs) app.GenesisState {
+	collateral := sdk.NewCoins()
+	for _, cdp := range cdps {
+		collateral = collateral.Add(cdp.Collateral)
+	}
+	return app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(types.ModuleName, collateral, authtypes.Minter, authtypes.Burner).
+		BuildMarshalled(cdc)
+}
+
 func cdps() (cdps types.CDPs) {
 	_, addrs := app.GeneratePrivKeyAddressPairs(3)
 	c1 := types.NewCDP(uint64(1), addrs[0], sdk.NewCoin("xrp", sdkmath.NewInt(100000000)), "xrp-a", sdk.NewCoin("usdx", sdkmath.NewInt(8000000)), tmtime.Canonical(time.Now()), sdk.OneDec())
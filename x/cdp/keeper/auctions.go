@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -57,6 +58,19 @@ func (k Keeper) CreateAuctionsFromDeposit(
 	debtDenom := k.GetDebtDenom(ctx)
 	numAuctions := numberOfAuctions.Int64()
 
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	lotPrice, err := k.getAuctionLotPrice(ctx, cp, principalDenom)
+	if err != nil {
+		if cp.DutchAuctionEnabled() {
+			return err
+		}
+		// collateral auctions do not depend on the market price, it is only recorded with them
+		lotPrice = sdk.ZeroDec()
+	}
+
 	// create whole auctions
 	for i := int64(0); i < numAuctions; i++ {
 		debtAmount := debtPerAuction
@@ -69,10 +83,10 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 		penalty := k.ApplyLiquidationPenalty(ctx, collateralType, debtAmount)
 
-		_, err := k.auctionKeeper.StartCollateralAuction(
-			ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, auctionSize),
-			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), []sdk.AccAddress{returnAddr},
-			[]sdkmath.Int{auctionSize}, sdk.NewCoin(debtDenom, debtAmount),
+		err := k.startAuction(
+			ctx, cp, sdk.NewCoin(collateral.Denom, auctionSize),
+			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), returnAddr,
+			sdk.NewCoin(debtDenom, debtAmount), lotPrice,
 		)
 		if err != nil {
 			return err
@@ -94,15 +108,52 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 	penalty := k.ApplyLiquidationPenalty(ctx, collateralType, lastAuctionDebt)
 
-	_, err := k.auctionKeeper.StartCollateralAuction(
-		ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
-		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), []sdk.AccAddress{returnAddr},
-		[]sdkmath.Int{lastAuctionCollateral}, sdk.NewCoin(debtDenom, lastAuctionDebt),
+	return k.startAuction(
+		ctx, cp, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
+		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), returnAddr,
+		sdk.NewCoin(debtDenom, lastAuctionDebt), lotPrice,
 	)
+}
 
+// startAuction starts an auction for the lot with the auction type set for the collateral type.
+// The lot price is recorded with the auction and dutch auctions start from it.
+func (k Keeper) startAuction(
+	ctx sdk.Context, cp types.CollateralParam, lot, maxBid sdk.Coin, returnAddr sdk.AccAddress, debt sdk.Coin,
+	lotPrice sdk.Dec,
+) error {
+	if cp.DutchAuctionEnabled() {
+		_, err := k.auctionKeeper.StartDutchAuction(
+			ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr},
+			[]sdkmath.Int{lot.Amount}, debt, lotPrice,
+		)
+		return err
+	}
+
+	_, err := k.auctionKeeper.StartCollateralAuction(
+		ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr},
+		[]sdkmath.Int{lot.Amount}, debt, lotPrice,
+	)
 	return err
 }
 
+// getAuctionLotPrice returns the liquidation market price of one unit of collateral, denominated in units of
+// the principal denom.
+func (k Keeper) getAuctionLotPrice(ctx sdk.Context, cp types.CollateralParam, principalDenom string) (sdk.Dec, error) {
+	dp, found := k.GetDebtParam(ctx, principalDenom)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrDebtNotSupported, principalDenom)
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// the market price is quoted for whole units, convert it to base units of collateral and principal
+	collateralConversion := sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())
+	debtConversion := sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64())
+	return price.Price.Mul(collateralConversion).Quo(debtConversion), nil
+}
+
 // NetSurplusAndDebt burns surplus and debt coins equal to the minimum of surplus and debt balances held by the liquidator module account
 // for example, if there is 1000 debt and 100 surplus, 100 surplus and 100 debt are burned, netting to 900 debt
 func (k Keeper) NetSurplusAndDebt(ctx sdk.Context) error {
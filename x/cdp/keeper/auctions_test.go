@@ -71,6 +71,34 @@ func (suite *AuctionTestSuite) TestCollateralAuction() {
 	suite.Require().NoError(err)
 }
 
+func (suite *AuctionTestSuite) TestDutchCollateralAuction() {
+	// sell bnb-a collateral with dutch auctions
+	params := suite.keeper.GetParams(suite.ctx)
+	for idx, cp := range params.CollateralParams {
+		if cp.Type == "bnb-a" {
+			params.CollateralParams[idx].AuctionType = types.AuctionTypeDutch
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	bk := suite.app.GetBankKeeper()
+	err := bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 21000000000), c("bnb", 190000000000)))
+	suite.Require().NoError(err)
+	testDeposit := types.NewDeposit(1, suite.addrs[0], c("bnb", 190000000000))
+	err = suite.keeper.AuctionCollateral(suite.ctx, types.Deposits{testDeposit}, "bnb-a", i(21000000000), "usdx")
+	suite.Require().NoError(err)
+
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 4)
+	for _, a := range auctions {
+		auction, ok := a.(*auctiontypes.DutchAuction)
+		suite.Require().True(ok)
+		// $17.25 per bnb is 0.1725 usdx per unit of bnb, plus the default 20% premium
+		suite.Equal(d("0.207"), auction.StartPrice)
+		suite.Equal([]sdk.AccAddress{suite.addrs[0]}, auction.LotReturns.Addresses)
+	}
+}
+
 func (suite *AuctionTestSuite) TestSurplusAuction() {
 	bk := suite.app.GetBankKeeper()
 	ak := suite.app.GetAccountKeeper()
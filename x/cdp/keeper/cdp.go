@@ -13,6 +13,9 @@ import (
 
 // AddCdp adds a cdp for a specific owner and collateral type
 func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, principal sdk.Coin, collateralType string) error {
+	if k.IsGloballySettled(ctx) {
+		return types.ErrGloballySettled
+	}
 	// validation
 	err := k.ValidateCollateral(ctx, collateral, collateralType)
 	if err != nil {
@@ -253,6 +256,8 @@ func (k Keeper) DeleteCDP(ctx sdk.Context, cdp types.CDP) error {
 		return errorsmod.Wrapf(types.ErrDenomPrefixNotFound, "%s", cdp.Collateral.Denom)
 	}
 	store.Delete(types.CdpKey(cdp.Type, cdp.ID))
+	k.deleteCdpManagers(ctx, cdp.ID)
+	k.deleteCdpTriggers(ctx, cdp.ID)
 	return nil
 }
 
@@ -628,10 +633,11 @@ func (k Keeper) GetMarketStatus(ctx sdk.Context, marketID string) bool {
 	return bz != nil
 }
 
-// UpdatePricefeedStatus determines if the price of an asset is available and updates the global status of the market
+// UpdatePricefeedStatus determines if the price of an asset is available and updates the global status of the market.
+// A market frozen by the pricefeed circuit breaker is treated as down.
 func (k Keeper) UpdatePricefeedStatus(ctx sdk.Context, marketID string) (ok bool) {
 	_, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
-	if err != nil {
+	if err != nil || k.pricefeedKeeper.IsMarketFrozen(ctx, marketID) {
 		k.SetMarketStatus(ctx, marketID, false)
 		return false
 	}
@@ -391,6 +391,32 @@ func (suite *CdpTestSuite) TestMarketStatus() {
 	suite.Require().False(status)
 }
 
+func (suite *CdpTestSuite) TestUpdatePricefeedStatus_FrozenMarket() {
+	pk := suite.app.GetPriceFeedKeeper()
+	params := pk.GetParams(suite.ctx)
+	for i, market := range params.Markets {
+		if market.MarketID == "xrp:usd" {
+			params.Markets[i].MaxPriceDeviation = d("0.1")
+			params.Markets[i].PriceRecoveryUpdates = 10
+		}
+	}
+	pk.SetParams(suite.ctx, params)
+
+	ok := suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd")
+	suite.Require().True(ok)
+
+	price, err := pk.GetCurrentPrice(suite.ctx, "xrp:usd")
+	suite.Require().NoError(err)
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress("oracle"), "xrp:usd", price.Price.MulInt64(2), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.Require().True(pk.IsMarketFrozen(suite.ctx, "xrp:usd"))
+
+	ok = suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd")
+	suite.Require().False(ok)
+	suite.Require().False(suite.keeper.GetMarketStatus(suite.ctx, "xrp:usd"))
+}
+
 func TestCdpTestSuite(t *testing.T) {
 	suite.Run(t, new(CdpTestSuite))
 }
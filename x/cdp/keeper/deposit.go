@@ -12,6 +12,9 @@ import (
 
 // DepositCollateral adds collateral to a cdp
 func (k Keeper) DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	if k.IsGloballySettled(ctx) {
+		return types.ErrGloballySettled
+	}
 	// check that collateral exists and has a functioning pricefeed
 	err := k.ValidateCollateral(ctx, collateral, collateralType)
 	if err != nil {
@@ -57,6 +60,9 @@ func (k Keeper) DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddre
 
 // WithdrawCollateral removes collateral from a cdp if it does not put the cdp below the liquidation ratio
 func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	if k.IsGloballySettled(ctx) {
+		return k.withdrawSettledCollateral(ctx, owner, depositor, collateral, collateralType)
+	}
 	err := k.ValidateCollateral(ctx, collateral, collateralType)
 	if err != nil {
 		return err
@@ -295,3 +295,99 @@ func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (type
 
 	return cdpResponses, nil
 }
+
+// MultiCollateralCdp queries the multi-collateral CDP of an owner and its health factor.
+func (s QueryServer) MultiCollateralCdp(c context.Context, req *types.QueryMultiCollateralCdpRequest) (*types.QueryMultiCollateralCdpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	cdp, found := s.keeper.GetMultiCollateralCdpByOwner(ctx, owner)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s has no multi-collateral cdp", req.Owner)
+	}
+
+	healthFactor, err := s.keeper.CalculateMultiCollateralHealthFactor(ctx, cdp.Collateral, cdp.GetTotalPrincipal(), spot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMultiCollateralCdpResponse{
+		Cdp:          cdp,
+		HealthFactor: healthFactor.String(),
+	}, nil
+}
+
+// SavingsRate queries the share of stability fees distributed to savings depositors and the pending distribution.
+func (s QueryServer) SavingsRate(c context.Context, req *types.QuerySavingsRateRequest) (*types.QuerySavingsRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dp := s.keeper.GetParams(ctx).DebtParam
+	savingsRate := sdk.ZeroDec()
+	if dp.SavingsRateEnabled() {
+		savingsRate = dp.SavingsRate
+	}
+	previousDistributionTime, _ := s.keeper.GetPreviousSavingsDistributionTime(ctx)
+
+	return &types.QuerySavingsRateResponse{
+		SavingsRate:              savingsRate,
+		DistributionFrequency:    dp.SavingsDistributionFrequency,
+		PendingSavings:           sdk.NewCoin(dp.Denom, s.keeper.GetPendingSavings(ctx)),
+		PreviousDistributionTime: previousDistributionTime,
+	}, nil
+}
+
+// GlobalSettlement queries whether the cdp system has been globally settled, and the state of the settlement.
+func (s QueryServer) GlobalSettlement(c context.Context, req *types.QueryGlobalSettlementRequest) (*types.QueryGlobalSettlementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	settlement, found := s.keeper.GetGlobalSettlement(ctx)
+	if !found {
+		return &types.QueryGlobalSettlementResponse{
+			Settled: false,
+			GlobalSettlement: types.GlobalSettlement{
+				RedemptionPool:  sdk.NewCoins(),
+				OutstandingDebt: sdk.ZeroInt(),
+			},
+		}, nil
+	}
+
+	return &types.QueryGlobalSettlementResponse{
+		Settled:          true,
+		GlobalSettlement: settlement,
+	}, nil
+}
+
+// CdpManagers queries the accounts authorized to manage a cdp.
+func (s QueryServer) CdpManagers(c context.Context, req *types.QueryCdpManagersRequest) (*types.QueryCdpManagersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	managers := s.keeper.GetCdpManagers(ctx, req.CdpId)
+	if managers == nil {
+		managers = types.CdpManagers{}
+	}
+
+	return &types.QueryCdpManagersResponse{Managers: managers}, nil
+}
+
+func (s QueryServer) CdpTriggers(c context.Context, req *types.QueryCdpTriggersRequest) (*types.QueryCdpTriggersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	triggers := s.keeper.GetCdpTriggers(ctx, req.CdpId)
+	if triggers == nil {
+		triggers = types.CdpTriggers{}
+	}
+
+	return &types.QueryCdpTriggersResponse{Triggers: triggers}, nil
+}
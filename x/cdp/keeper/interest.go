@@ -66,6 +66,17 @@ func (k Keeper) AccumulateInterest(ctx sdk.Context, ctype string) error {
 
 	newFeesSurplus := interestAccumulated
 
+	// mint the share of fees reserved for the savings rate to the cdp module account until it is distributed
+	savingsShare := k.calculateSavingsShare(dp, interestAccumulated)
+	if savingsShare.IsPositive() {
+		err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(dp.Denom, savingsShare)))
+		if err != nil {
+			return err
+		}
+		k.SetPendingSavings(ctx, k.GetPendingSavings(ctx).Add(savingsShare))
+		newFeesSurplus = newFeesSurplus.Sub(savingsShare)
+	}
+
 	// mint surplus coins to the liquidator module account.
 	if newFeesSurplus.IsPositive() {
 		err := k.bankKeeper.MintCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, newFeesSurplus)))
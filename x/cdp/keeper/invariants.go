@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RegisterInvariants registers the cdp module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "collateral", CollateralInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "redemption-pool", RedemptionPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "outstanding-debt", OutstandingDebtInvariant(k))
+}
+
+// AllInvariants runs all invariants of the cdp module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := CollateralInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		if res, stop := RedemptionPoolInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := OutstandingDebtInvariant(k)(ctx)
+		return res, stop
+	}
+}
+
+// CollateralInvariant ensures the cdp module account holds at least the collateral deposited in all cdps
+func CollateralInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "collateral broken", "module account holds less than the collateral deposited in cdps")
+
+	return func(ctx sdk.Context) (string, bool) {
+		deposited := sdk.NewCoins()
+		for _, cdp := range k.GetAllCdps(ctx) {
+			deposited = deposited.Add(cdp.Collateral)
+		}
+		for _, cdp := range k.GetAllMultiCollateralCdps(ctx) {
+			for _, cb := range cdp.Collateral {
+				deposited = deposited.Add(cb.Amount)
+			}
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		broken := !balance.IsAllGTE(deposited)
+		return message, broken
+	}
+}
+
+// RedemptionPoolInvariant ensures the liquidator module account holds the redemption pool after global settlement
+func RedemptionPoolInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "redemption pool broken", "liquidator module account holds less than the redemption pool")
+
+	return func(ctx sdk.Context) (string, bool) {
+		settlement, found := k.GetGlobalSettlement(ctx)
+		if !found || !settlement.Complete {
+			return message, false
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.LiquidatorMacc))
+		broken := !balance.IsAllGTE(settlement.RedemptionPool)
+		return message, broken
+	}
+}
+
+// OutstandingDebtInvariant ensures the supply of the debt asset does not exceed the outstanding debt that can be
+// redeemed after global settlement
+func OutstandingDebtInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "outstanding debt broken", "debt asset supply exceeds outstanding debt")
+
+	return func(ctx sdk.Context) (string, bool) {
+		settlement, found := k.GetGlobalSettlement(ctx)
+		if !found || !settlement.Complete {
+			return message, false
+		}
+
+		supply := k.bankKeeper.GetSupply(ctx, k.GetParams(ctx).DebtParam.Denom)
+		broken := supply.Amount.GT(settlement.OutstandingDebt)
+		return message, broken
+	}
+}
@@ -23,13 +23,14 @@ type Keeper struct {
 	auctionKeeper   types.AuctionKeeper
 	bankKeeper      types.BankKeeper
 	accountKeeper   types.AccountKeeper
+	savingsKeeper   types.SavingsKeeper
 	hooks           types.CDPHooks
 	maccPerms       map[string][]string
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace, pfk types.PricefeedKeeper,
-	ak types.AuctionKeeper, bk types.BankKeeper, ack types.AccountKeeper, maccs map[string][]string,
+	ak types.AuctionKeeper, bk types.BankKeeper, ack types.AccountKeeper, sk types.SavingsKeeper, maccs map[string][]string,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -43,6 +44,7 @@ func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.S
 		auctionKeeper:   ak,
 		bankKeeper:      bk,
 		accountKeeper:   ack,
+		savingsKeeper:   sk,
 		hooks:           nil,
 		maccPerms:       maccs,
 	}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// GrantCdpManager authorizes an account to operate a cdp on behalf of its owner until the expiry. An existing
+// authorization of the manager for the cdp is replaced.
+func (k Keeper) GrantCdpManager(ctx sdk.Context, owner, manager sdk.AccAddress, cdpID uint64, scope types.CdpManagerScope, expiry time.Time) error {
+	if !k.isCdpOwner(ctx, owner, cdpID) {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, cdp id %d", owner, cdpID)
+	}
+	if owner.Equals(manager) {
+		return errorsmod.Wrapf(types.ErrInvalidCdpManager, "manager cannot be the owner %s", owner)
+	}
+	if err := types.ValidateCdpManagerScope(scope); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidCdpManager, err.Error())
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidCdpManager, "expiry %s must be after block time %s", expiry, ctx.BlockTime())
+	}
+
+	k.SetCdpManager(ctx, types.NewCdpManager(cdpID, owner, manager, scope, expiry))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantCdpManager,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdpID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyManager, manager.String()),
+			sdk.NewAttribute(types.AttributeKeyScope, scope.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiry, expiry.String()),
+		),
+	)
+	return nil
+}
+
+// RevokeCdpManager removes the authorization of an account to operate a cdp
+func (k Keeper) RevokeCdpManager(ctx sdk.Context, owner, manager sdk.AccAddress, cdpID uint64) error {
+	m, found := k.GetCdpManager(ctx, cdpID, manager)
+	if !found || !m.Owner.Equals(owner) {
+		return errorsmod.Wrapf(types.ErrCdpManagerNotFound, "cdp id %d, manager %s", cdpID, manager)
+	}
+	k.DeleteCdpManager(ctx, cdpID, manager)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeCdpManager,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdpID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyManager, manager.String()),
+		),
+	)
+	return nil
+}
+
+// ValidateCdpManager returns an error if the manager does not hold an unexpired authorization for the cdp that
+// includes the required scope
+func (k Keeper) ValidateCdpManager(ctx sdk.Context, manager sdk.AccAddress, cdpID uint64, required types.CdpManagerScope) error {
+	m, found := k.GetCdpManager(ctx, cdpID, manager)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnauthorizedManager, "%s is not a manager of cdp %d", manager, cdpID)
+	}
+	if m.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedManager, "authorization of %s for cdp %d expired at %s", manager, cdpID, m.Expiry)
+	}
+	if !m.Scope.Includes(required) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedManager, "scope %s of %s for cdp %d does not include %s", m.Scope, manager, cdpID, required)
+	}
+	return nil
+}
+
+// ValidateCdpManagerForCollateralType validates the authorization of a manager for the cdp of an owner and collateral type
+func (k Keeper) ValidateCdpManagerForCollateralType(ctx sdk.Context, manager, owner sdk.AccAddress, collateralType string, required types.CdpManagerScope) error {
+	cdpID, found := k.GetCdpID(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	return k.ValidateCdpManager(ctx, manager, cdpID, required)
+}
+
+// ValidateMultiCollateralCdpManager validates the authorization of a manager for the multi-collateral cdp of an owner
+func (k Keeper) ValidateMultiCollateralCdpManager(ctx sdk.Context, manager, owner sdk.AccAddress, required types.CdpManagerScope) error {
+	cdp, found := k.GetMultiCollateralCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s has no multi-collateral cdp", owner)
+	}
+	return k.ValidateCdpManager(ctx, manager, cdp.ID, required)
+}
+
+// isCdpOwner returns true if the account owns the cdp or multi-collateral cdp with the input id
+func (k Keeper) isCdpOwner(ctx sdk.Context, owner sdk.AccAddress, cdpID uint64) bool {
+	cdpIDs, _ := k.GetCdpIdsByOwner(ctx, owner)
+	for _, id := range cdpIDs {
+		if id == cdpID {
+			return true
+		}
+	}
+	cdp, found := k.GetMultiCollateralCdp(ctx, cdpID)
+	return found && cdp.Owner.Equals(owner)
+}
+
+// GetCdpManager returns the authorization of a manager for a cdp
+func (k Keeper) GetCdpManager(ctx sdk.Context, cdpID uint64, manager sdk.AccAddress) (types.CdpManager, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpManagerKeyPrefix)
+	bz := store.Get(types.CdpManagerKey(cdpID, manager))
+	if bz == nil {
+		return types.CdpManager{}, false
+	}
+	var m types.CdpManager
+	k.cdc.MustUnmarshal(bz, &m)
+	return m, true
+}
+
+// SetCdpManager sets the authorization of a manager for a cdp
+func (k Keeper) SetCdpManager(ctx sdk.Context, m types.CdpManager) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpManagerKeyPrefix)
+	store.Set(types.CdpManagerKey(m.CdpID, m.Manager), k.cdc.MustMarshal(&m))
+}
+
+// DeleteCdpManager deletes the authorization of a manager for a cdp
+func (k Keeper) DeleteCdpManager(ctx sdk.Context, cdpID uint64, manager sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpManagerKeyPrefix)
+	store.Delete(types.CdpManagerKey(cdpID, manager))
+}
+
+// deleteCdpManagers deletes all authorizations for a cdp
+func (k Keeper) deleteCdpManagers(ctx sdk.Context, cdpID uint64) {
+	for _, m := range k.GetCdpManagers(ctx, cdpID) {
+		k.DeleteCdpManager(ctx, cdpID, m.Manager)
+	}
+}
+
+// IterateCdpManagers iterates over the authorizations for a cdp and performs a callback function
+func (k Keeper) IterateCdpManagers(ctx sdk.Context, cdpID uint64, cb func(m types.CdpManager) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpManagerKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetCdpIDBytes(cdpID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var m types.CdpManager
+		k.cdc.MustUnmarshal(iterator.Value(), &m)
+		if cb(m) {
+			break
+		}
+	}
+}
+
+// GetCdpManagers returns the authorizations for a cdp
+func (k Keeper) GetCdpManagers(ctx sdk.Context, cdpID uint64) (managers types.CdpManagers) {
+	k.IterateCdpManagers(ctx, cdpID, func(m types.CdpManager) bool {
+		managers = append(managers, m)
+		return false
+	})
+	return
+}
+
+// GetAllCdpManagers returns the authorizations for all cdps
+func (k Keeper) GetAllCdpManagers(ctx sdk.Context) (managers types.CdpManagers) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpManagerKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var m types.CdpManager
+		k.cdc.MustUnmarshal(iterator.Value(), &m)
+		managers = append(managers, m)
+	}
+	return
+}
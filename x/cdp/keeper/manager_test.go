@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type CdpManagerTestSuite struct {
+	suite.Suite
+
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	app       app.TestApp
+	ctx       sdk.Context
+	owner     sdk.AccAddress
+	manager   sdk.AccAddress
+}
+
+func (suite *CdpManagerTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewFundedGenStateWithSameCoins(cdc, cs(c("xrp", 500000000)), addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.msgServer = keeper.NewMsgServerImpl(suite.keeper)
+	suite.ctx = ctx
+	suite.owner = addrs[0]
+	suite.manager = addrs[1]
+
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, suite.owner, c("xrp", 400000000), c("usdx", 20000000), "xrp-a"))
+}
+
+func (suite *CdpManagerTestSuite) grant(scope types.CdpManagerScope) {
+	err := suite.keeper.GrantCdpManager(suite.ctx, suite.owner, suite.manager, 1, scope, suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+}
+
+func (suite *CdpManagerTestSuite) TestGrantCdpManager() {
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+
+	err := suite.keeper.GrantCdpManager(suite.ctx, suite.manager, suite.owner, 1, types.CDP_MANAGER_SCOPE_FULL, expiry)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.GrantCdpManager(suite.ctx, suite.owner, suite.manager, 2, types.CDP_MANAGER_SCOPE_FULL, expiry)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.GrantCdpManager(suite.ctx, suite.owner, suite.manager, 1, types.CDP_MANAGER_SCOPE_FULL, suite.ctx.BlockTime())
+	suite.Require().True(errors.Is(err, types.ErrInvalidCdpManager))
+	err = suite.keeper.GrantCdpManager(suite.ctx, suite.owner, suite.owner, 1, types.CDP_MANAGER_SCOPE_FULL, expiry)
+	suite.Require().True(errors.Is(err, types.ErrInvalidCdpManager))
+
+	suite.grant(types.CDP_MANAGER_SCOPE_REPAY)
+	suite.grant(types.CDP_MANAGER_SCOPE_REBALANCE)
+	managers := suite.keeper.GetCdpManagers(suite.ctx, 1)
+	suite.Require().Equal(types.CdpManagers{
+		types.NewCdpManager(1, suite.owner, suite.manager, types.CDP_MANAGER_SCOPE_REBALANCE, expiry),
+	}, managers)
+}
+
+func (suite *CdpManagerTestSuite) TestManagerScopes() {
+	type action struct {
+		name string
+		exec func() error
+	}
+	actions := []action{
+		{"repay", func() error {
+			_, err := suite.msgServer.RepayDebt(sdk.WrapSDKContext(suite.ctx), &types.MsgRepayDebt{
+				Sender: suite.owner.String(), CollateralType: "xrp-a", Payment: c("usdx", 1000000), Manager: suite.manager.String(),
+			})
+			return err
+		}},
+		{"deposit", func() error {
+			_, err := suite.msgServer.Deposit(sdk.WrapSDKContext(suite.ctx), &types.MsgDeposit{
+				Depositor: suite.owner.String(), Owner: suite.owner.String(), Collateral: c("xrp", 10000000), CollateralType: "xrp-a", Manager: suite.manager.String(),
+			})
+			return err
+		}},
+		{"withdraw", func() error {
+			_, err := suite.msgServer.Withdraw(sdk.WrapSDKContext(suite.ctx), &types.MsgWithdraw{
+				Depositor: suite.owner.String(), Owner: suite.owner.String(), Collateral: c("xrp", 10000000), CollateralType: "xrp-a", Manager: suite.manager.String(),
+			})
+			return err
+		}},
+		{"draw", func() error {
+			_, err := suite.msgServer.DrawDebt(sdk.WrapSDKContext(suite.ctx), &types.MsgDrawDebt{
+				Sender: suite.owner.String(), CollateralType: "xrp-a", Principal: c("usdx", 1000000), Manager: suite.manager.String(),
+			})
+			return err
+		}},
+	}
+	testCases := []struct {
+		scope   types.CdpManagerScope
+		allowed map[string]bool
+	}{
+		{types.CDP_MANAGER_SCOPE_UNSPECIFIED, map[string]bool{}},
+		{types.CDP_MANAGER_SCOPE_REPAY, map[string]bool{"repay": true}},
+		{types.CDP_MANAGER_SCOPE_REBALANCE, map[string]bool{"repay": true, "deposit": true, "withdraw": true}},
+		{types.CDP_MANAGER_SCOPE_FULL, map[string]bool{"repay": true, "deposit": true, "withdraw": true, "draw": true}},
+	}
+	for _, tc := range testCases {
+		for _, a := range actions {
+			suite.Run(tc.scope.String()+" "+a.name, func() {
+				suite.SetupTest()
+				if tc.scope != types.CDP_MANAGER_SCOPE_UNSPECIFIED {
+					suite.grant(tc.scope)
+				}
+
+				err := a.exec()
+				if tc.allowed[a.name] {
+					suite.Require().NoError(err)
+				} else {
+					suite.Require().True(errors.Is(err, types.ErrUnauthorizedManager))
+				}
+			})
+		}
+	}
+}
+
+func (suite *CdpManagerTestSuite) TestManagerFundsMoveToOwner() {
+	suite.grant(types.CDP_MANAGER_SCOPE_FULL)
+	bk := suite.app.GetBankKeeper()
+
+	_, err := suite.msgServer.DrawDebt(sdk.WrapSDKContext(suite.ctx), &types.MsgDrawDebt{
+		Sender: suite.owner.String(), CollateralType: "xrp-a", Principal: c("usdx", 5000000), Manager: suite.manager.String(),
+	})
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Withdraw(sdk.WrapSDKContext(suite.ctx), &types.MsgWithdraw{
+		Depositor: suite.owner.String(), Owner: suite.owner.String(), Collateral: c("xrp", 50000000), CollateralType: "xrp-a", Manager: suite.manager.String(),
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(c("usdx", 25000000), bk.GetBalance(suite.ctx, suite.owner, "usdx"))
+	suite.Require().Equal(c("xrp", 150000000), bk.GetBalance(suite.ctx, suite.owner, "xrp"))
+	suite.Require().True(bk.GetBalance(suite.ctx, suite.manager, "usdx").IsZero())
+	suite.Require().Equal(c("xrp", 500000000), bk.GetBalance(suite.ctx, suite.manager, "xrp"))
+}
+
+func (suite *CdpManagerTestSuite) TestExpiredManager() {
+	suite.grant(types.CDP_MANAGER_SCOPE_FULL)
+	suite.Require().NoError(suite.keeper.ValidateCdpManager(suite.ctx, suite.manager, 1, types.CDP_MANAGER_SCOPE_FULL))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	err := suite.keeper.ValidateCdpManager(suite.ctx, suite.manager, 1, types.CDP_MANAGER_SCOPE_REPAY)
+	suite.Require().True(errors.Is(err, types.ErrUnauthorizedManager))
+}
+
+func (suite *CdpManagerTestSuite) TestRevokeCdpManager() {
+	err := suite.keeper.RevokeCdpManager(suite.ctx, suite.owner, suite.manager, 1)
+	suite.Require().True(errors.Is(err, types.ErrCdpManagerNotFound))
+
+	suite.grant(types.CDP_MANAGER_SCOPE_FULL)
+	err = suite.keeper.RevokeCdpManager(suite.ctx, suite.manager, suite.manager, 1)
+	suite.Require().True(errors.Is(err, types.ErrCdpManagerNotFound))
+
+	_, err = suite.msgServer.RevokeCdpManager(sdk.WrapSDKContext(suite.ctx), &types.MsgRevokeCdpManager{
+		Owner: suite.owner.String(), Manager: suite.manager.String(), CdpID: 1,
+	})
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetCdpManager(suite.ctx, 1, suite.manager)
+	suite.Require().False(found)
+
+	err = suite.keeper.ValidateCdpManager(suite.ctx, suite.manager, 1, types.CDP_MANAGER_SCOPE_REPAY)
+	suite.Require().True(errors.Is(err, types.ErrUnauthorizedManager))
+}
+
+func (suite *CdpManagerTestSuite) TestClosedCdpRemovesManagers() {
+	suite.grant(types.CDP_MANAGER_SCOPE_REPAY)
+
+	err := suite.keeper.RepayPrincipal(suite.ctx, suite.owner, "xrp-a", c("usdx", 20000000))
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.owner, "xrp-a")
+	suite.Require().False(found)
+	suite.Require().Empty(suite.keeper.GetCdpManagers(suite.ctx, 1))
+}
+
+func TestCdpManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(CdpManagerTestSuite))
+}
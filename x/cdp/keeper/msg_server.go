@@ -57,6 +57,11 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
+	err = k.validateCdpManager(ctx, msg.Manager, owner, msg.CollateralType, types.CDP_MANAGER_SCOPE_REBALANCE)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.keeper.DepositCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
 	if err != nil {
 		return nil, err
@@ -85,6 +90,11 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
+	err = k.validateCdpManager(ctx, msg.Manager, owner, msg.CollateralType, types.CDP_MANAGER_SCOPE_REBALANCE)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.keeper.WithdrawCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
 	if err != nil {
 		return nil, err
@@ -108,6 +118,11 @@ func (k msgServer) DrawDebt(goCtx context.Context, msg *types.MsgDrawDebt) (*typ
 		return nil, err
 	}
 
+	err = k.validateCdpManager(ctx, msg.Manager, sender, msg.CollateralType, types.CDP_MANAGER_SCOPE_FULL)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.keeper.AddPrincipal(ctx, sender, msg.CollateralType, msg.Principal)
 	if err != nil {
 		return nil, err
@@ -131,6 +146,11 @@ func (k msgServer) RepayDebt(goCtx context.Context, msg *types.MsgRepayDebt) (*t
 		return nil, err
 	}
 
+	err = k.validateCdpManager(ctx, msg.Manager, sender, msg.CollateralType, types.CDP_MANAGER_SCOPE_REPAY)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.keeper.RepayPrincipal(ctx, sender, msg.CollateralType, msg.Payment)
 	if err != nil {
 		return nil, err
@@ -173,3 +193,291 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) CreateMultiCollateralCDP(goCtx context.Context, msg *types.MsgCreateMultiCollateralCDP) (*types.MsgCreateMultiCollateralCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.AddMultiCollateralCdp(ctx, sender, msg.Collateral, msg.Principal, msg.DebtType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	cdp, _ := k.keeper.GetMultiCollateralCdpByOwner(ctx, sender)
+	return &types.MsgCreateMultiCollateralCDPResponse{CdpID: cdp.ID}, nil
+}
+
+func (k msgServer) DepositMultiCollateral(goCtx context.Context, msg *types.MsgDepositMultiCollateral) (*types.MsgDepositMultiCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.validateMultiCollateralCdpManager(ctx, msg.Manager, sender, types.CDP_MANAGER_SCOPE_REBALANCE)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.DepositMultiCollateral(ctx, sender, msg.Collateral, msg.CollateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgDepositMultiCollateralResponse{}, nil
+}
+
+func (k msgServer) WithdrawMultiCollateral(goCtx context.Context, msg *types.MsgWithdrawMultiCollateral) (*types.MsgWithdrawMultiCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.validateMultiCollateralCdpManager(ctx, msg.Manager, sender, types.CDP_MANAGER_SCOPE_REBALANCE)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.WithdrawMultiCollateral(ctx, sender, msg.Collateral, msg.CollateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgWithdrawMultiCollateralResponse{}, nil
+}
+
+func (k msgServer) DrawMultiCollateralDebt(goCtx context.Context, msg *types.MsgDrawMultiCollateralDebt) (*types.MsgDrawMultiCollateralDebtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.validateMultiCollateralCdpManager(ctx, msg.Manager, sender, types.CDP_MANAGER_SCOPE_FULL)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.AddMultiCollateralPrincipal(ctx, sender, msg.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgDrawMultiCollateralDebtResponse{}, nil
+}
+
+func (k msgServer) RepayMultiCollateralDebt(goCtx context.Context, msg *types.MsgRepayMultiCollateralDebt) (*types.MsgRepayMultiCollateralDebtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.validateMultiCollateralCdpManager(ctx, msg.Manager, sender, types.CDP_MANAGER_SCOPE_REPAY)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RepayMultiCollateralPrincipal(ctx, sender, msg.Payment)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRepayMultiCollateralDebtResponse{}, nil
+}
+
+func (k msgServer) RedeemUSDX(goCtx context.Context, msg *types.MsgRedeemUSDX) (*types.MsgRedeemUSDXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collateral, err := k.keeper.RedeemDebt(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRedeemUSDXResponse{Collateral: collateral}, nil
+}
+
+func (k msgServer) GrantCdpManager(goCtx context.Context, msg *types.MsgGrantCdpManager) (*types.MsgGrantCdpManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.GrantCdpManager(ctx, owner, manager, msg.CdpID, msg.Scope, msg.Expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+	return &types.MsgGrantCdpManagerResponse{}, nil
+}
+
+func (k msgServer) RevokeCdpManager(goCtx context.Context, msg *types.MsgRevokeCdpManager) (*types.MsgRevokeCdpManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RevokeCdpManager(ctx, owner, manager, msg.CdpID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+	return &types.MsgRevokeCdpManagerResponse{}, nil
+}
+
+func (k msgServer) SetCdpTrigger(goCtx context.Context, msg *types.MsgSetCdpTrigger) (*types.MsgSetCdpTriggerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RegisterCdpTrigger(ctx, owner, msg.CollateralType, msg.Action, msg.TriggerRatio, msg.TargetRatio, msg.MaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+	return &types.MsgSetCdpTriggerResponse{}, nil
+}
+
+func (k msgServer) RemoveCdpTrigger(goCtx context.Context, msg *types.MsgRemoveCdpTrigger) (*types.MsgRemoveCdpTriggerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RemoveCdpTrigger(ctx, owner, msg.CollateralType, msg.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+	return &types.MsgRemoveCdpTriggerResponse{}, nil
+}
+
+// validateCdpManager checks that the manager signing a msg, if any, is authorized to operate the cdp of the owner and
+// collateral type with the required scope
+func (k msgServer) validateCdpManager(ctx sdk.Context, manager string, owner sdk.AccAddress, collateralType string, required types.CdpManagerScope) error {
+	if manager == "" {
+		return nil
+	}
+	managerAddr, err := sdk.AccAddressFromBech32(manager)
+	if err != nil {
+		return err
+	}
+	return k.keeper.ValidateCdpManagerForCollateralType(ctx, managerAddr, owner, collateralType, required)
+}
+
+// validateMultiCollateralCdpManager checks that the manager signing a msg, if any, is authorized to operate the
+// multi-collateral cdp of the owner with the required scope
+func (k msgServer) validateMultiCollateralCdpManager(ctx sdk.Context, manager string, owner sdk.AccAddress, required types.CdpManagerScope) error {
+	if manager == "" {
+		return nil
+	}
+	managerAddr, err := sdk.AccAddressFromBech32(manager)
+	if err != nil {
+		return err
+	}
+	return k.keeper.ValidateMultiCollateralCdpManager(ctx, managerAddr, owner, required)
+}
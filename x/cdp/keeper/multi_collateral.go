@@ -0,0 +1,681 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// AddMultiCollateralCdp adds a cdp backed by several collateral types for an owner. The debt of the cdp accrues interest
+// at the stability fee of the debt type and counts towards the debt limit of the debt type.
+func (k Keeper) AddMultiCollateralCdp(ctx sdk.Context, owner sdk.AccAddress, collateral types.CollateralBalances, principal sdk.Coin, debtType string) error {
+	if k.IsGloballySettled(ctx) {
+		return types.ErrGloballySettled
+	}
+	// validation
+	if err := collateral.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidDeposit, err.Error())
+	}
+	for _, cb := range collateral {
+		if err := k.ValidateCollateral(ctx, cb.Amount, cb.Type); err != nil {
+			return err
+		}
+		if err := k.ValidateBalance(ctx, cb.Amount, owner); err != nil {
+			return err
+		}
+	}
+	if _, found := k.GetCollateral(ctx, debtType); !found {
+		return errorsmod.Wrap(types.ErrCollateralNotSupported, debtType)
+	}
+	if _, found := k.GetMultiCollateralCdpByOwner(ctx, owner); found {
+		return errorsmod.Wrapf(types.ErrCdpAlreadyExists, "owner %s already has a multi-collateral cdp", owner)
+	}
+	err := k.ValidatePrincipalAdd(ctx, principal)
+	if err != nil {
+		return err
+	}
+	err = k.ValidateDebtLimit(ctx, debtType, principal)
+	if err != nil {
+		return err
+	}
+	err = k.ValidateMultiCollateralHealthFactor(ctx, collateral, principal)
+	if err != nil {
+		return err
+	}
+
+	id := k.GetNextCdpID(ctx)
+	interestFactor, found := k.GetInterestFactor(ctx, debtType)
+	if !found {
+		interestFactor = sdk.OneDec()
+		k.SetInterestFactor(ctx, debtType, interestFactor)
+	}
+	cdp := types.NewMultiCollateralCDP(id, owner, collateral, debtType, principal, ctx.BlockHeader().Time, interestFactor)
+
+	// send the collateral from the owner's account to the cdp module
+	collateralCoins := sdk.NewCoins()
+	for _, cb := range collateral {
+		collateralCoins = collateralCoins.Add(cb.Amount)
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, collateralCoins)
+	if err != nil {
+		return err
+	}
+
+	// mint the principal and send to the owners account
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(principal))
+	if err != nil {
+		panic(err)
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(principal))
+	if err != nil {
+		panic(err)
+	}
+
+	// mint the corresponding amount of debt coins
+	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), principal)
+	if err != nil {
+		panic(err)
+	}
+
+	// update total principal for the debt type
+	k.IncrementTotalPrincipal(ctx, debtType, principal)
+
+	k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+	k.SetNextCdpID(ctx, id+1)
+
+	// emit events for cdp creation, deposits, and draw
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateCdp,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	for _, cb := range collateral {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpDeposit,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, cb.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyCollateralType, cb.Type),
+			),
+		)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDraw,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, principal.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	return nil
+}
+
+// DepositMultiCollateral adds collateral of a collateral type to the multi-collateral cdp of an owner
+func (k Keeper) DepositMultiCollateral(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	if k.IsGloballySettled(ctx) {
+		return types.ErrGloballySettled
+	}
+	// check that collateral exists and has a functioning pricefeed
+	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+	cdp, found := k.GetMultiCollateralCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s has no multi-collateral cdp", owner)
+	}
+	err = k.ValidateBalance(ctx, collateral, owner)
+	if err != nil {
+		return err
+	}
+	cdp = k.SynchronizeMultiCollateralInterest(ctx, cdp)
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(collateral))
+	if err != nil {
+		return err
+	}
+
+	cdp.Collateral = cdp.Collateral.Add(collateralType, collateral)
+	k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, collateralType),
+		),
+	)
+
+	return nil
+}
+
+// WithdrawMultiCollateral removes collateral of a collateral type from the multi-collateral cdp of an owner if it does
+// not put the cdp below a health factor of one
+func (k Keeper) WithdrawMultiCollateral(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	if k.IsGloballySettled(ctx) {
+		return k.withdrawSettledMultiCollateral(ctx, owner, collateral, collateralType)
+	}
+	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+	cdp, found := k.GetMultiCollateralCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s has no multi-collateral cdp", owner)
+	}
+	balance, found := cdp.Collateral.AmountOf(collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "cdp %d, collateral type %s", cdp.ID, collateralType)
+	}
+	if collateral.Amount.GT(balance.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, deposit %s", collateral, balance)
+	}
+	cdp = k.SynchronizeMultiCollateralInterest(ctx, cdp)
+
+	remaining := cdp.Collateral.Sub(collateralType, collateral)
+	err = k.ValidateMultiCollateralHealthFactor(ctx, remaining, cdp.GetTotalPrincipal())
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(collateral))
+	if err != nil {
+		panic(err)
+	}
+
+	cdp.Collateral = remaining
+	k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, collateralType),
+		),
+	)
+
+	return nil
+}
+
+// AddMultiCollateralPrincipal adds debt to the multi-collateral cdp of an owner if the additional debt does not put the
+// cdp below a health factor of one
+func (k Keeper) AddMultiCollateralPrincipal(ctx sdk.Context, owner sdk.AccAddress, principal sdk.Coin) error {
+	if k.IsGloballySettled(ctx) {
+		return types.ErrGloballySettled
+	}
+	// validation
+	cdp, found := k.GetMultiCollateralCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s has no multi-collateral cdp", owner)
+	}
+	err := k.ValidatePrincipalDraw(ctx, principal, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+	err = k.ValidateDebtLimit(ctx, cdp.DebtType, principal)
+	if err != nil {
+		return err
+	}
+	for _, cb := range cdp.Collateral {
+		if err := k.ValidateCollateral(ctx, cb.Amount, cb.Type); err != nil {
+			return err
+		}
+	}
+	cdp = k.SynchronizeMultiCollateralInterest(ctx, cdp)
+
+	err = k.ValidateMultiCollateralHealthFactor(ctx, cdp.Collateral, cdp.GetTotalPrincipal().Add(principal))
+	if err != nil {
+		return err
+	}
+
+	// mint the principal and send it to the cdp owner
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(principal))
+	if err != nil {
+		panic(err)
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(principal))
+	if err != nil {
+		panic(err)
+	}
+
+	// mint the corresponding amount of debt coins in the cdp module account
+	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), principal)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDraw,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, principal.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	cdp.Principal = cdp.Principal.Add(principal)
+	k.IncrementTotalPrincipal(ctx, cdp.DebtType, principal)
+	k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+	return nil
+}
+
+// RepayMultiCollateralPrincipal removes debt from the multi-collateral cdp of an owner.
+// If all debt is repaid, the collateral is returned to the owner and the cdp is removed from the store.
+func (k Keeper) RepayMultiCollateralPrincipal(ctx sdk.Context, owner sdk.AccAddress, payment sdk.Coin) error {
+	if k.IsGloballySettled(ctx) {
+		return types.ErrGloballySettled
+	}
+	// validation
+	cdp, found := k.GetMultiCollateralCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s has no multi-collateral cdp", owner)
+	}
+	if payment.Denom != cdp.Principal.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidPayment, "cdp %d: expected %s, got %s", cdp.ID, cdp.Principal.Denom, payment.Denom)
+	}
+	dp, found := k.GetDebtParam(ctx, payment.Denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidPayment, "payment denom %s not found", payment.Denom)
+	}
+	err := k.ValidateBalance(ctx, payment, owner)
+	if err != nil {
+		return err
+	}
+	cdp = k.SynchronizeMultiCollateralInterest(ctx, cdp)
+
+	feePayment, principalPayment := k.calculatePayment(ctx, cdp.GetTotalPrincipal(), cdp.AccumulatedFees, payment)
+
+	proposedBalance := cdp.Principal.Amount.Sub(principalPayment.Amount)
+	if proposedBalance.IsPositive() && proposedBalance.LT(dp.DebtFloor) {
+		return errorsmod.Wrapf(types.ErrBelowDebtFloor, "proposed %s < minimum %s", sdk.NewCoin(payment.Denom, proposedBalance), dp.DebtFloor)
+	}
+
+	// send the payment from the owner to the cdp module and burn it
+	paymentCoins := sdk.NewCoins(feePayment.Add(principalPayment))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, paymentCoins)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, paymentCoins)
+	if err != nil {
+		panic(err)
+	}
+
+	// burn the corresponding amount of debt coins
+	debtDenom := k.GetDebtDenom(ctx)
+	err = k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, sdk.NewCoin(debtDenom, feePayment.Add(principalPayment).Amount))
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpRepay,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, feePayment.Add(principalPayment).String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	cdp.Principal = cdp.Principal.Sub(principalPayment)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	k.DecrementTotalPrincipal(ctx, cdp.DebtType, feePayment.Add(principalPayment))
+
+	if !cdp.Principal.IsZero() || !cdp.AccumulatedFees.IsZero() {
+		k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+		return nil
+	}
+
+	// the debt is fully paid, return the collateral and remove the cdp
+	for _, cb := range cdp.Collateral {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(cb.Amount)); err != nil {
+			panic(err)
+		}
+	}
+	k.DeleteMultiCollateralCdp(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpClose,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	return nil
+}
+
+// SynchronizeMultiCollateralInterest updates the input multi-collateral cdp to reflect the interest accumulated at the
+// interest factor of its debt type, updates the cdp and its health factor index in the store, and returns the updated cdp
+func (k Keeper) SynchronizeMultiCollateralInterest(ctx sdk.Context, cdp types.MultiCollateralCDP) types.MultiCollateralCDP {
+	globalInterestFactor, found := k.GetInterestFactor(ctx, cdp.DebtType)
+	if !found {
+		k.SetInterestFactor(ctx, cdp.DebtType, sdk.OneDec())
+		cdp.InterestFactor = sdk.OneDec()
+		cdp.FeesUpdated = ctx.BlockTime()
+		k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+		return cdp
+	}
+	prevAccrualTime, found := k.GetPreviousAccrualTime(ctx, cdp.DebtType)
+	if !found {
+		// no interest has accumulated, but the health factor index is still refreshed at current prices
+		k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+		return cdp
+	}
+
+	totalPrincipal := cdp.GetTotalPrincipal().Amount
+	cdpInterestFactor := globalInterestFactor.Quo(cdp.InterestFactor)
+	accumulatedInterest := sdk.NewDecFromInt(totalPrincipal).Mul(cdpInterestFactor).RoundInt().Sub(totalPrincipal)
+
+	// if all fees are rounding to zero, don't update the interest factor so the interest isn't lost
+	if !accumulatedInterest.IsZero() || cdpInterestFactor.Equal(sdk.OneDec()) {
+		cdp.AccumulatedFees = cdp.AccumulatedFees.AddAmount(accumulatedInterest)
+		cdp.InterestFactor = globalInterestFactor
+	}
+	cdp.FeesUpdated = prevAccrualTime
+	k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+	return cdp
+}
+
+// CalculateMultiCollateralHealthFactor returns the sum of the value of each collateral balance divided by the
+// liquidation ratio of its collateral type, relative to the input debt. A health factor of one is equivalent to each
+// collateral type being at its liquidation ratio.
+func (k Keeper) CalculateMultiCollateralHealthFactor(ctx sdk.Context, collateral types.CollateralBalances, debt sdk.Coin, pfType pricefeedType) (sdk.Dec, error) {
+	debtTotal := k.convertDebtToBaseUnits(ctx, debt)
+	if debtTotal.IsZero() || debtTotal.GTE(types.MaxSortableDec) {
+		return types.MaxSortableDec.Sub(sdk.SmallestDec()), nil
+	}
+
+	weightedValue := sdk.ZeroDec()
+	for _, cb := range collateral {
+		value, err := k.calculateCollateralValue(ctx, cb, pfType)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		weightedValue = weightedValue.Add(value.Quo(k.getLiquidationRatio(ctx, cb.Type)))
+	}
+	return weightedValue.Quo(debtTotal), nil
+}
+
+// ValidateMultiCollateralHealthFactor validates that the input collateral and debt have a health factor of at least one
+// at spot prices
+func (k Keeper) ValidateMultiCollateralHealthFactor(ctx sdk.Context, collateral types.CollateralBalances, debt sdk.Coin) error {
+	healthFactor, err := k.CalculateMultiCollateralHealthFactor(ctx, collateral, debt, spot)
+	if err != nil {
+		return err
+	}
+	if healthFactor.LT(sdk.OneDec()) {
+		return errorsmod.Wrapf(types.ErrInvalidCollateralRatio, "health factor %s is below 1", healthFactor)
+	}
+	return nil
+}
+
+// calculateCollateralValue returns the market value of a collateral balance in base units of the debt
+func (k Keeper) calculateCollateralValue(ctx sdk.Context, cb types.CollateralBalance, pfType pricefeedType) (sdk.Dec, error) {
+	var marketID string
+	switch pfType {
+	case spot:
+		marketID = k.getSpotMarketID(ctx, cb.Type)
+	case liquidation:
+		marketID = k.getliquidationMarketID(ctx, cb.Type)
+	default:
+		return sdk.Dec{}, pfType.IsValid()
+	}
+
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.convertCollateralToBaseUnits(ctx, cb.Amount, cb.Type).Mul(price.Price), nil
+}
+
+// RefreshMultiCollateralHealthIndex synchronizes the interest of up to count multi-collateral cdps, re-indexing their
+// health factors at current prices. A health factor depends on the prices of several collateral types, so index entries
+// go stale as prices move. Each call continues from the cdp after the last one refreshed, wrapping around to the first
+// cdp, so every entry is refreshed within a bounded number of calls.
+func (k Keeper) RefreshMultiCollateralHealthIndex(ctx sdk.Context, count sdkmath.Int) {
+	cursor := k.getMultiCdpRefreshCursor(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+
+	var cdps types.MultiCollateralCDPs
+	collect := func(start, end []byte) {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
+		for ; iterator.Valid() && int64(len(cdps)) < count.Int64(); iterator.Next() {
+			var cdp types.MultiCollateralCDP
+			k.cdc.MustUnmarshal(iterator.Value(), &cdp)
+			cdps = append(cdps, cdp)
+		}
+	}
+	collect(types.GetCdpIDBytes(cursor), nil)
+	if cursor > 0 {
+		collect(nil, types.GetCdpIDBytes(cursor))
+	}
+	if len(cdps) == 0 {
+		return
+	}
+
+	for _, cdp := range cdps {
+		k.SynchronizeMultiCollateralInterest(ctx, cdp)
+	}
+	k.setMultiCdpRefreshCursor(ctx, cdps[len(cdps)-1].ID+1)
+}
+
+func (k Keeper) getMultiCdpRefreshCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.MultiCdpRefreshCursorKey)
+	if bz == nil {
+		return 0
+	}
+	return types.GetCdpIDFromBytes(bz)
+}
+
+func (k Keeper) setMultiCdpRefreshCursor(ctx sdk.Context, cdpID uint64) {
+	ctx.KVStore(k.key).Set(types.MultiCdpRefreshCursorKey, types.GetCdpIDBytes(cdpID))
+}
+
+// LiquidateMultiCollateralCdps checks the multi-collateral cdps of a debt type with the lowest health factors in the
+// health factor index, up to the input count. The index holds the health factor of each cdp at liquidation prices when
+// it was last updated, and is kept close to current prices by RefreshMultiCollateralHealthIndex. Each checked cdp has
+// its interest synchronized and is re-indexed at current prices. Cdps with a current health factor below one are
+// liquidated.
+func (k Keeper) LiquidateMultiCollateralCdps(ctx sdk.Context, debtType string, count sdkmath.Int) error {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpHealthIndexPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomIterKey(debtType))
+	var cdpIDs []uint64
+	for ; iterator.Valid() && int64(len(cdpIDs)) < count.Int64(); iterator.Next() {
+		cdpIDs = append(cdpIDs, types.GetCdpIDFromBytes(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range cdpIDs {
+		cdp, found := k.GetMultiCollateralCdp(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("multi-collateral cdp %d does not exist", id))
+		}
+		cdp = k.SynchronizeMultiCollateralInterest(ctx, cdp)
+
+		healthFactor, err := k.CalculateMultiCollateralHealthFactor(ctx, cdp.Collateral, cdp.GetTotalPrincipal(), liquidation)
+		if err != nil {
+			// a price for one of the collateral types is unavailable, the cdp is checked again once it is
+			continue
+		}
+		if healthFactor.GTE(sdk.OneDec()) {
+			continue
+		}
+		if err := k.SeizeMultiCollateral(ctx, cdp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SeizeMultiCollateral liquidates all of the collateral in the input multi-collateral cdp.
+// The debt of the cdp is divided between its collateral types in proportion to the market value of each collateral
+// balance, and each balance is auctioned to raise its share of the debt, with the liquidation penalty of its
+// collateral type applied.
+func (k Keeper) SeizeMultiCollateral(ctx sdk.Context, cdp types.MultiCollateralCDP) error {
+	values := make([]sdk.Dec, len(cdp.Collateral))
+	totalValue := sdk.ZeroDec()
+	for i, cb := range cdp.Collateral {
+		value, err := k.calculateCollateralValue(ctx, cb, liquidation)
+		if err != nil {
+			return err
+		}
+		values[i] = value
+		totalValue = totalValue.Add(value)
+	}
+
+	// Move debt coins from cdp to liquidator account
+	debt := sdk.MinInt(cdp.GetTotalPrincipal().Amount, k.getModAccountDebt(ctx, types.ModuleName))
+	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debt)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin)); err != nil {
+		return err
+	}
+
+	allocatedDebt := sdk.ZeroInt()
+	for i, cb := range cdp.Collateral {
+		// the last collateral type is allocated the debt left over from rounding
+		debtShare := debt.Sub(allocatedDebt)
+		if i < len(cdp.Collateral)-1 {
+			if totalValue.IsPositive() {
+				debtShare = values[i].Quo(totalValue).MulInt(debt).TruncateInt()
+			} else {
+				debtShare = debt.QuoRaw(int64(len(cdp.Collateral)))
+			}
+		}
+		allocatedDebt = allocatedDebt.Add(debtShare)
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(cb.Amount)); err != nil {
+			return err
+		}
+		deposit := types.NewDeposit(cdp.ID, cdp.Owner, cb.Amount)
+		if err := k.AuctionCollateral(ctx, types.Deposits{deposit}, cb.Type, debtShare, cdp.Principal.Denom); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+				sdk.NewAttribute(types.AttributeKeyCollateralType, cb.Type),
+			),
+		)
+	}
+
+	// Decrement total principal for the debt type
+	k.DecrementTotalPrincipal(ctx, cdp.DebtType, cdp.GetTotalPrincipal())
+
+	k.DeleteMultiCollateralCdp(ctx, cdp)
+	return nil
+}
+
+// GetMultiCollateralCdp returns the multi-collateral cdp with the input id
+func (k Keeper) GetMultiCollateralCdp(ctx sdk.Context, cdpID uint64) (types.MultiCollateralCDP, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	bz := store.Get(types.GetCdpIDBytes(cdpID))
+	if bz == nil {
+		return types.MultiCollateralCDP{}, false
+	}
+	var cdp types.MultiCollateralCDP
+	k.cdc.MustUnmarshal(bz, &cdp)
+	return cdp, true
+}
+
+// GetMultiCollateralCdpByOwner returns the multi-collateral cdp of an owner
+func (k Keeper) GetMultiCollateralCdpByOwner(ctx sdk.Context, owner sdk.AccAddress) (types.MultiCollateralCDP, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpOwnerKeyPrefix)
+	bz := store.Get(owner)
+	if bz == nil {
+		return types.MultiCollateralCDP{}, false
+	}
+	return k.GetMultiCollateralCdp(ctx, types.GetCdpIDFromBytes(bz))
+}
+
+// SetMultiCollateralCdp sets a multi-collateral cdp and its owner index in the store
+func (k Keeper) SetMultiCollateralCdp(ctx sdk.Context, cdp types.MultiCollateralCDP) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	store.Set(types.GetCdpIDBytes(cdp.ID), k.cdc.MustMarshal(&cdp))
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpOwnerKeyPrefix)
+	ownerStore.Set(cdp.Owner, types.GetCdpIDBytes(cdp.ID))
+}
+
+// SetMultiCollateralCdpAndHealthIndex sets a multi-collateral cdp in the store and indexes it by its health factor at
+// liquidation prices. If a collateral price is unavailable the cdp is indexed with a health factor of zero, so it is
+// checked first by the next liquidation run.
+func (k Keeper) SetMultiCollateralCdpAndHealthIndex(ctx sdk.Context, cdp types.MultiCollateralCDP) {
+	k.SetMultiCollateralCdp(ctx, cdp)
+
+	healthFactor, err := k.CalculateMultiCollateralHealthFactor(ctx, cdp.Collateral, cdp.GetTotalPrincipal(), liquidation)
+	if err != nil {
+		healthFactor = sdk.ZeroDec()
+	}
+	k.IndexMultiCollateralCdpByHealthFactor(ctx, cdp, healthFactor)
+}
+
+// IndexMultiCollateralCdpByHealthFactor replaces the health factor index entry of a multi-collateral cdp
+func (k Keeper) IndexMultiCollateralCdpByHealthFactor(ctx sdk.Context, cdp types.MultiCollateralCDP, healthFactor sdk.Dec) {
+	k.removeMultiCollateralHealthIndex(ctx, cdp.ID)
+
+	indexKey := types.CollateralRatioKey(cdp.DebtType, cdp.ID, healthFactor)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpHealthIndexPrefix)
+	store.Set(indexKey, types.GetCdpIDBytes(cdp.ID))
+
+	// the indexed key is stored by id so the entry can be removed once prices have changed
+	indexedStore := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpIndexedHealthKeyPrefix)
+	indexedStore.Set(types.GetCdpIDBytes(cdp.ID), indexKey)
+}
+
+// DeleteMultiCollateralCdp deletes a multi-collateral cdp and its indexes from the store
+func (k Keeper) DeleteMultiCollateralCdp(ctx sdk.Context, cdp types.MultiCollateralCDP) {
+	k.removeMultiCollateralHealthIndex(ctx, cdp.ID)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	store.Delete(types.GetCdpIDBytes(cdp.ID))
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpOwnerKeyPrefix)
+	ownerStore.Delete(cdp.Owner)
+
+	k.deleteCdpManagers(ctx, cdp.ID)
+}
+
+func (k Keeper) removeMultiCollateralHealthIndex(ctx sdk.Context, cdpID uint64) {
+	indexedStore := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpIndexedHealthKeyPrefix)
+	indexKey := indexedStore.Get(types.GetCdpIDBytes(cdpID))
+	if indexKey == nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpHealthIndexPrefix)
+	store.Delete(indexKey)
+	indexedStore.Delete(types.GetCdpIDBytes(cdpID))
+}
+
+// IterateMultiCollateralCdps iterates over all multi-collateral cdps and performs a callback function
+func (k Keeper) IterateMultiCollateralCdps(ctx sdk.Context, cb func(cdp types.MultiCollateralCDP) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var cdp types.MultiCollateralCDP
+		k.cdc.MustUnmarshal(iterator.Value(), &cdp)
+		if cb(cdp) {
+			break
+		}
+	}
+}
+
+// GetAllMultiCollateralCdps returns all multi-collateral cdps from the store
+func (k Keeper) GetAllMultiCollateralCdps(ctx sdk.Context) (cdps types.MultiCollateralCDPs) {
+	k.IterateMultiCollateralCdps(ctx, func(cdp types.MultiCollateralCDP) bool {
+		cdps = append(cdps, cdp)
+		return false
+	})
+	return
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type MultiCollateralTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *MultiCollateralTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	coins := []sdk.Coins{
+		cs(c("xrp", 500000000), c("btc", 500000000), c("usdx", 10000000000)),
+		cs(c("xrp", 200000000)),
+	}
+
+	authGS := app.NewFundedGenStateWithCoins(cdc, coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	// 400 xrp at $0.25 with a liquidation ratio of 2.0 and 0.001 btc at $8000 with a liquidation ratio of 1.5
+	// support $55.33 of debt
+	collateral := types.CollateralBalances{
+		types.NewCollateralBalance("xrp-a", c("xrp", 400000000)),
+		types.NewCollateralBalance("btc-a", c("btc", 100000)),
+	}
+	err := suite.keeper.AddMultiCollateralCdp(suite.ctx, addrs[0], collateral, c("usdx", 50000000), "xrp-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *MultiCollateralTestSuite) TestAddMultiCollateralCdp() {
+	cdp, found := suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(uint64(1), cdp.ID)
+	suite.Equal("xrp-a", cdp.DebtType)
+	suite.Equal(i(50000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+	suite.Equal(uint64(2), suite.keeper.GetNextCdpID(suite.ctx))
+
+	acc := suite.app.GetAccountKeeper().GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(
+		cs(c("xrp", 400000000), c("btc", 100000), c("debt", 50000000)),
+		suite.app.GetBankKeeper().GetAllBalances(suite.ctx, acc.GetAddress()),
+	)
+
+	collateral := types.CollateralBalances{types.NewCollateralBalance("xrp-a", c("xrp", 100000000))}
+	err := suite.keeper.AddMultiCollateralCdp(suite.ctx, suite.addrs[0], collateral, c("usdx", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrCdpAlreadyExists))
+
+	// 100 xrp only supports $12.50 of debt
+	err = suite.keeper.AddMultiCollateralCdp(suite.ctx, suite.addrs[1], collateral, c("usdx", 20000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+}
+
+func (suite *MultiCollateralTestSuite) TestDepositWithdrawMultiCollateral() {
+	err := suite.keeper.DepositMultiCollateral(suite.ctx, suite.addrs[0], c("btc", 100000), "btc-a")
+	suite.Require().NoError(err)
+	cdp, _ := suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	amount, found := cdp.Collateral.AmountOf("btc-a")
+	suite.Require().True(found)
+	suite.Equal(c("btc", 200000), amount)
+
+	err = suite.keeper.DepositMultiCollateral(suite.ctx, suite.addrs[1], c("xrp", 100000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+
+	err = suite.keeper.WithdrawMultiCollateral(suite.ctx, suite.addrs[0], c("btc", 300000), "btc-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidWithdrawAmount))
+
+	// withdrawing all xrp leaves $10.67 of weighted collateral value for $50 of debt
+	err = suite.keeper.WithdrawMultiCollateral(suite.ctx, suite.addrs[0], c("xrp", 400000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+
+	// withdrawing all btc leaves the xrp balance at its liquidation ratio
+	err = suite.keeper.WithdrawMultiCollateral(suite.ctx, suite.addrs[0], c("btc", 200000), "btc-a")
+	suite.Require().NoError(err)
+	cdp, _ = suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	_, found = cdp.Collateral.AmountOf("btc-a")
+	suite.False(found)
+}
+
+func (suite *MultiCollateralTestSuite) TestDrawRepayMultiCollateralDebt() {
+	err := suite.keeper.AddMultiCollateralPrincipal(suite.ctx, suite.addrs[0], c("usdx", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+
+	err = suite.keeper.AddMultiCollateralPrincipal(suite.ctx, suite.addrs[0], c("usdx", 5000000))
+	suite.Require().NoError(err)
+	suite.Equal(i(55000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	err = suite.keeper.RepayMultiCollateralPrincipal(suite.ctx, suite.addrs[0], c("usdx", 50000000))
+	suite.Require().True(errors.Is(err, types.ErrBelowDebtFloor))
+
+	err = suite.keeper.RepayMultiCollateralPrincipal(suite.ctx, suite.addrs[0], c("usdx", 55000000))
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(
+		cs(c("xrp", 500000000), c("btc", 500000000), c("usdx", 10000000000)),
+		bk.GetAllBalances(suite.ctx, suite.addrs[0]),
+	)
+}
+
+func (suite *MultiCollateralTestSuite) TestLiquidateMultiCollateralCdps() {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	_, err := pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd:30", d("0.3"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "xrp:usd:30"))
+
+	err = suite.keeper.LiquidateMultiCollateralCdps(suite.ctx, "xrp-a", i(10))
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.True(found)
+
+	// 400 xrp at $0.20 and 0.001 btc at $8000 support $45.33 of debt
+	_, err = pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd:30", d("0.2"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "xrp:usd:30"))
+
+	err = suite.keeper.LiquidateMultiCollateralCdps(suite.ctx, "xrp-a", i(10))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	// the debt is split between the collateral auctions in proportion to the value of the collateral,
+	// $80 of xrp and $8 of btc
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 2)
+	suite.Equal(c("xrp", 400000000), auctions[0].GetLot())
+	suite.Equal(c("btc", 100000), auctions[1].GetLot())
+
+	// the auction module account holds the debt for the duration of the auctions
+	acc := suite.app.GetAccountKeeper().GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(i(50000000).String(), suite.app.GetBankKeeper().GetBalance(suite.ctx, acc.GetAddress(), "debt").Amount.String())
+}
+
+func (suite *MultiCollateralTestSuite) TestRefreshMultiCollateralHealthIndex() {
+	collateral := types.CollateralBalances{types.NewCollateralBalance("xrp-a", c("xrp", 200000000))}
+	err := suite.keeper.AddMultiCollateralCdp(suite.ctx, suite.addrs[1], collateral, c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	_, err = pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd:30", d("0.2"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "xrp:usd:30"))
+
+	// the undercollateralized cdp is indexed at a health factor from before the price drop
+	cdp, found := suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.keeper.IndexMultiCollateralCdpByHealthFactor(suite.ctx, cdp, d("10"))
+
+	err = suite.keeper.LiquidateMultiCollateralCdps(suite.ctx, "xrp-a", i(1))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.True(found)
+
+	// refreshing the index re-indexes the cdp at current prices, so it is checked first
+	suite.keeper.RefreshMultiCollateralHealthIndex(suite.ctx, i(10))
+	err = suite.keeper.LiquidateMultiCollateralCdps(suite.ctx, "xrp-a", i(1))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	_, found = suite.keeper.GetMultiCollateralCdpByOwner(suite.ctx, suite.addrs[1])
+	suite.True(found)
+}
+
+func TestMultiCollateralTestSuite(t *testing.T) {
+	suite.Run(t, new(MultiCollateralTestSuite))
+}
@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// HandleGlobalSettlementProposal is a handler for executing a passed global settlement proposal
+func HandleGlobalSettlementProposal(ctx sdk.Context, k Keeper, p *types.GlobalSettlementProposal) error {
+	return k.GlobalSettle(ctx, p.FinalPrices)
+}
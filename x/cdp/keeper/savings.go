@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// DistributeSavingsRate pays the stability fees reserved for the savings rate to savings depositors of the debt denom
+// if the savings distribution frequency has elapsed since the previous distribution. Fees that can't be distributed,
+// such as when there are no savings depositors, are sent to the liquidator module account as surplus.
+func (k Keeper) DistributeSavingsRate(ctx sdk.Context) error {
+	previousDistributionTime, found := k.GetPreviousSavingsDistributionTime(ctx)
+	if !found {
+		k.SetPreviousSavingsDistributionTime(ctx, ctx.BlockTime())
+		return nil
+	}
+
+	dp := k.GetParams(ctx).DebtParam
+	if ctx.BlockTime().Sub(previousDistributionTime) < dp.SavingsDistributionFrequency {
+		return nil
+	}
+	k.SetPreviousSavingsDistributionTime(ctx, ctx.BlockTime())
+
+	pending := k.GetPendingSavings(ctx)
+	if !pending.IsPositive() {
+		return nil
+	}
+	k.SetPendingSavings(ctx, sdk.ZeroInt())
+
+	distributed, err := k.savingsKeeper.DistributeYield(ctx, types.ModuleName, sdk.NewCoin(dp.Denom, pending))
+	if err != nil {
+		return err
+	}
+
+	remainder := pending.Sub(distributed.Amount)
+	if remainder.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, remainder)))
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsRateDistribution,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, distributed.String()),
+			sdk.NewAttribute(types.AttributeKeySurplus, sdk.NewCoin(dp.Denom, remainder).String()),
+		),
+	)
+	return nil
+}
+
+// calculateSavingsShare returns the portion of accumulated interest that is reserved for the savings rate
+func (k Keeper) calculateSavingsShare(dp types.DebtParam, interestAccumulated sdkmath.Int) sdkmath.Int {
+	if !dp.SavingsRateEnabled() {
+		return sdk.ZeroInt()
+	}
+	return sdk.NewDecFromInt(interestAccumulated).Mul(dp.SavingsRate).TruncateInt()
+}
+
+// GetPreviousSavingsDistributionTime returns the time the savings rate was last distributed
+func (k Keeper) GetPreviousSavingsDistributionTime(ctx sdk.Context) (time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PreviousSavingsDistributionTimeKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var previousDistributionTime time.Time
+	if err := previousDistributionTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	return previousDistributionTime, true
+}
+
+// SetPreviousSavingsDistributionTime sets the time the savings rate was last distributed
+func (k Keeper) SetPreviousSavingsDistributionTime(ctx sdk.Context, previousDistributionTime time.Time) {
+	store := ctx.KVStore(k.key)
+	bz, err := previousDistributionTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.PreviousSavingsDistributionTimeKey, bz)
+}
+
+// GetPendingSavings returns the amount of stability fees reserved for the savings rate that has not been distributed
+func (k Keeper) GetPendingSavings(ctx sdk.Context) (pending sdkmath.Int) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PendingSavingsKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	if err := pending.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return pending
+}
+
+// SetPendingSavings sets the amount of stability fees reserved for the savings rate that has not been distributed
+func (k Keeper) SetPendingSavings(ctx sdk.Context, pending sdkmath.Int) {
+	store := ctx.KVStore(k.key)
+	bz, err := pending.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.PendingSavingsKey, bz)
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
+)
+
+type SavingsRateTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *SavingsRateTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewFundedGenStateWithSameCoins(cdc, cs(c("usdx", 1000000000)), addrs)
+	savingsGS := savingstypes.NewGenesisState(savingstypes.NewParams([]string{"usdx"}), savingstypes.Deposits{})
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+		app.GenesisState{savingstypes.ModuleName: cdc.MustMarshalJSON(&savingsGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.addrs = addrs
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParam.SavingsRate = d("0.5")
+	params.DebtParam.SavingsDistributionFrequency = time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+// accumulateOneYear accrues one year of interest on a total principal of 100,000,000 usdx for bnb-a
+func (suite *SavingsRateTestSuite) accumulateOneYear() {
+	suite.keeper.SetTotalPrincipal(suite.ctx, "bnb-a", types.DefaultStableDenom, sdkmath.NewInt(100000000000000))
+	suite.keeper.SetPreviousAccrualTime(suite.ctx, "bnb-a", suite.ctx.BlockTime())
+	suite.keeper.SetInterestFactor(suite.ctx, "bnb-a", sdk.OneDec())
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 31536000))
+	err := suite.keeper.AccumulateInterest(suite.ctx, "bnb-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *SavingsRateTestSuite) TestAccumulateInterest_SavingsShare() {
+	suite.accumulateOneYear()
+
+	// 5000000000012 usdx of interest accrues, half of which is reserved for the savings rate
+	suite.Require().Equal(sdkmath.NewInt(2500000000006), suite.keeper.GetPendingSavings(suite.ctx))
+
+	bk := suite.app.GetBankKeeper()
+	ak := suite.app.GetAccountKeeper()
+	cdpBalance := bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.ModuleName), "usdx")
+	suite.Require().Equal(sdkmath.NewInt(2500000000006), cdpBalance.Amount)
+	liquidatorBalance := bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.LiquidatorMacc), "usdx")
+	suite.Require().Equal(sdkmath.NewInt(2500000000006), liquidatorBalance.Amount)
+}
+
+func (suite *SavingsRateTestSuite) TestAccumulateInterest_SavingsRateDisabled() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParam.SavingsRate = sdk.ZeroDec()
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.accumulateOneYear()
+
+	suite.Require().Equal(sdk.ZeroInt(), suite.keeper.GetPendingSavings(suite.ctx))
+	liquidatorBalance := suite.app.GetBankKeeper().GetBalance(suite.ctx, suite.app.GetAccountKeeper().GetModuleAddress(types.LiquidatorMacc), "usdx")
+	suite.Require().Equal(sdkmath.NewInt(5000000000012), liquidatorBalance.Amount)
+}
+
+func (suite *SavingsRateTestSuite) TestDistributeSavingsRate() {
+	sk := suite.app.GetSavingsKeeper()
+	suite.Require().NoError(sk.Deposit(suite.ctx, suite.addrs[0], cs(c("usdx", 300000000))))
+	suite.Require().NoError(sk.Deposit(suite.ctx, suite.addrs[1], cs(c("usdx", 100000000))))
+
+	// the first call only records the distribution time
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx))
+	suite.accumulateOneYear()
+	pending := suite.keeper.GetPendingSavings(suite.ctx)
+
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx))
+	suite.Require().Equal(sdk.ZeroInt(), suite.keeper.GetPendingSavings(suite.ctx))
+	previousDistributionTime, found := suite.keeper.GetPreviousSavingsDistributionTime(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.ctx.BlockTime(), previousDistributionTime)
+
+	deposit, found := sk.GetDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdx", 300000000+1875000000004)), deposit.Amount)
+	deposit, found = sk.GetDeposit(suite.ctx, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdx", 100000000+625000000001)), deposit.Amount)
+
+	// all pending savings are sent to the savings module account, rounding dust stays there
+	bk := suite.app.GetBankKeeper()
+	ak := suite.app.GetAccountKeeper()
+	suite.Require().True(bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.ModuleName), "usdx").IsZero())
+	liquidatorBalance := bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.LiquidatorMacc), "usdx")
+	suite.Require().Equal(sdkmath.NewInt(5000000000012).Sub(pending), liquidatorBalance.Amount)
+}
+
+func (suite *SavingsRateTestSuite) TestDistributeSavingsRate_NoDepositors() {
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx))
+	suite.accumulateOneYear()
+
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx))
+	suite.Require().Equal(sdk.ZeroInt(), suite.keeper.GetPendingSavings(suite.ctx))
+	liquidatorBalance := suite.app.GetBankKeeper().GetBalance(suite.ctx, suite.app.GetAccountKeeper().GetModuleAddress(types.LiquidatorMacc), "usdx")
+	suite.Require().Equal(sdkmath.NewInt(5000000000012), liquidatorBalance.Amount)
+}
+
+func (suite *SavingsRateTestSuite) TestDistributeSavingsRate_BeforeFrequency() {
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx))
+	suite.keeper.SetPendingSavings(suite.ctx, sdkmath.NewInt(100))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	suite.Require().NoError(suite.keeper.DistributeSavingsRate(suite.ctx))
+	suite.Require().Equal(sdkmath.NewInt(100), suite.keeper.GetPendingSavings(suite.ctx))
+}
+
+func TestSavingsRateTestSuite(t *testing.T) {
+	suite.Run(t, new(SavingsRateTestSuite))
+}
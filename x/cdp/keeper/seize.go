@@ -14,6 +14,9 @@ import (
 // if the cdp is liquidated, the keeper that sent the transaction is rewarded a percentage of the collateral according to that collateral types'
 // keeper reward percentage.
 func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccAddress, collateralType string) error {
+	if k.IsGloballySettled(ctx) {
+		return types.ErrGloballySettled
+	}
 	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
 	if !found {
 		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", owner, collateralType)
@@ -25,21 +28,34 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 	if err != nil {
 		return err
 	}
+	if pl, ok := k.calculatePartialLiquidation(ctx, cdp); ok {
+		return k.seizePartialCollateral(ctx, cdp, pl, keeper)
+	}
 	cdp, err = k.payoutKeeperLiquidationReward(ctx, keeper, cdp)
 	if err != nil {
 		return err
 	}
-	return k.SeizeCollateral(ctx, cdp)
+	return k.seizeAllCollateral(ctx, cdp)
 }
 
 // SeizeCollateral liquidates the collateral in the input cdp.
+// If partial liquidation is enabled for the cdp's collateral type, only enough collateral and debt to restore the
+// cdp to the liquidation target ratio are seized and the cdp remains open. Otherwise the cdp is liquidated in full.
+func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
+	if pl, ok := k.calculatePartialLiquidation(ctx, cdp); ok {
+		return k.seizePartialCollateral(ctx, cdp, pl, nil)
+	}
+	return k.seizeAllCollateral(ctx, cdp)
+}
+
+// seizeAllCollateral liquidates all of the collateral in the input cdp.
 // the following operations are performed:
 // 1. Collateral for all deposits is sent from the cdp module to the liquidator module account
 // 2. The liquidation penalty is applied
 // 3. Debt coins are sent from the cdp module to the liquidator module account
 // 4. The total amount of principal outstanding for that collateral type is decremented
 // (this is the equivalent of saying that fees are no longer accumulated by a cdp once it gets liquidated)
-func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
+func (k Keeper) seizeAllCollateral(ctx sdk.Context, cdp types.CDP) error {
 	// Calculate the previous collateral ratio
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
 
@@ -87,6 +103,190 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 	return k.DeleteCDP(ctx, cdp)
 }
 
+// partialLiquidation defines the collateral and debt removed from a cdp by a partial liquidation
+type partialLiquidation struct {
+	Collateral sdk.Coin
+	Debt       sdk.Coin
+}
+
+// calculatePartialLiquidation returns the collateral and debt to seize from the input cdp so that its
+// collateralization ratio is restored to the liquidation target ratio of its collateral type. The seized debt is
+// capped by the close factor. It returns false if partial liquidation is disabled for the collateral type or if
+// the cdp cannot be left open, in which case the cdp should be liquidated in full.
+//
+// Seizing debt d removes d * (1 + liquidation penalty) of collateral value, so for collateral value C,
+// debt D and target ratio T the debt to seize is: d = (T * D - C) / (T - (1 + liquidation penalty))
+func (k Keeper) calculatePartialLiquidation(ctx sdk.Context, cdp types.CDP) (partialLiquidation, bool) {
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found || !cp.PartialLiquidationEnabled() {
+		return partialLiquidation{}, false
+	}
+	dp, found := k.GetDebtParam(ctx, cdp.Principal.Denom)
+	if !found {
+		return partialLiquidation{}, false
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil || !price.Price.IsPositive() {
+		return partialLiquidation{}, false
+	}
+
+	penaltyMultiplier := sdk.OneDec().Add(cp.LiquidationPenalty)
+	denominator := cp.LiquidationTargetRatio.Sub(penaltyMultiplier)
+	if !denominator.IsPositive() {
+		return partialLiquidation{}, false
+	}
+
+	totalDebt := cdp.GetTotalPrincipal()
+	collateralValue := k.convertCollateralToBaseUnits(ctx, cdp.Collateral, cdp.Type).Mul(price.Price)
+	debtValue := k.convertDebtToBaseUnits(ctx, totalDebt)
+	debtValueToSeize := cp.LiquidationTargetRatio.Mul(debtValue).Sub(collateralValue).Quo(denominator)
+	if !debtValueToSeize.IsPositive() {
+		return partialLiquidation{}, false
+	}
+
+	debtConversion := sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64())
+	debtToSeize := debtValueToSeize.Quo(debtConversion).Ceil().TruncateInt()
+	maxDebtToSeize := sdk.NewDecFromInt(totalDebt.Amount).Mul(cp.CloseFactor).TruncateInt()
+	debtToSeize = sdk.MinInt(debtToSeize, maxDebtToSeize)
+	if !debtToSeize.IsPositive() {
+		return partialLiquidation{}, false
+	}
+	debt := sdk.NewCoin(totalDebt.Denom, debtToSeize)
+
+	// the remaining principal must not fall below the debt floor
+	_, principalPayment := k.calculatePayment(ctx, totalDebt, cdp.AccumulatedFees, debt)
+	if cdp.Principal.Amount.Sub(principalPayment.Amount).LT(dp.DebtFloor) {
+		return partialLiquidation{}, false
+	}
+
+	collateralConversion := sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())
+	collateralValueToSeize := k.convertDebtToBaseUnits(ctx, debt).Mul(penaltyMultiplier)
+	collateralToSeize := collateralValueToSeize.Quo(price.Price).Quo(collateralConversion).Ceil().TruncateInt()
+	if collateralToSeize.GTE(cdp.Collateral.Amount) {
+		return partialLiquidation{}, false
+	}
+
+	return partialLiquidation{
+		Collateral: sdk.NewCoin(cdp.Collateral.Denom, collateralToSeize),
+		Debt:       debt,
+	}, true
+}
+
+// seizePartialCollateral liquidates part of the collateral and debt of the input cdp.
+// the following operations are performed:
+// 1. Collateral is seized from each deposit in proportion to its share of the cdp's collateral
+// 2. If a keeper triggered the liquidation, it is rewarded a percentage of the seized collateral
+// 3. The remaining seized collateral and the corresponding debt coins are sent to the liquidator module account and auctioned
+// 4. The seized debt is removed from the cdp's fees first and then its principal, and the total principal is decremented
+// 5. The cdp is updated in the store and re-indexed by its new collateral ratio
+func (k Keeper) seizePartialCollateral(ctx sdk.Context, cdp types.CDP, pl partialLiquidation, keeper sdk.AccAddress) error {
+	deposits := k.GetDeposits(ctx, cdp.ID)
+	seized := splitDeposits(deposits, pl.Collateral.Amount)
+
+	for i, dep := range deposits {
+		dep.Amount = dep.Amount.Sub(seized[i].Amount)
+		if dep.Amount.IsZero() {
+			k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+		} else {
+			k.SetDeposit(ctx, dep)
+		}
+	}
+
+	if keeper != nil {
+		collateralParam, found := k.GetCollateral(ctx, cdp.Type)
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidCollateral, "%s", cdp.Type)
+		}
+		reward := sdk.NewDecFromInt(pl.Collateral.Amount).Mul(collateralParam.KeeperRewardPercentage).TruncateInt()
+		if reward.IsPositive() {
+			rewards := splitDeposits(seized, reward)
+			for i := range seized {
+				seized[i].Amount = seized[i].Amount.Sub(rewards[i].Amount)
+			}
+			rewardCoin := sdk.NewCoin(pl.Collateral.Denom, reward)
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, keeper, sdk.NewCoins(rewardCoin)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Move debt coins from cdp to liquidator account
+	debt := sdk.MinInt(pl.Debt.Amount, k.getModAccountDebt(ctx, types.ModuleName))
+	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debt)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin)); err != nil {
+		return err
+	}
+
+	auctionDeposits := types.Deposits{}
+	for _, dep := range seized {
+		if dep.Amount.IsZero() {
+			continue
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(dep.Amount)); err != nil {
+			return err
+		}
+		auctionDeposits = append(auctionDeposits, dep)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, dep.String()),
+			),
+		)
+	}
+
+	if len(auctionDeposits) > 0 {
+		if err := k.AuctionCollateral(ctx, auctionDeposits, cdp.Type, debt, cdp.Principal.Denom); err != nil {
+			return err
+		}
+	}
+
+	feePayment, principalPayment := k.calculatePayment(ctx, cdp.GetTotalPrincipal(), cdp.AccumulatedFees, pl.Debt)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	cdp.Principal = cdp.Principal.Sub(principalPayment)
+	cdp.Collateral = cdp.Collateral.Sub(pl.Collateral)
+
+	// Decrement total principal for this collateral type
+	k.DecrementTotalPrincipal(ctx, cdp.Type, pl.Debt)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpPartialLiquidation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateralSeized, pl.Collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyDebtSeized, pl.Debt.String()),
+		),
+	)
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// splitDeposits divides the input amount between the deposits in proportion to their size, returning the portion of
+// each deposit in the same order. Rounding remainders are allocated to the deposits in order.
+// CONTRACT: amount must not exceed the sum of the deposits.
+func splitDeposits(deposits types.Deposits, amount sdkmath.Int) types.Deposits {
+	total := deposits.SumCollateral()
+	portions := make(types.Deposits, len(deposits))
+	allocated := sdk.ZeroInt()
+	for i, dep := range deposits {
+		portion := dep.Amount.Amount.Mul(amount).Quo(total)
+		portions[i] = types.NewDeposit(dep.CdpID, dep.Depositor, sdk.NewCoin(dep.Amount.Denom, portion))
+		allocated = allocated.Add(portion)
+	}
+	remainder := amount.Sub(allocated)
+	for i := 0; remainder.IsPositive() && i < len(deposits); i++ {
+		available := deposits[i].Amount.Amount.Sub(portions[i].Amount.Amount)
+		extra := sdk.MinInt(available, remainder)
+		portions[i].Amount = portions[i].Amount.AddAmount(extra)
+		remainder = remainder.Sub(extra)
+	}
+	return portions
+}
+
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType string, liquidationRatio sdk.Dec, count sdkmath.Int) error {
 	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
@@ -281,6 +281,7 @@ func (suite *SeizeTestSuite) TestKeeperLiquidation() {
 							Addresses: []sdk.AccAddress{addr},
 							Weights:   []sdkmath.Int{sdkmath.NewInt(9900000)},
 						},
+						MarketPrice: d("190"),
 					},
 				},
 			},
@@ -320,6 +321,7 @@ func (suite *SeizeTestSuite) TestKeeperLiquidation() {
 							Addresses: []sdk.AccAddress{addr},
 							Weights:   []sdkmath.Int{sdkmath.NewInt(9900000)},
 						},
+						MarketPrice: d("190"),
 					},
 				},
 			},
@@ -446,6 +448,112 @@ func (suite *SeizeTestSuite) TestKeeperLiquidation() {
 	}
 }
 
+func (suite *SeizeTestSuite) TestPartialLiquidation() {
+	type args struct {
+		keeperLiquidation   bool
+		expectedCollateral  sdk.Coin
+		expectedPrincipal   sdk.Coin
+		expectedLot         sdk.Coin
+		expectedKeeperCoins sdk.Coins
+	}
+	type test struct {
+		name string
+		args args
+	}
+
+	testCases := []test{
+		{
+			"partial liquidation",
+			args{
+				keeperLiquidation:   false,
+				expectedCollateral:  c("btc", 6403517),
+				expectedPrincipal:   c("usdx", 666665000),
+				expectedLot:         c("btc", 3596483),
+				expectedKeeperCoins: cs(c("btc", 100000000), c("xrp", 10000000000)),
+			},
+		},
+		{
+			"keeper partial liquidation",
+			args{
+				keeperLiquidation:   true,
+				expectedCollateral:  c("btc", 6403517),
+				expectedPrincipal:   c("usdx", 666665000),
+				expectedLot:         c("btc", 3560519),
+				expectedKeeperCoins: cs(c("btc", 100035964), c("xrp", 10000000000)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// enable partial liquidation for btc-a
+			params := suite.keeper.GetParams(suite.ctx)
+			for idx, cp := range params.CollateralParams {
+				if cp.Type == "btc-a" {
+					params.CollateralParams[idx].CloseFactor = d("0.5")
+					params.CollateralParams[idx].LiquidationTargetRatio = d("2.0")
+				}
+			}
+			suite.keeper.SetParams(suite.ctx, params)
+
+			pk := suite.app.GetPriceFeedKeeper()
+			_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "btc:usd", d("20000.00"), suite.ctx.BlockTime().Add(time.Hour*24))
+			suite.Require().NoError(err)
+			err = pk.SetCurrentPrices(suite.ctx, "btc:usd")
+			suite.Require().NoError(err)
+
+			suite.keeper.SetPreviousAccrualTime(suite.ctx, "btc-a", suite.ctx.BlockTime())
+			suite.keeper.SetInterestFactor(suite.ctx, "btc-a", sdk.OneDec())
+			err = suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 10000000), c("usdx", 1333330000), "btc-a")
+			suite.Require().NoError(err)
+
+			// collateralization ratio drops to 1.425, below the liquidation ratio of 1.5
+			for _, market := range []string{"btc:usd", "btc:usd:30"} {
+				_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, market, d("19000.00"), suite.ctx.BlockTime().Add(time.Hour*24))
+				suite.Require().NoError(err)
+				err = pk.SetCurrentPrices(suite.ctx, market)
+				suite.Require().NoError(err)
+			}
+
+			if tc.args.keeperLiquidation {
+				err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "btc-a")
+			} else {
+				cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+				suite.Require().True(found)
+				err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+			}
+			suite.Require().NoError(err)
+
+			// the close factor caps the seized debt at half of the cdp's debt, and the cdp stays open
+			cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.expectedCollateral, cdp.Collateral)
+			suite.Require().Equal(tc.args.expectedPrincipal, cdp.Principal)
+			suite.Require().Equal(tc.args.expectedPrincipal.Amount, suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdx"))
+
+			deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.expectedCollateral, deposit.Amount)
+
+			augmentedCdp := suite.keeper.LoadAugmentedCDP(suite.ctx, cdp)
+			suite.Require().True(augmentedCdp.CollateralizationRatio.GT(d("1.5")))
+
+			auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+			suite.Require().Len(auctions, 1)
+			auction, ok := auctions[0].(*auctiontypes.CollateralAuction)
+			suite.Require().True(ok)
+			suite.Require().Equal(tc.args.expectedLot, auction.Lot)
+			suite.Require().Equal(c("debt", 666665000), auction.CorrespondingDebt)
+			suite.Require().Equal(c("usdx", 683331625), auction.MaxBid)
+
+			bk := suite.app.GetBankKeeper()
+			suite.Require().Equal(tc.args.expectedKeeperCoins, bk.GetAllBalances(suite.ctx, suite.addrs[1]))
+		})
+	}
+}
+
 func (suite *SeizeTestSuite) TestBeginBlockerLiquidation() {
 	type args struct {
 		ctype            string
@@ -499,6 +607,7 @@ func (suite *SeizeTestSuite) TestBeginBlockerLiquidation() {
 							Addresses: []sdk.AccAddress{addr},
 							Weights:   []sdkmath.Int{sdkmath.NewInt(10000000)},
 						},
+						MarketPrice: d("80"),
 					},
 				},
 			},
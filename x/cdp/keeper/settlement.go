@@ -0,0 +1,536 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// maxCdpsSettledPerBlock is the number of cdps settled in each block until global settlement is complete
+const maxCdpsSettledPerBlock = 200
+
+// GlobalSettle shuts down the cdp system. Every cdp is settled at the final price of the liquidation market of its
+// collateral type: the collateral backing its debt is moved to the liquidator module account and its debt is cleared,
+// leaving any excess collateral to be withdrawn by its depositors. Cdps are settled in batches, starting with this call
+// and continuing in following blocks. Once all cdps are settled the surplus and debt held by the system are burned,
+// and the collateral held by the liquidator module account becomes the redemption pool that holders of the debt asset
+// can redeem against pro-rata. Final prices override the pricefeed for the input markets.
+func (k Keeper) GlobalSettle(ctx sdk.Context, finalPrices types.SettlementPrices) error {
+	if k.IsGloballySettled(ctx) {
+		return types.ErrGloballySettled
+	}
+	params := k.GetParams(ctx)
+
+	prices := types.SettlementPrices{}
+	for _, cp := range params.CollateralParams {
+		if _, found := prices.Get(cp.LiquidationMarketID); found {
+			continue
+		}
+		price, found := finalPrices.Get(cp.LiquidationMarketID)
+		if !found {
+			currentPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+			if err != nil {
+				return errorsmod.Wrapf(types.ErrNoFinalPrice, "%s: %s", cp.LiquidationMarketID, err)
+			}
+			price = currentPrice.Price
+		}
+		prices = append(prices, types.NewSettlementPrice(cp.LiquidationMarketID, price))
+	}
+
+	for _, cp := range params.CollateralParams {
+		if err := k.AccumulateInterest(ctx, cp.Type); err != nil {
+			return err
+		}
+	}
+
+	settlement := types.NewGlobalSettlement(ctx.BlockTime(), prices, sdk.NewCoins(), sdk.ZeroInt())
+	k.SetGlobalSettlement(ctx, settlement)
+	k.RestartGlobalSettlement(ctx)
+
+	return k.ContinueGlobalSettlement(ctx)
+}
+
+// RestartGlobalSettlement settles every cdp again from the start while a global settlement is in progress, such as
+// after the settlement is imported from genesis. Settling a cdp that has already been settled has no effect.
+func (k Keeper) RestartGlobalSettlement(ctx sdk.Context) {
+	ctx.KVStore(k.key).Delete(types.SettlementMultiCdpCursorKey)
+	k.setSettlementCdpCursor(ctx, []byte{})
+}
+
+// ContinueGlobalSettlement settles the next batch of cdps while a global settlement is in progress. Cdps are settled
+// first, followed by multi-collateral cdps. Once every cdp is settled the surplus and debt held by the system are
+// burned, and the redemption pool and outstanding debt are recorded, completing the settlement.
+func (k Keeper) ContinueGlobalSettlement(ctx sdk.Context) error {
+	settlement, found := k.GetGlobalSettlement(ctx)
+	if !found || settlement.Complete {
+		return nil
+	}
+
+	if cursor, found := k.getSettlementCdpCursor(ctx); found {
+		next, err := k.settleCdpBatch(ctx, cursor, settlement.FinalPrices)
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			k.setSettlementCdpCursor(ctx, next)
+			return nil
+		}
+		ctx.KVStore(k.key).Delete(types.SettlementCdpCursorKey)
+		k.setSettlementMultiCdpCursor(ctx, 0)
+	}
+
+	if cursor, found := k.getSettlementMultiCdpCursor(ctx); found {
+		next, done, err := k.settleMultiCollateralCdpBatch(ctx, cursor, settlement.FinalPrices)
+		if err != nil {
+			return err
+		}
+		if !done {
+			k.setSettlementMultiCdpCursor(ctx, next)
+			return nil
+		}
+		ctx.KVStore(k.key).Delete(types.SettlementMultiCdpCursorKey)
+	}
+
+	return k.completeGlobalSettlement(ctx, settlement)
+}
+
+// settleCdpBatch settles up to maxCdpsSettledPerBlock cdps, starting at the input cdp key. It returns the key to
+// continue from, or nil once every cdp has been settled.
+func (k Keeper) settleCdpBatch(ctx sdk.Context, start []byte, prices types.SettlementPrices) ([]byte, error) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
+	iterator := store.Iterator(start, nil)
+	var cdps types.CDPs
+	var next []byte
+	for ; iterator.Valid(); iterator.Next() {
+		if len(cdps) == maxCdpsSettledPerBlock {
+			next = iterator.Key()
+			break
+		}
+		var cdp types.CDP
+		k.cdc.MustUnmarshal(iterator.Value(), &cdp)
+		cdps = append(cdps, cdp)
+	}
+	iterator.Close()
+
+	for _, cdp := range cdps {
+		if err := k.settleCdp(ctx, cdp, prices); err != nil {
+			return nil, err
+		}
+	}
+	return next, nil
+}
+
+// settleMultiCollateralCdpBatch settles up to maxCdpsSettledPerBlock multi-collateral cdps, starting at the input cdp
+// id. It returns the id to continue from, and true once every multi-collateral cdp has been settled.
+func (k Keeper) settleMultiCollateralCdpBatch(ctx sdk.Context, start uint64, prices types.SettlementPrices) (uint64, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCdpKeyPrefix)
+	iterator := store.Iterator(types.GetCdpIDBytes(start), nil)
+	var cdps types.MultiCollateralCDPs
+	done := true
+	for ; iterator.Valid(); iterator.Next() {
+		if len(cdps) == maxCdpsSettledPerBlock {
+			done = false
+			break
+		}
+		var cdp types.MultiCollateralCDP
+		k.cdc.MustUnmarshal(iterator.Value(), &cdp)
+		cdps = append(cdps, cdp)
+	}
+	iterator.Close()
+
+	for _, cdp := range cdps {
+		if err := k.settleMultiCollateralCdp(ctx, cdp, prices); err != nil {
+			return 0, false, err
+		}
+	}
+	if done {
+		return 0, true, nil
+	}
+	return cdps[len(cdps)-1].ID + 1, false, nil
+}
+
+// completeGlobalSettlement burns the surplus and debt held by the system and records the collateral held by the
+// liquidator module account as the redemption pool, and the supply of the debt asset as the outstanding debt
+func (k Keeper) completeGlobalSettlement(ctx sdk.Context, settlement types.GlobalSettlement) error {
+	if err := k.BurnSettlementSurplusAndDebt(ctx); err != nil {
+		return err
+	}
+	k.SetPendingSavings(ctx, sdk.ZeroInt())
+
+	params := k.GetParams(ctx)
+	liquidatorAddr := k.accountKeeper.GetModuleAddress(types.LiquidatorMacc)
+	redemptionPool := sdk.NewCoins()
+	for _, cp := range params.CollateralParams {
+		if redemptionPool.AmountOf(cp.Denom).IsPositive() {
+			continue
+		}
+		redemptionPool = redemptionPool.Add(k.bankKeeper.GetBalance(ctx, liquidatorAddr, cp.Denom))
+	}
+	outstandingDebt := k.bankKeeper.GetSupply(ctx, params.DebtParam.Denom).Amount
+
+	settlement.RedemptionPool = redemptionPool
+	settlement.OutstandingDebt = outstandingDebt
+	settlement.Complete = true
+	k.SetGlobalSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGlobalSettlement,
+			sdk.NewAttribute(types.AttributeKeyRedemptionPool, redemptionPool.String()),
+			sdk.NewAttribute(types.AttributeKeyOutstandingDebt, outstandingDebt.String()),
+		),
+	)
+	return nil
+}
+
+// settleCdp clears the debt of a cdp, moving the collateral backing the debt at the final price to the liquidator
+// module account. The collateral is taken from each deposit in proportion to its size. The cdp is deleted if no
+// collateral remains.
+func (k Keeper) settleCdp(ctx sdk.Context, cdp types.CDP, prices types.SettlementPrices) error {
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCollateralNotSupported, "%s", cdp.Type)
+	}
+	price, _ := prices.Get(cp.LiquidationMarketID)
+
+	debtValue := k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal())
+	owedAmount := k.convertBaseUnitsToCollateral(ctx, debtValue.Quo(price), cdp.Type)
+	owed := sdk.NewCoin(cdp.Collateral.Denom, sdk.MinInt(owedAmount, cdp.Collateral.Amount))
+
+	if owed.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(owed)); err != nil {
+			return err
+		}
+		deposits := k.GetDeposits(ctx, cdp.ID)
+		portions := splitDeposits(deposits, sdk.MinInt(owed.Amount, deposits.SumCollateral()))
+		for i, dep := range deposits {
+			dep.Amount = dep.Amount.Sub(portions[i].Amount)
+			if dep.Amount.IsZero() {
+				k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+			} else {
+				k.SetDeposit(ctx, dep)
+			}
+		}
+	}
+
+	if err := k.BurnDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), cdp.GetTotalPrincipal()); err != nil {
+		return err
+	}
+	k.DecrementTotalPrincipal(ctx, cdp.Type, cdp.GetTotalPrincipal())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpSettlement,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateralOwed, owed.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, cdp.Type),
+		),
+	)
+
+	cdp.Collateral = cdp.Collateral.Sub(owed)
+	if cdp.Collateral.IsZero() {
+		k.RemoveCdpOwnerIndex(ctx, cdp)
+		return k.DeleteCdpAndCollateralRatioIndex(ctx, cdp)
+	}
+	cdp.Principal = sdk.NewCoin(cdp.Principal.Denom, sdk.ZeroInt())
+	cdp.AccumulatedFees = sdk.NewCoin(cdp.AccumulatedFees.Denom, sdk.ZeroInt())
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// settleMultiCollateralCdp clears the debt of a multi-collateral cdp, moving the collateral backing the debt at the final
+// prices to the liquidator module account. The same fraction of each collateral balance is taken. The cdp is deleted
+// if no collateral remains.
+func (k Keeper) settleMultiCollateralCdp(ctx sdk.Context, cdp types.MultiCollateralCDP, prices types.SettlementPrices) error {
+	cdp = k.SynchronizeMultiCollateralInterest(ctx, cdp)
+
+	totalValue := sdk.ZeroDec()
+	for _, cb := range cdp.Collateral {
+		cp, found := k.GetCollateral(ctx, cb.Type)
+		if !found {
+			return errorsmod.Wrapf(types.ErrCollateralNotSupported, "%s", cb.Type)
+		}
+		price, _ := prices.Get(cp.LiquidationMarketID)
+		totalValue = totalValue.Add(k.convertCollateralToBaseUnits(ctx, cb.Amount, cb.Type).Mul(price))
+	}
+	debtValue := k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal())
+	owedFraction := sdk.OneDec()
+	if totalValue.GT(debtValue) {
+		owedFraction = debtValue.Quo(totalValue)
+	}
+
+	remaining := cdp.Collateral
+	for _, cb := range cdp.Collateral {
+		owedAmount := sdk.NewDecFromInt(cb.Amount.Amount).Mul(owedFraction).Ceil().TruncateInt()
+		owed := sdk.NewCoin(cb.Amount.Denom, sdk.MinInt(owedAmount, cb.Amount.Amount))
+		if !owed.IsPositive() {
+			continue
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(owed)); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(cb.Type, owed)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpSettlement,
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyCollateralOwed, owed.String()),
+				sdk.NewAttribute(types.AttributeKeyCollateralType, cb.Type),
+			),
+		)
+	}
+
+	if err := k.BurnDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), cdp.GetTotalPrincipal()); err != nil {
+		return err
+	}
+	k.DecrementTotalPrincipal(ctx, cdp.DebtType, cdp.GetTotalPrincipal())
+
+	cdp.Collateral = remaining
+	if len(cdp.Collateral) == 0 {
+		k.DeleteMultiCollateralCdp(ctx, cdp)
+		return nil
+	}
+	cdp.Principal = sdk.NewCoin(cdp.Principal.Denom, sdk.ZeroInt())
+	cdp.AccumulatedFees = sdk.NewCoin(cdp.AccumulatedFees.Denom, sdk.ZeroInt())
+	k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+	return nil
+}
+
+// BurnSettlementSurplusAndDebt burns the debt asset and debt coins held by the cdp and liquidator module accounts.
+// After global settlement debt asset sent to the system, such as the proceeds of auctions that were running at
+// settlement, can no longer be used to cover debt and is removed from circulation. Once the settlement is complete the
+// burned debt asset is removed from the outstanding debt, so the redemption pool is shared by the remaining supply.
+func (k Keeper) BurnSettlementSurplusAndDebt(ctx sdk.Context) error {
+	debtAssetDenom := k.GetParams(ctx).DebtParam.Denom
+	burnedDebtAsset := sdk.ZeroInt()
+	for _, moduleName := range []string{types.ModuleName, types.LiquidatorMacc} {
+		addr := k.accountKeeper.GetModuleAddress(moduleName)
+		for _, denom := range []string{debtAssetDenom, k.GetDebtDenom(ctx)} {
+			balance := k.bankKeeper.GetBalance(ctx, addr, denom)
+			if !balance.IsPositive() {
+				continue
+			}
+			if err := k.bankKeeper.BurnCoins(ctx, moduleName, sdk.NewCoins(balance)); err != nil {
+				return err
+			}
+			if denom == debtAssetDenom {
+				burnedDebtAsset = burnedDebtAsset.Add(balance.Amount)
+			}
+		}
+	}
+
+	settlement, found := k.GetGlobalSettlement(ctx)
+	if !found || !settlement.Complete || burnedDebtAsset.IsZero() {
+		return nil
+	}
+	settlement.OutstandingDebt = settlement.OutstandingDebt.Sub(sdk.MinInt(burnedDebtAsset, settlement.OutstandingDebt))
+	k.SetGlobalSettlement(ctx, settlement)
+	return nil
+}
+
+// RedeemDebt burns debt asset in exchange for a pro-rata share of the redemption pool after global settlement. It
+// returns the collateral paid out.
+func (k Keeper) RedeemDebt(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coins, error) {
+	settlement, found := k.GetGlobalSettlement(ctx)
+	if !found {
+		return nil, types.ErrNotGloballySettled
+	}
+	if !settlement.Complete {
+		return nil, types.ErrSettlementInProgress
+	}
+	debtDenom := k.GetParams(ctx).DebtParam.Denom
+	if amount.Denom != debtDenom {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRedemption, "denom %s, expected %s", amount.Denom, debtDenom)
+	}
+	if amount.Amount.GT(settlement.OutstandingDebt) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRedemption, "amount %s exceeds outstanding debt %s", amount, settlement.OutstandingDebt)
+	}
+	if err := k.ValidateBalance(ctx, amount, sender); err != nil {
+		return nil, err
+	}
+
+	payout := settlement.CalculateRedemption(amount.Amount)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.LiquidatorMacc, sdk.NewCoins(amount)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(amount)); err != nil {
+		return nil, err
+	}
+	if !payout.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidatorMacc, sender, payout); err != nil {
+			return nil, err
+		}
+	}
+
+	settlement.RedemptionPool = settlement.RedemptionPool.Sub(payout...)
+	settlement.OutstandingDebt = settlement.OutstandingDebt.Sub(amount.Amount)
+	k.SetGlobalSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemUSDX,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralOwed, payout.String()),
+		),
+	)
+	return payout, nil
+}
+
+// withdrawSettledCollateral withdraws the collateral of a deposit that was left in a cdp after global settlement
+func (k Keeper) withdrawSettledCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	if !k.isGlobalSettlementComplete(ctx) {
+		return types.ErrSettlementInProgress
+	}
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	if collateral.Denom != cdp.Collateral.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidCollateral, "collateral %s, expected %s", collateral.Denom, cdp.Collateral.Denom)
+	}
+	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "depositor %s, collateral %s %s", depositor, collateral.Denom, collateralType)
+	}
+	if collateral.Amount.GT(deposit.Amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, deposit %s", collateral, deposit.Amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(collateral)); err != nil {
+		return err
+	}
+
+	deposit.Amount = deposit.Amount.Sub(collateral)
+	if deposit.Amount.IsZero() {
+		k.DeleteDeposit(ctx, deposit.CdpID, deposit.Depositor)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	cdp.Collateral = cdp.Collateral.Sub(collateral)
+	if cdp.Collateral.IsZero() {
+		k.RemoveCdpOwnerIndex(ctx, cdp)
+		return k.DeleteCdpAndCollateralRatioIndex(ctx, cdp)
+	}
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// withdrawSettledMultiCollateral withdraws collateral that was left in a multi-collateral cdp after global settlement
+func (k Keeper) withdrawSettledMultiCollateral(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	if !k.isGlobalSettlementComplete(ctx) {
+		return types.ErrSettlementInProgress
+	}
+	cdp, found := k.GetMultiCollateralCdpByOwner(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s has no multi-collateral cdp", owner)
+	}
+	balance, found := cdp.Collateral.AmountOf(collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "cdp %d, collateral type %s", cdp.ID, collateralType)
+	}
+	if collateral.Denom != balance.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidCollateral, "collateral %s, expected %s", collateral.Denom, balance.Denom)
+	}
+	if collateral.Amount.GT(balance.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, deposit %s", collateral, balance)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(collateral)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, collateralType),
+		),
+	)
+
+	cdp.Collateral = cdp.Collateral.Sub(collateralType, collateral)
+	if len(cdp.Collateral) == 0 {
+		k.DeleteMultiCollateralCdp(ctx, cdp)
+		return nil
+	}
+	k.SetMultiCollateralCdpAndHealthIndex(ctx, cdp)
+	return nil
+}
+
+// convertBaseUnitsToCollateral converts an amount in base units of a collateral type to the collateral denom
+// (ie multiplies the input by 10^ConversionFactor), rounding up
+func (k Keeper) convertBaseUnitsToCollateral(ctx sdk.Context, baseUnits sdk.Dec, collateralType string) sdkmath.Int {
+	cp, _ := k.GetCollateral(ctx, collateralType)
+	return baseUnits.Quo(sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())).Ceil().TruncateInt()
+}
+
+// IsGloballySettled returns true if the cdp system has been shut down by global settlement
+func (k Keeper) IsGloballySettled(ctx sdk.Context) bool {
+	_, found := k.GetGlobalSettlement(ctx)
+	return found
+}
+
+// isGlobalSettlementComplete returns true once every cdp has been settled by global settlement
+func (k Keeper) isGlobalSettlementComplete(ctx sdk.Context) bool {
+	settlement, found := k.GetGlobalSettlement(ctx)
+	return found && settlement.Complete
+}
+
+func (k Keeper) getSettlementCdpCursor(ctx sdk.Context) ([]byte, bool) {
+	bz := ctx.KVStore(k.key).Get(types.SettlementCdpCursorKey)
+	return bz, bz != nil
+}
+
+func (k Keeper) setSettlementCdpCursor(ctx sdk.Context, cdpKey []byte) {
+	ctx.KVStore(k.key).Set(types.SettlementCdpCursorKey, cdpKey)
+}
+
+func (k Keeper) getSettlementMultiCdpCursor(ctx sdk.Context) (uint64, bool) {
+	bz := ctx.KVStore(k.key).Get(types.SettlementMultiCdpCursorKey)
+	if bz == nil {
+		return 0, false
+	}
+	return types.GetCdpIDFromBytes(bz), true
+}
+
+func (k Keeper) setSettlementMultiCdpCursor(ctx sdk.Context, cdpID uint64) {
+	ctx.KVStore(k.key).Set(types.SettlementMultiCdpCursorKey, types.GetCdpIDBytes(cdpID))
+}
+
+// GetGlobalSettlement returns the state of the global settlement
+func (k Keeper) GetGlobalSettlement(ctx sdk.Context) (types.GlobalSettlement, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.GlobalSettlementKey)
+	if bz == nil {
+		return types.GlobalSettlement{}, false
+	}
+	var settlement types.GlobalSettlement
+	k.cdc.MustUnmarshal(bz, &settlement)
+	return settlement, true
+}
+
+// SetGlobalSettlement sets the state of the global settlement
+func (k Keeper) SetGlobalSettlement(ctx sdk.Context, settlement types.GlobalSettlement) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.GlobalSettlementKey, k.cdc.MustMarshal(&settlement))
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type SettlementTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *SettlementTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000)),
+			cs(c("xrp", 200000000)),
+			cs(c("xrp", 100000000)),
+		},
+		addrs,
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 10000000), "xrp-a"))
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, addrs[1], c("xrp", 200000000), c("usdx", 10000000), "xrp-a"))
+}
+
+// settle settles the system with a final xrp price of 0.5, so each cdp owes 20 xrp for its 10 usdx of debt
+func (suite *SettlementTestSuite) settle() {
+	err := suite.keeper.GlobalSettle(suite.ctx, types.SettlementPrices{types.NewSettlementPrice("xrp:usd:30", d("0.5"))})
+	suite.Require().NoError(err)
+}
+
+func (suite *SettlementTestSuite) TestGlobalSettle() {
+	suite.settle()
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 380000000), cdp.Collateral)
+	suite.Require().True(cdp.GetTotalPrincipal().IsZero())
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 380000000), deposit.Amount)
+
+	cdp, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 180000000), cdp.Collateral)
+	suite.Require().True(suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx").IsZero())
+
+	settlement, found := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("xrp", 40000000)), settlement.RedemptionPool)
+	suite.Require().Equal(i(20000000), settlement.OutstandingDebt)
+	price, found := settlement.FinalPrices.Get("xrp:usd:30")
+	suite.Require().True(found)
+	suite.Require().Equal(d("0.5"), price)
+	// markets without a final price in the proposal settle at the current price
+	price, found = settlement.FinalPrices.Get("btc:usd:30")
+	suite.Require().True(found)
+	suite.Require().Equal(d("8000.00"), price)
+
+	bk := suite.app.GetBankKeeper()
+	ak := suite.app.GetAccountKeeper()
+	suite.Require().True(bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.ModuleName), types.DefaultDebtDenom).IsZero())
+
+	_, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(stop)
+
+	err := suite.keeper.GlobalSettle(suite.ctx, types.SettlementPrices{})
+	suite.Require().True(errors.Is(err, types.ErrGloballySettled))
+}
+
+func (suite *SettlementTestSuite) TestGlobalSettle_UndercollateralizedCdp() {
+	// at a final price of 0.04 the cdp of addrs[1] is worth 8 usdx and all of its collateral is owed
+	err := suite.keeper.GlobalSettle(suite.ctx, types.SettlementPrices{types.NewSettlementPrice("xrp:usd:30", d("0.04"))})
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "xrp-a")
+	suite.Require().False(found)
+	_, found = suite.keeper.GetDeposit(suite.ctx, 2, suite.addrs[1])
+	suite.Require().False(found)
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 150000000), cdp.Collateral)
+
+	settlement, _ := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().Equal(cs(c("xrp", 450000000)), settlement.RedemptionPool)
+}
+
+func (suite *SettlementTestSuite) TestMsgsAfterSettlement() {
+	suite.settle()
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[2], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrGloballySettled))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrGloballySettled))
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrGloballySettled))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrGloballySettled))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[0], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrGloballySettled))
+}
+
+func (suite *SettlementTestSuite) TestWithdrawExcessCollateral() {
+	suite.settle()
+	bk := suite.app.GetBankKeeper()
+
+	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 380000001), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidWithdrawAmount))
+
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 80000000), "xrp-a")
+	suite.Require().NoError(err)
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 300000000), cdp.Collateral)
+
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 300000000), "xrp-a")
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().False(found)
+	suite.Require().Equal(c("xrp", 480000000), bk.GetBalance(suite.ctx, suite.addrs[0], "xrp"))
+
+	_, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(stop)
+}
+
+func (suite *SettlementTestSuite) TestRedeemDebt() {
+	_, err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrNotGloballySettled))
+
+	suite.settle()
+	bk := suite.app.GetBankKeeper()
+
+	_, err = suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("xrp", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrInvalidRedemption))
+	_, err = suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 20000001))
+	suite.Require().True(errors.Is(err, types.ErrInvalidRedemption))
+
+	payout, err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 5000000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(cs(c("xrp", 10000000)), payout)
+	suite.Require().Equal(c("usdx", 5000000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx"))
+	suite.Require().Equal(c("xrp", 110000000), bk.GetBalance(suite.ctx, suite.addrs[0], "xrp"))
+
+	settlement, _ := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().Equal(cs(c("xrp", 30000000)), settlement.RedemptionPool)
+	suite.Require().Equal(i(15000000), settlement.OutstandingDebt)
+	suite.Require().Equal(i(15000000), bk.GetSupply(suite.ctx, "usdx").Amount)
+
+	_, err = suite.keeper.RedeemDebt(suite.ctx, suite.addrs[1], c("usdx", 10000000))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 5000000))
+	suite.Require().NoError(err)
+
+	settlement, _ = suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().True(settlement.RedemptionPool.IsZero())
+	suite.Require().True(settlement.OutstandingDebt.IsZero())
+
+	_, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(stop)
+}
+
+func (suite *SettlementTestSuite) TestBurnSettlementSurplusAndDebt_ReducesOutstandingDebt() {
+	suite.settle()
+	bk := suite.app.GetBankKeeper()
+
+	// debt asset received by the system after settlement, such as auction proceeds, is burned
+	suite.Require().NoError(bk.SendCoinsFromAccountToModule(suite.ctx, suite.addrs[1], types.LiquidatorMacc, cs(c("usdx", 10000000))))
+	suite.Require().NoError(suite.keeper.BurnSettlementSurplusAndDebt(suite.ctx))
+
+	settlement, _ := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().Equal(i(10000000), settlement.OutstandingDebt)
+	suite.Require().Equal(i(10000000), bk.GetSupply(suite.ctx, "usdx").Amount)
+
+	// the remaining supply redeems the whole pool
+	payout, err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 10000000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(cs(c("xrp", 40000000)), payout)
+
+	_, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(stop)
+}
+
+func (suite *SettlementTestSuite) TestGlobalSettlementInProgress() {
+	suite.settle()
+
+	// a settlement imported from genesis before it completed settles every cdp again
+	settlement, _ := suite.keeper.GetGlobalSettlement(suite.ctx)
+	settlement.Complete = false
+	suite.keeper.SetGlobalSettlement(suite.ctx, settlement)
+	suite.keeper.RestartGlobalSettlement(suite.ctx)
+
+	_, err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 5000000))
+	suite.Require().True(errors.Is(err, types.ErrSettlementInProgress))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrSettlementInProgress))
+
+	suite.Require().NoError(suite.keeper.ContinueGlobalSettlement(suite.ctx))
+	settlement, _ = suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().True(settlement.Complete)
+	suite.Require().Equal(cs(c("xrp", 40000000)), settlement.RedemptionPool)
+	suite.Require().Equal(i(20000000), settlement.OutstandingDebt)
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 380000000), cdp.Collateral)
+}
+
+func TestSettlementTestSuite(t *testing.T) {
+	suite.Run(t, new(SettlementTestSuite))
+}
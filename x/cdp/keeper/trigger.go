@@ -0,0 +1,403 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RegisterCdpTrigger sets a trigger for the cdp of an owner and collateral type, replacing any existing trigger with
+// the same action
+func (k Keeper) RegisterCdpTrigger(
+	ctx sdk.Context, owner sdk.AccAddress, collateralType string, action types.CdpTriggerAction, triggerRatio,
+	targetRatio sdk.Dec, maxAmount sdk.Coin,
+) error {
+	if err := types.ValidateCdpTrigger(action, triggerRatio, targetRatio, maxAmount); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidCdpTrigger, err.Error())
+	}
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	if targetRatio.LTE(cp.LiquidationRatio) {
+		return errorsmod.Wrapf(types.ErrInvalidCdpTrigger, "target ratio %s must be greater than liquidation ratio %s", targetRatio, cp.LiquidationRatio)
+	}
+	expectedDenom := cdp.Collateral.Denom
+	if action == types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS {
+		expectedDenom = cdp.Principal.Denom
+	}
+	if maxAmount.Denom != expectedDenom {
+		return errorsmod.Wrapf(types.ErrInvalidCdpTrigger, "max amount denom %s does not match %s", maxAmount.Denom, expectedDenom)
+	}
+
+	k.SetCdpTrigger(ctx, types.NewCdpTrigger(cdp.ID, owner, collateralType, action, triggerRatio, targetRatio, maxAmount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCdpTrigger,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+			sdk.NewAttribute(types.AttributeKeyTriggerRatio, triggerRatio.String()),
+			sdk.NewAttribute(types.AttributeKeyTargetRatio, targetRatio.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxAmount, maxAmount.String()),
+		),
+	)
+	return nil
+}
+
+// RemoveCdpTrigger removes the trigger with the input action from the cdp of an owner and collateral type
+func (k Keeper) RemoveCdpTrigger(ctx sdk.Context, owner sdk.AccAddress, collateralType string, action types.CdpTriggerAction) error {
+	cdpID, found := k.GetCdpID(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	if _, found := k.GetCdpTrigger(ctx, cdpID, action); !found {
+		return errorsmod.Wrapf(types.ErrCdpTriggerNotFound, "cdp id %d, action %s", cdpID, action)
+	}
+	k.DeleteCdpTrigger(ctx, cdpID, action)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveCdpTrigger,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdpID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+		),
+	)
+	return nil
+}
+
+const (
+	// cdpTriggerReadGas is the gas charged to the trigger gas meter for each trigger visited
+	cdpTriggerReadGas uint64 = 1000
+	// maxCdpTriggerFailures is the number of consecutive failures after which a trigger is removed
+	maxCdpTriggerFailures uint64 = 3
+)
+
+// RunCdpTriggers runs the triggers of cdps of the input collateral type whose collateralization ratio is below their
+// trigger ratio. Triggers are visited round robin, starting after the last trigger visited in a previous block, and
+// each visit consumes gas from the input gas meter until it is exhausted. Each trigger runs in a cached context, and
+// its state changes are discarded if it fails or runs out of gas. A trigger that fails maxCdpTriggerFailures times in
+// a row is removed.
+func (k Keeper) RunCdpTriggers(ctx sdk.Context, cp types.CollateralParam, gasMeter storetypes.GasMeter) {
+	if gasMeter.IsOutOfGas() {
+		return
+	}
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerCollateralIndexPrefix)
+	store := prefix.NewStore(indexStore, types.CdpTriggerCollateralPrefix(cp.Type))
+	start := k.getCdpTriggerCursor(ctx, cp.Type)
+	next := start
+	wrapped := false
+	for {
+		if gasMeter.GasRemaining() < cdpTriggerReadGas {
+			ctx.Logger().Debug(fmt.Sprintf("x/cdp trigger gas limit reached, skipping remaining triggers for %s", cp.Type))
+			break
+		}
+		gasMeter.ConsumeGas(cdpTriggerReadGas, "cdp trigger read")
+
+		key, found := nextCdpTriggerKey(store, next)
+		if !found || (wrapped && bytes.Compare(key, start) >= 0) {
+			if wrapped || start == nil {
+				// every trigger of the collateral type has been visited
+				next = start
+				break
+			}
+			wrapped = true
+			next = nil
+			continue
+		}
+		// the smallest key after the trigger visited
+		next = append(key, 0x00)
+
+		cdpID, action := types.SplitCdpTriggerKey(key)
+		t, found := k.GetCdpTrigger(ctx, cdpID, action)
+		if !found {
+			continue
+		}
+		k.handleCdpTrigger(ctx, t, gasMeter)
+	}
+	k.setCdpTriggerCursor(ctx, cp.Type, next)
+}
+
+// handleCdpTrigger runs a trigger in a cached context, writing its state changes and emitting an event if its action
+// ran. The failures of the trigger are counted, and the trigger is removed after maxCdpTriggerFailures in a row.
+func (k Keeper) handleCdpTrigger(ctx sdk.Context, t types.CdpTrigger, gasMeter storetypes.GasMeter) {
+	cacheCtx, write := ctx.WithGasMeter(gasMeter).CacheContext()
+	gasBefore := gasMeter.GasConsumed()
+	ratio, amount, ran, err := k.runCdpTrigger(cacheCtx, t)
+	if err != nil {
+		ctx.Logger().Debug(fmt.Sprintf("x/cdp trigger %s for cdp %d failed: %s", t.Action, t.CdpID, err))
+		k.recordCdpTriggerFailure(ctx, t, err)
+		return
+	}
+	if !ran {
+		return
+	}
+	write()
+
+	if t.ConsecutiveFailures > 0 {
+		// the action may have closed the cdp, removing its triggers
+		if t, found := k.GetCdpTrigger(ctx, t.CdpID, t.Action); found {
+			t.ConsecutiveFailures = 0
+			k.SetCdpTrigger(ctx, t)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpTrigger,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", t.CdpID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, t.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, t.CollateralType),
+			sdk.NewAttribute(types.AttributeKeyAction, t.Action.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralRatio, ratio.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasMeter.GasConsumed()-gasBefore)),
+		),
+	)
+}
+
+// recordCdpTriggerFailure increments the consecutive failures of a trigger, removing it once it reaches
+// maxCdpTriggerFailures
+func (k Keeper) recordCdpTriggerFailure(ctx sdk.Context, t types.CdpTrigger, err error) {
+	t.ConsecutiveFailures++
+	if t.ConsecutiveFailures < maxCdpTriggerFailures {
+		k.SetCdpTrigger(ctx, t)
+		return
+	}
+	k.DeleteCdpTrigger(ctx, t.CdpID, t.Action)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpTriggerDisabled,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", t.CdpID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, t.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, t.CollateralType),
+			sdk.NewAttribute(types.AttributeKeyAction, t.Action.String()),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+// nextCdpTriggerKey returns the first key of a store of trigger keys that is not before start
+func nextCdpTriggerKey(store sdk.KVStore, start []byte) ([]byte, bool) {
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, false
+	}
+	return append([]byte{}, iterator.Key()...), true
+}
+
+func (k Keeper) getCdpTriggerCursor(ctx sdk.Context, collateralType string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerCursorKeyPrefix)
+	return store.Get([]byte(collateralType))
+}
+
+func (k Keeper) setCdpTriggerCursor(ctx sdk.Context, collateralType string, key []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerCursorKeyPrefix)
+	if len(key) == 0 {
+		store.Delete([]byte(collateralType))
+		return
+	}
+	store.Set([]byte(collateralType), key)
+}
+
+// runCdpTrigger runs the action of a trigger if the collateralization ratio of its cdp is below the trigger ratio. It
+// returns the ratio before the action, the amount spent and true if the action ran. An out of gas panic is returned as
+// an error.
+func (k Keeper) runCdpTrigger(ctx sdk.Context, t types.CdpTrigger) (ratio sdk.Dec, amount sdk.Coin, ran bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			ran = false
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+		}
+	}()
+
+	cdp, found := k.GetCDP(ctx, t.CollateralType, t.CdpID)
+	if !found {
+		return ratio, amount, false, errorsmod.Wrapf(types.ErrCdpNotFound, "cdp id %d", t.CdpID)
+	}
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	ratio, err = k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	if err != nil {
+		return ratio, amount, false, err
+	}
+	if !t.Triggered(ratio) {
+		return ratio, amount, false, nil
+	}
+	if !ratio.IsPositive() {
+		return ratio, amount, false, errorsmod.Wrapf(types.ErrInvalidCdpTrigger, "cdp %d has no collateral", cdp.ID)
+	}
+
+	switch t.Action {
+	case types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS:
+		amount, err = k.repayFromSavings(ctx, t, cdp, ratio)
+	case types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET:
+		amount, err = k.depositFromWallet(ctx, t, cdp, ratio)
+	default:
+		err = errorsmod.Wrapf(types.ErrInvalidCdpTrigger, "invalid action %s", t.Action)
+	}
+	if err != nil {
+		return ratio, amount, false, err
+	}
+	return ratio, amount, true, nil
+}
+
+// repayFromSavings withdraws debt asset from the savings deposit of the owner and repays the cdp, reducing its debt so
+// the collateralization ratio reaches the target ratio. The debt remaining is kept above the debt floor.
+func (k Keeper) repayFromSavings(ctx sdk.Context, t types.CdpTrigger, cdp types.CDP, ratio sdk.Dec) (sdk.Coin, error) {
+	debt := cdp.GetTotalPrincipal()
+	// collateral value is ratio * debt, so the ratio reaches the target when debt falls to ratio * debt / target
+	payment := sdk.NewDecFromInt(debt.Amount).Mul(sdk.OneDec().Sub(ratio.Quo(t.TargetRatio))).Ceil().TruncateInt()
+	payment = sdkmath.MinInt(payment, t.MaxAmount.Amount)
+
+	deposit, found := k.savingsKeeper.GetDeposit(ctx, t.Owner)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidCdpTrigger, "%s has no savings deposit", t.Owner)
+	}
+	payment = sdkmath.MinInt(payment, deposit.Amount.AmountOf(debt.Denom))
+
+	debtFloor := k.GetParams(ctx).DebtParam.DebtFloor
+	if payment.LT(debt.Amount) && debt.Amount.Sub(payment).LT(debtFloor) {
+		payment = debt.Amount.Sub(debtFloor)
+	}
+	if !payment.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidCdpTrigger, "no %s available to repay cdp %d", debt.Denom, cdp.ID)
+	}
+
+	coin := sdk.NewCoin(debt.Denom, payment)
+	if err := k.savingsKeeper.Withdraw(ctx, t.Owner, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.RepayPrincipal(ctx, t.Owner, cdp.Type, coin); err != nil {
+		return sdk.Coin{}, err
+	}
+	return coin, nil
+}
+
+// depositFromWallet deposits collateral from the account of the owner to the cdp, increasing its collateral so the
+// collateralization ratio reaches the target ratio
+func (k Keeper) depositFromWallet(ctx sdk.Context, t types.CdpTrigger, cdp types.CDP, ratio sdk.Dec) (sdk.Coin, error) {
+	// collateral value is linear in the collateral amount, so the ratio reaches the target at collateral * target / ratio
+	amount := sdk.NewDecFromInt(cdp.Collateral.Amount).Mul(t.TargetRatio.Sub(ratio)).Quo(ratio).Ceil().TruncateInt()
+	amount = sdkmath.MinInt(amount, t.MaxAmount.Amount)
+	amount = sdkmath.MinInt(amount, k.bankKeeper.SpendableCoins(ctx, t.Owner).AmountOf(cdp.Collateral.Denom))
+	if !amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidCdpTrigger, "no %s available to deposit to cdp %d", cdp.Collateral.Denom, cdp.ID)
+	}
+
+	coin := sdk.NewCoin(cdp.Collateral.Denom, amount)
+	if err := k.DepositCollateral(ctx, t.Owner, t.Owner, coin, cdp.Type); err != nil {
+		return sdk.Coin{}, err
+	}
+	return coin, nil
+}
+
+// GetCdpTrigger returns the trigger of a cdp with the input action
+func (k Keeper) GetCdpTrigger(ctx sdk.Context, cdpID uint64, action types.CdpTriggerAction) (types.CdpTrigger, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerKeyPrefix)
+	bz := store.Get(types.CdpTriggerKey(cdpID, action))
+	if bz == nil {
+		return types.CdpTrigger{}, false
+	}
+	var t types.CdpTrigger
+	k.cdc.MustUnmarshal(bz, &t)
+	return t, true
+}
+
+// SetCdpTrigger sets a trigger of a cdp and indexes it by collateral type
+func (k Keeper) SetCdpTrigger(ctx sdk.Context, t types.CdpTrigger) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerKeyPrefix)
+	store.Set(types.CdpTriggerKey(t.CdpID, t.Action), k.cdc.MustMarshal(&t))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerCollateralIndexPrefix)
+	indexStore.Set(types.CdpTriggerCollateralKey(t.CollateralType, t.CdpID, t.Action), types.GetCdpIDBytes(t.CdpID))
+}
+
+// DeleteCdpTrigger deletes the trigger of a cdp with the input action
+func (k Keeper) DeleteCdpTrigger(ctx sdk.Context, cdpID uint64, action types.CdpTriggerAction) {
+	t, found := k.GetCdpTrigger(ctx, cdpID, action)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerKeyPrefix)
+	store.Delete(types.CdpTriggerKey(cdpID, action))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerCollateralIndexPrefix)
+	indexStore.Delete(types.CdpTriggerCollateralKey(t.CollateralType, cdpID, action))
+}
+
+// deleteCdpTriggers deletes all triggers of a cdp
+func (k Keeper) deleteCdpTriggers(ctx sdk.Context, cdpID uint64) {
+	for _, t := range k.GetCdpTriggers(ctx, cdpID) {
+		k.DeleteCdpTrigger(ctx, cdpID, t.Action)
+	}
+}
+
+// IterateCdpTriggers iterates over the triggers of a cdp and performs a callback function
+func (k Keeper) IterateCdpTriggers(ctx sdk.Context, cdpID uint64, cb func(t types.CdpTrigger) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetCdpIDBytes(cdpID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var t types.CdpTrigger
+		k.cdc.MustUnmarshal(iterator.Value(), &t)
+		if cb(t) {
+			break
+		}
+	}
+}
+
+// IterateAllCdpTriggers iterates over the triggers of all cdps in order of cdp id and performs a callback function
+func (k Keeper) IterateAllCdpTriggers(ctx sdk.Context, cb func(t types.CdpTrigger) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpTriggerKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var t types.CdpTrigger
+		k.cdc.MustUnmarshal(iterator.Value(), &t)
+		if cb(t) {
+			break
+		}
+	}
+}
+
+// GetCdpTriggers returns the triggers of a cdp
+func (k Keeper) GetCdpTriggers(ctx sdk.Context, cdpID uint64) (triggers types.CdpTriggers) {
+	k.IterateCdpTriggers(ctx, cdpID, func(t types.CdpTrigger) bool {
+		triggers = append(triggers, t)
+		return false
+	})
+	return
+}
+
+// GetAllCdpTriggers returns the triggers of all cdps
+func (k Keeper) GetAllCdpTriggers(ctx sdk.Context) (triggers types.CdpTriggers) {
+	k.IterateAllCdpTriggers(ctx, func(t types.CdpTrigger) bool {
+		triggers = append(triggers, t)
+		return false
+	})
+	return
+}
@@ -0,0 +1,286 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
+)
+
+type CdpTriggerTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	owner  sdk.AccAddress
+}
+
+func (suite *CdpTriggerTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	authGS := app.NewFundedGenStateWithSameCoins(cdc, cs(c("xrp", 500000000)), addrs)
+	savingsGS := savingstypes.NewGenesisState(savingstypes.NewParams([]string{"usdx"}), savingstypes.Deposits{})
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+		app.GenesisState{savingstypes.ModuleName: cdc.MustMarshalJSON(&savingsGS)},
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.owner = addrs[0]
+
+	// 400 xrp at 0.25 usd backs 20 usdx of debt, a collateralization ratio of 5.0
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, suite.owner, c("xrp", 400000000), c("usdx", 20000000), "xrp-a"))
+}
+
+func (suite *CdpTriggerTestSuite) runTriggers() {
+	cp, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	suite.keeper.RunCdpTriggers(suite.ctx, cp, sdk.NewGasMeter(types.DefaultTriggerGasLimit))
+}
+
+func (suite *CdpTriggerTestSuite) cdp() types.CDP {
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.owner, "xrp-a")
+	suite.Require().True(found)
+	return cdp
+}
+
+func (suite *CdpTriggerTestSuite) triggerEvents() []sdk.Event {
+	var events []sdk.Event
+	for _, e := range suite.ctx.EventManager().Events() {
+		if e.Type == types.EventTypeCdpTrigger {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func (suite *CdpTriggerTestSuite) TestRegisterCdpTrigger() {
+	repay := types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS
+	deposit := types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET
+
+	err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "bnb-a", deposit, d("3.0"), d("4.0"), c("bnb", 100))
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", deposit, d("1.5"), d("1.8"), c("xrp", 100))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCdpTrigger))
+	err = suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", repay, d("3.0"), d("4.0"), c("xrp", 100))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCdpTrigger))
+	err = suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", deposit, d("4.0"), d("3.0"), c("xrp", 100))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCdpTrigger))
+
+	suite.Require().NoError(suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", deposit, d("3.0"), d("4.0"), c("xrp", 100)))
+	suite.Require().NoError(suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", deposit, d("3.0"), d("5.0"), c("xrp", 200)))
+	suite.Require().NoError(suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", repay, d("3.0"), d("4.0"), c("usdx", 100)))
+
+	suite.Require().Equal(types.CdpTriggers{
+		types.NewCdpTrigger(1, suite.owner, "xrp-a", repay, d("3.0"), d("4.0"), c("usdx", 100)),
+		types.NewCdpTrigger(1, suite.owner, "xrp-a", deposit, d("3.0"), d("5.0"), c("xrp", 200)),
+	}, suite.keeper.GetCdpTriggers(suite.ctx, 1))
+}
+
+func (suite *CdpTriggerTestSuite) TestRemoveCdpTrigger() {
+	deposit := types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET
+
+	err := suite.keeper.RemoveCdpTrigger(suite.ctx, suite.owner, "xrp-a", deposit)
+	suite.Require().True(errors.Is(err, types.ErrCdpTriggerNotFound))
+
+	suite.Require().NoError(suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", deposit, d("3.0"), d("4.0"), c("xrp", 100)))
+	suite.Require().NoError(suite.keeper.RemoveCdpTrigger(suite.ctx, suite.owner, "xrp-a", deposit))
+	suite.Require().Empty(suite.keeper.GetCdpTriggers(suite.ctx, 1))
+}
+
+func (suite *CdpTriggerTestSuite) TestClosedCdpRemovesTriggers() {
+	err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, d("3.0"), d("4.0"), c("xrp", 100))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.keeper.RepayPrincipal(suite.ctx, suite.owner, "xrp-a", c("usdx", 20000000)))
+	suite.Require().Empty(suite.keeper.GetCdpTriggers(suite.ctx, 1))
+}
+
+func (suite *CdpTriggerTestSuite) TestDepositFromWallet() {
+	testCases := []struct {
+		name               string
+		targetRatio        sdk.Dec
+		maxAmount          sdk.Coin
+		expectedCollateral sdk.Coin
+	}{
+		// reaching a ratio of 5.5 requires 40 xrp
+		{"reaches target ratio", d("5.5"), c("xrp", 1000000000), c("xrp", 440000000)},
+		{"limited by max amount", d("5.5"), c("xrp", 10000000), c("xrp", 410000000)},
+		// reaching a ratio of 7.0 requires 160 xrp, the owner has 100 xrp
+		{"limited by balance", d("7.0"), c("xrp", 1000000000), c("xrp", 500000000)},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, d("5.1"), tc.targetRatio, tc.maxAmount)
+			suite.Require().NoError(err)
+
+			suite.runTriggers()
+
+			suite.Require().Equal(tc.expectedCollateral, suite.cdp().Collateral)
+			events := suite.triggerEvents()
+			suite.Require().Len(events, 1)
+			suite.Require().Contains(events[0].Attributes, sdk.NewAttribute(sdk.AttributeKeyAmount, tc.expectedCollateral.Sub(c("xrp", 400000000)).String()).ToKVPair())
+		})
+	}
+}
+
+func (suite *CdpTriggerTestSuite) TestRepayFromSavings() {
+	sk := suite.app.GetSavingsKeeper()
+	suite.Require().NoError(sk.Deposit(suite.ctx, suite.owner, cs(c("usdx", 10000000))))
+
+	err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS, d("5.1"), d("8.0"), c("usdx", 100000000))
+	suite.Require().NoError(err)
+
+	suite.runTriggers()
+
+	// reaching a ratio of 8.0 requires repaying 7.5 usdx
+	suite.Require().Equal(c("usdx", 12500000), suite.cdp().GetTotalPrincipal())
+	deposit, found := sk.GetDeposit(suite.ctx, suite.owner)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdx", 2500000)), deposit.Amount)
+	suite.Require().Len(suite.triggerEvents(), 1)
+}
+
+func (suite *CdpTriggerTestSuite) TestRepayFromSavings_DebtFloor() {
+	sk := suite.app.GetSavingsKeeper()
+	suite.Require().NoError(sk.Deposit(suite.ctx, suite.owner, cs(c("usdx", 20000000))))
+
+	err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS, d("5.1"), d("20.0"), c("usdx", 100000000))
+	suite.Require().NoError(err)
+
+	suite.runTriggers()
+
+	// reaching a ratio of 20.0 requires repaying 15 usdx, the debt remaining is kept at the debt floor
+	suite.Require().Equal(c("usdx", 10000000), suite.cdp().GetTotalPrincipal())
+}
+
+func (suite *CdpTriggerTestSuite) TestTriggerNotRun() {
+	testCases := []struct {
+		name     string
+		setup    func()
+		gasMeter sdk.GasMeter
+	}{
+		{
+			"ratio above trigger ratio",
+			func() {
+				err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, d("4.0"), d("6.0"), c("xrp", 100000000))
+				suite.Require().NoError(err)
+			},
+			sdk.NewGasMeter(types.DefaultTriggerGasLimit),
+		},
+		{
+			"action fails",
+			func() {
+				err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS, d("5.1"), d("8.0"), c("usdx", 100000000))
+				suite.Require().NoError(err)
+			},
+			sdk.NewGasMeter(types.DefaultTriggerGasLimit),
+		},
+		{
+			"out of gas",
+			func() {
+				err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, d("5.1"), d("6.0"), c("xrp", 100000000))
+				suite.Require().NoError(err)
+			},
+			sdk.NewGasMeter(1000),
+		},
+		{
+			"triggers disabled",
+			func() {
+				err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, d("5.1"), d("6.0"), c("xrp", 100000000))
+				suite.Require().NoError(err)
+			},
+			sdk.NewGasMeter(0),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.setup()
+			cdp := suite.cdp()
+
+			cp, _ := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+			suite.keeper.RunCdpTriggers(suite.ctx, cp, tc.gasMeter)
+
+			suite.Require().Equal(cdp, suite.cdp())
+			suite.Require().Empty(suite.triggerEvents())
+		})
+	}
+}
+
+func (suite *CdpTriggerTestSuite) TestTriggerRemovedAfterFailures() {
+	repay := types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS
+	// the owner has no savings deposit, so the action fails every time it runs
+	err := suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", repay, d("5.1"), d("8.0"), c("usdx", 100000000))
+	suite.Require().NoError(err)
+
+	suite.runTriggers()
+	suite.runTriggers()
+	t, found := suite.keeper.GetCdpTrigger(suite.ctx, 1, repay)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), t.ConsecutiveFailures)
+
+	suite.runTriggers()
+	_, found = suite.keeper.GetCdpTrigger(suite.ctx, 1, repay)
+	suite.Require().False(found)
+
+	var disabled []sdk.Event
+	for _, e := range suite.ctx.EventManager().Events() {
+		if e.Type == types.EventTypeCdpTriggerDisabled {
+			disabled = append(disabled, e)
+		}
+	}
+	suite.Require().Len(disabled, 1)
+	suite.Require().Contains(disabled[0].Attributes, sdk.NewAttribute(types.AttributeKeyAction, repay.String()).ToKVPair())
+}
+
+func (suite *CdpTriggerTestSuite) TestTriggersRunRoundRobin() {
+	repay := types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS
+	deposit := types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET
+	suite.Require().NoError(suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", repay, d("5.1"), d("8.0"), c("usdx", 100000000)))
+	suite.Require().NoError(suite.keeper.RegisterCdpTrigger(suite.ctx, suite.owner, "xrp-a", deposit, d("5.1"), d("6.0"), c("xrp", 100000000)))
+	cp, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+
+	failures := func(action types.CdpTriggerAction) uint64 {
+		t, found := suite.keeper.GetCdpTrigger(suite.ctx, 1, action)
+		suite.Require().True(found)
+		return t.ConsecutiveFailures
+	}
+
+	// each gas budget only covers visiting a single trigger, which then runs out of gas
+	suite.keeper.RunCdpTriggers(suite.ctx, cp, sdk.NewGasMeter(1000))
+	suite.Require().Equal(uint64(1), failures(repay))
+	suite.Require().Equal(uint64(0), failures(deposit))
+
+	suite.keeper.RunCdpTriggers(suite.ctx, cp, sdk.NewGasMeter(1000))
+	suite.Require().Equal(uint64(1), failures(repay))
+	suite.Require().Equal(uint64(1), failures(deposit))
+
+	// a full budget continues from the repay trigger, and a successful run resets the failures of the deposit trigger
+	suite.runTriggers()
+	suite.Require().Equal(uint64(2), failures(repay))
+	suite.Require().Equal(uint64(0), failures(deposit))
+	suite.Require().Len(suite.triggerEvents(), 1)
+}
+
+func TestCdpTriggerTestSuite(t *testing.T) {
+	suite.Run(t, new(CdpTriggerTestSuite))
+}
@@ -30,6 +30,76 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CdpManagerScope defines the operations a cdp manager is authorized to perform on behalf of the owner of a cdp. Each
+// scope includes the operations of the scopes before it.
+type CdpManagerScope int32
+
+const (
+	// CDP_MANAGER_SCOPE_UNSPECIFIED defines an invalid scope.
+	CDP_MANAGER_SCOPE_UNSPECIFIED CdpManagerScope = 0
+	// CDP_MANAGER_SCOPE_REPAY authorizes repaying debt.
+	CDP_MANAGER_SCOPE_REPAY CdpManagerScope = 1
+	// CDP_MANAGER_SCOPE_REBALANCE additionally authorizes depositing and withdrawing collateral.
+	CDP_MANAGER_SCOPE_REBALANCE CdpManagerScope = 2
+	// CDP_MANAGER_SCOPE_FULL additionally authorizes drawing debt.
+	CDP_MANAGER_SCOPE_FULL CdpManagerScope = 3
+)
+
+var CdpManagerScope_name = map[int32]string{
+	0: "CDP_MANAGER_SCOPE_UNSPECIFIED",
+	1: "CDP_MANAGER_SCOPE_REPAY",
+	2: "CDP_MANAGER_SCOPE_REBALANCE",
+	3: "CDP_MANAGER_SCOPE_FULL",
+}
+
+var CdpManagerScope_value = map[string]int32{
+	"CDP_MANAGER_SCOPE_UNSPECIFIED": 0,
+	"CDP_MANAGER_SCOPE_REPAY":       1,
+	"CDP_MANAGER_SCOPE_REBALANCE":   2,
+	"CDP_MANAGER_SCOPE_FULL":        3,
+}
+
+func (x CdpManagerScope) String() string {
+	return proto.EnumName(CdpManagerScope_name, int32(x))
+}
+
+func (CdpManagerScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{0}
+}
+
+// CdpTriggerAction defines the action a cdp trigger takes when the collateralization ratio of a cdp falls below the
+// trigger ratio.
+type CdpTriggerAction int32
+
+const (
+	// CDP_TRIGGER_ACTION_UNSPECIFIED defines an invalid action.
+	CDP_TRIGGER_ACTION_UNSPECIFIED CdpTriggerAction = 0
+	// CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS repays debt from the savings deposit of the owner.
+	CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS CdpTriggerAction = 1
+	// CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET deposits collateral from the account of the owner.
+	CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET CdpTriggerAction = 2
+)
+
+var CdpTriggerAction_name = map[int32]string{
+	0: "CDP_TRIGGER_ACTION_UNSPECIFIED",
+	1: "CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS",
+	2: "CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET",
+}
+
+var CdpTriggerAction_value = map[string]int32{
+	"CDP_TRIGGER_ACTION_UNSPECIFIED":         0,
+	"CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS":  1,
+	"CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET": 2,
+}
+
+func (x CdpTriggerAction) String() string {
+	return proto.EnumName(CdpTriggerAction_name, int32(x))
+}
+
+func (CdpTriggerAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{1}
+}
+
 // CDP defines the state of a single collateralized debt position.
 type CDP struct {
 	ID              uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -231,57 +301,366 @@ func (m *OwnerCDPIndex) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OwnerCDPIndex proto.InternalMessageInfo
 
+// MultiCollateralCDP defines the state of a collateralized debt position backed by several collateral types.
+type MultiCollateralCDP struct {
+	ID    uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// debt_type is the collateral type whose stability fee and debt limit apply to the debt of the cdp.
+	DebtType        string                                 `protobuf:"bytes,3,opt,name=debt_type,json=debtType,proto3" json:"debt_type,omitempty"`
+	Collateral      CollateralBalances                     `protobuf:"bytes,4,rep,name=collateral,proto3,castrepeated=CollateralBalances" json:"collateral"`
+	Principal       types.Coin                             `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal"`
+	AccumulatedFees types.Coin                             `protobuf:"bytes,6,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
+	FeesUpdated     time.Time                              `protobuf:"bytes,7,opt,name=fees_updated,json=feesUpdated,proto3,stdtime" json:"fees_updated"`
+	InterestFactor  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_factor"`
+}
+
+func (m *MultiCollateralCDP) Reset()         { *m = MultiCollateralCDP{} }
+func (m *MultiCollateralCDP) String() string { return proto.CompactTextString(m) }
+func (*MultiCollateralCDP) ProtoMessage()    {}
+func (*MultiCollateralCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{5}
+}
+func (m *MultiCollateralCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCollateralCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCollateralCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiCollateralCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCollateralCDP.Merge(m, src)
+}
+func (m *MultiCollateralCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCollateralCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCollateralCDP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiCollateralCDP proto.InternalMessageInfo
+
+// CollateralBalance defines the amount of one collateral type held by a multi-collateral cdp
+type CollateralBalance struct {
+	Type   string     `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *CollateralBalance) Reset()         { *m = CollateralBalance{} }
+func (m *CollateralBalance) String() string { return proto.CompactTextString(m) }
+func (*CollateralBalance) ProtoMessage()    {}
+func (*CollateralBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{6}
+}
+func (m *CollateralBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralBalance.Merge(m, src)
+}
+func (m *CollateralBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralBalance proto.InternalMessageInfo
+
+// GlobalSettlement defines the state of the cdp system after it has been shut down by governance
+type GlobalSettlement struct {
+	// settlement_time is the block time at which the system was settled
+	SettlementTime time.Time `protobuf:"bytes,1,opt,name=settlement_time,json=settlementTime,proto3,stdtime" json:"settlement_time"`
+	// final_prices are the prices at which all cdps were settled
+	FinalPrices SettlementPrices `protobuf:"bytes,2,rep,name=final_prices,json=finalPrices,proto3,castrepeated=SettlementPrices" json:"final_prices"`
+	// redemption_pool is the collateral held by the liquidator module account that remains to be redeemed
+	RedemptionPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=redemption_pool,json=redemptionPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"redemption_pool"`
+	// outstanding_debt is the amount of debt asset that has not been redeemed for collateral
+	OutstandingDebt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=outstanding_debt,json=outstandingDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outstanding_debt"`
+	// complete is true once every cdp has been settled and the redemption pool and outstanding debt are recorded
+	Complete bool `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (m *GlobalSettlement) Reset()         { *m = GlobalSettlement{} }
+func (m *GlobalSettlement) String() string { return proto.CompactTextString(m) }
+func (*GlobalSettlement) ProtoMessage()    {}
+func (*GlobalSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{7}
+}
+func (m *GlobalSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalSettlement.Merge(m, src)
+}
+func (m *GlobalSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalSettlement proto.InternalMessageInfo
+
+// SettlementPrice defines the final price of a market at global settlement
+type SettlementPrice struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *SettlementPrice) Reset()         { *m = SettlementPrice{} }
+func (m *SettlementPrice) String() string { return proto.CompactTextString(m) }
+func (*SettlementPrice) ProtoMessage()    {}
+func (*SettlementPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{8}
+}
+func (m *SettlementPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlementPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementPrice.Merge(m, src)
+}
+func (m *SettlementPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementPrice proto.InternalMessageInfo
+
+// CdpManager defines an authorization for an account to operate a cdp on behalf of its owner. Funds are always
+// moved to and from the owner.
+type CdpManager struct {
+	CdpID   uint64                                        `protobuf:"varint,1,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
+	Owner   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Manager github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=manager,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"manager,omitempty"`
+	Scope   CdpManagerScope                               `protobuf:"varint,4,opt,name=scope,proto3,enum=kava.cdp.v1beta1.CdpManagerScope" json:"scope,omitempty"`
+	// expiry is the time after which the authorization is no longer valid
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *CdpManager) Reset()         { *m = CdpManager{} }
+func (m *CdpManager) String() string { return proto.CompactTextString(m) }
+func (*CdpManager) ProtoMessage()    {}
+func (*CdpManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{9}
+}
+func (m *CdpManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CdpManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CdpManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CdpManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CdpManager.Merge(m, src)
+}
+func (m *CdpManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *CdpManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_CdpManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CdpManager proto.InternalMessageInfo
+
+// CdpTrigger defines an action run at begin block when the collateralization ratio of a cdp falls below the trigger
+// ratio. The action restores the ratio to the target ratio, spending at most max_amount each time it runs.
+type CdpTrigger struct {
+	CdpID          uint64                                        `protobuf:"varint,1,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
+	Owner          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	CollateralType string                                        `protobuf:"bytes,3,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Action         CdpTriggerAction                              `protobuf:"varint,4,opt,name=action,proto3,enum=kava.cdp.v1beta1.CdpTriggerAction" json:"action,omitempty"`
+	TriggerRatio   github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,5,opt,name=trigger_ratio,json=triggerRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_ratio"`
+	TargetRatio    github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,6,opt,name=target_ratio,json=targetRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_ratio"`
+	MaxAmount      types.Coin                                    `protobuf:"bytes,7,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount"`
+	// consecutive_failures is the number of times in a row the action has failed
+	ConsecutiveFailures uint64 `protobuf:"varint,8,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+}
+
+func (m *CdpTrigger) Reset()         { *m = CdpTrigger{} }
+func (m *CdpTrigger) String() string { return proto.CompactTextString(m) }
+func (*CdpTrigger) ProtoMessage()    {}
+func (*CdpTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a9ab097fb7be40, []int{10}
+}
+func (m *CdpTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CdpTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CdpTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CdpTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CdpTrigger.Merge(m, src)
+}
+func (m *CdpTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *CdpTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_CdpTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CdpTrigger proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("kava.cdp.v1beta1.CdpManagerScope", CdpManagerScope_name, CdpManagerScope_value)
+	proto.RegisterEnum("kava.cdp.v1beta1.CdpTriggerAction", CdpTriggerAction_name, CdpTriggerAction_value)
 	proto.RegisterType((*CDP)(nil), "kava.cdp.v1beta1.CDP")
 	proto.RegisterType((*Deposit)(nil), "kava.cdp.v1beta1.Deposit")
 	proto.RegisterType((*TotalPrincipal)(nil), "kava.cdp.v1beta1.TotalPrincipal")
 	proto.RegisterType((*TotalCollateral)(nil), "kava.cdp.v1beta1.TotalCollateral")
 	proto.RegisterType((*OwnerCDPIndex)(nil), "kava.cdp.v1beta1.OwnerCDPIndex")
+	proto.RegisterType((*MultiCollateralCDP)(nil), "kava.cdp.v1beta1.MultiCollateralCDP")
+	proto.RegisterType((*CollateralBalance)(nil), "kava.cdp.v1beta1.CollateralBalance")
+	proto.RegisterType((*GlobalSettlement)(nil), "kava.cdp.v1beta1.GlobalSettlement")
+	proto.RegisterType((*SettlementPrice)(nil), "kava.cdp.v1beta1.SettlementPrice")
+	proto.RegisterType((*CdpManager)(nil), "kava.cdp.v1beta1.CdpManager")
+	proto.RegisterType((*CdpTrigger)(nil), "kava.cdp.v1beta1.CdpTrigger")
 }
 
 func init() { proto.RegisterFile("kava/cdp/v1beta1/cdp.proto", fileDescriptor_68a9ab097fb7be40) }
 
 var fileDescriptor_68a9ab097fb7be40 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xae, 0xbb, 0x36, 0x5b, 0xbd, 0xb1, 0x4e, 0x06, 0xa1, 0xac, 0x87, 0xa4, 0x1a, 0x02, 0x7a,
-	0x69, 0xa2, 0x01, 0x12, 0x17, 0x10, 0x5a, 0x1a, 0x0d, 0xca, 0x85, 0x29, 0x1a, 0x17, 0x0e, 0x54,
-	0xae, 0xed, 0x96, 0x68, 0x69, 0x1c, 0xc5, 0xee, 0xd8, 0x1e, 0x02, 0x69, 0x0f, 0xb3, 0x87, 0xd8,
-	0x81, 0xc3, 0xb4, 0x13, 0xe2, 0x10, 0x20, 0x7b, 0x0b, 0x4e, 0xc8, 0x4e, 0xba, 0xec, 0x58, 0x24,
-	0x38, 0xf5, 0xf7, 0xc7, 0xdf, 0xf7, 0x73, 0x7f, 0xdf, 0x17, 0xc3, 0xce, 0x11, 0x3e, 0xc6, 0x2e,
-	0xa1, 0x89, 0x7b, 0xbc, 0x3b, 0x66, 0x12, 0xef, 0xaa, 0xd8, 0x49, 0x52, 0x2e, 0x39, 0xda, 0x52,
-	0x3d, 0x47, 0xe5, 0x65, 0xaf, 0x63, 0x11, 0x2e, 0x66, 0x5c, 0xb8, 0x63, 0x2c, 0x58, 0x05, 0xe0,
-	0x61, 0x5c, 0x20, 0x3a, 0xdb, 0x45, 0x7f, 0xa4, 0x33, 0xb7, 0x48, 0xca, 0xd6, 0xbd, 0x29, 0x9f,
-	0xf2, 0xa2, 0xae, 0xa2, 0xb2, 0x6a, 0x4f, 0x39, 0x9f, 0x46, 0xcc, 0xd5, 0xd9, 0x78, 0x3e, 0x71,
-	0x65, 0x38, 0x63, 0x42, 0xe2, 0x59, 0x79, 0x87, 0x9d, 0x2f, 0x0d, 0xb8, 0x32, 0xf0, 0x0f, 0xd0,
-	0x7d, 0x58, 0x0f, 0xa9, 0x09, 0xba, 0xa0, 0xd7, 0xf0, 0x8c, 0x3c, 0xb3, 0xeb, 0x43, 0x3f, 0xa8,
-	0x87, 0x14, 0x7d, 0x84, 0x4d, 0xfe, 0x39, 0x66, 0xa9, 0x59, 0xef, 0x82, 0xde, 0x86, 0xf7, 0xe6,
-	0x77, 0x66, 0xf7, 0xa7, 0xa1, 0xfc, 0x34, 0x1f, 0x3b, 0x84, 0xcf, 0xca, 0x2b, 0x94, 0x3f, 0x7d,
-	0x41, 0x8f, 0x5c, 0x79, 0x9a, 0x30, 0xe1, 0xec, 0x11, 0xb2, 0x47, 0x69, 0xca, 0x84, 0xb8, 0x3a,
-	0xef, 0xdf, 0x2d, 0x2f, 0x5a, 0x56, 0xbc, 0x53, 0xc9, 0x44, 0x50, 0xd0, 0x22, 0x04, 0x1b, 0x0a,
-	0x61, 0xae, 0x74, 0x41, 0xaf, 0x15, 0xe8, 0x18, 0xbd, 0x82, 0x90, 0xf0, 0x28, 0xc2, 0x92, 0xa5,
-	0x38, 0x32, 0x1b, 0x5d, 0xd0, 0x5b, 0x7f, 0xb2, 0xed, 0x94, 0x24, 0x6a, 0x35, 0x8b, 0x7d, 0x39,
-	0x03, 0x1e, 0xc6, 0x5e, 0xe3, 0x22, 0xb3, 0x6b, 0xc1, 0x2d, 0x08, 0x7a, 0x09, 0x5b, 0x49, 0x1a,
-	0xc6, 0x24, 0x4c, 0x70, 0x64, 0x36, 0x97, 0xc3, 0x57, 0x08, 0xf4, 0x16, 0x6e, 0x61, 0x42, 0xe6,
-	0xb3, 0xb9, 0xe2, 0xa3, 0xa3, 0x09, 0x63, 0xc2, 0x34, 0x96, 0x63, 0x69, 0xdf, 0x02, 0xee, 0x33,
-	0x26, 0xd0, 0x6b, 0xb8, 0xa1, 0xf0, 0xa3, 0x79, 0x42, 0x55, 0xcd, 0x5c, 0xd5, 0x3c, 0x1d, 0xa7,
-	0xd0, 0xc5, 0x59, 0xe8, 0xe2, 0x1c, 0x2e, 0x74, 0xf1, 0xd6, 0x14, 0xd1, 0xd9, 0x0f, 0x1b, 0x04,
-	0xeb, 0x0a, 0xf9, 0xbe, 0x00, 0x22, 0x06, 0xdb, 0x61, 0x2c, 0x59, 0xca, 0x84, 0x1c, 0x4d, 0x30,
-	0x91, 0x3c, 0x35, 0xd7, 0xd4, 0xce, 0xbc, 0x17, 0xea, 0xfc, 0xf7, 0xcc, 0x7e, 0xb4, 0x84, 0x2c,
-	0x3e, 0x23, 0x57, 0xe7, 0x7d, 0x58, 0xfe, 0x09, 0x9f, 0x91, 0x60, 0x73, 0x41, 0xba, 0xaf, 0x39,
-	0x77, 0xbe, 0x02, 0xb8, 0xea, 0xb3, 0x84, 0x8b, 0x50, 0xa2, 0x2e, 0x34, 0x08, 0x4d, 0x46, 0x37,
-	0xbe, 0x68, 0xe5, 0x99, 0xdd, 0x1c, 0xd0, 0x64, 0xe8, 0x07, 0x4d, 0x42, 0x93, 0x21, 0x45, 0x13,
-	0xd8, 0xa2, 0xc5, 0x61, 0x5e, 0x38, 0xa4, 0xf5, 0x0f, 0x1d, 0x52, 0x51, 0xa3, 0xe7, 0xd0, 0xc0,
-	0x33, 0x3e, 0x8f, 0xa5, 0xf6, 0xc9, 0x12, 0x3a, 0x94, 0xc7, 0x77, 0x52, 0xb8, 0x79, 0xc8, 0x25,
-	0x8e, 0x0e, 0x6e, 0xc4, 0x7d, 0x0c, 0xdb, 0x95, 0x53, 0x46, 0xda, 0x7b, 0x40, 0x7b, 0x6f, 0xb3,
-	0x2a, 0x1f, 0x2a, 0x17, 0x56, 0x33, 0xeb, 0x7f, 0x37, 0x53, 0xc0, 0xb6, 0x9e, 0x39, 0xa8, 0x0c,
-	0xf9, 0xff, 0x87, 0x3e, 0x83, 0x77, 0xde, 0xa9, 0x0f, 0x6a, 0xe0, 0x1f, 0x0c, 0x63, 0xca, 0x4e,
-	0xd0, 0x03, 0xb8, 0x5a, 0x88, 0x27, 0x4c, 0xd0, 0x5d, 0xe9, 0x35, 0x3c, 0x98, 0x67, 0xb6, 0xa1,
-	0xd5, 0x13, 0x81, 0xa1, 0xe5, 0x13, 0xde, 0xe0, 0xe2, 0x97, 0x55, 0xbb, 0xc8, 0x2d, 0x70, 0x99,
-	0x5b, 0xe0, 0x67, 0x6e, 0x81, 0xb3, 0x6b, 0xab, 0x76, 0x79, 0x6d, 0xd5, 0xbe, 0x5d, 0x5b, 0xb5,
-	0x0f, 0x0f, 0x6f, 0xc9, 0xa8, 0x9e, 0xaa, 0x7e, 0x84, 0xc7, 0x42, 0x47, 0xee, 0x89, 0x7e, 0xd2,
-	0xb4, 0x92, 0x63, 0x43, 0x9b, 0xf8, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0xe3, 0x65,
-	0xc4, 0xeb, 0x04, 0x00, 0x00,
+	// 1241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0xe7, 0xdf, 0x26, 0x6f, 0xb7, 0x9b, 0x30, 0xad, 0x8a, 0x9b, 0x8a, 0x24, 0x4d, 0xd5,
+	0x92, 0x56, 0xda, 0x84, 0x16, 0xa4, 0x4a, 0xa8, 0x80, 0xf2, 0x6f, 0x17, 0xa3, 0xfd, 0x13, 0x39,
+	0x29, 0x08, 0x0e, 0x35, 0x13, 0x7b, 0x36, 0x58, 0xb5, 0x3d, 0x96, 0x67, 0x52, 0xb6, 0xdf, 0x80,
+	0x0b, 0x52, 0x25, 0xf8, 0x06, 0xdc, 0x7a, 0xee, 0x91, 0x2b, 0x52, 0x0f, 0x1c, 0xaa, 0x9e, 0x10,
+	0x87, 0x14, 0xd2, 0x13, 0x5f, 0x81, 0x13, 0x9a, 0xb1, 0x77, 0x1d, 0x65, 0x23, 0x94, 0x45, 0x5b,
+	0xc1, 0x81, 0x53, 0x66, 0xe6, 0xbd, 0xdf, 0xef, 0x3d, 0xbf, 0xf7, 0x9b, 0x37, 0x0a, 0x14, 0x1f,
+	0xe0, 0x87, 0xb8, 0x61, 0x5a, 0x7e, 0xe3, 0xe1, 0xad, 0x21, 0xe1, 0xf8, 0x96, 0x58, 0xd7, 0xfd,
+	0x80, 0x72, 0x8a, 0x0a, 0xc2, 0x56, 0x17, 0xfb, 0xc8, 0x56, 0x2c, 0x99, 0x94, 0xb9, 0x94, 0x35,
+	0x86, 0x98, 0x91, 0x18, 0x40, 0x6d, 0x2f, 0x44, 0x14, 0x2f, 0x85, 0x76, 0x43, 0xee, 0x1a, 0xe1,
+	0x26, 0x32, 0x5d, 0x18, 0xd1, 0x11, 0x0d, 0xcf, 0xc5, 0x2a, 0x3a, 0x2d, 0x8f, 0x28, 0x1d, 0x39,
+	0xa4, 0x21, 0x77, 0xc3, 0xf1, 0x41, 0x83, 0xdb, 0x2e, 0x61, 0x1c, 0xbb, 0x51, 0x0e, 0xd5, 0x6f,
+	0x53, 0x90, 0x6c, 0x77, 0x7a, 0xe8, 0x22, 0x24, 0x6c, 0x4b, 0x55, 0x2a, 0x4a, 0x2d, 0xd5, 0xca,
+	0x4c, 0x27, 0xe5, 0x84, 0xd6, 0xd1, 0x13, 0xb6, 0x85, 0xee, 0x43, 0x9a, 0x7e, 0xed, 0x91, 0x40,
+	0x4d, 0x54, 0x94, 0xda, 0x7a, 0xeb, 0xe3, 0x3f, 0x27, 0xe5, 0xcd, 0x91, 0xcd, 0xbf, 0x1a, 0x0f,
+	0xeb, 0x26, 0x75, 0xa3, 0x14, 0xa2, 0x9f, 0x4d, 0x66, 0x3d, 0x68, 0xf0, 0x47, 0x3e, 0x61, 0xf5,
+	0xa6, 0x69, 0x36, 0x2d, 0x2b, 0x20, 0x8c, 0xbd, 0x78, 0xba, 0x79, 0x3e, 0x4a, 0x34, 0x3a, 0x69,
+	0x3d, 0xe2, 0x84, 0xe9, 0x21, 0x2d, 0x42, 0x90, 0x12, 0x08, 0x35, 0x59, 0x51, 0x6a, 0x39, 0x5d,
+	0xae, 0xd1, 0x47, 0x00, 0x26, 0x75, 0x1c, 0xcc, 0x49, 0x80, 0x1d, 0x35, 0x55, 0x51, 0x6a, 0x6b,
+	0xb7, 0x2f, 0xd5, 0x23, 0x12, 0x51, 0x9a, 0xa3, 0x7a, 0xd5, 0xdb, 0xd4, 0xf6, 0x5a, 0xa9, 0x67,
+	0x93, 0xf2, 0x8a, 0x3e, 0x03, 0x41, 0x1f, 0x40, 0xce, 0x0f, 0x6c, 0xcf, 0xb4, 0x7d, 0xec, 0xa8,
+	0xe9, 0xe5, 0xf0, 0x31, 0x02, 0x7d, 0x02, 0x05, 0x6c, 0x9a, 0x63, 0x77, 0x2c, 0xf8, 0x2c, 0xe3,
+	0x80, 0x10, 0xa6, 0x66, 0x96, 0x63, 0xc9, 0xcf, 0x00, 0xb7, 0x08, 0x61, 0x68, 0x1b, 0xd6, 0x05,
+	0xde, 0x18, 0xfb, 0x96, 0x38, 0x53, 0x57, 0x25, 0x4f, 0xb1, 0x1e, 0xf6, 0xa5, 0x7e, 0xd4, 0x97,
+	0xfa, 0xe0, 0xa8, 0x2f, 0xad, 0xac, 0x20, 0x7a, 0xfc, 0xb2, 0xac, 0xe8, 0x6b, 0x02, 0x79, 0x2f,
+	0x04, 0x22, 0x02, 0x79, 0xdb, 0xe3, 0x24, 0x20, 0x8c, 0x1b, 0x07, 0xd8, 0xe4, 0x34, 0x50, 0xb3,
+	0xa2, 0x66, 0xad, 0xbb, 0xc2, 0xff, 0xd7, 0x49, 0xf9, 0xfa, 0x12, 0x6d, 0xe9, 0x10, 0xf3, 0xc5,
+	0xd3, 0x4d, 0x88, 0x3e, 0xa2, 0x43, 0x4c, 0x7d, 0xe3, 0x88, 0x74, 0x4b, 0x72, 0x56, 0x7f, 0x56,
+	0x60, 0xb5, 0x43, 0x7c, 0xca, 0x6c, 0x8e, 0x2a, 0x90, 0x31, 0x2d, 0xdf, 0x38, 0xd6, 0x45, 0x6e,
+	0x3a, 0x29, 0xa7, 0xdb, 0x96, 0xaf, 0x75, 0xf4, 0xb4, 0x69, 0xf9, 0x9a, 0x85, 0x0e, 0x20, 0x67,
+	0x85, 0xce, 0x34, 0x54, 0x48, 0xee, 0x0c, 0x15, 0x12, 0x53, 0xa3, 0x3b, 0x90, 0xc1, 0x2e, 0x1d,
+	0x7b, 0x5c, 0x4d, 0x2e, 0xd7, 0x87, 0xc8, 0xbd, 0x1a, 0xc0, 0xc6, 0x80, 0x72, 0xec, 0xf4, 0x8e,
+	0x9b, 0xfb, 0x36, 0xe4, 0x63, 0xa5, 0x18, 0x52, 0x7b, 0x8a, 0xd4, 0xde, 0x46, 0x7c, 0x3c, 0x10,
+	0x2a, 0x8c, 0x63, 0x26, 0x4e, 0x17, 0x93, 0x41, 0x5e, 0xc6, 0x6c, 0xc7, 0x82, 0x7c, 0xfd, 0x41,
+	0xdf, 0x83, 0x73, 0xfb, 0xe2, 0x42, 0xb5, 0x3b, 0x3d, 0xcd, 0xb3, 0xc8, 0x21, 0xba, 0x0a, 0xab,
+	0x61, 0xf3, 0x98, 0xaa, 0x54, 0x92, 0xb5, 0x54, 0x0b, 0xa6, 0x93, 0x72, 0x46, 0x76, 0x8f, 0xe9,
+	0x19, 0xd9, 0x3e, 0x56, 0xfd, 0x29, 0x05, 0x68, 0x77, 0xec, 0x70, 0x3b, 0xce, 0xf5, 0xdf, 0x1c,
+	0x06, 0x97, 0x85, 0x9c, 0x86, 0xdc, 0x98, 0x99, 0x08, 0x59, 0x71, 0x20, 0x4b, 0x63, 0xcc, 0x4d,
+	0x85, 0x64, 0x6d, 0xed, 0xf6, 0xd5, 0xfa, 0xfc, 0x08, 0xad, 0xc7, 0x5f, 0xd2, 0xc2, 0x0e, 0xf6,
+	0x4c, 0xd2, 0x2a, 0x8a, 0x42, 0x3d, 0x79, 0x59, 0x46, 0x27, 0x4c, 0xec, 0xff, 0xa9, 0x71, 0x36,
+	0x53, 0xe3, 0x4b, 0x78, 0xe3, 0x44, 0x71, 0x8f, 0x47, 0xbb, 0x32, 0x33, 0xda, 0xff, 0xb1, 0xbe,
+	0x7f, 0x4c, 0x42, 0x61, 0xdb, 0xa1, 0x43, 0xec, 0xf4, 0x09, 0xe7, 0x0e, 0x71, 0x89, 0xc7, 0xd1,
+	0x2e, 0xe4, 0xd9, 0xf1, 0xce, 0x10, 0x4f, 0x9b, 0xaa, 0x9c, 0xa2, 0x52, 0x1b, 0x31, 0x58, 0x98,
+	0xd1, 0x7d, 0x58, 0x3f, 0xb0, 0x3d, 0xec, 0x18, 0x7e, 0x60, 0x9b, 0x84, 0xa9, 0x09, 0xa9, 0xb1,
+	0x2b, 0x27, 0x35, 0x16, 0xa7, 0xd0, 0x13, 0x9e, 0x2d, 0x35, 0x52, 0x58, 0x61, 0xce, 0xc0, 0xf4,
+	0x35, 0x49, 0x18, 0x6e, 0x10, 0x87, 0x7c, 0x40, 0x2c, 0xe2, 0xfa, 0xdc, 0xa6, 0x9e, 0xe1, 0x53,
+	0xea, 0xa8, 0xc9, 0x4a, 0xf2, 0xef, 0xab, 0xf0, 0x4e, 0x44, 0x5d, 0x5b, 0xa2, 0x4f, 0x02, 0xc0,
+	0xf4, 0x8d, 0x38, 0x46, 0x8f, 0x52, 0x07, 0x8d, 0xa0, 0x40, 0xc7, 0x9c, 0x71, 0xec, 0x59, 0xb6,
+	0x37, 0x32, 0xc4, 0x7d, 0x52, 0x53, 0xa7, 0xd6, 0x80, 0xe6, 0xf1, 0x19, 0x0d, 0x68, 0x1e, 0xd7,
+	0xf3, 0x33, 0xac, 0x1d, 0x32, 0xe4, 0xa8, 0x08, 0x59, 0x93, 0xba, 0xbe, 0x43, 0x38, 0x91, 0xd7,
+	0x27, 0xab, 0x1f, 0xef, 0xab, 0x8f, 0x15, 0xc8, 0xcf, 0x15, 0x07, 0xdd, 0x80, 0x9c, 0x8b, 0x83,
+	0x07, 0x84, 0x1f, 0xbd, 0x30, 0xb9, 0xd6, 0xfa, 0x74, 0x52, 0xce, 0xee, 0xca, 0x43, 0xad, 0xa3,
+	0x67, 0x43, 0xb3, 0x66, 0x21, 0x1d, 0xd2, 0xb2, 0x27, 0x6a, 0xe2, 0xd4, 0x89, 0x9f, 0x14, 0x6f,
+	0x48, 0x55, 0xfd, 0x23, 0x01, 0xd0, 0xb6, 0xfc, 0x5d, 0xec, 0xe1, 0x11, 0x09, 0x96, 0x78, 0xec,
+	0x5e, 0xf7, 0xf4, 0x1b, 0xc2, 0xaa, 0x1b, 0x26, 0xa3, 0x26, 0xcf, 0x38, 0xc2, 0x11, 0x31, 0xba,
+	0x03, 0x69, 0x66, 0x52, 0x9f, 0x48, 0x05, 0x6c, 0x2c, 0xd2, 0x76, 0x5c, 0x92, 0xbe, 0x70, 0xd4,
+	0x43, 0x7f, 0x74, 0x17, 0x32, 0xe4, 0xd0, 0xb7, 0x83, 0x47, 0x6a, 0xfa, 0x14, 0x37, 0x2c, 0xc2,
+	0x54, 0x9f, 0xa4, 0x64, 0xad, 0x07, 0x81, 0x3d, 0xfa, 0x6f, 0xd4, 0x7a, 0xc1, 0x83, 0x9c, 0x5c,
+	0xf8, 0x20, 0xbf, 0x0f, 0x19, 0x6c, 0x8a, 0xbb, 0x14, 0x55, 0xac, 0xba, 0xb0, 0x62, 0xd1, 0x87,
+	0x35, 0xa5, 0xa7, 0x1e, 0x21, 0x10, 0x86, 0x73, 0x3c, 0x34, 0x18, 0x01, 0xe6, 0x36, 0x55, 0xd3,
+	0x67, 0xa0, 0xde, 0xf5, 0x88, 0x52, 0x17, 0x8c, 0xc8, 0x80, 0x75, 0x8e, 0x83, 0x11, 0xe1, 0x51,
+	0x84, 0xcc, 0x19, 0x44, 0x58, 0x0b, 0x19, 0xc3, 0x00, 0x1f, 0x02, 0xb8, 0xf8, 0xd0, 0x88, 0x86,
+	0xf6, 0xea, 0x92, 0xaf, 0xa2, 0x8b, 0x0f, 0x9b, 0x12, 0x81, 0x6e, 0xc1, 0x05, 0x93, 0x7a, 0x8c,
+	0x98, 0x63, 0x6e, 0x3f, 0x24, 0xc6, 0x01, 0xb6, 0x9d, 0x71, 0x40, 0x98, 0x7c, 0x85, 0x52, 0xfa,
+	0xf9, 0x19, 0xdb, 0x56, 0x64, 0xba, 0xf9, 0xbd, 0x02, 0xf9, 0x39, 0x15, 0xa2, 0x2b, 0xf0, 0x56,
+	0xbb, 0xd3, 0x33, 0x76, 0x9b, 0x7b, 0xcd, 0xed, 0xae, 0x6e, 0xf4, 0xdb, 0xfb, 0xbd, 0xae, 0x71,
+	0x6f, 0xaf, 0xdf, 0xeb, 0xb6, 0xb5, 0x2d, 0xad, 0xdb, 0x29, 0xac, 0xa0, 0xcb, 0xf0, 0xe6, 0x49,
+	0x17, 0xbd, 0xdb, 0x6b, 0x7e, 0x5e, 0x50, 0x50, 0x19, 0x2e, 0x2f, 0x32, 0xb6, 0x9a, 0x3b, 0xcd,
+	0xbd, 0x76, 0xb7, 0x90, 0x40, 0x45, 0xb8, 0x78, 0xd2, 0x61, 0xeb, 0xde, 0xce, 0x4e, 0x21, 0x59,
+	0x4c, 0x7d, 0xf3, 0x43, 0x69, 0xe5, 0xe6, 0x77, 0x0a, 0x14, 0xe6, 0x5b, 0x8d, 0xaa, 0x50, 0x12,
+	0xb0, 0x81, 0xae, 0x6d, 0x0b, 0x58, 0xb3, 0x3d, 0xd0, 0xf6, 0xf7, 0xe6, 0x12, 0xbb, 0x01, 0xd7,
+	0x16, 0xf8, 0xc8, 0xcc, 0x8c, 0x2d, 0x7d, 0x7f, 0xd7, 0xe8, 0x37, 0x3f, 0xd5, 0xf6, 0xb6, 0xfb,
+	0x05, 0x05, 0xdd, 0x84, 0xeb, 0x0b, 0x5c, 0x3b, 0xdd, 0xde, 0x7e, 0x5f, 0x1b, 0x84, 0xce, 0x9f,
+	0x35, 0x77, 0x76, 0xba, 0x83, 0x42, 0x22, 0xcc, 0xaa, 0xd5, 0x7e, 0xf6, 0x7b, 0x69, 0xe5, 0xd9,
+	0xb4, 0xa4, 0x3c, 0x9f, 0x96, 0x94, 0xdf, 0xa6, 0x25, 0xe5, 0xf1, 0xab, 0xd2, 0xca, 0xf3, 0x57,
+	0xa5, 0x95, 0x5f, 0x5e, 0x95, 0x56, 0xbe, 0xb8, 0x36, 0x23, 0x00, 0xa1, 0xdb, 0x4d, 0x07, 0x0f,
+	0x99, 0x5c, 0x35, 0x0e, 0xe5, 0x9f, 0x52, 0xa9, 0x81, 0x61, 0x46, 0x5e, 0xe2, 0x77, 0xff, 0x1a,
+	0x00, 0x3d, 0x18, 0x36, 0x65, 0xad, 0x0e, 0x00, 0x00,
 }
 
 func (m *CDP) Marshal() (dAtA []byte, err error) {
@@ -540,118 +919,1865 @@ func (m *OwnerCDPIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCdp(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCdp(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MultiCollateralCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CDP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MultiCollateralCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCollateralCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovCdp(uint64(m.ID))
+	{
+		size := m.InterestFactor.Size()
+		i -= size
+		if _, err := m.InterestFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovCdp(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FeesUpdated):])
+	if err10 != nil {
+		return 0, err10
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovCdp(uint64(l))
+	i -= n10
+	i = encodeVarintCdp(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovCdp(uint64(l))
-	l = m.Principal.Size()
-	n += 1 + l + sovCdp(uint64(l))
-	l = m.AccumulatedFees.Size()
-	n += 1 + l + sovCdp(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FeesUpdated)
-	n += 1 + l + sovCdp(uint64(l))
-	l = m.InterestFactor.Size()
-	n += 1 + l + sovCdp(uint64(l))
-	return n
-}
-
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.CdpID != 0 {
-		n += 1 + sovCdp(uint64(m.CdpID))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCdp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovCdp(uint64(l))
+	if len(m.DebtType) > 0 {
+		i -= len(m.DebtType)
+		copy(dAtA[i:], m.DebtType)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.DebtType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovCdp(uint64(l))
-	return n
-}
-
-func (m *TotalPrincipal) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
+	if m.ID != 0 {
+		i = encodeVarintCdp(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.CollateralType)
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.OutstandingDebt.Size()
+		i -= size
+		if _, err := m.OutstandingDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RedemptionPool) > 0 {
+		for iNdEx := len(m.RedemptionPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCdp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FinalPrices) > 0 {
+		for iNdEx := len(m.FinalPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCdp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettlementTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettlementTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintCdp(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CdpManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CdpManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CdpManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintCdp(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x2a
+	if m.Scope != 0 {
+		i = encodeVarintCdp(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CdpID != 0 {
+		i = encodeVarintCdp(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CdpTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CdpTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CdpTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintCdp(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.MaxAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TargetRatio.Size()
+		i -= size
+		if _, err := m.TargetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TriggerRatio.Size()
+		i -= size
+		if _, err := m.TriggerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Action != 0 {
+		i = encodeVarintCdp(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CdpID != 0 {
+		i = encodeVarintCdp(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCdp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCdp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovCdp(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovCdp(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovCdp(uint64(l))
-	return n
-}
+	l = m.Collateral.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.AccumulatedFees.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FeesUpdated)
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.InterestFactor.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		n += 1 + sovCdp(uint64(m.CdpID))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *TotalPrincipal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *TotalCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *OwnerCDPIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CdpIDs) > 0 {
+		l = 0
+		for _, e := range m.CdpIDs {
+			l += sovCdp(uint64(e))
+		}
+		n += 1 + sovCdp(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MultiCollateralCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovCdp(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = len(m.DebtType)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovCdp(uint64(l))
+		}
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.AccumulatedFees.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FeesUpdated)
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.InterestFactor.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *CollateralBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *GlobalSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettlementTime)
+	n += 1 + l + sovCdp(uint64(l))
+	if len(m.FinalPrices) > 0 {
+		for _, e := range m.FinalPrices {
+			l = e.Size()
+			n += 1 + l + sovCdp(uint64(l))
+		}
+	}
+	if len(m.RedemptionPool) > 0 {
+		for _, e := range m.RedemptionPool {
+			l = e.Size()
+			n += 1 + l + sovCdp(uint64(l))
+		}
+	}
+	l = m.OutstandingDebt.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	if m.Complete {
+		n += 2
+	}
+	return n
+}
+
+func (m *SettlementPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *CdpManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		n += 1 + sovCdp(uint64(m.CdpID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovCdp(uint64(m.Scope))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *CdpTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		n += 1 + sovCdp(uint64(m.CdpID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCdp(uint64(m.Action))
+	}
+	l = m.TriggerRatio.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.TargetRatio.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovCdp(uint64(m.ConsecutiveFailures))
+	}
+	return n
+}
+
+func sovCdp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCdp(x uint64) (n int) {
+	return sovCdp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CDP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CDP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CDP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalPrincipal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalPrincipal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalPrincipal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerCDPIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerCDPIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerCDPIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCdp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CdpIDs = append(m.CdpIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCdp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCdp
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCdp
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CdpIDs) == 0 {
+					m.CdpIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCdp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CdpIDs = append(m.CdpIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiCollateralCDP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiCollateralCDP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiCollateralCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebtType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, CollateralBalance{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *TotalCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovCdp(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovCdp(uint64(l))
-	return n
+	return nil
 }
-
-func (m *OwnerCDPIndex) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CdpIDs) > 0 {
-		l = 0
-		for _, e := range m.CdpIDs {
-			l += sovCdp(uint64(e))
+func (m *CollateralBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovCdp(uint64(l)) + l
 	}
-	return n
-}
 
-func sovCdp(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCdp(x uint64) (n int) {
-	return sovCdp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CDP) Unmarshal(dAtA []byte) error {
+func (m *GlobalSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -674,36 +2800,17 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CDP: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobalSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CDP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobalSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCdp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCdp
@@ -713,31 +2820,30 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCdp
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCdp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettlementTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPrices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCdp
@@ -747,27 +2853,29 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCdp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCdp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.FinalPrices = append(m.FinalPrices, SettlementPrice{})
+			if err := m.FinalPrices[len(m.FinalPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -794,15 +2902,16 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedemptionPool = append(m.RedemptionPool, types.Coin{})
+			if err := m.RedemptionPool[len(m.RedemptionPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingDebt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCdp
@@ -812,30 +2921,31 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCdp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCdp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutstandingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCdp
@@ -845,30 +2955,67 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCdp
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCdp
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccumulatedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlementPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeesUpdated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCdp
@@ -878,28 +3025,27 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCdp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCdp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FeesUpdated, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -927,7 +3073,7 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterestFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -952,7 +3098,7 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *CdpManager) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -975,10 +3121,10 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CdpManager: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CdpManager: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1002,9 +3148,9 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCdp
@@ -1014,27 +3160,82 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCdp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCdp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = append(m.Manager[:0], dAtA[iNdEx:postIndex]...)
+			if m.Manager == nil {
+				m.Manager = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= CdpManagerScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1061,7 +3262,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1086,7 +3287,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalPrincipal) Unmarshal(dAtA []byte) error {
+func (m *CdpTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1096,26 +3297,79 @@ func (m *TotalPrincipal) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowCdp
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CdpTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CdpTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPrincipal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPrincipal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
@@ -1147,11 +3401,30 @@ func (m *TotalPrincipal) Unmarshal(dAtA []byte) error {
 			}
 			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= CdpTriggerAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCdp
@@ -1161,78 +3434,29 @@ func (m *TotalPrincipal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCdp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCdp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCdp(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCdp
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCdp
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalCollateral: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1260,11 +3484,13 @@ func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			if err := m.TargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1291,135 +3517,28 @@ func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCdp(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCdp
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OwnerCDPIndex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCdp
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OwnerCDPIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnerCDPIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCdp
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.CdpIDs = append(m.CdpIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCdp
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthCdp
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthCdp
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.CdpIDs) == 0 {
-					m.CdpIDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCdp
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.CdpIDs = append(m.CdpIDs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CdpIDs", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
@@ -18,6 +19,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDrawDebt{}, "cdp/MsgDrawDebt", nil)
 	cdc.RegisterConcrete(&MsgRepayDebt{}, "cdp/MsgRepayDebt", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "cdp/MsgLiquidate", nil)
+	cdc.RegisterConcrete(&MsgCreateMultiCollateralCDP{}, "cdp/MsgCreateMultiCollateralCDP", nil)
+	cdc.RegisterConcrete(&MsgDepositMultiCollateral{}, "cdp/MsgDepositMultiCollateral", nil)
+	cdc.RegisterConcrete(&MsgWithdrawMultiCollateral{}, "cdp/MsgWithdrawMultiCollateral", nil)
+	cdc.RegisterConcrete(&MsgDrawMultiCollateralDebt{}, "cdp/MsgDrawMultiCollateralDebt", nil)
+	cdc.RegisterConcrete(&MsgRepayMultiCollateralDebt{}, "cdp/MsgRepayMultiCollateralDebt", nil)
+	cdc.RegisterConcrete(&MsgRedeemUSDX{}, "cdp/MsgRedeemUSDX", nil)
+	cdc.RegisterConcrete(&MsgGrantCdpManager{}, "cdp/MsgGrantCdpManager", nil)
+	cdc.RegisterConcrete(&MsgRevokeCdpManager{}, "cdp/MsgRevokeCdpManager", nil)
+	cdc.RegisterConcrete(&MsgSetCdpTrigger{}, "cdp/MsgSetCdpTrigger", nil)
+	cdc.RegisterConcrete(&MsgRemoveCdpTrigger{}, "cdp/MsgRemoveCdpTrigger", nil)
+	cdc.RegisterConcrete(&GlobalSettlementProposal{}, "kava/GlobalSettlementProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +40,19 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDrawDebt{},
 		&MsgRepayDebt{},
 		&MsgLiquidate{},
+		&MsgCreateMultiCollateralCDP{},
+		&MsgDepositMultiCollateral{},
+		&MsgWithdrawMultiCollateral{},
+		&MsgDrawMultiCollateralDebt{},
+		&MsgRepayMultiCollateralDebt{},
+		&MsgRedeemUSDX{},
+		&MsgGrantCdpManager{},
+		&MsgRevokeCdpManager{},
+		&MsgSetCdpTrigger{},
+		&MsgRemoveCdpTrigger{},
+	)
+	registry.RegisterImplementations((*govv1beta1.Content)(nil),
+		&GlobalSettlementProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
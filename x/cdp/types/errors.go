@@ -49,4 +49,24 @@ var (
 	ErrInsufficientBalance = errorsmod.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = errorsmod.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrGloballySettled error for when the cdp system has been shut down by global settlement
+	ErrGloballySettled = errorsmod.Register(ModuleName, 24, "cdp system has been globally settled")
+	// ErrNotGloballySettled error for when an action requires the cdp system to be globally settled
+	ErrNotGloballySettled = errorsmod.Register(ModuleName, 25, "cdp system has not been globally settled")
+	// ErrNoFinalPrice error for when a final price cannot be found for a market at global settlement
+	ErrNoFinalPrice = errorsmod.Register(ModuleName, 26, "no final price found for market")
+	// ErrInvalidRedemption error for when a redemption of debt asset is invalid
+	ErrInvalidRedemption = errorsmod.Register(ModuleName, 27, "invalid redemption")
+	// ErrUnauthorizedManager error for when an account is not authorized to manage a cdp
+	ErrUnauthorizedManager = errorsmod.Register(ModuleName, 28, "account not authorized to manage cdp")
+	// ErrCdpManagerNotFound error for when a cdp manager authorization is not found
+	ErrCdpManagerNotFound = errorsmod.Register(ModuleName, 29, "cdp manager not found")
+	// ErrInvalidCdpManager error for when a cdp manager authorization is invalid
+	ErrInvalidCdpManager = errorsmod.Register(ModuleName, 30, "invalid cdp manager")
+	// ErrCdpTriggerNotFound error for when a cdp trigger is not found
+	ErrCdpTriggerNotFound = errorsmod.Register(ModuleName, 31, "cdp trigger not found")
+	// ErrInvalidCdpTrigger error for when a cdp trigger is invalid
+	ErrInvalidCdpTrigger = errorsmod.Register(ModuleName, 32, "invalid cdp trigger")
+	// ErrSettlementInProgress error for when cdps are still being settled by global settlement
+	ErrSettlementInProgress = errorsmod.Register(ModuleName, 33, "global settlement is in progress")
 )
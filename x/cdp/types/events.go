@@ -2,17 +2,45 @@ package types
 
 // Event types for cdp module
 const (
-	EventTypeCreateCdp         = "create_cdp"
-	EventTypeCdpDeposit        = "cdp_deposit"
-	EventTypeCdpDraw           = "cdp_draw"
-	EventTypeCdpRepay          = "cdp_repayment"
-	EventTypeCdpClose          = "cdp_close"
-	EventTypeCdpWithdrawal     = "cdp_withdrawal"
-	EventTypeCdpLiquidation    = "cdp_liquidation"
-	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
+	EventTypeCreateCdp               = "create_cdp"
+	EventTypeCdpDeposit              = "cdp_deposit"
+	EventTypeCdpDraw                 = "cdp_draw"
+	EventTypeCdpRepay                = "cdp_repayment"
+	EventTypeCdpClose                = "cdp_close"
+	EventTypeCdpWithdrawal           = "cdp_withdrawal"
+	EventTypeCdpLiquidation          = "cdp_liquidation"
+	EventTypeCdpPartialLiquidation   = "cdp_partial_liquidation"
+	EventTypeBeginBlockerFatal       = "cdp_begin_block_error"
+	EventTypeSavingsRateDistribution = "cdp_savings_rate_distribution"
+	EventTypeGlobalSettlement        = "cdp_global_settlement"
+	EventTypeCdpSettlement           = "cdp_settlement"
+	EventTypeRedeemUSDX              = "cdp_redeem_usdx"
+	EventTypeGrantCdpManager         = "cdp_grant_manager"
+	EventTypeRevokeCdpManager        = "cdp_revoke_manager"
+	EventTypeSetCdpTrigger           = "cdp_set_trigger"
+	EventTypeRemoveCdpTrigger        = "cdp_remove_trigger"
+	EventTypeCdpTrigger              = "cdp_trigger"
+	EventTypeCdpTriggerDisabled      = "cdp_trigger_disabled"
 
-	AttributeKeyCdpID      = "cdp_id"
-	AttributeKeyDeposit    = "deposit"
-	AttributeValueCategory = "cdp"
-	AttributeKeyError      = "error_message"
+	AttributeKeyCdpID            = "cdp_id"
+	AttributeKeyDeposit          = "deposit"
+	AttributeValueCategory       = "cdp"
+	AttributeKeyError            = "error_message"
+	AttributeKeyCollateralSeized = "collateral_seized"
+	AttributeKeyDebtSeized       = "debt_seized"
+	AttributeKeyCollateralType   = "collateral_type"
+	AttributeKeySurplus          = "surplus"
+	AttributeKeyCollateralOwed   = "collateral_owed"
+	AttributeKeyRedemptionPool   = "redemption_pool"
+	AttributeKeyOutstandingDebt  = "outstanding_debt"
+	AttributeKeyOwner            = "owner"
+	AttributeKeyManager          = "manager"
+	AttributeKeyScope            = "scope"
+	AttributeKeyExpiry           = "expiry"
+	AttributeKeyAction           = "action"
+	AttributeKeyTriggerRatio     = "trigger_ratio"
+	AttributeKeyTargetRatio      = "target_ratio"
+	AttributeKeyMaxAmount        = "max_amount"
+	AttributeKeyCollateralRatio  = "collateral_ratio"
+	AttributeKeyGasUsed          = "gas_used"
 )
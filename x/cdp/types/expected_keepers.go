@@ -9,6 +9,7 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
 )
 
 // BankKeeper defines the expected bank keeper for module accounts
@@ -30,6 +31,7 @@ var _ BankKeeper = (bankkeeper.Keeper)(nil)
 // PricefeedKeeper defines the expected interface for the pricefeed
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	IsMarketFrozen(sdk.Context, string) bool
 	GetParams(sdk.Context) pftypes.Params
 	// These are used for testing TODO replace mockApp with keeper in tests to remove these
 	SetParams(sdk.Context, pftypes.Params)
@@ -41,7 +43,8 @@ type PricefeedKeeper interface {
 type AuctionKeeper interface {
 	StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, error)
 	StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, initialLot sdk.Coin, debt sdk.Coin) (uint64, error)
-	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin) (uint64, error)
+	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin, lotPrice sdk.Dec) (uint64, error)
+	StartDutchAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin, lotPrice sdk.Dec) (uint64, error)
 }
 
 // AccountKeeper expected interface for the account keeper
@@ -55,6 +58,13 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
+// SavingsKeeper expected interface for the savings keeper
+type SavingsKeeper interface {
+	DistributeYield(ctx sdk.Context, senderModule string, yield sdk.Coin) (sdk.Coin, error)
+	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
+	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
+}
+
 // CDPHooks event hooks for other keepers to run code in response to CDP modifications
 type CDPHooks interface {
 	AfterCDPCreated(ctx sdk.Context, cdp CDP)
@@ -12,7 +12,9 @@ import (
 // NewGenesisState returns a new genesis state
 func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID uint64,
 	debtDenom, govDenom string, prevAccumTimes GenesisAccumulationTimes,
-	totalPrincipals GenesisTotalPrincipals,
+	totalPrincipals GenesisTotalPrincipals, multiCdps MultiCollateralCDPs,
+	prevSavingsDistributionTime time.Time, pendingSavings sdkmath.Int, globalSettlement *GlobalSettlement,
+	cdpManagers CdpManagers, cdpTriggers CdpTriggers,
 ) GenesisState {
 	return GenesisState{
 		Params:                    params,
@@ -23,6 +25,13 @@ func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID
 		GovDenom:                  govDenom,
 		PreviousAccumulationTimes: prevAccumTimes,
 		TotalPrincipals:           totalPrincipals,
+		MultiCollateralCDPs:       multiCdps,
+
+		PreviousSavingsDistributionTime: prevSavingsDistributionTime,
+		PendingSavings:                  pendingSavings,
+		GlobalSettlement:                globalSettlement,
+		CdpManagers:                     cdpManagers,
+		CdpTriggers:                     cdpTriggers,
 	}
 }
 
@@ -37,6 +46,12 @@ func DefaultGenesisState() GenesisState {
 		DefaultGovDenom,
 		GenesisAccumulationTimes{},
 		GenesisTotalPrincipals{},
+		MultiCollateralCDPs{},
+		time.Time{},
+		sdk.ZeroInt(),
+		nil,
+		CdpManagers{},
+		CdpTriggers{},
 	)
 }
 
@@ -51,10 +66,22 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.MultiCollateralCDPs.Validate(); err != nil {
+		return err
+	}
+
 	if err := gs.Deposits.Validate(); err != nil {
 		return err
 	}
 
+	if err := gs.CdpManagers.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.CdpTriggers.Validate(); err != nil {
+		return err
+	}
+
 	if err := gs.PreviousAccumulationTimes.Validate(); err != nil {
 		return err
 	}
@@ -63,6 +90,16 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if !gs.PendingSavings.IsNil() && gs.PendingSavings.IsNegative() {
+		return fmt.Errorf("pending savings should not be negative, is %s", gs.PendingSavings)
+	}
+
+	if gs.GlobalSettlement != nil {
+		if err := gs.GlobalSettlement.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -41,6 +42,15 @@ type GenesisState struct {
 	GovDenom                  string                   `protobuf:"bytes,6,opt,name=gov_denom,json=govDenom,proto3" json:"gov_denom,omitempty"`
 	PreviousAccumulationTimes GenesisAccumulationTimes `protobuf:"bytes,7,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
 	TotalPrincipals           GenesisTotalPrincipals   `protobuf:"bytes,8,rep,name=total_principals,json=totalPrincipals,proto3,castrepeated=GenesisTotalPrincipals" json:"total_principals"`
+	MultiCollateralCDPs       MultiCollateralCDPs      `protobuf:"bytes,9,rep,name=multi_collateral_cdps,json=multiCollateralCdps,proto3,castrepeated=MultiCollateralCDPs" json:"multi_collateral_cdps"`
+	// previous_savings_distribution_time is the time the savings rate was last distributed to savings depositors.
+	PreviousSavingsDistributionTime time.Time `protobuf:"bytes,10,opt,name=previous_savings_distribution_time,json=previousSavingsDistributionTime,proto3,stdtime" json:"previous_savings_distribution_time"`
+	// pending_savings is the amount of stability fees reserved for the savings rate that has not been distributed.
+	PendingSavings github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=pending_savings,json=pendingSavings,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pending_savings"`
+	// global_settlement is the state of the global settlement, if the cdp system has been shut down.
+	GlobalSettlement *GlobalSettlement `protobuf:"bytes,12,opt,name=global_settlement,json=globalSettlement,proto3" json:"global_settlement,omitempty"`
+	CdpManagers      CdpManagers       `protobuf:"bytes,13,rep,name=cdp_managers,json=cdpManagers,proto3,castrepeated=CdpManagers" json:"cdp_managers"`
+	CdpTriggers      CdpTriggers       `protobuf:"bytes,14,rep,name=cdp_triggers,json=cdpTriggers,proto3,castrepeated=CdpTriggers" json:"cdp_triggers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +142,41 @@ func (m *GenesisState) GetTotalPrincipals() GenesisTotalPrincipals {
 	return nil
 }
 
+func (m *GenesisState) GetMultiCollateralCDPs() MultiCollateralCDPs {
+	if m != nil {
+		return m.MultiCollateralCDPs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreviousSavingsDistributionTime() time.Time {
+	if m != nil {
+		return m.PreviousSavingsDistributionTime
+	}
+	return time.Time{}
+}
+
+func (m *GenesisState) GetGlobalSettlement() *GlobalSettlement {
+	if m != nil {
+		return m.GlobalSettlement
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCdpManagers() CdpManagers {
+	if m != nil {
+		return m.CdpManagers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCdpTriggers() CdpTriggers {
+	if m != nil {
+		return m.CdpTriggers
+	}
+	return nil
+}
+
 // Params defines the parameters for the cdp module.
 type Params struct {
 	CollateralParams         CollateralParams                       `protobuf:"bytes,1,rep,name=collateral_params,json=collateralParams,proto3,castrepeated=CollateralParams" json:"collateral_params"`
@@ -143,6 +188,8 @@ type Params struct {
 	DebtAuctionLot           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=debt_auction_lot,json=debtAuctionLot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_lot"`
 	CircuitBreaker           bool                                   `protobuf:"varint,8,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
 	LiquidationBlockInterval int64                                  `protobuf:"varint,9,opt,name=liquidation_block_interval,json=liquidationBlockInterval,proto3" json:"liquidation_block_interval,omitempty"`
+	// trigger_gas_limit is the gas available each block to run cdp triggers, zero disables triggers
+	TriggerGasLimit uint64 `protobuf:"varint,10,opt,name=trigger_gas_limit,json=triggerGasLimit,proto3" json:"trigger_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -213,12 +260,24 @@ func (m *Params) GetLiquidationBlockInterval() int64 {
 	return 0
 }
 
+func (m *Params) GetTriggerGasLimit() uint64 {
+	if m != nil {
+		return m.TriggerGasLimit
+	}
+	return 0
+}
+
 // DebtParam defines governance params for debt assets
 type DebtParam struct {
 	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	ReferenceAsset   string                                 `protobuf:"bytes,2,opt,name=reference_asset,json=referenceAsset,proto3" json:"reference_asset,omitempty"`
 	ConversionFactor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
 	DebtFloor        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=debt_floor,json=debtFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_floor"`
+	// savings_rate is the fraction of accumulated stability fees that is distributed to savings depositors of the debt
+	// denom.
+	SavingsRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=savings_rate,json=savingsRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"savings_rate"`
+	// savings_distribution_frequency is the minimum time between distributions of the savings rate.
+	SavingsDistributionFrequency time.Duration `protobuf:"bytes,6,opt,name=savings_distribution_frequency,json=savingsDistributionFrequency,proto3,stdduration" json:"savings_distribution_frequency"`
 }
 
 func (m *DebtParam) Reset()         { *m = DebtParam{} }
@@ -268,6 +327,13 @@ func (m *DebtParam) GetReferenceAsset() string {
 	return ""
 }
 
+func (m *DebtParam) GetSavingsDistributionFrequency() time.Duration {
+	if m != nil {
+		return m.SavingsDistributionFrequency
+	}
+	return 0
+}
+
 // CollateralParam defines governance parameters for each collateral type within the cdp module
 type CollateralParam struct {
 	Denom                            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -282,6 +348,14 @@ type CollateralParam struct {
 	KeeperRewardPercentage           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
 	CheckCollateralizationIndexCount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=check_collateralization_index_count,json=checkCollateralizationIndexCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"check_collateralization_index_count"`
 	ConversionFactor                 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// close_factor is the maximum fraction of a cdp's debt that can be seized in a single partial liquidation.
+	// A zero close factor disables partial liquidation and cdps are liquidated in full.
+	CloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=close_factor,json=closeFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_factor"`
+	// liquidation_target_ratio is the collateralization ratio a partially liquidated cdp is restored to.
+	LiquidationTargetRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=liquidation_target_ratio,json=liquidationTargetRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_target_ratio"`
+	// auction_type is the type of auction liquidated collateral is sold with, either "collateral" or "dutch".
+	// An empty auction type defaults to collateral auctions.
+	AuctionType string `protobuf:"bytes,15,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
 }
 
 func (m *CollateralParam) Reset()         { *m = CollateralParam{} }
@@ -352,6 +426,13 @@ func (m *CollateralParam) GetLiquidationMarketID() string {
 	return ""
 }
 
+func (m *CollateralParam) GetAuctionType() string {
+	if m != nil {
+		return m.AuctionType
+	}
+	return ""
+}
+
 // GenesisAccumulationTime defines the previous distribution time and its corresponding denom
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -464,83 +545,102 @@ func init() {
 func init() { proto.RegisterFile("kava/cdp/v1beta1/genesis.proto", fileDescriptor_e4494a90aaab0034) }
 
 var fileDescriptor_e4494a90aaab0034 = []byte{
-	// 1208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1a, 0xc7,
-	0x17, 0xf7, 0xda, 0xc4, 0x81, 0xb1, 0x63, 0xf0, 0xc4, 0x49, 0xd6, 0x8e, 0xfe, 0xc0, 0xdf, 0x55,
-	0x1b, 0x7a, 0x11, 0x50, 0x52, 0x29, 0x52, 0xa5, 0xa8, 0x69, 0xd6, 0x28, 0x11, 0x4a, 0x2a, 0xa1,
-	0xb5, 0xaf, 0xda, 0x8b, 0xd5, 0xec, 0xec, 0x80, 0x47, 0x2c, 0x3b, 0xdb, 0x99, 0x81, 0x26, 0x79,
-	0x85, 0xaa, 0x6a, 0xd4, 0x97, 0xa8, 0x14, 0xf5, 0xb2, 0x0f, 0x91, 0xde, 0x45, 0xbd, 0xaa, 0x7a,
-	0x41, 0x2a, 0xf2, 0x22, 0xd5, 0x7c, 0x00, 0x6b, 0x30, 0x52, 0x64, 0xd3, 0x1b, 0x76, 0xe7, 0x7c,
-	0xfc, 0xce, 0xc7, 0x9c, 0x73, 0xf6, 0x00, 0xca, 0x3d, 0x34, 0x44, 0x0d, 0x1c, 0xa5, 0x8d, 0xe1,
-	0xbd, 0x90, 0x48, 0x74, 0xaf, 0xd1, 0x25, 0x09, 0x11, 0x54, 0xd4, 0x53, 0xce, 0x24, 0x83, 0x25,
-	0xc5, 0xaf, 0xe3, 0x28, 0xad, 0x5b, 0xfe, 0x41, 0x19, 0x33, 0xd1, 0x67, 0xa2, 0x11, 0x22, 0x41,
-	0xa6, 0x4a, 0x98, 0xd1, 0xc4, 0x68, 0x1c, 0xec, 0x1b, 0x7e, 0xa0, 0x4f, 0x0d, 0x73, 0xb0, 0xac,
-	0xbd, 0x2e, 0xeb, 0x32, 0x43, 0x57, 0x6f, 0x96, 0x5a, 0xe9, 0x32, 0xd6, 0x8d, 0x49, 0x43, 0x9f,
-	0xc2, 0x41, 0xa7, 0x21, 0x69, 0x9f, 0x08, 0x89, 0xfa, 0xa9, 0x15, 0x38, 0x58, 0xf0, 0x51, 0xf9,
-	0xa3, 0x79, 0x87, 0x7f, 0xe4, 0xc0, 0xf6, 0x53, 0xe3, 0xf1, 0xb1, 0x44, 0x92, 0xc0, 0x07, 0x60,
-	0x33, 0x45, 0x1c, 0xf5, 0x85, 0xeb, 0x54, 0x9d, 0xda, 0xd6, 0x7d, 0xb7, 0x3e, 0x1f, 0x41, 0xbd,
-	0xad, 0xf9, 0x5e, 0xee, 0xed, 0xa8, 0xb2, 0xe6, 0x5b, 0x69, 0xf8, 0x08, 0xe4, 0x70, 0x94, 0x0a,
-	0x77, 0xbd, 0xba, 0x51, 0xdb, 0xba, 0x7f, 0x63, 0x51, 0xeb, 0xa8, 0xd9, 0xf6, 0xf6, 0x94, 0xca,
-	0x78, 0x54, 0xc9, 0x1d, 0x35, 0xdb, 0xe2, 0xcd, 0x7b, 0xf3, 0xf4, 0xb5, 0x22, 0x7c, 0x0a, 0xf2,
-	0x11, 0x49, 0x99, 0xa0, 0x52, 0xb8, 0x1b, 0x1a, 0x64, 0x7f, 0x11, 0xa4, 0x69, 0x24, 0xbc, 0x92,
-	0x02, 0x7a, 0xf3, 0xbe, 0x92, 0xb7, 0x04, 0xe1, 0x4f, 0x95, 0xe1, 0x97, 0xa0, 0x28, 0x24, 0xe2,
-	0x92, 0x26, 0xdd, 0x00, 0x47, 0x69, 0x40, 0x23, 0x37, 0x57, 0x75, 0x6a, 0x39, 0x6f, 0x77, 0x3c,
-	0xaa, 0x5c, 0x3b, 0xb6, 0xac, 0xa3, 0x28, 0x6d, 0x35, 0xfd, 0x6b, 0x22, 0x73, 0x8c, 0xe0, 0xff,
-	0x00, 0x88, 0x48, 0x28, 0x83, 0x88, 0x24, 0xac, 0xef, 0x5e, 0xa9, 0x3a, 0xb5, 0x82, 0x5f, 0x50,
-	0x94, 0xa6, 0x22, 0xc0, 0xdb, 0xa0, 0xd0, 0x65, 0x43, 0xcb, 0xdd, 0xd4, 0xdc, 0x7c, 0x97, 0x0d,
-	0x0d, 0xf3, 0x47, 0x07, 0xdc, 0x4e, 0x39, 0x19, 0x52, 0x36, 0x10, 0x01, 0xc2, 0x78, 0xd0, 0x1f,
-	0xc4, 0x48, 0x52, 0x96, 0x04, 0xfa, 0x3e, 0xdc, 0xab, 0x3a, 0xa6, 0xcf, 0x17, 0x63, 0xb2, 0xe9,
-	0x7f, 0x9c, 0x51, 0x39, 0xa1, 0x7d, 0xe2, 0x55, 0x6d, 0x8c, 0xee, 0x12, 0x01, 0xe1, 0xef, 0x4f,
-	0xec, 0x2d, 0xb0, 0x20, 0x07, 0x25, 0xc9, 0x24, 0x8a, 0x83, 0x94, 0xd3, 0x04, 0xd3, 0x14, 0xc5,
-	0xc2, 0xcd, 0x6b, 0x0f, 0xee, 0x2c, 0xf5, 0xe0, 0x44, 0x29, 0xb4, 0x27, 0xf2, 0x5e, 0xd9, 0xda,
-	0xbf, 0x79, 0x2e, 0x5b, 0xf8, 0x45, 0x79, 0x96, 0x70, 0xf8, 0xdb, 0x26, 0xd8, 0x34, 0xb5, 0x01,
-	0x4f, 0xc1, 0x2e, 0x66, 0x71, 0x8c, 0x24, 0xe1, 0xca, 0x87, 0x49, 0x41, 0x29, 0xfb, 0xff, 0x3f,
-	0xa7, 0x34, 0xa6, 0xa2, 0x5a, 0xdd, 0x73, 0xad, 0xe5, 0xd2, 0x1c, 0x43, 0xf8, 0x25, 0x3c, 0x47,
-	0x81, 0x5f, 0xdb, 0x2b, 0xd3, 0x36, 0xdc, 0x75, 0x5d, 0xb3, 0xb7, 0xcf, 0x2b, 0x9c, 0x50, 0x1a,
-	0x70, 0x53, 0xb6, 0xfa, 0x56, 0x35, 0x01, 0x3e, 0x03, 0xbb, 0xdd, 0x98, 0x85, 0x28, 0x0e, 0x34,
-	0x50, 0x4c, 0xfb, 0x54, 0xba, 0x1b, 0x1a, 0x68, 0xbf, 0x6e, 0xfb, 0x4f, 0x35, 0x6b, 0xc6, 0x5d,
-	0x9a, 0x58, 0x98, 0xa2, 0xd1, 0x54, 0xe8, 0xcf, 0x95, 0x1e, 0x7c, 0x01, 0xf6, 0xc5, 0x80, 0xa7,
-	0xb1, 0xaa, 0x81, 0x01, 0x36, 0xd7, 0x7f, 0xca, 0x89, 0x38, 0x65, 0xb1, 0x29, 0xc3, 0x82, 0xf7,
-	0x50, 0x69, 0xfe, 0x3d, 0xaa, 0x7c, 0xd6, 0xa5, 0xf2, 0x74, 0x10, 0xd6, 0x31, 0xeb, 0xdb, 0x36,
-	0xb7, 0x8f, 0xbb, 0x22, 0xea, 0x35, 0xe4, 0xcb, 0x94, 0x88, 0x7a, 0x2b, 0x91, 0x7f, 0xfe, 0x7e,
-	0x17, 0x58, 0x2f, 0x5a, 0x89, 0xf4, 0x6f, 0x59, 0xf8, 0xc7, 0x06, 0xfd, 0x64, 0x02, 0x0e, 0x63,
-	0x70, 0x7d, 0xde, 0x72, 0xcc, 0xa4, 0x29, 0xe2, 0x4b, 0xda, 0xdc, 0x3d, 0x6b, 0xf3, 0x39, 0x93,
-	0x90, 0x83, 0x9b, 0x3a, 0x5b, 0x8b, 0x41, 0x6e, 0xae, 0xc0, 0xe0, 0x9e, 0xc2, 0x5e, 0x88, 0xb0,
-	0x03, 0x4a, 0x67, 0x6c, 0xaa, 0xf0, 0xae, 0xae, 0xc0, 0xda, 0x4e, 0xc6, 0x9a, 0x8a, 0xed, 0x0e,
-	0x28, 0x62, 0xca, 0xf1, 0x80, 0xca, 0x20, 0xe4, 0x04, 0xf5, 0x08, 0x77, 0xf3, 0x55, 0xa7, 0x96,
-	0xf7, 0x77, 0x2c, 0xd9, 0x33, 0x54, 0xf8, 0x10, 0x1c, 0xc4, 0xf4, 0xfb, 0x01, 0x8d, 0x4c, 0x9f,
-	0x87, 0x31, 0xc3, 0xbd, 0x80, 0x26, 0x92, 0xf0, 0x21, 0x8a, 0xdd, 0x42, 0xd5, 0xa9, 0x6d, 0xf8,
-	0x6e, 0x46, 0xc2, 0x53, 0x02, 0x2d, 0xcb, 0x3f, 0xfc, 0x65, 0x1d, 0x14, 0xa6, 0x65, 0x09, 0xf7,
-	0xc0, 0x15, 0x33, 0x57, 0x1c, 0x3d, 0x57, 0xcc, 0x41, 0xb9, 0xc2, 0x49, 0x87, 0x70, 0x92, 0x60,
-	0x12, 0x20, 0x21, 0x88, 0xd4, 0x25, 0x5e, 0xf0, 0x77, 0xa6, 0xe4, 0xc7, 0x8a, 0x0a, 0xa9, 0x6a,
-	0xb8, 0x64, 0x48, 0xb8, 0x50, 0x9e, 0x74, 0x10, 0x96, 0x8c, 0xeb, 0x22, 0xbe, 0x6c, 0x72, 0x4a,
-	0x33, 0xd8, 0x27, 0x1a, 0x15, 0x7e, 0x67, 0x3b, 0xae, 0x13, 0x33, 0xc6, 0x57, 0x52, 0xd3, 0xba,
-	0x19, 0x9f, 0x28, 0xb8, 0xc3, 0x9f, 0xf3, 0xa0, 0x38, 0xd7, 0xf5, 0x4b, 0x52, 0x03, 0x41, 0x4e,
-	0xe1, 0xd9, 0x7c, 0xe8, 0x77, 0x95, 0x85, 0xec, 0x85, 0x70, 0xf5, 0xb8, 0x40, 0x16, 0x9a, 0x04,
-	0x67, 0x3c, 0x6c, 0x12, 0xec, 0x97, 0x32, 0xb0, 0xbe, 0xfa, 0x85, 0x5f, 0xd9, 0x2c, 0x98, 0x71,
-	0x91, 0xfb, 0xb8, 0x71, 0xa1, 0x03, 0x35, 0x83, 0x02, 0x01, 0xf5, 0xed, 0x09, 0x69, 0x4c, 0xe5,
-	0xcb, 0xa0, 0x43, 0xc8, 0x05, 0x1a, 0x75, 0xd1, 0xcd, 0xed, 0x29, 0xe4, 0x13, 0x42, 0x60, 0x00,
-	0xb6, 0x27, 0xad, 0x22, 0xe8, 0x2b, 0xb2, 0x92, 0xce, 0xdc, 0xb2, 0x88, 0xc7, 0xf4, 0x15, 0x81,
-	0x7d, 0x70, 0x3d, 0x9b, 0xee, 0x94, 0x24, 0x28, 0x96, 0x2f, 0x2f, 0xd0, 0x93, 0x8b, 0x91, 0xc0,
-	0x0c, 0x70, 0xdb, 0xe0, 0xc2, 0x07, 0x60, 0x47, 0xa4, 0x4c, 0x06, 0x7d, 0xc4, 0x7b, 0x44, 0xaa,
-	0xef, 0x7a, 0x5e, 0x5b, 0x2a, 0x8d, 0x47, 0x95, 0xed, 0xe3, 0x94, 0xc9, 0x6f, 0x34, 0xa3, 0xd5,
-	0xf4, 0xb7, 0xc5, 0xec, 0x14, 0xc1, 0x67, 0xe0, 0x46, 0xd6, 0xcd, 0x99, 0x7a, 0x41, 0xab, 0xdf,
-	0x1a, 0x8f, 0x2a, 0xd7, 0x9f, 0xcf, 0x04, 0xa6, 0x28, 0xd9, 0xe0, 0xa6, 0x60, 0x43, 0xe0, 0xf6,
-	0x08, 0x49, 0x09, 0x0f, 0x38, 0xf9, 0x01, 0xf1, 0x28, 0x48, 0x09, 0xc7, 0x24, 0x91, 0xa8, 0x4b,
-	0x5c, 0xb0, 0x82, 0xc0, 0x6f, 0x1a, 0x74, 0x5f, 0x83, 0xb7, 0xa7, 0xd8, 0x6a, 0xbd, 0xf8, 0x04,
-	0x9f, 0x12, 0xdc, 0x0b, 0x66, 0x9f, 0x40, 0xfa, 0xca, 0x44, 0x44, 0x93, 0x88, 0xbc, 0x08, 0x30,
-	0x1b, 0x24, 0xd2, 0xdd, 0x5a, 0xc1, 0x25, 0x57, 0xb5, 0xa1, 0xa3, 0x79, 0x3b, 0x2d, 0x65, 0xe6,
-	0x48, 0x59, 0x39, 0x7f, 0xdc, 0x6c, 0xff, 0x17, 0xe3, 0xe6, 0xf0, 0xa7, 0x75, 0x70, 0x6b, 0xc9,
-	0x06, 0xa4, 0x27, 0xf5, 0x6c, 0xcd, 0xd0, 0xe3, 0xc0, 0xcc, 0x88, 0x9d, 0x19, 0xf9, 0x44, 0x0d,
-	0x86, 0x10, 0x1c, 0x2c, 0xdf, 0xcd, 0xec, 0xd6, 0x70, 0x50, 0x37, 0x8b, 0x74, 0x7d, 0xb2, 0x48,
-	0xd7, 0x4f, 0x26, 0x8b, 0xb4, 0x97, 0x57, 0x41, 0xbd, 0x7e, 0x5f, 0x71, 0x7c, 0x77, 0xd9, 0xce,
-	0x05, 0x09, 0x28, 0xea, 0xd9, 0x4f, 0x84, 0xbc, 0xf8, 0x00, 0x5e, 0x2c, 0x88, 0x9d, 0x09, 0xa8,
-	0xcd, 0xc7, 0xaf, 0x0e, 0xb8, 0x71, 0xee, 0x46, 0xf6, 0xf1, 0xd9, 0x20, 0xa0, 0x38, 0xb7, 0x1c,
-	0x9a, 0x29, 0x7a, 0xd9, 0xef, 0xe8, 0xd9, 0x85, 0xd0, 0x7b, 0xf4, 0x76, 0x5c, 0x76, 0xde, 0x8d,
-	0xcb, 0xce, 0x3f, 0xe3, 0xb2, 0xf3, 0xfa, 0x43, 0x79, 0xed, 0xdd, 0x87, 0xf2, 0xda, 0x5f, 0x1f,
-	0xca, 0x6b, 0xdf, 0x7e, 0x9a, 0xc1, 0x57, 0xab, 0xda, 0xdd, 0x18, 0x85, 0x42, 0xbf, 0x35, 0x5e,
-	0xe8, 0x3f, 0x2a, 0xda, 0x44, 0xb8, 0xa9, 0x6f, 0xe2, 0x8b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x5c, 0xdf, 0x27, 0x18, 0x65, 0x0d, 0x00, 0x00,
+	// 1513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x6e, 0x1b, 0x37,
+	0x17, 0xb6, 0x6c, 0xc5, 0x91, 0x68, 0x59, 0x92, 0x69, 0x3b, 0x19, 0x3b, 0xf9, 0x25, 0x45, 0xff,
+	0x25, 0xfe, 0x0b, 0x44, 0x42, 0x52, 0x20, 0x40, 0x81, 0xa0, 0x69, 0x64, 0xc1, 0x81, 0x91, 0x04,
+	0x35, 0xc6, 0x5a, 0xb5, 0x8b, 0x01, 0x35, 0x43, 0x8f, 0x09, 0xcd, 0x0c, 0x27, 0x24, 0xa5, 0xc6,
+	0x79, 0x85, 0xb4, 0x40, 0x96, 0x7d, 0x83, 0x02, 0xd9, 0x74, 0xd3, 0x87, 0xc8, 0x32, 0xe8, 0x2a,
+	0xe8, 0xc2, 0x29, 0x9c, 0x17, 0x29, 0x78, 0x99, 0xd1, 0x58, 0x92, 0x8b, 0x5c, 0xd4, 0x8d, 0x35,
+	0x3c, 0x97, 0xef, 0xf0, 0xf0, 0xdc, 0x48, 0x83, 0xda, 0x00, 0x8d, 0x50, 0xdb, 0xf5, 0xe2, 0xf6,
+	0xe8, 0x76, 0x1f, 0x0b, 0x74, 0xbb, 0xed, 0xe3, 0x08, 0x73, 0xc2, 0x5b, 0x31, 0xa3, 0x82, 0xc2,
+	0xaa, 0xe4, 0xb7, 0x5c, 0x2f, 0x6e, 0x19, 0xfe, 0x76, 0xcd, 0xa5, 0x3c, 0xa4, 0xbc, 0xdd, 0x47,
+	0x1c, 0xa7, 0x4a, 0x2e, 0x25, 0x91, 0xd6, 0xd8, 0xde, 0xd2, 0x7c, 0x47, 0xad, 0xda, 0x7a, 0x61,
+	0x58, 0x1b, 0x3e, 0xf5, 0xa9, 0xa6, 0xcb, 0x2f, 0x43, 0xad, 0xf9, 0x94, 0xfa, 0x01, 0x6e, 0xab,
+	0x55, 0x7f, 0x78, 0xd4, 0xf6, 0x86, 0x0c, 0x09, 0x42, 0x13, 0xc0, 0xfa, 0x24, 0x5f, 0x90, 0x10,
+	0x73, 0x81, 0xc2, 0xd8, 0x08, 0x6c, 0x4f, 0xf9, 0xe0, 0x7a, 0x86, 0xd7, 0xfc, 0xb5, 0x08, 0x4a,
+	0x0f, 0xb5, 0x47, 0x87, 0x02, 0x09, 0x0c, 0xef, 0x82, 0xe5, 0x18, 0x31, 0x14, 0x72, 0x2b, 0xd7,
+	0xc8, 0xed, 0xac, 0xdc, 0xb1, 0x5a, 0x93, 0x1e, 0xb6, 0x0e, 0x14, 0xbf, 0x93, 0x7f, 0x7d, 0x5a,
+	0x5f, 0xb0, 0x8d, 0x34, 0xbc, 0x0f, 0xf2, 0xae, 0x17, 0x73, 0x6b, 0xb1, 0xb1, 0xb4, 0xb3, 0x72,
+	0x67, 0x73, 0x5a, 0x6b, 0xb7, 0x7b, 0xd0, 0xd9, 0x90, 0x2a, 0x67, 0xa7, 0xf5, 0xfc, 0x6e, 0xf7,
+	0x80, 0xbf, 0x7a, 0xa7, 0x7f, 0x6d, 0xa5, 0x08, 0x1f, 0x82, 0x82, 0x87, 0x63, 0xca, 0x89, 0xe0,
+	0xd6, 0x92, 0x02, 0xd9, 0x9a, 0x06, 0xe9, 0x6a, 0x89, 0x4e, 0x55, 0x02, 0xbd, 0x7a, 0x57, 0x2f,
+	0x18, 0x02, 0xb7, 0x53, 0x65, 0xf8, 0x15, 0xa8, 0x70, 0x81, 0x98, 0x20, 0x91, 0xef, 0xb8, 0x5e,
+	0xec, 0x10, 0xcf, 0xca, 0x37, 0x72, 0x3b, 0xf9, 0xce, 0xda, 0xd9, 0x69, 0x7d, 0xf5, 0xd0, 0xb0,
+	0x76, 0xbd, 0x78, 0xbf, 0x6b, 0xaf, 0xf2, 0xcc, 0xd2, 0x83, 0xff, 0x02, 0xc0, 0xc3, 0x7d, 0xe1,
+	0x78, 0x38, 0xa2, 0xa1, 0x75, 0xa9, 0x91, 0xdb, 0x29, 0xda, 0x45, 0x49, 0xe9, 0x4a, 0x02, 0xbc,
+	0x06, 0x8a, 0x3e, 0x1d, 0x19, 0xee, 0xb2, 0xe2, 0x16, 0x7c, 0x3a, 0xd2, 0xcc, 0x17, 0x39, 0x70,
+	0x2d, 0x66, 0x78, 0x44, 0xe8, 0x90, 0x3b, 0xc8, 0x75, 0x87, 0xe1, 0x30, 0x50, 0x61, 0x72, 0x54,
+	0x3c, 0xac, 0xcb, 0xca, 0xa7, 0xff, 0x4f, 0xfb, 0x64, 0x8e, 0xff, 0x41, 0x46, 0xa5, 0x47, 0x42,
+	0xdc, 0x69, 0x18, 0x1f, 0xad, 0x0b, 0x04, 0xb8, 0xbd, 0x95, 0xd8, 0x9b, 0x62, 0x41, 0x06, 0xaa,
+	0x82, 0x0a, 0x14, 0x38, 0x31, 0x23, 0x91, 0x4b, 0x62, 0x14, 0x70, 0xab, 0xa0, 0x76, 0x70, 0xf3,
+	0xc2, 0x1d, 0xf4, 0xa4, 0xc2, 0x41, 0x22, 0xdf, 0xa9, 0x19, 0xfb, 0x57, 0x66, 0xb2, 0xb9, 0x5d,
+	0x11, 0xe7, 0x09, 0xf0, 0xc7, 0x1c, 0xd8, 0x0c, 0x87, 0x81, 0x20, 0x8e, 0x4b, 0x83, 0x00, 0x09,
+	0xcc, 0x50, 0xe0, 0xa8, 0xa4, 0x28, 0x2a, 0xcb, 0xff, 0x99, 0xb6, 0xfc, 0x44, 0x8a, 0xef, 0xa6,
+	0xd2, 0x32, 0x47, 0xee, 0x98, 0x1c, 0x59, 0x9f, 0xe6, 0xc9, 0x94, 0x99, 0x45, 0xb6, 0xd7, 0xc3,
+	0x09, 0xa2, 0x4c, 0xa8, 0xa7, 0xa0, 0x99, 0xc6, 0x83, 0xa3, 0x11, 0x89, 0x7c, 0xee, 0x78, 0x84,
+	0x0b, 0x46, 0xfa, 0xc3, 0x34, 0x2e, 0x16, 0x50, 0x59, 0xbe, 0xdd, 0xd2, 0x45, 0xd4, 0x4a, 0x8a,
+	0xa8, 0xd5, 0x4b, 0x8a, 0xa8, 0x53, 0x90, 0x1b, 0x7a, 0xf9, 0xae, 0x9e, 0xb3, 0xeb, 0x09, 0xde,
+	0xa1, 0x86, 0xeb, 0x66, 0xd0, 0xa4, 0x3c, 0xc4, 0xa0, 0x12, 0xe3, 0xc8, 0x93, 0x99, 0x67, 0x2c,
+	0x5a, 0x2b, 0x32, 0x4d, 0x3a, 0xf7, 0x24, 0xc6, 0x1f, 0xa7, 0xf5, 0xff, 0xf9, 0x44, 0x1c, 0x0f,
+	0xfb, 0x2d, 0x97, 0x86, 0xa6, 0xf4, 0xcd, 0xcf, 0x2d, 0xee, 0x0d, 0xda, 0xe2, 0x24, 0xc6, 0xbc,
+	0xb5, 0x1f, 0x89, 0xdf, 0x7f, 0xbb, 0x05, 0x34, 0x5d, 0xae, 0xec, 0xb2, 0x01, 0x35, 0x66, 0xe1,
+	0xb7, 0x60, 0xcd, 0x0f, 0x68, 0x1f, 0x05, 0x0e, 0xc7, 0x42, 0x04, 0x38, 0xc4, 0x91, 0xb0, 0x4a,
+	0xca, 0x91, 0xe6, 0x8c, 0xe8, 0x2a, 0xd1, 0xc3, 0x54, 0xd2, 0xae, 0xfa, 0x13, 0x14, 0xd8, 0x03,
+	0x25, 0x59, 0x29, 0x21, 0x8a, 0x90, 0x8f, 0x19, 0xb7, 0x56, 0x55, 0xbc, 0xae, 0xcf, 0x28, 0x62,
+	0x2f, 0x7e, 0xa2, 0x85, 0x3a, 0xeb, 0x26, 0x3d, 0x56, 0xc6, 0x34, 0x6e, 0xaf, 0xb8, 0xe3, 0x45,
+	0x82, 0x2a, 0x18, 0xf1, 0x15, 0x6a, 0xf9, 0x6f, 0x50, 0x7b, 0x5a, 0xe8, 0x1c, 0xaa, 0xa1, 0x69,
+	0xd4, 0x64, 0xd1, 0x7c, 0xbb, 0x0c, 0x96, 0x75, 0x07, 0x82, 0xc7, 0x60, 0x2d, 0x93, 0x69, 0x69,
+	0xdb, 0x92, 0x56, 0x6e, 0xcc, 0xb0, 0x92, 0x8a, 0x2a, 0xf5, 0x8e, 0x65, 0x4c, 0x55, 0x27, 0x18,
+	0xdc, 0xae, 0xba, 0x13, 0x14, 0xf8, 0x8d, 0x69, 0x0c, 0xca, 0x86, 0xb5, 0xa8, 0x8e, 0xfa, 0xda,
+	0xac, 0xf6, 0xd4, 0x17, 0x1a, 0x5c, 0x37, 0xc7, 0xa2, 0x97, 0x10, 0xe0, 0xa3, 0x34, 0x66, 0x0a,
+	0x28, 0x20, 0x21, 0x11, 0xd6, 0x92, 0x02, 0xda, 0x6a, 0x99, 0x58, 0xcb, 0x91, 0x91, 0xd9, 0x2e,
+	0x89, 0x0c, 0x4c, 0x45, 0x6b, 0x4a, 0xf4, 0xc7, 0x52, 0x0f, 0x3e, 0x03, 0x5b, 0x7c, 0xc8, 0xe2,
+	0x40, 0x76, 0x9a, 0xa1, 0xab, 0x93, 0xf9, 0x98, 0x61, 0x7e, 0x4c, 0x03, 0xdd, 0xec, 0x3e, 0x37,
+	0xe3, 0xae, 0x1a, 0xf8, 0x07, 0x1a, 0xbd, 0x97, 0x80, 0xc3, 0x00, 0xac, 0x4f, 0x5a, 0x0e, 0xa8,
+	0xb0, 0x2e, 0xcd, 0xc1, 0xe6, 0xda, 0x79, 0x9b, 0x8f, 0xa9, 0x80, 0x0c, 0x5c, 0x51, 0xa7, 0x35,
+	0xed, 0xe4, 0xf2, 0x1c, 0x0c, 0x6e, 0x48, 0xec, 0x29, 0x0f, 0x8f, 0x40, 0xf5, 0x9c, 0x4d, 0xe9,
+	0xde, 0xe5, 0x79, 0x14, 0x71, 0xc6, 0x9a, 0xf4, 0xed, 0x26, 0xa8, 0xb8, 0x84, 0xb9, 0x43, 0x22,
+	0x9c, 0x3e, 0xc3, 0x68, 0x80, 0x99, 0x55, 0x68, 0xe4, 0x76, 0x0a, 0x76, 0xd9, 0x90, 0x3b, 0x9a,
+	0x0a, 0xef, 0x81, 0xed, 0x80, 0x3c, 0x1d, 0x12, 0x4f, 0x4f, 0x93, 0x7e, 0x40, 0xdd, 0x81, 0x43,
+	0x22, 0x81, 0xd9, 0x08, 0x05, 0x56, 0xb1, 0x91, 0xdb, 0x59, 0xb2, 0xad, 0x8c, 0x44, 0x47, 0x0a,
+	0xec, 0x1b, 0x3e, 0xfc, 0x02, 0xac, 0x99, 0x02, 0x74, 0x7c, 0xc4, 0x4d, 0xde, 0xc9, 0xa6, 0x97,
+	0xb7, 0x2b, 0x86, 0xf1, 0x10, 0x71, 0x95, 0x56, 0xcd, 0xd3, 0x25, 0x50, 0x4c, 0x53, 0x18, 0x6e,
+	0x80, 0x4b, 0x7a, 0xd2, 0xe5, 0xd4, 0xa4, 0xd3, 0x0b, 0xb9, 0x6d, 0x86, 0x8f, 0x30, 0xc3, 0x91,
+	0x8b, 0x1d, 0xc4, 0x39, 0x16, 0xaa, 0x1c, 0x8a, 0x76, 0x39, 0x25, 0x3f, 0x90, 0x54, 0x48, 0x64,
+	0x71, 0x46, 0x23, 0xcc, 0xb8, 0xdc, 0xf5, 0x11, 0x72, 0x05, 0x65, 0xd6, 0xd2, 0x1c, 0x0e, 0xb2,
+	0x3a, 0x86, 0xdd, 0x53, 0xa8, 0xf0, 0x7b, 0x53, 0x9d, 0x47, 0x01, 0xa5, 0x6c, 0x2e, 0xf9, 0xaf,
+	0x0a, 0x77, 0x4f, 0xc2, 0x41, 0x07, 0x94, 0x92, 0xe9, 0xc1, 0x90, 0xc0, 0x9f, 0x90, 0xea, 0x5d,
+	0xec, 0x66, 0xe0, 0xbb, 0xd8, 0xb5, 0x57, 0x0c, 0xa2, 0x2d, 0x6f, 0x5c, 0x04, 0xd4, 0x66, 0x8e,
+	0xa7, 0x23, 0x86, 0x9f, 0x0e, 0x71, 0xe4, 0x9e, 0x58, 0xcb, 0xa6, 0x4d, 0x4c, 0xce, 0xa8, 0xae,
+	0xb9, 0x08, 0xea, 0x11, 0xf5, 0xb3, 0x1c, 0x51, 0xd7, 0xf9, 0xf4, 0x68, 0xda, 0x4b, 0x80, 0x9a,
+	0x2f, 0x00, 0xa8, 0x4c, 0x74, 0xbb, 0x0b, 0xc2, 0x0c, 0x41, 0x5e, 0x6e, 0xde, 0xc4, 0x56, 0x7d,
+	0xcb, 0x88, 0x66, 0x13, 0x51, 0xd9, 0xb6, 0x96, 0xe6, 0x70, 0x1c, 0xd5, 0x0c, 0xac, 0x2d, 0xff,
+	0xc2, 0xaf, 0x4d, 0x44, 0x75, 0xba, 0xe6, 0x3f, 0xac, 0x4d, 0xaa, 0xa0, 0xe9, 0x06, 0x89, 0x80,
+	0xbc, 0xd9, 0xf5, 0x49, 0x40, 0xc4, 0x89, 0x73, 0x84, 0xe7, 0x13, 0xb5, 0x52, 0x0a, 0xb9, 0x87,
+	0xb1, 0xcc, 0x8b, 0xa4, 0x45, 0x70, 0xf2, 0x1c, 0xcf, 0xa5, 0x23, 0xad, 0x18, 0xc4, 0x43, 0xf2,
+	0x1c, 0xc3, 0x10, 0xac, 0x67, 0x8f, 0x3b, 0xc6, 0x11, 0x0a, 0xc4, 0x89, 0x75, 0x79, 0x0e, 0x9e,
+	0xc0, 0x0c, 0xf0, 0x81, 0xc6, 0x85, 0x77, 0x41, 0x99, 0xc7, 0x54, 0x38, 0x21, 0x62, 0x03, 0x2c,
+	0xe4, 0xad, 0xb9, 0xa0, 0x2c, 0x55, 0xcf, 0x4e, 0xeb, 0xa5, 0xc3, 0x98, 0x8a, 0x27, 0x8a, 0xb1,
+	0xdf, 0xb5, 0x4b, 0x7c, 0xbc, 0xf2, 0xe0, 0x23, 0xb0, 0x99, 0xdd, 0xe6, 0x58, 0xbd, 0xa8, 0xd4,
+	0xaf, 0xca, 0xab, 0xdc, 0xe3, 0xb1, 0x40, 0x8a, 0xb2, 0x1e, 0x4c, 0x11, 0x3d, 0x38, 0x02, 0xd6,
+	0x00, 0xe3, 0x18, 0x33, 0x87, 0xe1, 0x1f, 0x10, 0xf3, 0x9c, 0x18, 0x33, 0x17, 0x47, 0x02, 0xf9,
+	0xfa, 0xa6, 0xf6, 0xb9, 0x8e, 0x5f, 0xd1, 0xe8, 0xb6, 0x02, 0x3f, 0x48, 0xb1, 0xe5, 0xe5, 0xfd,
+	0xdf, 0xee, 0x31, 0x76, 0x07, 0x99, 0xab, 0x2b, 0x79, 0xae, 0x3d, 0x22, 0x91, 0x87, 0x9f, 0x39,
+	0x2e, 0x1d, 0x46, 0x62, 0x2e, 0xb7, 0xb9, 0x86, 0x32, 0xb4, 0x3b, 0x69, 0x67, 0x5f, 0x9a, 0xd9,
+	0x95, 0x56, 0x66, 0xb7, 0xce, 0xd2, 0x3f, 0xd2, 0x3a, 0x1d, 0x50, 0x72, 0x03, 0xca, 0x71, 0x62,
+	0x65, 0x75, 0x1e, 0xdd, 0x4d, 0x21, 0x1a, 0x03, 0x23, 0x90, 0x9d, 0x4d, 0x8e, 0x40, 0xcc, 0xc7,
+	0xc2, 0xf4, 0x8e, 0xf2, 0x3c, 0x22, 0x9a, 0x41, 0xef, 0x29, 0x70, 0xdd, 0x41, 0x6e, 0x8c, 0xcb,
+	0x53, 0x35, 0xb2, 0x8a, 0x6a, 0x64, 0x49, 0x81, 0xf5, 0x4e, 0x62, 0xdc, 0xfc, 0x69, 0x11, 0x5c,
+	0xbd, 0xe0, 0x6d, 0xa5, 0xa6, 0xf3, 0xf8, 0x6a, 0xa9, 0x10, 0x74, 0x7f, 0x2c, 0x8f, 0xc9, 0x12,
+	0x04, 0xf6, 0xc1, 0xf6, 0xc5, 0xaf, 0x3e, 0x6b, 0xf1, 0x23, 0x5e, 0x17, 0xd6, 0x45, 0xaf, 0x39,
+	0xf9, 0xac, 0x50, 0xf3, 0x1e, 0x73, 0xf1, 0xe9, 0x83, 0x74, 0xfa, 0xe8, 0xca, 0x09, 0xa8, 0x0e,
+	0x55, 0xf3, 0x97, 0x1c, 0xd8, 0x9c, 0xf9, 0xd6, 0xfb, 0xf0, 0xd3, 0xc0, 0xa0, 0x32, 0xf1, 0xec,
+	0xb4, 0x16, 0x3f, 0x7a, 0xa7, 0x33, 0xee, 0x4e, 0xe7, 0x9f, 0x9a, 0x9d, 0xfb, 0xaf, 0xcf, 0x6a,
+	0xb9, 0x37, 0x67, 0xb5, 0xdc, 0x9f, 0x67, 0xb5, 0xdc, 0xcb, 0xf7, 0xb5, 0x85, 0x37, 0xef, 0x6b,
+	0x0b, 0x6f, 0xdf, 0xd7, 0x16, 0xbe, 0xfb, 0x6f, 0x06, 0x5f, 0x5e, 0xcf, 0x6f, 0x05, 0xa8, 0xcf,
+	0xd5, 0x57, 0xfb, 0x99, 0xfa, 0x17, 0x88, 0x32, 0xd1, 0x5f, 0x56, 0x91, 0xf8, 0xf2, 0xaf, 0x01,
+	0x00, 0x41, 0xeb, 0xa0, 0x6e, 0xdf, 0x11, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -563,6 +663,78 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CdpTriggers) > 0 {
+		for iNdEx := len(m.CdpTriggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CdpTriggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CdpManagers) > 0 {
+		for iNdEx := len(m.CdpManagers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CdpManagers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.GlobalSettlement != nil {
+		{
+			size, err := m.GlobalSettlement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.PendingSavings.Size()
+		i -= size
+		if _, err := m.PendingSavings.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousSavingsDistributionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousSavingsDistributionTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	if len(m.MultiCollateralCDPs) > 0 {
+		for iNdEx := len(m.MultiCollateralCDPs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiCollateralCDPs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.TotalPrincipals) > 0 {
 		for iNdEx := len(m.TotalPrincipals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -671,6 +843,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TriggerGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TriggerGasLimit))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.LiquidationBlockInterval != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LiquidationBlockInterval))
 		i--
@@ -783,6 +960,24 @@ func (m *DebtParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SavingsDistributionFrequency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SavingsDistributionFrequency):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SavingsRate.Size()
+		i -= size
+		if _, err := m.SavingsRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.DebtFloor.Size()
 		i -= size
@@ -840,6 +1035,33 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size := m.LiquidationTargetRatio.Size()
+		i -= size
+		if _, err := m.LiquidationTargetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.CloseFactor.Size()
+		i -= size
+		if _, err := m.CloseFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	{
 		size := m.ConversionFactor.Size()
 		i -= size
@@ -981,12 +1203,12 @@ func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccumulationTime):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccumulationTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintGenesis(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if len(m.CollateralType) > 0 {
@@ -1093,6 +1315,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MultiCollateralCDPs) > 0 {
+		for _, e := range m.MultiCollateralCDPs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousSavingsDistributionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PendingSavings.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.GlobalSettlement != nil {
+		l = m.GlobalSettlement.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.CdpManagers) > 0 {
+		for _, e := range m.CdpManagers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CdpTriggers) > 0 {
+		for _, e := range m.CdpTriggers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1126,6 +1374,9 @@ func (m *Params) Size() (n int) {
 	if m.LiquidationBlockInterval != 0 {
 		n += 1 + sovGenesis(uint64(m.LiquidationBlockInterval))
 	}
+	if m.TriggerGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.TriggerGasLimit))
+	}
 	return n
 }
 
@@ -1147,6 +1398,10 @@ func (m *DebtParam) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.DebtFloor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SavingsRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SavingsDistributionFrequency)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1188,6 +1443,14 @@ func (m *CollateralParam) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ConversionFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CloseFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LiquidationTargetRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1510,6 +1773,211 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCollateralCDPs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiCollateralCDPs = append(m.MultiCollateralCDPs, MultiCollateralCDP{})
+			if err := m.MultiCollateralCDPs[len(m.MultiCollateralCDPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSavingsDistributionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreviousSavingsDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSavings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingSavings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalSettlement == nil {
+				m.GlobalSettlement = &GlobalSettlement{}
+			}
+			if err := m.GlobalSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpManagers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CdpManagers = append(m.CdpManagers, CdpManager{})
+			if err := m.CdpManagers[len(m.CdpManagers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpTriggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CdpTriggers = append(m.CdpTriggers, CdpTrigger{})
+			if err := m.CdpTriggers[len(m.CdpTriggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1835,6 +2303,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerGasLimit", wireType)
+			}
+			m.TriggerGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2017,6 +2504,73 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SavingsRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsDistributionFrequency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SavingsDistributionFrequency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2466,6 +3020,106 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationTargetRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationTargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
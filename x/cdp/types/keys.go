@@ -42,6 +42,21 @@ var sep = []byte(":")
 // - 0x08:previousDistributionTime
 // - 0x09<marketID>:downTime
 // - 0x10:totalDistributed
+// - 0x14<cdpID_Bytes>: MultiCollateralCDP
+// - 0x15<cdpOwner_Bytes>: cdpID
+//    - One cdp owner can control one multi-collateral cdp
+// - 0x16<debtType>:<healthFactor_Bytes>:<cdpID_Bytes>: cdpID
+// - 0x17<cdpID_Bytes>: healthFactor_Bytes
+// - 0x18: previousSavingsDistributionTime
+// - 0x19: pendingSavings
+// - 0x1A: globalSettlement
+// - 0x1B<cdpID_Bytes>:<managerAddr_Bytes>: CdpManager
+// - 0x1C<cdpID_Bytes>:<action_Bytes>: CdpTrigger
+// - 0x1D: multiCdpHealthIndexRefreshCursor
+// - 0x1E: settlementCdpCursor
+// - 0x1F: settlementMultiCdpCursor
+// - 0x20<collateralType>:<cdpID_Bytes>:<action_Bytes>: cdpID
+// - 0x21<collateralType>: cdpTriggerCursor
 
 // KVStore key prefixes
 var (
@@ -56,6 +71,26 @@ var (
 	PricefeedStatusKeyPrefix   = []byte{0x10}
 	PreviousAccrualTimePrefix  = []byte{0x12}
 	InterestFactorPrefix       = []byte{0x13}
+
+	MultiCdpKeyPrefix              = []byte{0x14}
+	MultiCdpOwnerKeyPrefix         = []byte{0x15}
+	MultiCdpHealthIndexPrefix      = []byte{0x16}
+	MultiCdpIndexedHealthKeyPrefix = []byte{0x17}
+
+	PreviousSavingsDistributionTimeKey = []byte{0x18}
+	PendingSavingsKey                  = []byte{0x19}
+
+	GlobalSettlementKey = []byte{0x1A}
+	CdpManagerKeyPrefix = []byte{0x1B}
+	CdpTriggerKeyPrefix = []byte{0x1C}
+
+	MultiCdpRefreshCursorKey = []byte{0x1D}
+
+	SettlementCdpCursorKey      = []byte{0x1E}
+	SettlementMultiCdpCursorKey = []byte{0x1F}
+
+	CdpTriggerCollateralIndexPrefix = []byte{0x20}
+	CdpTriggerCursorKeyPrefix       = []byte{0x21}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
@@ -114,6 +149,32 @@ func SplitDepositIterKey(key []byte) (cdpID uint64) {
 	return GetCdpIDFromBytes(key)
 }
 
+// CdpManagerKey key of a specific cdp manager in the store
+func CdpManagerKey(cdpID uint64, manager sdk.AccAddress) []byte {
+	return createKey(GetCdpIDBytes(cdpID), sep, manager)
+}
+
+// CdpTriggerKey key of a specific cdp trigger in the store
+func CdpTriggerKey(cdpID uint64, action CdpTriggerAction) []byte {
+	return createKey(GetCdpIDBytes(cdpID), sep, []byte{byte(action)})
+}
+
+// SplitCdpTriggerKey returns the cdp id and action of a cdp trigger key
+func SplitCdpTriggerKey(key []byte) (uint64, CdpTriggerAction) {
+	return GetCdpIDFromBytes(key[:8]), CdpTriggerAction(key[len(key)-1])
+}
+
+// CdpTriggerCollateralPrefix returns the prefix of the index of triggers of cdps of a collateral type, under which
+// triggers are keyed by CdpTriggerKey
+func CdpTriggerCollateralPrefix(collateralType string) []byte {
+	return createKey([]byte(collateralType), sep)
+}
+
+// CdpTriggerCollateralKey key of a cdp trigger in the index of triggers by collateral type
+func CdpTriggerCollateralKey(collateralType string, cdpID uint64, action CdpTriggerAction) []byte {
+	return createKey(CdpTriggerCollateralPrefix(collateralType), CdpTriggerKey(cdpID, action))
+}
+
 // CollateralRatioBytes returns the liquidation ratio as sortable bytes
 func CollateralRatioBytes(ratio sdk.Dec) []byte {
 	ok := ValidSortableDec(ratio)
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCdpManager returns a new CdpManager
+func NewCdpManager(cdpID uint64, owner, manager sdk.AccAddress, scope CdpManagerScope, expiry time.Time) CdpManager {
+	return CdpManager{
+		CdpID:   cdpID,
+		Owner:   owner,
+		Manager: manager,
+		Scope:   scope,
+		Expiry:  expiry,
+	}
+}
+
+// Validate performs a basic validation of the CdpManager fields.
+func (m CdpManager) Validate() error {
+	if m.CdpID == 0 {
+		return errors.New("cdp manager cdp id cannot be 0")
+	}
+	if m.Owner.Empty() {
+		return errors.New("cdp manager owner cannot be empty")
+	}
+	if m.Manager.Empty() {
+		return errors.New("cdp manager cannot be empty")
+	}
+	if m.Owner.Equals(m.Manager) {
+		return fmt.Errorf("cdp manager cannot be the owner %s", m.Owner)
+	}
+	if err := ValidateCdpManagerScope(m.Scope); err != nil {
+		return err
+	}
+	if m.Expiry.Unix() <= 0 {
+		return errors.New("cdp manager expiry cannot be zero")
+	}
+	return nil
+}
+
+// IsExpired returns true if the authorization is no longer valid at the input block time
+func (m CdpManager) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(m.Expiry)
+}
+
+// CdpManagers a collection of CdpManager objects
+type CdpManagers []CdpManager
+
+// Validate validates each CdpManager and checks that each cdp has at most one authorization per manager
+func (ms CdpManagers) Validate() error {
+	seen := make(map[string]bool)
+	for _, m := range ms {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d:%s", m.CdpID, m.Manager)
+		if seen[key] {
+			return fmt.Errorf("duplicate cdp manager %s for cdp %d", m.Manager, m.CdpID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Includes returns true if the scope authorizes the operations of the required scope
+func (s CdpManagerScope) Includes(required CdpManagerScope) bool {
+	return s >= required
+}
+
+// ValidateCdpManagerScope returns an error if the scope is not a valid authorization scope
+func ValidateCdpManagerScope(scope CdpManagerScope) error {
+	switch scope {
+	case CDP_MANAGER_SCOPE_REPAY, CDP_MANAGER_SCOPE_REBALANCE, CDP_MANAGER_SCOPE_FULL:
+		return nil
+	default:
+		return fmt.Errorf("invalid cdp manager scope %s", scope)
+	}
+}
+
+// ParseCdpManagerScope returns the scope matching the input string, one of repay, rebalance or full
+func ParseCdpManagerScope(scope string) (CdpManagerScope, error) {
+	switch strings.ToLower(strings.TrimSpace(scope)) {
+	case "repay":
+		return CDP_MANAGER_SCOPE_REPAY, nil
+	case "rebalance":
+		return CDP_MANAGER_SCOPE_REBALANCE, nil
+	case "full":
+		return CDP_MANAGER_SCOPE_FULL, nil
+	default:
+		return CDP_MANAGER_SCOPE_UNSPECIFIED, fmt.Errorf("invalid cdp manager scope %s, must be one of repay, rebalance or full", scope)
+	}
+}
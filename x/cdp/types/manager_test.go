@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+func TestCdpManagerValidation(t *testing.T) {
+	owner := sdk.AccAddress("owner1")
+	manager := sdk.AccAddress("manager1")
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid := types.NewCdpManager(1, owner, manager, types.CDP_MANAGER_SCOPE_REBALANCE, expiry)
+
+	testCases := []struct {
+		name       string
+		managers   types.CdpManagers
+		expectPass bool
+	}{
+		{"valid", types.CdpManagers{valid}, true},
+		{
+			"valid multiple managers",
+			types.CdpManagers{valid, types.NewCdpManager(1, owner, sdk.AccAddress("manager2"), types.CDP_MANAGER_SCOPE_FULL, expiry)},
+			true,
+		},
+		{"zero cdp id", types.CdpManagers{types.NewCdpManager(0, owner, manager, types.CDP_MANAGER_SCOPE_FULL, expiry)}, false},
+		{"empty owner", types.CdpManagers{types.NewCdpManager(1, nil, manager, types.CDP_MANAGER_SCOPE_FULL, expiry)}, false},
+		{"empty manager", types.CdpManagers{types.NewCdpManager(1, owner, nil, types.CDP_MANAGER_SCOPE_FULL, expiry)}, false},
+		{"manager is owner", types.CdpManagers{types.NewCdpManager(1, owner, owner, types.CDP_MANAGER_SCOPE_FULL, expiry)}, false},
+		{"invalid scope", types.CdpManagers{types.NewCdpManager(1, owner, manager, types.CdpManagerScope(4), expiry)}, false},
+		{"zero expiry", types.CdpManagers{types.NewCdpManager(1, owner, manager, types.CDP_MANAGER_SCOPE_FULL, time.Time{})}, false},
+		{"duplicate manager", types.CdpManagers{valid, valid}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.managers.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCdpManagerExpiry(t *testing.T) {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := types.NewCdpManager(1, sdk.AccAddress("owner1"), sdk.AccAddress("manager1"), types.CDP_MANAGER_SCOPE_FULL, expiry)
+
+	require.False(t, m.IsExpired(expiry.Add(-time.Second)))
+	require.True(t, m.IsExpired(expiry))
+	require.True(t, m.IsExpired(expiry.Add(time.Second)))
+}
+
+func TestCdpManagerScope(t *testing.T) {
+	require.True(t, types.CDP_MANAGER_SCOPE_FULL.Includes(types.CDP_MANAGER_SCOPE_REBALANCE))
+	require.True(t, types.CDP_MANAGER_SCOPE_REBALANCE.Includes(types.CDP_MANAGER_SCOPE_REPAY))
+	require.True(t, types.CDP_MANAGER_SCOPE_REPAY.Includes(types.CDP_MANAGER_SCOPE_REPAY))
+	require.False(t, types.CDP_MANAGER_SCOPE_REPAY.Includes(types.CDP_MANAGER_SCOPE_REBALANCE))
+	require.False(t, types.CDP_MANAGER_SCOPE_REBALANCE.Includes(types.CDP_MANAGER_SCOPE_FULL))
+
+	for s, expected := range map[string]types.CdpManagerScope{
+		"repay":     types.CDP_MANAGER_SCOPE_REPAY,
+		"Rebalance": types.CDP_MANAGER_SCOPE_REBALANCE,
+		"full":      types.CDP_MANAGER_SCOPE_FULL,
+	} {
+		scope, err := types.ParseCdpManagerScope(s)
+		require.NoError(t, err)
+		require.Equal(t, expected, scope)
+	}
+	_, err := types.ParseCdpManagerScope("withdraw")
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +19,16 @@ var (
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgCreateMultiCollateralCDP{}
+	_ sdk.Msg = &MsgDepositMultiCollateral{}
+	_ sdk.Msg = &MsgWithdrawMultiCollateral{}
+	_ sdk.Msg = &MsgDrawMultiCollateralDebt{}
+	_ sdk.Msg = &MsgRepayMultiCollateralDebt{}
+	_ sdk.Msg = &MsgRedeemUSDX{}
+	_ sdk.Msg = &MsgGrantCdpManager{}
+	_ sdk.Msg = &MsgRevokeCdpManager{}
+	_ sdk.Msg = &MsgSetCdpTrigger{}
+	_ sdk.Msg = &MsgRemoveCdpTrigger{}
 )
 
 // NewMsgCreateCDP returns a new MsgPlaceBid.
@@ -103,6 +114,12 @@ func (msg MsgDeposit) ValidateBasic() error {
 	if strings.TrimSpace(msg.CollateralType) == "" {
 		return fmt.Errorf("collateral type cannot be empty")
 	}
+	if err := validateManager(msg.Manager, msg.Owner); err != nil {
+		return err
+	}
+	if msg.Manager != "" && msg.Depositor != msg.Owner {
+		return errors.New("depositor must be the owner when signed by a manager")
+	}
 	return nil
 }
 
@@ -114,6 +131,13 @@ func (msg MsgDeposit) GetSignBytes() []byte {
 
 // GetSigners returns the addresses of signers that must sign.
 func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	if msg.Manager != "" {
+		manager, err := sdk.AccAddressFromBech32(msg.Manager)
+		if err != nil {
+			panic(err)
+		}
+		return []sdk.AccAddress{manager}
+	}
 	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
 	if err != nil {
 		panic(err)
@@ -154,6 +178,12 @@ func (msg MsgWithdraw) ValidateBasic() error {
 	if strings.TrimSpace(msg.CollateralType) == "" {
 		return fmt.Errorf("collateral type cannot be empty")
 	}
+	if err := validateManager(msg.Manager, msg.Owner); err != nil {
+		return err
+	}
+	if msg.Manager != "" && msg.Depositor != msg.Owner {
+		return errors.New("depositor must be the owner when signed by a manager")
+	}
 	return nil
 }
 
@@ -165,6 +195,13 @@ func (msg MsgWithdraw) GetSignBytes() []byte {
 
 // GetSigners returns the addresses of signers that must sign.
 func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	if msg.Manager != "" {
+		manager, err := sdk.AccAddressFromBech32(msg.Manager)
+		if err != nil {
+			panic(err)
+		}
+		return []sdk.AccAddress{manager}
+	}
 	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
 	if err != nil {
 		panic(err)
@@ -200,7 +237,7 @@ func (msg MsgDrawDebt) ValidateBasic() error {
 	if msg.Principal.IsZero() || !msg.Principal.IsValid() {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "principal amount %s", msg.Principal)
 	}
-	return nil
+	return validateManager(msg.Manager, msg.Sender)
 }
 
 // GetSignBytes gets the canonical byte representation of the Msg.
@@ -211,6 +248,13 @@ func (msg MsgDrawDebt) GetSignBytes() []byte {
 
 // GetSigners returns the addresses of signers that must sign.
 func (msg MsgDrawDebt) GetSigners() []sdk.AccAddress {
+	if msg.Manager != "" {
+		manager, err := sdk.AccAddressFromBech32(msg.Manager)
+		if err != nil {
+			panic(err)
+		}
+		return []sdk.AccAddress{manager}
+	}
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		panic(err)
@@ -246,7 +290,7 @@ func (msg MsgRepayDebt) ValidateBasic() error {
 	if msg.Payment.IsZero() || !msg.Payment.IsValid() {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "payment amount %s", msg.Payment)
 	}
-	return nil
+	return validateManager(msg.Manager, msg.Sender)
 }
 
 // GetSignBytes gets the canonical byte representation of the Msg.
@@ -257,6 +301,13 @@ func (msg MsgRepayDebt) GetSignBytes() []byte {
 
 // GetSigners returns the addresses of signers that must sign.
 func (msg MsgRepayDebt) GetSigners() []sdk.AccAddress {
+	if msg.Manager != "" {
+		manager, err := sdk.AccAddressFromBech32(msg.Manager)
+		if err != nil {
+			panic(err)
+		}
+		return []sdk.AccAddress{manager}
+	}
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		panic(err)
@@ -310,3 +361,506 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgCreateMultiCollateralCDP returns a new MsgCreateMultiCollateralCDP.
+func NewMsgCreateMultiCollateralCDP(sender sdk.AccAddress, collateral CollateralBalances, principal sdk.Coin, debtType string) MsgCreateMultiCollateralCDP {
+	return MsgCreateMultiCollateralCDP{
+		Sender:     sender.String(),
+		Collateral: collateral,
+		Principal:  principal,
+		DebtType:   debtType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCreateMultiCollateralCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCreateMultiCollateralCDP) Type() string { return "create_multi_collateral_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCreateMultiCollateralCDP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Collateral.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if msg.Principal.IsZero() || !msg.Principal.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "principal amount %s", msg.Principal)
+	}
+	if strings.TrimSpace(msg.DebtType) == "" {
+		return fmt.Errorf("debt type cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCreateMultiCollateralCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCreateMultiCollateralCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgDepositMultiCollateral returns a new MsgDepositMultiCollateral
+func NewMsgDepositMultiCollateral(sender sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgDepositMultiCollateral {
+	return MsgDepositMultiCollateral{
+		Sender:         sender.String(),
+		Collateral:     collateral,
+		CollateralType: collateralType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositMultiCollateral) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositMultiCollateral) Type() string { return "deposit_multi_collateral_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositMultiCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.Collateral.IsValid() || msg.Collateral.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "collateral amount %s", msg.Collateral)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	return validateManager(msg.Manager, msg.Sender)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositMultiCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositMultiCollateral) GetSigners() []sdk.AccAddress {
+	if msg.Manager != "" {
+		manager, err := sdk.AccAddressFromBech32(msg.Manager)
+		if err != nil {
+			panic(err)
+		}
+		return []sdk.AccAddress{manager}
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgWithdrawMultiCollateral returns a new MsgWithdrawMultiCollateral
+func NewMsgWithdrawMultiCollateral(sender sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgWithdrawMultiCollateral {
+	return MsgWithdrawMultiCollateral{
+		Sender:         sender.String(),
+		Collateral:     collateral,
+		CollateralType: collateralType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawMultiCollateral) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawMultiCollateral) Type() string { return "withdraw_multi_collateral_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawMultiCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.Collateral.IsValid() || msg.Collateral.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "collateral amount %s", msg.Collateral)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	return validateManager(msg.Manager, msg.Sender)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawMultiCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawMultiCollateral) GetSigners() []sdk.AccAddress {
+	if msg.Manager != "" {
+		manager, err := sdk.AccAddressFromBech32(msg.Manager)
+		if err != nil {
+			panic(err)
+		}
+		return []sdk.AccAddress{manager}
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgDrawMultiCollateralDebt returns a new MsgDrawMultiCollateralDebt
+func NewMsgDrawMultiCollateralDebt(sender sdk.AccAddress, principal sdk.Coin) MsgDrawMultiCollateralDebt {
+	return MsgDrawMultiCollateralDebt{
+		Sender:    sender.String(),
+		Principal: principal,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDrawMultiCollateralDebt) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDrawMultiCollateralDebt) Type() string { return "draw_multi_collateral_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDrawMultiCollateralDebt) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Principal.IsZero() || !msg.Principal.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "principal amount %s", msg.Principal)
+	}
+	return validateManager(msg.Manager, msg.Sender)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDrawMultiCollateralDebt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDrawMultiCollateralDebt) GetSigners() []sdk.AccAddress {
+	if msg.Manager != "" {
+		manager, err := sdk.AccAddressFromBech32(msg.Manager)
+		if err != nil {
+			panic(err)
+		}
+		return []sdk.AccAddress{manager}
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRepayMultiCollateralDebt returns a new MsgRepayMultiCollateralDebt
+func NewMsgRepayMultiCollateralDebt(sender sdk.AccAddress, payment sdk.Coin) MsgRepayMultiCollateralDebt {
+	return MsgRepayMultiCollateralDebt{
+		Sender:  sender.String(),
+		Payment: payment,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRepayMultiCollateralDebt) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRepayMultiCollateralDebt) Type() string { return "repay_multi_collateral_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRepayMultiCollateralDebt) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Payment.IsZero() || !msg.Payment.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "payment amount %s", msg.Payment)
+	}
+	return validateManager(msg.Manager, msg.Sender)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRepayMultiCollateralDebt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRepayMultiCollateralDebt) GetSigners() []sdk.AccAddress {
+	if msg.Manager != "" {
+		manager, err := sdk.AccAddressFromBech32(msg.Manager)
+		if err != nil {
+			panic(err)
+		}
+		return []sdk.AccAddress{manager}
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRedeemUSDX returns a new MsgRedeemUSDX
+func NewMsgRedeemUSDX(sender sdk.AccAddress, amount sdk.Coin) MsgRedeemUSDX {
+	return MsgRedeemUSDX{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedeemUSDX) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedeemUSDX) Type() string { return "redeem_usdx" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemUSDX) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "redemption amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemUSDX) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemUSDX) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgGrantCdpManager returns a new MsgGrantCdpManager
+func NewMsgGrantCdpManager(owner, manager sdk.AccAddress, cdpID uint64, scope CdpManagerScope, expiry time.Time) MsgGrantCdpManager {
+	return MsgGrantCdpManager{
+		Owner:   owner.String(),
+		Manager: manager.String(),
+		CdpID:   cdpID,
+		Scope:   scope,
+		Expiry:  expiry,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgGrantCdpManager) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgGrantCdpManager) Type() string { return "grant_cdp_manager" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgGrantCdpManager) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	if msg.Manager == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "manager cannot be empty")
+	}
+	if err := validateManager(msg.Manager, msg.Owner); err != nil {
+		return err
+	}
+	if msg.CdpID == 0 {
+		return errors.New("cdp id cannot be 0")
+	}
+	if err := ValidateCdpManagerScope(msg.Scope); err != nil {
+		return err
+	}
+	if msg.Expiry.Unix() <= 0 {
+		return errors.New("expiry cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgGrantCdpManager) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgGrantCdpManager) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgRevokeCdpManager returns a new MsgRevokeCdpManager
+func NewMsgRevokeCdpManager(owner, manager sdk.AccAddress, cdpID uint64) MsgRevokeCdpManager {
+	return MsgRevokeCdpManager{
+		Owner:   owner.String(),
+		Manager: manager.String(),
+		CdpID:   cdpID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevokeCdpManager) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevokeCdpManager) Type() string { return "revoke_cdp_manager" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevokeCdpManager) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address %s", err)
+	}
+	if msg.CdpID == 0 {
+		return errors.New("cdp id cannot be 0")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevokeCdpManager) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevokeCdpManager) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgSetCdpTrigger returns a new MsgSetCdpTrigger
+func NewMsgSetCdpTrigger(
+	owner sdk.AccAddress, collateralType string, action CdpTriggerAction, triggerRatio, targetRatio sdk.Dec, maxAmount sdk.Coin,
+) MsgSetCdpTrigger {
+	return MsgSetCdpTrigger{
+		Owner:          owner.String(),
+		CollateralType: collateralType,
+		Action:         action,
+		TriggerRatio:   triggerRatio,
+		TargetRatio:    targetRatio,
+		MaxAmount:      maxAmount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetCdpTrigger) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetCdpTrigger) Type() string { return "set_cdp_trigger" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetCdpTrigger) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("collateral type cannot be empty")
+	}
+	if err := ValidateCdpTrigger(msg.Action, msg.TriggerRatio, msg.TargetRatio, msg.MaxAmount); err != nil {
+		return errorsmod.Wrap(ErrInvalidCdpTrigger, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetCdpTrigger) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetCdpTrigger) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgRemoveCdpTrigger returns a new MsgRemoveCdpTrigger
+func NewMsgRemoveCdpTrigger(owner sdk.AccAddress, collateralType string, action CdpTriggerAction) MsgRemoveCdpTrigger {
+	return MsgRemoveCdpTrigger{
+		Owner:          owner.String(),
+		CollateralType: collateralType,
+		Action:         action,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRemoveCdpTrigger) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRemoveCdpTrigger) Type() string { return "remove_cdp_trigger" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRemoveCdpTrigger) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("collateral type cannot be empty")
+	}
+	return ValidateCdpTriggerAction(msg.Action)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRemoveCdpTrigger) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRemoveCdpTrigger) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// validateManager validates the optional manager of a msg that operates a cdp on behalf of its owner
+func validateManager(manager, owner string) error {
+	if manager == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(manager); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address %s", err)
+	}
+	if manager == owner {
+		return errors.New("manager cannot be the owner of the cdp")
+	}
+	return nil
+}
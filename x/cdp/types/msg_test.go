@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -167,3 +168,231 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCreateMultiCollateralCDP(t *testing.T) {
+	collateral := CollateralBalances{
+		NewCollateralBalance("type-a", coinsSingle),
+		NewCollateralBalance("type-b", sdk.NewInt64Coin("btc", 1000)),
+	}
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		collateral  CollateralBalances
+		principal   sdk.Coin
+		debtType    string
+		expectPass  bool
+	}{
+		{"create multi-collateral cdp", addrs[0], collateral, coinsSingle, "type-a", true},
+		{"create multi-collateral cdp no collateral", addrs[0], CollateralBalances{}, coinsSingle, "type-a", false},
+		{"create multi-collateral cdp zero collateral", addrs[0], CollateralBalances{NewCollateralBalance("type-a", coinsZero)}, coinsSingle, "type-a", false},
+		{"create multi-collateral cdp duplicate type", addrs[0], CollateralBalances{collateral[0], collateral[0]}, coinsSingle, "type-a", false},
+		{"create multi-collateral cdp no debt", addrs[0], collateral, coinsZero, "type-a", false},
+		{"create multi-collateral cdp empty owner", sdk.AccAddress{}, collateral, coinsSingle, "type-a", false},
+		{"create multi-collateral cdp empty debt type", addrs[0], collateral, coinsSingle, "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCreateMultiCollateralCDP(
+			tc.sender,
+			tc.collateral,
+			tc.principal,
+			tc.debtType,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgDepositWithdrawMultiCollateral(t *testing.T) {
+	tests := []struct {
+		description    string
+		sender         sdk.AccAddress
+		collateral     sdk.Coin
+		collateralType string
+		expectPass     bool
+	}{
+		{"valid", addrs[0], coinsSingle, "type-a", true},
+		{"empty sender", sdk.AccAddress{}, coinsSingle, "type-a", false},
+		{"zero collateral", addrs[0], coinsZero, "type-a", false},
+		{"empty collateral type", addrs[0], coinsSingle, "", false},
+	}
+
+	for _, tc := range tests {
+		deposit := NewMsgDepositMultiCollateral(tc.sender, tc.collateral, tc.collateralType)
+		withdraw := NewMsgWithdrawMultiCollateral(tc.sender, tc.collateral, tc.collateralType)
+		if tc.expectPass {
+			require.NoError(t, deposit.ValidateBasic(), "test: %v", tc.description)
+			require.NoError(t, withdraw.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, deposit.ValidateBasic(), "test: %v", tc.description)
+			require.Error(t, withdraw.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgDrawRepayMultiCollateralDebt(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"valid", addrs[0], coinsSingle, true},
+		{"empty sender", sdk.AccAddress{}, coinsSingle, false},
+		{"zero amount", addrs[0], coinsZero, false},
+	}
+
+	for _, tc := range tests {
+		draw := NewMsgDrawMultiCollateralDebt(tc.sender, tc.amount)
+		repay := NewMsgRepayMultiCollateralDebt(tc.sender, tc.amount)
+		if tc.expectPass {
+			require.NoError(t, draw.ValidateBasic(), "test: %v", tc.description)
+			require.NoError(t, repay.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, draw.ValidateBasic(), "test: %v", tc.description)
+			require.Error(t, repay.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgRedeemUSDX(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"valid", addrs[0], coinsSingle, true},
+		{"empty sender", sdk.AccAddress{}, coinsSingle, false},
+		{"zero amount", addrs[0], coinsZero, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemUSDX(tc.sender, tc.amount)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgManagedCdp(t *testing.T) {
+	tests := []struct {
+		description string
+		msg         sdk.Msg
+		signer      sdk.AccAddress
+		expectPass  bool
+	}{
+		{
+			"draw by manager",
+			&MsgDrawDebt{Sender: addrs[0].String(), CollateralType: "type-a", Principal: coinsSingle, Manager: addrs[1].String()},
+			addrs[1], true,
+		},
+		{
+			"draw manager is sender",
+			&MsgDrawDebt{Sender: addrs[0].String(), CollateralType: "type-a", Principal: coinsSingle, Manager: addrs[0].String()},
+			addrs[0], false,
+		},
+		{
+			"repay invalid manager",
+			&MsgRepayDebt{Sender: addrs[0].String(), CollateralType: "type-a", Payment: coinsSingle, Manager: "invalid"},
+			nil, false,
+		},
+		{
+			"withdraw by manager",
+			&MsgWithdraw{Owner: addrs[0].String(), Depositor: addrs[0].String(), Collateral: coinsSingle, CollateralType: "type-a", Manager: addrs[1].String()},
+			addrs[1], true,
+		},
+		{
+			"withdraw by manager for another depositor",
+			&MsgWithdraw{Owner: addrs[0].String(), Depositor: addrs[1].String(), Collateral: coinsSingle, CollateralType: "type-a", Manager: addrs[1].String()},
+			addrs[1], false,
+		},
+		{
+			"deposit multi-collateral by manager",
+			&MsgDepositMultiCollateral{Sender: addrs[0].String(), Collateral: coinsSingle, CollateralType: "type-a", Manager: addrs[1].String()},
+			addrs[1], true,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.description)
+			require.Equal(t, []sdk.AccAddress{tc.signer}, tc.msg.GetSigners(), "test: %v", tc.description)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgGrantRevokeCdpManager(t *testing.T) {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		owner       sdk.AccAddress
+		manager     sdk.AccAddress
+		cdpID       uint64
+		scope       CdpManagerScope
+		expiry      time.Time
+		expectPass  bool
+	}{
+		{"valid", addrs[0], addrs[1], 1, CDP_MANAGER_SCOPE_REPAY, expiry, true},
+		{"empty owner", sdk.AccAddress{}, addrs[1], 1, CDP_MANAGER_SCOPE_REPAY, expiry, false},
+		{"empty manager", addrs[0], sdk.AccAddress{}, 1, CDP_MANAGER_SCOPE_REPAY, expiry, false},
+		{"manager is owner", addrs[0], addrs[0], 1, CDP_MANAGER_SCOPE_REPAY, expiry, false},
+		{"zero cdp id", addrs[0], addrs[1], 0, CDP_MANAGER_SCOPE_REPAY, expiry, false},
+		{"unspecified scope", addrs[0], addrs[1], 1, CDP_MANAGER_SCOPE_UNSPECIFIED, expiry, false},
+		{"zero expiry", addrs[0], addrs[1], 1, CDP_MANAGER_SCOPE_FULL, time.Time{}, false},
+	}
+
+	for _, tc := range tests {
+		grant := NewMsgGrantCdpManager(tc.owner, tc.manager, tc.cdpID, tc.scope, tc.expiry)
+		revoke := NewMsgRevokeCdpManager(tc.owner, tc.manager, tc.cdpID)
+		if tc.expectPass {
+			require.NoError(t, grant.ValidateBasic(), "test: %v", tc.description)
+			require.NoError(t, revoke.ValidateBasic(), "test: %v", tc.description)
+			require.Equal(t, []sdk.AccAddress{tc.owner}, grant.GetSigners())
+			require.Equal(t, []sdk.AccAddress{tc.owner}, revoke.GetSigners())
+		} else {
+			require.Error(t, grant.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgSetRemoveCdpTrigger(t *testing.T) {
+	tests := []struct {
+		description    string
+		owner          sdk.AccAddress
+		collateralType string
+		action         CdpTriggerAction
+		triggerRatio   sdk.Dec
+		targetRatio    sdk.Dec
+		maxAmount      sdk.Coin
+		expectPass     bool
+	}{
+		{"valid", addrs[0], "type-a", CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), coinsSingle, true},
+		{"empty owner", sdk.AccAddress{}, "type-a", CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), coinsSingle, false},
+		{"empty type", addrs[0], "", CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), coinsSingle, false},
+		{"unspecified action", addrs[0], "type-a", CDP_TRIGGER_ACTION_UNSPECIFIED, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), coinsSingle, false},
+		{"target below trigger", addrs[0], "type-a", CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS, sdk.MustNewDecFromStr("2.0"), sdk.MustNewDecFromStr("1.6"), coinsSingle, false},
+		{"zero max amount", addrs[0], "type-a", CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), coinsZero, false},
+	}
+
+	for _, tc := range tests {
+		set := NewMsgSetCdpTrigger(tc.owner, tc.collateralType, tc.action, tc.triggerRatio, tc.targetRatio, tc.maxAmount)
+		if tc.expectPass {
+			require.NoError(t, set.ValidateBasic(), "test: %v", tc.description)
+			require.Equal(t, []sdk.AccAddress{tc.owner}, set.GetSigners())
+		} else {
+			require.Error(t, set.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+
+	require.NoError(t, NewMsgRemoveCdpTrigger(addrs[0], "type-a", CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS).ValidateBasic())
+	require.Error(t, NewMsgRemoveCdpTrigger(addrs[0], "type-a", CDP_TRIGGER_ACTION_UNSPECIFIED).ValidateBasic())
+	require.Error(t, NewMsgRemoveCdpTrigger(addrs[0], "", CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS).ValidateBasic())
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMultiCollateralCDP creates a new MultiCollateralCDP object
+func NewMultiCollateralCDP(id uint64, owner sdk.AccAddress, collateral CollateralBalances, debtType string, principal sdk.Coin, time time.Time, interestFactor sdk.Dec) MultiCollateralCDP {
+	fees := sdk.NewCoin(principal.Denom, sdk.ZeroInt())
+	return MultiCollateralCDP{
+		ID:              id,
+		Owner:           owner,
+		DebtType:        debtType,
+		Collateral:      collateral,
+		Principal:       principal,
+		AccumulatedFees: fees,
+		FeesUpdated:     time,
+		InterestFactor:  interestFactor,
+	}
+}
+
+// Validate performs a basic validation of the MultiCollateralCDP fields.
+func (cdp MultiCollateralCDP) Validate() error {
+	if cdp.ID == 0 {
+		return errors.New("cdp id cannot be 0")
+	}
+	if cdp.Owner.Empty() {
+		return errors.New("cdp owner cannot be empty")
+	}
+	if strings.TrimSpace(cdp.DebtType) == "" {
+		return fmt.Errorf("cdp debt type cannot be empty")
+	}
+	if err := cdp.Collateral.Validate(); err != nil {
+		return err
+	}
+	if !cdp.Principal.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "principal %s", cdp.Principal)
+	}
+	if !cdp.AccumulatedFees.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "accumulated fees %s", cdp.AccumulatedFees)
+	}
+	if cdp.FeesUpdated.Unix() <= 0 {
+		return errors.New("cdp updated fee time cannot be zero")
+	}
+	return nil
+}
+
+// GetTotalPrincipal returns the total principle for the cdp
+func (cdp MultiCollateralCDP) GetTotalPrincipal() sdk.Coin {
+	return cdp.Principal.Add(cdp.AccumulatedFees)
+}
+
+// MultiCollateralCDPs a collection of MultiCollateralCDP objects
+type MultiCollateralCDPs []MultiCollateralCDP
+
+// Validate validates each MultiCollateralCDP and checks that ids and owners are unique
+func (cdps MultiCollateralCDPs) Validate() error {
+	ids := make(map[uint64]bool)
+	owners := make(map[string]bool)
+	for _, cdp := range cdps {
+		if err := cdp.Validate(); err != nil {
+			return err
+		}
+		if ids[cdp.ID] {
+			return fmt.Errorf("duplicate multi-collateral cdp id %d", cdp.ID)
+		}
+		ids[cdp.ID] = true
+		if owners[cdp.Owner.String()] {
+			return fmt.Errorf("duplicate multi-collateral cdp owner %s", cdp.Owner)
+		}
+		owners[cdp.Owner.String()] = true
+	}
+	return nil
+}
+
+// NewCollateralBalance returns a new CollateralBalance
+func NewCollateralBalance(collateralType string, amount sdk.Coin) CollateralBalance {
+	return CollateralBalance{
+		Type:   collateralType,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic validation of the CollateralBalance fields.
+func (cb CollateralBalance) Validate() error {
+	if strings.TrimSpace(cb.Type) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	if !cb.Amount.IsValid() || cb.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "collateral %s for %s", cb.Amount, cb.Type)
+	}
+	return nil
+}
+
+// CollateralBalances a collection of CollateralBalance objects
+type CollateralBalances []CollateralBalance
+
+// Validate validates each CollateralBalance and checks that collateral types are unique
+func (cbs CollateralBalances) Validate() error {
+	if len(cbs) == 0 {
+		return fmt.Errorf("collateral cannot be empty")
+	}
+	types := make(map[string]bool)
+	for _, cb := range cbs {
+		if err := cb.Validate(); err != nil {
+			return err
+		}
+		if types[cb.Type] {
+			return fmt.Errorf("duplicate collateral type %s", cb.Type)
+		}
+		types[cb.Type] = true
+	}
+	return nil
+}
+
+// AmountOf returns the amount of collateral held for a collateral type
+func (cbs CollateralBalances) AmountOf(collateralType string) (sdk.Coin, bool) {
+	for _, cb := range cbs {
+		if cb.Type == collateralType {
+			return cb.Amount, true
+		}
+	}
+	return sdk.Coin{}, false
+}
+
+// Add adds collateral of a collateral type, appending a new balance if the type isn't held yet
+func (cbs CollateralBalances) Add(collateralType string, amount sdk.Coin) CollateralBalances {
+	updated := make(CollateralBalances, 0, len(cbs)+1)
+	added := false
+	for _, cb := range cbs {
+		if cb.Type == collateralType {
+			cb.Amount = cb.Amount.Add(amount)
+			added = true
+		}
+		updated = append(updated, cb)
+	}
+	if !added {
+		updated = append(updated, NewCollateralBalance(collateralType, amount))
+	}
+	return updated
+}
+
+// Sub removes collateral of a collateral type, removing the balance if it reaches zero.
+// CONTRACT: the amount must not exceed the balance of the collateral type.
+func (cbs CollateralBalances) Sub(collateralType string, amount sdk.Coin) CollateralBalances {
+	updated := make(CollateralBalances, 0, len(cbs))
+	for _, cb := range cbs {
+		if cb.Type == collateralType {
+			cb.Amount = cb.Amount.Sub(amount)
+			if cb.Amount.IsZero() {
+				continue
+			}
+		}
+		updated = append(updated, cb)
+	}
+	return updated
+}
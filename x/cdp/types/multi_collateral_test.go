@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+func TestMultiCollateralCDPValidation(t *testing.T) {
+	owner := sdk.AccAddress("test1")
+	collateral := types.CollateralBalances{
+		types.NewCollateralBalance("bnb-a", sdk.NewInt64Coin("bnb", 100000)),
+		types.NewCollateralBalance("btc-a", sdk.NewInt64Coin("btc", 100000)),
+	}
+	principal := sdk.NewInt64Coin("usdx", 100000)
+
+	testCases := []struct {
+		name       string
+		cdp        types.MultiCollateralCDP
+		expectPass bool
+	}{
+		{
+			name:       "valid cdp",
+			cdp:        types.NewMultiCollateralCDP(1, owner, collateral, "bnb-a", principal, tmtime.Now(), sdk.OneDec()),
+			expectPass: true,
+		},
+		{
+			name:       "invalid cdp id",
+			cdp:        types.NewMultiCollateralCDP(0, owner, collateral, "bnb-a", principal, tmtime.Now(), sdk.OneDec()),
+			expectPass: false,
+		},
+		{
+			name:       "empty debt type",
+			cdp:        types.NewMultiCollateralCDP(1, owner, collateral, " ", principal, tmtime.Now(), sdk.OneDec()),
+			expectPass: false,
+		},
+		{
+			name:       "no collateral",
+			cdp:        types.NewMultiCollateralCDP(1, owner, types.CollateralBalances{}, "bnb-a", principal, tmtime.Now(), sdk.OneDec()),
+			expectPass: false,
+		},
+		{
+			name:       "duplicate collateral type",
+			cdp:        types.NewMultiCollateralCDP(1, owner, types.CollateralBalances{collateral[0], collateral[0]}, "bnb-a", principal, tmtime.Now(), sdk.OneDec()),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cdp.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	cdps := types.MultiCollateralCDPs{
+		types.NewMultiCollateralCDP(1, owner, collateral, "bnb-a", principal, tmtime.Now(), sdk.OneDec()),
+		types.NewMultiCollateralCDP(2, owner, collateral, "bnb-a", principal, tmtime.Now(), sdk.OneDec()),
+	}
+	require.Error(t, cdps.Validate(), "duplicate owner")
+}
+
+func TestCollateralBalancesAddSub(t *testing.T) {
+	balances := types.CollateralBalances{
+		types.NewCollateralBalance("bnb-a", sdk.NewInt64Coin("bnb", 100)),
+	}
+
+	balances = balances.Add("btc-a", sdk.NewInt64Coin("btc", 50))
+	balances = balances.Add("bnb-a", sdk.NewInt64Coin("bnb", 25))
+	require.Equal(t, types.CollateralBalances{
+		types.NewCollateralBalance("bnb-a", sdk.NewInt64Coin("bnb", 125)),
+		types.NewCollateralBalance("btc-a", sdk.NewInt64Coin("btc", 50)),
+	}, balances)
+
+	balances = balances.Sub("bnb-a", sdk.NewInt64Coin("bnb", 125))
+	require.Equal(t, types.CollateralBalances{
+		types.NewCollateralBalance("btc-a", sdk.NewInt64Coin("btc", 50)),
+	}, balances)
+
+	_, found := balances.AmountOf("bnb-a")
+	require.False(t, found)
+	amount, found := balances.AmountOf("btc-a")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("btc", 50), amount)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -11,6 +12,12 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Auction types that liquidated collateral can be sold with
+const (
+	AuctionTypeCollateral = "collateral"
+	AuctionTypeDutch      = "dutch"
+)
+
 // Parameter keys
 var (
 	KeyGlobalDebtLimit                    = []byte("GlobalDebtLimit")
@@ -22,14 +29,17 @@ var (
 	KeySurplusThreshold                   = []byte("SurplusThreshold")
 	KeySurplusLot                         = []byte("SurplusLot")
 	KeyBeginBlockerExecutionBlockInterval = []byte("BeginBlockerExecutionBlockInterval")
+	KeyTriggerGasLimit                    = []byte("TriggerGasLimit")
 	DefaultGlobalDebt                     = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
 	DefaultCircuitBreaker                 = false
 	DefaultCollateralParams               = CollateralParams{}
 	DefaultDebtParam                      = DebtParam{
-		Denom:            "usdx",
-		ReferenceAsset:   "usd",
-		ConversionFactor: sdkmath.NewInt(6),
-		DebtFloor:        sdkmath.NewInt(10000000),
+		Denom:                        "usdx",
+		ReferenceAsset:               "usd",
+		ConversionFactor:             sdkmath.NewInt(6),
+		DebtFloor:                    sdkmath.NewInt(10000000),
+		SavingsRate:                  sdk.ZeroDec(),
+		SavingsDistributionFrequency: DefaultSavingsDistributionFrequency,
 	}
 	DefaultCdpStartingID    = uint64(1)
 	DefaultDebtDenom        = "debt"
@@ -42,12 +52,15 @@ var (
 	stabilityFeeMax         = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
 	// Run every block
 	DefaultBeginBlockerExecutionBlockInterval = int64(1)
+	DefaultSavingsDistributionFrequency       = time.Hour * 24
+	DefaultTriggerGasLimit                    = uint64(1000000)
 )
 
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
 	surplusLot, debtThreshold, debtLot sdkmath.Int, breaker bool, beginBlockerExecutionBlockInterval int64,
+	triggerGasLimit uint64,
 ) Params {
 	return Params{
 		GlobalDebtLimit:          debtLimit,
@@ -59,6 +72,7 @@ func NewParams(
 		DebtAuctionLot:           debtLot,
 		CircuitBreaker:           breaker,
 		LiquidationBlockInterval: beginBlockerExecutionBlockInterval,
+		TriggerGasLimit:          triggerGasLimit,
 	}
 }
 
@@ -67,7 +81,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
-		DefaultCircuitBreaker, DefaultBeginBlockerExecutionBlockInterval,
+		DefaultCircuitBreaker, DefaultBeginBlockerExecutionBlockInterval, DefaultTriggerGasLimit,
 	)
 }
 
@@ -92,6 +106,16 @@ func NewCollateralParam(
 	}
 }
 
+// PartialLiquidationEnabled returns true if cdps of this collateral type are partially liquidated
+func (cp CollateralParam) PartialLiquidationEnabled() bool {
+	return !cp.CloseFactor.IsNil() && cp.CloseFactor.IsPositive()
+}
+
+// DutchAuctionEnabled returns true if liquidated collateral of this collateral type is sold with dutch auctions
+func (cp CollateralParam) DutchAuctionEnabled() bool {
+	return cp.AuctionType == AuctionTypeDutch
+}
+
 // CollateralParams array of CollateralParam
 type CollateralParams []CollateralParam
 
@@ -105,6 +129,11 @@ func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdkmath.In
 	}
 }
 
+// SavingsRateEnabled returns true if a share of stability fees is distributed to savings depositors
+func (dp DebtParam) SavingsRateEnabled() bool {
+	return !dp.SavingsRate.IsNil() && dp.SavingsRate.IsPositive()
+}
+
 // DebtParams array of DebtParam
 type DebtParams []DebtParam
 
@@ -127,6 +156,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		paramtypes.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
 		paramtypes.NewParamSetPair(KeyBeginBlockerExecutionBlockInterval, &p.LiquidationBlockInterval, validateBeginBlockerExecutionBlockIntervalParam),
+		paramtypes.NewParamSetPair(KeyTriggerGasLimit, &p.TriggerGasLimit, validateTriggerGasLimitParam),
 	}
 }
 
@@ -152,6 +182,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateTriggerGasLimitParam(p.TriggerGasLimit); err != nil {
+		return err
+	}
+
 	if err := validateSurplusAuctionThresholdParam(p.SurplusAuctionThreshold); err != nil {
 		return err
 	}
@@ -278,6 +312,20 @@ func validateCollateralParams(i interface{}) error {
 		if cp.CheckCollateralizationIndexCount.IsNegative() {
 			return fmt.Errorf("keeper reward percentage should be positive, is %s for %s", cp.CheckCollateralizationIndexCount, cp.Denom)
 		}
+		if !cp.CloseFactor.IsNil() && (cp.CloseFactor.IsNegative() || cp.CloseFactor.GT(sdk.OneDec())) {
+			return fmt.Errorf("close factor should be between 0 and 1, is %s for %s", cp.CloseFactor, cp.Denom)
+		}
+		if cp.AuctionType != "" && cp.AuctionType != AuctionTypeCollateral && cp.AuctionType != AuctionTypeDutch {
+			return fmt.Errorf("invalid auction type %s for %s", cp.AuctionType, cp.Denom)
+		}
+		if cp.PartialLiquidationEnabled() {
+			if cp.LiquidationTargetRatio.IsNil() || cp.LiquidationTargetRatio.LTE(cp.LiquidationRatio) {
+				return fmt.Errorf("liquidation target ratio must be greater than liquidation ratio %s for %s", cp.LiquidationRatio, cp.Denom)
+			}
+			if cp.LiquidationTargetRatio.LTE(sdk.OneDec().Add(cp.LiquidationPenalty)) {
+				return fmt.Errorf("liquidation target ratio must be greater than 1 + liquidation penalty, is %s for %s", cp.LiquidationTargetRatio, cp.Denom)
+			}
+		}
 	}
 
 	return nil
@@ -291,6 +339,12 @@ func validateDebtParam(i interface{}) error {
 	if err := sdk.ValidateDenom(debtParam.Denom); err != nil {
 		return fmt.Errorf("debt denom invalid %s", debtParam.Denom)
 	}
+	if !debtParam.SavingsRate.IsNil() && (debtParam.SavingsRate.IsNegative() || debtParam.SavingsRate.GT(sdk.OneDec())) {
+		return fmt.Errorf("savings rate should be between 0 and 1, is %s", debtParam.SavingsRate)
+	}
+	if debtParam.SavingsDistributionFrequency < 0 {
+		return fmt.Errorf("savings distribution frequency should not be negative, is %s", debtParam.SavingsDistributionFrequency)
+	}
 
 	return nil
 }
@@ -368,3 +422,12 @@ func validateBeginBlockerExecutionBlockIntervalParam(i interface{}) error {
 
 	return nil
 }
+
+func validateTriggerGasLimitParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
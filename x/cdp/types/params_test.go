@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -692,6 +693,145 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "liquidation ratio must be > 0",
 			},
 		},
+		{
+			name: "valid collateral params partial liquidation",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						CloseFactor:                      sdk.MustNewDecFromStr("0.5"),
+						LiquidationTargetRatio:           sdk.MustNewDecFromStr("2.0"),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral params close factor out of range",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						CloseFactor:                      sdk.MustNewDecFromStr("1.5"),
+						LiquidationTargetRatio:           sdk.MustNewDecFromStr("2.0"),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "close factor should be between 0 and 1",
+			},
+		},
+		{
+			name: "invalid collateral params liquidation target ratio below liquidation ratio",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						CloseFactor:                      sdk.MustNewDecFromStr("0.5"),
+						LiquidationTargetRatio:           sdk.MustNewDecFromStr("1.4"),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "liquidation target ratio must be greater than liquidation ratio",
+			},
+		},
+		{
+			name: "invalid collateral params auction type",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						AuctionType:                      "english",
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid auction type",
+			},
+		},
 		{
 			name: "invalid debt param empty denom",
 			args: args{
@@ -730,6 +870,126 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "debt denom invalid",
 			},
 		},
+		{
+			name: "valid debt param savings rate",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:                        "usdx",
+					ReferenceAsset:               "usd",
+					ConversionFactor:             sdkmath.NewInt(6),
+					DebtFloor:                    sdkmath.NewInt(10000000),
+					SavingsRate:                  sdk.MustNewDecFromStr("0.5"),
+					SavingsDistributionFrequency: time.Hour,
+				},
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid debt param savings rate",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:                        "usdx",
+					ReferenceAsset:               "usd",
+					ConversionFactor:             sdkmath.NewInt(6),
+					DebtFloor:                    sdkmath.NewInt(10000000),
+					SavingsRate:                  sdk.MustNewDecFromStr("1.1"),
+					SavingsDistributionFrequency: time.Hour,
+				},
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "savings rate should be between 0 and 1",
+			},
+		},
+		{
+			name: "invalid debt param negative savings distribution frequency",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50000000000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:                        "usdx",
+					ReferenceAsset:               "usd",
+					ConversionFactor:             sdkmath.NewInt(6),
+					DebtFloor:                    sdkmath.NewInt(10000000),
+					SavingsRate:                  sdk.MustNewDecFromStr("0.5"),
+					SavingsDistributionFrequency: -time.Hour,
+				},
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "savings distribution frequency should not be negative",
+			},
+		},
 		{
 			name: "nil debt limit",
 			args: args{
@@ -859,7 +1119,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.beginBlockerExecutionBlockInterval)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.beginBlockerExecutionBlockInterval, types.DefaultTriggerGasLimit)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
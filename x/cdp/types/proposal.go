@@ -0,0 +1,67 @@
+package types
+
+import (
+	fmt "fmt"
+	"strings"
+
+	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeGlobalSettlement defines the type for a GlobalSettlementProposal
+	ProposalTypeGlobalSettlement = "GlobalSettlement"
+)
+
+// Assert GlobalSettlementProposal implements govtypes.Content at compile-time
+var _ govv1beta1.Content = &GlobalSettlementProposal{}
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeGlobalSettlement)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&GlobalSettlementProposal{}, "kava/GlobalSettlementProposal", nil)
+}
+
+// NewGlobalSettlementProposal creates a new global settlement proposal.
+func NewGlobalSettlementProposal(title, description string, finalPrices SettlementPrices) *GlobalSettlementProposal {
+	return &GlobalSettlementProposal{
+		Title:       title,
+		Description: description,
+		FinalPrices: finalPrices,
+	}
+}
+
+// GetTitle returns the title of a global settlement proposal.
+func (p *GlobalSettlementProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a global settlement proposal.
+func (p *GlobalSettlementProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a global settlement proposal.
+func (p *GlobalSettlementProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a global settlement proposal.
+func (p *GlobalSettlementProposal) ProposalType() string {
+	return ProposalTypeGlobalSettlement
+}
+
+// String implements fmt.Stringer
+func (p *GlobalSettlementProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Global Settlement Proposal:
+  Title:        %s
+  Description:  %s
+  Final Prices:
+`, p.Title, p.Description))
+	for _, sp := range p.FinalPrices {
+		b.WriteString(fmt.Sprintf("    %s: %s\n", sp.MarketID, sp.Price))
+	}
+	return b.String()
+}
+
+// ValidateBasic stateless validation of a global settlement proposal.
+func (p *GlobalSettlementProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.FinalPrices.Validate()
+}
@@ -0,0 +1,741 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/cdp/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GlobalSettlementProposal shuts down the cdp system, settling every cdp at a final price
+type GlobalSettlementProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// final_prices override the current price of markets, such as when a market no longer has a valid price
+	FinalPrices SettlementPrices `protobuf:"bytes,3,rep,name=final_prices,json=finalPrices,proto3,castrepeated=SettlementPrices" json:"final_prices"`
+}
+
+func (m *GlobalSettlementProposal) Reset()      { *m = GlobalSettlementProposal{} }
+func (*GlobalSettlementProposal) ProtoMessage() {}
+func (*GlobalSettlementProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d8a89a8bf45ef45, []int{0}
+}
+func (m *GlobalSettlementProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalSettlementProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalSettlementProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalSettlementProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalSettlementProposal.Merge(m, src)
+}
+func (m *GlobalSettlementProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalSettlementProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalSettlementProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalSettlementProposal proto.InternalMessageInfo
+
+// GlobalSettlementProposalJSON defines a GlobalSettlementProposal with a deposit
+type GlobalSettlementProposalJSON struct {
+	Title       string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	FinalPrices SettlementPrices                         `protobuf:"bytes,3,rep,name=final_prices,json=finalPrices,proto3,castrepeated=SettlementPrices" json:"final_prices"`
+	Deposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *GlobalSettlementProposalJSON) Reset()         { *m = GlobalSettlementProposalJSON{} }
+func (m *GlobalSettlementProposalJSON) String() string { return proto.CompactTextString(m) }
+func (*GlobalSettlementProposalJSON) ProtoMessage()    {}
+func (*GlobalSettlementProposalJSON) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d8a89a8bf45ef45, []int{1}
+}
+func (m *GlobalSettlementProposalJSON) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalSettlementProposalJSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalSettlementProposalJSON.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalSettlementProposalJSON) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalSettlementProposalJSON.Merge(m, src)
+}
+func (m *GlobalSettlementProposalJSON) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalSettlementProposalJSON) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalSettlementProposalJSON.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalSettlementProposalJSON proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GlobalSettlementProposal)(nil), "kava.cdp.v1beta1.GlobalSettlementProposal")
+	proto.RegisterType((*GlobalSettlementProposalJSON)(nil), "kava.cdp.v1beta1.GlobalSettlementProposalJSON")
+}
+
+func init() { proto.RegisterFile("kava/cdp/v1beta1/proposal.proto", fileDescriptor_6d8a89a8bf45ef45) }
+
+var fileDescriptor_6d8a89a8bf45ef45 = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xbd, 0x4e, 0xfb, 0x30,
+	0x10, 0xc0, 0x93, 0xf6, 0xff, 0xe7, 0x23, 0x65, 0xa8, 0xa2, 0x0e, 0xa1, 0x42, 0x49, 0xa9, 0x84,
+	0xd4, 0xa5, 0x36, 0x85, 0x8d, 0x05, 0xa9, 0x0c, 0x48, 0x0c, 0x80, 0xda, 0x8d, 0x01, 0xe4, 0x38,
+	0xa6, 0x58, 0x75, 0x73, 0x56, 0x6c, 0x2a, 0x78, 0x03, 0x46, 0x46, 0xc6, 0x6e, 0x48, 0x3c, 0x03,
+	0x0f, 0xd0, 0xb1, 0x23, 0x13, 0xa0, 0xf6, 0x45, 0x50, 0x9c, 0x50, 0x55, 0x45, 0xec, 0x4c, 0xbe,
+	0xcf, 0xdf, 0xdd, 0xf9, 0xce, 0x09, 0xfa, 0x64, 0x48, 0x30, 0x8d, 0x24, 0x1e, 0xb6, 0x42, 0xa6,
+	0x49, 0x0b, 0xcb, 0x04, 0x24, 0x28, 0x22, 0x90, 0x4c, 0x40, 0x83, 0x5b, 0x4e, 0x03, 0x10, 0x8d,
+	0x24, 0xca, 0x03, 0xaa, 0x3e, 0x05, 0x35, 0x00, 0x85, 0x43, 0xa2, 0xd8, 0x3c, 0x8b, 0x02, 0x8f,
+	0xb3, 0x8c, 0x6a, 0xa5, 0x07, 0x3d, 0x30, 0x22, 0x4e, 0xa5, 0xdc, 0x5a, 0xfd, 0x51, 0x28, 0x65,
+	0x1a, 0x5f, 0xfd, 0xd5, 0x76, 0xbc, 0x63, 0x01, 0x21, 0x11, 0x5d, 0xa6, 0xb5, 0x60, 0x03, 0x16,
+	0xeb, 0xf3, 0xbc, 0x0d, 0xb7, 0xe2, 0xfc, 0xd7, 0x5c, 0x0b, 0xe6, 0xd9, 0x35, 0xbb, 0xb1, 0xde,
+	0xc9, 0x14, 0xb7, 0xe6, 0x94, 0x22, 0xa6, 0x68, 0xc2, 0xa5, 0xe6, 0x10, 0x7b, 0x05, 0xe3, 0x5b,
+	0x34, 0xb9, 0x97, 0xce, 0xc6, 0x35, 0x8f, 0x89, 0xb8, 0x92, 0x09, 0xa7, 0x4c, 0x79, 0xc5, 0x5a,
+	0xb1, 0x51, 0xda, 0xdb, 0x46, 0xcb, 0xf3, 0xa0, 0xc5, 0x9a, 0x9c, 0xb2, 0xb6, 0x37, 0x7e, 0x0f,
+	0xac, 0x97, 0x8f, 0xa0, 0xbc, 0xe4, 0x50, 0x9d, 0x92, 0x01, 0x66, 0xca, 0xc1, 0xda, 0xc3, 0x28,
+	0xb0, 0x9e, 0x46, 0x81, 0x55, 0x7f, 0x2e, 0x38, 0x5b, 0xbf, 0xb5, 0x7f, 0xd2, 0x3d, 0x3b, 0xfd,
+	0xab, 0x23, 0xb8, 0xcc, 0x59, 0x8d, 0x98, 0x04, 0xc5, 0xb5, 0xf7, 0xcf, 0xa0, 0x37, 0x51, 0xb6,
+	0x5b, 0x94, 0xee, 0x76, 0x4e, 0x3f, 0x02, 0x1e, 0xb7, 0x77, 0x73, 0x64, 0xa3, 0xc7, 0xf5, 0xcd,
+	0x6d, 0x88, 0x28, 0x0c, 0x70, 0x7e, 0x08, 0xd9, 0xd3, 0x54, 0x51, 0x1f, 0xeb, 0x7b, 0xc9, 0x94,
+	0x49, 0x50, 0x9d, 0x6f, 0xf6, 0xfc, 0xa7, 0xec, 0xf6, 0xe1, 0x78, 0xea, 0xdb, 0x93, 0xa9, 0x6f,
+	0x7f, 0x4e, 0x7d, 0xfb, 0x71, 0xe6, 0x5b, 0x93, 0x99, 0x6f, 0xbd, 0xcd, 0x7c, 0xeb, 0x62, 0x67,
+	0x01, 0x9b, 0x8e, 0xd7, 0x14, 0x24, 0x54, 0x46, 0xc2, 0x77, 0xe6, 0x6a, 0x0c, 0x39, 0x5c, 0x31,
+	0x07, 0xb3, 0xff, 0x35, 0x00, 0xe8, 0x47, 0x5f, 0x04, 0xb7, 0x02, 0x00, 0x00,
+}
+
+func (m *GlobalSettlementProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSettlementProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSettlementProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalPrices) > 0 {
+		for iNdEx := len(m.FinalPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalSettlementProposalJSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSettlementProposalJSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSettlementProposalJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FinalPrices) > 0 {
+		for iNdEx := len(m.FinalPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GlobalSettlementProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.FinalPrices) > 0 {
+		for _, e := range m.FinalPrices {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GlobalSettlementProposalJSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.FinalPrices) > 0 {
+		for _, e := range m.FinalPrices {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GlobalSettlementProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSettlementProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSettlementProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalPrices = append(m.FinalPrices, SettlementPrice{})
+			if err := m.FinalPrices[len(m.FinalPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalSettlementProposalJSON) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSettlementProposalJSON: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSettlementProposalJSON: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalPrices = append(m.FinalPrices, SettlementPrice{})
+			if err := m.FinalPrices[len(m.FinalPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGlobalSettlement returns a new GlobalSettlement that is in progress
+func NewGlobalSettlement(settlementTime time.Time, finalPrices SettlementPrices, redemptionPool sdk.Coins, outstandingDebt sdkmath.Int) GlobalSettlement {
+	return GlobalSettlement{
+		SettlementTime:  settlementTime,
+		FinalPrices:     finalPrices,
+		RedemptionPool:  redemptionPool,
+		OutstandingDebt: outstandingDebt,
+	}
+}
+
+// Validate performs a basic validation of the GlobalSettlement fields.
+func (gs GlobalSettlement) Validate() error {
+	if gs.SettlementTime.Unix() <= 0 {
+		return errors.New("settlement time cannot be zero")
+	}
+	if err := gs.FinalPrices.Validate(); err != nil {
+		return err
+	}
+	if !gs.RedemptionPool.IsValid() {
+		return fmt.Errorf("invalid redemption pool: %s", gs.RedemptionPool)
+	}
+	if gs.OutstandingDebt.IsNil() || gs.OutstandingDebt.IsNegative() {
+		return fmt.Errorf("outstanding debt should not be negative, is %s", gs.OutstandingDebt)
+	}
+	return nil
+}
+
+// CalculateRedemption returns the share of the redemption pool that is paid out for redeeming the input amount of
+// outstanding debt. Payouts round down so the pool can always pay the remaining outstanding debt.
+func (gs GlobalSettlement) CalculateRedemption(amount sdkmath.Int) sdk.Coins {
+	if !gs.OutstandingDebt.IsPositive() {
+		return sdk.NewCoins()
+	}
+	payout := sdk.NewCoins()
+	for _, coin := range gs.RedemptionPool {
+		share := coin.Amount.Mul(amount).Quo(gs.OutstandingDebt)
+		payout = payout.Add(sdk.NewCoin(coin.Denom, share))
+	}
+	return payout
+}
+
+// NewSettlementPrice returns a new SettlementPrice
+func NewSettlementPrice(marketID string, price sdk.Dec) SettlementPrice {
+	return SettlementPrice{
+		MarketID: marketID,
+		Price:    price,
+	}
+}
+
+// Validate performs a basic validation of the SettlementPrice fields.
+func (sp SettlementPrice) Validate() error {
+	if strings.TrimSpace(sp.MarketID) == "" {
+		return errors.New("settlement price market id cannot be empty")
+	}
+	if sp.Price.IsNil() || !sp.Price.IsPositive() {
+		return fmt.Errorf("settlement price should be positive, is %s for %s", sp.Price, sp.MarketID)
+	}
+	return nil
+}
+
+// SettlementPrices a collection of SettlementPrice objects
+type SettlementPrices []SettlementPrice
+
+// Validate validates each SettlementPrice and checks that market ids are unique
+func (sps SettlementPrices) Validate() error {
+	markets := make(map[string]bool)
+	for _, sp := range sps {
+		if err := sp.Validate(); err != nil {
+			return err
+		}
+		if markets[sp.MarketID] {
+			return fmt.Errorf("duplicate settlement price for market %s", sp.MarketID)
+		}
+		markets[sp.MarketID] = true
+	}
+	return nil
+}
+
+// Get returns the settlement price of a market
+func (sps SettlementPrices) Get(marketID string) (sdk.Dec, bool) {
+	for _, sp := range sps {
+		if sp.MarketID == marketID {
+			return sp.Price, true
+		}
+	}
+	return sdk.Dec{}, false
+}
@@ -0,0 +1,160 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+func TestGlobalSettlementValidation(t *testing.T) {
+	prices := types.SettlementPrices{
+		types.NewSettlementPrice("bnb:usd", sdk.MustNewDecFromStr("17.25")),
+		types.NewSettlementPrice("btc:usd", sdk.MustNewDecFromStr("8700.0")),
+	}
+	pool := sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000), sdk.NewInt64Coin("btc", 10))
+
+	testCases := []struct {
+		name       string
+		settlement types.GlobalSettlement
+		expectPass bool
+	}{
+		{
+			name:       "valid settlement",
+			settlement: types.NewGlobalSettlement(tmtime.Now(), prices, pool, sdkmath.NewInt(5000)),
+			expectPass: true,
+		},
+		{
+			name:       "zero settlement time",
+			settlement: types.NewGlobalSettlement(time.Time{}, prices, pool, sdkmath.NewInt(5000)),
+			expectPass: false,
+		},
+		{
+			name: "duplicate final price",
+			settlement: types.NewGlobalSettlement(tmtime.Now(), append(prices,
+				types.NewSettlementPrice("bnb:usd", sdk.MustNewDecFromStr("18.0"))), pool, sdkmath.NewInt(5000)),
+			expectPass: false,
+		},
+		{
+			name: "non-positive final price",
+			settlement: types.NewGlobalSettlement(tmtime.Now(), types.SettlementPrices{
+				types.NewSettlementPrice("bnb:usd", sdk.ZeroDec())}, pool, sdkmath.NewInt(5000)),
+			expectPass: false,
+		},
+		{
+			name: "empty market id",
+			settlement: types.NewGlobalSettlement(tmtime.Now(), types.SettlementPrices{
+				types.NewSettlementPrice(" ", sdk.OneDec())}, pool, sdkmath.NewInt(5000)),
+			expectPass: false,
+		},
+		{
+			name:       "negative outstanding debt",
+			settlement: types.NewGlobalSettlement(tmtime.Now(), prices, pool, sdkmath.NewInt(-1)),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.settlement.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGlobalSettlementCalculateRedemption(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000), sdk.NewInt64Coin("btc", 7))
+
+	testCases := []struct {
+		name        string
+		outstanding sdkmath.Int
+		amount      sdkmath.Int
+		expected    sdk.Coins
+	}{
+		{
+			name:        "pro-rata share",
+			outstanding: sdkmath.NewInt(500),
+			amount:      sdkmath.NewInt(100),
+			expected:    sdk.NewCoins(sdk.NewInt64Coin("bnb", 200), sdk.NewInt64Coin("btc", 1)),
+		},
+		{
+			name:        "all outstanding debt",
+			outstanding: sdkmath.NewInt(500),
+			amount:      sdkmath.NewInt(500),
+			expected:    pool,
+		},
+		{
+			name:        "shares round down",
+			outstanding: sdkmath.NewInt(3000),
+			amount:      sdkmath.NewInt(1),
+			expected:    sdk.NewCoins(),
+		},
+		{
+			name:        "no outstanding debt",
+			outstanding: sdk.ZeroInt(),
+			amount:      sdkmath.NewInt(100),
+			expected:    sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			settlement := types.NewGlobalSettlement(tmtime.Now(), types.SettlementPrices{}, pool, tc.outstanding)
+			require.Equal(t, tc.expected, settlement.CalculateRedemption(tc.amount))
+		})
+	}
+}
+
+func TestGlobalSettlementProposalValidateBasic(t *testing.T) {
+	prices := types.SettlementPrices{types.NewSettlementPrice("bnb:usd", sdk.MustNewDecFromStr("17.25"))}
+
+	testCases := []struct {
+		name       string
+		proposal   *types.GlobalSettlementProposal
+		expectPass bool
+	}{
+		{
+			name:       "valid proposal",
+			proposal:   types.NewGlobalSettlementProposal("title", "description", prices),
+			expectPass: true,
+		},
+		{
+			name:       "valid proposal without final prices",
+			proposal:   types.NewGlobalSettlementProposal("title", "description", types.SettlementPrices{}),
+			expectPass: true,
+		},
+		{
+			name:       "empty title",
+			proposal:   types.NewGlobalSettlementProposal("", "description", prices),
+			expectPass: false,
+		},
+		{
+			name: "invalid final price",
+			proposal: types.NewGlobalSettlementProposal("title", "description", types.SettlementPrices{
+				types.NewSettlementPrice("bnb:usd", sdk.MustNewDecFromStr("-1"))}),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCdpTrigger returns a new CdpTrigger
+func NewCdpTrigger(
+	cdpID uint64, owner sdk.AccAddress, collateralType string, action CdpTriggerAction, triggerRatio, targetRatio sdk.Dec,
+	maxAmount sdk.Coin,
+) CdpTrigger {
+	return CdpTrigger{
+		CdpID:          cdpID,
+		Owner:          owner,
+		CollateralType: collateralType,
+		Action:         action,
+		TriggerRatio:   triggerRatio,
+		TargetRatio:    targetRatio,
+		MaxAmount:      maxAmount,
+	}
+}
+
+// Validate performs a basic validation of the CdpTrigger fields.
+func (t CdpTrigger) Validate() error {
+	if t.CdpID == 0 {
+		return errors.New("cdp trigger cdp id cannot be 0")
+	}
+	if t.Owner.Empty() {
+		return errors.New("cdp trigger owner cannot be empty")
+	}
+	if strings.TrimSpace(t.CollateralType) == "" {
+		return errors.New("cdp trigger collateral type cannot be blank")
+	}
+	return ValidateCdpTrigger(t.Action, t.TriggerRatio, t.TargetRatio, t.MaxAmount)
+}
+
+// Triggered returns true if the trigger runs for a cdp with the input collateralization ratio
+func (t CdpTrigger) Triggered(collateralRatio sdk.Dec) bool {
+	return collateralRatio.LT(t.TriggerRatio)
+}
+
+// CdpTriggers a collection of CdpTrigger objects
+type CdpTriggers []CdpTrigger
+
+// Validate validates each CdpTrigger and checks that each cdp has at most one trigger per action
+func (ts CdpTriggers) Validate() error {
+	seen := make(map[string]bool)
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d:%s", t.CdpID, t.Action)
+		if seen[key] {
+			return fmt.Errorf("duplicate cdp trigger %s for cdp %d", t.Action, t.CdpID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// ValidateCdpTrigger validates the action, ratios and maximum amount of a cdp trigger
+func ValidateCdpTrigger(action CdpTriggerAction, triggerRatio, targetRatio sdk.Dec, maxAmount sdk.Coin) error {
+	if err := ValidateCdpTriggerAction(action); err != nil {
+		return err
+	}
+	if triggerRatio.IsNil() || !triggerRatio.IsPositive() {
+		return fmt.Errorf("cdp trigger ratio must be positive, is %s", triggerRatio)
+	}
+	if targetRatio.IsNil() || targetRatio.LTE(triggerRatio) {
+		return fmt.Errorf("cdp trigger target ratio %s must be greater than trigger ratio %s", targetRatio, triggerRatio)
+	}
+	if !maxAmount.IsValid() || !maxAmount.IsPositive() {
+		return fmt.Errorf("cdp trigger max amount must be positive, is %s", maxAmount)
+	}
+	return nil
+}
+
+// ValidateCdpTriggerAction returns an error if the action is not a valid trigger action
+func ValidateCdpTriggerAction(action CdpTriggerAction) error {
+	switch action {
+	case CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS, CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET:
+		return nil
+	default:
+		return fmt.Errorf("invalid cdp trigger action %s", action)
+	}
+}
+
+// ParseCdpTriggerAction returns the action matching the input string, one of repay-from-savings or deposit-from-wallet
+func ParseCdpTriggerAction(action string) (CdpTriggerAction, error) {
+	switch strings.ToLower(strings.TrimSpace(action)) {
+	case "repay-from-savings":
+		return CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS, nil
+	case "deposit-from-wallet":
+		return CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, nil
+	default:
+		return CDP_TRIGGER_ACTION_UNSPECIFIED, fmt.Errorf("invalid cdp trigger action %s, must be one of repay-from-savings or deposit-from-wallet", action)
+	}
+}
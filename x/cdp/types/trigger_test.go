@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+func TestCdpTriggerValidation(t *testing.T) {
+	owner := sdk.AccAddress("owner1")
+	repay := types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS
+	deposit := types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET
+	maxAmount := sdk.NewInt64Coin("usdx", 1000)
+	valid := types.NewCdpTrigger(1, owner, "xrp-a", repay, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), maxAmount)
+
+	testCases := []struct {
+		name       string
+		triggers   types.CdpTriggers
+		expectPass bool
+	}{
+		{"valid", types.CdpTriggers{valid}, true},
+		{
+			"valid multiple actions",
+			types.CdpTriggers{valid, types.NewCdpTrigger(1, owner, "xrp-a", deposit, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), sdk.NewInt64Coin("xrp", 1000))},
+			true,
+		},
+		{"zero cdp id", types.CdpTriggers{types.NewCdpTrigger(0, owner, "xrp-a", repay, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), maxAmount)}, false},
+		{"empty owner", types.CdpTriggers{types.NewCdpTrigger(1, nil, "xrp-a", repay, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), maxAmount)}, false},
+		{"blank collateral type", types.CdpTriggers{types.NewCdpTrigger(1, owner, " ", repay, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), maxAmount)}, false},
+		{"unspecified action", types.CdpTriggers{types.NewCdpTrigger(1, owner, "xrp-a", types.CDP_TRIGGER_ACTION_UNSPECIFIED, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), maxAmount)}, false},
+		{"zero trigger ratio", types.CdpTriggers{types.NewCdpTrigger(1, owner, "xrp-a", repay, sdk.ZeroDec(), sdk.MustNewDecFromStr("2.0"), maxAmount)}, false},
+		{"target ratio equal to trigger ratio", types.CdpTriggers{types.NewCdpTrigger(1, owner, "xrp-a", repay, sdk.MustNewDecFromStr("2.0"), sdk.MustNewDecFromStr("2.0"), maxAmount)}, false},
+		{"zero max amount", types.CdpTriggers{types.NewCdpTrigger(1, owner, "xrp-a", repay, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), sdk.NewInt64Coin("usdx", 0))}, false},
+		{"duplicate action", types.CdpTriggers{valid, valid}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.triggers.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCdpTriggerTriggered(t *testing.T) {
+	trigger := types.NewCdpTrigger(1, sdk.AccAddress("owner1"), "xrp-a", types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS,
+		sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2.0"), sdk.NewInt64Coin("usdx", 1000))
+
+	require.True(t, trigger.Triggered(sdk.MustNewDecFromStr("1.59")))
+	require.False(t, trigger.Triggered(sdk.MustNewDecFromStr("1.6")))
+	require.False(t, trigger.Triggered(sdk.MustNewDecFromStr("2.5")))
+}
+
+func TestParseCdpTriggerAction(t *testing.T) {
+	action, err := types.ParseCdpTriggerAction("repay-from-savings")
+	require.NoError(t, err)
+	require.Equal(t, types.CDP_TRIGGER_ACTION_REPAY_FROM_SAVINGS, action)
+
+	action, err = types.ParseCdpTriggerAction("Deposit-From-Wallet")
+	require.NoError(t, err)
+	require.Equal(t, types.CDP_TRIGGER_ACTION_DEPOSIT_FROM_WALLET, action)
+
+	_, err = types.ParseCdpTriggerAction("withdraw")
+	require.Error(t, err)
+}
@@ -17,4 +17,9 @@ func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.ProcessProposals(ctx)
+
+	if err := k.ReleaseVoteLocks(ctx); err != nil {
+		panic(err)
+	}
+	k.PruneVetoedProposals(ctx)
 }
@@ -24,6 +24,11 @@ import (
 	"github.com/kava-labs/kava/x/committee/types"
 )
 
+const (
+	flagConviction = "conviction"
+	flagLockAmount = "lock-amount"
+)
+
 const PARAMS_CHANGE_PROPOSAL_EXAMPLE = `
 {
 	"@type": "/cosmos.params.v1beta1.ParameterChangeProposal",
@@ -74,6 +79,7 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdVote(),
 		getCmdSubmitProposal(),
+		getCmdCancelProposal(),
 	}
 
 	for _, cmd := range cmds {
@@ -146,12 +152,21 @@ For example:
 
 // getCmdVote returns the command to vote on a proposal.
 func getCmdVote() *cobra.Command {
-	return &cobra.Command{
-		Use:     "vote [proposal-id] [vote]",
-		Args:    cobra.ExactArgs(2),
-		Short:   "Vote for an active proposal",
-		Long:    "Submit a [yes/no/abstain] vote for the proposal with id [proposal-id].",
-		Example: fmt.Sprintf("%s tx %s vote 2 yes", version.AppName, types.ModuleName),
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [vote]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal",
+		Long: fmt.Sprintf(`Submit a [yes/no/abstain/no_with_veto] vote for the proposal with id [proposal-id].
+
+No with veto votes count as no votes, and veto the proposal if they exceed the veto threshold of votes cast.
+Vetoed proposals have their deposit burned and cannot be resubmitted to the committee until its veto cooldown ends.
+
+Votes on committees with conviction voting must lock --%s of the committee's tally denom. Tokens are locked until
+the proposal deadline plus the committee's lock period doubled for each --%s level above 1, and the vote weight
+is the locked tokens multiplied by the conviction (0 to %d). Votes with conviction 0 are weighted at 0.1x and
+locked until the proposal deadline.`, flagLockAmount, flagConviction, types.MaxConviction),
+		Example: fmt.Sprintf(`%[1]s tx %[2]s vote 2 yes
+%[1]s tx %[2]s vote 2 yes --%[3]s 1000000 --%[4]s 3`, version.AppName, types.ModuleName, flagLockAmount, flagConviction),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -180,12 +195,58 @@ func getCmdVote() *cobra.Command {
 				vote = types.VOTE_TYPE_NO
 			case "abstain", "a":
 				vote = types.VOTE_TYPE_ABSTAIN
+			case "no_with_veto", "veto", "nwv":
+				vote = types.VOTE_TYPE_NO_WITH_VETO
 			default:
-				return fmt.Errorf("must specify a valid vote type: (yes/y, no/n, abstain/a)")
+				return fmt.Errorf("must specify a valid vote type: (yes/y, no/n, abstain/a, no_with_veto/veto/nwv)")
+			}
+
+			conviction, err := cmd.Flags().GetUint32(flagConviction)
+			if err != nil {
+				return err
+			}
+			lockAmount, ok := sdk.NewIntFromString(cmd.Flag(flagLockAmount).Value.String())
+			if !ok {
+				return fmt.Errorf("lock amount %s not a valid int", cmd.Flag(flagLockAmount).Value.String())
 			}
 
 			// Build vote message and run basic validation
-			msg := types.NewMsgVote(from, proposalID, vote)
+			msg := types.NewMsgConvictionVote(from, proposalID, vote, types.Conviction(conviction), lockAmount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint32(flagConviction, 0, "conviction to lock tokens with, for committees with conviction voting")
+	cmd.Flags().String(flagLockAmount, "0", "amount of tally denom to lock, for committees with conviction voting")
+	return cmd
+}
+
+// getCmdCancelProposal returns the command to cancel a proposal before it is voted on.
+func getCmdCancelProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:     "cancel-proposal [proposal-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Cancel a proposal before it is voted on",
+		Long:    "Cancel a proposal you submitted, refunding its deposit. Proposals can only be cancelled before any votes are cast.",
+		Example: fmt.Sprintf("%s tx %s cancel-proposal 2", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(clientCtx.GetFromAddress(), proposalID)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -26,6 +26,12 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs *types.GenesisState)
 	for _, v := range gs.Votes {
 		keeper.SetVote(ctx, v)
 	}
+	for _, l := range gs.VoteLocks {
+		keeper.SetVoteLock(ctx, l)
+	}
+	for _, vp := range gs.VetoedProposals {
+		keeper.SetVetoedProposal(ctx, vp)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -37,11 +43,15 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	committees := keeper.GetCommittees(ctx)
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
+	voteLocks := keeper.GetVoteLocks(ctx)
+	vetoedProposals := keeper.GetVetoedProposals(ctx)
 
 	return types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
+		voteLocks,
+		vetoedProposals,
 	)
 }
@@ -74,6 +74,8 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{memberCom},
 				[]types.Proposal{},
 				[]types.Vote{},
+				types.VoteLocks{},
+				types.VetoedProposals{},
 			),
 			expectPass: true,
 		},
@@ -84,6 +86,8 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{tokenCom},
 				[]types.Proposal{},
 				[]types.Vote{},
+				types.VoteLocks{},
+				types.VetoedProposals{},
 			),
 			expectPass: true,
 		},
@@ -94,6 +98,8 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{memberCom, memberCom},
 				[]types.Proposal{},
 				[]types.Vote{},
+				types.VoteLocks{},
+				types.VetoedProposals{},
 			),
 			expectPass: false,
 		},
@@ -104,6 +110,8 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{},
 				[]types.Proposal{{ID: 1, CommitteeID: 57}},
 				[]types.Vote{},
+				types.VoteLocks{},
+				types.VetoedProposals{},
 			),
 			expectPass: false,
 		},
@@ -114,6 +122,8 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{},
 				[]types.Proposal{},
 				[]types.Vote{{Voter: suite.addresses[0], ProposalID: 1, VoteType: types.VOTE_TYPE_YES}},
+				types.VoteLocks{},
+				types.VetoedProposals{},
 			),
 			expectPass: false,
 		},
@@ -124,6 +134,8 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{memberCom},
 				[]types.Proposal{{ID: 3, CommitteeID: 1}, {ID: 4, CommitteeID: 1}},
 				[]types.Vote{},
+				types.VoteLocks{},
+				types.VetoedProposals{},
 			),
 			expectPass: false,
 		},
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// lockVoteTokens moves tokens from a voter into the committee module account until the unlock time. Locks of the same
+// voter, proposal and unlock time are combined.
+func (k Keeper) lockVoteTokens(ctx sdk.Context, lock types.VoteLock) error {
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, lock.Voter, types.ModuleName, sdk.NewCoins(lock.Amount))
+	if err != nil {
+		return err
+	}
+	if existingLock, found := k.GetVoteLock(ctx, lock.UnlockTime, lock.ProposalID, lock.Voter); found {
+		lock.Amount = lock.Amount.Add(existingLock.Amount)
+	}
+	k.SetVoteLock(ctx, lock)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteLock,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", lock.ProposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, lock.Voter.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, lock.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockTime, lock.UnlockTime.String()),
+		),
+	)
+	return nil
+}
+
+// relockVoteTokens moves the tokens locked by a voter's prior vote on a proposal to the unlock time of their new vote,
+// and returns the amount locked by the prior vote so it can be added to the new vote.
+func (k Keeper) relockVoteTokens(ctx sdk.Context, proposal types.Proposal, prior types.Vote, lockPeriod time.Duration,
+	unlockTime time.Time,
+) sdkmath.Int {
+	if prior.LockedAmount.IsNil() || !prior.LockedAmount.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+	priorUnlockTime := proposal.Deadline.Add(prior.Conviction.LockDuration(lockPeriod))
+	if priorUnlockTime.Equal(unlockTime) {
+		return prior.LockedAmount
+	}
+	lock, found := k.GetVoteLock(ctx, priorUnlockTime, proposal.ID, prior.Voter)
+	if !found {
+		return prior.LockedAmount
+	}
+	k.DeleteVoteLock(ctx, lock)
+	lock.UnlockTime = unlockTime
+	if existingLock, found := k.GetVoteLock(ctx, unlockTime, proposal.ID, prior.Voter); found {
+		lock.Amount = lock.Amount.Add(existingLock.Amount)
+	}
+	k.SetVoteLock(ctx, lock)
+	return prior.LockedAmount
+}
+
+// ReleaseVoteLocks returns the tokens of all vote locks that have reached their unlock time to their voters.
+func (k Keeper) ReleaseVoteLocks(ctx sdk.Context) error {
+	var unlocked types.VoteLocks
+	k.IterateVoteLocks(ctx, func(lock types.VoteLock) bool {
+		if lock.UnlockTime.After(ctx.BlockTime()) {
+			return true
+		}
+		unlocked = append(unlocked, lock)
+		return false
+	})
+
+	for _, lock := range unlocked {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lock.Voter, sdk.NewCoins(lock.Amount))
+		if err != nil {
+			return err
+		}
+		k.DeleteVoteLock(ctx, lock)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVoteUnlock,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", lock.ProposalID)),
+				sdk.NewAttribute(types.AttributeKeyVoter, lock.Voter.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, lock.Amount.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// TallyConvictionVotes returns the polling status of a conviction voting token committee vote. Yes, no and total
+// votes are the tokens locked by voters weighted by their conviction. Locked votes are the unweighted tokens locked by
+// voters, and possible votes are the token supply.
+func (k Keeper) TallyConvictionVotes(ctx sdk.Context, proposalID uint64,
+	tallyDenom string,
+) (yesVotes, noVotes, totalVotes, lockedVotes, possibleVotes sdk.Dec) {
+	votes := k.GetVotesByProposal(ctx, proposalID)
+
+	yesVotes = sdk.ZeroDec()
+	noVotes = sdk.ZeroDec()
+	totalVotes = sdk.ZeroDec()
+	lockedVotes = sdk.ZeroDec()
+	for _, vote := range votes {
+		lockedAmount := vote.LockedAmount
+		if lockedAmount.IsNil() {
+			lockedAmount = sdkmath.ZeroInt()
+		}
+		weight := vote.Weight()
+
+		// Add votes to counters
+		lockedVotes = lockedVotes.Add(sdk.NewDecFromInt(lockedAmount))
+		totalVotes = totalVotes.Add(weight)
+		if vote.VoteType == types.VOTE_TYPE_YES {
+			yesVotes = yesVotes.Add(weight)
+		} else if vote.VoteType == types.VOTE_TYPE_NO || vote.VoteType == types.VOTE_TYPE_NO_WITH_VETO {
+			noVotes = noVotes.Add(weight)
+		}
+	}
+
+	possibleVotesInt := k.bankKeeper.GetSupply(ctx, tallyDenom).Amount
+	return yesVotes, noVotes, totalVotes, lockedVotes, sdk.NewDecFromInt(possibleVotesInt)
+}
+
+// validateConvictionVote checks a vote can be cast on a committee, and returns the tokens it locks, if any.
+func validateConvictionVote(
+	proposal types.Proposal, com types.Committee, voter sdk.AccAddress, conviction types.Conviction, lockAmount sdkmath.Int,
+) (*types.VoteLock, error) {
+	if err := conviction.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidConvictionVote, err.Error())
+	}
+	tokenCom, ok := com.(*types.TokenCommittee)
+	if !ok || !tokenCom.ConvictionVoting {
+		if conviction != types.CONVICTION_NONE || (!lockAmount.IsNil() && !lockAmount.IsZero()) {
+			return nil, errorsmod.Wrap(types.ErrInvalidConvictionVote, "committee does not use conviction voting")
+		}
+		return nil, nil
+	}
+	if lockAmount.IsNil() || !lockAmount.IsPositive() {
+		return nil, errorsmod.Wrap(types.ErrInvalidConvictionVote, "conviction votes must lock a positive amount")
+	}
+
+	unlockTime := proposal.Deadline.Add(conviction.LockDuration(tokenCom.ConvictionLockPeriod))
+	lock := types.NewVoteLock(voter, proposal.ID, sdk.NewCoin(tokenCom.TallyDenom, lockAmount), unlockTime)
+	return &lock, nil
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/committee/testutil"
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+func (suite *keeperTestSuite) TestConvictionVoting() {
+	lockPeriod := time.Hour * 24
+	tokenCom := types.MustNewTokenCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:5],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+		testutil.D("0.4"),
+		"hard",
+	)
+	tokenCom.SetConvictionVoting(lockPeriod)
+	var proposalID uint64 = 1
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	deadline := firstBlockTime.Add(time.Hour * 24 * 7)
+
+	genAddrs := suite.Addresses[:3]
+	genCoins := []sdk.Coins{testutil.Cs(testutil.C("hard", 100)), testutil.Cs(testutil.C("hard", 100)), testutil.Cs(testutil.C("hard", 100))}
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(
+			tApp.AppCodec(),
+			[]types.Committee{tokenCom},
+			[]types.Proposal{types.MustNewProposal(
+				govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+				proposalID,
+				tokenCom.GetID(),
+				deadline,
+			)},
+			[]types.Vote{},
+		),
+		app.NewFundedGenStateWithCoins(tApp.AppCodec(), genCoins, genAddrs),
+	)
+
+	// Votes must lock tokens
+	err := keeper.AddVote(ctx, proposalID, genAddrs[0], types.VOTE_TYPE_YES)
+	suite.ErrorIs(err, types.ErrInvalidConvictionVote)
+
+	suite.NoError(keeper.AddConvictionVote(ctx, proposalID, genAddrs[0], types.VOTE_TYPE_YES, types.CONVICTION_LOCKED_3X, sdk.NewInt(20)))
+	suite.NoError(keeper.AddConvictionVote(ctx, proposalID, genAddrs[1], types.VOTE_TYPE_NO, types.CONVICTION_LOCKED_1X, sdk.NewInt(50)))
+	suite.NoError(keeper.AddConvictionVote(ctx, proposalID, genAddrs[2], types.VOTE_TYPE_NO, types.CONVICTION_NONE, sdk.NewInt(100)))
+
+	// Locked tokens are held by the module account
+	suite.Equal(testutil.C("hard", 80), bankKeeper.GetBalance(ctx, genAddrs[0], "hard"))
+	suite.Equal(testutil.C("hard", 170), bankKeeper.GetBalance(ctx, tApp.GetAccountKeeper().GetModuleAddress(types.ModuleName), "hard"))
+	lock, found := keeper.GetVoteLock(ctx, deadline.Add(4*lockPeriod), proposalID, genAddrs[0])
+	suite.True(found)
+	suite.Equal(testutil.C("hard", 20), lock.Amount)
+
+	// Votes are weighted by conviction, quorum is met by tokens locked
+	tally, found := keeper.GetProposalTallyResponse(ctx, proposalID)
+	suite.True(found)
+	suite.True(tally.ConvictionVoting)
+	suite.Equal(testutil.D("60"), tally.YesVotes)
+	suite.Equal(testutil.D("60"), tally.NoVotes)
+	suite.Equal(testutil.D("120"), tally.CurrentVotes)
+	suite.Equal(testutil.D("170"), tally.LockedVotes)
+	suite.Equal(testutil.D("300"), tally.PossibleVotes)
+	suite.False(keeper.GetTokenCommitteeProposalResult(ctx, proposalID, tokenCom))
+
+	// Tokens are released at the unlock time of each lock
+	suite.NoError(keeper.ReleaseVoteLocks(ctx.WithBlockTime(deadline)))
+	suite.Equal(testutil.C("hard", 100), bankKeeper.GetBalance(ctx, genAddrs[2], "hard"))
+	suite.NoError(keeper.ReleaseVoteLocks(ctx.WithBlockTime(deadline.Add(lockPeriod))))
+	suite.Equal(testutil.C("hard", 100), bankKeeper.GetBalance(ctx, genAddrs[1], "hard"))
+	suite.Equal(testutil.C("hard", 80), bankKeeper.GetBalance(ctx, genAddrs[0], "hard"))
+	suite.Len(keeper.GetVoteLocks(ctx), 1)
+	suite.NoError(keeper.ReleaseVoteLocks(ctx.WithBlockTime(deadline.Add(4 * lockPeriod))))
+	suite.Equal(testutil.C("hard", 100), bankKeeper.GetBalance(ctx, genAddrs[0], "hard"))
+	suite.Empty(keeper.GetVoteLocks(ctx))
+}
+
+func (suite *keeperTestSuite) TestConvictionVoting_Revote() {
+	lockPeriod := time.Hour * 24
+	tokenCom := types.MustNewTokenCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:5],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+		testutil.D("0.4"),
+		"hard",
+	)
+	tokenCom.SetConvictionVoting(lockPeriod)
+	var proposalID uint64 = 1
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	deadline := firstBlockTime.Add(time.Hour * 24 * 7)
+	voter := suite.Addresses[0]
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(
+			tApp.AppCodec(),
+			[]types.Committee{tokenCom},
+			[]types.Proposal{types.MustNewProposal(
+				govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+				proposalID,
+				tokenCom.GetID(),
+				deadline,
+			)},
+			[]types.Vote{},
+		),
+		app.NewFundedGenStateWithCoins(tApp.AppCodec(), []sdk.Coins{testutil.Cs(testutil.C("hard", 100))}, []sdk.AccAddress{voter}),
+	)
+
+	// Voting again adds to the tokens locked and keeps the higher conviction
+	suite.NoError(keeper.AddConvictionVote(ctx, proposalID, voter, types.VOTE_TYPE_YES, types.CONVICTION_LOCKED_1X, sdk.NewInt(20)))
+	suite.NoError(keeper.AddConvictionVote(ctx, proposalID, voter, types.VOTE_TYPE_NO, types.CONVICTION_LOCKED_3X, sdk.NewInt(30)))
+	suite.NoError(keeper.AddConvictionVote(ctx, proposalID, voter, types.VOTE_TYPE_NO, types.CONVICTION_NONE, sdk.NewInt(10)))
+
+	vote, found := keeper.GetVote(ctx, proposalID, voter)
+	suite.True(found)
+	suite.Equal(types.VOTE_TYPE_NO, vote.VoteType)
+	suite.Equal(types.CONVICTION_LOCKED_3X, vote.Conviction)
+	suite.Equal(sdk.NewInt(60), vote.LockedAmount)
+
+	// All tokens are locked until the unlock time of the highest conviction
+	locks := keeper.GetVoteLocks(ctx)
+	suite.Len(locks, 1)
+	suite.Equal(testutil.C("hard", 60), locks[0].Amount)
+	suite.Equal(deadline.Add(4*lockPeriod), locks[0].UnlockTime)
+
+	tally, found := keeper.GetProposalTallyResponse(ctx, proposalID)
+	suite.True(found)
+	suite.Equal(testutil.D("180"), tally.NoVotes)
+	suite.Equal(testutil.D("60"), tally.LockedVotes)
+}
+
+func (suite *keeperTestSuite) TestConvictionVoting_Disabled() {
+	memberCom := mustNewTestMemberCommittee(suite.Addresses)
+	suite.Keeper.SetCommittee(suite.Ctx, memberCom)
+	suite.Keeper.SetNextProposalID(suite.Ctx, 1)
+	proposalID, err := suite.Keeper.StoreNewProposal(suite.Ctx, govv1beta1.NewTextProposal("A Title", "A description of this proposal."), memberCom.GetID(), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.AddConvictionVote(suite.Ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES, types.CONVICTION_LOCKED_1X, sdk.NewInt(10))
+	suite.ErrorIs(err, types.ErrInvalidConvictionVote)
+	suite.Empty(suite.Keeper.GetVoteLocks(suite.Ctx))
+}
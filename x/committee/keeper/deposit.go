@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// CancelProposal closes a proposal on behalf of its proposer, refunding the deposit. Proposals can only be cancelled
+// before they receive any votes.
+func (k Keeper) CancelProposal(ctx sdk.Context, proposer sdk.AccAddress, proposalID uint64) error {
+	pr, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if pr.Proposer.Empty() || !pr.Proposer.Equals(proposer) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the proposer can cancel a proposal")
+	}
+	if pr.HasExpiredBy(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrProposalExpired, "%s ≥ %s", ctx.BlockTime(), pr.Deadline)
+	}
+	if len(k.GetVotesByProposal(ctx, proposalID)) > 0 {
+		return errorsmod.Wrap(types.ErrCannotCancelProposal, "proposal has been voted on")
+	}
+
+	k.CloseProposal(ctx, pr, types.Cancelled)
+	return nil
+}
+
+// settleProposalDeposit burns the deposit of a vetoed proposal, or refunds it to the proposer otherwise.
+func (k Keeper) settleProposalDeposit(ctx sdk.Context, proposal types.Proposal, outcome types.ProposalOutcome) error {
+	if proposal.Deposit.IsZero() {
+		return nil
+	}
+	if outcome == types.Vetoed {
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, proposal.Deposit)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposal.Proposer, proposal.Deposit)
+}
+
+// vetoProposal blocks a vetoed proposal from being resubmitted to its committee until the committee's veto cooldown ends.
+func (k Keeper) vetoProposal(ctx sdk.Context, proposal types.Proposal) error {
+	com, found := k.GetCommittee(ctx, proposal.CommitteeID)
+	if !found || com.GetVetoCooldown() <= 0 {
+		return nil
+	}
+	contentHash, err := types.GetContentHash(proposal.GetContent())
+	if err != nil {
+		return err
+	}
+	k.SetVetoedProposal(ctx, types.NewVetoedProposal(com.GetID(), contentHash, ctx.BlockTime().Add(com.GetVetoCooldown())))
+	return nil
+}
+
+// validateNotVetoed checks a proposal is not being resubmitted to a committee during its veto cooldown.
+func (k Keeper) validateNotVetoed(ctx sdk.Context, committeeID uint64, pubProposal types.PubProposal) error {
+	contentHash, err := types.GetContentHash(pubProposal)
+	if err != nil {
+		return err
+	}
+	vetoedProposal, found := k.GetVetoedProposal(ctx, committeeID, contentHash)
+	if found && !vetoedProposal.HasCooledDownBy(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrProposalVetoed, "proposal cannot be resubmitted until %s", vetoedProposal.CooldownEnd)
+	}
+	return nil
+}
+
+// PruneVetoedProposals deletes the records of vetoed proposals whose cooldown has ended.
+func (k Keeper) PruneVetoedProposals(ctx sdk.Context) {
+	var expired types.VetoedProposals
+	k.IterateVetoedProposalsByCooldown(ctx, ctx.BlockTime(), func(vetoedProposal types.VetoedProposal) bool {
+		expired = append(expired, vetoedProposal)
+		return false
+	})
+	for _, vetoedProposal := range expired {
+		k.DeleteVetoedProposal(ctx, vetoedProposal.CommitteeID, vetoedProposal.ContentHash)
+	}
+}
+
+// IsProposalVetoed returns whether the no with veto votes on a proposal exceed the veto threshold of current votes.
+func (k Keeper) IsProposalVetoed(ctx sdk.Context, proposalID uint64) bool {
+	tally, found := k.GetProposalTallyResponse(ctx, proposalID)
+	if !found {
+		return false
+	}
+	return tally.VetoVotes.IsPositive() && tally.VetoVotes.GT(tally.VetoThreshold.Mul(tally.CurrentVotes))
+}
+
+// TallyVetoVotes returns the no with veto votes on a proposal, weighted as the committee weights votes.
+func (k Keeper) TallyVetoVotes(ctx sdk.Context, proposalID uint64, committee types.Committee) sdk.Dec {
+	vetoVotes := sdk.ZeroDec()
+	for _, vote := range k.GetVotesByProposal(ctx, proposalID) {
+		if vote.VoteType != types.VOTE_TYPE_NO_WITH_VETO {
+			continue
+		}
+		switch com := committee.(type) {
+		case *types.MemberCommittee:
+			vetoVotes = vetoVotes.Add(sdk.OneDec())
+		case *types.TokenCommittee:
+			if com.ConvictionVoting {
+				vetoVotes = vetoVotes.Add(vote.Weight())
+			} else {
+				vetoVotes = vetoVotes.Add(sdk.NewDecFromInt(k.bankKeeper.GetBalance(ctx, vote.Voter, com.TallyDenom).Amount))
+			}
+		}
+	}
+	return vetoVotes
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/committee/testutil"
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// setupDepositTest initializes an app with a member committee that requires proposal deposits from funded members.
+func (suite *keeperTestSuite) setupDepositTest(tallyOption types.TallyOption) (app.TestApp, sdk.Context, *types.MemberCommittee) {
+	memberCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:5],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.6"),
+		time.Hour*24*7,
+		tallyOption,
+	)
+	memberCom.SetProposalDeposit(testutil.Cs(testutil.C("ukava", 100)))
+	memberCom.SetVetoCooldown(time.Hour * 24 * 30)
+
+	genAddrs := suite.Addresses[:5]
+	genCoins := make([]sdk.Coins, len(genAddrs))
+	for i := range genCoins {
+		genCoins[i] = testutil.Cs(testutil.C("ukava", 1000))
+	}
+
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{memberCom}, []types.Proposal{}, []types.Vote{}),
+		app.NewFundedGenStateWithCoins(tApp.AppCodec(), genCoins, genAddrs),
+	)
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	return tApp, ctx, memberCom
+}
+
+func (suite *keeperTestSuite) TestProposalDeposit_Refunded() {
+	tApp, ctx, memberCom := suite.setupDepositTest(types.TALLY_OPTION_FIRST_PAST_THE_POST)
+	keeper := tApp.GetCommitteeKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+	proposer := suite.Addresses[0]
+
+	proposalID, err := keeper.SubmitProposal(ctx, proposer, memberCom.ID, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	suite.Equal(testutil.C("ukava", 900), bankKeeper.GetBalance(ctx, proposer, "ukava"))
+	proposal, found := keeper.GetProposal(ctx, proposalID)
+	suite.True(found)
+	suite.Equal(proposer, proposal.Proposer)
+	suite.Equal(testutil.Cs(testutil.C("ukava", 100)), proposal.Deposit)
+
+	for _, voter := range suite.Addresses[:3] {
+		suite.Require().NoError(keeper.AddVote(ctx, proposalID, voter, types.VOTE_TYPE_YES))
+	}
+	keeper.ProcessProposals(ctx)
+
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(testutil.C("ukava", 1000), bankKeeper.GetBalance(ctx, proposer, "ukava"))
+}
+
+func (suite *keeperTestSuite) TestProposalDeposit_InsufficientFunds() {
+	tApp, ctx, memberCom := suite.setupDepositTest(types.TALLY_OPTION_FIRST_PAST_THE_POST)
+	keeper := tApp.GetCommitteeKeeper()
+
+	memberCom.SetProposalDeposit(testutil.Cs(testutil.C("ukava", 1001)))
+	keeper.SetCommittee(ctx, memberCom)
+
+	_, err := keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.ID, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	suite.Empty(keeper.GetProposals(ctx))
+}
+
+func (suite *keeperTestSuite) TestCancelProposal() {
+	tApp, ctx, memberCom := suite.setupDepositTest(types.TALLY_OPTION_FIRST_PAST_THE_POST)
+	keeper := tApp.GetCommitteeKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+	proposer := suite.Addresses[0]
+	pubProposal := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+
+	proposalID, err := keeper.SubmitProposal(ctx, proposer, memberCom.ID, pubProposal)
+	suite.Require().NoError(err)
+
+	// Only the proposer can cancel
+	err = keeper.CancelProposal(ctx, suite.Addresses[1], proposalID)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.NoError(keeper.CancelProposal(ctx, proposer, proposalID))
+	_, found := keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(testutil.C("ukava", 1000), bankKeeper.GetBalance(ctx, proposer, "ukava"))
+
+	err = keeper.CancelProposal(ctx, proposer, proposalID)
+	suite.ErrorIs(err, types.ErrUnknownProposal)
+
+	// Proposals cannot be cancelled once voted on
+	proposalID, err = keeper.SubmitProposal(ctx, proposer, memberCom.ID, pubProposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[1], types.VOTE_TYPE_YES))
+	err = keeper.CancelProposal(ctx, proposer, proposalID)
+	suite.ErrorIs(err, types.ErrCannotCancelProposal)
+}
+
+func (suite *keeperTestSuite) TestVetoProposal() {
+	tApp, ctx, memberCom := suite.setupDepositTest(types.TALLY_OPTION_DEADLINE)
+	keeper := tApp.GetCommitteeKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+	proposer := suite.Addresses[0]
+	pubProposal := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+	supplyBefore := bankKeeper.GetSupply(ctx, "ukava")
+
+	proposalID, err := keeper.SubmitProposal(ctx, proposer, memberCom.ID, pubProposal)
+	suite.Require().NoError(err)
+
+	// Vetoed proposals are not enacted even if they pass the vote threshold
+	for _, voter := range suite.Addresses[:3] {
+		suite.Require().NoError(keeper.AddVote(ctx, proposalID, voter, types.VOTE_TYPE_YES))
+	}
+	for _, voter := range suite.Addresses[3:5] {
+		suite.Require().NoError(keeper.AddVote(ctx, proposalID, voter, types.VOTE_TYPE_NO_WITH_VETO))
+	}
+	tally, found := keeper.GetProposalTallyResponse(ctx, proposalID)
+	suite.True(found)
+	suite.Equal(testutil.D("3"), tally.YesVotes)
+	suite.Equal(testutil.D("2"), tally.NoVotes)
+	suite.Equal(testutil.D("2"), tally.VetoVotes)
+	suite.Equal(types.VetoThreshold, tally.VetoThreshold)
+	suite.True(keeper.IsProposalVetoed(ctx, proposalID))
+
+	proposal, _ := keeper.GetProposal(ctx, proposalID)
+	ctx = ctx.WithBlockTime(proposal.Deadline)
+	keeper.ProcessProposals(ctx)
+
+	// The deposit is burned
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(testutil.C("ukava", 900), bankKeeper.GetBalance(ctx, proposer, "ukava"))
+	suite.Equal(supplyBefore.SubAmount(sdk.NewInt(100)), bankKeeper.GetSupply(ctx, "ukava"))
+
+	// The proposal cannot be resubmitted until the cooldown ends
+	_, err = keeper.SubmitProposal(ctx, proposer, memberCom.ID, pubProposal)
+	suite.ErrorIs(err, types.ErrProposalVetoed)
+	_, err = keeper.SubmitProposal(ctx, proposer, memberCom.ID, govv1beta1.NewTextProposal("Another Title", "A different description."))
+	suite.ErrorIs(err, types.ErrProposalVetoed)
+
+	// Records are pruned once their cooldown ends
+	keeper.PruneVetoedProposals(ctx.WithBlockTime(ctx.BlockTime().Add(memberCom.VetoCooldown - time.Second)))
+	suite.Len(keeper.GetVetoedProposals(ctx), 1)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(memberCom.VetoCooldown))
+	keeper.PruneVetoedProposals(ctx)
+	suite.Empty(keeper.GetVetoedProposals(ctx))
+	_, err = keeper.SubmitProposal(ctx, proposer, memberCom.ID, pubProposal)
+	suite.NoError(err)
+}
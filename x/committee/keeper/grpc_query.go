@@ -192,13 +192,17 @@ func (s queryServer) proposalResponseFromProposal(proposal types.Proposal) types
 		ID:          proposal.ID,
 		CommitteeID: proposal.CommitteeID,
 		Deadline:    proposal.Deadline,
+		Proposer:    proposal.Proposer.String(),
+		Deposit:     proposal.Deposit,
 	}
 }
 
 func (s queryServer) votesResponseFromVote(vote types.Vote) types.QueryVoteResponse {
 	return types.QueryVoteResponse{
-		ProposalID: vote.ProposalID,
-		Voter:      vote.Voter.String(),
-		VoteType:   vote.VoteType,
+		ProposalID:   vote.ProposalID,
+		Voter:        vote.Voter.String(),
+		VoteType:     vote.VoteType,
+		Conviction:   vote.Conviction,
+		LockedAmount: vote.LockedAmount,
 	}
 }
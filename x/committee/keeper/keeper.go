@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -136,6 +137,14 @@ func (k Keeper) IncrementNextProposalID(ctx sdk.Context) error {
 
 // StoreNewProposal stores a proposal, adding a new ID
 func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal, committeeID uint64, deadline time.Time) (uint64, error) {
+	return k.StoreNewProposalWithDeposit(ctx, pubProposal, committeeID, deadline, nil, nil)
+}
+
+// StoreNewProposalWithDeposit stores a proposal with its proposer and deposit, adding a new ID. The deposit must
+// already be held in the module account.
+func (k Keeper) StoreNewProposalWithDeposit(ctx sdk.Context, pubProposal types.PubProposal, committeeID uint64, deadline time.Time,
+	proposer sdk.AccAddress, deposit sdk.Coins,
+) (uint64, error) {
 	newProposalID, err := k.GetNextProposalID(ctx)
 	if err != nil {
 		return 0, err
@@ -149,6 +158,8 @@ func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal,
 	if err != nil {
 		return 0, err
 	}
+	proposal.Proposer = proposer
+	proposal.Deposit = deposit
 
 	k.SetProposal(ctx, proposal)
 
@@ -299,3 +310,157 @@ func (k Keeper) GetVotesByProposal(ctx sdk.Context, proposalID uint64) []types.V
 
 	return results
 }
+
+// ------------------------------------------
+//				Vote Locks
+// ------------------------------------------
+
+// GetVoteLock gets a vote lock from the store.
+func (k Keeper) GetVoteLock(ctx sdk.Context, unlockTime time.Time, proposalID uint64, voter sdk.AccAddress) (types.VoteLock, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteLockKeyPrefix)
+	bz := store.Get(types.GetVoteLockKey(unlockTime, proposalID, voter))
+	if bz == nil {
+		return types.VoteLock{}, false
+	}
+	var lock types.VoteLock
+	k.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// SetVoteLock puts a vote lock into the store.
+func (k Keeper) SetVoteLock(ctx sdk.Context, lock types.VoteLock) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteLockKeyPrefix)
+	bz := k.cdc.MustMarshal(&lock)
+	store.Set(types.GetVoteLockKey(lock.UnlockTime, lock.ProposalID, lock.Voter), bz)
+}
+
+// DeleteVoteLock removes a vote lock from the store.
+func (k Keeper) DeleteVoteLock(ctx sdk.Context, lock types.VoteLock) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteLockKeyPrefix)
+	store.Delete(types.GetVoteLockKey(lock.UnlockTime, lock.ProposalID, lock.Voter))
+}
+
+// IterateVoteLocks provides an iterator over all stored vote locks, in order of unlock time.
+// For each vote lock, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateVoteLocks(ctx sdk.Context, cb func(lock types.VoteLock) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteLockKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.VoteLock
+		k.cdc.MustUnmarshal(iterator.Value(), &lock)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// GetVoteLocks returns all stored vote locks.
+func (k Keeper) GetVoteLocks(ctx sdk.Context) types.VoteLocks {
+	results := types.VoteLocks{}
+	k.IterateVoteLocks(ctx, func(lock types.VoteLock) bool {
+		results = append(results, lock)
+		return false
+	})
+	return results
+}
+
+// GetVoteLocksByVoter returns all vote locks of one voter.
+func (k Keeper) GetVoteLocksByVoter(ctx sdk.Context, voter sdk.AccAddress) types.VoteLocks {
+	results := types.VoteLocks{}
+	k.IterateVoteLocks(ctx, func(lock types.VoteLock) bool {
+		if lock.Voter.Equals(voter) {
+			results = append(results, lock)
+		}
+		return false
+	})
+	return results
+}
+
+// ------------------------------------------
+//				Vetoed Proposals
+// ------------------------------------------
+
+// GetVetoedProposal gets a vetoed proposal from the store.
+func (k Keeper) GetVetoedProposal(ctx sdk.Context, committeeID uint64, contentHash []byte) (types.VetoedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VetoedProposalKeyPrefix)
+	bz := store.Get(types.GetVetoedProposalKey(committeeID, contentHash))
+	if bz == nil {
+		return types.VetoedProposal{}, false
+	}
+	var vetoedProposal types.VetoedProposal
+	k.cdc.MustUnmarshal(bz, &vetoedProposal)
+	return vetoedProposal, true
+}
+
+// SetVetoedProposal puts a vetoed proposal into the store, and indexes it by cooldown end.
+func (k Keeper) SetVetoedProposal(ctx sdk.Context, vetoedProposal types.VetoedProposal) {
+	k.DeleteVetoedProposal(ctx, vetoedProposal.CommitteeID, vetoedProposal.ContentHash)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VetoedProposalKeyPrefix)
+	bz := k.cdc.MustMarshal(&vetoedProposal)
+	store.Set(types.GetVetoedProposalKey(vetoedProposal.CommitteeID, vetoedProposal.ContentHash), bz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VetoedProposalByCooldownKeyPrefix)
+	indexStore.Set(types.GetVetoedProposalByCooldownKey(vetoedProposal.CooldownEnd, vetoedProposal.CommitteeID, vetoedProposal.ContentHash), []byte{})
+}
+
+// DeleteVetoedProposal removes a vetoed proposal and its cooldown index from the store.
+func (k Keeper) DeleteVetoedProposal(ctx sdk.Context, committeeID uint64, contentHash []byte) {
+	vetoedProposal, found := k.GetVetoedProposal(ctx, committeeID, contentHash)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VetoedProposalKeyPrefix)
+	store.Delete(types.GetVetoedProposalKey(committeeID, contentHash))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VetoedProposalByCooldownKeyPrefix)
+	indexStore.Delete(types.GetVetoedProposalByCooldownKey(vetoedProposal.CooldownEnd, committeeID, contentHash))
+}
+
+// IterateVetoedProposalsByCooldown provides an iterator over the vetoed proposals whose cooldown has ended by a time,
+// in order of cooldown end. For each vetoed proposal, cb will be called. If cb returns true, the iterator will close
+// and stop.
+func (k Keeper) IterateVetoedProposalsByCooldown(ctx sdk.Context, cutoffTime time.Time,
+	cb func(vetoedProposal types.VetoedProposal) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VetoedProposalByCooldownKeyPrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(cutoffTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(sdk.FormatTimeBytes(cutoffTime)):]
+		vetoedProposal, found := k.GetVetoedProposal(ctx, types.Uint64FromBytes(key[:8]), key[8:])
+		if !found {
+			panic(fmt.Sprintf("vetoed proposal index has no record for committee %d: %X", types.Uint64FromBytes(key[:8]), key[8:]))
+		}
+		if cb(vetoedProposal) {
+			break
+		}
+	}
+}
+
+// IterateVetoedProposals provides an iterator over all stored vetoed proposals.
+// For each vetoed proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateVetoedProposals(ctx sdk.Context, cb func(vetoedProposal types.VetoedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VetoedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vetoedProposal types.VetoedProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &vetoedProposal)
+		if cb(vetoedProposal) {
+			break
+		}
+	}
+}
+
+// GetVetoedProposals returns all stored vetoed proposals.
+func (k Keeper) GetVetoedProposals(ctx sdk.Context) types.VetoedProposals {
+	results := types.VetoedProposals{}
+	k.IterateVetoedProposals(ctx, func(vetoedProposal types.VetoedProposal) bool {
+		results = append(results, vetoedProposal)
+		return false
+	})
+	return results
+}
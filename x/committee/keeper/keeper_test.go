@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/stretchr/testify/suite"
 
@@ -75,8 +76,9 @@ func (suite *keeperTestSuite) TestGetSetDeleteProposal() {
 func (suite *keeperTestSuite) TestGetSetDeleteVote() {
 	// test setup
 	vote := types.Vote{
-		ProposalID: 12,
-		Voter:      suite.Addresses[0],
+		ProposalID:   12,
+		Voter:        suite.Addresses[0],
+		LockedAmount: sdkmath.ZeroInt(),
 	}
 
 	// write and read from store
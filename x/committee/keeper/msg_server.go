@@ -54,7 +54,7 @@ func (m msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 		return nil, err
 	}
 
-	if err := m.keeper.AddVote(ctx, msg.ProposalID, voter, msg.VoteType); err != nil {
+	if err := m.keeper.AddConvictionVote(ctx, msg.ProposalID, voter, msg.VoteType, msg.Conviction, msg.LockAmount); err != nil {
 		return nil, err
 	}
 
@@ -68,3 +68,27 @@ func (m msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 
 	return &types.MsgVoteResponse{}, nil
 }
+
+// CancelProposal handles MsgCancelProposal messages
+func (m msgServer) CancelProposal(goCtx context.Context, msg *types.MsgCancelProposal) (*types.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.CancelProposal(ctx, proposer, msg.ProposalID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+	)
+
+	return &types.MsgCancelProposalResponse{}, nil
+}
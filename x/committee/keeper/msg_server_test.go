@@ -62,6 +62,8 @@ func (suite *MsgServerTestSuite) SetupTest() {
 		[]types.Committee{memberCommittee},
 		[]types.Proposal{},
 		[]types.Vote{},
+		types.VoteLocks{},
+		types.VetoedProposals{},
 	)
 	suite.communityPoolAmt = sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000)))
 	suite.app.InitializeFromGenesisStates(
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -31,9 +32,22 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 		return 0, err
 	}
 
+	// Check proposal was not recently vetoed
+	if err := k.validateNotVetoed(ctx, committeeID, pubProposal); err != nil {
+		return 0, err
+	}
+
+	// Hold the deposit in the module account until the proposal closes
+	deposit := com.GetProposalDeposit()
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, deposit); err != nil {
+			return 0, err
+		}
+	}
+
 	// Get a new ID and store the proposal
 	deadline := ctx.BlockTime().Add(com.GetProposalDuration())
-	proposalID, err := k.StoreNewProposal(ctx, pubProposal, committeeID, deadline)
+	proposalID, err := k.StoreNewProposalWithDeposit(ctx, pubProposal, committeeID, deadline, proposer, deposit)
 	if err != nil {
 		return 0, err
 	}
@@ -44,6 +58,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute(types.AttributeKeyDeadline, deadline.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
 		),
 	)
 	return proposalID, nil
@@ -51,6 +66,15 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 
 // AddVote submits a vote on a proposal.
 func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, voteType types.VoteType) error {
+	return k.AddConvictionVote(ctx, proposalID, voter, voteType, types.CONVICTION_NONE, sdk.ZeroInt())
+}
+
+// AddConvictionVote submits a vote on a proposal. On conviction voting committees the lock amount of tally denom is
+// locked in the module account with the conviction, and weights the vote. Voting again replaces the vote type, adds the
+// lock amount to the tokens locked by the earlier vote and keeps the higher of the two convictions.
+func (k Keeper) AddConvictionVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, voteType types.VoteType,
+	conviction types.Conviction, lockAmount sdkmath.Int,
+) error {
 	// Validate
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
@@ -68,13 +92,33 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 		if !com.HasMember(voter) {
 			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee")
 		}
-		if voteType != types.VOTE_TYPE_YES {
-			return errorsmod.Wrap(types.ErrInvalidVoteType, "member committees only accept yes votes")
+		if voteType != types.VOTE_TYPE_YES && voteType != types.VOTE_TYPE_NO_WITH_VETO {
+			return errorsmod.Wrap(types.ErrInvalidVoteType, "member committees only accept yes and no with veto votes")
 		}
 	}
 
+	prior, hasPrior := k.GetVote(ctx, proposalID, voter)
+	if hasPrior && prior.Conviction > conviction {
+		conviction = prior.Conviction
+	}
+	lock, err := validateConvictionVote(pr, com, voter, conviction, lockAmount)
+	if err != nil {
+		return err
+	}
+	vote := types.NewVote(proposalID, voter, voteType)
+	if lock != nil {
+		if hasPrior {
+			lockPeriod := com.(*types.TokenCommittee).ConvictionLockPeriod
+			lockAmount = lockAmount.Add(k.relockVoteTokens(ctx, pr, prior, lockPeriod, lock.UnlockTime))
+		}
+		if err := k.lockVoteTokens(ctx, *lock); err != nil {
+			return err
+		}
+		vote = types.NewConvictionVote(proposalID, voter, voteType, conviction, lockAmount)
+	}
+
 	// Store vote, overwriting any prior vote
-	k.SetVote(ctx, types.NewVote(proposalID, voter, voteType))
+	k.SetVote(ctx, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -132,7 +176,7 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 		if !proposal.HasExpiredBy(ctx.BlockTime()) {
 			if committee.GetTallyOption() == types.TALLY_OPTION_FIRST_PAST_THE_POST {
 				passed := k.GetProposalResult(ctx, proposal.ID, committee)
-				if passed {
+				if passed && !k.IsProposalVetoed(ctx, proposal.ID) {
 					outcome := k.attemptEnactProposal(ctx, proposal)
 					k.CloseProposal(ctx, proposal, outcome)
 				}
@@ -140,7 +184,9 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 		} else {
 			passed := k.GetProposalResult(ctx, proposal.ID, committee)
 			outcome := types.Failed
-			if passed {
+			if k.IsProposalVetoed(ctx, proposal.ID) {
+				outcome = types.Vetoed
+			} else if passed {
 				outcome = k.attemptEnactProposal(ctx, proposal)
 			}
 			k.CloseProposal(ctx, proposal, outcome)
@@ -162,9 +208,10 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 
 // GetMemberCommitteeProposalResult gets the result of a member committee proposal
 func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
-	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID)
+	// members can only vote yes or no with veto
+	yesVotes := k.TallyMemberCommitteeVotes(ctx, proposalID).Sub(k.TallyVetoVotes(ctx, proposalID, committee))
 	possibleVotes := sdk.NewDec(int64(len(committee.GetMembers())))
-	return currVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
+	return yesVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
 }
 
 // TallyMemberCommitteeVotes returns the polling status of a member committee vote
@@ -175,7 +222,13 @@ func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64) (t
 
 // GetTokenCommitteeProposalResult gets the result of a token committee proposal
 func (k Keeper) GetTokenCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee *types.TokenCommittee) bool {
-	yesVotes, noVotes, totalVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposalID, committee.TallyDenom)
+	var yesVotes, noVotes, totalVotes, possibleVotes sdk.Dec
+	if committee.ConvictionVoting {
+		// quorum is met by the tokens locked, rather than the conviction weighted votes
+		yesVotes, noVotes, _, totalVotes, possibleVotes = k.TallyConvictionVotes(ctx, proposalID, committee.TallyDenom)
+	} else {
+		yesVotes, noVotes, totalVotes, possibleVotes = k.TallyTokenCommitteeVotes(ctx, proposalID, committee.TallyDenom)
+	}
 	if totalVotes.GTE(committee.Quorum.Mul(possibleVotes)) { // quorum requirement
 		nonAbstainVotes := yesVotes.Add(noVotes)
 		if yesVotes.GTE(nonAbstainVotes.Mul(committee.VoteThreshold)) { // vote threshold requirements
@@ -205,7 +258,7 @@ func (k Keeper) TallyTokenCommitteeVotes(ctx sdk.Context, proposalID uint64,
 		totalVotes = totalVotes.Add(sdk.NewDecFromInt(accNumCoins))
 		if vote.VoteType == types.VOTE_TYPE_YES {
 			yesVotes = yesVotes.Add(sdk.NewDecFromInt(accNumCoins))
-		} else if vote.VoteType == types.VOTE_TYPE_NO {
+		} else if vote.VoteType == types.VOTE_TYPE_NO || vote.VoteType == types.VOTE_TYPE_NO_WITH_VETO {
 			noVotes = noVotes.Add(sdk.NewDecFromInt(accNumCoins))
 		}
 	}
@@ -261,36 +314,67 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 	switch com := committee.(type) {
 	case *types.MemberCommittee:
 		currVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID)
+		vetoVotes := k.TallyVetoVotes(ctx, proposal.ID, com)
 		possibleVotes := sdk.NewDec(int64(len(com.Members)))
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
-			YesVotes:      currVotes,
-			NoVotes:       sdk.ZeroDec(),
+			YesVotes:      currVotes.Sub(vetoVotes),
+			NoVotes:       vetoVotes,
 			CurrentVotes:  currVotes,
 			PossibleVotes: possibleVotes,
 			VoteThreshold: com.VoteThreshold,
 			Quorum:        sdk.ZeroDec(),
+			LockedVotes:   sdk.ZeroDec(),
 		}
 	case *types.TokenCommittee:
-		yesVotes, noVotes, currVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposal.ID, com.TallyDenom)
-		proposalTally = types.QueryTallyResponse{
-			ProposalID:    proposal.ID,
-			YesVotes:      yesVotes,
-			NoVotes:       noVotes,
-			CurrentVotes:  currVotes,
-			PossibleVotes: possibleVotes,
-			VoteThreshold: com.VoteThreshold,
-			Quorum:        com.Quorum,
+		if com.ConvictionVoting {
+			yesVotes, noVotes, currVotes, lockedVotes, possibleVotes := k.TallyConvictionVotes(ctx, proposal.ID, com.TallyDenom)
+			proposalTally = types.QueryTallyResponse{
+				ProposalID:       proposal.ID,
+				YesVotes:         yesVotes,
+				NoVotes:          noVotes,
+				CurrentVotes:     currVotes,
+				PossibleVotes:    possibleVotes,
+				VoteThreshold:    com.VoteThreshold,
+				Quorum:           com.Quorum,
+				ConvictionVoting: true,
+				LockedVotes:      lockedVotes,
+			}
+		} else {
+			yesVotes, noVotes, currVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposal.ID, com.TallyDenom)
+			proposalTally = types.QueryTallyResponse{
+				ProposalID:    proposal.ID,
+				YesVotes:      yesVotes,
+				NoVotes:       noVotes,
+				CurrentVotes:  currVotes,
+				PossibleVotes: possibleVotes,
+				VoteThreshold: com.VoteThreshold,
+				Quorum:        com.Quorum,
+				LockedVotes:   sdk.ZeroDec(),
+			}
 		}
 	}
+	proposalTally.VetoVotes = k.TallyVetoVotes(ctx, proposal.ID, committee)
+	proposalTally.VetoThreshold = types.VetoThreshold
 	return &proposalTally, true
 }
 
-// CloseProposal deletes proposals and their votes, emitting an event denoting the final status of the proposal
+// CloseProposal deletes proposals and their votes, emitting an event denoting the final status of the proposal.
+// The deposit is refunded to the proposer, unless the proposal was vetoed in which case it is burned.
 func (k Keeper) CloseProposal(ctx sdk.Context, proposal types.Proposal, outcome types.ProposalOutcome) {
 	tally, _ := k.GetProposalTallyResponse(ctx, proposal.ID)
 	k.DeleteProposalAndVotes(ctx, proposal.ID)
 
+	// the deposit is held in the module account, so this can only fail if the module account is underfunded
+	if err := k.settleProposalDeposit(ctx, proposal, outcome); err != nil {
+		panic(fmt.Sprintf("could not settle proposal %d deposit: %s", proposal.ID, err))
+	}
+	if outcome == types.Vetoed {
+		if err := k.vetoProposal(ctx, proposal); err != nil {
+			panic(fmt.Sprintf("could not veto proposal %d: %s", proposal.ID, err))
+		}
+	}
+
 	bz, err := k.cdc.MarshalJSON(tally)
 	if err != nil {
 		fmt.Println("error marshaling proposal tally to bytes:", tally.String())
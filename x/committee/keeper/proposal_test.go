@@ -843,6 +843,8 @@ func committeeGenState(cdc codec.Codec, committees []types.Committee, proposals
 		committees,
 		proposals,
 		votes,
+		types.VoteLocks{},
+		types.VetoedProposals{},
 	)
 	return app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(gs)}
 }
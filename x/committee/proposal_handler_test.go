@@ -68,6 +68,8 @@ func (suite *ProposalHandlerTestSuite) SetupTest() {
 		[]types.Vote{
 			{ProposalID: 1, Voter: suite.addresses[0], VoteType: types.VOTE_TYPE_YES},
 		},
+		types.VoteLocks{},
+		types.VetoedProposals{},
 	)
 }
 
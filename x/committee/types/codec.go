@@ -81,6 +81,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// Msgs
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "kava/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "kava/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "kava/MsgCancelProposal")
 }
 
 // RegisterProposalTypeCodec allows external modules to register their own pubproposal types on the
@@ -93,6 +94,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitProposal{},
 		&MsgVote{},
+		&MsgCancelProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
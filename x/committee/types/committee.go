@@ -4,6 +4,7 @@ import (
 	fmt "fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -56,6 +57,13 @@ type Committee interface {
 	SetVoteThreshold(sdk.Dec)
 
 	GetTallyOption() TallyOption
+
+	GetProposalDeposit() sdk.Coins
+	SetProposalDeposit(sdk.Coins)
+
+	GetVetoCooldown() time.Duration
+	SetVetoCooldown(time.Duration)
+
 	Validate() error
 
 	String() string
@@ -143,10 +151,13 @@ func (c BaseCommittee) String() string {
   	Permissions:               			%s
   	VoteThreshold:            		  %s
 	ProposalDuration:        						%s
-	TallyOption:   						%s`,
+	TallyOption:   						%s
+	ProposalDeposit:   						%s
+	VetoCooldown:   						%s`,
 		c.ID, c.Description, c.GetMembers(), c.Permissions,
 		c.VoteThreshold.String(), c.ProposalDuration.String(),
-		c.TallyOption.String(),
+		c.TallyOption.String(), c.ProposalDeposit.String(),
+		c.VetoCooldown.String(),
 	)
 }
 
@@ -169,6 +180,22 @@ func (c *BaseCommittee) SetProposalDuration(proposalDuration time.Duration) {
 // GetTallyOption is a getter for committee TallyOption
 func (c BaseCommittee) GetTallyOption() TallyOption { return c.TallyOption }
 
+// GetProposalDeposit is a getter for committee ProposalDeposit
+func (c BaseCommittee) GetProposalDeposit() sdk.Coins { return c.ProposalDeposit }
+
+// SetProposalDeposit is a setter for committee ProposalDeposit
+func (c *BaseCommittee) SetProposalDeposit(proposalDeposit sdk.Coins) {
+	c.ProposalDeposit = proposalDeposit
+}
+
+// GetVetoCooldown is a getter for committee VetoCooldown
+func (c BaseCommittee) GetVetoCooldown() time.Duration { return c.VetoCooldown }
+
+// SetVetoCooldown is a setter for committee VetoCooldown
+func (c *BaseCommittee) SetVetoCooldown(vetoCooldown time.Duration) {
+	c.VetoCooldown = vetoCooldown
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (c BaseCommittee) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, any := range c.Permissions {
@@ -228,6 +255,14 @@ func (c BaseCommittee) Validate() error {
 		return fmt.Errorf("invalid tally option: %d", c.TallyOption)
 	}
 
+	if err := c.ProposalDeposit.Validate(); err != nil {
+		return fmt.Errorf("invalid proposal deposit: %w", err)
+	}
+
+	if c.VetoCooldown < 0 {
+		return fmt.Errorf("invalid veto cooldown: %s", c.VetoCooldown)
+	}
+
 	return nil
 }
 
@@ -309,6 +344,12 @@ func (c TokenCommittee) GetQuorum() sdk.Dec { return c.Quorum }
 // GetTallyDenom returns the tally denom of the committee
 func (c TokenCommittee) GetTallyDenom() string { return c.TallyDenom }
 
+// SetConvictionVoting enables conviction voting with a lock period, or disables it if the lock period is zero
+func (c *TokenCommittee) SetConvictionVoting(lockPeriod time.Duration) {
+	c.ConvictionVoting = lockPeriod > 0
+	c.ConvictionLockPeriod = lockPeriod
+}
+
 // Validate validates the committee's fields
 func (c TokenCommittee) Validate() error {
 	if c.TallyDenom == BondDenom {
@@ -324,6 +365,10 @@ func (c TokenCommittee) Validate() error {
 		return fmt.Errorf("invalid quorum: %s", c.Quorum)
 	}
 
+	if c.ConvictionVoting && c.ConvictionLockPeriod <= 0 {
+		return fmt.Errorf("invalid conviction lock period: %s", c.ConvictionLockPeriod)
+	}
+
 	return c.BaseCommittee.Validate()
 }
 
@@ -449,10 +494,17 @@ func (p Proposal) HasExpiredBy(time time.Time) bool {
 
 // NewVote instantiates a new instance of Vote
 func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
+	return NewConvictionVote(proposalID, voter, voteType, CONVICTION_NONE, sdk.ZeroInt())
+}
+
+// NewConvictionVote instantiates a new instance of Vote weighted by tokens locked with a conviction
+func NewConvictionVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType, conviction Conviction, lockedAmount sdkmath.Int) Vote {
 	return Vote{
-		ProposalID: proposalID,
-		Voter:      voter,
-		VoteType:   voteType,
+		ProposalID:   proposalID,
+		Voter:        voter,
+		VoteType:     voteType,
+		Conviction:   conviction,
+		LockedAmount: lockedAmount,
 	}
 }
 
@@ -461,6 +513,20 @@ func (v Vote) Validate() error {
 	if v.Voter.Empty() {
 		return fmt.Errorf("voter address cannot be empty")
 	}
+	if err := v.Conviction.Validate(); err != nil {
+		return err
+	}
+	if !v.LockedAmount.IsNil() && v.LockedAmount.IsNegative() {
+		return fmt.Errorf("locked amount cannot be negative: %s", v.LockedAmount)
+	}
 
 	return v.VoteType.Validate()
 }
+
+// Weight returns the conviction weighted votes of the vote's locked tokens
+func (v Vote) Weight() sdk.Dec {
+	if v.LockedAmount.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(v.LockedAmount).Mul(v.Conviction.Multiplier())
+}
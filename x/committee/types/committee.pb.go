@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -73,6 +74,10 @@ type BaseCommittee struct {
 	// The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
 	ProposalDuration time.Duration `protobuf:"bytes,6,opt,name=proposal_duration,json=proposalDuration,proto3,stdduration" json:"proposal_duration"`
 	TallyOption      TallyOption   `protobuf:"varint,7,opt,name=tally_option,json=tallyOption,proto3,enum=kava.committee.v1beta1.TallyOption" json:"tally_option,omitempty"`
+	// Deposit required to submit a proposal, refunded when the proposal closes unless it is vetoed
+	ProposalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=proposal_deposit,json=proposalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"proposal_deposit"`
+	// The length of time a vetoed proposal cannot be resubmitted for
+	VetoCooldown time.Duration `protobuf:"bytes,9,opt,name=veto_cooldown,json=vetoCooldown,proto3,stdduration" json:"veto_cooldown"`
 }
 
 func (m *BaseCommittee) Reset()      { *m = BaseCommittee{} }
@@ -149,6 +154,10 @@ type TokenCommittee struct {
 	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
 	Quorum         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum"`
 	TallyDenom     string                                 `protobuf:"bytes,3,opt,name=tally_denom,json=tallyDenom,proto3" json:"tally_denom,omitempty"`
+	// Enables conviction voting, where votes are weighted by tokens locked with a conviction instead of token balances
+	ConvictionVoting bool `protobuf:"varint,4,opt,name=conviction_voting,json=convictionVoting,proto3" json:"conviction_voting,omitempty"`
+	// The base length of time tokens are locked for after the proposal deadline when voting with conviction
+	ConvictionLockPeriod time.Duration `protobuf:"bytes,5,opt,name=conviction_lock_period,json=convictionLockPeriod,proto3,stdduration" json:"conviction_lock_period"`
 }
 
 func (m *TokenCommittee) Reset()      { *m = TokenCommittee{} }
@@ -195,48 +204,56 @@ func init() {
 }
 
 var fileDescriptor_a2549fd9d70ca349 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xb6, 0x93, 0x90, 0xd2, 0x75, 0x1b, 0xd2, 0xa5, 0x54, 0x4e, 0x85, 0x6c, 0xab, 0x40, 0x15,
-	0x81, 0x62, 0xab, 0xe1, 0xc6, 0x2d, 0xae, 0x13, 0xd5, 0x52, 0x69, 0x22, 0xc7, 0x3d, 0xc0, 0xc5,
-	0xb2, 0xe3, 0x25, 0xb5, 0x1a, 0x67, 0x83, 0x77, 0x53, 0x35, 0x6f, 0xc0, 0x91, 0x63, 0x8f, 0x48,
-	0xbc, 0x42, 0x1f, 0xa2, 0xea, 0xa9, 0xe2, 0x84, 0x38, 0x84, 0x92, 0x3e, 0x05, 0x9c, 0x90, 0xff,
-	0x1a, 0x17, 0x8a, 0x04, 0x07, 0x4e, 0xde, 0xfd, 0xe6, 0x9b, 0x99, 0xfd, 0x66, 0x3e, 0x19, 0x6c,
-	0x1e, 0xda, 0x47, 0xb6, 0xd2, 0xc3, 0xbe, 0xef, 0x51, 0x8a, 0x90, 0x72, 0xb4, 0xe5, 0x20, 0x6a,
-	0x6f, 0xcd, 0x11, 0x79, 0x14, 0x60, 0x8a, 0xe1, 0x5a, 0xc8, 0x93, 0xe7, 0x68, 0xc2, 0x5b, 0xaf,
-	0xf4, 0x30, 0xf1, 0x31, 0xb1, 0x22, 0x96, 0x12, 0x5f, 0xe2, 0x94, 0xf5, 0xd5, 0x3e, 0xee, 0xe3,
-	0x18, 0x0f, 0x4f, 0x09, 0x5a, 0xe9, 0x63, 0xdc, 0x1f, 0x20, 0x25, 0xba, 0x39, 0xe3, 0x37, 0x8a,
-	0x3d, 0x9c, 0x24, 0x21, 0xe1, 0xd7, 0x90, 0x3b, 0x0e, 0x6c, 0xea, 0xe1, 0x61, 0x1c, 0xdf, 0xf8,
-	0x9e, 0x07, 0xcb, 0xaa, 0x4d, 0xd0, 0x76, 0xfa, 0x0a, 0xb8, 0x06, 0x72, 0x9e, 0xcb, 0xb3, 0x12,
-	0x5b, 0x2d, 0xa8, 0xc5, 0xd9, 0x54, 0xcc, 0xe9, 0x9a, 0x91, 0xf3, 0x5c, 0x28, 0x01, 0xce, 0x45,
-	0xa4, 0x17, 0x78, 0xa3, 0x30, 0x9d, 0xcf, 0x49, 0x6c, 0x75, 0xd1, 0xc8, 0x42, 0xd0, 0x01, 0x0b,
-	0x3e, 0xf2, 0x1d, 0x14, 0x10, 0x3e, 0x2f, 0xe5, 0xab, 0x4b, 0xea, 0xce, 0x8f, 0xa9, 0x58, 0xeb,
-	0x7b, 0xf4, 0x60, 0xec, 0x84, 0x32, 0x13, 0x29, 0xc9, 0xa7, 0x46, 0xdc, 0x43, 0x85, 0x4e, 0x46,
-	0x88, 0xc8, 0x8d, 0x5e, 0xaf, 0xe1, 0xba, 0x01, 0x22, 0xe4, 0xd3, 0x69, 0xed, 0x7e, 0x22, 0x38,
-	0x41, 0xd4, 0x09, 0x45, 0xc4, 0x48, 0x0b, 0xc3, 0x16, 0xe0, 0x46, 0x28, 0xf0, 0x3d, 0x42, 0x3c,
-	0x3c, 0x24, 0x7c, 0x41, 0xca, 0x57, 0xb9, 0xfa, 0xaa, 0x1c, 0xab, 0x94, 0x53, 0x95, 0x72, 0x63,
-	0x38, 0x51, 0x4b, 0xe7, 0xa7, 0x35, 0xd0, 0xb9, 0x26, 0x1b, 0xd9, 0x44, 0xb8, 0x0f, 0x4a, 0x47,
-	0x98, 0x22, 0x8b, 0x1e, 0x04, 0x88, 0x1c, 0xe0, 0x81, 0xcb, 0xdf, 0x09, 0x05, 0xa9, 0xf2, 0xd9,
-	0x54, 0x64, 0xbe, 0x4c, 0xc5, 0xcd, 0xbf, 0x78, 0xb6, 0x86, 0x7a, 0xc6, 0x72, 0x58, 0xc5, 0x4c,
-	0x8b, 0xc0, 0x0e, 0x58, 0x19, 0x05, 0x78, 0x84, 0x89, 0x3d, 0xb0, 0xd2, 0x49, 0xf3, 0x45, 0x89,
-	0xad, 0x72, 0xf5, 0xca, 0x6f, 0x8f, 0xd4, 0x12, 0x82, 0x7a, 0x37, 0x6c, 0x7a, 0xf2, 0x55, 0x64,
-	0x8d, 0x72, 0x9a, 0x9d, 0xc6, 0x60, 0x0b, 0x2c, 0x51, 0x7b, 0x30, 0x98, 0x58, 0x38, 0x9e, 0xfb,
-	0x82, 0xc4, 0x56, 0x4b, 0xf5, 0x47, 0xf2, 0xed, 0xde, 0x91, 0xcd, 0x90, 0xdb, 0x8e, 0xa8, 0x06,
-	0x47, 0xe7, 0x97, 0x17, 0x2b, 0x27, 0x1f, 0x44, 0xe6, 0xfc, 0xb4, 0xb6, 0x78, 0xbd, 0xe9, 0x8d,
-	0x63, 0x70, 0xef, 0x65, 0x34, 0xd6, 0xf9, 0xf2, 0x0d, 0x50, 0x72, 0x6c, 0x82, 0xac, 0xeb, 0xc2,
-	0x91, 0x11, 0xb8, 0xfa, 0x93, 0x3f, 0xf5, 0xbb, 0xe1, 0x1d, 0xb5, 0x70, 0x31, 0x15, 0x59, 0x63,
-	0xd9, 0xc9, 0x82, 0xb7, 0x75, 0xbe, 0x64, 0x41, 0xc9, 0xc4, 0x87, 0x68, 0xf8, 0x5f, 0x3b, 0xc3,
-	0x16, 0x28, 0xbe, 0x1d, 0xe3, 0x60, 0xec, 0xc7, 0x6e, 0xfd, 0xe7, 0xe5, 0x26, 0xd9, 0x50, 0x04,
-	0xf1, 0x28, 0x2d, 0x17, 0x0d, 0xb1, 0xcf, 0xe7, 0x23, 0xeb, 0x83, 0x08, 0xd2, 0x42, 0xe4, 0x16,
-	0x89, 0x4f, 0x03, 0xc0, 0x65, 0x76, 0x01, 0x1f, 0x02, 0xde, 0x6c, 0xec, 0xee, 0xbe, 0xb2, 0xda,
-	0x1d, 0x53, 0x6f, 0xef, 0x59, 0xfb, 0x7b, 0xdd, 0x4e, 0x73, 0x5b, 0x6f, 0xe9, 0x4d, 0xad, 0xcc,
-	0xc0, 0xc7, 0x40, 0xba, 0x11, 0x6d, 0xe9, 0x46, 0xd7, 0xb4, 0x3a, 0x8d, 0xae, 0x69, 0x99, 0x3b,
-	0x4d, 0xab, 0xd3, 0xee, 0x9a, 0x65, 0x16, 0x56, 0xc0, 0x83, 0x1b, 0x2c, 0xad, 0xd9, 0xd0, 0x76,
-	0xf5, 0xbd, 0x66, 0x39, 0xb7, 0x5e, 0x78, 0xf7, 0x51, 0x60, 0x54, 0xfd, 0xec, 0x9b, 0xc0, 0x9c,
-	0xcd, 0x04, 0xf6, 0x62, 0x26, 0xb0, 0x97, 0x33, 0x81, 0x7d, 0x7f, 0x25, 0x30, 0x17, 0x57, 0x02,
-	0xf3, 0xf9, 0x4a, 0x60, 0x5e, 0x3f, 0xcb, 0xa8, 0x0e, 0x67, 0x5a, 0x1b, 0xd8, 0x0e, 0x89, 0x4e,
-	0xca, 0x71, 0xe6, 0x6f, 0x15, 0xc9, 0x77, 0x8a, 0x91, 0x4b, 0x9f, 0xff, 0x0c, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x0b, 0x28, 0x7d, 0xcc, 0x04, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xb6, 0xd3, 0x6c, 0xb7, 0x9d, 0x34, 0xd9, 0x74, 0x28, 0x95, 0x53, 0x21, 0xdb, 0x5a, 0x60,
+	0x65, 0xb1, 0x8a, 0xcd, 0x86, 0x1b, 0xb7, 0x38, 0x1f, 0x6a, 0xa4, 0xd0, 0x44, 0x8e, 0x17, 0x69,
+	0xb9, 0x58, 0xfe, 0x18, 0x52, 0x2b, 0xb6, 0xc7, 0x78, 0x9c, 0xb0, 0xf9, 0x07, 0x1c, 0x39, 0xee,
+	0x11, 0x89, 0x1b, 0xe7, 0xde, 0xf8, 0x03, 0xd5, 0x9e, 0x2a, 0x4e, 0x88, 0x43, 0x0a, 0xe9, 0x85,
+	0xdf, 0xc0, 0x09, 0x8d, 0x3f, 0x12, 0x17, 0x8a, 0x14, 0x21, 0xed, 0x29, 0x9e, 0xe7, 0x7d, 0x9f,
+	0xf7, 0xe3, 0x99, 0x67, 0x14, 0xf0, 0x6c, 0x66, 0x2e, 0x4c, 0xc5, 0xc6, 0xbe, 0xef, 0xc6, 0x31,
+	0x42, 0xca, 0xe2, 0x85, 0x85, 0x62, 0xf3, 0xc5, 0x16, 0x91, 0xc3, 0x08, 0xc7, 0x18, 0x9e, 0xd2,
+	0x3c, 0x79, 0x8b, 0x66, 0x79, 0x67, 0xbc, 0x8d, 0x89, 0x8f, 0x89, 0x62, 0x99, 0xa4, 0x48, 0x76,
+	0x83, 0x94, 0x77, 0xd6, 0x48, 0xe3, 0x46, 0x72, 0x52, 0xd2, 0x43, 0x16, 0x3a, 0x99, 0xe2, 0x29,
+	0x4e, 0x71, 0xfa, 0x95, 0x13, 0xa6, 0x18, 0x4f, 0x3d, 0xa4, 0x24, 0x27, 0x6b, 0xfe, 0xb5, 0x62,
+	0x06, 0xcb, 0x2c, 0xc4, 0xff, 0x33, 0xe4, 0xcc, 0x23, 0x33, 0x76, 0x71, 0xd6, 0xeb, 0xe9, 0xcf,
+	0x8f, 0x40, 0x55, 0x35, 0x09, 0xea, 0xe4, 0x53, 0xc2, 0x53, 0x50, 0x72, 0x1d, 0x8e, 0x15, 0x59,
+	0xa9, 0xac, 0xee, 0xaf, 0x57, 0x42, 0x69, 0xd0, 0xd5, 0x4a, 0xae, 0x03, 0x45, 0x50, 0x71, 0x10,
+	0xb1, 0x23, 0x37, 0xa4, 0x74, 0xae, 0x24, 0xb2, 0xd2, 0xa1, 0x56, 0x84, 0xa0, 0x05, 0x1e, 0xfb,
+	0xc8, 0xb7, 0x50, 0x44, 0xb8, 0x3d, 0x71, 0x4f, 0x3a, 0x52, 0xcf, 0xff, 0x5a, 0x09, 0xcd, 0xa9,
+	0x1b, 0x5f, 0xce, 0x2d, 0x2a, 0x43, 0xb6, 0x4a, 0xf6, 0xd3, 0x24, 0xce, 0x4c, 0x89, 0x97, 0x21,
+	0x22, 0x72, 0xdb, 0xb6, 0xdb, 0x8e, 0x13, 0x21, 0x42, 0x7e, 0xb9, 0x6a, 0xbe, 0x97, 0x2d, 0x9c,
+	0x21, 0xea, 0x32, 0x46, 0x44, 0xcb, 0x0b, 0xc3, 0x3e, 0xa8, 0x84, 0x28, 0xf2, 0x5d, 0x42, 0x5c,
+	0x1c, 0x10, 0xae, 0x2c, 0xee, 0x49, 0x95, 0xd6, 0x89, 0x9c, 0x6e, 0x29, 0xe7, 0x5b, 0xca, 0xed,
+	0x60, 0xa9, 0xd6, 0xde, 0x5e, 0x35, 0xc1, 0x78, 0x93, 0xac, 0x15, 0x89, 0xf0, 0x25, 0xa8, 0x2d,
+	0x70, 0x8c, 0x8c, 0xf8, 0x32, 0x42, 0xe4, 0x12, 0x7b, 0x0e, 0xf7, 0x88, 0x2e, 0xa4, 0xca, 0xd7,
+	0x2b, 0x81, 0xf9, 0x6d, 0x25, 0x3c, 0xdb, 0x61, 0xec, 0x2e, 0xb2, 0xb5, 0x2a, 0xad, 0xa2, 0xe7,
+	0x45, 0xe0, 0x18, 0x1c, 0x87, 0x11, 0x0e, 0x31, 0x31, 0x3d, 0x23, 0x57, 0x9a, 0xdb, 0x17, 0x59,
+	0xa9, 0xd2, 0x6a, 0xfc, 0x6b, 0xc8, 0x6e, 0x96, 0xa0, 0x1e, 0xd0, 0xa6, 0x6f, 0x6e, 0x05, 0x56,
+	0xab, 0xe7, 0xec, 0x3c, 0x06, 0xfb, 0xe0, 0x28, 0x36, 0x3d, 0x6f, 0x69, 0xe0, 0x54, 0xf7, 0xc7,
+	0x22, 0x2b, 0xd5, 0x5a, 0x1f, 0xca, 0x0f, 0x7b, 0x4b, 0xd6, 0x69, 0xee, 0x28, 0x49, 0xd5, 0x2a,
+	0xf1, 0xf6, 0x00, 0x17, 0xa0, 0xbe, 0x9d, 0x0c, 0x85, 0x98, 0xb8, 0x31, 0x77, 0x90, 0xa8, 0xd7,
+	0x90, 0x33, 0xc5, 0xa9, 0x1f, 0x37, 0x85, 0x3a, 0xd8, 0x0d, 0xd4, 0x4f, 0xe9, 0x60, 0x3f, 0xdd,
+	0x0a, 0xd2, 0x0e, 0x6a, 0x50, 0x02, 0xd1, 0x9e, 0x6c, 0x16, 0x48, 0x7b, 0xc0, 0x73, 0x50, 0x5d,
+	0xa0, 0x18, 0x1b, 0x36, 0xc6, 0x9e, 0x83, 0xbf, 0x0d, 0xb8, 0xc3, 0xdd, 0xd5, 0x38, 0xa2, 0xcc,
+	0x4e, 0x46, 0xfc, 0xfc, 0xf8, 0xcd, 0x0f, 0x02, 0xf3, 0xf6, 0xaa, 0x79, 0xb8, 0xf1, 0xea, 0xd3,
+	0xd7, 0xe0, 0xc9, 0x17, 0x89, 0x31, 0xb6, 0xf6, 0xd5, 0x40, 0x8d, 0xee, 0x61, 0x6c, 0xa4, 0x49,
+	0xac, 0x5c, 0x69, 0x7d, 0xfc, 0x5f, 0x8a, 0xdd, 0x73, 0xbf, 0x5a, 0xbe, 0x59, 0x09, 0xac, 0x56,
+	0xb5, 0x8a, 0xe0, 0x43, 0x9d, 0xff, 0x2c, 0x81, 0x9a, 0x8e, 0x67, 0x28, 0x78, 0xa7, 0x9d, 0x61,
+	0x1f, 0xec, 0x7f, 0x33, 0xc7, 0xd1, 0xdc, 0xe7, 0x4a, 0xff, 0xcb, 0x9e, 0x19, 0x1b, 0x0a, 0x20,
+	0x35, 0x83, 0xe1, 0xa0, 0x00, 0xfb, 0xdc, 0x5e, 0xf2, 0x78, 0x41, 0x02, 0x75, 0x29, 0x02, 0x9f,
+	0x83, 0x63, 0x1b, 0x07, 0x0b, 0xd7, 0xa6, 0x57, 0x60, 0x2c, 0x70, 0xec, 0x06, 0x53, 0xae, 0x2c,
+	0xb2, 0xd2, 0x81, 0x56, 0xdf, 0x06, 0xbe, 0x4c, 0x70, 0xf8, 0x0a, 0x9c, 0x16, 0x92, 0x3d, 0x6c,
+	0xcf, 0x8c, 0x10, 0x45, 0x2e, 0x4e, 0x1f, 0xd1, 0x8e, 0x97, 0x7b, 0xb2, 0x2d, 0x31, 0xc4, 0xf6,
+	0x6c, 0x9c, 0x14, 0x78, 0x40, 0xea, 0x4f, 0x22, 0x50, 0x29, 0xb8, 0x1a, 0x7e, 0x00, 0x38, 0xbd,
+	0x3d, 0x1c, 0xbe, 0x32, 0x46, 0x63, 0x7d, 0x30, 0xba, 0x30, 0x5e, 0x5e, 0x4c, 0xc6, 0xbd, 0xce,
+	0xa0, 0x3f, 0xe8, 0x75, 0xeb, 0x0c, 0xfc, 0x08, 0x88, 0xf7, 0xa2, 0xfd, 0x81, 0x36, 0xd1, 0x8d,
+	0x71, 0x7b, 0xa2, 0x1b, 0xfa, 0x79, 0xcf, 0x18, 0x8f, 0x26, 0x7a, 0x9d, 0x85, 0x0d, 0xf0, 0xfe,
+	0xbd, 0xac, 0x6e, 0xaf, 0xdd, 0x1d, 0x0e, 0x2e, 0x7a, 0xf5, 0xd2, 0x59, 0xf9, 0xbb, 0x1f, 0x79,
+	0x46, 0x1d, 0x5c, 0xff, 0xc1, 0x33, 0xd7, 0x6b, 0x9e, 0xbd, 0x59, 0xf3, 0xec, 0xef, 0x6b, 0x9e,
+	0xfd, 0xfe, 0x8e, 0x67, 0x6e, 0xee, 0x78, 0xe6, 0xd7, 0x3b, 0x9e, 0xf9, 0xea, 0x79, 0x41, 0x7d,
+	0x7a, 0xb7, 0x4d, 0xcf, 0xb4, 0x48, 0xf2, 0xa5, 0xbc, 0x2e, 0xfc, 0x2f, 0x24, 0xd7, 0x60, 0xed,
+	0x27, 0x22, 0x7c, 0xf6, 0xf7, 0x00, 0x63, 0xcd, 0x44, 0x1d, 0x36, 0x06, 0x00, 0x00,
 }
 
 func (m *BaseCommittee) Marshal() (dAtA []byte, err error) {
@@ -259,17 +276,39 @@ func (m *BaseCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VetoCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VetoCooldown):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCommittee(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ProposalDeposit) > 0 {
+		for iNdEx := len(m.ProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommittee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.TallyOption != 0 {
 		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
 		i--
 		dAtA[i] = 0x38
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposalDuration):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposalDuration):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintCommittee(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintCommittee(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -375,6 +414,24 @@ func (m *TokenCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ConvictionLockPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ConvictionLockPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCommittee(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.ConvictionVoting {
+		i--
+		if m.ConvictionVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.TallyDenom) > 0 {
 		i -= len(m.TallyDenom)
 		copy(dAtA[i:], m.TallyDenom)
@@ -450,6 +507,14 @@ func (m *BaseCommittee) Size() (n int) {
 	if m.TallyOption != 0 {
 		n += 1 + sovCommittee(uint64(m.TallyOption))
 	}
+	if len(m.ProposalDeposit) > 0 {
+		for _, e := range m.ProposalDeposit {
+			l = e.Size()
+			n += 1 + l + sovCommittee(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VetoCooldown)
+	n += 1 + l + sovCommittee(uint64(l))
 	return n
 }
 
@@ -482,6 +547,11 @@ func (m *TokenCommittee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommittee(uint64(l))
 	}
+	if m.ConvictionVoting {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ConvictionLockPeriod)
+	n += 1 + l + sovCommittee(uint64(l))
 	return n
 }
 
@@ -723,6 +793,73 @@ func (m *BaseCommittee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalDeposit = append(m.ProposalDeposit, types1.Coin{})
+			if err := m.ProposalDeposit[len(m.ProposalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VetoCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
@@ -961,6 +1098,59 @@ func (m *TokenCommittee) Unmarshal(dAtA []byte) error {
 			}
 			m.TallyDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConvictionVoting = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionLockPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ConvictionLockPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxConviction is the highest conviction a vote can be cast with.
+const MaxConviction = CONVICTION_LOCKED_6X
+
+// Validate checks the conviction is one of the defined convictions.
+func (c Conviction) Validate() error {
+	if c < CONVICTION_NONE || c > MaxConviction {
+		return fmt.Errorf("invalid conviction: %d", c)
+	}
+	return nil
+}
+
+// Multiplier returns the vote weight multiplier of the conviction. Votes without conviction are weighted at 0.1x.
+func (c Conviction) Multiplier() sdk.Dec {
+	if c == CONVICTION_NONE {
+		return sdk.NewDecWithPrec(1, 1)
+	}
+	return sdk.NewDec(int64(c))
+}
+
+// LockDuration returns how long tokens are locked for after the proposal deadline. Each conviction doubles the lock
+// period of the conviction below it, votes without conviction are only locked until the proposal deadline.
+func (c Conviction) LockDuration(lockPeriod time.Duration) time.Duration {
+	if c == CONVICTION_NONE {
+		return 0
+	}
+	return lockPeriod * time.Duration(int64(1)<<(c-1))
+}
+
+// NewVoteLock returns a new record of tokens locked by a conviction vote.
+func NewVoteLock(voter sdk.AccAddress, proposalID uint64, amount sdk.Coin, unlockTime time.Time) VoteLock {
+	return VoteLock{
+		Voter:      voter,
+		ProposalID: proposalID,
+		Amount:     amount,
+		UnlockTime: unlockTime,
+	}
+}
+
+// Validate performs a basic validation of the vote lock fields.
+func (l VoteLock) Validate() error {
+	if l.Voter.Empty() {
+		return fmt.Errorf("vote lock voter cannot be empty")
+	}
+	if !l.Amount.IsValid() || !l.Amount.IsPositive() {
+		return fmt.Errorf("invalid vote lock amount: %s", l.Amount)
+	}
+	if l.UnlockTime.IsZero() {
+		return fmt.Errorf("vote lock unlock time cannot be zero")
+	}
+	return nil
+}
+
+// VoteLocks a collection of VoteLock objects
+type VoteLocks []VoteLock
+
+// Validate validates each vote lock and checks that there is only one lock per voter, proposal and unlock time
+func (ls VoteLocks) Validate() error {
+	seen := make(map[string]bool)
+	for _, l := range ls {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		key := string(GetVoteLockKey(l.UnlockTime, l.ProposalID, l.Voter))
+		if seen[key] {
+			return fmt.Errorf("duplicate vote lock for voter %s on proposal %d", l.Voter, l.ProposalID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// TotalAmount returns the total coins locked.
+func (ls VoteLocks) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, l := range ls {
+		total = total.Add(l.Amount)
+	}
+	return total
+}
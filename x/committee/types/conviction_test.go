@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/testutil"
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+func TestConviction(t *testing.T) {
+	lockPeriod := time.Hour
+	tests := []struct {
+		conviction   types.Conviction
+		multiplier   sdk.Dec
+		lockDuration time.Duration
+	}{
+		{types.CONVICTION_NONE, testutil.D("0.1"), 0},
+		{types.CONVICTION_LOCKED_1X, testutil.D("1"), time.Hour},
+		{types.CONVICTION_LOCKED_2X, testutil.D("2"), 2 * time.Hour},
+		{types.CONVICTION_LOCKED_3X, testutil.D("3"), 4 * time.Hour},
+		{types.CONVICTION_LOCKED_4X, testutil.D("4"), 8 * time.Hour},
+		{types.CONVICTION_LOCKED_5X, testutil.D("5"), 16 * time.Hour},
+		{types.CONVICTION_LOCKED_6X, testutil.D("6"), 32 * time.Hour},
+	}
+	for _, tc := range tests {
+		t.Run(tc.conviction.String(), func(t *testing.T) {
+			require.NoError(t, tc.conviction.Validate())
+			require.Equal(t, tc.multiplier, tc.conviction.Multiplier())
+			require.Equal(t, tc.lockDuration, tc.conviction.LockDuration(lockPeriod))
+		})
+	}
+	require.Error(t, (types.MaxConviction + 1).Validate())
+}
+
+func TestVoteLocks_Validate(t *testing.T) {
+	testTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2"))),
+	}
+
+	tests := []struct {
+		name       string
+		locks      types.VoteLocks
+		expectPass bool
+	}{
+		{
+			name: "normal",
+			locks: types.VoteLocks{
+				types.NewVoteLock(addresses[0], 1, testutil.C("hard", 10), testTime),
+				types.NewVoteLock(addresses[1], 1, testutil.C("hard", 10), testTime),
+				types.NewVoteLock(addresses[0], 1, testutil.C("hard", 10), testTime.Add(time.Hour)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "duplicate lock",
+			locks: types.VoteLocks{
+				types.NewVoteLock(addresses[0], 1, testutil.C("hard", 10), testTime),
+				types.NewVoteLock(addresses[0], 1, testutil.C("hard", 20), testTime),
+			},
+			expectPass: false,
+		},
+		{
+			name:       "empty voter",
+			locks:      types.VoteLocks{types.NewVoteLock(nil, 1, testutil.C("hard", 10), testTime)},
+			expectPass: false,
+		},
+		{
+			name:       "zero amount",
+			locks:      types.VoteLocks{types.NewVoteLock(addresses[0], 1, testutil.C("hard", 0), testTime)},
+			expectPass: false,
+		},
+		{
+			name:       "zero unlock time",
+			locks:      types.VoteLocks{types.NewVoteLock(addresses[0], 1, testutil.C("hard", 10), time.Time{})},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.locks.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -14,4 +14,7 @@ var (
 	ErrUnknownSubspace         = errorsmod.Register(ModuleName, 10, "subspace not found")
 	ErrInvalidVoteType         = errorsmod.Register(ModuleName, 11, "invalid vote type")
 	ErrNotFoundProposalTally   = errorsmod.Register(ModuleName, 12, "proposal tally not found")
+	ErrInvalidConvictionVote   = errorsmod.Register(ModuleName, 13, "invalid conviction vote")
+	ErrProposalVetoed          = errorsmod.Register(ModuleName, 14, "proposal was vetoed")
+	ErrCannotCancelProposal    = errorsmod.Register(ModuleName, 15, "proposal cannot be cancelled")
 )
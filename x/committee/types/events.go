@@ -5,6 +5,8 @@ const (
 	EventTypeProposalSubmit = "proposal_submit"
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
+	EventTypeVoteLock       = "vote_lock"
+	EventTypeVoteUnlock     = "vote_unlock"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
@@ -15,4 +17,6 @@ const (
 	AttributeKeyVote                = "vote"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
+	AttributeKeyUnlockTime          = "unlock_time"
+	AttributeKeyDeposit             = "deposit"
 )
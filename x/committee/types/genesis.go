@@ -11,16 +11,20 @@ import (
 const DefaultNextProposalID uint64 = 1
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(nextProposalID uint64, committees []Committee, proposals Proposals, votes []Vote) *GenesisState {
+func NewGenesisState(nextProposalID uint64, committees []Committee, proposals Proposals, votes []Vote, voteLocks VoteLocks,
+	vetoedProposals VetoedProposals,
+) *GenesisState {
 	packedCommittees, err := PackCommittees(committees)
 	if err != nil {
 		panic(err)
 	}
 	return &GenesisState{
-		NextProposalID: nextProposalID,
-		Committees:     packedCommittees,
-		Proposals:      proposals,
-		Votes:          votes,
+		NextProposalID:  nextProposalID,
+		Committees:      packedCommittees,
+		Proposals:       proposals,
+		Votes:           votes,
+		VoteLocks:       voteLocks,
+		VetoedProposals: vetoedProposals,
 	}
 }
 
@@ -31,6 +35,8 @@ func DefaultGenesisState() *GenesisState {
 		Committees{},
 		Proposals{},
 		[]Vote{},
+		VoteLocks{},
+		VetoedProposals{},
 	)
 }
 
@@ -103,6 +109,11 @@ func (gs GenesisState) Validate() error {
 		if err := p.ValidateBasic(); err != nil {
 			return fmt.Errorf("proposal %d invalid: %w", p.ID, err)
 		}
+
+		// validate deposit
+		if err := p.Deposit.Validate(); err != nil {
+			return fmt.Errorf("proposal %d invalid deposit: %w", p.ID, err)
+		}
 	}
 
 	// validate votes
@@ -117,7 +128,14 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("vote refers to non existent proposal; vote: %+v", v)
 		}
 	}
-	return nil
+
+	// validate vote locks, which are kept after their proposal closes
+	if err := gs.VoteLocks.Validate(); err != nil {
+		return err
+	}
+
+	// validate vetoed proposals, which are kept after their proposal closes
+	return gs.VetoedProposals.Validate()
 }
 
 // PackCommittees converts a committee slice to Any slice
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -42,6 +43,8 @@ const (
 	VOTE_TYPE_NO VoteType = 2
 	// VOTE_TYPE_ABSTAIN defines an abstain vote option.
 	VOTE_TYPE_ABSTAIN VoteType = 3
+	// VOTE_TYPE_NO_WITH_VETO defines a no vote option that vetoes the proposal.
+	VOTE_TYPE_NO_WITH_VETO VoteType = 4
 )
 
 var VoteType_name = map[int32]string{
@@ -49,13 +52,15 @@ var VoteType_name = map[int32]string{
 	1: "VOTE_TYPE_YES",
 	2: "VOTE_TYPE_NO",
 	3: "VOTE_TYPE_ABSTAIN",
+	4: "VOTE_TYPE_NO_WITH_VETO",
 }
 
 var VoteType_value = map[string]int32{
-	"VOTE_TYPE_UNSPECIFIED": 0,
-	"VOTE_TYPE_YES":         1,
-	"VOTE_TYPE_NO":          2,
-	"VOTE_TYPE_ABSTAIN":     3,
+	"VOTE_TYPE_UNSPECIFIED":  0,
+	"VOTE_TYPE_YES":          1,
+	"VOTE_TYPE_NO":           2,
+	"VOTE_TYPE_ABSTAIN":      3,
+	"VOTE_TYPE_NO_WITH_VETO": 4,
 }
 
 func (x VoteType) String() string {
@@ -66,12 +71,62 @@ func (VoteType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_919b27ac60d8c5fd, []int{0}
 }
 
+// Conviction enumerates the lock periods a conviction vote can be cast with.
+type Conviction int32
+
+const (
+	// CONVICTION_NONE locks tokens until the proposal deadline, with 0.1x vote weight.
+	CONVICTION_NONE Conviction = 0
+	// CONVICTION_LOCKED_1X locks tokens for 1 lock period after the proposal deadline, with 1x vote weight.
+	CONVICTION_LOCKED_1X Conviction = 1
+	// CONVICTION_LOCKED_2X locks tokens for 2 lock periods after the proposal deadline, with 2x vote weight.
+	CONVICTION_LOCKED_2X Conviction = 2
+	// CONVICTION_LOCKED_3X locks tokens for 4 lock periods after the proposal deadline, with 3x vote weight.
+	CONVICTION_LOCKED_3X Conviction = 3
+	// CONVICTION_LOCKED_4X locks tokens for 8 lock periods after the proposal deadline, with 4x vote weight.
+	CONVICTION_LOCKED_4X Conviction = 4
+	// CONVICTION_LOCKED_5X locks tokens for 16 lock periods after the proposal deadline, with 5x vote weight.
+	CONVICTION_LOCKED_5X Conviction = 5
+	// CONVICTION_LOCKED_6X locks tokens for 32 lock periods after the proposal deadline, with 6x vote weight.
+	CONVICTION_LOCKED_6X Conviction = 6
+)
+
+var Conviction_name = map[int32]string{
+	0: "CONVICTION_NONE",
+	1: "CONVICTION_LOCKED_1X",
+	2: "CONVICTION_LOCKED_2X",
+	3: "CONVICTION_LOCKED_3X",
+	4: "CONVICTION_LOCKED_4X",
+	5: "CONVICTION_LOCKED_5X",
+	6: "CONVICTION_LOCKED_6X",
+}
+
+var Conviction_value = map[string]int32{
+	"CONVICTION_NONE":      0,
+	"CONVICTION_LOCKED_1X": 1,
+	"CONVICTION_LOCKED_2X": 2,
+	"CONVICTION_LOCKED_3X": 3,
+	"CONVICTION_LOCKED_4X": 4,
+	"CONVICTION_LOCKED_5X": 5,
+	"CONVICTION_LOCKED_6X": 6,
+}
+
+func (x Conviction) String() string {
+	return proto.EnumName(Conviction_name, int32(x))
+}
+
+func (Conviction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_919b27ac60d8c5fd, []int{1}
+}
+
 // GenesisState defines the committee module's genesis state.
 type GenesisState struct {
-	NextProposalID uint64       `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
-	Committees     []*types.Any `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
-	Proposals      Proposals    `protobuf:"bytes,3,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
-	Votes          []Vote       `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	NextProposalID  uint64          `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
+	Committees      []*types.Any    `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	Proposals       Proposals       `protobuf:"bytes,3,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
+	Votes           []Vote          `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	VoteLocks       VoteLocks       `protobuf:"bytes,5,rep,name=vote_locks,json=voteLocks,proto3,castrepeated=VoteLocks" json:"vote_locks"`
+	VetoedProposals VetoedProposals `protobuf:"bytes,6,rep,name=vetoed_proposals,json=vetoedProposals,proto3,castrepeated=VetoedProposals" json:"vetoed_proposals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,10 +164,13 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 // Proposal is an internal record of a governance proposal submitted to a committee.
 type Proposal struct {
-	Content     *types.Any `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	ID          uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CommitteeID uint64     `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
-	Deadline    time.Time  `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	Content     *types.Any                                    `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ID          uint64                                        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CommitteeID uint64                                        `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	Deadline    time.Time                                     `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	Proposer    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	// Deposit held in the committee module account until the proposal closes
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
@@ -152,6 +210,9 @@ type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 	VoteType   VoteType                                      `protobuf:"varint,3,opt,name=vote_type,json=voteType,proto3,enum=kava.committee.v1beta1.VoteType" json:"vote_type,omitempty"`
+	Conviction Conviction                                    `protobuf:"varint,4,opt,name=conviction,proto3,enum=kava.committee.v1beta1.Conviction" json:"conviction,omitempty"`
+	// Amount of tally denom locked by the voter, used to weight votes on conviction voting committees
+	LockedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=locked_amount,json=lockedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked_amount"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -187,11 +248,97 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// VoteLock is a record of tokens locked in the committee module account by a conviction vote.
+type VoteLock struct {
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	ProposalID uint64                                        `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Amount     types1.Coin                                   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	UnlockTime time.Time                                     `protobuf:"bytes,4,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *VoteLock) Reset()         { *m = VoteLock{} }
+func (m *VoteLock) String() string { return proto.CompactTextString(m) }
+func (*VoteLock) ProtoMessage()    {}
+func (*VoteLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_919b27ac60d8c5fd, []int{3}
+}
+func (m *VoteLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteLock.Merge(m, src)
+}
+func (m *VoteLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteLock proto.InternalMessageInfo
+
+// VetoedProposal is a record of a vetoed proposal that blocks the proposal from being resubmitted to the committee
+// until the cooldown ends.
+type VetoedProposal struct {
+	CommitteeID uint64 `protobuf:"varint,1,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	// SHA-256 hash of the proposal content
+	ContentHash []byte    `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	CooldownEnd time.Time `protobuf:"bytes,3,opt,name=cooldown_end,json=cooldownEnd,proto3,stdtime" json:"cooldown_end"`
+}
+
+func (m *VetoedProposal) Reset()         { *m = VetoedProposal{} }
+func (m *VetoedProposal) String() string { return proto.CompactTextString(m) }
+func (*VetoedProposal) ProtoMessage()    {}
+func (*VetoedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_919b27ac60d8c5fd, []int{4}
+}
+func (m *VetoedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VetoedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VetoedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VetoedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoedProposal.Merge(m, src)
+}
+func (m *VetoedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *VetoedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VetoedProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("kava.committee.v1beta1.VoteType", VoteType_name, VoteType_value)
+	proto.RegisterEnum("kava.committee.v1beta1.Conviction", Conviction_name, Conviction_value)
 	proto.RegisterType((*GenesisState)(nil), "kava.committee.v1beta1.GenesisState")
 	proto.RegisterType((*Proposal)(nil), "kava.committee.v1beta1.Proposal")
 	proto.RegisterType((*Vote)(nil), "kava.committee.v1beta1.Vote")
+	proto.RegisterType((*VoteLock)(nil), "kava.committee.v1beta1.VoteLock")
+	proto.RegisterType((*VetoedProposal)(nil), "kava.committee.v1beta1.VetoedProposal")
 }
 
 func init() {
@@ -199,48 +346,72 @@ func init() {
 }
 
 var fileDescriptor_919b27ac60d8c5fd = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x1d, 0x53, 0x92, 0x4b, 0x1a, 0xd2, 0xa3, 0xad, 0xd2, 0x08, 0xd9, 0x55, 0xc5, 0x50,
-	0x81, 0x62, 0xab, 0x65, 0x41, 0x15, 0x48, 0xc4, 0x49, 0x00, 0x2f, 0x69, 0x71, 0x42, 0xa5, 0x32,
-	0x10, 0x39, 0xf1, 0x61, 0xac, 0x26, 0xbe, 0x28, 0x77, 0x8d, 0x9a, 0x6f, 0xd0, 0xb1, 0x23, 0x23,
-	0x12, 0x4c, 0xcc, 0xfd, 0x10, 0x55, 0xa7, 0x8a, 0x89, 0x01, 0xb9, 0xc8, 0xfd, 0x06, 0x8c, 0x4c,
-	0xe8, 0xce, 0x7f, 0x12, 0x51, 0x3a, 0xf9, 0xdd, 0x7b, 0xbf, 0xf7, 0xe7, 0xf7, 0x7b, 0x4f, 0x06,
-	0x0f, 0x0f, 0xed, 0x89, 0xad, 0xf7, 0xf1, 0x70, 0xe8, 0x51, 0x8a, 0x90, 0x3e, 0xd9, 0xea, 0x21,
-	0x6a, 0x6f, 0xe9, 0x2e, 0xf2, 0x11, 0xf1, 0x88, 0x36, 0x1a, 0x63, 0x8a, 0xe1, 0x2a, 0x43, 0x69,
-	0x29, 0x4a, 0x8b, 0x51, 0x95, 0xb5, 0x3e, 0x26, 0x43, 0x4c, 0xba, 0x1c, 0xa5, 0x47, 0x8f, 0x28,
-	0xa5, 0xb2, 0xec, 0x62, 0x17, 0x47, 0x7e, 0x66, 0xc5, 0xde, 0x35, 0x17, 0x63, 0x77, 0x80, 0x74,
-	0xfe, 0xea, 0x1d, 0x7d, 0xd0, 0x6d, 0x7f, 0x1a, 0x87, 0xd4, 0x7f, 0x43, 0xd4, 0x1b, 0x22, 0x42,
-	0xed, 0xe1, 0x28, 0x02, 0x6c, 0x7c, 0x95, 0x40, 0xe1, 0x55, 0x34, 0x56, 0x9b, 0xda, 0x14, 0xc1,
-	0x67, 0xa0, 0xe4, 0xa3, 0x63, 0xca, 0xba, 0x8f, 0x30, 0xb1, 0x07, 0x5d, 0xcf, 0x29, 0x8b, 0xeb,
-	0xe2, 0xa6, 0x6c, 0xc0, 0x30, 0x50, 0x8b, 0x2d, 0x74, 0x4c, 0xf7, 0xe2, 0x90, 0xd9, 0xb0, 0x8a,
-	0xfe, 0xfc, 0xdb, 0x81, 0x75, 0x00, 0x52, 0x42, 0xa4, 0x2c, 0xad, 0x67, 0x36, 0xf3, 0xdb, 0xcb,
-	0x5a, 0x34, 0x84, 0x96, 0x0c, 0xa1, 0xd5, 0xfc, 0xa9, 0xb1, 0x78, 0x71, 0x56, 0xcd, 0xd5, 0x13,
-	0xac, 0x35, 0x97, 0x06, 0xdf, 0x80, 0x5c, 0xd2, 0x9d, 0x94, 0x33, 0xbc, 0xc6, 0xba, 0xf6, 0x7f,
-	0xb1, 0xb4, 0xa4, 0xb7, 0xb1, 0x74, 0x1e, 0xa8, 0xc2, 0xb7, 0x2b, 0x35, 0x97, 0x78, 0x88, 0x35,
-	0xab, 0x02, 0x9f, 0x82, 0x3b, 0x13, 0x4c, 0x11, 0x29, 0xcb, 0xbc, 0xdc, 0x83, 0xdb, 0xca, 0xed,
-	0x63, 0x8a, 0x0c, 0x99, 0x95, 0xb2, 0xa2, 0x84, 0x1d, 0xf9, 0xe4, 0xb3, 0x2a, 0x6c, 0xfc, 0x16,
-	0x41, 0x36, 0x29, 0x0c, 0x5b, 0xe0, 0x6e, 0x1f, 0xfb, 0x14, 0xf9, 0x94, 0x2b, 0x73, 0x1b, 0x43,
-	0xe5, 0xe2, 0xac, 0x5a, 0x89, 0xd7, 0xe7, 0xe2, 0x49, 0xda, 0xa3, 0x1e, 0xe5, 0x5a, 0x49, 0x11,
-	0xb8, 0x0a, 0x24, 0xcf, 0x29, 0x4b, 0x5c, 0xe4, 0x85, 0x30, 0x50, 0x25, 0xb3, 0x61, 0x49, 0x9e,
-	0x03, 0xb7, 0x41, 0x21, 0x9d, 0x90, 0xad, 0x21, 0xc3, 0x11, 0xf7, 0xc2, 0x40, 0xcd, 0xa7, 0xc2,
-	0x99, 0x0d, 0x2b, 0x9f, 0x82, 0x4c, 0x07, 0xbe, 0x00, 0x59, 0x07, 0xd9, 0xce, 0xc0, 0xf3, 0x51,
-	0x59, 0xe6, 0xc3, 0x55, 0x6e, 0x0c, 0xd7, 0x49, 0x6e, 0xc0, 0xc8, 0x32, 0xa6, 0xa7, 0x57, 0xaa,
-	0x68, 0xa5, 0x59, 0x3b, 0x59, 0x46, 0xf8, 0x13, 0x23, 0xfd, 0x53, 0x04, 0x32, 0x13, 0x04, 0xea,
-	0x20, 0x7f, 0xf3, 0x1c, 0x8a, 0x61, 0xa0, 0x82, 0xb9, 0x53, 0x00, 0xa3, 0xd9, 0x19, 0xbc, 0x8f,
-	0xe4, 0x1e, 0x73, 0x52, 0x05, 0xe3, 0xf5, 0x9f, 0x40, 0xad, 0xba, 0x1e, 0xfd, 0x78, 0xd4, 0x63,
-	0x9a, 0xc7, 0x37, 0x1d, 0x7f, 0xaa, 0xc4, 0x39, 0xd4, 0xe9, 0x74, 0x84, 0x88, 0x56, 0xeb, 0xf7,
-	0x6b, 0x8e, 0x33, 0x46, 0x84, 0x7c, 0x3f, 0xab, 0xde, 0x8f, 0xa5, 0x8b, 0x3d, 0xc6, 0x94, 0x22,
-	0x12, 0x2d, 0x65, 0x0c, 0x9f, 0x83, 0x1c, 0x33, 0xba, 0x2c, 0x8d, 0xcb, 0x52, 0xbc, 0xfd, 0x42,
-	0x18, 0x83, 0xce, 0x74, 0x84, 0xac, 0xec, 0x24, 0xb6, 0xa2, 0x9d, 0x3e, 0x72, 0x41, 0x36, 0x89,
-	0xc1, 0x35, 0xb0, 0xb2, 0xbf, 0xdb, 0x69, 0x76, 0x3b, 0x07, 0x7b, 0xcd, 0xee, 0xdb, 0x56, 0x7b,
-	0xaf, 0x59, 0x37, 0x5f, 0x9a, 0xcd, 0x46, 0x49, 0x80, 0x4b, 0x60, 0x71, 0x16, 0x3a, 0x68, 0xb6,
-	0x4b, 0x22, 0x2c, 0x81, 0xc2, 0xcc, 0xd5, 0xda, 0x2d, 0x49, 0x70, 0x05, 0x2c, 0xcd, 0x3c, 0x35,
-	0xa3, 0xdd, 0xa9, 0x99, 0xad, 0x52, 0xa6, 0x22, 0x9f, 0x7c, 0x51, 0x04, 0xa3, 0x79, 0x1e, 0x2a,
-	0xe2, 0x65, 0xa8, 0x88, 0xbf, 0x42, 0x45, 0x3c, 0xbd, 0x56, 0x84, 0xcb, 0x6b, 0x45, 0xf8, 0x71,
-	0xad, 0x08, 0xef, 0x1e, 0xcf, 0x89, 0xc2, 0xc6, 0xaf, 0x0e, 0xec, 0x1e, 0xe1, 0x96, 0x7e, 0x3c,
-	0xf7, 0xff, 0xe0, 0xea, 0xf4, 0x16, 0xf8, 0x02, 0x9f, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x6a,
-	0x30, 0x5b, 0x09, 0x5e, 0x04, 0x00, 0x00,
+	// 1034 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x49, 0xd9, 0x91, 0x4f, 0x8a, 0x4c, 0x5f, 0x1c, 0x97, 0x16, 0x0a, 0xd1, 0x35, 0x8a,
+	0xc0, 0x48, 0x21, 0xb2, 0x76, 0xfa, 0x0b, 0x41, 0x0a, 0x54, 0x94, 0xd9, 0x98, 0x68, 0x20, 0xb9,
+	0xb4, 0xea, 0x2a, 0x1d, 0x4a, 0x50, 0xe2, 0x55, 0x66, 0x2d, 0xf1, 0x04, 0xdf, 0x59, 0xb5, 0xe7,
+	0x2c, 0x19, 0x33, 0x76, 0x6c, 0xd1, 0xad, 0xb3, 0xd1, 0xb5, 0x43, 0x97, 0x20, 0x53, 0x90, 0xa9,
+	0xe8, 0xa0, 0x14, 0x72, 0xff, 0x8a, 0x4e, 0xc5, 0x91, 0x47, 0x52, 0x8e, 0x65, 0xd7, 0x01, 0xda,
+	0xc9, 0x77, 0xef, 0xbd, 0xef, 0xe3, 0x7b, 0xef, 0x7b, 0xef, 0x2c, 0xf0, 0xf6, 0xbe, 0x3b, 0x74,
+	0xf5, 0x0e, 0xee, 0xf7, 0x7d, 0x4a, 0x11, 0xd2, 0x87, 0xeb, 0x6d, 0x44, 0xdd, 0x75, 0xbd, 0x8b,
+	0x02, 0x44, 0x7c, 0xa2, 0x0d, 0x0e, 0x30, 0xc5, 0x70, 0x89, 0x45, 0x69, 0x49, 0x94, 0xc6, 0xa3,
+	0x4a, 0xe5, 0x0e, 0x26, 0x7d, 0x4c, 0xf4, 0xb6, 0x4b, 0x52, 0x68, 0x07, 0xfb, 0x41, 0x84, 0x2b,
+	0x2d, 0x47, 0x7e, 0x27, 0xbc, 0xe9, 0xd1, 0x85, 0xbb, 0x16, 0xbb, 0xb8, 0x8b, 0x23, 0x3b, 0x3b,
+	0xc5, 0x80, 0x2e, 0xc6, 0xdd, 0x1e, 0xd2, 0xc3, 0x5b, 0xfb, 0xf0, 0x1b, 0xdd, 0x0d, 0x8e, 0xb9,
+	0x4b, 0x7d, 0xd5, 0x45, 0xfd, 0x3e, 0x22, 0xd4, 0xed, 0x0f, 0xa2, 0x80, 0xd5, 0xbf, 0x24, 0x50,
+	0xb8, 0x1f, 0xa5, 0xbd, 0x43, 0x5d, 0x8a, 0xe0, 0x3d, 0x20, 0x07, 0xe8, 0x88, 0xb2, 0xaf, 0x0f,
+	0x30, 0x71, 0x7b, 0x8e, 0xef, 0x29, 0xc2, 0x8a, 0xb0, 0x96, 0x35, 0xe0, 0x78, 0xa4, 0x16, 0xeb,
+	0xe8, 0x88, 0x6e, 0x73, 0x97, 0xb5, 0x69, 0x17, 0x83, 0xc9, 0xbb, 0x07, 0x6b, 0x00, 0x24, 0x05,
+	0x13, 0x45, 0x5c, 0x91, 0xd6, 0xf2, 0x1b, 0x8b, 0x5a, 0x94, 0x84, 0x16, 0x27, 0xa1, 0x55, 0x83,
+	0x63, 0xe3, 0xfa, 0xb3, 0x93, 0xca, 0x5c, 0x2d, 0x8e, 0xb5, 0x27, 0x60, 0xf0, 0x73, 0x30, 0x17,
+	0x7f, 0x9d, 0x28, 0x52, 0xc8, 0xb1, 0xa2, 0x4d, 0x6f, 0xa6, 0x16, 0x7f, 0xdb, 0x58, 0x78, 0x3a,
+	0x52, 0x33, 0x3f, 0xbf, 0x54, 0xe7, 0x62, 0x0b, 0xb1, 0x53, 0x16, 0xf8, 0x11, 0x98, 0x19, 0x62,
+	0x8a, 0x88, 0x92, 0x0d, 0xe9, 0xde, 0xbc, 0x88, 0x6e, 0x17, 0x53, 0x64, 0x64, 0x19, 0x95, 0x1d,
+	0x01, 0xa0, 0x0d, 0x00, 0x3b, 0x38, 0x3d, 0xdc, 0xd9, 0x27, 0xca, 0xcc, 0xe5, 0xd9, 0x30, 0xf8,
+	0x03, 0xdc, 0xd9, 0x4f, 0xb3, 0x89, 0x2d, 0xc4, 0x9e, 0x1b, 0xc6, 0x47, 0xf8, 0x2d, 0x90, 0x87,
+	0x88, 0x62, 0xe4, 0x39, 0x69, 0x9d, 0xb3, 0x21, 0xf3, 0xad, 0x0b, 0x99, 0xc3, 0xf8, 0xa4, 0xda,
+	0x37, 0x38, 0xff, 0xfc, 0x59, 0x3b, 0xb1, 0xe7, 0x87, 0x67, 0x0d, 0x77, 0xb3, 0x8f, 0x7f, 0x50,
+	0x33, 0xab, 0xbf, 0x4a, 0x20, 0x17, 0xdb, 0x60, 0x1d, 0x5c, 0xeb, 0xe0, 0x80, 0xa2, 0x80, 0x86,
+	0xca, 0x5e, 0xa4, 0x50, 0xf9, 0xd9, 0x49, 0xa5, 0xc4, 0xc7, 0xaf, 0x8b, 0x87, 0x49, 0x2a, 0xb5,
+	0x08, 0x6b, 0xc7, 0x24, 0x70, 0x09, 0x88, 0xbe, 0xa7, 0x88, 0xe1, 0x90, 0xcc, 0x8e, 0x47, 0xaa,
+	0x68, 0x6d, 0xda, 0xa2, 0xef, 0xc1, 0x0d, 0x50, 0x48, 0x0a, 0x61, 0x63, 0x24, 0x85, 0x11, 0xf3,
+	0xe3, 0x91, 0x9a, 0x4f, 0x84, 0xb7, 0x36, 0xed, 0x7c, 0x12, 0x64, 0x79, 0xf0, 0x13, 0x90, 0xf3,
+	0x90, 0xeb, 0xf5, 0xfc, 0x00, 0x29, 0xd9, 0x30, 0xb9, 0xd2, 0xb9, 0xe4, 0x9a, 0xf1, 0x0c, 0x1b,
+	0x39, 0xd6, 0x86, 0x27, 0x2f, 0x55, 0xc1, 0x4e, 0x50, 0xd0, 0x03, 0xb9, 0xa8, 0xab, 0xe8, 0x40,
+	0x99, 0x59, 0x11, 0xd6, 0x0a, 0xc6, 0xd6, 0xdf, 0x23, 0xb5, 0xd2, 0xf5, 0xe9, 0xde, 0x61, 0x9b,
+	0x75, 0x96, 0xaf, 0x14, 0xff, 0x53, 0x21, 0xde, 0xbe, 0x4e, 0x8f, 0x07, 0x88, 0x68, 0xd5, 0x4e,
+	0xa7, 0xea, 0x79, 0x07, 0x88, 0x90, 0x17, 0x27, 0x95, 0x1b, 0xbc, 0x72, 0x6e, 0x31, 0x8e, 0x29,
+	0x22, 0x76, 0xc2, 0x0c, 0x11, 0xb8, 0xe6, 0xa1, 0x01, 0x26, 0x3e, 0xe5, 0xca, 0x2d, 0x6b, 0x1c,
+	0xc0, 0xd6, 0x7a, 0xa2, 0x57, 0x7e, 0x60, 0xbc, 0xcb, 0xc5, 0x5a, 0xbb, 0x42, 0x0e, 0x0c, 0x40,
+	0xec, 0x98, 0xfb, 0x6e, 0x8e, 0xa9, 0xf7, 0x3d, 0x53, 0xf0, 0x91, 0x04, 0xb2, 0x6c, 0x98, 0xa0,
+	0x0e, 0xf2, 0xe7, 0x77, 0xb3, 0x38, 0x1e, 0xa9, 0x60, 0x62, 0x2f, 0xc1, 0x20, 0xdd, 0xc9, 0xaf,
+	0xa3, 0xd9, 0x3f, 0x50, 0xc4, 0xff, 0xb8, 0x1b, 0x11, 0x2d, 0xfc, 0x18, 0x84, 0xa3, 0xed, 0x30,
+	0x58, 0xa8, 0x71, 0xf1, 0xf2, 0x05, 0x69, 0x1e, 0x0f, 0x90, 0x9d, 0x1b, 0xf2, 0x13, 0x34, 0xd8,
+	0x93, 0x11, 0x0c, 0xfd, 0x0e, 0xf5, 0x71, 0x10, 0x6a, 0x5e, 0xdc, 0x58, 0xbd, 0x08, 0x5f, 0x4b,
+	0x22, 0xed, 0x09, 0x14, 0x74, 0xc1, 0x75, 0xb6, 0x9f, 0xc8, 0x73, 0xdc, 0x3e, 0x3e, 0x0c, 0x68,
+	0x28, 0xfc, 0x9c, 0x71, 0x8f, 0x35, 0xfe, 0x8f, 0x91, 0x7a, 0xeb, 0x0a, 0xe5, 0x5a, 0x01, 0x7d,
+	0x71, 0x52, 0x01, 0xbc, 0x4e, 0x2b, 0xa0, 0x76, 0x21, 0xa2, 0xac, 0x86, 0x8c, 0x7c, 0x8f, 0x7e,
+	0x14, 0x41, 0x2e, 0x5e, 0xe9, 0xb4, 0xb1, 0xc2, 0xff, 0xd3, 0xd8, 0x57, 0x94, 0x16, 0xff, 0x55,
+	0xe9, 0x0f, 0xc1, 0x2c, 0xaf, 0x5f, 0x5a, 0x11, 0x2e, 0x9f, 0xc9, 0xe8, 0x8d, 0xe3, 0xe1, 0xd0,
+	0x04, 0xf9, 0xc3, 0x80, 0x95, 0xeb, 0xb0, 0xff, 0x0f, 0xaf, 0xb5, 0x78, 0x20, 0x02, 0x32, 0x17,
+	0xef, 0xd1, 0x2f, 0x02, 0x28, 0x9e, 0x7d, 0x96, 0xce, 0xbd, 0x04, 0xc2, 0x15, 0x5e, 0x82, 0xb7,
+	0x40, 0x81, 0x3f, 0x30, 0xce, 0x9e, 0x4b, 0xf6, 0xa2, 0xe9, 0xb5, 0xf3, 0xdc, 0xb6, 0xe5, 0x92,
+	0x3d, 0x78, 0x9f, 0x85, 0xe0, 0x9e, 0x87, 0xbf, 0x0b, 0x1c, 0x14, 0x78, 0x8a, 0xf4, 0x1a, 0x79,
+	0xe7, 0x63, 0xa4, 0x19, 0x78, 0x51, 0xe2, 0xb7, 0x1f, 0x09, 0x91, 0xb8, 0xe1, 0x58, 0x2e, 0x83,
+	0x9b, 0xbb, 0x8d, 0xa6, 0xe9, 0x34, 0x1f, 0x6e, 0x9b, 0xce, 0x17, 0xf5, 0x9d, 0x6d, 0xb3, 0x66,
+	0x7d, 0x6a, 0x99, 0x9b, 0x72, 0x06, 0x2e, 0x80, 0xeb, 0xa9, 0xeb, 0xa1, 0xb9, 0x23, 0x0b, 0x50,
+	0x06, 0x85, 0xd4, 0x54, 0x6f, 0xc8, 0x22, 0xbc, 0x09, 0x16, 0x52, 0x4b, 0xd5, 0xd8, 0x69, 0x56,
+	0xad, 0xba, 0x2c, 0xc1, 0x12, 0x58, 0x9a, 0x0c, 0x74, 0xbe, 0xb4, 0x9a, 0x5b, 0xce, 0xae, 0xd9,
+	0x6c, 0xc8, 0xd9, 0x52, 0xf6, 0xf1, 0x4f, 0xe5, 0xcc, 0xed, 0xdf, 0x04, 0x00, 0xd2, 0x31, 0x87,
+	0x37, 0xc0, 0x7c, 0xad, 0x51, 0xdf, 0xb5, 0x6a, 0x4d, 0xab, 0x51, 0x77, 0xea, 0x8d, 0xba, 0x29,
+	0x67, 0xa0, 0x02, 0x16, 0x27, 0x8c, 0x0f, 0x1a, 0xb5, 0xcf, 0xcc, 0x4d, 0x67, 0xbd, 0x25, 0x0b,
+	0xd3, 0x3d, 0x1b, 0x2d, 0x59, 0x9c, 0xee, 0xb9, 0xd3, 0x92, 0xa5, 0xe9, 0x9e, 0xf7, 0x5a, 0x72,
+	0x76, 0xba, 0xe7, 0xfd, 0x96, 0x3c, 0x33, 0xdd, 0xf3, 0x41, 0x4b, 0x9e, 0x8d, 0xaa, 0x30, 0xcc,
+	0xa7, 0xe3, 0xb2, 0xf0, 0x7c, 0x5c, 0x16, 0xfe, 0x1c, 0x97, 0x85, 0x27, 0xa7, 0xe5, 0xcc, 0xf3,
+	0xd3, 0x72, 0xe6, 0xf7, 0xd3, 0x72, 0xe6, 0xab, 0x77, 0x26, 0x56, 0x84, 0x6d, 0x79, 0xa5, 0xe7,
+	0xb6, 0x49, 0x78, 0xd2, 0x8f, 0x26, 0x7e, 0x53, 0x85, 0xbb, 0xd2, 0x9e, 0x0d, 0x35, 0xbc, 0xf3,
+	0xcf, 0x00, 0xf0, 0x77, 0x41, 0x98, 0x72, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -263,6 +434,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VetoedProposals) > 0 {
+		for iNdEx := len(m.VetoedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VetoedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VoteLocks) > 0 {
+		for iNdEx := len(m.VoteLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -333,6 +532,27 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
 	if err1 != nil {
 		return 0, err1
@@ -386,6 +606,21 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LockedAmount.Size()
+		i -= size
+		if _, err := m.LockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Conviction != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Conviction))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.VoteType != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.VoteType))
 		i--
@@ -406,6 +641,102 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ProposalID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VetoedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VetoedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VetoedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CooldownEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CooldownEnd):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommitteeID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CommitteeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -444,6 +775,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VoteLocks) > 0 {
+		for _, e := range m.VoteLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VetoedProposals) > 0 {
+		for _, e := range m.VetoedProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -465,6 +808,16 @@ func (m *Proposal) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -484,6 +837,49 @@ func (m *Vote) Size() (n int) {
 	if m.VoteType != 0 {
 		n += 1 + sovGenesis(uint64(m.VoteType))
 	}
+	if m.Conviction != 0 {
+		n += 1 + sovGenesis(uint64(m.Conviction))
+	}
+	l = m.LockedAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *VoteLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ProposalID != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalID))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *VetoedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CommitteeID))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CooldownEnd)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -643,34 +1039,102 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteLocks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Proposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+			m.VoteLocks = append(m.VoteLocks, VoteLock{})
+			if err := m.VoteLocks[len(m.VoteLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoedProposals = append(m.VetoedProposals, VetoedProposal{})
+			if err := m.VetoedProposals[len(m.VetoedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
@@ -800,6 +1264,74 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -922,6 +1454,364 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conviction", wireType)
+			}
+			m.Conviction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conviction |= Conviction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VetoedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VetoedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VetoedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeID", wireType)
+			}
+			m.CommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CooldownEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -65,6 +65,8 @@ func TestGenesisState_Validate(t *testing.T) {
 			{ProposalID: 1, Voter: addresses[0], VoteType: types.VOTE_TYPE_YES},
 			{ProposalID: 1, Voter: addresses[1], VoteType: types.VOTE_TYPE_YES},
 		},
+		types.VoteLocks{},
+		types.VetoedProposals{},
 	)
 
 	testCases := []struct {
@@ -89,6 +91,8 @@ func TestGenesisState_Validate(t *testing.T) {
 				append(testGenesis.GetCommittees(), testGenesis.GetCommittees()[0]),
 				testGenesis.Proposals,
 				testGenesis.Votes,
+				testGenesis.VoteLocks,
+				testGenesis.VetoedProposals,
 			),
 			expectPass: false,
 		},
@@ -99,6 +103,8 @@ func TestGenesisState_Validate(t *testing.T) {
 				append(testGenesis.GetCommittees(), &types.MemberCommittee{BaseCommittee: &types.BaseCommittee{}}),
 				testGenesis.Proposals,
 				testGenesis.Votes,
+				testGenesis.VoteLocks,
+				testGenesis.VetoedProposals,
 			),
 			expectPass: false,
 		},
@@ -109,6 +115,8 @@ func TestGenesisState_Validate(t *testing.T) {
 				testGenesis.GetCommittees(),
 				append(testGenesis.Proposals, testGenesis.Proposals[0]),
 				testGenesis.Votes,
+				testGenesis.VoteLocks,
+				testGenesis.VetoedProposals,
 			),
 			expectPass: false,
 		},
@@ -119,6 +127,8 @@ func TestGenesisState_Validate(t *testing.T) {
 				testGenesis.GetCommittees(),
 				testGenesis.Proposals,
 				testGenesis.Votes,
+				testGenesis.VoteLocks,
+				testGenesis.VetoedProposals,
 			),
 			expectPass: false,
 		},
@@ -137,6 +147,8 @@ func TestGenesisState_Validate(t *testing.T) {
 					),
 				),
 				testGenesis.Votes,
+				testGenesis.VoteLocks,
+				testGenesis.VetoedProposals,
 			),
 			expectPass: false,
 		},
@@ -147,6 +159,8 @@ func TestGenesisState_Validate(t *testing.T) {
 				testGenesis.GetCommittees(),
 				append(testGenesis.Proposals, types.Proposal{}),
 				testGenesis.Votes,
+				testGenesis.VoteLocks,
+				testGenesis.VetoedProposals,
 			),
 			expectPass: false,
 		},
@@ -157,6 +171,8 @@ func TestGenesisState_Validate(t *testing.T) {
 				testGenesis.GetCommittees(),
 				nil,
 				testGenesis.Votes,
+				testGenesis.VoteLocks,
+				testGenesis.VetoedProposals,
 			),
 			expectPass: false,
 		},
@@ -167,6 +183,8 @@ func TestGenesisState_Validate(t *testing.T) {
 				testGenesis.GetCommittees(),
 				testGenesis.Proposals,
 				append(testGenesis.Votes, types.Vote{}),
+				testGenesis.VoteLocks,
+				testGenesis.VetoedProposals,
 			),
 			expectPass: false,
 		},
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,6 +28,11 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	VoteLockKeyPrefix = []byte{0x04} // prefix for keys that store vote locks, ordered by unlock time
+
+	VetoedProposalKeyPrefix           = []byte{0x05} // prefix for keys that store vetoed proposals
+	VetoedProposalByCooldownKeyPrefix = []byte{0x06} // prefix for keys that index vetoed proposals by cooldown end
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -38,6 +44,21 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+// GetVoteLockKey returns the key of a vote lock, ordered by unlock time.
+func GetVoteLockKey(unlockTime time.Time, proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(append(sdk.FormatTimeBytes(unlockTime), GetKeyFromID(proposalID)...), voter.Bytes()...)
+}
+
+// GetVetoedProposalKey returns the key of a vetoed proposal from its committee and content hash.
+func GetVetoedProposalKey(committeeID uint64, contentHash []byte) []byte {
+	return append(GetKeyFromID(committeeID), contentHash...)
+}
+
+// GetVetoedProposalByCooldownKey returns the key indexing a vetoed proposal, ordered by cooldown end.
+func GetVetoedProposalByCooldownKey(cooldownEnd time.Time, committeeID uint64, contentHash []byte) []byte {
+	return append(sdk.FormatTimeBytes(cooldownEnd), GetVetoedProposalKey(committeeID, contentHash)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -4,6 +4,7 @@ import (
 	fmt "fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -12,11 +13,12 @@ import (
 const (
 	TypeMsgSubmitProposal = "commmittee_submit_proposal" // 'committee' prefix appended to avoid potential conflicts with gov msg types
 	TypeMsgVote           = "committee_vote"
+	TypeMsgCancelProposal = "committee_cancel_proposal"
 )
 
 var (
-	_, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}
-	_    types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}, &MsgCancelProposal{}
+	_       types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
@@ -98,7 +100,7 @@ func (vt *VoteType) Unmarshal(data []byte) error {
 }
 
 func (vt VoteType) Validate() error {
-	if vt <= 0 || vt > 3 {
+	if vt <= 0 || vt > VOTE_TYPE_NO_WITH_VETO {
 		return fmt.Errorf("invalid vote type: %d", vt)
 	}
 	return nil
@@ -116,7 +118,19 @@ func (vo VoteType) Format(s fmt.State, verb rune) {
 
 // NewMsgVote creates a message to cast a vote on an active proposal
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, voteType VoteType) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), voteType}
+	return NewMsgConvictionVote(voter, proposalID, voteType, CONVICTION_NONE, sdk.ZeroInt())
+}
+
+// NewMsgConvictionVote creates a message to cast a vote on an active proposal of a conviction voting committee,
+// locking tokens with a conviction
+func NewMsgConvictionVote(voter sdk.AccAddress, proposalID uint64, voteType VoteType, conviction Conviction, lockAmount sdkmath.Int) *MsgVote {
+	return &MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter.String(),
+		VoteType:   voteType,
+		Conviction: conviction,
+		LockAmount: lockAmount,
+	}
 }
 
 // Route return the message type used for routing the message.
@@ -130,6 +144,12 @@ func (msg MsgVote) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return err
 	}
+	if err := msg.Conviction.Validate(); err != nil {
+		return err
+	}
+	if !msg.LockAmount.IsNil() && msg.LockAmount.IsNegative() {
+		return fmt.Errorf("lock amount cannot be negative: %s", msg.LockAmount)
+	}
 	return msg.VoteType.Validate()
 }
 
@@ -151,3 +171,42 @@ func (msg MsgVote) GetVoter() sdk.AccAddress {
 	}
 	return address
 }
+
+// NewMsgCancelProposal creates a message to cancel a proposal before it is voted on
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) *MsgCancelProposal {
+	return &MsgCancelProposal{
+		ProposalID: proposalID,
+		Proposer:   proposer.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCancelProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Proposer)
+	return err
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetProposer()}
+}
+
+func (msg MsgCancelProposal) GetProposer() sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return sdk.AccAddress{}
+	}
+	return address
+}
@@ -65,32 +65,52 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 	}{
 		{
 			name:       "normal",
-			msg:        MsgVote{5, addr.String(), VOTE_TYPE_YES},
+			msg:        *NewMsgVote(addr, 5, VOTE_TYPE_YES),
 			expectPass: true,
 		},
 		{
 			name:       "No",
-			msg:        MsgVote{5, addr.String(), VOTE_TYPE_NO},
+			msg:        *NewMsgVote(addr, 5, VOTE_TYPE_NO),
 			expectPass: true,
 		},
 		{
 			name:       "Abstain",
-			msg:        MsgVote{5, addr.String(), VOTE_TYPE_ABSTAIN},
+			msg:        *NewMsgVote(addr, 5, VOTE_TYPE_ABSTAIN),
 			expectPass: true,
 		},
 		{
 			name:       "Null vote",
-			msg:        MsgVote{5, addr.String(), VOTE_TYPE_UNSPECIFIED},
+			msg:        *NewMsgVote(addr, 5, VOTE_TYPE_UNSPECIFIED),
 			expectPass: false,
 		},
 		{
 			name:       "empty address",
-			msg:        MsgVote{5, "", VOTE_TYPE_YES},
+			msg:        *NewMsgVote(nil, 5, VOTE_TYPE_YES),
 			expectPass: false,
 		},
+		{
+			name:       "No with veto",
+			msg:        *NewMsgVote(addr, 5, VOTE_TYPE_NO_WITH_VETO),
+			expectPass: true,
+		},
 		{
 			name:       "invalid vote (greater)",
-			msg:        MsgVote{5, addr.String(), 4},
+			msg:        *NewMsgVote(addr, 5, 5),
+			expectPass: false,
+		},
+		{
+			name:       "conviction",
+			msg:        *NewMsgConvictionVote(addr, 5, VOTE_TYPE_YES, CONVICTION_LOCKED_6X, sdk.NewInt(100)),
+			expectPass: true,
+		},
+		{
+			name:       "invalid conviction",
+			msg:        *NewMsgConvictionVote(addr, 5, VOTE_TYPE_YES, MaxConviction+1, sdk.NewInt(100)),
+			expectPass: false,
+		},
+		{
+			name:       "negative lock amount",
+			msg:        *NewMsgConvictionVote(addr, 5, VOTE_TYPE_YES, CONVICTION_LOCKED_1X, sdk.NewInt(-1)),
 			expectPass: false,
 		},
 	}
@@ -41,6 +41,7 @@ func (suite *ParamsChangeTestSuite) SetupTest() {
 		ReferenceAsset:   "usd",
 		ConversionFactor: sdkmath.NewInt(6),
 		DebtFloor:        sdkmath.NewInt(1000),
+		SavingsRate:      sdk.ZeroDec(),
 	}
 
 	suite.cdpCollateralParams = cdptypes.CollateralParams{
@@ -108,7 +109,9 @@ func (s *ParamsChangeTestSuite) TestSingleSubparams_CdpDeptParams() {
 					"denom": "bnb",
 					"reference_asset": "bnbx",
 					"conversion_factor": "11",
-					"debt_floor": "1200"
+					"debt_floor": "1200",
+					"savings_rate": "0.000000000000000000",
+					"savings_distribution_frequency": "0"
 				}`,
 			},
 		},
@@ -127,7 +130,9 @@ func (s *ParamsChangeTestSuite) TestSingleSubparams_CdpDeptParams() {
 					"denom": "bnb",
 					"reference_asset": "usd",
 					"conversion_factor": "6",
-					"debt_floor": "1100"
+					"debt_floor": "1100",
+					"savings_rate": "0.000000000000000000",
+					"savings_distribution_frequency": "0"
 				}`,
 			},
 		},
@@ -146,7 +151,9 @@ func (s *ParamsChangeTestSuite) TestSingleSubparams_CdpDeptParams() {
 					"denom": "usdx",
 					"reference_asset": "usd",
 					"conversion_factor": "7",
-					"debt_floor": "1000"
+					"debt_floor": "1000",
+					"savings_rate": "0.000000000000000000",
+					"savings_distribution_frequency": "0"
 				}`,
 			},
 		},
@@ -204,7 +211,9 @@ func (s *ParamsChangeTestSuite) TestSingleSubparams_CdpDeptParams() {
 					"denom": "usdx",
 					"reference_asset": "usd",
 					"conversion_factor": "6",
-					"debt_floor": "1000"
+					"debt_floor": "1000",
+					"savings_rate": "0.000000000000000000",
+					"savings_distribution_frequency": "0"
 				}`,
 			},
 		},
@@ -247,7 +256,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 		"liquidation_market_id": "bnb:usd",
 		"keeper_reward_percentage": "0",
 		"check_collateralization_index_count": "0",
-		"conversion_factor": "6"
+		"conversion_factor": "6",
+		"close_factor": "0",
+		"liquidation_target_ratio": "0"
 	}`
 	unchangedBtcValue := `{
 		"denom": "btc",
@@ -261,7 +272,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 		"liquidation_market_id": "btc:usd",
 		"keeper_reward_percentage": "0.12",
 		"check_collateralization_index_count": "1",
-		"conversion_factor": "8"
+		"conversion_factor": "8",
+		"close_factor": "0",
+		"liquidation_target_ratio": "0"
 	}`
 
 	testcases := []struct {
@@ -293,7 +306,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "bnb:usd",
 					"keeper_reward_percentage": "0",
 					"check_collateralization_index_count": "0",
-					"conversion_factor": "9"
+					"conversion_factor": "9",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0"
 				},
 				{
 					"denom": "btc",
@@ -307,7 +322,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.000000000000000000",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0"
 				}]`,
 			},
 		},
@@ -378,7 +395,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0"
 				}`),
 			},
 		},
@@ -419,7 +438,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "btc:usd",
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0"
 				}`),
 			},
 		},
@@ -447,7 +468,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0"
 				}`),
 			},
 		},
@@ -475,7 +498,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0"
 				}`),
 			},
 		},
@@ -504,7 +529,9 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
 					"check_collateralization_index_count": "1",
-					"conversion_factor": "8"
+					"conversion_factor": "8",
+					"close_factor": "0",
+					"liquidation_target_ratio": "0"
 				}`),
 			},
 		},
@@ -660,7 +687,9 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_PassWhenOneAllowed()
 					"denom": "usdx",
 					"reference_asset": "usd2",
 					"conversion_factor": "6",
-					"debt_floor": "1000"
+					"debt_floor": "1000",
+					"savings_rate": "0.000000000000000000",
+					"savings_distribution_frequency": "0"
 				}`,
 			},
 		},
@@ -703,14 +732,18 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 				"base_asset": "xrp",
 				"quote_asset": "usdx",
 				"oracles": [],
-				"active": true
+				"active": true,
+				"max_price_deviation": "0",
+				"max_price_age": "0"
 			},
 			{
 				"market_id": "btc:usd",
 				"base_asset": "btc",
 				"quote_asset": "usd",
 				"oracles": ["%s"],
-				"active": false
+				"active": false,
+				"max_price_deviation": "0",
+				"max_price_age": "0"
 			}]`, oracles[1].String()),
 		},
 		{
@@ -721,14 +754,18 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 				"base_asset": "xrp",
 				"quote_asset": "usdx",
 				"oracles": ["%s"],
-				"active": true
+				"active": true,
+				"max_price_deviation": "0",
+				"max_price_age": "0"
 			},
 			{
 				"market_id": "btc:usd",
 				"base_asset": "btc",
 				"quote_asset": "usd",
 				"oracles": ["%s"],
-				"active": false
+				"active": false,
+				"max_price_deviation": "0",
+				"max_price_age": "0"
 			}]`, oracles[0].String(), oracles[2].String()),
 		},
 	}
@@ -21,12 +21,18 @@ const (
 	Failed
 	// Invalid indicates that proposal passed but an error occurred when attempting to enact it
 	Invalid
+	// Vetoed indicates that the proposal was vetoed and was not enacted
+	Vetoed
+	// Cancelled indicates that the proposal was cancelled by its proposer before being voted on
+	Cancelled
 )
 
 var toString = map[ProposalOutcome]string{
-	Passed:  "Passed",
-	Failed:  "Failed",
-	Invalid: "Invalid",
+	Passed:    "Passed",
+	Failed:    "Failed",
+	Invalid:   "Invalid",
+	Vetoed:    "Vetoed",
+	Cancelled: "Cancelled",
 }
 
 func (p ProposalOutcome) String() string {
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -304,10 +305,12 @@ var xxx_messageInfo_QueryProposalRequest proto.InternalMessageInfo
 
 // QueryProposalResponse defines the response type for querying x/committee proposal.
 type QueryProposalResponse struct {
-	PubProposal *types.Any `protobuf:"bytes,1,opt,name=pub_proposal,json=pubProposal,proto3" json:"pub_proposal,omitempty"`
-	ID          uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CommitteeID uint64     `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
-	Deadline    time.Time  `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	PubProposal *types.Any                               `protobuf:"bytes,1,opt,name=pub_proposal,json=pubProposal,proto3" json:"pub_proposal,omitempty"`
+	ID          uint64                                   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CommitteeID uint64                                   `protobuf:"varint,3,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	Deadline    time.Time                                `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	Proposer    string                                   `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Deposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
 func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
@@ -539,9 +542,11 @@ var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
 
 // QueryVoteResponse defines the response type for querying x/committee vote.
 type QueryVoteResponse struct {
-	ProposalID uint64   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	Voter      string   `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	VoteType   VoteType `protobuf:"varint,3,opt,name=vote_type,json=voteType,proto3,enum=kava.committee.v1beta1.VoteType" json:"vote_type,omitempty"`
+	ProposalID   uint64                                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter        string                                 `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	VoteType     VoteType                               `protobuf:"varint,3,opt,name=vote_type,json=voteType,proto3,enum=kava.committee.v1beta1.VoteType" json:"vote_type,omitempty"`
+	Conviction   Conviction                             `protobuf:"varint,4,opt,name=conviction,proto3,enum=kava.committee.v1beta1.Conviction" json:"conviction,omitempty"`
+	LockedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=locked_amount,json=lockedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked_amount"`
 }
 
 func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
@@ -624,6 +629,14 @@ type QueryTallyResponse struct {
 	PossibleVotes github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=possible_votes,json=possibleVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"possible_votes"`
 	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
 	Quorum        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum"`
+	// Whether votes are conviction weighted. If so, yes, no and current votes are conviction weighted, and the
+	// quorum is measured against the tokens locked by voters.
+	ConvictionVoting bool                                   `protobuf:"varint,8,opt,name=conviction_voting,json=convictionVoting,proto3" json:"conviction_voting,omitempty"`
+	LockedVotes      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=locked_votes,json=lockedVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"locked_votes"`
+	// No with veto votes, which are included in no votes. The proposal is vetoed if they exceed the veto threshold of
+	// current votes.
+	VetoVotes     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=veto_votes,json=vetoVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_votes"`
+	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold"`
 }
 
 func (m *QueryTallyResponse) Reset()         { *m = QueryTallyResponse{} }
@@ -762,83 +775,94 @@ func init() {
 }
 
 var fileDescriptor_b81d271efeb6eee5 = []byte{
-	// 1211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xc1, 0x6f, 0xe3, 0xc4,
-	0x17, 0xc7, 0xeb, 0x34, 0xed, 0x26, 0x2f, 0xdb, 0xfe, 0xfa, 0x1b, 0x95, 0x92, 0x86, 0x55, 0xd2,
-	0x35, 0xab, 0xa5, 0x5b, 0x88, 0x4d, 0x53, 0x50, 0x05, 0xa2, 0x82, 0x4d, 0xdb, 0x45, 0x11, 0x12,
-	0xea, 0x9a, 0xc2, 0x81, 0x95, 0x88, 0x26, 0xf5, 0x6c, 0x6a, 0x35, 0xb1, 0x5d, 0x8f, 0xd3, 0x36,
-	0x2a, 0xbd, 0x70, 0x47, 0x5a, 0x09, 0x81, 0xb4, 0x07, 0x24, 0x84, 0x40, 0x42, 0xe2, 0x86, 0xf6,
-	0x8f, 0xa8, 0xf6, 0xb4, 0x12, 0x17, 0xc4, 0x21, 0x40, 0xca, 0x1f, 0x82, 0x3c, 0x33, 0x9e, 0xb8,
-	0x49, 0xdb, 0xb8, 0x39, 0x25, 0xb6, 0xdf, 0xfb, 0xce, 0x67, 0xde, 0xbc, 0x79, 0xef, 0x81, 0xba,
-	0x87, 0x0f, 0xb0, 0xbe, 0xe3, 0x34, 0x9b, 0x96, 0xef, 0x13, 0xa2, 0x1f, 0x2c, 0xd7, 0x88, 0x8f,
-	0x97, 0xf5, 0xfd, 0x16, 0xf1, 0xda, 0x9a, 0xeb, 0x39, 0xbe, 0x83, 0xe6, 0x02, 0x1b, 0x4d, 0xda,
-	0x68, 0xc2, 0x26, 0xb7, 0xb4, 0xe3, 0xd0, 0xa6, 0x43, 0xf5, 0x1a, 0xa6, 0x84, 0x3b, 0x48, 0x77,
-	0x17, 0xd7, 0x2d, 0x1b, 0xfb, 0x96, 0x63, 0x73, 0x8d, 0xdc, 0x3c, 0xb7, 0xad, 0xb2, 0x27, 0x9d,
-	0x3f, 0x88, 0x4f, 0xb3, 0x75, 0xa7, 0xee, 0xf0, 0xf7, 0xc1, 0x3f, 0xf1, 0xf6, 0x56, 0xdd, 0x71,
-	0xea, 0x0d, 0xa2, 0x63, 0xd7, 0xd2, 0xb1, 0x6d, 0x3b, 0x3e, 0x53, 0x0b, 0x7d, 0xe6, 0xc5, 0x57,
-	0xf6, 0x54, 0x6b, 0x3d, 0xd6, 0xb1, 0x2d, 0x68, 0x73, 0x85, 0xfe, 0x4f, 0xbe, 0xd5, 0x24, 0xd4,
-	0xc7, 0x4d, 0x57, 0x18, 0xdc, 0xb9, 0x64, 0xcb, 0x75, 0x62, 0x13, 0x6a, 0x89, 0x15, 0xd4, 0x2c,
-	0xcc, 0x3d, 0x0c, 0xb6, 0xb4, 0x1e, 0xda, 0x51, 0x83, 0xec, 0xb7, 0x08, 0xf5, 0xd5, 0x2f, 0xe0,
-	0xe5, 0x81, 0x2f, 0xd4, 0x75, 0x6c, 0x4a, 0xd0, 0x3a, 0x80, 0xd4, 0xa5, 0x59, 0x65, 0x61, 0x7c,
-	0x31, 0x53, 0x9a, 0xd5, 0x38, 0x90, 0x16, 0x02, 0x69, 0xf7, 0xed, 0x76, 0x79, 0xea, 0xf9, 0xb3,
-	0x62, 0x5a, 0x2a, 0x18, 0x11, 0x37, 0xf5, 0x5d, 0x78, 0xe9, 0xbc, 0xbe, 0x58, 0x18, 0xdd, 0x86,
-	0x9b, 0xd2, 0xac, 0x6a, 0x99, 0x59, 0x65, 0x41, 0x59, 0x4c, 0x1a, 0x19, 0xf9, 0xae, 0x62, 0xaa,
-	0x8f, 0xfa, 0xa9, 0x25, 0xda, 0x7d, 0x48, 0x4b, 0x43, 0xe6, 0x19, 0x93, 0xac, 0xe7, 0x25, 0xc1,
-	0xb6, 0x3c, 0xc7, 0x75, 0x28, 0x6e, 0xd0, 0x6b, 0x80, 0xed, 0x09, 0xb0, 0x88, 0xaf, 0x00, 0x7b,
-	0x08, 0x69, 0x37, 0x7c, 0x29, 0x42, 0x56, 0xd4, 0x2e, 0xce, 0x38, 0xed, 0x9c, 0x44, 0xa8, 0x50,
-	0x4e, 0x9e, 0x76, 0x0a, 0x63, 0x46, 0x4f, 0x45, 0x5d, 0x85, 0xd9, 0x3e, 0x4b, 0xce, 0x59, 0x80,
-	0x4c, 0x68, 0xd4, 0xc3, 0x84, 0xf0, 0x55, 0xc5, 0x54, 0xbf, 0x4e, 0xf4, 0x6d, 0x51, 0x52, 0x3e,
-	0x86, 0x9b, 0x6e, 0xab, 0x56, 0x0d, 0x6d, 0xaf, 0x8c, 0x60, 0xb1, 0xdb, 0x29, 0x64, 0xb6, 0x5a,
-	0xb5, 0x50, 0xe4, 0xf9, 0xb3, 0x62, 0x4e, 0x64, 0x7c, 0xdd, 0x39, 0x90, 0x9b, 0x59, 0x77, 0x6c,
-	0x9f, 0xd8, 0xbe, 0x91, 0x71, 0x7b, 0xa6, 0x68, 0x0e, 0x12, 0x96, 0x99, 0x4d, 0x04, 0x64, 0xe5,
-	0xc9, 0x6e, 0xa7, 0x90, 0xa8, 0x6c, 0x18, 0x09, 0xcb, 0x44, 0xa5, 0xbe, 0x10, 0x8f, 0x33, 0x8b,
-	0xff, 0x05, 0x2b, 0xc9, 0xb3, 0xaa, 0x6c, 0x9c, 0x8b, 0x39, 0xfa, 0x00, 0x52, 0x26, 0xc1, 0x66,
-	0xc3, 0xb2, 0x49, 0x36, 0xc9, 0x78, 0x73, 0x03, 0xbc, 0xdb, 0xe1, 0xe5, 0x28, 0xa7, 0x82, 0x28,
-	0x3e, 0xf9, 0xab, 0xa0, 0x18, 0xd2, 0x4b, 0xbd, 0x05, 0x39, 0x16, 0x8e, 0x8f, 0xc9, 0x91, 0x1f,
-	0x22, 0x56, 0x36, 0xc2, 0x8b, 0xf0, 0x08, 0x5e, 0xb9, 0xf0, 0xab, 0x08, 0xd9, 0x7b, 0x30, 0x63,
-	0x93, 0x23, 0xbf, 0x3a, 0x10, 0xf2, 0x32, 0xea, 0x76, 0x0a, 0xd3, 0x7d, 0x5e, 0xd3, 0x76, 0xf4,
-	0xd9, 0x54, 0xbf, 0x84, 0xff, 0x33, 0xf1, 0xcf, 0x1c, 0x5f, 0x5e, 0xbd, 0xa1, 0x07, 0x88, 0x1e,
-	0x00, 0xf4, 0x4a, 0x0f, 0x0b, 0x63, 0xa6, 0x74, 0x57, 0x13, 0xc1, 0x0f, 0xea, 0x94, 0xc6, 0x0b,
-	0x5b, 0x78, 0x06, 0x5b, 0xb8, 0x1e, 0x5e, 0x2f, 0x23, 0xe2, 0xa9, 0xfe, 0xa4, 0x00, 0x8a, 0x2e,
-	0x2f, 0xb6, 0xb4, 0x09, 0x13, 0x07, 0xc1, 0x0b, 0x91, 0xa7, 0xf7, 0xae, 0xcc, 0xd3, 0xc0, 0xb5,
-	0x2f, 0x47, 0xb9, 0x37, 0xfa, 0xf0, 0x02, 0xca, 0xd7, 0x86, 0x52, 0x72, 0xa5, 0x73, 0x98, 0x15,
-	0x98, 0x89, 0x2c, 0x15, 0x33, 0x46, 0xb3, 0x7c, 0x13, 0x1e, 0x5b, 0x38, 0xcd, 0x99, 0x3c, 0xf5,
-	0xa9, 0x12, 0x09, 0xb8, 0xdc, 0xb0, 0x7e, 0x81, 0x58, 0x79, 0xba, 0xdb, 0x29, 0x40, 0xe4, 0xe8,
-	0x86, 0x8a, 0xa3, 0x35, 0x48, 0x07, 0x7f, 0xaa, 0x7e, 0xdb, 0x25, 0x2c, 0x75, 0xa7, 0x4b, 0x0b,
-	0x97, 0xc5, 0x2e, 0x58, 0x7f, 0xbb, 0xed, 0x12, 0x23, 0x75, 0x20, 0xfe, 0xa9, 0x6f, 0x09, 0xb4,
-	0x6d, 0xdc, 0x68, 0xb4, 0x63, 0x5f, 0xe6, 0x5f, 0x92, 0xe2, 0x0c, 0x85, 0xdb, 0xa8, 0x5b, 0xfa,
-	0x08, 0xd2, 0x6d, 0x42, 0xab, 0xfc, 0xe0, 0xd9, 0xb6, 0xca, 0x5a, 0x70, 0x9a, 0x7f, 0x76, 0x0a,
-	0x77, 0xeb, 0x96, 0xbf, 0xdb, 0xaa, 0x05, 0xbb, 0x10, 0x3d, 0x4d, 0xfc, 0x14, 0xa9, 0xb9, 0xa7,
-	0x07, 0xbb, 0xa5, 0xda, 0x06, 0xd9, 0x31, 0x52, 0x6d, 0x42, 0x59, 0x26, 0xa1, 0x0a, 0xa4, 0x6c,
-	0x47, 0x68, 0x8d, 0x8f, 0xa4, 0x75, 0xc3, 0x76, 0xb8, 0xd4, 0x27, 0x30, 0xb5, 0xd3, 0xf2, 0x3c,
-	0x62, 0xfb, 0x42, 0x2f, 0x39, 0x92, 0xde, 0x4d, 0x21, 0xc2, 0x45, 0x3f, 0x85, 0x69, 0xd7, 0xa1,
-	0xd4, 0xaa, 0x35, 0x88, 0x50, 0x9d, 0x18, 0x49, 0x75, 0x2a, 0x54, 0x91, 0xb2, 0x3c, 0x01, 0x76,
-	0x3d, 0x42, 0x77, 0x9d, 0x86, 0x99, 0x9d, 0x1c, 0x4d, 0x96, 0xe5, 0x44, 0x28, 0x82, 0x1e, 0xc0,
-	0xe4, 0x7e, 0xcb, 0xf1, 0x5a, 0xcd, 0xec, 0x8d, 0x91, 0xe4, 0x84, 0xb7, 0xba, 0x29, 0xca, 0xbe,
-	0x81, 0x0f, 0xb7, 0xb0, 0x87, 0x9b, 0xb2, 0xe0, 0xe4, 0x20, 0x45, 0x5b, 0x35, 0xea, 0xe2, 0x1d,
-	0xde, 0x34, 0xd3, 0x86, 0x7c, 0x46, 0x33, 0x30, 0xbe, 0x47, 0xda, 0x22, 0xd1, 0x83, 0xbf, 0xea,
-	0x8a, 0x68, 0x72, 0x11, 0x19, 0x91, 0x74, 0xf3, 0x90, 0xf2, 0xf0, 0x61, 0xd5, 0xc4, 0x3e, 0x16,
-	0x3a, 0x37, 0x3c, 0x7c, 0xb8, 0x81, 0x7d, 0x5c, 0xfa, 0x2d, 0x03, 0x13, 0xcc, 0x0b, 0x3d, 0x55,
-	0x00, 0x7a, 0x43, 0x05, 0xd2, 0xae, 0xac, 0x2e, 0x03, 0x73, 0x49, 0x4e, 0x8f, 0x6d, 0xcf, 0xa1,
-	0xd4, 0xa5, 0xaf, 0x7e, 0xff, 0xf7, 0x9b, 0xc4, 0x1d, 0xa4, 0xea, 0x97, 0x4c, 0x44, 0xbd, 0xa1,
-	0x04, 0xfd, 0xac, 0x40, 0x6f, 0x28, 0x40, 0xc5, 0x78, 0x4b, 0x85, 0x64, 0x5a, 0x5c, 0x73, 0x01,
-	0xf6, 0x0e, 0x03, 0x5b, 0x41, 0xcb, 0xc3, 0xc1, 0xf4, 0xe3, 0x68, 0x5b, 0x3c, 0x41, 0xdf, 0x2a,
-	0x90, 0x96, 0x33, 0x06, 0x8a, 0x37, 0x48, 0xd0, 0x78, 0x9c, 0x03, 0xa3, 0x8b, 0x7a, 0x8f, 0x71,
-	0xbe, 0x8a, 0x6e, 0x5f, 0xc6, 0x29, 0x47, 0x12, 0xf4, 0x83, 0x02, 0x29, 0xd9, 0xe4, 0xdf, 0x88,
-	0x39, 0xdf, 0x70, 0xaa, 0xeb, 0x4d, 0x43, 0xea, 0x2a, 0x83, 0x5a, 0x46, 0xfa, 0x50, 0x28, 0xfd,
-	0x38, 0x52, 0x08, 0x4f, 0xd0, 0xaf, 0x0a, 0xf4, 0x35, 0x65, 0x54, 0xba, 0x72, 0xe9, 0x0b, 0xa7,
-	0x82, 0xdc, 0xca, 0xb5, 0x7c, 0x04, 0xf4, 0x9b, 0x0c, 0x7a, 0x09, 0x2d, 0x5e, 0x06, 0x1d, 0x4c,
-	0x07, 0xc5, 0x10, 0xb7, 0x68, 0x99, 0xe8, 0x7b, 0x05, 0x26, 0x78, 0x6d, 0x19, 0xde, 0x85, 0xe5,
-	0x01, 0x2f, 0xc5, 0x31, 0x15, 0x48, 0x6b, 0x0c, 0x69, 0x15, 0xbd, 0x7d, 0xcd, 0x38, 0xea, 0xbc,
-	0xc7, 0xff, 0xa8, 0x40, 0x32, 0x10, 0x44, 0x8b, 0x31, 0x86, 0x04, 0x4e, 0x17, 0x7f, 0x9c, 0x50,
-	0x37, 0x19, 0xdc, 0xfb, 0x68, 0x6d, 0x24, 0x38, 0xfd, 0x98, 0xb5, 0xe5, 0x13, 0x16, 0x44, 0xd6,
-	0x1d, 0x87, 0x04, 0x31, 0xda, 0x78, 0x87, 0x04, 0xf1, 0x5c, 0xb3, 0x1d, 0x3d, 0x88, 0x3e, 0xa3,
-	0xfa, 0x4e, 0x81, 0xb4, 0x2c, 0xa6, 0x43, 0x6e, 0x73, 0x7f, 0xed, 0x1e, 0x72, 0x9b, 0x07, 0x6a,
-	0xf4, 0xf0, 0x72, 0xe8, 0xe1, 0xc3, 0xa2, 0xcb, 0x7c, 0xca, 0x95, 0xd3, 0x7f, 0xf2, 0x63, 0xa7,
-	0xdd, 0xbc, 0xf2, 0xa2, 0x9b, 0x57, 0xfe, 0xee, 0xe6, 0x95, 0x27, 0x67, 0xf9, 0xb1, 0x17, 0x67,
-	0xf9, 0xb1, 0x3f, 0xce, 0xf2, 0x63, 0x9f, 0xbf, 0x1e, 0x69, 0x3f, 0x81, 0x56, 0xb1, 0x81, 0x6b,
-	0x94, 0xab, 0x1e, 0x45, 0x74, 0x59, 0x1f, 0xaa, 0x4d, 0xb2, 0x59, 0x7c, 0xe5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb4, 0xd1, 0x58, 0x0c, 0x8a, 0x0f, 0x00, 0x00,
+	// 1382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xe6, 0xaf, 0xfd, 0x3a, 0xc9, 0x2f, 0x1d, 0xa5, 0xf9, 0x39, 0xa6, 0xb2, 0xd3, 0xa5,
+	0x2a, 0x6e, 0x8a, 0x77, 0x9b, 0x04, 0x54, 0x81, 0x5a, 0x41, 0x9d, 0xb4, 0xc8, 0x42, 0xa0, 0x74,
+	0x29, 0x3d, 0x50, 0x09, 0x6b, 0xec, 0x9d, 0xba, 0xab, 0xd8, 0x3b, 0xdb, 0x9d, 0xb1, 0x53, 0xab,
+	0xf4, 0xc2, 0x27, 0xa8, 0x84, 0x40, 0xe2, 0x80, 0x84, 0x10, 0x5c, 0xe0, 0x86, 0xfa, 0x01, 0x38,
+	0x56, 0x3d, 0xa0, 0x0a, 0x2e, 0x88, 0x43, 0x0a, 0x2e, 0x1f, 0x04, 0xed, 0xcc, 0xec, 0x7a, 0xe3,
+	0xfc, 0xf1, 0xc6, 0x9c, 0xbc, 0x3b, 0xf3, 0xbe, 0xcf, 0xfb, 0xcc, 0x33, 0x33, 0xef, 0x3e, 0x06,
+	0x7d, 0x07, 0x77, 0xb0, 0x59, 0xa7, 0xad, 0x96, 0xc3, 0x39, 0x21, 0x66, 0x67, 0xad, 0x46, 0x38,
+	0x5e, 0x33, 0xef, 0xb7, 0x89, 0xdf, 0x35, 0x3c, 0x9f, 0x72, 0x8a, 0x96, 0x82, 0x18, 0x23, 0x8a,
+	0x31, 0x54, 0x4c, 0x6e, 0xb5, 0x4e, 0x59, 0x8b, 0x32, 0xb3, 0x86, 0x19, 0x91, 0x09, 0x51, 0xba,
+	0x87, 0x1b, 0x8e, 0x8b, 0xb9, 0x43, 0x5d, 0x89, 0x91, 0xcb, 0xc7, 0x63, 0xc3, 0xa8, 0x3a, 0x75,
+	0xc2, 0xf9, 0x65, 0x39, 0x5f, 0x15, 0x6f, 0xa6, 0x7c, 0x51, 0x53, 0x8b, 0x0d, 0xda, 0xa0, 0x72,
+	0x3c, 0x78, 0x52, 0xa3, 0x67, 0x1a, 0x94, 0x36, 0x9a, 0xc4, 0xc4, 0x9e, 0x63, 0x62, 0xd7, 0xa5,
+	0x5c, 0x54, 0x0b, 0x73, 0x96, 0xd5, 0xac, 0x78, 0xab, 0xb5, 0xef, 0x9a, 0xd8, 0x55, 0xab, 0xc9,
+	0x15, 0x06, 0xa7, 0xb8, 0xd3, 0x22, 0x8c, 0xe3, 0x96, 0xa7, 0x02, 0xce, 0x1d, 0x21, 0x49, 0x83,
+	0xb8, 0x84, 0x39, 0xaa, 0x82, 0x9e, 0x85, 0xa5, 0x9b, 0xc1, 0x92, 0x37, 0xc3, 0x38, 0x66, 0x91,
+	0xfb, 0x6d, 0xc2, 0xb8, 0xfe, 0x29, 0xfc, 0xff, 0xc0, 0x0c, 0xf3, 0xa8, 0xcb, 0x08, 0xda, 0x04,
+	0x88, 0x70, 0x59, 0x56, 0x5b, 0x99, 0x28, 0x66, 0xd6, 0x17, 0x0d, 0x49, 0xc8, 0x08, 0x09, 0x19,
+	0xd7, 0xdc, 0x6e, 0x79, 0xee, 0xd9, 0x93, 0x52, 0x3a, 0x42, 0xb0, 0x62, 0x69, 0xfa, 0xdb, 0x70,
+	0x7a, 0x3f, 0xbe, 0x2a, 0x8c, 0xce, 0xc2, 0x6c, 0x14, 0x56, 0x75, 0xec, 0xac, 0xb6, 0xa2, 0x15,
+	0x27, 0xad, 0x4c, 0x34, 0x56, 0xb1, 0xf5, 0x3b, 0x83, 0xac, 0x23, 0x6a, 0xd7, 0x20, 0x1d, 0x05,
+	0x8a, 0xcc, 0x84, 0xcc, 0xfa, 0x59, 0x11, 0xb1, 0x6d, 0x9f, 0x7a, 0x94, 0xe1, 0x26, 0x3b, 0x01,
+	0xb1, 0x1d, 0x58, 0x1a, 0xcc, 0x55, 0xc4, 0x6e, 0x42, 0xda, 0x0b, 0x07, 0x95, 0x64, 0x25, 0xe3,
+	0xf0, 0x13, 0x69, 0xec, 0x83, 0x08, 0x11, 0xca, 0x93, 0x4f, 0xf7, 0x0a, 0x63, 0x56, 0x1f, 0x45,
+	0xbf, 0x0c, 0x8b, 0x03, 0x91, 0x92, 0x67, 0x01, 0x32, 0x61, 0x50, 0x9f, 0x26, 0x84, 0x43, 0x15,
+	0x5b, 0x7f, 0x3c, 0x01, 0xa7, 0x0f, 0xad, 0x81, 0xee, 0xc2, 0xac, 0xd7, 0xae, 0x55, 0xc3, 0xd8,
+	0x63, 0x15, 0x2c, 0xf5, 0xf6, 0x0a, 0x99, 0xed, 0x76, 0x2d, 0x04, 0x79, 0xf6, 0xa4, 0x94, 0x53,
+	0x27, 0xbe, 0x41, 0x3b, 0xd1, 0x62, 0x36, 0xa9, 0xcb, 0x89, 0xcb, 0xad, 0x8c, 0xd7, 0x0f, 0x45,
+	0x4b, 0x30, 0xee, 0xd8, 0xd9, 0xf1, 0x80, 0x59, 0x79, 0xba, 0xb7, 0x57, 0x18, 0xaf, 0x6c, 0x59,
+	0xe3, 0x8e, 0x8d, 0xd6, 0x07, 0x24, 0x9e, 0x10, 0x11, 0xff, 0x0b, 0x2a, 0x45, 0x7b, 0x55, 0xd9,
+	0xda, 0xa7, 0x39, 0x7a, 0x17, 0x52, 0x36, 0xc1, 0x76, 0xd3, 0x71, 0x49, 0x76, 0x52, 0xf0, 0xcd,
+	0x1d, 0xe0, 0x7b, 0x2b, 0xbc, 0x1c, 0xe5, 0x54, 0xa0, 0xe2, 0xe3, 0x17, 0x05, 0xcd, 0x8a, 0xb2,
+	0x50, 0x0e, 0x52, 0x72, 0xc5, 0xc4, 0xcf, 0x4e, 0xad, 0x68, 0xc5, 0xb4, 0x15, 0xbd, 0x23, 0x02,
+	0x33, 0x36, 0xf1, 0x28, 0x73, 0x78, 0x76, 0x5a, 0xec, 0xda, 0xb2, 0xa1, 0x16, 0x19, 0xf4, 0x80,
+	0xd8, 0x2a, 0x1d, 0xb7, 0x7c, 0x29, 0xc0, 0xfe, 0xf1, 0x45, 0xa1, 0xd8, 0x70, 0xf8, 0xbd, 0x76,
+	0x2d, 0xd8, 0x59, 0xd5, 0x03, 0xd4, 0x4f, 0x89, 0xd9, 0x3b, 0x26, 0xef, 0x7a, 0x84, 0x89, 0x04,
+	0x66, 0x85, 0xd8, 0xfa, 0x19, 0xc8, 0x89, 0x1d, 0xf9, 0x90, 0x3c, 0xe0, 0xa1, 0x4a, 0x95, 0xad,
+	0xf0, 0x2e, 0xde, 0x81, 0x57, 0x0e, 0x9d, 0x55, 0xbb, 0x76, 0x05, 0x16, 0x5c, 0xf2, 0x80, 0x57,
+	0x0f, 0xec, 0x7a, 0x19, 0xf5, 0xf6, 0x0a, 0xf3, 0x03, 0x59, 0xf3, 0x6e, 0xfc, 0xdd, 0xd6, 0x3f,
+	0x83, 0x53, 0x02, 0xfc, 0x36, 0xe5, 0x84, 0x25, 0x3d, 0x43, 0xe8, 0x06, 0x40, 0xbf, 0x3b, 0x8a,
+	0x9d, 0xcc, 0xac, 0x9f, 0xdf, 0x27, 0x8d, 0xec, 0xbd, 0xa1, 0x40, 0xdb, 0xb8, 0x11, 0xde, 0x70,
+	0x2b, 0x96, 0xa9, 0x7f, 0xaf, 0x01, 0x8a, 0x97, 0x57, 0x4b, 0xba, 0x0e, 0x53, 0x9d, 0x60, 0x40,
+	0x5d, 0x95, 0x0b, 0xc7, 0x5e, 0x95, 0x20, 0x75, 0xe0, 0x9a, 0xc8, 0x6c, 0xf4, 0xde, 0x21, 0x2c,
+	0x5f, 0x1b, 0xca, 0x52, 0x22, 0xed, 0xa3, 0x59, 0x81, 0x85, 0x58, 0xa9, 0x84, 0x1a, 0x2d, 0xca,
+	0x45, 0xf8, 0xa2, 0x70, 0x5a, 0x72, 0xf2, 0xf5, 0x5f, 0xc6, 0x63, 0x82, 0x47, 0x0b, 0x36, 0x0f,
+	0x01, 0x2b, 0xcf, 0xf7, 0xf6, 0x0a, 0x10, 0xdb, 0xba, 0xa1, 0xe0, 0xe8, 0x2a, 0xa4, 0x83, 0x87,
+	0x6a, 0x70, 0xc8, 0xc4, 0xed, 0x99, 0x5f, 0x5f, 0x39, 0x4a, 0xbb, 0xa0, 0xfe, 0xad, 0xae, 0x47,
+	0xac, 0x54, 0x47, 0x3d, 0xa1, 0x72, 0xd0, 0xd9, 0xdd, 0x8e, 0x53, 0x17, 0x7a, 0x4d, 0x8a, 0x7c,
+	0xfd, 0xa8, 0xfc, 0xcd, 0x28, 0xd2, 0x8a, 0x65, 0x21, 0x0c, 0x73, 0x4d, 0x5a, 0xdf, 0x21, 0x76,
+	0x15, 0xb7, 0x68, 0xdb, 0xe5, 0xf2, 0x4a, 0x95, 0xaf, 0x04, 0xfb, 0xf2, 0xe7, 0x5e, 0xe1, 0x7c,
+	0x82, 0xcb, 0x51, 0x71, 0xf9, 0x6f, 0x4f, 0x4a, 0xa0, 0xf6, 0xa9, 0xe2, 0x72, 0x6b, 0x56, 0x42,
+	0x5e, 0x13, 0x88, 0xfa, 0x1b, 0x4a, 0xc1, 0x5b, 0xb8, 0xd9, 0xec, 0x26, 0x6e, 0x7b, 0xbf, 0x4e,
+	0x03, 0x8a, 0xa7, 0x8d, 0xaa, 0xfc, 0xfb, 0x90, 0xee, 0x12, 0x56, 0x95, 0xe7, 0x53, 0xa8, 0x5f,
+	0x36, 0x4e, 0xb0, 0xb8, 0x2d, 0x52, 0xb7, 0x52, 0x5d, 0xc2, 0xc4, 0x81, 0x47, 0x15, 0x48, 0xb9,
+	0x54, 0x61, 0x4d, 0x8c, 0x84, 0x35, 0xe3, 0x52, 0x09, 0xf5, 0x11, 0xcc, 0xd5, 0xdb, 0xbe, 0x4f,
+	0x5c, 0xae, 0xf0, 0x26, 0x47, 0xc2, 0x9b, 0x55, 0x20, 0x12, 0xf4, 0x63, 0x98, 0xf7, 0x28, 0x63,
+	0x4e, 0xad, 0x49, 0x14, 0xea, 0xd4, 0x48, 0xa8, 0x73, 0x21, 0x4a, 0x04, 0x2b, 0xcf, 0xe9, 0x3d,
+	0x9f, 0xb0, 0x7b, 0xb4, 0x69, 0x67, 0xa7, 0x47, 0x83, 0x15, 0x47, 0x37, 0x04, 0x41, 0x37, 0x60,
+	0xfa, 0x7e, 0x9b, 0xfa, 0xed, 0x56, 0x76, 0x66, 0x24, 0x38, 0x95, 0x8d, 0x2e, 0xc2, 0xa9, 0xfe,
+	0x89, 0x0e, 0xd6, 0xed, 0xb8, 0x8d, 0x6c, 0x6a, 0x45, 0x2b, 0xa6, 0xac, 0x85, 0xfe, 0xc4, 0x6d,
+	0x31, 0x8e, 0x6e, 0x82, 0x3a, 0x9d, 0x4a, 0xa0, 0xf4, 0x48, 0xa5, 0x33, 0x12, 0x43, 0xca, 0xf3,
+	0x01, 0x40, 0x87, 0xf0, 0xf0, 0x5c, 0xc0, 0x48, 0x80, 0xe9, 0x00, 0xa1, 0xaf, 0x76, 0x00, 0xd7,
+	0x57, 0x3b, 0x33, 0xa2, 0xda, 0x84, 0xd3, 0x48, 0x6d, 0xfd, 0xba, 0xb2, 0x11, 0x16, 0xde, 0xdd,
+	0xc6, 0x3e, 0x6e, 0x45, 0x5f, 0x8f, 0x1c, 0xa4, 0x58, 0xbb, 0xc6, 0x3c, 0x5c, 0x97, 0x26, 0x2c,
+	0x6d, 0x45, 0xef, 0x68, 0x01, 0x26, 0x76, 0x48, 0x57, 0x75, 0xad, 0xe0, 0x51, 0xdf, 0x80, 0xa5,
+	0x41, 0x18, 0x75, 0x35, 0x97, 0x21, 0xe5, 0xe3, 0xdd, 0xaa, 0x8d, 0x39, 0x56, 0x38, 0x33, 0x3e,
+	0xde, 0xdd, 0xc2, 0x1c, 0xaf, 0xff, 0x9c, 0x81, 0x29, 0x91, 0x85, 0xbe, 0xd6, 0x00, 0xfa, 0x26,
+	0x15, 0x19, 0xc7, 0x7e, 0x2a, 0x0e, 0xf8, 0xdc, 0x9c, 0x99, 0x38, 0x5e, 0x92, 0xd2, 0x57, 0x3f,
+	0xff, 0xfd, 0x9f, 0x2f, 0xc6, 0xcf, 0x21, 0xdd, 0x3c, 0xc2, 0x61, 0xd7, 0xfb, 0x64, 0x7e, 0xd0,
+	0xa0, 0x6f, 0x32, 0x51, 0x29, 0x59, 0xa9, 0x90, 0x99, 0x91, 0x34, 0x5c, 0x11, 0x7b, 0x4b, 0x10,
+	0xdb, 0x40, 0x6b, 0xc3, 0x89, 0x99, 0x0f, 0xe3, 0x36, 0xeb, 0x11, 0xfa, 0x52, 0x83, 0x74, 0xe4,
+	0x59, 0x51, 0x32, 0x63, 0xca, 0x92, 0xf1, 0x3c, 0x60, 0x85, 0xf5, 0x0b, 0x82, 0xe7, 0xab, 0xe8,
+	0xec, 0x51, 0x3c, 0x23, 0x8b, 0x8b, 0xbe, 0xd5, 0x20, 0x15, 0x99, 0xc6, 0xd7, 0x13, 0xfa, 0x65,
+	0xc9, 0xea, 0x64, 0xee, 0x5a, 0xbf, 0x2c, 0x48, 0xad, 0x21, 0x73, 0x28, 0x29, 0xf3, 0x61, 0xec,
+	0x73, 0xf1, 0x08, 0xfd, 0xa4, 0xc1, 0x80, 0xc3, 0x42, 0xeb, 0xc7, 0x96, 0x3e, 0xd4, 0xe2, 0xe5,
+	0x36, 0x4e, 0x94, 0xa3, 0x48, 0x5f, 0x12, 0xa4, 0x57, 0x51, 0xf1, 0x28, 0xd2, 0x81, 0xd5, 0x2b,
+	0x85, 0x74, 0x4b, 0x8e, 0x8d, 0xbe, 0xd1, 0x60, 0x4a, 0xf6, 0x84, 0xe1, 0x96, 0x2a, 0xda, 0xe0,
+	0xd5, 0x24, 0xa1, 0x8a, 0xd2, 0x55, 0x41, 0xe9, 0x32, 0x7a, 0xf3, 0x84, 0x3a, 0x9a, 0xd2, 0xb0,
+	0x7d, 0xa7, 0xc1, 0x64, 0x00, 0x88, 0x8a, 0x09, 0x1c, 0x9f, 0x64, 0x97, 0xdc, 0x1b, 0xea, 0xd7,
+	0x05, 0xb9, 0x77, 0xd0, 0xd5, 0x91, 0xc8, 0x99, 0x0f, 0x83, 0x1f, 0xff, 0x91, 0x10, 0x51, 0x78,
+	0x88, 0x21, 0x22, 0xc6, 0xed, 0x49, 0x6e, 0x35, 0x49, 0xe8, 0x7f, 0x15, 0x91, 0x0b, 0x56, 0x5f,
+	0x69, 0x90, 0x8e, 0x9a, 0xe9, 0x90, 0xdb, 0x3c, 0xd8, 0xbb, 0x73, 0x46, 0xd2, 0xf0, 0xa4, 0xed,
+	0xd0, 0xc7, 0xbb, 0x25, 0x4f, 0xe4, 0x94, 0x2b, 0x4f, 0xff, 0xce, 0x8f, 0x3d, 0xed, 0xe5, 0xb5,
+	0xe7, 0xbd, 0xbc, 0xf6, 0x57, 0x2f, 0xaf, 0x3d, 0x7e, 0x99, 0x1f, 0x7b, 0xfe, 0x32, 0x3f, 0xf6,
+	0xc7, 0xcb, 0xfc, 0xd8, 0x27, 0x17, 0x63, 0x5f, 0xa1, 0x00, 0xab, 0xd4, 0xc4, 0x35, 0x26, 0x51,
+	0x1f, 0xc4, 0x70, 0xc5, 0xe7, 0xa8, 0x36, 0x2d, 0xfe, 0xdb, 0x6d, 0xfc, 0x3b, 0x00, 0xb6, 0xca,
+	0xb4, 0xbb, 0xfa, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1464,6 +1488,27 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
 	if err2 != nil {
 		return 0, err2
@@ -1692,6 +1737,21 @@ func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LockedAmount.Size()
+		i -= size
+		if _, err := m.LockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Conviction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Conviction))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.VoteType != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
 		i--
@@ -1760,6 +1820,46 @@ func (m *QueryTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.VetoThreshold.Size()
+		i -= size
+		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.VetoVotes.Size()
+		i -= size
+		if _, err := m.VetoVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LockedVotes.Size()
+		i -= size
+		if _, err := m.LockedVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ConvictionVoting {
+		i--
+		if m.ConvictionVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.Quorum.Size()
 		i -= size
@@ -2012,6 +2112,16 @@ func (m *QueryProposalResponse) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
 	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2103,6 +2213,11 @@ func (m *QueryVoteResponse) Size() (n int) {
 	if m.VoteType != 0 {
 		n += 1 + sovQuery(uint64(m.VoteType))
 	}
+	if m.Conviction != 0 {
+		n += 1 + sovQuery(uint64(m.Conviction))
+	}
+	l = m.LockedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -2139,6 +2254,15 @@ func (m *QueryTallyResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.Quorum.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.ConvictionVoting {
+		n += 2
+	}
+	l = m.LockedVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VetoVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VetoThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -2825,6 +2949,72 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3390,6 +3580,59 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conviction", wireType)
+			}
+			m.Conviction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conviction |= Conviction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3732,6 +3975,128 @@ func (m *QueryTallyResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConvictionVoting = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// VetoThreshold is the fraction of current votes that must be no with veto votes for a proposal to be vetoed.
+var VetoThreshold = sdk.MustNewDecFromStr("0.334")
+
+// GetContentHash returns the hash used to identify a proposal's content when it is resubmitted. The title and
+// description are cleared before hashing, so that a proposal cannot be resubmitted by only rewording them.
+func GetContentHash(pubProposal PubProposal) ([]byte, error) {
+	msg, ok := pubProposal.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T does not implement proto.Message", pubProposal)
+	}
+	msg = proto.Clone(msg)
+	if v := reflect.ValueOf(msg); v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct {
+		for _, name := range []string{"Title", "Description"} {
+			if field := v.Elem().FieldByName(name); field.CanSet() && field.Kind() == reflect.String {
+				field.SetString("")
+			}
+		}
+	}
+	contentAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(append([]byte(contentAny.TypeUrl), contentAny.Value...))
+	return hash[:], nil
+}
+
+// NewVetoedProposal returns a new record of a vetoed proposal.
+func NewVetoedProposal(committeeID uint64, contentHash []byte, cooldownEnd time.Time) VetoedProposal {
+	return VetoedProposal{
+		CommitteeID: committeeID,
+		ContentHash: contentHash,
+		CooldownEnd: cooldownEnd,
+	}
+}
+
+// HasCooledDownBy returns whether the vetoed proposal can be resubmitted at a certain time.
+func (vp VetoedProposal) HasCooledDownBy(time time.Time) bool {
+	return !time.Before(vp.CooldownEnd)
+}
+
+// Validate performs a basic validation of the vetoed proposal fields.
+func (vp VetoedProposal) Validate() error {
+	if len(vp.ContentHash) != sha256.Size {
+		return fmt.Errorf("invalid vetoed proposal content hash length: %d", len(vp.ContentHash))
+	}
+	if vp.CooldownEnd.IsZero() {
+		return fmt.Errorf("vetoed proposal cooldown end cannot be zero")
+	}
+	return nil
+}
+
+// VetoedProposals a collection of VetoedProposal objects
+type VetoedProposals []VetoedProposal
+
+// Validate validates each vetoed proposal and checks there is only one record per committee and content hash
+func (vps VetoedProposals) Validate() error {
+	seen := make(map[string]bool)
+	for _, vp := range vps {
+		if err := vp.Validate(); err != nil {
+			return err
+		}
+		key := string(GetVetoedProposalKey(vp.CommitteeID, vp.ContentHash))
+		if seen[key] {
+			return fmt.Errorf("duplicate vetoed proposal for committee %d: %X", vp.CommitteeID, vp.ContentHash)
+		}
+		seen[key] = true
+	}
+	return nil
+}
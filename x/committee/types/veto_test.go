@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+func TestGetContentHash(t *testing.T) {
+	hash, err := types.GetContentHash(govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	require.NoError(t, err)
+	sameHash, err := types.GetContentHash(govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	require.NoError(t, err)
+	rewordedHash, err := types.GetContentHash(govv1beta1.NewTextProposal("Another Title", "Another description."))
+	require.NoError(t, err)
+
+	require.Equal(t, hash, sameHash)
+	require.Equal(t, hash, rewordedHash)
+
+	// Proposals with different payloads have different hashes
+	changeHash, err := types.GetContentHash(paramsproposal.NewParameterChangeProposal("A Title", "A description of this proposal.",
+		[]paramsproposal.ParamChange{{Subspace: "auction", Key: "BidDuration", Value: `"3600000000000"`}},
+	))
+	require.NoError(t, err)
+	otherChangeHash, err := types.GetContentHash(paramsproposal.NewParameterChangeProposal("A Title", "A description of this proposal.",
+		[]paramsproposal.ParamChange{{Subspace: "auction", Key: "BidDuration", Value: `"7200000000000"`}},
+	))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, changeHash)
+	require.NotEqual(t, changeHash, otherChangeHash)
+
+	// Hashing does not modify the proposal
+	textProposal := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+	_, err = types.GetContentHash(textProposal)
+	require.NoError(t, err)
+	require.Equal(t, "A Title", textProposal.GetTitle())
+}
+
+func TestVetoedProposals_Validate(t *testing.T) {
+	testTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	hash, err := types.GetContentHash(govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		vetoedProposals types.VetoedProposals
+		expectPass      bool
+	}{
+		{
+			name: "normal",
+			vetoedProposals: types.VetoedProposals{
+				types.NewVetoedProposal(1, hash, testTime),
+				types.NewVetoedProposal(2, hash, testTime),
+			},
+			expectPass: true,
+		},
+		{
+			name: "duplicate",
+			vetoedProposals: types.VetoedProposals{
+				types.NewVetoedProposal(1, hash, testTime),
+				types.NewVetoedProposal(1, hash, testTime.Add(time.Hour)),
+			},
+			expectPass: false,
+		},
+		{
+			name:            "invalid hash",
+			vetoedProposals: types.VetoedProposals{types.NewVetoedProposal(1, hash[:8], testTime)},
+			expectPass:      false,
+		},
+		{
+			name:            "zero cooldown end",
+			vetoedProposals: types.VetoedProposals{types.NewVetoedProposal(1, hash, time.Time{})},
+			expectPass:      false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.vetoedProposals.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
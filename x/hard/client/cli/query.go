@@ -16,9 +16,11 @@ import (
 
 // flags for cli queries
 const (
-	flagName  = "name"
-	flagDenom = "denom"
-	flagOwner = "owner"
+	flagName          = "name"
+	flagDenom         = "denom"
+	flagOwner         = "owner"
+	flagStableRate    = "stable-rate"
+	flagReceiptTokens = "receipt-tokens"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -43,6 +45,7 @@ func GetQueryCmd() *cobra.Command {
 		queryInterestRateCmd(),
 		queryReserves(),
 		queryInterestFactorsCmd(),
+		queryHealthFactorCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -532,3 +535,29 @@ func queryInterestFactorsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func queryHealthFactorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "health-factor [owner-addr]",
+		Short:   "get the health factor of an account's borrows",
+		Long:    "get the ratio of the value that can be borrowed against an account's deposits to the value of its borrows, borrows with a health factor below 1.0 can be liquidated",
+		Example: fmt.Sprintf(`%[1]s q %[2]s health-factor kava1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.HealthFactor(context.Background(), &types.QueryHealthFactorRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
@@ -31,6 +32,11 @@ func GetTxCmd() *cobra.Command {
 		getCmdBorrow(),
 		getCmdRepay(),
 		getCmdLiquidate(),
+		getCmdFlashLoan(),
+		getCmdLiquidateBorrow(),
+		getCmdRebalanceStableRate(),
+		getCmdRedeemReceiptTokens(),
+		getCmdTransferReceiptTokens(),
 	}
 
 	for _, cmd := range cmds {
@@ -43,11 +49,13 @@ func GetTxCmd() *cobra.Command {
 }
 
 func getCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [amount]",
 		Short: "deposit coins to hard",
+		Long:  strings.TrimSpace(`deposits coins to hard with optional --receipt-tokens param to receive transferable receipt tokens for the deposit`),
 		Example: fmt.Sprintf(
-			`%s tx %s deposit 10000000bnb --from <key>`, version.AppName, types.ModuleName,
+			`%[1]s tx %[2]s deposit 10000000bnb --from <key>
+%[1]s tx %[2]s deposit 10000000bnb --receipt-tokens --from <key>`, version.AppName, types.ModuleName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -59,13 +67,26 @@ func getCmdDeposit() *cobra.Command {
 			if err != nil {
 				return err
 			}
+
+			receiptTokens, err := cmd.Flags().GetBool(flagReceiptTokens)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), amount)
+			if receiptTokens {
+				msg = types.NewMsgDepositReceiptTokens(clientCtx.GetFromAddress(), amount)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Bool(flagReceiptTokens, false, "receive receipt tokens for the deposit instead of adding to the account's deposit")
+
+	return cmd
 }
 
 func getCmdWithdraw() *cobra.Command {
@@ -96,13 +117,14 @@ func getCmdWithdraw() *cobra.Command {
 }
 
 func getCmdBorrow() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "borrow [amount]",
 		Short: "borrow tokens from the hard protocol",
-		Long:  strings.TrimSpace(`borrows tokens from the hard protocol`),
+		Long:  strings.TrimSpace(`borrows tokens from the hard protocol with optional --stable-rate param to lock in the current stable rate`),
 		Args:  cobra.ExactArgs(1),
 		Example: fmt.Sprintf(
-			`%s tx %s borrow 1000000000uist --from <key>`, version.AppName, types.ModuleName,
+			`%[1]s tx %[2]s borrow 1000000000uist --from <key>
+%[1]s tx %[2]s borrow 1000000000uist --stable-rate --from <key>`, version.AppName, types.ModuleName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -115,13 +137,25 @@ func getCmdBorrow() *cobra.Command {
 				return err
 			}
 
+			stableRate, err := cmd.Flags().GetBool(flagStableRate)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgBorrow(clientCtx.GetFromAddress(), coins)
+			if stableRate {
+				msg = types.NewMsgBorrowStableRate(clientCtx.GetFromAddress(), coins)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Bool(flagStableRate, false, "borrow at the current stable rate instead of the variable rate")
+
+	return cmd
 }
 
 func getCmdRepay() *cobra.Command {
@@ -203,3 +237,161 @@ func getCmdLiquidate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdFlashLoan() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flash-loan [amount] [tx-json-file]",
+		Short: "borrow tokens from the hard protocol that are repaid in the same transaction",
+		Long: strings.TrimSpace(`borrows tokens from the hard protocol, executes the messages of a transaction
+generated with --generate-only, then repays the tokens plus the flash loan fee`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s flash-loan 1000000000usdx tx.json --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFlashLoan(clientCtx.GetFromAddress(), coins, theTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdLiquidateBorrow() *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate-borrow [borrower-addr] [repay] [collateral-denom]",
+		Short: "repay part of an unhealthy borrow in exchange for the borrower's deposits",
+		Long: strings.TrimSpace(`repays up to the close factor of a borrower's unhealthy borrow, receiving the borrower's
+deposits of the collateral denom worth the repayment plus a liquidation bonus`),
+		Args: cobra.ExactArgs(3),
+		Example: fmt.Sprintf(
+			`%s tx %s liquidate-borrow kava1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j 1000000usdx bnb --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			repay, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLiquidateBorrow(clientCtx.GetFromAddress(), borrower, repay, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdRebalanceStableRate() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebalance-stable-rate [borrower-addr] [denom]",
+		Short: "rebalance the rate of a stable rate borrow to the current stable rate",
+		Long: strings.TrimSpace(`resets the locked in rate of a borrower's stable rate borrow to the current stable rate, which
+is allowed while the utilization of the money market is at or above its rebalance utilization`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s rebalance-stable-rate kava1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j usdx --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRebalanceStableRate(clientCtx.GetFromAddress(), borrower, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdRedeemReceiptTokens() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-receipt-tokens [amount]",
+		Short: "redeem receipt tokens for the deposits they represent",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s redeem-receipt-tokens 10000000hard/bnb --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRedeemReceiptTokens(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdTransferReceiptTokens() *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-receipt-tokens [receiver] [amount]",
+		Short: "send receipt tokens to another account",
+		Args:  cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s transfer-receipt-tokens kava1... 10000000hard/bnb --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receiver, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferReceiptTokens(clientCtx.GetFromAddress(), receiver, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
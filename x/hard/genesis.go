@@ -35,10 +35,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 		k.SetBorrow(ctx, borrow)
 	}
 
+	// The debt of isolated collateral is rebuilt from the borrows of accounts with isolated deposits
+	for _, borrow := range gs.Borrows {
+		if isolatedMarket, isIsolated := k.GetIsolatedCollateral(ctx, borrow.Borrower); isIsolated {
+			k.IncrementIsolatedDebt(ctx, isolatedMarket.Denom, borrow.Amount)
+		}
+	}
+
 	k.SetSuppliedCoins(ctx, gs.TotalSupplied)
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
 
+	// The total borrowed at stable rates is rebuilt from the stable amounts of the borrows
+	for _, borrow := range gs.Borrows {
+		k.IncrementStableBorrowedCoins(ctx, borrow.StableAmount)
+	}
+
 	// check if the module account exists
 	DepositModuleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	if DepositModuleAccount == nil {
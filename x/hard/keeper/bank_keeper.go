@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+var _ bankkeeper.Keeper = &ReceiptBankKeeper{}
+
+// ReceiptBankKeeper is a bank keeper wrapper that calls the receipt token hooks for the accounts on both sides of every
+// send of hard receipt tokens. Receipt tokens earn supply rewards for their holder, so the rewards of both accounts
+// must be synced whenever receipt tokens move, whichever module moves them.
+// Module accounts don't earn receipt token rewards, so the hooks aren't called for the module side of module sends.
+type ReceiptBankKeeper struct {
+	bankkeeper.Keeper
+	hooks types.HARDHooks
+}
+
+// NewReceiptBankKeeper returns a bank keeper that calls receipt token hooks around sends of receipt tokens
+func NewReceiptBankKeeper(bk bankkeeper.Keeper) *ReceiptBankKeeper {
+	return &ReceiptBankKeeper{
+		Keeper: bk,
+		hooks:  nil,
+	}
+}
+
+// SetHooks sets the hooks called around sends of receipt tokens.
+func (k *ReceiptBankKeeper) SetHooks(hooks types.HARDHooks) *ReceiptBankKeeper {
+	if k.hooks != nil {
+		panic("cannot set receipt bank keeper hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// SendCoins sends coins between accounts, syncing the receipt token rewards of both accounts
+func (k *ReceiptBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.withReceiptHooks(ctx, amt, []sdk.AccAddress{fromAddr, toAddr}, func() error {
+		return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+// InputOutputCoins performs a multi-send, syncing the receipt token rewards of every account sending or receiving
+// receipt tokens
+func (k *ReceiptBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	var holders []sdk.AccAddress
+	for _, input := range inputs {
+		if hasReceiptTokens(input.Coins) {
+			holders = append(holders, sdk.MustAccAddressFromBech32(input.Address))
+		}
+	}
+	for _, output := range outputs {
+		if hasReceiptTokens(output.Coins) {
+			holders = append(holders, sdk.MustAccAddressFromBech32(output.Address))
+		}
+	}
+
+	return k.withReceiptHooks(ctx, nil, holders, func() error {
+		return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	})
+}
+
+// SendCoinsFromModuleToAccount sends coins from a module account, syncing the receipt token rewards of the recipient
+func (k *ReceiptBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	return k.withReceiptHooks(ctx, amt, []sdk.AccAddress{recipientAddr}, func() error {
+		return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
+
+// SendCoinsFromAccountToModule sends coins to a module account, syncing the receipt token rewards of the sender
+func (k *ReceiptBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	return k.withReceiptHooks(ctx, amt, []sdk.AccAddress{senderAddr}, func() error {
+		return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+// withReceiptHooks runs a send between the holders, calling the receipt token hooks of each holder around it if
+// receipt tokens are sent. A nil amount means the caller has already checked for receipt tokens.
+func (k *ReceiptBankKeeper) withReceiptHooks(ctx sdk.Context, amt sdk.Coins, holders []sdk.AccAddress, send func() error) error {
+	if k.hooks == nil || (amt != nil && !hasReceiptTokens(amt)) || len(holders) == 0 {
+		return send()
+	}
+
+	holders = uniqueAccountHolders(holders)
+	for _, holder := range holders {
+		k.hooks.BeforeReceiptTokensModified(ctx, holder)
+	}
+	if err := send(); err != nil {
+		return err
+	}
+	for _, holder := range holders {
+		k.hooks.AfterReceiptTokensModified(ctx, holder)
+	}
+	return nil
+}
+
+// hasReceiptTokens returns true if any of the coins are hard receipt tokens
+func hasReceiptTokens(coins sdk.Coins) bool {
+	for _, coin := range coins {
+		if _, ok := types.ParseReceiptDenom(coin.Denom); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// uniqueAccountHolders removes duplicate addresses and the hard module account, which only holds receipt tokens while
+// minting and burning them
+func uniqueAccountHolders(holders []sdk.AccAddress) []sdk.AccAddress {
+	hardModuleAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+	seen := make(map[string]bool)
+	var unique []sdk.AccAddress
+	for _, holder := range holders {
+		if seen[holder.String()] || holder.Equals(hardModuleAddress) {
+			continue
+		}
+		seen[holder.String()] = true
+		unique = append(unique, holder)
+	}
+	return unique
+}
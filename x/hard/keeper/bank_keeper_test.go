@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *KeeperTestSuite) TestReceiptBankKeeper() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+	holder := sdk.AccAddress(crypto.AddressHash([]byte("holder")))
+
+	usdxMarket := newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "1")
+	usdxMarket.ReceiptTokensEnabled = true
+	suite.setupMoneyMarketTest(
+		types.MoneyMarkets{
+			usdxMarket,
+			newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.8"),
+		},
+		supplier,
+		[]sdk.AccAddress{depositor},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)))},
+	)
+	bankKeeper := suite.app.GetBankKeeper()
+	incentiveKeeper := suite.app.GetIncentiveKeeper()
+	receiptBalances := func(addr sdk.AccAddress) sdk.Coins {
+		claim, found := incentiveKeeper.GetHardLiquidityProviderClaim(suite.ctx, addr)
+		suite.Require().True(found)
+		return claim.ReceiptBalances
+	}
+
+	receiptTokens, err := suite.keeper.DepositReceiptTokens(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiptTokens, receiptBalances(depositor))
+
+	// Receipt tokens sent to a module account, such as a swap pool, stop earning rewards for the sender
+	err = bankKeeper.SendCoinsFromAccountToModule(suite.ctx, depositor, swaptypes.ModuleName, receiptTokens)
+	suite.Require().NoError(err)
+	suite.Require().True(receiptBalances(depositor).Empty())
+
+	// Receipt tokens sent from a module account start earning rewards for the recipient
+	err = bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, swaptypes.ModuleName, holder, receiptTokens)
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiptTokens, receiptBalances(holder))
+
+	// Bank sends between accounts sync both accounts
+	err = bankKeeper.SendCoins(suite.ctx, holder, depositor, receiptTokens)
+	suite.Require().NoError(err)
+	suite.Require().True(receiptBalances(holder).Empty())
+	suite.Require().Equal(receiptTokens, receiptBalances(depositor))
+
+	// Module accounts don't get reward claims
+	_, found := incentiveKeeper.GetHardLiquidityProviderClaim(suite.ctx, suite.app.GetAccountKeeper().GetModuleAddress(swaptypes.ModuleName))
+	suite.Require().False(found)
+	_, found = incentiveKeeper.GetHardLiquidityProviderClaim(suite.ctx, suite.app.GetAccountKeeper().GetModuleAddress(types.ModuleAccountName))
+	suite.Require().False(found)
+}
@@ -12,6 +12,11 @@ import (
 
 // Borrow funds
 func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
+	return k.borrow(ctx, borrower, coins, false)
+}
+
+// borrow funds at variable rates, or at stable rates if stableRate is true
+func (k Keeper) borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins, stableRate bool) error {
 	// Set any new denoms' global borrow index to 1.0
 	for _, coin := range coins {
 		_, foundInterestFactor := k.GetBorrowInterestFactor(ctx, coin.Denom)
@@ -36,6 +41,18 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	k.SyncSupplyInterest(ctx, borrower)
 	k.SyncBorrowInterest(ctx, borrower)
 
+	if stableRate {
+		for _, coin := range coins {
+			moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+			if !found {
+				return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+			}
+			if !moneyMarket.StableRateEnabled() {
+				return errorsmod.Wrapf(types.ErrStableRateDisabled, "no stable rate premium set for denom %s", coin.Denom)
+			}
+		}
+	}
+
 	// Validate borrow amount within user and protocol limits
 	err := k.ValidateBorrow(ctx, borrower, coins)
 	if err != nil {
@@ -83,15 +100,35 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 
 	// Construct the user's new/updated borrow with amount and interest factors
 	borrow := types.NewBorrow(borrower, amount, interestFactors)
+	if foundBorrow {
+		borrow.StableAmount = currBorrow.StableAmount
+		borrow.StableRates = currBorrow.StableRates
+	}
+
+	if stableRate {
+		// Stable rates are locked in after the borrow is added to the total stable borrowed coins, so they reflect the
+		// utilization of the money markets after the borrow
+		k.IncrementStableBorrowedCoins(ctx, coins)
+		borrow, err = k.lockStableRates(ctx, borrow, coins)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Update total borrowed amount by newly borrowed coins. Don't add user's pending interest as
+		// it has already been included in the total borrowed coins by the BeginBlocker.
+		k.IncrementBorrowedCoins(ctx, coins)
+	}
+
 	if borrow.Amount.Empty() {
 		k.DeleteBorrow(ctx, borrow)
 	} else {
 		k.SetBorrow(ctx, borrow)
 	}
 
-	// Update total borrowed amount by newly borrowed coins. Don't add user's pending interest as
-	// it has already been included in the total borrowed coins by the BeginBlocker.
-	k.IncrementBorrowedCoins(ctx, coins)
+	// Track the coins borrowed against isolated collateral for the isolated money market's debt ceiling
+	if isolatedMarket, isIsolated := k.GetIsolatedCollateral(ctx, borrower); isIsolated {
+		k.IncrementIsolatedDebt(ctx, isolatedMarket.Denom, coins)
+	}
 
 	if !hasExistingBorrow {
 		k.AfterBorrowCreated(ctx, borrow)
@@ -147,17 +184,16 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		if err != nil {
 			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
+		// borrowing is paused while the price is frozen by the pricefeed circuit breaker
+		if k.pricefeedKeeper.IsMarketFrozen(ctx, moneyMarket.SpotMarketID) {
+			return errorsmod.Wrapf(types.ErrPriceFrozen, "price of market %s is frozen", moneyMarket.SpotMarketID)
+		}
 		coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
 
 		// Validate the requested borrow value for the asset against the money market's global borrow limit
 		if moneyMarket.BorrowLimit.HasMaxLimit {
 			var assetTotalBorrowedAmount sdkmath.Int
-			totalBorrowedCoins, found := k.GetBorrowedCoins(ctx)
-			if !found {
-				assetTotalBorrowedAmount = sdk.ZeroInt()
-			} else {
-				assetTotalBorrowedAmount = totalBorrowedCoins.AmountOf(coin.Denom)
-			}
+			assetTotalBorrowedAmount = k.getTotalBorrowedCoins(ctx).AmountOf(coin.Denom)
 			newProposedAssetTotalBorrowedAmount := sdk.NewDecFromInt(assetTotalBorrowedAmount.Add(coin.Amount))
 			if newProposedAssetTotalBorrowedAmount.GT(moneyMarket.BorrowLimit.MaximumLimit) {
 				return errorsmod.Wrapf(types.ErrGreaterThanAssetBorrowLimit,
@@ -173,6 +209,24 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	if !found {
 		return errorsmod.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
 	}
+
+	// Deposits of an isolated money market can only back borrows of its borrowable denoms up to its debt ceiling
+	isolatedMarket, isIsolated, err := k.ValidateCollateralIsolation(ctx, deposit.Amount)
+	if err != nil {
+		return err
+	}
+	if isIsolated {
+		if err := k.ValidateIsolatedBorrow(ctx, isolatedMarket, amount); err != nil {
+			return err
+		}
+	}
+
+	// Deposits are borrowed against with their e-mode loan-to-value when the deposits and borrows are all in the same
+	// e-mode category
+	existingBorrow, hasExistingBorrow := k.GetBorrow(ctx, borrower)
+	borrowDenoms := getDenoms(existingBorrow.Amount.Add(amount...))
+	eMode := k.GetEModeCategory(ctx, removeDuplicates(getDenoms(deposit.Amount), borrowDenoms)) != ""
+
 	totalBorrowableAmount := sdk.ZeroDec()
 	for _, coin := range deposit.Amount {
 		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
@@ -185,15 +239,18 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		if err != nil {
 			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
+		// borrowing is paused while the price is frozen by the pricefeed circuit breaker
+		if k.pricefeedKeeper.IsMarketFrozen(ctx, moneyMarket.SpotMarketID) {
+			return errorsmod.Wrapf(types.ErrPriceFrozen, "price of market %s is frozen", moneyMarket.SpotMarketID)
+		}
 		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
+		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.LoanToValue(eMode))
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
 	}
 
 	// Get the total USD value of user's existing borrows
 	existingBorrowUSDValue := sdk.ZeroDec()
-	existingBorrow, found := k.GetBorrow(ctx, borrower)
-	if found {
+	if hasExistingBorrow {
 		for _, coin := range existingBorrow.Amount {
 			moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
 			if !found {
@@ -306,6 +363,7 @@ func (k Keeper) GetSyncedBorrow(ctx sdk.Context, borrower sdk.AccAddress) (types
 func (k Keeper) loadSyncedBorrow(ctx sdk.Context, borrow types.Borrow) types.Borrow {
 	totalNewInterest := sdk.Coins{}
 	newBorrowIndexes := types.BorrowInterestFactors{}
+	variableAmount := borrow.VariableAmount()
 	for _, coin := range borrow.Amount {
 		interestFactorValue, foundInterestFactorValue := k.GetBorrowInterestFactor(ctx, coin.Denom)
 		if foundInterestFactorValue {
@@ -320,7 +378,7 @@ func (k Keeper) loadSyncedBorrow(ctx sdk.Context, borrow types.Borrow) types.Bor
 
 			// Calculate interest owed by user for this asset
 			if foundAtIndex != -1 {
-				storedAmount := sdk.NewDecFromInt(borrow.Amount.AmountOf(coin.Denom))
+				storedAmount := sdk.NewDecFromInt(variableAmount.AmountOf(coin.Denom))
 				userLastInterestFactor := borrow.Index[foundAtIndex].Value
 				coinInterest := (storedAmount.Quo(userLastInterestFactor).Mul(interestFactorValue)).Sub(storedAmount)
 				totalNewInterest = totalNewInterest.Add(sdk.NewCoin(coin.Denom, coinInterest.TruncateInt()))
@@ -331,5 +389,9 @@ func (k Keeper) loadSyncedBorrow(ctx sdk.Context, borrow types.Borrow) types.Bor
 		newBorrowIndexes = append(newBorrowIndexes, borrowIndex)
 	}
 
-	return types.NewBorrow(borrow.Borrower, borrow.Amount.Add(totalNewInterest...), newBorrowIndexes)
+	syncedBorrow := types.NewBorrow(borrow.Borrower, borrow.Amount.Add(totalNewInterest...), newBorrowIndexes)
+	syncedBorrow.StableAmount = borrow.StableAmount
+	syncedBorrow.StableRates = borrow.StableRates
+	syncedBorrow, _ = accrueStableInterest(syncedBorrow, ctx.BlockTime())
+	return syncedBorrow
 }
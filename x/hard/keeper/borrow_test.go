@@ -817,6 +817,80 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestBorrow_FrozenPrice() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	borrower := addrs[0]
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithSameCoins(
+		tApp.AppCodec(),
+		sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000*KAVA_CF))),
+		[]sdk.AccAddress{borrower},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	hardGS := types.NewGenesisState(
+		types.NewParams(
+			types.MoneyMarkets{
+				types.NewMoneyMarket("ukava",
+					types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+					"kava:usd",
+					sdkmath.NewInt(KAVA_CF),
+					model,
+					sdk.MustNewDecFromStr("0.05"),
+					sdk.ZeroDec()),
+			},
+			sdk.NewDec(10),
+		),
+		types.DefaultAccumulationTimes,
+		types.DefaultDeposits,
+		types.DefaultBorrows,
+		types.DefaultTotalSupplied,
+		types.DefaultTotalBorrowed,
+		types.DefaultTotalReserves,
+	)
+
+	market := pricefeedtypes.NewMarket("kava:usd", "kava", "usd", []sdk.AccAddress{}, true)
+	market.MaxPriceDeviation = sdk.MustNewDecFromStr("0.1")
+	market.PriceRecoveryUpdates = 10
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{market},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))))
+	suite.Require().NoError(err)
+
+	// a price move over the market's max deviation freezes the price
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", sdk.MustNewDecFromStr("4.00"), time.Now().Add(1*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, "kava:usd"))
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))))
+	suite.Require().ErrorIs(err, types.ErrPriceFrozen)
+}
+
 func (suite *KeeperTestSuite) TestFilterCoinsByDenoms() {
 	type args struct {
 		coins         sdk.Coins
@@ -38,6 +38,13 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		return err
 	}
 
+	// Deposits of an isolated money market can't be combined with other deposits
+	syncedDeposit, _ := k.GetDeposit(ctx, depositor)
+	err = k.ValidateCollateralChange(ctx, depositor, syncedDeposit.Amount.Add(coins...))
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
 		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// FlashLoan lends coins from the money markets to the borrower and executes msgs on behalf of the borrower. Once the
+// msgs have executed, the loan plus a fee is collected from the borrower, and the flash loan fails if it can't be
+// repaid. The fee is split between the reserves and the suppliers of each money market by its reserve factor.
+func (k Keeper) FlashLoan(ctx sdk.Context, borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) ([][]byte, error) {
+	// Flash loans can't be taken by msgs executed within a flash loan
+	if k.GetFlashLoanInProgress(ctx) {
+		return nil, types.ErrFlashLoanInProgress
+	}
+
+	fees, err := k.ValidateFlashLoan(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Interest is accrued before the loan leaves the module account, as accrual within the msgs would otherwise
+	// see the cash drained by the loan
+	for _, coin := range amount {
+		if err := k.AccrueInterest(ctx, coin.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetFlashLoanInProgress(ctx, true)
+	defer k.SetFlashLoanInProgress(ctx, false)
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.executeFlashLoanMsgs(ctx, borrower, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	repayment := amount.Add(fees...)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, borrower, types.ModuleAccountName, repayment)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrFlashLoanNotRepaid, "borrower %s cannot repay %s: %s", borrower, repayment, err)
+	}
+
+	k.distributeFlashLoanFees(ctx, fees)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardFlashLoan,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyFlashLoanCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFlashLoanFee, fees.String()),
+		),
+	)
+
+	return results, nil
+}
+
+// ValidateFlashLoan validates a flash loan request against the money markets and protocol limits, returning the
+// fees owed on the loan
+func (k Keeper) ValidateFlashLoan(ctx sdk.Context, amount sdk.Coins) (sdk.Coins, error) {
+	if amount.IsZero() {
+		return nil, types.ErrBorrowEmptyCoins
+	}
+
+	fees := sdk.NewCoins()
+	for _, coin := range amount {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		if !moneyMarket.FlashLoansEnabled() {
+			return nil, errorsmod.Wrapf(types.ErrFlashLoansDisabled, "no flash loan fee set for denom %s", coin.Denom)
+		}
+		// Fees are rounded up so every flash loan pays a fee
+		fee := sdk.NewDecFromInt(coin.Amount).Mul(moneyMarket.FlashLoanFee).Ceil().TruncateInt()
+		fees = fees.Add(sdk.NewCoin(coin.Denom, fee))
+	}
+
+	// The reserve coins aren't available for flash loans
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	hardMaccCoins := FilterCoinsByDenoms(k.bankKeeper.GetAllBalances(ctx, macc.GetAddress()), amount)
+	reserveCoins, foundReserveCoins := k.GetTotalReserves(ctx)
+	if !foundReserveCoins {
+		reserveCoins = sdk.NewCoins()
+	} else {
+		reserveCoins = FilterCoinsByDenoms(reserveCoins, amount)
+	}
+
+	fundsAvailableToLend, isNegative := hardMaccCoins.SafeSub(reserveCoins...)
+	if isNegative {
+		return nil, errorsmod.Wrapf(types.ErrReservesExceedCash, "reserves %s > cash %s", reserveCoins, hardMaccCoins)
+	}
+	if amount.IsAnyGT(fundsAvailableToLend) {
+		return nil, errorsmod.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested flash loan %s > available to borrow %s", amount, fundsAvailableToLend)
+	}
+
+	return fees, nil
+}
+
+// executeFlashLoanMsgs routes each msg to its handler, returning the result data of each msg
+func (k Keeper) executeFlashLoanMsgs(ctx sdk.Context, borrower sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(borrower) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFlashLoanMsg, "message %d must be signed by the borrower only", i)
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message %d", i)
+		}
+		results[i] = res.Data
+
+		// The handler emits events to its own event manager, so they are emitted again here
+		for _, event := range res.Events {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+	}
+	return results, nil
+}
+
+// distributeFlashLoanFees adds the reserve factor share of each fee to the reserves and the rest to the suppliers of
+// the money market
+func (k Keeper) distributeFlashLoanFees(ctx sdk.Context, fees sdk.Coins) {
+	// Cash excludes the fees, which have already been paid to the module account
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	cash := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress()).Sub(fees...)
+	k.distributeInterest(ctx, fees, cash)
+}
+
+// distributeInterest adds the reserve factor share of interest to the reserves and the rest to the suppliers of the
+// money market by increasing the supply interest factor. Cash is the module account balance the supply interest factor
+// is calculated from.
+func (k Keeper) distributeInterest(ctx sdk.Context, interest sdk.Coins, cash sdk.Coins) {
+	reserves, foundReserves := k.GetTotalReserves(ctx)
+	if !foundReserves {
+		reserves = sdk.NewCoins()
+	}
+	suppliedCoins, _ := k.GetSuppliedCoins(ctx)
+	borrowedCoins := k.getTotalBorrowedCoins(ctx)
+
+	for _, coin := range interest {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			panic(errorsmod.Wrapf(types.ErrMoneyMarketNotFound, "%s", coin.Denom))
+		}
+
+		reservesNew := sdk.NewDecFromInt(coin.Amount).Mul(moneyMarket.ReserveFactor).TruncateInt()
+		supplyInterestNew := coin.Amount.Sub(reservesNew)
+
+		supplyInterestFactorPrior, foundSupplyInterestFactor := k.GetSupplyInterestFactor(ctx, coin.Denom)
+		if !foundSupplyInterestFactor || suppliedCoins.AmountOf(coin.Denom).IsZero() {
+			// Without suppliers all of the interest is added to the reserves
+			reservesNew = coin.Amount
+		} else if supplyInterestNew.IsPositive() {
+			supplyInterestFactor := CalculateSupplyInterestFactor(
+				sdk.NewDecFromInt(supplyInterestNew),
+				sdk.NewDecFromInt(cash.AmountOf(coin.Denom)),
+				sdk.NewDecFromInt(borrowedCoins.AmountOf(coin.Denom)),
+				sdk.NewDecFromInt(reserves.AmountOf(coin.Denom)),
+			)
+			k.SetSupplyInterestFactor(ctx, coin.Denom, supplyInterestFactorPrior.Mul(supplyInterestFactor))
+			k.IncrementSuppliedCoins(ctx, sdk.NewCoins(sdk.NewCoin(coin.Denom, supplyInterestNew)))
+		}
+
+		reserves = reserves.Add(sdk.NewCoin(coin.Denom, reservesNew))
+	}
+
+	k.SetTotalReserves(ctx, reserves)
+}
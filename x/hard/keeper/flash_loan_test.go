@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashLoan() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	other := sdk.AccAddress(crypto.AddressHash([]byte("other")))
+
+	supplierCoins := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)), sdk.NewCoin("ukava", sdkmath.NewInt(1000*KAVA_CF)))
+	borrowerCoins := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(10*USDX_CF)))
+	loan := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)))
+
+	depositAndWithdraw := func() []sdk.Msg {
+		deposit := types.NewMsgDeposit(borrower, loan)
+		withdraw := types.NewMsgWithdraw(borrower, loan)
+		return []sdk.Msg{&deposit, &withdraw}
+	}
+
+	testCases := []struct {
+		name        string
+		amount      sdk.Coins
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{
+			name:   "valid: loan is repaid with fee",
+			amount: loan,
+			msgs:   depositAndWithdraw(),
+		},
+		{
+			name:        "invalid: flash loans disabled for market",
+			amount:      sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))),
+			msgs:        depositAndWithdraw(),
+			expectedErr: types.ErrFlashLoansDisabled,
+		},
+		{
+			name:        "invalid: no money market",
+			amount:      sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100))),
+			msgs:        depositAndWithdraw(),
+			expectedErr: types.ErrMarketNotFound,
+		},
+		{
+			name:        "invalid: exceeds available cash",
+			amount:      sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1001*USDX_CF))),
+			msgs:        depositAndWithdraw(),
+			expectedErr: types.ErrExceedsProtocolBorrowableBalance,
+		},
+		{
+			name:   "invalid: loan is not repaid",
+			amount: loan,
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(borrower, other, loan),
+			},
+			expectedErr: types.ErrFlashLoanNotRepaid,
+		},
+		{
+			name:   "invalid: message not signed by borrower",
+			amount: loan,
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(other, borrower, loan),
+			},
+			expectedErr: types.ErrInvalidFlashLoanMsg,
+		},
+		{
+			name:   "invalid: nested flash loan",
+			amount: loan,
+			msgs: func() []sdk.Msg {
+				nested := types.NewMsgFlashLoan(borrower, loan, depositAndWithdraw())
+				return []sdk.Msg{&nested}
+			}(),
+			expectedErr: types.ErrFlashLoanInProgress,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupFlashLoanTest(supplier, supplierCoins, borrower, borrowerCoins)
+
+			err := suite.keeper.Deposit(suite.ctx, supplier, supplierCoins)
+			suite.Require().NoError(err)
+
+			_, err = suite.keeper.FlashLoan(suite.ctx, borrower, tc.amount, tc.msgs)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().False(suite.keeper.GetFlashLoanInProgress(suite.ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().False(suite.keeper.GetFlashLoanInProgress(suite.ctx))
+
+			// fee of 0.1% is split by the 5% reserve factor
+			fee := sdk.NewCoin("usdx", sdkmath.NewInt(100_000))
+			suite.Require().Equal(borrowerCoins.Sub(fee), suite.getAccountCoins(suite.getAccount(borrower)))
+
+			mAcc := suite.getModuleAccount(types.ModuleAccountName)
+			suite.Require().Equal(supplierCoins.Add(fee), suite.getAccountCoins(mAcc))
+
+			reserves, found := suite.keeper.GetTotalReserves(suite.ctx)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(5_000))), reserves)
+
+			supplied, found := suite.keeper.GetSuppliedCoins(suite.ctx)
+			suite.Require().True(found)
+			suite.Require().Equal(supplierCoins.Add(sdk.NewCoin("usdx", sdkmath.NewInt(95_000))), supplied)
+
+			supplyInterestFactor, found := suite.keeper.GetSupplyInterestFactor(suite.ctx, "usdx")
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.MustNewDecFromStr("1.000095"), supplyInterestFactor)
+
+			suite.Require().Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+				types.EventTypeHardFlashLoan,
+				sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+				sdk.NewAttribute(types.AttributeKeyFlashLoanCoins, loan.String()),
+				sdk.NewAttribute(types.AttributeKeyFlashLoanFee, fee.String()),
+			))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFlashLoan_AccruesInterestBeforeLoan() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	supplierCoins := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)), sdk.NewCoin("ukava", sdkmath.NewInt(1000*KAVA_CF)))
+	borrowerCoins := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(10*USDX_CF)))
+	loan := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(400*USDX_CF)))
+
+	suite.setupFlashLoanTest(supplier, supplierCoins, borrower, borrowerCoins)
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, supplier, supplierCoins))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, supplier, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(500*USDX_CF)))))
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * time.Minute))
+
+	// A flash loan without msgs accrues interest on the cash held before the loan
+	expectedCtx, _ := suite.ctx.CacheContext()
+	_, err := suite.keeper.FlashLoan(expectedCtx, borrower, loan, nil)
+	suite.Require().NoError(err)
+	expectedBorrowFactor, _ := suite.keeper.GetBorrowInterestFactor(expectedCtx, "usdx")
+	expectedSupplyFactor, _ := suite.keeper.GetSupplyInterestFactor(expectedCtx, "usdx")
+	suite.Require().True(expectedBorrowFactor.GT(sdk.OneDec()))
+
+	// Msgs that accrue interest within the flash loan don't change the interest factors
+	deposit := types.NewMsgDeposit(borrower, loan)
+	withdraw := types.NewMsgWithdraw(borrower, loan)
+	_, err = suite.keeper.FlashLoan(suite.ctx, borrower, loan, []sdk.Msg{&deposit, &withdraw})
+	suite.Require().NoError(err)
+
+	borrowFactor, _ := suite.keeper.GetBorrowInterestFactor(suite.ctx, "usdx")
+	supplyFactor, _ := suite.keeper.GetSupplyInterestFactor(suite.ctx, "usdx")
+	suite.Require().Equal(expectedBorrowFactor, borrowFactor)
+	suite.Require().Equal(expectedSupplyFactor, supplyFactor)
+}
+
+func (suite *KeeperTestSuite) setupFlashLoanTest(supplier sdk.AccAddress, supplierCoins sdk.Coins, borrower sdk.AccAddress, borrowerCoins sdk.Coins) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{supplierCoins, borrowerCoins},
+		[]sdk.AccAddress{supplier, borrower},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	usdxMarket := types.NewMoneyMarket("usdx",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), // Borrow Limit
+		"usdx:usd",                    // Market ID
+		sdkmath.NewInt(USDX_CF),       // Conversion Factor
+		model,                         // Interest Rate Model
+		sdk.MustNewDecFromStr("0.05"), // Reserve Factor
+		sdk.MustNewDecFromStr("0.05")) // Keeper Reward Percent
+	usdxMarket.FlashLoanFee = sdk.MustNewDecFromStr("0.001")
+
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			usdxMarket,
+			types.NewMoneyMarket("ukava",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
+				"kava:usd",                     // Market ID
+				sdkmath.NewInt(KAVA_CF),        // Conversion Factor
+				model,                          // Interest Rate Model
+				sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
+				sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	// Run BeginBlocker once to transition MoneyMarkets
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+}
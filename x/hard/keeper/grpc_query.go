@@ -378,13 +378,25 @@ func (s queryServer) TotalBorrowed(ctx context.Context, req *types.QueryTotalBor
 		borrowedCoins = sdk.NewCoins()
 	}
 
+	// Stable borrowed coins are left out when nothing is borrowed at stable rates
+	var stableBorrowedCoins sdk.Coins
+	if coins, found := s.keeper.GetStableBorrowedCoins(sdkCtx); found {
+		stableBorrowedCoins = coins
+	}
+
 	// If user specified a denom only return coins of that denom type
 	if len(req.Denom) > 0 {
 		borrowedCoins = sdk.NewCoins(sdk.NewCoin(req.Denom, borrowedCoins.AmountOf(req.Denom)))
+		if amount := stableBorrowedCoins.AmountOf(req.Denom); amount.IsPositive() {
+			stableBorrowedCoins = sdk.NewCoins(sdk.NewCoin(req.Denom, amount))
+		} else {
+			stableBorrowedCoins = nil
+		}
 	}
 
 	return &types.QueryTotalBorrowedResponse{
-		BorrowedCoins: borrowedCoins,
+		BorrowedCoins:       borrowedCoins,
+		StableBorrowedCoins: stableBorrowedCoins,
 	}, nil
 }
 
@@ -433,27 +445,17 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 	// Calculate the borrow and supply APY interest rates for each money market
 	for _, moneyMarket := range moneyMarkets {
 		denom := moneyMarket.Denom
-		macc := s.accountKeeper.GetModuleAccount(sdkCtx, types.ModuleName)
-		cash := s.bankKeeper.GetBalance(sdkCtx, macc.GetAddress(), denom).Amount
 
-		borrowed := sdk.NewCoin(denom, sdk.ZeroInt())
-		borrowedCoins, foundBorrowedCoins := s.keeper.GetBorrowedCoins(sdkCtx)
-		if foundBorrowedCoins {
-			borrowed = sdk.NewCoin(denom, borrowedCoins.AmountOf(denom))
-		}
-
-		reserves, foundReserves := s.keeper.GetTotalReserves(sdkCtx)
-		if !foundReserves {
-			reserves = sdk.NewCoins()
-		}
+		// Utilization includes borrows at both variable and stable rates
+		cash, borrowed, reserves := s.keeper.loadMarketLiquidity(sdkCtx, denom)
 
 		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has ien borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, cash, borrowed, reserves)
 		if err != nil {
 			return nil, err
 		}
 
-		utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		utilRatio := CalculateUtilizationRatio(cash, borrowed, reserves)
 		fullSupplyAPY := borrowAPY.Mul(utilRatio)
 		realSupplyAPY := fullSupplyAPY.Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
 
@@ -462,6 +464,9 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 			SupplyInterestRate: realSupplyAPY.String(),
 			BorrowInterestRate: borrowAPY.String(),
 		}
+		if moneyMarket.StableRateEnabled() {
+			moneyMarketInterestRate.StableBorrowInterestRate = borrowAPY.Add(moneyMarket.StableRatePremium).String()
+		}
 
 		moneyMarketInterestRates = append(moneyMarketInterestRates, moneyMarketInterestRate)
 	}
@@ -544,3 +549,34 @@ func (s queryServer) InterestFactors(ctx context.Context, req *types.QueryIntere
 		InterestFactors: interestFactors,
 	}, nil
 }
+
+func (s queryServer) HealthFactor(ctx context.Context, req *types.QueryHealthFactorRequest) (*types.QueryHealthFactorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	borrow, found := s.keeper.GetSyncedBorrow(sdkCtx, owner)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBorrowNotFound, "no borrows found for %s", owner)
+	}
+	deposit, found := s.keeper.GetSyncedDeposit(sdkCtx, owner)
+	if !found {
+		deposit = types.NewDeposit(owner, sdk.NewCoins(), types.SupplyInterestFactors{})
+	}
+
+	healthFactor, err := s.keeper.CalculateHealthFactor(sdkCtx, deposit, borrow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHealthFactorResponse{
+		HealthFactor: healthFactor.String(),
+	}, nil
+}
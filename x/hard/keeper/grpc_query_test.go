@@ -65,7 +65,9 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryParams() {
 	defaultHARDState := NewHARDGenState(suite.tApp.AppCodec())
 	suite.tApp.AppCodec().MustUnmarshalJSON(defaultHARDState[types.ModuleName], &expected)
 
-	suite.Equal(expected.Params, res.Params, "params should equal test genesis state")
+	// compare encoded params, as the param store decodes empty isolation mode borrowable denoms as nil
+	cdc := suite.tApp.AppCodec()
+	suite.JSONEq(string(cdc.MustMarshalJSON(&expected.Params)), string(cdc.MustMarshalJSON(&res.Params)), "params should equal test genesis state")
 }
 
 func (suite *grpcQueryTestSuite) TestGrpcQueryAccounts() {
@@ -50,3 +50,17 @@ func (k Keeper) AfterBorrowModified(ctx sdk.Context, borrow types.Borrow) {
 		k.hooks.AfterBorrowModified(ctx, borrow)
 	}
 }
+
+// BeforeReceiptTokensModified - call hook if registered
+func (k Keeper) BeforeReceiptTokensModified(ctx sdk.Context, holder sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.BeforeReceiptTokensModified(ctx, holder)
+	}
+}
+
+// AfterReceiptTokensModified - call hook if registered
+func (k Keeper) AfterReceiptTokensModified(ctx sdk.Context, holder sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterReceiptTokensModified(ctx, holder)
+	}
+}
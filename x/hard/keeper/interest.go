@@ -88,6 +88,10 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 		return nil
 	}
 
+	// Stable rate borrows accrue interest at their own rates, but count towards the utilization of the money market
+	stableBorrowedCoinsPrior, _ := k.GetStableBorrowedCoins(ctx)
+	totalBorrowedPrior := borrowedPrior.Amount.Add(stableBorrowedCoinsPrior.AmountOf(denom))
+
 	reservesPrior, foundReservesPrior := k.GetTotalReserves(ctx)
 	if !foundReservesPrior {
 		newReservesPrior := sdk.NewCoins()
@@ -116,7 +120,7 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	}
 
 	// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-	borrowRateApy, err := CalculateBorrowRate(mm.InterestRateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	borrowRateApy, err := CalculateBorrowRate(mm.InterestRateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(totalBorrowedPrior), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
 	if err != nil {
 		return err
 	}
@@ -143,7 +147,7 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 
 	// Calculate supply interest factor and update
 	supplyInterestNew := interestBorrowAccumulated.Sub(reservesNew)
-	supplyInterestFactor := CalculateSupplyInterestFactor(sdk.NewDecFromInt(supplyInterestNew), sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	supplyInterestFactor := CalculateSupplyInterestFactor(sdk.NewDecFromInt(supplyInterestNew), sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(totalBorrowedPrior), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
 	supplyInterestFactorNew := supplyInterestFactorPrior.Mul(supplyInterestFactor)
 	k.SetSupplyInterestFactor(ctx, denom, supplyInterestFactorNew)
 
@@ -226,6 +230,7 @@ func (k Keeper) SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress) {
 	if !found {
 		return
 	}
+	variableAmount := borrow.VariableAmount()
 	for _, coin := range borrow.Amount {
 		// Locate the borrow interest factor item by coin denom in the user's list of borrow indexes
 		foundAtIndex := -1
@@ -241,7 +246,7 @@ func (k Keeper) SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress) {
 			borrow.Index = append(borrow.Index, types.NewBorrowInterestFactor(coin.Denom, interestFactorValue))
 		} else { // User has an existing borrow index for this denom
 			// Calculate interest owed by user since asset's last borrow index update
-			storedAmount := sdk.NewDecFromInt(borrow.Amount.AmountOf(coin.Denom))
+			storedAmount := sdk.NewDecFromInt(variableAmount.AmountOf(coin.Denom))
 			userLastInterestFactor := borrow.Index[foundAtIndex].Value
 			interest := (storedAmount.Quo(userLastInterestFactor).Mul(interestFactorValue)).Sub(storedAmount)
 			totalNewInterest = totalNewInterest.Add(sdk.NewCoin(coin.Denom, interest.TruncateInt()))
@@ -252,6 +257,15 @@ func (k Keeper) SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress) {
 	// Add all pending interest to user's borrow
 	borrow.Amount = borrow.Amount.Add(totalNewInterest...)
 
+	// Stable rate borrows accrue interest when they are synced, which is distributed to the reserves and suppliers
+	borrow, stableInterest := accrueStableInterest(borrow, ctx.BlockTime())
+	if !stableInterest.Empty() {
+		macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+		cash := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+		k.distributeInterest(ctx, stableInterest, cash)
+		k.IncrementStableBorrowedCoins(ctx, stableInterest)
+	}
+
 	// Update user's borrow in the store
 	k.SetBorrow(ctx, borrow)
 }
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// ValidateCollateralIsolation validates that deposits of an isolated money market aren't combined with other deposits,
+// returning the isolated money market if the deposits are isolated
+func (k Keeper) ValidateCollateralIsolation(ctx sdk.Context, deposits sdk.Coins) (types.MoneyMarket, bool, error) {
+	for _, coin := range deposits {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found || !moneyMarket.IsolationMode.Enabled {
+			continue
+		}
+		if len(deposits) > 1 {
+			return types.MoneyMarket{}, false, errorsmod.Wrapf(types.ErrIsolatedCollateral,
+				"%s deposits cannot be combined with deposits of %s", coin.Denom, deposits)
+		}
+		return moneyMarket, true, nil
+	}
+	return types.MoneyMarket{}, false, nil
+}
+
+// ValidateCollateralChange validates an account's proposed deposits against isolation mode. Deposits of an isolated
+// money market can't be combined with other deposits, and an account's deposits can't become isolated while it has
+// borrows that weren't made against them, as those borrows aren't tracked in the isolated market's debt.
+func (k Keeper) ValidateCollateralChange(ctx sdk.Context, addr sdk.AccAddress, proposedDeposits sdk.Coins) error {
+	isolatedMarket, isolated, err := k.ValidateCollateralIsolation(ctx, proposedDeposits)
+	if err != nil || !isolated {
+		return err
+	}
+	if currentMarket, found := k.GetIsolatedCollateral(ctx, addr); found && currentMarket.Denom == isolatedMarket.Denom {
+		return nil
+	}
+	if _, hasBorrow := k.GetBorrow(ctx, addr); hasBorrow {
+		return errorsmod.Wrapf(types.ErrIsolatedCollateral,
+			"deposits cannot become isolated %s deposits while borrows against other deposits are outstanding", isolatedMarket.Denom)
+	}
+	return nil
+}
+
+// ValidateReceiptIsolation validates coins deposited for or redeemed from receipt tokens against isolation mode.
+// Receipt tokens are freely transferable and aren't counted in any account's deposits, so deposits of an isolated money
+// market can't be exchanged for receipt tokens, as they would escape the market's debt ceiling. Receipt tokens minted
+// before a money market was isolated can still be redeemed, but not together with receipt tokens of other markets.
+func (k Keeper) ValidateReceiptIsolation(ctx sdk.Context, coins sdk.Coins, minting bool) error {
+	isolatedMarket, isolated, err := k.ValidateCollateralIsolation(ctx, coins)
+	if err != nil {
+		return err
+	}
+	if isolated && minting {
+		return errorsmod.Wrapf(types.ErrIsolatedCollateral,
+			"isolated %s deposits cannot be exchanged for receipt tokens", isolatedMarket.Denom)
+	}
+	return nil
+}
+
+// GetIsolatedCollateral returns the isolated money market backing an account's borrows if the account's deposits are
+// isolated
+func (k Keeper) GetIsolatedCollateral(ctx sdk.Context, addr sdk.AccAddress) (types.MoneyMarket, bool) {
+	deposit, found := k.GetDeposit(ctx, addr)
+	if !found || len(deposit.Amount) != 1 {
+		return types.MoneyMarket{}, false
+	}
+	moneyMarket, found := k.GetMoneyMarket(ctx, deposit.Amount[0].Denom)
+	if !found || !moneyMarket.IsolationMode.Enabled {
+		return types.MoneyMarket{}, false
+	}
+	return moneyMarket, true
+}
+
+// ValidateIsolatedBorrow validates a borrow against deposits of an isolated money market against the money market's
+// borrowable denoms and debt ceiling
+func (k Keeper) ValidateIsolatedBorrow(ctx sdk.Context, isolatedMarket types.MoneyMarket, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !isolatedMarket.IsolationMode.IsBorrowable(coin.Denom) {
+			return errorsmod.Wrapf(types.ErrBorrowNotAllowedInIsolation, "%s cannot be borrowed against isolated %s deposits",
+				coin.Denom, isolatedMarket.Denom)
+		}
+	}
+
+	debt, _ := k.GetIsolatedDebt(ctx, isolatedMarket.Denom)
+	proposedDebt := debt.Add(amount...)
+	proposedDebtUSDValue := sdk.ZeroDec()
+	for _, coin := range proposedDebt {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+		}
+		coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
+		proposedDebtUSDValue = proposedDebtUSDValue.Add(coinUSDValue)
+	}
+
+	if proposedDebtUSDValue.GT(isolatedMarket.IsolationMode.DebtCeiling) {
+		return errorsmod.Wrapf(types.ErrExceedsDebtCeiling,
+			"proposed borrow would result in $%s borrowed against isolated %s deposits, but the debt ceiling is $%s",
+			proposedDebtUSDValue, isolatedMarket.Denom, isolatedMarket.IsolationMode.DebtCeiling)
+	}
+	return nil
+}
+
+// IncrementIsolatedDebt increments the coins borrowed against deposits of an isolated money market
+func (k Keeper) IncrementIsolatedDebt(ctx sdk.Context, denom string, coins sdk.Coins) {
+	debt, _ := k.GetIsolatedDebt(ctx, denom)
+	k.SetIsolatedDebt(ctx, denom, debt.Add(coins...))
+}
+
+// DecrementIsolatedDebt decrements the coins borrowed against deposits of an isolated money market. The debt only
+// tracks borrowed principal, so repayments of interest are capped at the outstanding debt.
+func (k Keeper) DecrementIsolatedDebt(ctx sdk.Context, denom string, coins sdk.Coins) {
+	debt, _ := k.GetIsolatedDebt(ctx, denom)
+	decrement := sdk.NewCoins()
+	for _, coin := range coins {
+		amount := sdk.MinInt(coin.Amount, debt.AmountOf(coin.Denom))
+		decrement = decrement.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	k.SetIsolatedDebt(ctx, denom, debt.Sub(decrement...))
+}
+
+// GetEModeCategory returns the efficiency mode category shared by the money markets of every denom, or an empty string
+// if the denoms aren't all in the same category
+func (k Keeper) GetEModeCategory(ctx sdk.Context, denoms []string) string {
+	moneyMarkets := make([]types.MoneyMarket, 0, len(denoms))
+	for _, denom := range denoms {
+		moneyMarket, found := k.GetMoneyMarket(ctx, denom)
+		if !found {
+			return ""
+		}
+		moneyMarkets = append(moneyMarkets, moneyMarket)
+	}
+	return getEModeCategory(moneyMarkets)
+}
+
+func getEModeCategory(moneyMarkets []types.MoneyMarket) string {
+	if len(moneyMarkets) == 0 {
+		return ""
+	}
+	category := moneyMarkets[0].EModeCategory
+	for _, moneyMarket := range moneyMarkets[1:] {
+		if moneyMarket.EModeCategory != category {
+			return ""
+		}
+	}
+	return category
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestIsolatedCollateral() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	bnbMarket := newTestMoneyMarket("bnb", "bnb:usd", BNB_CF, "0.8")
+	bnbMarket.IsolationMode = types.NewIsolationMode(true, []string{"usdx"}, sdk.NewDec(150))
+	suite.setupMoneyMarketTest(
+		types.MoneyMarkets{
+			newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "1"),
+			newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.8"),
+			bnbMarket,
+		},
+		supplier,
+		[]sdk.AccAddress{borrower},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF)), sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))},
+	)
+
+	// $200 of bnb deposits can back $160 of borrows, but only $150 of usdx can be borrowed against bnb
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF))))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))))
+	suite.Require().ErrorIs(err, types.ErrIsolatedCollateral)
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))))
+	suite.Require().ErrorIs(err, types.ErrBorrowNotAllowedInIsolation)
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	debt, found := suite.keeper.GetIsolatedDebt(suite.ctx, "bnb")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))), debt)
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(60*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrExceedsDebtCeiling)
+
+	err = suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(40*USDX_CF))))
+	suite.Require().NoError(err)
+	debt, found = suite.keeper.GetIsolatedDebt(suite.ctx, "bnb")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(60*USDX_CF))), debt)
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(60*USDX_CF))))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestEModeBorrow() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	kavaMarket := newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.5")
+	kavaMarket.EModeCategory = "kava"
+	kavaMarket.EModeLoanToValue = sdk.MustNewDecFromStr("0.9")
+	bkavaMarket := newTestMoneyMarket("bkava", "bkava:usd", KAVA_CF, "0.5")
+	bkavaMarket.EModeCategory = "kava"
+	bkavaMarket.EModeLoanToValue = sdk.MustNewDecFromStr("0.9")
+
+	testCases := []struct {
+		name        string
+		borrow      sdk.Coins
+		expectedErr error
+	}{
+		{
+			name:   "valid: borrow in e-mode category",
+			borrow: sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(85*KAVA_CF))),
+		},
+		{
+			name:        "invalid: borrow outside e-mode category",
+			borrow:      sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(150*USDX_CF))),
+			expectedErr: types.ErrInsufficientLoanToValue,
+		},
+		{
+			name: "invalid: borrow partially outside e-mode category",
+			borrow: sdk.NewCoins(
+				sdk.NewCoin("ukava", sdkmath.NewInt(50*KAVA_CF)),
+				sdk.NewCoin("usdx", sdkmath.NewInt(10*USDX_CF)),
+			),
+			expectedErr: types.ErrInsufficientLoanToValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupMoneyMarketTest(
+				types.MoneyMarkets{
+					newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "0.5"),
+					kavaMarket,
+					bkavaMarket,
+				},
+				supplier,
+				[]sdk.AccAddress{borrower},
+				[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("bkava", sdkmath.NewInt(100*KAVA_CF)))},
+			)
+
+			// $200 of bkava deposits can back $100 of borrows, or $180 of borrows in the kava e-mode category
+			err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bkava", sdkmath.NewInt(100*KAVA_CF))))
+			suite.Require().NoError(err)
+
+			err = suite.keeper.Borrow(suite.ctx, borrower, tc.borrow)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// The position isn't liquidatable at the e-mode loan-to-value
+			deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+			suite.Require().True(found)
+			borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+			suite.Require().True(found)
+			isWithinRange, err := suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+			suite.Require().NoError(err)
+			suite.Require().True(isWithinRange)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReceiptIsolation() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+
+	bnbMarket := newTestMoneyMarket("bnb", "bnb:usd", BNB_CF, "0.8")
+	bnbMarket.ReceiptTokensEnabled = true
+	kavaMarket := newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.8")
+	kavaMarket.ReceiptTokensEnabled = true
+	suite.setupMoneyMarketTest(
+		types.MoneyMarkets{
+			newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "1"),
+			kavaMarket,
+			bnbMarket,
+		},
+		supplier,
+		[]sdk.AccAddress{depositor},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF)), sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))},
+	)
+
+	receiptTokens, err := suite.keeper.DepositReceiptTokens(suite.ctx, depositor,
+		sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(50*BNB_CF)), sdk.NewCoin("ukava", sdkmath.NewInt(50*KAVA_CF))))
+	suite.Require().NoError(err)
+
+	// Isolate bnb after its receipt tokens were minted
+	isolatedMarket, found := suite.keeper.GetMoneyMarket(suite.ctx, "bnb")
+	suite.Require().True(found)
+	isolatedMarket.IsolationMode = types.NewIsolationMode(true, []string{"usdx"}, sdk.NewDec(150))
+	suite.keeper.SetMoneyMarket(suite.ctx, "bnb", isolatedMarket)
+
+	_, err = suite.keeper.DepositReceiptTokens(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(10*BNB_CF))))
+	suite.Require().ErrorIs(err, types.ErrIsolatedCollateral)
+
+	_, err = suite.keeper.DepositReceiptTokens(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.RedeemReceiptTokens(suite.ctx, depositor, receiptTokens)
+	suite.Require().ErrorIs(err, types.ErrIsolatedCollateral)
+
+	bnbReceiptTokens := sdk.NewCoins(sdk.NewCoin(types.ReceiptDenom("bnb"), receiptTokens.AmountOf(types.ReceiptDenom("bnb"))))
+	redeemed, err := suite.keeper.RedeemReceiptTokens(suite.ctx, depositor, bnbReceiptTokens)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(50*BNB_CF))), redeemed)
+}
+
+func (suite *KeeperTestSuite) TestWithdrawToIsolatedCollateral() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	suite.setupMoneyMarketTest(
+		types.MoneyMarkets{
+			newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "1"),
+			newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.8"),
+			newTestMoneyMarket("bnb", "bnb:usd", BNB_CF, "0.8"),
+		},
+		supplier,
+		[]sdk.AccAddress{borrower},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF)), sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))},
+	)
+
+	err := suite.keeper.Deposit(suite.ctx, borrower,
+		sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF)), sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(10*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// Isolate bnb after the borrow was made against both deposits
+	isolatedMarket, found := suite.keeper.GetMoneyMarket(suite.ctx, "bnb")
+	suite.Require().True(found)
+	isolatedMarket.IsolationMode = types.NewIsolationMode(true, []string{"usdx"}, sdk.NewDec(150))
+	suite.keeper.SetMoneyMarket(suite.ctx, "bnb", isolatedMarket)
+
+	err = suite.keeper.Withdraw(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))))
+	suite.Require().ErrorIs(err, types.ErrIsolatedCollateral)
+
+	err = suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(10*USDX_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Withdraw(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))))
+	suite.Require().NoError(err)
+}
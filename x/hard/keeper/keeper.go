@@ -21,13 +21,14 @@ type Keeper struct {
 	bankKeeper      types.BankKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
+	router          types.MessageRouter
 	hooks           types.HARDHooks
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper,
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, router types.MessageRouter,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -41,6 +42,7 @@ func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.S
 		bankKeeper:      bk,
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
+		router:          router,
 		hooks:           nil,
 	}
 }
@@ -169,6 +171,32 @@ func (k Keeper) GetBorrowedCoins(ctx sdk.Context) (sdk.Coins, bool) {
 	return borrowed.Coins, true
 }
 
+// SetStableBorrowedCoins sets the total amount of coins currently borrowed at stable rates in the store
+func (k Keeper) SetStableBorrowedCoins(ctx sdk.Context, borrowedCoins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StableBorrowedCoinsPrefix)
+	if borrowedCoins.Empty() {
+		store.Set(types.StableBorrowedCoinsPrefix, []byte{})
+	} else {
+		bz := k.cdc.MustMarshal(&types.CoinsProto{
+			Coins: borrowedCoins,
+		})
+		store.Set(types.StableBorrowedCoinsPrefix, bz)
+	}
+}
+
+// GetStableBorrowedCoins returns an sdk.Coins object from the store representing all coins currently borrowed at
+// stable rates
+func (k Keeper) GetStableBorrowedCoins(ctx sdk.Context) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StableBorrowedCoinsPrefix)
+	bz := store.Get(types.StableBorrowedCoinsPrefix)
+	if len(bz) == 0 {
+		return sdk.Coins{}, false
+	}
+	var borrowed types.CoinsProto
+	k.cdc.MustUnmarshal(bz, &borrowed)
+	return borrowed.Coins, true
+}
+
 // SetSuppliedCoins sets the total amount of coins currently supplied in the store
 func (k Keeper) SetSuppliedCoins(ctx sdk.Context, suppliedCoins sdk.Coins) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.SuppliedCoinsPrefix)
@@ -364,3 +392,43 @@ func (k Keeper) IterateSupplyInterestFactors(ctx sdk.Context, cb func(denom stri
 		}
 	}
 }
+
+// GetFlashLoanInProgress returns true while the msgs of a flash loan are executing
+func (k Keeper) GetFlashLoanInProgress(ctx sdk.Context) bool {
+	return ctx.KVStore(k.key).Has(types.FlashLoanInProgressKey)
+}
+
+// SetFlashLoanInProgress sets whether the msgs of a flash loan are executing
+func (k Keeper) SetFlashLoanInProgress(ctx sdk.Context, inProgress bool) {
+	store := ctx.KVStore(k.key)
+	if inProgress {
+		store.Set(types.FlashLoanInProgressKey, []byte{1})
+	} else {
+		store.Delete(types.FlashLoanInProgressKey)
+	}
+}
+
+// GetIsolatedDebt returns the coins borrowed against deposits of an isolated money market
+func (k Keeper) GetIsolatedDebt(ctx sdk.Context, denom string) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.Coins{}, false
+	}
+	var debt types.CoinsProto
+	k.cdc.MustUnmarshal(bz, &debt)
+	return debt.Coins, true
+}
+
+// SetIsolatedDebt sets the coins borrowed against deposits of an isolated money market
+func (k Keeper) SetIsolatedDebt(ctx sdk.Context, denom string, debt sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	if debt.Empty() {
+		store.Delete([]byte(denom))
+	} else {
+		bz := k.cdc.MustMarshal(&types.CoinsProto{
+			Coins: debt,
+		})
+		store.Set([]byte(denom), bz)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
@@ -16,8 +17,10 @@ import (
 
 	"github.com/kava-labs/kava/app"
 	auctionkeeper "github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/hard"
 	"github.com/kava-labs/kava/x/hard/keeper"
 	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // Test suite used for all keeper tests
@@ -230,6 +233,77 @@ func (suite *KeeperTestSuite) getModuleAccountAtCtx(name string, ctx sdk.Context
 	return ak.GetModuleAccount(ctx, name)
 }
 
+func newTestMoneyMarket(denom, spotMarketID string, conversionFactor int64, loanToValue string) types.MoneyMarket {
+	return types.NewMoneyMarket(denom,
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*conversionFactor), sdk.MustNewDecFromStr(loanToValue)), // Borrow Limit
+		spotMarketID,                     // Market ID
+		sdkmath.NewInt(conversionFactor), // Conversion Factor
+		types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), // Interest Rate Model
+		sdk.MustNewDecFromStr("0.05"), // Reserve Factor
+		sdk.MustNewDecFromStr("0.05")) // Keeper Reward Percent
+}
+
+// setupMoneyMarketTest sets up the money markets with usdx and ukava supplied by the supplier, and funds the addrs with
+// the coins
+func (suite *KeeperTestSuite) setupMoneyMarketTest(moneyMarkets types.MoneyMarkets, supplier sdk.AccAddress, addrs []sdk.AccAddress, coins []sdk.Coins) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	supplierCoins := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)), sdk.NewCoin("ukava", sdkmath.NewInt(1000*KAVA_CF)))
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		append([]sdk.Coins{supplierCoins}, coins...),
+		append([]sdk.AccAddress{supplier}, addrs...),
+	)
+
+	hardGS := types.NewGenesisState(types.NewParams(moneyMarkets, sdk.NewDec(10)),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	var markets []pricefeedtypes.Market
+	var postedPrices []pricefeedtypes.PostedPrice
+	for _, mm := range []struct {
+		marketID  string
+		baseAsset string
+		price     string
+	}{
+		{"usdx:usd", "usdx", "1.00"},
+		{"kava:usd", "kava", "2.00"},
+		{"bkava:usd", "bkava", "2.00"},
+		{"bnb:usd", "bnb", "2.00"},
+	} {
+		markets = append(markets, pricefeedtypes.Market{
+			MarketID: mm.marketID, BaseAsset: mm.baseAsset, QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true,
+		})
+		postedPrices = append(postedPrices, pricefeedtypes.PostedPrice{
+			MarketID:      mm.marketID,
+			OracleAddress: sdk.AccAddress{},
+			Price:         sdk.MustNewDecFromStr(mm.price),
+			Expiry:        time.Now().Add(1 * time.Hour),
+		})
+	}
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params:       pricefeedtypes.Params{Markets: markets},
+		PostedPrices: postedPrices,
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	// Run BeginBlocker once to transition MoneyMarkets
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.Deposit(suite.ctx, supplier, supplierCoins)
+	suite.Require().NoError(err)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -15,6 +15,7 @@ type LiqData struct {
 	price            sdk.Dec
 	ltv              sdk.Dec
 	conversionFactor sdkmath.Int
+	auctionType      string
 }
 
 // AttemptKeeperLiquidation enables a keeper to liquidate an individual borrower's position
@@ -46,6 +47,10 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 		return types.ErrBorrowNotFound
 	}
 
+	if err := k.validatePricesNotFrozen(ctx, deposit, borrow); err != nil {
+		return err
+	}
+
 	isWithinRange, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
 	if err != nil {
 		return err
@@ -54,6 +59,18 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 		return errorsmod.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
 	}
 
+	// The liquidated borrow no longer counts towards the debt ceiling of isolated collateral
+	if isolatedMarket, isIsolated := k.GetIsolatedCollateral(ctx, borrower); isIsolated {
+		k.DecrementIsolatedDebt(ctx, isolatedMarket.Denom, borrow.Amount)
+	}
+
+	// Stable rate borrows are liquidated by the same auctions as variable rate borrows, which decrement the total
+	// borrowed coins as they start
+	if !borrow.StableAmount.Empty() {
+		k.DecrementStableBorrowedCoins(ctx, borrow.StableAmount)
+		k.IncrementBorrowedCoins(ctx, borrow.StableAmount)
+	}
+
 	// Sending coins to auction module with keeper address getting % of the profits
 	borrowDenoms := getDenoms(borrow.Amount)
 	depositDenoms := getDenoms(deposit.Amount)
@@ -72,6 +89,177 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 	return nil
 }
 
+// LiquidateBorrow repays part of an unhealthy borrow on behalf of the borrower in exchange for the borrower's deposits
+// of the collateral denom plus a liquidation bonus. The repayment is capped by the close factor of the borrowed money
+// market, and the bonus grows as the borrower's health factor falls. It returns the coins repaid and seized.
+func (k Keeper) LiquidateBorrow(ctx sdk.Context, liquidator, borrower sdk.AccAddress, repay sdk.Coin,
+	collateralDenom string,
+) (sdk.Coin, sdk.Coin, error) {
+	deposit, found := k.GetDeposit(ctx, borrower)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrDepositNotFound
+	}
+
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrBorrowNotFound
+	}
+
+	// Call incentive hooks
+	k.BeforeDepositModified(ctx, deposit)
+	k.BeforeBorrowModified(ctx, borrow)
+
+	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, borrower)
+
+	deposit, _ = k.GetDeposit(ctx, borrower)
+	borrow, _ = k.GetBorrow(ctx, borrower)
+
+	repayMarket, found := k.GetMoneyMarket(ctx, repay.Denom)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", repay.Denom)
+	}
+	if !repayMarket.DirectLiquidationEnabled() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrDirectLiquidationDisabled, "no close factor set for denom %s", repay.Denom)
+	}
+	collateralMarket, found := k.GetMoneyMarket(ctx, collateralDenom)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", collateralDenom)
+	}
+
+	borrowed := borrow.Amount.AmountOf(repay.Denom)
+	if !borrowed.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidRepaymentDenom, "%s has no %s borrowed", borrower, repay.Denom)
+	}
+	collateral := deposit.Amount.AmountOf(collateralDenom)
+	if !collateral.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidWithdrawDenom, "%s has no %s deposited", borrower, collateralDenom)
+	}
+
+	if err := k.validatePricesNotFrozen(ctx, deposit, borrow); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	healthFactor, err := calculateHealthFactor(deposit, borrow, liqMap)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if healthFactor.GTE(sdk.OneDec()) {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrBorrowNotLiquidatable, "health factor %s is not below 1.0", healthFactor)
+	}
+
+	// The liquidator receives the USD value of the repayment plus the bonus in collateral
+	bonus := collateralMarket.LiquidationBonus(healthFactor)
+	rData := liqMap[repay.Denom]
+	cData := liqMap[collateralDenom]
+	repayAmount := sdk.MinInt(repay.Amount, sdk.NewDecFromInt(borrowed).Mul(repayMarket.CloseFactor).TruncateInt())
+	repayUSDValue := sdk.NewDecFromInt(repayAmount).Quo(sdk.NewDecFromInt(rData.conversionFactor)).Mul(rData.price)
+	seizeAmount := repayUSDValue.Mul(sdk.OneDec().Add(bonus)).Quo(cData.price).MulInt(cData.conversionFactor).TruncateInt()
+
+	// If the deposit can't cover the repayment and bonus, all of it is seized and the repayment is reduced to match
+	if seizeAmount.GT(collateral) {
+		seizeAmount = collateral
+		collateralUSDValue := sdk.NewDecFromInt(collateral).Quo(sdk.NewDecFromInt(cData.conversionFactor)).Mul(cData.price)
+		repayAmount = collateralUSDValue.Quo(sdk.OneDec().Add(bonus)).Quo(rData.price).MulInt(rData.conversionFactor).TruncateInt()
+	}
+	if !repayAmount.IsPositive() || !seizeAmount.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidLiquidationAmount,
+			"liquidation would repay %s%s and seize %s%s", repayAmount, repay.Denom, seizeAmount, collateralDenom)
+	}
+	repaid := sdk.NewCoin(repay.Denom, repayAmount)
+	seized := sdk.NewCoin(collateralDenom, seizeAmount)
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, liquidator, types.ModuleAccountName, sdk.NewCoins(repaid))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, liquidator, sdk.NewCoins(seized))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// The repayment no longer counts towards the debt ceiling of isolated collateral
+	if isolatedMarket, isIsolated := k.GetIsolatedCollateral(ctx, borrower); isIsolated {
+		k.DecrementIsolatedDebt(ctx, isolatedMarket.Denom, sdk.NewCoins(repaid))
+	}
+
+	// Update the borrow, resetting the borrow index factor if the denom has been completely repaid. Repayments are
+	// applied to variable rate borrows before stable rate borrows.
+	variableRepaid, stableRepaid := borrow.SplitRepayment(sdk.NewCoins(repaid))
+	if repayAmount.Equal(borrowed) {
+		borrowIndex, removed := borrow.Index.RemoveInterestFactor(repay.Denom)
+		if !removed {
+			return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", repay.Denom)
+		}
+		borrow.Index = borrowIndex
+	}
+	borrow.Amount = borrow.Amount.Sub(repaid)
+	borrow = borrow.RepayStable(stableRepaid)
+	if borrow.Amount.Empty() {
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		k.SetBorrow(ctx, borrow)
+	}
+	if !variableRepaid.Empty() {
+		if err := k.DecrementBorrowedCoins(ctx, variableRepaid); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+	k.DecrementStableBorrowedCoins(ctx, stableRepaid)
+
+	// Update the deposit, resetting the supply index factor if the denom has been completely seized
+	if seizeAmount.Equal(collateral) {
+		depositIndex, removed := deposit.Index.RemoveInterestFactor(collateralDenom)
+		if !removed {
+			return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", collateralDenom)
+		}
+		deposit.Index = depositIndex
+	}
+	deposit.Amount = deposit.Amount.Sub(seized)
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	if err := k.DecrementSuppliedCoins(ctx, sdk.NewCoins(seized)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Call incentive hooks
+	k.AfterDepositModified(ctx, deposit)
+	k.AfterBorrowModified(ctx, borrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardLiquidateBorrow,
+			sdk.NewAttribute(types.AttributeKeyLiquidator, liquidator.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeySeizedCoins, seized.String()),
+			sdk.NewAttribute(types.AttributeKeyHealthFactor, healthFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidationBonus, bonus.String()),
+		),
+	)
+
+	return repaid, seized, nil
+}
+
+// validatePricesNotFrozen returns an error if any of the prices of a position are frozen by the pricefeed circuit
+// breaker, as liquidations are paused while they are frozen
+func (k Keeper) validatePricesNotFrozen(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) error {
+	for _, denom := range removeDuplicates(getDenoms(borrow.Amount), getDenoms(deposit.Amount)) {
+		mm, found := k.GetMoneyMarket(ctx, denom)
+		if found && k.pricefeedKeeper.IsMarketFrozen(ctx, mm.SpotMarketID) {
+			return errorsmod.Wrapf(types.ErrPriceFrozen, "price of market %s is frozen", mm.SpotMarketID)
+		}
+	}
+	return nil
+}
+
 // SeizeDeposits seizes a list of deposits and sends them to auction
 func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
 	borrow types.Borrow, dDenoms, bDenoms []string,
@@ -194,7 +382,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = full borrow amount, lot = maxLotSize
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				err := k.startAuction(ctx, lot, bid, returnAddrs, weights, debt, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -245,7 +433,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = maxBid, lot = whole deposit amount
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				err := k.startAuction(ctx, lot, bid, returnAddrs, weights, debt, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -324,6 +512,38 @@ func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, bo
 	return true, nil
 }
 
+// CalculateHealthFactor calculates the health factor of a deposit and borrow at current prices, the ratio of the USD
+// value that can be borrowed against the deposit to the USD value of the borrow. Borrows are liquidatable when their
+// health factor is below one.
+func (k Keeper) CalculateHealthFactor(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return calculateHealthFactor(deposit, borrow, liqMap)
+}
+
+func calculateHealthFactor(deposit types.Deposit, borrow types.Borrow, liqMap map[string]LiqData) (sdk.Dec, error) {
+	totalBorrowableUSDAmount := sdk.ZeroDec()
+	for _, depCoin := range deposit.Amount {
+		lData := liqMap[depCoin.Denom]
+		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(usdValue.Mul(lData.ltv))
+	}
+
+	totalBorrowedUSDAmount := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		totalBorrowedUSDAmount = totalBorrowedUSDAmount.Add(usdValue)
+	}
+
+	if !totalBorrowedUSDAmount.IsPositive() {
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrBorrowNotFound, "no borrows found for %s", borrow.Borrower)
+	}
+	return totalBorrowableUSDAmount.Quo(totalBorrowedUSDAmount), nil
+}
+
 // GetStoreLTV calculates the user's current LTV based on their deposits/borrows in the store
 // and does not include any outsanding interest.
 func (k Keeper) GetStoreLTV(ctx sdk.Context, addr sdk.AccAddress) (sdk.Dec, error) {
@@ -377,6 +597,26 @@ func (k Keeper) CalculateLtv(ctx sdk.Context, deposit types.Deposit, borrow type
 	return borrowCoinValues.Sum().Quo(sumDeposits), nil
 }
 
+// startAuction starts an auction for the lot with the auction type set for the lot's money market.
+// Auctions record the spot price of the lot denominated in units of the bid denom, dutch auctions start from it.
+func (k Keeper) startAuction(ctx sdk.Context, lot, bid sdk.Coin, returnAddrs []sdk.AccAddress, weights []sdkmath.Int,
+	debt sdk.Coin, liqMap map[string]LiqData,
+) error {
+	lData := liqMap[lot.Denom]
+	bData := liqMap[bid.Denom]
+	lotPrice := sdk.ZeroDec()
+	if bData.price.IsPositive() {
+		lotPrice = lData.price.MulInt(bData.conversionFactor).Quo(bData.price).QuoInt(lData.conversionFactor)
+	}
+	if lData.auctionType != types.AuctionTypeDutch {
+		_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt, lotPrice)
+		return err
+	}
+
+	_, err := k.auctionKeeper.StartDutchAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt, lotPrice)
+	return err
+}
+
 // LoadLiquidationData returns liquidation data, deposit, borrow
 func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (map[string]LiqData, error) {
 	liqMap := make(map[string]LiqData)
@@ -385,19 +625,26 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 	depositDenoms := getDenoms(deposit.Amount)
 	denoms := removeDuplicates(borrowDenoms, depositDenoms)
 
-	// Load required liquidation data for every deposit/borrow denom
+	moneyMarkets := make([]types.MoneyMarket, 0, len(denoms))
 	for _, denom := range denoms {
 		mm, found := k.GetMoneyMarket(ctx, denom)
 		if !found {
 			return liqMap, errorsmod.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", denom)
 		}
+		moneyMarkets = append(moneyMarkets, mm)
+	}
+
+	// Positions with deposits and borrows all in the same e-mode category use the e-mode loan-to-value
+	eMode := getEModeCategory(moneyMarkets) != ""
 
+	// Load required liquidation data for every deposit/borrow denom
+	for _, mm := range moneyMarkets {
 		priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
 		if err != nil {
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		liqMap[mm.Denom] = LiqData{priceData.Price, mm.LoanToValue(eMode), mm.ConversionFactor, mm.AuctionType}
 	}
 
 	return liqMap, nil
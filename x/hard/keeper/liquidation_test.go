@@ -91,6 +91,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 8004766),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 				},
 			},
@@ -130,6 +131,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 8004765),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 				},
 			},
@@ -197,6 +199,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("bnb", 200003287),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("20"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -212,6 +215,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("btc", 20000032),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("2"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -227,6 +231,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 10000782),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -242,6 +247,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("usdc", 20003284),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("2"),
 					},
 				},
 			},
@@ -283,6 +289,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 40036023),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("0.05"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -298,6 +305,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 40036023),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("0.5"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -313,6 +321,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 40040087),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 				},
 			},
@@ -358,6 +367,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("bnb", 900097134), // $90.00
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("10"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -373,6 +383,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("bnb", 99985020), // $10.00
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("10"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -388,6 +399,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("btc", 80011211), // $80.01
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -403,6 +415,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("btc", 19989610), // $19.99
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -418,6 +431,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 35010052), // $70.02
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("0.5"),
 					},
 				},
 			},
@@ -462,6 +476,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("usdt", 250507897),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -477,6 +492,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("usdx", 65125788),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 					&auctiontypes.CollateralAuction{
 						BaseAuction: auctiontypes.BaseAuction{
@@ -492,6 +508,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("usdx", 180362106),
 						LotReturns:        lotReturns,
+						MarketPrice:       sdk.MustNewDecFromStr("1"),
 					},
 				},
 			},
@@ -763,3 +780,101 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestLiquidateBorrow() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	liquidator := sdk.AccAddress(crypto.AddressHash([]byte("directliquidator")))
+
+	usdxMarket := newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "1")
+	usdxMarket.CloseFactor = sdk.MustNewDecFromStr("0.5")
+	bnbMarket := newTestMoneyMarket("bnb", "bnb:usd", BNB_CF, "0.8")
+	bnbMarket.MaxLiquidationBonus = sdk.MustNewDecFromStr("0.15")
+
+	testCases := []struct {
+		name        string
+		bnbPrice    sdk.Dec
+		repay       sdk.Coin
+		collateral  string
+		expectedErr error
+	}{
+		{
+			name:       "valid: repayment capped by close factor",
+			bnbPrice:   sdk.MustNewDecFromStr("1.8"),
+			repay:      sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)),
+			collateral: "bnb",
+		},
+		{
+			name:        "invalid: healthy borrow",
+			bnbPrice:    sdk.MustNewDecFromStr("2.0"),
+			repay:       sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)),
+			collateral:  "bnb",
+			expectedErr: types.ErrBorrowNotLiquidatable,
+		},
+		{
+			name:        "invalid: no close factor",
+			bnbPrice:    sdk.MustNewDecFromStr("1.8"),
+			repay:       sdk.NewCoin("bnb", sdkmath.NewInt(BNB_CF)),
+			collateral:  "bnb",
+			expectedErr: types.ErrDirectLiquidationDisabled,
+		},
+		{
+			name:        "invalid: no collateral deposited",
+			bnbPrice:    sdk.MustNewDecFromStr("1.8"),
+			repay:       sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)),
+			collateral:  "usdx",
+			expectedErr: types.ErrInvalidWithdrawDenom,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupMoneyMarketTest(
+				types.MoneyMarkets{usdxMarket, newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.8"), bnbMarket},
+				supplier,
+				[]sdk.AccAddress{borrower, liquidator},
+				[]sdk.Coins{
+					sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF))),
+					sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))),
+				},
+			)
+
+			// $200 of bnb deposits back $150 of usdx borrows
+			err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF))))
+			suite.Require().NoError(err)
+			err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(150*USDX_CF))))
+			suite.Require().NoError(err)
+
+			pricefeedKeeper := suite.app.GetPriceFeedKeeper()
+			_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", tc.bnbPrice, time.Now().Add(1*time.Hour))
+			suite.Require().NoError(err)
+			suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+			repaid, seized, err := suite.keeper.LiquidateBorrow(suite.ctx, liquidator, borrower, tc.repay, tc.collateral)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// The health factor of 144/150 = 0.96 gives a bonus of 0.05 + 0.04, so $75 of usdx buys $81.75 of bnb
+			suite.Require().Equal(sdk.NewCoin("usdx", sdkmath.NewInt(75*USDX_CF)), repaid)
+			suite.Require().Equal(sdk.NewCoin("bnb", sdkmath.NewInt(4541666666)), seized)
+			suite.Require().Equal(
+				sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(25*USDX_CF)), seized),
+				suite.getAccountCoins(suite.getAccount(liquidator)),
+			)
+
+			borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(75*USDX_CF))), borrow.Amount)
+			deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF-4541666666))), deposit.Amount)
+
+			healthFactor, err := suite.keeper.CalculateHealthFactor(suite.ctx, deposit, borrow)
+			suite.Require().NoError(err)
+			suite.Require().True(healthFactor.GT(sdk.OneDec()))
+		})
+	}
+}
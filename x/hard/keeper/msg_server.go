@@ -28,7 +28,11 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	if msg.ReceiptTokens {
+		_, err = k.keeper.DepositReceiptTokens(ctx, depositor, msg.Amount)
+	} else {
+		err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +78,11 @@ func (k msgServer) Borrow(goCtx context.Context, msg *types.MsgBorrow) (*types.M
 		return nil, err
 	}
 
-	err = k.keeper.Borrow(ctx, borrower, msg.Amount)
+	if msg.StableRate {
+		err = k.keeper.BorrowStableRate(ctx, borrower, msg.Amount)
+	} else {
+		err = k.keeper.Borrow(ctx, borrower, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -144,3 +152,134 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) FlashLoan(goCtx context.Context, msg *types.MsgFlashLoan) (*types.MsgFlashLoanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.keeper.FlashLoan(ctx, borrower, msg.Amount, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Borrower),
+		),
+	)
+	return &types.MsgFlashLoanResponse{Results: results}, nil
+}
+
+func (k msgServer) LiquidateBorrow(goCtx context.Context, msg *types.MsgLiquidateBorrow) (*types.MsgLiquidateBorrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return nil, err
+	}
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	repaid, seized, err := k.keeper.LiquidateBorrow(ctx, liquidator, borrower, msg.Repay, msg.CollateralDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Liquidator),
+		),
+	)
+	return &types.MsgLiquidateBorrowResponse{
+		Repaid: repaid,
+		Seized: seized,
+	}, nil
+}
+
+func (k msgServer) RebalanceStableRate(goCtx context.Context, msg *types.MsgRebalanceStableRate) (*types.MsgRebalanceStableRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RebalanceStableRate(ctx, borrower, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRebalanceStableRateResponse{}, nil
+}
+
+func (k msgServer) RedeemReceiptTokens(goCtx context.Context, msg *types.MsgRedeemReceiptTokens) (*types.MsgRedeemReceiptTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.keeper.RedeemReceiptTokens(ctx, redeemer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Redeemer),
+		),
+	)
+	return &types.MsgRedeemReceiptTokensResponse{Amount: amount}, nil
+}
+
+func (k msgServer) TransferReceiptTokens(goCtx context.Context, msg *types.MsgTransferReceiptTokens) (*types.MsgTransferReceiptTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.TransferReceiptTokens(ctx, sender, receiver, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgTransferReceiptTokensResponse{}, nil
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// DepositReceiptTokens deposits coins to money markets that allow receipt tokens and mints the receipt tokens to the
+// depositor. Receipt tokens are minted at the supply interest factor of each money market, so they can be redeemed by
+// any holder for the deposited coins and the interest accrued since.
+// Receipt tokens can be sent like any other coin. Their supply rewards are synced by the ReceiptBankKeeper whenever
+// they move, including when they are minted and burned.
+func (k Keeper) DepositReceiptTokens(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	receiptTokens := sdk.NewCoins()
+	for _, coin := range coins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDepositDenom, "money market denom %s not found", coin.Denom)
+		}
+		if !moneyMarket.ReceiptTokensEnabled {
+			return nil, errorsmod.Wrapf(types.ErrReceiptTokensDisabled, "money market %s does not allow receipt tokens", coin.Denom)
+		}
+
+		interestFactor, found := k.GetSupplyInterestFactor(ctx, coin.Denom)
+		if !found {
+			interestFactor = sdk.OneDec()
+			k.SetSupplyInterestFactor(ctx, coin.Denom, interestFactor)
+		}
+		amount := sdk.NewDecFromInt(coin.Amount).Quo(interestFactor).TruncateInt()
+		if !amount.IsPositive() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit of %s is too small to mint receipt tokens", coin)
+		}
+		receiptTokens = receiptTokens.Add(sdk.NewCoin(types.ReceiptDenom(coin.Denom), amount))
+	}
+	if err := k.ValidateReceiptIsolation(ctx, coins, true); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleAccountName, receiptTokens); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, receiptTokens); err != nil {
+		return nil, err
+	}
+
+	k.IncrementSuppliedCoins(ctx, coins)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardMintReceipt,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiptTokens, receiptTokens.String()),
+		),
+	)
+
+	return receiptTokens, nil
+}
+
+// RedeemReceiptTokens burns receipt tokens and returns the deposited coins they represent, including interest, to the
+// redeemer
+func (k Keeper) RedeemReceiptTokens(ctx sdk.Context, redeemer sdk.AccAddress, receiptTokens sdk.Coins) (sdk.Coins, error) {
+	amount, err := k.CalculateReceiptTokenValue(ctx, receiptTokens)
+	if err != nil {
+		return nil, err
+	}
+	for _, coin := range receiptTokens {
+		denom, _ := types.ParseReceiptDenom(coin.Denom)
+		if !amount.AmountOf(denom).IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "redeeming %s returns no deposited coins", coin)
+		}
+	}
+	if err := k.ValidateReceiptIsolation(ctx, amount, false); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleAccountName, receiptTokens); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleAccountName, receiptTokens); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, redeemer, amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.DecrementSuppliedCoins(ctx, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardRedeemReceipt,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemer, redeemer.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiptTokens, receiptTokens.String()),
+		),
+	)
+
+	return amount, nil
+}
+
+// TransferReceiptTokens sends receipt tokens from sender to receiver. Receipt tokens can also be sent with the bank
+// module, which syncs the receipt token rewards of both accounts in the same way.
+func (k Keeper) TransferReceiptTokens(ctx sdk.Context, sender, receiver sdk.AccAddress, receiptTokens sdk.Coins) error {
+	for _, coin := range receiptTokens {
+		if _, ok := types.ParseReceiptDenom(coin.Denom); !ok {
+			return errorsmod.Wrapf(types.ErrInvalidReceiptDenom, "%s", coin.Denom)
+		}
+	}
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, receiver, receiptTokens); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardTransferReceipt,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiptTokens, receiptTokens.String()),
+		),
+	)
+
+	return nil
+}
+
+// CalculateReceiptTokenValue returns the deposited coins that receipt tokens can be redeemed for at the current supply
+// interest factors
+func (k Keeper) CalculateReceiptTokenValue(ctx sdk.Context, receiptTokens sdk.Coins) (sdk.Coins, error) {
+	amount := sdk.NewCoins()
+	for _, coin := range receiptTokens {
+		denom, ok := types.ParseReceiptDenom(coin.Denom)
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidReceiptDenom, "%s", coin.Denom)
+		}
+		interestFactor, found := k.GetSupplyInterestFactor(ctx, denom)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidReceiptDenom, "no supply interest factor found for %s", denom)
+		}
+		amount = amount.Add(sdk.NewCoin(denom, sdk.NewDecFromInt(coin.Amount).Mul(interestFactor).TruncateInt()))
+	}
+	return amount, nil
+}
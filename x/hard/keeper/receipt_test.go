@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestReceiptTokens() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+	holder := sdk.AccAddress(crypto.AddressHash([]byte("holder")))
+
+	usdxMarket := newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "1")
+	usdxMarket.ReceiptTokensEnabled = true
+	suite.setupMoneyMarketTest(
+		types.MoneyMarkets{
+			usdxMarket,
+			newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.8"),
+		},
+		supplier,
+		[]sdk.AccAddress{depositor},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)), sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)))},
+	)
+	receiptDenom := types.ReceiptDenom("usdx")
+
+	_, err := suite.keeper.DepositReceiptTokens(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))))
+	suite.Require().ErrorIs(err, types.ErrReceiptTokensDisabled)
+
+	// Receipt tokens are minted at the supply interest factor instead of adding to the depositor's deposit
+	suite.keeper.SetSupplyInterestFactor(suite.ctx, "usdx", sdk.MustNewDecFromStr("1.25"))
+	receiptTokens, err := suite.keeper.DepositReceiptTokens(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(receiptDenom, sdkmath.NewInt(80*USDX_CF))), receiptTokens)
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)), sdk.NewCoin(receiptDenom, sdkmath.NewInt(80*USDX_CF))),
+		suite.getAccountCoins(suite.getAccount(depositor)),
+	)
+	_, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().False(found)
+	supplied, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(sdkmath.NewInt(1100*USDX_CF), supplied.AmountOf("usdx"))
+
+	// Receipt tokens can be sent with the bank module, which syncs the receipt token rewards of both accounts
+	bankKeeper := suite.app.GetBankKeeper()
+	incentiveKeeper := suite.app.GetIncentiveKeeper()
+	halfReceiptTokens := sdk.NewCoins(sdk.NewCoin(receiptDenom, sdkmath.NewInt(40*USDX_CF)))
+	suite.Require().NoError(bankKeeper.IsSendEnabledCoins(suite.ctx, receiptTokens...))
+	err = bankKeeper.SendCoins(suite.ctx, depositor, holder, halfReceiptTokens)
+	suite.Require().NoError(err)
+	for _, addr := range []sdk.AccAddress{depositor, holder} {
+		claim, found := incentiveKeeper.GetHardLiquidityProviderClaim(suite.ctx, addr)
+		suite.Require().True(found)
+		suite.Require().Equal(halfReceiptTokens, claim.ReceiptBalances)
+	}
+
+	// Transferring the receipt tokens moves the claim on the deposit
+	err = suite.keeper.TransferReceiptTokens(suite.ctx, depositor, holder, halfReceiptTokens)
+	suite.Require().NoError(err)
+	suite.Require().Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeHardTransferReceipt,
+		sdk.NewAttribute(types.AttributeKeySender, depositor.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, holder.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiptTokens, halfReceiptTokens.String()),
+	))
+	claim, found := incentiveKeeper.GetHardLiquidityProviderClaim(suite.ctx, holder)
+	suite.Require().True(found)
+	suite.Require().Equal(receiptTokens, claim.ReceiptBalances)
+
+	_, err = suite.keeper.RedeemReceiptTokens(suite.ctx, depositor, receiptTokens)
+	suite.Require().Error(err)
+
+	// Interest accrued since the deposit is redeemed with the receipt tokens
+	suite.keeper.SetSupplyInterestFactor(suite.ctx, "usdx", sdk.MustNewDecFromStr("1.5"))
+	redeemed, err := suite.keeper.RedeemReceiptTokens(suite.ctx, holder, receiptTokens)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(120*USDX_CF))), redeemed)
+	suite.Require().Equal(redeemed, suite.getAccountCoins(suite.getAccount(holder)))
+	suite.Require().True(bankKeeper.GetSupply(suite.ctx, receiptDenom).IsZero())
+	supplied, _ = suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(sdkmath.NewInt(980*USDX_CF), supplied.AmountOf("usdx"))
+
+	suite.Require().Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeHardRedeemReceipt,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, redeemed.String()),
+		sdk.NewAttribute(types.AttributeKeyRedeemer, holder.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiptTokens, receiptTokens.String()),
+	))
+}
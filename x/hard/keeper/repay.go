@@ -40,6 +40,9 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 		return err
 	}
 
+	// Repayments are applied to variable rate borrows before stable rate borrows
+	variablePayment, stablePayment := borrow.SplitRepayment(payment)
+
 	// If any coin denoms have been completely repaid reset the denom's borrow index factor
 	for _, coin := range payment {
 		if coin.Amount.Equal(borrow.Amount.AmountOf(coin.Denom)) {
@@ -53,6 +56,7 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 
 	// Update user's borrow in store
 	borrow.Amount = borrow.Amount.Sub(payment...)
+	borrow = borrow.RepayStable(stablePayment)
 
 	if borrow.Amount.Empty() {
 		k.DeleteBorrow(ctx, borrow)
@@ -60,10 +64,17 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 		k.SetBorrow(ctx, borrow)
 	}
 
-	// Update total borrowed amount
-	err = k.DecrementBorrowedCoins(ctx, payment)
-	if err != nil {
-		return err
+	// Update total borrowed amounts
+	if !variablePayment.Empty() {
+		err = k.DecrementBorrowedCoins(ctx, variablePayment)
+		if err != nil {
+			return err
+		}
+	}
+	k.DecrementStableBorrowedCoins(ctx, stablePayment)
+
+	if isolatedMarket, isIsolated := k.GetIsolatedCollateral(ctx, owner); isIsolated {
+		k.DecrementIsolatedDebt(ctx, isolatedMarket.Denom, payment)
 	}
 
 	// Call incentive hook
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// BorrowStableRate borrows funds at the current stable rate of each money market. The rate is locked in until the
+// borrow is repaid or the rate is rebalanced.
+func (k Keeper) BorrowStableRate(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
+	return k.borrow(ctx, borrower, coins, true)
+}
+
+// RebalanceStableRate resets the locked in rate of a borrower's stable rate borrow to the current stable rate, which
+// is only allowed while the utilization of the money market is at or above its rebalance utilization
+func (k Keeper) RebalanceStableRate(ctx sdk.Context, borrower sdk.AccAddress, denom string) error {
+	moneyMarket, found := k.GetMoneyMarket(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", denom)
+	}
+	if !moneyMarket.StableRateEnabled() {
+		return errorsmod.Wrapf(types.ErrStableRateDisabled, "no stable rate premium set for denom %s", denom)
+	}
+
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found {
+		return types.ErrBorrowNotFound
+	}
+	if borrow.StableAmount.AmountOf(denom).IsZero() {
+		return errorsmod.Wrapf(types.ErrStableBorrowNotFound, "%s has no stable rate borrow of %s", borrower, denom)
+	}
+
+	utilization := CalculateUtilizationRatio(k.loadMarketLiquidity(ctx, denom))
+	if utilization.LT(moneyMarket.StableRebalanceUtilization) {
+		return errorsmod.Wrapf(types.ErrStableRateRebalanceNotAllowed, "utilization %s is below the rebalance utilization %s",
+			utilization, moneyMarket.StableRebalanceUtilization)
+	}
+
+	// Call incentive hook
+	k.BeforeBorrowModified(ctx, borrow)
+
+	// Sync borrow interest so interest at the previous rate is accrued before the rate changes
+	k.SyncBorrowInterest(ctx, borrower)
+	borrow, _ = k.GetBorrow(ctx, borrower)
+
+	rateApy, err := k.CalculateStableBorrowRate(ctx, denom)
+	if err != nil {
+		return err
+	}
+	rate, err := APYToSPY(sdk.OneDec().Add(rateApy))
+	if err != nil {
+		return err
+	}
+	borrow.StableRates = borrow.StableRates.SetRate(types.NewStableBorrowRate(denom, rate, ctx.BlockTime()))
+	k.SetBorrow(ctx, borrow)
+
+	// Call incentive hook
+	k.AfterBorrowModified(ctx, borrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardRebalanceStable,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyStableBorrowDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyStableRate, rateApy.String()),
+		),
+	)
+
+	return nil
+}
+
+// CalculateStableBorrowRate calculates the current stable borrow rate of a money market, which is the variable borrow
+// rate plus the money market's stable rate premium, as an APY expressed as a decimal
+func (k Keeper) CalculateStableBorrowRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	moneyMarket, found := k.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", denom)
+	}
+	if !moneyMarket.StableRateEnabled() {
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrStableRateDisabled, "no stable rate premium set for denom %s", denom)
+	}
+
+	cash, borrows, reserves := k.loadMarketLiquidity(ctx, denom)
+	borrowRateApy, err := CalculateBorrowRate(moneyMarket.InterestRateModel, cash, borrows, reserves)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return borrowRateApy.Add(moneyMarket.StableRatePremium), nil
+}
+
+// calculateStableBorrowRateSpy calculates the current stable borrow rate of a money market as a per second rate,
+// expressed as (1 + rate)
+func (k Keeper) calculateStableBorrowRateSpy(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	rateApy, err := k.CalculateStableBorrowRate(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return APYToSPY(sdk.OneDec().Add(rateApy))
+}
+
+// lockStableRates adds newly borrowed coins to the stable amount of a borrow at the current stable rates. The rate of
+// an existing stable rate borrow of the same denom is averaged with the current rate, weighted by amount.
+func (k Keeper) lockStableRates(ctx sdk.Context, borrow types.Borrow, coins sdk.Coins) (types.Borrow, error) {
+	for _, coin := range coins {
+		rate, err := k.calculateStableBorrowRateSpy(ctx, coin.Denom)
+		if err != nil {
+			return types.Borrow{}, err
+		}
+
+		existingAmount := borrow.StableAmount.AmountOf(coin.Denom)
+		if existingRate, found := borrow.StableRates.GetRate(coin.Denom); found && existingAmount.IsPositive() {
+			rate = existingRate.Rate.MulInt(existingAmount).Add(rate.MulInt(coin.Amount)).QuoInt(existingAmount.Add(coin.Amount))
+		}
+		borrow.StableRates = borrow.StableRates.SetRate(types.NewStableBorrowRate(coin.Denom, rate, ctx.BlockTime()))
+	}
+	borrow.StableAmount = borrow.StableAmount.Add(coins...)
+	return borrow, nil
+}
+
+// accrueStableInterest adds the interest accrued at the locked in rates of a borrow's stable rate borrows, returning
+// the updated borrow and the accrued interest. Accrual times are only advanced when interest is accrued, so interest
+// that rounds to zero isn't lost.
+func accrueStableInterest(borrow types.Borrow, blockTime time.Time) (types.Borrow, sdk.Coins) {
+	totalNewInterest := sdk.NewCoins()
+	if len(borrow.StableRates) == 0 {
+		return borrow, totalNewInterest
+	}
+	stableRates := make(types.StableBorrowRates, len(borrow.StableRates))
+	copy(stableRates, borrow.StableRates)
+
+	for i, stableRate := range stableRates {
+		secondsElapsed := int64(blockTime.Sub(stableRate.AccrualTime).Seconds())
+		if secondsElapsed <= 0 {
+			continue
+		}
+		storedAmount := sdk.NewDecFromInt(borrow.StableAmount.AmountOf(stableRate.Denom))
+		interestFactor := CalculateBorrowInterestFactor(stableRate.Rate, sdkmath.NewInt(secondsElapsed))
+		interest := storedAmount.Mul(interestFactor).Sub(storedAmount).TruncateInt()
+		if !interest.IsPositive() {
+			continue
+		}
+		totalNewInterest = totalNewInterest.Add(sdk.NewCoin(stableRate.Denom, interest))
+		stableRates[i].AccrualTime = blockTime
+	}
+
+	borrow.Amount = borrow.Amount.Add(totalNewInterest...)
+	borrow.StableAmount = borrow.StableAmount.Add(totalNewInterest...)
+	borrow.StableRates = stableRates
+	return borrow, totalNewInterest
+}
+
+// loadMarketLiquidity returns the cash, total variable and stable rate borrows, and reserves of a money market, which
+// determine its utilization
+func (k Keeper) loadMarketLiquidity(ctx sdk.Context, denom string) (sdk.Dec, sdk.Dec, sdk.Dec) {
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	cash := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), denom).Amount
+	borrowed := k.getTotalBorrowedCoins(ctx).AmountOf(denom)
+	reserves, _ := k.GetTotalReserves(ctx)
+	return sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed), sdk.NewDecFromInt(reserves.AmountOf(denom))
+}
+
+// getTotalBorrowedCoins returns the coins currently borrowed at both variable and stable rates
+func (k Keeper) getTotalBorrowedCoins(ctx sdk.Context) sdk.Coins {
+	borrowedCoins, _ := k.GetBorrowedCoins(ctx)
+	stableBorrowedCoins, _ := k.GetStableBorrowedCoins(ctx)
+	return borrowedCoins.Add(stableBorrowedCoins...)
+}
+
+// IncrementStableBorrowedCoins increments the total amount of coins borrowed at stable rates by the newCoins parameter
+func (k Keeper) IncrementStableBorrowedCoins(ctx sdk.Context, newCoins sdk.Coins) {
+	borrowedCoins, _ := k.GetStableBorrowedCoins(ctx)
+	k.SetStableBorrowedCoins(ctx, borrowedCoins.Add(newCoins...))
+}
+
+// DecrementStableBorrowedCoins decrements the total amount of coins borrowed at stable rates by the coins parameter,
+// capped at the total amount
+func (k Keeper) DecrementStableBorrowedCoins(ctx sdk.Context, coins sdk.Coins) {
+	borrowedCoins, _ := k.GetStableBorrowedCoins(ctx)
+	decrement := sdk.NewCoins()
+	for _, coin := range coins {
+		amount := sdk.MinInt(coin.Amount, borrowedCoins.AmountOf(coin.Denom))
+		decrement = decrement.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	k.SetStableBorrowedCoins(ctx, borrowedCoins.Sub(decrement...))
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestBorrowStableRate() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	usdxMarket := newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "1")
+	usdxMarket.StableRatePremium = sdk.MustNewDecFromStr("0.02")
+	usdxMarket.StableRebalanceUtilization = sdk.MustNewDecFromStr("0.5")
+	suite.setupMoneyMarketTest(
+		types.MoneyMarkets{
+			usdxMarket,
+			newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.8"),
+		},
+		supplier,
+		[]sdk.AccAddress{borrower},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)), sdk.NewCoin("usdx", sdkmath.NewInt(20*USDX_CF)))},
+	)
+
+	// $200 of ukava deposits can back $160 of borrows
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.BorrowStableRate(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))))
+	suite.Require().ErrorIs(err, types.ErrStableRateDisabled)
+
+	stableBorrow := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50*USDX_CF)))
+	err = suite.keeper.BorrowStableRate(suite.ctx, borrower, stableBorrow)
+	suite.Require().NoError(err)
+
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(stableBorrow, borrow.Amount)
+	suite.Require().Equal(stableBorrow, borrow.StableAmount)
+	stableBorrowed, found := suite.keeper.GetStableBorrowedCoins(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(stableBorrow, stableBorrowed)
+	borrowed, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+	suite.Require().True(borrowed.AmountOf("usdx").IsZero())
+
+	// At 5% utilization the variable rate is 15%, so the stable rate is 17%
+	stableRate, found := borrow.StableRates.GetRate("usdx")
+	suite.Require().True(found)
+	suite.Require().True(stableRate.EstimatedAPY().Sub(sdk.MustNewDecFromStr("0.17")).Abs().LT(sdk.MustNewDecFromStr("0.000001")))
+
+	// The stable rate can't be rebalanced below 50% utilization
+	err = suite.keeper.RebalanceStableRate(suite.ctx, borrower, "usdx")
+	suite.Require().ErrorIs(err, types.ErrStableRateRebalanceNotAllowed)
+
+	// A year of interest accrues at the locked in rate when the borrow is synced
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	suite.keeper.SyncBorrowInterest(suite.ctx, borrower)
+
+	borrow, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	owed := borrow.Amount.AmountOf("usdx")
+	suite.Require().True(owed.GT(sdkmath.NewInt(58*USDX_CF)) && owed.LT(sdkmath.NewInt(59*USDX_CF)), "owed %s", owed)
+	suite.Require().Equal(borrow.Amount, borrow.StableAmount)
+	stableBorrowed, _ = suite.keeper.GetStableBorrowedCoins(suite.ctx)
+	suite.Require().Equal(borrow.StableAmount, stableBorrowed)
+
+	// The interest is split between the reserves and suppliers
+	reserves, found := suite.keeper.GetTotalReserves(suite.ctx)
+	suite.Require().True(found)
+	interest := owed.Sub(sdkmath.NewInt(50 * USDX_CF))
+	suite.Require().Equal(sdk.NewDecFromInt(interest).Mul(sdk.MustNewDecFromStr("0.05")).TruncateInt(), reserves.AmountOf("usdx"))
+	supplied, found := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(1000*USDX_CF).Add(interest).Sub(reserves.AmountOf("usdx")), supplied.AmountOf("usdx"))
+
+	// Repaying the whole borrow removes it from the stable borrowed coins
+	err = suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(60*USDX_CF))))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+	stableBorrowed, _ = suite.keeper.GetStableBorrowedCoins(suite.ctx)
+	suite.Require().True(stableBorrowed.Empty())
+}
+
+func (suite *KeeperTestSuite) TestRebalanceStableRate() {
+	supplier := sdk.AccAddress(crypto.AddressHash([]byte("supplier")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	usdxMarket := newTestMoneyMarket("usdx", "usdx:usd", USDX_CF, "1")
+	usdxMarket.StableRatePremium = sdk.MustNewDecFromStr("0.02")
+	usdxMarket.StableRebalanceUtilization = sdk.MustNewDecFromStr("0.1")
+	suite.setupMoneyMarketTest(
+		types.MoneyMarkets{
+			usdxMarket,
+			newTestMoneyMarket("ukava", "kava:usd", KAVA_CF, "0.8"),
+		},
+		supplier,
+		[]sdk.AccAddress{borrower},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))},
+	)
+
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.RebalanceStableRate(suite.ctx, borrower, "usdx")
+	suite.Require().ErrorIs(err, types.ErrBorrowNotFound)
+
+	// The stable rate is locked in at 5% utilization
+	err = suite.keeper.BorrowStableRate(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50*USDX_CF))))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.RebalanceStableRate(suite.ctx, borrower, "ukava")
+	suite.Require().ErrorIs(err, types.ErrStableRateDisabled)
+
+	// Variable rate borrows raise the utilization to 10%, where the variable rate is 25%
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50*USDX_CF))))
+	suite.Require().NoError(err)
+
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))), borrow.Amount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50*USDX_CF))), borrow.StableAmount)
+	previousRate, found := borrow.StableRates.GetRate("usdx")
+	suite.Require().True(found)
+
+	err = suite.keeper.RebalanceStableRate(suite.ctx, borrower, "usdx")
+	suite.Require().NoError(err)
+
+	borrow, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	rate, found := borrow.StableRates.GetRate("usdx")
+	suite.Require().True(found)
+	suite.Require().True(rate.Rate.GT(previousRate.Rate))
+	suite.Require().True(rate.EstimatedAPY().Sub(sdk.MustNewDecFromStr("0.27")).Abs().LT(sdk.MustNewDecFromStr("0.000001")))
+
+	suite.Require().Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeHardRebalanceStable,
+		sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+		sdk.NewAttribute(types.AttributeKeyStableBorrowDenom, "usdx"),
+		sdk.NewAttribute(types.AttributeKeyStableRate, "0.270000000000000000"),
+	))
+
+	// Repayments are applied to the variable rate borrow first
+	err = suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(60*USDX_CF))))
+	suite.Require().NoError(err)
+	borrow, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(40*USDX_CF))), borrow.StableAmount)
+	stableBorrowed, _ := suite.keeper.GetStableBorrowedCoins(suite.ctx)
+	suite.Require().Equal(borrow.StableAmount, stableBorrowed)
+	borrowed, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+	suite.Require().True(borrowed.AmountOf("usdx").IsZero())
+}
@@ -47,6 +47,12 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "proposed withdraw outside loan-to-value range")
 	}
 
+	// Withdrawing other deposits can leave only deposits of an isolated money market
+	err = k.ValidateCollateralChange(ctx, depositor, proposedDeposit.Amount)
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount)
 	if err != nil {
 		return err
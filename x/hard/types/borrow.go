@@ -3,10 +3,14 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// secondsPerYear is used to estimate the annual rates of per second interest rates
+const secondsPerYear = 31536000
+
 // NewBorrow returns a new Borrow instance
 func NewBorrow(borrower sdk.AccAddress, amount sdk.Coins, index BorrowInterestFactors) Borrow {
 	return Borrow{
@@ -16,7 +20,7 @@ func NewBorrow(borrower sdk.AccAddress, amount sdk.Coins, index BorrowInterestFa
 	}
 }
 
-// NormalizedBorrow is the borrow amounts divided by the interest factors.
+// NormalizedBorrow is the variable rate borrow amounts divided by the interest factors.
 //
 // Multiplying the normalized borrow by the current global factors gives the current borrow (ie including all interest, ie a synced borrow).
 // The normalized borrow is effectively how big the borrow would have been if it had been borrowed at time 0 and not touched since.
@@ -25,7 +29,7 @@ func NewBorrow(borrower sdk.AccAddress, amount sdk.Coins, index BorrowInterestFa
 func (b Borrow) NormalizedBorrow() (sdk.DecCoins, error) {
 	normalized := sdk.NewDecCoins()
 
-	for _, coin := range b.Amount {
+	for _, coin := range b.VariableAmount() {
 
 		factor, found := b.Index.GetInterestFactor(coin.Denom)
 		if !found {
@@ -58,12 +62,65 @@ func (b Borrow) Validate() error {
 		return err
 	}
 
+	if !b.StableAmount.IsValid() {
+		return fmt.Errorf("invalid stable borrow coins: %s", b.StableAmount)
+	}
+	if !b.StableAmount.IsAllLTE(b.Amount) {
+		return fmt.Errorf("stable borrow coins %s cannot exceed borrow coins %s", b.StableAmount, b.Amount)
+	}
+	for _, coin := range b.StableAmount {
+		if _, found := b.StableRates.GetRate(coin.Denom); !found {
+			return fmt.Errorf("stable borrowed amount '%s' missing stable rate", coin.Denom)
+		}
+	}
+	if err := b.StableRates.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// VariableAmount returns the part of the borrow amount borrowed at variable rates
+func (b Borrow) VariableAmount() sdk.Coins {
+	return b.Amount.Sub(b.StableAmount...)
+}
+
+// SplitRepayment splits a repayment into the coins repaying variable rate borrows and the coins repaying stable rate
+// borrows. Repayments are applied to variable rate borrows first.
+func (b Borrow) SplitRepayment(payment sdk.Coins) (sdk.Coins, sdk.Coins) {
+	variableAmount := b.VariableAmount()
+	variablePayment := sdk.NewCoins()
+	stablePayment := sdk.NewCoins()
+	for _, coin := range payment {
+		variable := sdk.MinInt(coin.Amount, variableAmount.AmountOf(coin.Denom))
+		if variable.IsPositive() {
+			variablePayment = variablePayment.Add(sdk.NewCoin(coin.Denom, variable))
+		}
+		if stable := coin.Amount.Sub(variable); stable.IsPositive() {
+			stablePayment = stablePayment.Add(sdk.NewCoin(coin.Denom, stable))
+		}
+	}
+	return variablePayment, stablePayment
+}
+
+// RepayStable removes repaid coins from the stable borrow amount, removing the stable rates of fully repaid denoms.
+// The repaid coins are not removed from the borrow amount.
+func (b Borrow) RepayStable(coins sdk.Coins) Borrow {
+	b.StableAmount = b.StableAmount.Sub(coins...)
+	for _, coin := range coins {
+		if b.StableAmount.AmountOf(coin.Denom).IsZero() {
+			b.StableRates, _ = b.StableRates.RemoveRate(coin.Denom)
+		}
+	}
+	return b
+}
+
 // ToResponse converts Borrow to BorrowResponse
 func (b Borrow) ToResponse() BorrowResponse {
-	return NewBorrowResponse(b.Borrower, b.Amount, b.Index)
+	response := NewBorrowResponse(b.Borrower, b.Amount, b.Index)
+	response.StableAmount = b.StableAmount
+	response.StableRates = b.StableRates.ToResponse()
+	return response
 }
 
 // Borrows is a slice of Borrow
@@ -196,3 +253,98 @@ func (bifs BorrowInterestFactors) ToResponse() BorrowInterestFactorResponses {
 
 // BorrowInterestFactorResponses is a slice of BorrowInterestFactorResponse
 type BorrowInterestFactorResponses []BorrowInterestFactorResponse
+
+// NewStableBorrowRate returns a new StableBorrowRate instance
+func NewStableBorrowRate(denom string, rate sdk.Dec, accrualTime time.Time) StableBorrowRate {
+	return StableBorrowRate{
+		Denom:       denom,
+		Rate:        rate,
+		AccrualTime: accrualTime,
+	}
+}
+
+// Validate validates StableBorrowRate values
+func (sbr StableBorrowRate) Validate() error {
+	if strings.TrimSpace(sbr.Denom) == "" {
+		return fmt.Errorf("stable borrow rate denom cannot be empty")
+	}
+	if sbr.Rate.IsNil() || sbr.Rate.LT(sdk.OneDec()) {
+		return fmt.Errorf("stable borrow rate should be ≥ 1.0: %s", sbr)
+	}
+	return nil
+}
+
+// EstimatedAPY converts the per second rate of the stable borrow into an estimated annual interest rate
+func (sbr StableBorrowRate) EstimatedAPY() sdk.Dec {
+	return sbr.Rate.Power(secondsPerYear).Sub(sdk.OneDec())
+}
+
+// ToResponse converts StableBorrowRate to StableBorrowRateResponse
+func (sbr StableBorrowRate) ToResponse() StableBorrowRateResponse {
+	return StableBorrowRateResponse{
+		Denom: sbr.Denom,
+		Rate:  sbr.EstimatedAPY().String(),
+	}
+}
+
+// StableBorrowRates is a slice of StableBorrowRate, because Amino won't marshal maps
+type StableBorrowRates []StableBorrowRate
+
+// GetRate returns a denom's stable borrow rate
+func (sbrs StableBorrowRates) GetRate(denom string) (StableBorrowRate, bool) {
+	for _, sbr := range sbrs {
+		if sbr.Denom == denom {
+			return sbr, true
+		}
+	}
+	return StableBorrowRate{}, false
+}
+
+// SetRate sets a denom's stable borrow rate
+func (sbrs StableBorrowRates) SetRate(rate StableBorrowRate) StableBorrowRates {
+	for i, sbr := range sbrs {
+		if sbr.Denom == rate.Denom {
+			sbrs[i] = rate
+			return sbrs
+		}
+	}
+	return append(sbrs, rate)
+}
+
+// RemoveRate removes a denom's stable borrow rate
+func (sbrs StableBorrowRates) RemoveRate(denom string) (StableBorrowRates, bool) {
+	for i, sbr := range sbrs {
+		if sbr.Denom == denom {
+			return append(sbrs[:i], sbrs[i+1:]...), true
+		}
+	}
+	return sbrs, false
+}
+
+// Validate validates StableBorrowRates
+func (sbrs StableBorrowRates) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, sbr := range sbrs {
+		if err := sbr.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[sbr.Denom] {
+			return fmt.Errorf("duplicate stable borrow rate denom: %s", sbr.Denom)
+		}
+		seenDenoms[sbr.Denom] = true
+	}
+	return nil
+}
+
+// ToResponse converts StableBorrowRates to StableBorrowRateResponses
+func (sbrs StableBorrowRates) ToResponse() StableBorrowRateResponses {
+	var sbrResponses StableBorrowRateResponses
+
+	for _, sbr := range sbrs {
+		sbrResponses = append(sbrResponses, sbr.ToResponse())
+	}
+	return sbrResponses
+}
+
+// StableBorrowRateResponses is a slice of StableBorrowRateResponse
+type StableBorrowRateResponses []StableBorrowRateResponse
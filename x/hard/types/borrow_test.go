@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -39,6 +40,32 @@ func TestBorrow_NormalizedBorrow(t *testing.T) {
 				sdk.NewInt64DecCoin("xrpb", 8e7),
 			),
 		},
+		{
+			name: "stable rate borrows are excluded",
+			borrow: types.Borrow{
+				Amount: sdk.NewCoins(
+					sdk.NewInt64Coin("bnb", 100e8),
+					sdk.NewInt64Coin("usdx", 100e6),
+				),
+				Index: types.BorrowInterestFactors{
+					{
+						Denom: "bnb",
+						Value: sdk.MustNewDecFromStr("2.0"),
+					},
+					{
+						Denom: "usdx",
+						Value: sdk.MustNewDecFromStr("1.25"),
+					},
+				},
+				StableAmount: sdk.NewCoins(
+					sdk.NewInt64Coin("bnb", 40e8),
+					sdk.NewInt64Coin("usdx", 100e6),
+				),
+			},
+			expect: sdk.NewDecCoins(
+				sdk.NewInt64DecCoin("bnb", 30e8),
+			),
+		},
 		{
 			name: "empty borrow amount returns empty dec coins",
 			borrow: types.Borrow{
@@ -114,3 +141,130 @@ func TestBorrow_NormalizedBorrow(t *testing.T) {
 		})
 	}
 }
+
+func TestBorrow_Validate(t *testing.T) {
+	borrower := sdk.AccAddress("test1")
+	accrualTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("usdx", 100e6))
+	index := types.BorrowInterestFactors{types.NewBorrowInterestFactor("usdx", sdk.OneDec())}
+
+	testCases := []struct {
+		name      string
+		borrow    types.Borrow
+		expectErr string
+	}{
+		{
+			name: "valid stable rate borrow",
+			borrow: types.Borrow{
+				Borrower:     borrower,
+				Amount:       amount,
+				Index:        index,
+				StableAmount: sdk.NewCoins(sdk.NewInt64Coin("usdx", 50e6)),
+				StableRates: types.StableBorrowRates{
+					types.NewStableBorrowRate("usdx", sdk.MustNewDecFromStr("1.000000002"), accrualTime),
+				},
+			},
+		},
+		{
+			name: "stable amount exceeds amount",
+			borrow: types.Borrow{
+				Borrower:     borrower,
+				Amount:       amount,
+				Index:        index,
+				StableAmount: sdk.NewCoins(sdk.NewInt64Coin("usdx", 200e6)),
+				StableRates: types.StableBorrowRates{
+					types.NewStableBorrowRate("usdx", sdk.MustNewDecFromStr("1.000000002"), accrualTime),
+				},
+			},
+			expectErr: "cannot exceed borrow coins",
+		},
+		{
+			name: "missing stable rate",
+			borrow: types.Borrow{
+				Borrower:     borrower,
+				Amount:       amount,
+				Index:        index,
+				StableAmount: sdk.NewCoins(sdk.NewInt64Coin("usdx", 50e6)),
+			},
+			expectErr: "missing stable rate",
+		},
+		{
+			name: "invalid stable rate",
+			borrow: types.Borrow{
+				Borrower:     borrower,
+				Amount:       amount,
+				Index:        index,
+				StableAmount: sdk.NewCoins(sdk.NewInt64Coin("usdx", 50e6)),
+				StableRates: types.StableBorrowRates{
+					types.NewStableBorrowRate("usdx", sdk.MustNewDecFromStr("0.9"), accrualTime),
+				},
+			},
+			expectErr: "stable borrow rate should be ≥ 1.0",
+		},
+		{
+			name: "duplicate stable rate",
+			borrow: types.Borrow{
+				Borrower:     borrower,
+				Amount:       amount,
+				Index:        index,
+				StableAmount: sdk.NewCoins(sdk.NewInt64Coin("usdx", 50e6)),
+				StableRates: types.StableBorrowRates{
+					types.NewStableBorrowRate("usdx", sdk.MustNewDecFromStr("1.000000002"), accrualTime),
+					types.NewStableBorrowRate("usdx", sdk.MustNewDecFromStr("1.000000003"), accrualTime),
+				},
+			},
+			expectErr: "duplicate stable borrow rate denom",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.borrow.Validate()
+			if len(tc.expectErr) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBorrow_SplitRepayment(t *testing.T) {
+	borrow := types.Borrow{
+		Amount: sdk.NewCoins(
+			sdk.NewInt64Coin("bnb", 100e8),
+			sdk.NewInt64Coin("usdx", 100e6),
+		),
+		StableAmount: sdk.NewCoins(
+			sdk.NewInt64Coin("usdx", 40e6),
+		),
+		StableRates: types.StableBorrowRates{
+			types.NewStableBorrowRate("usdx", sdk.MustNewDecFromStr("1.000000002"), time.Time{}),
+		},
+	}
+
+	variable, stable := borrow.SplitRepayment(sdk.NewCoins(
+		sdk.NewInt64Coin("bnb", 10e8),
+		sdk.NewInt64Coin("usdx", 70e6),
+	))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bnb", 10e8), sdk.NewInt64Coin("usdx", 60e6)), variable)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usdx", 10e6)), stable)
+
+	// partially repaid stable rate borrows keep their rate
+	repaid := borrow.RepayStable(stable)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usdx", 30e6)), repaid.StableAmount)
+	_, found := repaid.StableRates.GetRate("usdx")
+	require.True(t, found)
+
+	// fully repaid stable rate borrows have their rate removed
+	repaid = repaid.RepayStable(sdk.NewCoins(sdk.NewInt64Coin("usdx", 30e6)))
+	require.True(t, repaid.StableAmount.Empty())
+	_, found = repaid.StableRates.GetRate("usdx")
+	require.False(t, found)
+}
+
+func TestStableBorrowRate_EstimatedAPY(t *testing.T) {
+	// a per second rate of 1.000000001547125958 is an APY of ~5%
+	rate := types.NewStableBorrowRate("usdx", sdk.MustNewDecFromStr("1.000000001547125958"), time.Time{})
+	require.True(t, rate.EstimatedAPY().Sub(sdk.MustNewDecFromStr("0.05")).Abs().LT(sdk.MustNewDecFromStr("0.000001")))
+}
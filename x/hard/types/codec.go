@@ -15,6 +15,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "hard/MsgLiquidate", nil)
 	cdc.RegisterConcrete(&MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(&MsgFlashLoan{}, "hard/MsgFlashLoan", nil)
+	cdc.RegisterConcrete(&MsgLiquidateBorrow{}, "hard/MsgLiquidateBorrow", nil)
+	cdc.RegisterConcrete(&MsgRebalanceStableRate{}, "hard/MsgRebalanceStableRate", nil)
+	cdc.RegisterConcrete(&MsgRedeemReceiptTokens{}, "hard/MsgRedeemReceiptTokens", nil)
+	cdc.RegisterConcrete(&MsgTransferReceiptTokens{}, "hard/MsgTransferReceiptTokens", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +29,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBorrow{},
 		&MsgLiquidate{},
 		&MsgRepay{},
+		&MsgFlashLoan{},
+		&MsgLiquidateBorrow{},
+		&MsgRebalanceStableRate{},
+		&MsgRedeemReceiptTokens{},
+		&MsgTransferReceiptTokens{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
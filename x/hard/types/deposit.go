@@ -7,6 +7,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// ReceiptDenomPrefix is the prefix of the denoms of receipt tokens minted for deposits
+const ReceiptDenomPrefix = "hard/"
+
+// ReceiptDenom returns the denom of the receipt tokens minted for deposits of a money market
+func ReceiptDenom(denom string) string {
+	return ReceiptDenomPrefix + denom
+}
+
+// ParseReceiptDenom returns the denom of the money market of a receipt token, or false if the denom isn't a receipt
+// token denom
+func ParseReceiptDenom(receiptDenom string) (string, bool) {
+	if !strings.HasPrefix(receiptDenom, ReceiptDenomPrefix) || len(receiptDenom) == len(ReceiptDenomPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(receiptDenom, ReceiptDenomPrefix), true
+}
+
 // NewDeposit returns a new deposit
 func NewDeposit(depositor sdk.AccAddress, amount sdk.Coins, indexes SupplyInterestFactors) Deposit {
 	return Deposit{
@@ -114,3 +114,42 @@ func TestDeposit_NormalizedDeposit(t *testing.T) {
 		})
 	}
 }
+
+func TestParseReceiptDenom(t *testing.T) {
+	testCases := []struct {
+		name         string
+		receiptDenom string
+		expectDenom  string
+		expectOk     bool
+	}{
+		{
+			name:         "receipt token",
+			receiptDenom: types.ReceiptDenom("bnb"),
+			expectDenom:  "bnb",
+			expectOk:     true,
+		},
+		{
+			name:         "receipt token of ibc denom",
+			receiptDenom: "hard/ibc/0471F1C4E7AFD3F07702BEF6DC365268D64570F7C1FDC98EA6098DD6DE59817B",
+			expectDenom:  "ibc/0471F1C4E7AFD3F07702BEF6DC365268D64570F7C1FDC98EA6098DD6DE59817B",
+			expectOk:     true,
+		},
+		{
+			name:         "not a receipt token",
+			receiptDenom: "bnb",
+			expectOk:     false,
+		},
+		{
+			name:         "receipt prefix without denom",
+			receiptDenom: types.ReceiptDenomPrefix,
+			expectOk:     false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, ok := types.ParseReceiptDenom(tc.receiptDenom)
+			require.Equal(t, tc.expectOk, ok)
+			require.Equal(t, tc.expectDenom, denom)
+		})
+	}
+}
@@ -67,4 +67,34 @@ var (
 	ErrExceedsProtocolBorrowableBalance = errorsmod.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = errorsmod.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrPriceFrozen for when a money market's price is frozen by the pricefeed circuit breaker
+	ErrPriceFrozen = errorsmod.Register(ModuleName, 33, "money market price is frozen")
+	// ErrFlashLoansDisabled for when a flash loan is requested from a money market without a flash loan fee
+	ErrFlashLoansDisabled = errorsmod.Register(ModuleName, 34, "flash loans disabled")
+	// ErrFlashLoanNotRepaid for when the borrower of a flash loan cannot repay the loan and fee
+	ErrFlashLoanNotRepaid = errorsmod.Register(ModuleName, 35, "flash loan not repaid")
+	// ErrFlashLoanInProgress for when a flash loan is requested by a message executed within another flash loan
+	ErrFlashLoanInProgress = errorsmod.Register(ModuleName, 36, "flash loan already in progress")
+	// ErrInvalidFlashLoanMsg for when a message executed within a flash loan is not signed by the borrower
+	ErrInvalidFlashLoanMsg = errorsmod.Register(ModuleName, 37, "invalid flash loan message")
+	// ErrIsolatedCollateral for when deposits of an isolated money market are combined with other deposits
+	ErrIsolatedCollateral = errorsmod.Register(ModuleName, 38, "isolated collateral cannot be combined with other collateral")
+	// ErrBorrowNotAllowedInIsolation for when a borrow against isolated collateral isn't of a borrowable denom
+	ErrBorrowNotAllowedInIsolation = errorsmod.Register(ModuleName, 39, "denom cannot be borrowed against isolated collateral")
+	// ErrExceedsDebtCeiling for when a borrow against isolated collateral exceeds the isolated market's debt ceiling
+	ErrExceedsDebtCeiling = errorsmod.Register(ModuleName, 40, "exceeds isolated collateral debt ceiling")
+	// ErrDirectLiquidationDisabled for when a direct liquidation repays a borrow of a money market without a close factor
+	ErrDirectLiquidationDisabled = errorsmod.Register(ModuleName, 41, "direct liquidation disabled")
+	// ErrInvalidLiquidationAmount for when a direct liquidation would repay or seize zero coins
+	ErrInvalidLiquidationAmount = errorsmod.Register(ModuleName, 42, "invalid liquidation amount")
+	// ErrStableRateDisabled for when a stable rate borrow is requested from a money market without a stable rate premium
+	ErrStableRateDisabled = errorsmod.Register(ModuleName, 43, "stable rate borrows disabled")
+	// ErrStableBorrowNotFound for when a stable rate borrow of a denom cannot be found
+	ErrStableBorrowNotFound = errorsmod.Register(ModuleName, 44, "stable rate borrow not found")
+	// ErrStableRateRebalanceNotAllowed for when a stable rate is rebalanced below the rebalance utilization
+	ErrStableRateRebalanceNotAllowed = errorsmod.Register(ModuleName, 45, "stable rate rebalance not allowed")
+	// ErrReceiptTokensDisabled for when receipt tokens are requested for deposits of a money market that doesn't allow them
+	ErrReceiptTokensDisabled = errorsmod.Register(ModuleName, 46, "receipt tokens disabled")
+	// ErrInvalidReceiptDenom for when a redeemed coin is not a receipt token of a money market
+	ErrInvalidReceiptDenom = errorsmod.Register(ModuleName, 47, "invalid receipt token denom")
 )
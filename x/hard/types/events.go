@@ -7,6 +7,12 @@ const (
 	EventTypeHardBorrow           = "hard_borrow"
 	EventTypeHardLiquidation      = "hard_liquidation"
 	EventTypeHardRepay            = "hard_repay"
+	EventTypeHardFlashLoan        = "hard_flash_loan"
+	EventTypeHardLiquidateBorrow  = "hard_liquidate_borrow"
+	EventTypeHardRebalanceStable  = "hard_rebalance_stable_rate"
+	EventTypeHardMintReceipt      = "hard_mint_receipt_tokens"
+	EventTypeHardRedeemReceipt    = "hard_redeem_receipt_tokens"
+	EventTypeHardTransferReceipt  = "hard_transfer_receipt_tokens"
 	AttributeValueCategory        = ModuleName
 	AttributeKeyDeposit           = "deposit"
 	AttributeKeyDepositDenom      = "deposit_denom"
@@ -22,4 +28,15 @@ const (
 	AttributeKeyKeeper            = "keeper"
 	AttributeKeyKeeperRewardCoins = "keeper_reward_coins"
 	AttributeKeyOwner             = "owner"
+	AttributeKeyFlashLoanCoins    = "flash_loan_coins"
+	AttributeKeyFlashLoanFee      = "flash_loan_fee"
+	AttributeKeyLiquidator        = "liquidator"
+	AttributeKeySeizedCoins       = "seized_coins"
+	AttributeKeyHealthFactor      = "health_factor"
+	AttributeKeyLiquidationBonus  = "liquidation_bonus"
+	AttributeKeyStableBorrowDenom = "stable_borrow_denom"
+	AttributeKeyStableRate        = "stable_rate"
+	AttributeKeyRedeemer          = "redeemer"
+	AttributeKeyReceiptTokens     = "receipt_tokens"
+	AttributeKeyReceiver          = "receiver"
 )
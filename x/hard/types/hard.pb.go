@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -74,6 +78,34 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	// auction_type is the type of auction liquidated deposits of this market are sold with, either "collateral" or
+	// "dutch". An empty auction type defaults to collateral auctions.
+	AuctionType string `protobuf:"bytes,8,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	// flash_loan_fee is the fee charged on flash loans of this market as a fraction of the amount lent. Flash loans are
+	// disabled when the fee is not set or zero.
+	FlashLoanFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=flash_loan_fee,json=flashLoanFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_loan_fee"`
+	// isolation_mode restricts deposits of this market to backing borrows of a whitelisted set of assets up to a debt
+	// ceiling.
+	IsolationMode IsolationMode `protobuf:"bytes,10,opt,name=isolation_mode,json=isolationMode,proto3" json:"isolation_mode"`
+	// e_mode_category groups money markets of correlated assets. Accounts whose deposits and borrows are all in the same
+	// category borrow against their deposits with the e_mode_loan_to_value of each market.
+	EModeCategory    string                                 `protobuf:"bytes,11,opt,name=e_mode_category,json=eModeCategory,proto3" json:"e_mode_category,omitempty"`
+	EModeLoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=e_mode_loan_to_value,json=eModeLoanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"e_mode_loan_to_value"`
+	// close_factor is the fraction of an unhealthy borrow of this market that can be repaid in a single direct
+	// liquidation. Direct liquidations of borrows of this market are disabled when it is not set or zero.
+	CloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=close_factor,json=closeFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_factor"`
+	// max_liquidation_bonus is the maximum bonus paid to direct liquidators of deposits of this market. The bonus starts
+	// at the keeper_reward_percentage and grows as the health factor of the liquidated account falls.
+	MaxLiquidationBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=max_liquidation_bonus,json=maxLiquidationBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_liquidation_bonus"`
+	// stable_rate_premium is added to the variable borrow rate of this market to give the rate locked in by stable rate
+	// borrows. Stable rate borrows of this market are disabled when it is not set or zero.
+	StableRatePremium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=stable_rate_premium,json=stableRatePremium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stable_rate_premium"`
+	// stable_rebalance_utilization is the utilization ratio of this market above which the locked in rates of stable
+	// rate borrows can be rebalanced to the current stable rate.
+	StableRebalanceUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=stable_rebalance_utilization,json=stableRebalanceUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stable_rebalance_utilization"`
+	// receipt_tokens_enabled allows deposits of this market to be made for transferable receipt tokens, which are
+	// redeemable for the deposit and its interest.
+	ReceiptTokensEnabled bool `protobuf:"varint,17,opt,name=receipt_tokens_enabled,json=receiptTokensEnabled,proto3" json:"receipt_tokens_enabled,omitempty"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
@@ -109,6 +141,48 @@ func (m *MoneyMarket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MoneyMarket proto.InternalMessageInfo
 
+// IsolationMode restricts the borrows that deposits of an isolated money market can back.
+type IsolationMode struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled"`
+	// borrowable_denoms are the denoms that can be borrowed against deposits of the isolated money market.
+	BorrowableDenoms []string `protobuf:"bytes,2,rep,name=borrowable_denoms,json=borrowableDenoms,proto3" json:"borrowable_denoms,omitempty"`
+	// debt_ceiling is the maximum USD value that can be borrowed against deposits of the isolated money market.
+	DebtCeiling github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=debt_ceiling,json=debtCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"debt_ceiling"`
+}
+
+func (m *IsolationMode) Reset()         { *m = IsolationMode{} }
+func (m *IsolationMode) String() string { return proto.CompactTextString(m) }
+func (*IsolationMode) ProtoMessage()    {}
+func (*IsolationMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{2}
+}
+func (m *IsolationMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolationMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolationMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolationMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolationMode.Merge(m, src)
+}
+func (m *IsolationMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolationMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolationMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolationMode proto.InternalMessageInfo
+
 // BorrowLimit enforces restrictions on a money market.
 type BorrowLimit struct {
 	HasMaxLimit  bool                                   `protobuf:"varint,1,opt,name=has_max_limit,json=hasMaxLimit,proto3" json:"has_max_limit"`
@@ -120,7 +194,7 @@ func (m *BorrowLimit) Reset()         { *m = BorrowLimit{} }
 func (m *BorrowLimit) String() string { return proto.CompactTextString(m) }
 func (*BorrowLimit) ProtoMessage()    {}
 func (*BorrowLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{2}
+	return fileDescriptor_23a5de800263a2ff, []int{3}
 }
 func (m *BorrowLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +235,7 @@ func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
 func (m *InterestRateModel) String() string { return proto.CompactTextString(m) }
 func (*InterestRateModel) ProtoMessage()    {}
 func (*InterestRateModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{3}
+	return fileDescriptor_23a5de800263a2ff, []int{4}
 }
 func (m *InterestRateModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +275,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{4}
+	return fileDescriptor_23a5de800263a2ff, []int{5}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,13 +309,16 @@ type Borrow struct {
 	Borrower github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=borrower,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"borrower,omitempty"`
 	Amount   github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	Index    BorrowInterestFactors                         `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=BorrowInterestFactors" json:"index"`
+	// stable_amount is the part of the amount borrowed at stable rates.
+	StableAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=stable_amount,json=stableAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"stable_amount"`
+	StableRates  StableBorrowRates                        `protobuf:"bytes,5,rep,name=stable_rates,json=stableRates,proto3,castrepeated=StableBorrowRates" json:"stable_rates"`
 }
 
 func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{5}
+	return fileDescriptor_23a5de800263a2ff, []int{6}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +357,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{6}
+	return fileDescriptor_23a5de800263a2ff, []int{7}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +396,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{7}
+	return fileDescriptor_23a5de800263a2ff, []int{8}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,6 +425,48 @@ func (m *BorrowInterestFactor) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BorrowInterestFactor proto.InternalMessageInfo
 
+// StableBorrowRate defines the rate locked in by an individual stable rate borrow.
+type StableBorrowRate struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the per second interest rate of the borrow, expressed as (1 + rate).
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// accrual_time is the time interest was last accrued on the borrow.
+	AccrualTime time.Time `protobuf:"bytes,3,opt,name=accrual_time,json=accrualTime,proto3,stdtime" json:"accrual_time"`
+}
+
+func (m *StableBorrowRate) Reset()         { *m = StableBorrowRate{} }
+func (m *StableBorrowRate) String() string { return proto.CompactTextString(m) }
+func (*StableBorrowRate) ProtoMessage()    {}
+func (*StableBorrowRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{9}
+}
+func (m *StableBorrowRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StableBorrowRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StableBorrowRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StableBorrowRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableBorrowRate.Merge(m, src)
+}
+func (m *StableBorrowRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *StableBorrowRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableBorrowRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableBorrowRate proto.InternalMessageInfo
+
 // CoinsProto defines a Protobuf wrapper around a Coins slice
 type CoinsProto struct {
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
@@ -357,7 +476,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{8}
+	return fileDescriptor_23a5de800263a2ff, []int{10}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,76 +508,106 @@ var xxx_messageInfo_CoinsProto proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.hard.v1beta1.Params")
 	proto.RegisterType((*MoneyMarket)(nil), "kava.hard.v1beta1.MoneyMarket")
+	proto.RegisterType((*IsolationMode)(nil), "kava.hard.v1beta1.IsolationMode")
 	proto.RegisterType((*BorrowLimit)(nil), "kava.hard.v1beta1.BorrowLimit")
 	proto.RegisterType((*InterestRateModel)(nil), "kava.hard.v1beta1.InterestRateModel")
 	proto.RegisterType((*Deposit)(nil), "kava.hard.v1beta1.Deposit")
 	proto.RegisterType((*Borrow)(nil), "kava.hard.v1beta1.Borrow")
 	proto.RegisterType((*SupplyInterestFactor)(nil), "kava.hard.v1beta1.SupplyInterestFactor")
 	proto.RegisterType((*BorrowInterestFactor)(nil), "kava.hard.v1beta1.BorrowInterestFactor")
+	proto.RegisterType((*StableBorrowRate)(nil), "kava.hard.v1beta1.StableBorrowRate")
 	proto.RegisterType((*CoinsProto)(nil), "kava.hard.v1beta1.CoinsProto")
 }
 
 func init() { proto.RegisterFile("kava/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
 
 var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x4e, 0x9a, 0xcc, 0xda, 0x69, 0x3c, 0x49, 0xaa, 0x6d, 0x54, 0xec, 0x60, 0xa0,
+	0x44, 0x42, 0xb1, 0x69, 0xf9, 0x73, 0xe2, 0x92, 0xad, 0x29, 0x44, 0xd4, 0x92, 0xb5, 0x4d, 0x91,
+	0x5a, 0x21, 0x2d, 0xb3, 0xbb, 0x13, 0x67, 0xf0, 0xee, 0xce, 0xb2, 0x33, 0x9b, 0xda, 0x1c, 0x10,
+	0x27, 0x24, 0x2e, 0xa8, 0x1f, 0x82, 0x13, 0x5c, 0xfb, 0x0d, 0xe0, 0xd0, 0x63, 0xd5, 0x13, 0xe2,
+	0xe0, 0x42, 0x7a, 0xeb, 0x99, 0x13, 0x27, 0x34, 0x7f, 0xd6, 0x76, 0x52, 0x57, 0x6a, 0xd5, 0x15,
+	0xe2, 0x94, 0x9d, 0xf7, 0xde, 0xfc, 0x7e, 0xef, 0xbd, 0x79, 0x6f, 0x9e, 0x27, 0xe0, 0x52, 0x1f,
+	0x1d, 0xa3, 0xd6, 0x11, 0x4a, 0x83, 0xd6, 0xf1, 0x15, 0x0f, 0x73, 0x74, 0x45, 0x2e, 0x9a, 0x49,
+	0x4a, 0x39, 0x85, 0x55, 0xa1, 0x6d, 0x4a, 0x81, 0xd6, 0x6e, 0xd5, 0x7c, 0xca, 0x22, 0xca, 0x5a,
+	0x1e, 0x62, 0x78, 0xbc, 0xc5, 0xa7, 0x24, 0x56, 0x5b, 0xb6, 0x2e, 0x2a, 0xbd, 0x2b, 0x57, 0x2d,
+	0xb5, 0xd0, 0xaa, 0x8d, 0x1e, 0xed, 0x51, 0x25, 0x17, 0x5f, 0x5a, 0x5a, 0xef, 0x51, 0xda, 0x0b,
+	0x71, 0x4b, 0xae, 0xbc, 0xec, 0xb0, 0xc5, 0x49, 0x84, 0x19, 0x47, 0x51, 0xa2, 0x0c, 0x1a, 0x7f,
+	0x1b, 0x60, 0xa9, 0x8b, 0x52, 0x14, 0x31, 0x78, 0x1b, 0x54, 0x22, 0x1a, 0xe3, 0xa1, 0x1b, 0xa1,
+	0xb4, 0x8f, 0x39, 0xb3, 0x8c, 0xed, 0x85, 0x1d, 0xf3, 0x6a, 0xad, 0xf9, 0x8c, 0x9f, 0xcd, 0x8e,
+	0xb0, 0xeb, 0x48, 0x33, 0x7b, 0xe3, 0xc1, 0xa8, 0x3e, 0xf7, 0xf3, 0xe3, 0x7a, 0x79, 0x4a, 0xc8,
+	0x9c, 0x72, 0x34, 0xb5, 0x82, 0x3f, 0x1a, 0xc0, 0x8a, 0x48, 0x4c, 0xa2, 0x2c, 0x72, 0x3d, 0x9a,
+	0xa6, 0xf4, 0xae, 0x9b, 0xb1, 0xc0, 0x3d, 0x46, 0x61, 0x86, 0xad, 0xf9, 0x6d, 0x63, 0x67, 0xc5,
+	0xbe, 0x25, 0x60, 0xfe, 0x18, 0xd5, 0x2f, 0xf7, 0x08, 0x3f, 0xca, 0xbc, 0xa6, 0x4f, 0x23, 0x1d,
+	0xa0, 0xfe, 0xb3, 0xcb, 0x82, 0x7e, 0x8b, 0x0f, 0x13, 0xcc, 0x9a, 0x6d, 0xec, 0x9f, 0x8c, 0xea,
+	0x9b, 0x1d, 0x85, 0x68, 0x4b, 0xc0, 0x5b, 0x37, 0xdb, 0x9f, 0x0b, 0xb8, 0x47, 0xf7, 0x77, 0x81,
+	0x4e, 0x4c, 0x1b, 0xfb, 0xce, 0x66, 0x74, 0xca, 0x88, 0x05, 0xd2, 0xa8, 0xf1, 0x8b, 0x09, 0xcc,
+	0x29, 0x7f, 0xe1, 0x06, 0x58, 0x0c, 0x70, 0x4c, 0x23, 0xcb, 0x10, 0xce, 0x38, 0x6a, 0x01, 0x3f,
+	0x01, 0x65, 0xed, 0x6d, 0x48, 0x22, 0xc2, 0xa5, 0xa7, 0xb3, 0x13, 0xa2, 0xe0, 0x6f, 0x08, 0x2b,
+	0xbb, 0x24, 0x22, 0x71, 0x4c, 0x6f, 0x22, 0x82, 0x1f, 0x82, 0x55, 0x96, 0x50, 0xae, 0x33, 0xeb,
+	0x92, 0xc0, 0x5a, 0x90, 0x41, 0xaf, 0x9d, 0x8c, 0xea, 0xe5, 0x9b, 0x09, 0xe5, 0xca, 0x8d, 0xfd,
+	0xb6, 0x53, 0x66, 0x93, 0x55, 0x00, 0x09, 0xa8, 0xfa, 0x34, 0x3e, 0xc6, 0x29, 0x23, 0x34, 0x76,
+	0x0f, 0x91, 0xcf, 0x69, 0x6a, 0x95, 0xe4, 0xd6, 0x8f, 0x5e, 0x22, 0x5f, 0xfb, 0x31, 0x9f, 0x4a,
+	0xcb, 0x7e, 0xcc, 0x9d, 0xb5, 0x09, 0xec, 0x75, 0x89, 0x0a, 0xef, 0x80, 0x75, 0x12, 0x73, 0x9c,
+	0x62, 0xc6, 0xdd, 0x14, 0x71, 0xec, 0x46, 0x34, 0xc0, 0xa1, 0xb5, 0x28, 0x43, 0x7e, 0x73, 0x46,
+	0xc8, 0xfb, 0xda, 0xda, 0x41, 0x1c, 0x77, 0x84, 0xad, 0x0e, 0xbc, 0x4a, 0xce, 0x2a, 0xa0, 0x0f,
+	0x56, 0x53, 0xcc, 0x70, 0x7a, 0x8c, 0xf3, 0x18, 0x96, 0x5e, 0x3a, 0x86, 0x36, 0xf6, 0xcf, 0x1c,
+	0x6d, 0x45, 0x63, 0xea, 0x00, 0x8e, 0x81, 0xd5, 0xc7, 0x38, 0xc1, 0xa9, 0x9b, 0xe2, 0xbb, 0x28,
+	0x0d, 0xdc, 0x04, 0xa7, 0x3e, 0x8e, 0x39, 0xea, 0x61, 0xeb, 0x5c, 0x01, 0x74, 0x17, 0x14, 0xba,
+	0x23, 0xc1, 0xbb, 0x63, 0x6c, 0xf8, 0x3a, 0x28, 0xa3, 0xcc, 0xe7, 0xe2, 0x80, 0xc4, 0x56, 0x6b,
+	0x59, 0x56, 0x90, 0xa9, 0x65, 0x07, 0xc3, 0x04, 0x43, 0x0f, 0xac, 0x1e, 0x86, 0x88, 0x1d, 0xb9,
+	0x21, 0x45, 0xb1, 0x7b, 0x88, 0xb1, 0xb5, 0x52, 0x80, 0x43, 0x65, 0x89, 0x79, 0x83, 0xa2, 0xf8,
+	0x3a, 0xc6, 0xb0, 0x03, 0x56, 0x09, 0xa3, 0x21, 0x92, 0x8e, 0x88, 0xb3, 0xb3, 0x80, 0x3c, 0xba,
+	0xed, 0x59, 0x47, 0x97, 0x1b, 0x8a, 0xe3, 0xd1, 0xc7, 0x56, 0x21, 0xd3, 0x42, 0x78, 0x19, 0x9c,
+	0x57, 0x25, 0xe0, 0xfa, 0x88, 0xe3, 0x1e, 0x4d, 0x87, 0x96, 0x29, 0x03, 0xab, 0xc8, 0x33, 0xbd,
+	0xa6, 0x85, 0x30, 0x04, 0x1b, 0xda, 0x4e, 0xc6, 0xc6, 0xa9, 0x6e, 0xea, 0x72, 0x01, 0x01, 0xae,
+	0x49, 0x2a, 0x11, 0xe0, 0x01, 0x95, 0x6d, 0x0b, 0x5d, 0x50, 0xf6, 0x43, 0xca, 0xc6, 0x65, 0x54,
+	0x29, 0x80, 0xc5, 0x94, 0x88, 0xba, 0x88, 0x12, 0xb0, 0x19, 0xa1, 0x81, 0x1b, 0x92, 0xaf, 0x33,
+	0x12, 0xa8, 0x5c, 0x7a, 0x34, 0xce, 0x98, 0xb5, 0x5a, 0x00, 0xd3, 0x7a, 0x84, 0x06, 0x37, 0x26,
+	0xc8, 0xb6, 0x00, 0x86, 0x21, 0x58, 0x67, 0x1c, 0x79, 0x21, 0x56, 0x5d, 0x97, 0xa4, 0x38, 0x22,
+	0x59, 0x64, 0x9d, 0x2f, 0x80, 0xaf, 0xaa, 0x80, 0x45, 0x1f, 0x76, 0x15, 0x2c, 0xfc, 0x16, 0x5c,
+	0xca, 0xd9, 0xb0, 0x87, 0x42, 0x14, 0xfb, 0xd8, 0xcd, 0x38, 0x09, 0xc9, 0x37, 0xd2, 0x25, 0x6b,
+	0xad, 0x00, 0xda, 0x2d, 0x4d, 0x9b, 0x13, 0xdc, 0x9a, 0xe0, 0xc3, 0xf7, 0xc1, 0x85, 0x14, 0xfb,
+	0x98, 0x24, 0xdc, 0xe5, 0xb4, 0x8f, 0x63, 0xe6, 0xe2, 0x58, 0x18, 0x07, 0x56, 0x75, 0xdb, 0xd8,
+	0x59, 0x76, 0x36, 0xb4, 0xf6, 0x40, 0x2a, 0x3f, 0x56, 0xba, 0xc6, 0x6f, 0x06, 0xa8, 0x9c, 0xaa,
+	0x59, 0xf8, 0x16, 0x38, 0x97, 0x6f, 0x14, 0x37, 0xf6, 0xb2, 0x6d, 0x3e, 0x1d, 0xd5, 0x73, 0x91,
+	0x93, 0x7f, 0xc0, 0x77, 0x40, 0x55, 0x5d, 0xc3, 0x32, 0x64, 0x79, 0xa9, 0x33, 0x6b, 0x7e, 0x7b,
+	0x61, 0x67, 0xc5, 0x59, 0x9b, 0x28, 0xda, 0x52, 0x2e, 0x8a, 0x2b, 0xc0, 0x1e, 0x77, 0x7d, 0x4c,
+	0x42, 0x12, 0xf7, 0xac, 0x85, 0x02, 0x72, 0x61, 0x0a, 0xc4, 0x6b, 0x0a, 0xb0, 0xf1, 0xc3, 0x3c,
+	0x30, 0xa7, 0x06, 0x05, 0xfc, 0x00, 0x54, 0x8e, 0x10, 0x73, 0x55, 0xc1, 0x89, 0xf9, 0xa2, 0x42,
+	0xa9, 0x3e, 0x1d, 0xd5, 0x4f, 0x2b, 0x1c, 0xf3, 0x08, 0xb1, 0x0e, 0x1a, 0xa8, 0x6d, 0x08, 0x54,
+	0x22, 0x34, 0x90, 0xb3, 0x74, 0x32, 0x96, 0x5e, 0xf9, 0x32, 0xd1, 0x90, 0x8a, 0xe2, 0x4b, 0x50,
+	0x39, 0xdd, 0xce, 0x85, 0xe4, 0x22, 0x9c, 0x74, 0x72, 0xe3, 0xa7, 0x05, 0x50, 0x7d, 0x66, 0x82,
+	0x40, 0x0a, 0x2a, 0xe2, 0xa7, 0x8f, 0x6a, 0x05, 0x94, 0x0c, 0xd5, 0x38, 0xb6, 0x3f, 0x7b, 0xe9,
+	0xdf, 0x06, 0xa6, 0x8d, 0x98, 0x2c, 0xfc, 0xbd, 0xee, 0xed, 0xb3, 0x6e, 0x78, 0xb9, 0x2a, 0x19,
+	0x42, 0x0c, 0xce, 0x4b, 0xc2, 0x28, 0x0b, 0x39, 0x49, 0x42, 0x82, 0xd3, 0x42, 0xb2, 0xb9, 0x2a,
+	0x40, 0x3b, 0x63, 0x4c, 0xd8, 0x05, 0xa5, 0x3e, 0x89, 0xfb, 0x85, 0xa4, 0x51, 0x22, 0x09, 0xc7,
+	0xbf, 0xca, 0xa2, 0x64, 0xda, 0xf1, 0x52, 0x11, 0x8e, 0x0b, 0xd0, 0x89, 0xe3, 0x8d, 0xfb, 0xf3,
+	0xe0, 0x5c, 0x1b, 0x27, 0x94, 0x11, 0x0e, 0x0f, 0xc1, 0x4a, 0xa0, 0x3e, 0x69, 0xaa, 0x0f, 0xe6,
+	0xd3, 0x7f, 0x46, 0xf5, 0xdd, 0x17, 0x20, 0xda, 0xf3, 0xfd, 0xbd, 0x20, 0x48, 0x31, 0x63, 0x8f,
+	0xee, 0xef, 0xae, 0x6b, 0x3e, 0x2d, 0xb1, 0x87, 0x1c, 0x33, 0x67, 0x02, 0x0d, 0x7d, 0xb0, 0x84,
+	0x22, 0x9a, 0xc5, 0x5c, 0x76, 0xaa, 0x79, 0xf5, 0x62, 0x53, 0x6f, 0x10, 0x49, 0x1d, 0xcf, 0xb0,
+	0x6b, 0x94, 0xc4, 0xf6, 0xbb, 0xfa, 0xb7, 0xe7, 0xce, 0x0b, 0xf8, 0x20, 0x36, 0x30, 0x47, 0x43,
+	0xc3, 0x2f, 0xc0, 0x22, 0x89, 0x03, 0x3c, 0xb0, 0x16, 0x24, 0xc7, 0xdb, 0x33, 0xa6, 0xe4, 0xcd,
+	0x2c, 0x49, 0xc2, 0x61, 0x5e, 0xa4, 0x6a, 0x40, 0xd8, 0xaf, 0x69, 0xc6, 0xcd, 0x59, 0x5a, 0xe6,
+	0x28, 0xd0, 0xc6, 0xf7, 0x25, 0xb0, 0xa4, 0x3a, 0x1d, 0x06, 0x60, 0x59, 0xdd, 0x34, 0xb8, 0xf8,
+	0xa4, 0x8d, 0x91, 0xff, 0x37, 0x39, 0x53, 0x41, 0x3f, 0x2f, 0x67, 0xb3, 0xb4, 0x79, 0xce, 0x60,
+	0x02, 0x2a, 0x7a, 0x34, 0xe9, 0x48, 0x4a, 0xc5, 0x47, 0x52, 0x56, 0x0c, 0x7b, 0x2a, 0x1e, 0x04,
+	0xca, 0x53, 0xa3, 0x97, 0x59, 0x8b, 0x92, 0xf0, 0x8d, 0x59, 0xa5, 0x20, 0xcd, 0x94, 0xfb, 0xe2,
+	0xea, 0xb0, 0x2f, 0x6a, 0xea, 0xea, 0x59, 0x0d, 0x73, 0xcc, 0xc9, 0xd4, 0x65, 0x8d, 0xef, 0x0c,
+	0xb0, 0x31, 0xab, 0x52, 0x9e, 0xf3, 0xe0, 0x70, 0xc0, 0xe2, 0xf4, 0x9b, 0xe8, 0xd5, 0x7a, 0x59,
+	0x41, 0x49, 0x17, 0x66, 0x25, 0xfe, 0x3f, 0x74, 0xe1, 0x57, 0x03, 0xac, 0x9d, 0x4d, 0xd4, 0x73,
+	0xe8, 0xbb, 0xa0, 0x24, 0x0e, 0xa3, 0x10, 0x76, 0x89, 0x24, 0x1e, 0x71, 0xc8, 0xf7, 0xd3, 0x0c,
+	0x85, 0xae, 0x78, 0xfc, 0xca, 0x3b, 0xd8, 0xbc, 0xba, 0xd5, 0x54, 0x2f, 0xe3, 0x66, 0xfe, 0x32,
+	0x6e, 0x1e, 0xe4, 0x2f, 0x63, 0x7b, 0x59, 0xb0, 0xde, 0x7b, 0x5c, 0x37, 0x1c, 0x53, 0xef, 0x14,
+	0xba, 0x06, 0x05, 0x40, 0x56, 0x51, 0x57, 0xec, 0x80, 0x08, 0x2c, 0x8a, 0x87, 0x79, 0xfe, 0x4a,
+	0x2e, 0xb4, 0x4c, 0x15, 0xb2, 0xdd, 0x7e, 0xf0, 0x57, 0x6d, 0xee, 0xc1, 0x49, 0xcd, 0x78, 0x78,
+	0x52, 0x33, 0xfe, 0x3c, 0xa9, 0x19, 0xf7, 0x9e, 0xd4, 0xe6, 0x1e, 0x3e, 0xa9, 0xcd, 0xfd, 0xfe,
+	0xa4, 0x36, 0x77, 0x67, 0x3a, 0x27, 0xa2, 0x62, 0x77, 0x43, 0xe4, 0x31, 0xf9, 0xd5, 0x1a, 0xa8,
+	0xff, 0x39, 0x48, 0x48, 0x6f, 0x49, 0x46, 0xf8, 0xde, 0xbf, 0x03, 0x00, 0x51, 0x50, 0x92, 0xf1,
+	0x8d, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -528,6 +677,104 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReceiptTokensEnabled {
+		i--
+		if m.ReceiptTokensEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.StableRebalanceUtilization.Size()
+		i -= size
+		if _, err := m.StableRebalanceUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.StableRatePremium.Size()
+		i -= size
+		if _, err := m.StableRatePremium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.MaxLiquidationBonus.Size()
+		i -= size
+		if _, err := m.MaxLiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.CloseFactor.Size()
+		i -= size
+		if _, err := m.CloseFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.EModeLoanToValue.Size()
+		i -= size
+		if _, err := m.EModeLoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.EModeCategory) > 0 {
+		i -= len(m.EModeCategory)
+		copy(dAtA[i:], m.EModeCategory)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.EModeCategory)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size, err := m.IsolationMode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.FlashLoanFee.Size()
+		i -= size
+		if _, err := m.FlashLoanFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.KeeperRewardPercentage.Size()
 		i -= size
@@ -595,6 +842,58 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IsolationMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IsolationMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsolationMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DebtCeiling.Size()
+		i -= size
+		if _, err := m.DebtCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BorrowableDenoms) > 0 {
+		for iNdEx := len(m.BorrowableDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BorrowableDenoms[iNdEx])
+			copy(dAtA[i:], m.BorrowableDenoms[iNdEx])
+			i = encodeVarintHard(dAtA, i, uint64(len(m.BorrowableDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BorrowLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -789,6 +1088,34 @@ func (m *Borrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StableRates) > 0 {
+		for iNdEx := len(m.StableRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StableRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StableAmount) > 0 {
+		for iNdEx := len(m.StableAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StableAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Index) > 0 {
 		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -907,6 +1234,54 @@ func (m *BorrowInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StableBorrowRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StableBorrowRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StableBorrowRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AccrualTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AccrualTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintHard(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -996,6 +1371,51 @@ func (m *MoneyMarket) Size() (n int) {
 	n += 1 + l + sovHard(uint64(l))
 	l = m.KeeperRewardPercentage.Size()
 	n += 1 + l + sovHard(uint64(l))
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.FlashLoanFee.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.IsolationMode.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = len(m.EModeCategory)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.EModeLoanToValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.CloseFactor.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MaxLiquidationBonus.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.StableRatePremium.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.StableRebalanceUtilization.Size()
+	n += 2 + l + sovHard(uint64(l))
+	if m.ReceiptTokensEnabled {
+		n += 3
+	}
+	return n
+}
+
+func (m *IsolationMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.BorrowableDenoms) > 0 {
+		for _, s := range m.BorrowableDenoms {
+			l = len(s)
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.DebtCeiling.Size()
+	n += 1 + l + sovHard(uint64(l))
 	return n
 }
 
@@ -1079,6 +1499,18 @@ func (m *Borrow) Size() (n int) {
 			n += 1 + l + sovHard(uint64(l))
 		}
 	}
+	if len(m.StableAmount) > 0 {
+		for _, e := range m.StableAmount {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if len(m.StableRates) > 0 {
+		for _, e := range m.StableRates {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1112,15 +1544,32 @@ func (m *BorrowInterestFactor) Size() (n int) {
 	return n
 }
 
-func (m *CoinsProto) Size() (n int) {
+func (m *StableBorrowRate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AccrualTime)
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *CoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
 			n += 1 + l + sovHard(uint64(l))
 		}
 	}
@@ -1404,18 +1853,490 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashLoanFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashLoanFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IsolationMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeCategory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EModeCategory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeLoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EModeLoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLiquidationBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableRatePremium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StableRatePremium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableRebalanceUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StableRebalanceUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptTokensEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiptTokensEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsolationMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsolationMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsolationMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1425,28 +2346,15 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthHard
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthHard
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowableDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,13 +2382,11 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BorrowableDenoms = append(m.BorrowableDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1508,7 +2414,7 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DebtCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2136,6 +3042,74 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableAmount = append(m.StableAmount, types.Coin{})
+			if err := m.StableAmount[len(m.StableAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableRates = append(m.StableRates, StableBorrowRate{})
+			if err := m.StableRates[len(m.StableRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHard(dAtA[iNdEx:])
@@ -2389,6 +3363,155 @@ func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StableBorrowRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StableBorrowRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StableBorrowRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CoinsProto) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -51,3 +51,17 @@ func (h MultiHARDHooks) AfterBorrowModified(ctx sdk.Context, borrow Borrow) {
 		h[i].AfterBorrowModified(ctx, borrow)
 	}
 }
+
+// BeforeReceiptTokensModified runs before the receipt tokens held by an account are minted or redeemed
+func (h MultiHARDHooks) BeforeReceiptTokensModified(ctx sdk.Context, holder sdk.AccAddress) {
+	for i := range h {
+		h[i].BeforeReceiptTokensModified(ctx, holder)
+	}
+}
+
+// AfterReceiptTokensModified runs after the receipt tokens held by an account are minted or redeemed
+func (h MultiHARDHooks) AfterReceiptTokensModified(ctx sdk.Context, holder sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterReceiptTokensModified(ctx, holder)
+	}
+}
@@ -28,6 +28,9 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	FlashLoanInProgressKey        = []byte{0x11}
+	IsolatedDebtPrefix            = []byte{0x12} // denom -> sdk.Coins
+	StableBorrowedCoinsPrefix     = []byte{0x13}
 )
 
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
@@ -2,8 +2,11 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 )
 
 // ensure Msg interface compliance at compile time
@@ -13,6 +16,13 @@ var (
 	_ sdk.Msg = &MsgBorrow{}
 	_ sdk.Msg = &MsgRepay{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgFlashLoan{}
+	_ sdk.Msg = &MsgLiquidateBorrow{}
+	_ sdk.Msg = &MsgRebalanceStableRate{}
+	_ sdk.Msg = &MsgRedeemReceiptTokens{}
+	_ sdk.Msg = &MsgTransferReceiptTokens{}
+
+	_ cdctypes.UnpackInterfacesMessage = MsgFlashLoan{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -23,6 +33,15 @@ func NewMsgDeposit(depositor sdk.AccAddress, amount sdk.Coins) MsgDeposit {
 	}
 }
 
+// NewMsgDepositReceiptTokens returns a new MsgDeposit that mints receipt tokens to the depositor
+func NewMsgDepositReceiptTokens(depositor sdk.AccAddress, amount sdk.Coins) MsgDeposit {
+	return MsgDeposit{
+		Depositor:     depositor.String(),
+		Amount:        amount,
+		ReceiptTokens: true,
+	}
+}
+
 // Route return the message type used for routing the message.
 func (msg MsgDeposit) Route() string { return RouterKey }
 
@@ -106,6 +125,15 @@ func NewMsgBorrow(borrower sdk.AccAddress, amount sdk.Coins) MsgBorrow {
 	}
 }
 
+// NewMsgBorrowStableRate returns a new MsgBorrow that borrows at stable rates
+func NewMsgBorrowStableRate(borrower sdk.AccAddress, amount sdk.Coins) MsgBorrow {
+	return MsgBorrow{
+		Borrower:   borrower.String(),
+		Amount:     amount,
+		StableRate: true,
+	}
+}
+
 // Route return the message type used for routing the message.
 func (msg MsgBorrow) Route() string { return RouterKey }
 
@@ -226,3 +254,286 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgFlashLoan returns a new MsgFlashLoan
+func NewMsgFlashLoan(borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) MsgFlashLoan {
+	msgsAny := make([]*cdctypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := cdctypes.NewAnyWithValue(msg)
+		if err != nil {
+			panic(err)
+		}
+		msgsAny[i] = any
+	}
+
+	return MsgFlashLoan{
+		Borrower: borrower.String(),
+		Amount:   amount,
+		Msgs:     msgsAny,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashLoan) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashLoan) Type() string { return "hard_flash_loan" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashLoan) ValidateBasic() error {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "flash loan amount %s", msg.Amount)
+	}
+	if len(msg.Msgs) == 0 {
+		return errorsmod.Wrap(ErrInvalidFlashLoanMsg, "flash loan messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for i, m := range msgs {
+		if _, ok := m.(*MsgFlashLoan); ok {
+			return errorsmod.Wrapf(ErrInvalidFlashLoanMsg, "message %d is a flash loan, flash loans cannot be nested", i)
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "message %d", i)
+		}
+		signers := m.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(borrower) {
+			return errorsmod.Wrapf(ErrInvalidFlashLoanMsg, "message %d must be signed by the borrower only", i)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg. The authz amino codec is used since the messages
+// of other modules executed by the flash loan are registered on it.
+func (msg MsgFlashLoan) GetSignBytes() []byte {
+	bz := authzcodec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashLoan) GetSigners() []sdk.AccAddress {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{borrower}
+}
+
+// GetMessages returns the unpacked messages executed by the flash loan.
+func (msg MsgFlashLoan) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "sdk.MsgFlashLoan")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashLoan) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, m := range msg.Msgs {
+		var sdkMsg sdk.Msg
+		if err := unpacker.UnpackAny(m, &sdkMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewMsgLiquidateBorrow returns a new MsgLiquidateBorrow
+func NewMsgLiquidateBorrow(liquidator, borrower sdk.AccAddress, repay sdk.Coin, collateralDenom string) MsgLiquidateBorrow {
+	return MsgLiquidateBorrow{
+		Liquidator:      liquidator.String(),
+		Borrower:        borrower.String(),
+		Repay:           repay,
+		CollateralDenom: collateralDenom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLiquidateBorrow) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLiquidateBorrow) Type() string { return "hard_liquidate_borrow" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLiquidateBorrow) ValidateBasic() error {
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if liquidator.Equals(borrower) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "borrower cannot liquidate their own borrow")
+	}
+
+	if !msg.Repay.IsValid() || !msg.Repay.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "repay amount %s", msg.Repay)
+	}
+	if err := sdk.ValidateDenom(msg.CollateralDenom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLiquidateBorrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLiquidateBorrow) GetSigners() []sdk.AccAddress {
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{liquidator}
+}
+
+// NewMsgRebalanceStableRate returns a new MsgRebalanceStableRate
+func NewMsgRebalanceStableRate(sender, borrower sdk.AccAddress, denom string) MsgRebalanceStableRate {
+	return MsgRebalanceStableRate{
+		Sender:   sender.String(),
+		Borrower: borrower.String(),
+		Denom:    denom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRebalanceStableRate) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRebalanceStableRate) Type() string { return "hard_rebalance_stable_rate" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRebalanceStableRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Borrower); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRebalanceStableRate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRebalanceStableRate) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRedeemReceiptTokens returns a new MsgRedeemReceiptTokens
+func NewMsgRedeemReceiptTokens(redeemer sdk.AccAddress, amount sdk.Coins) MsgRedeemReceiptTokens {
+	return MsgRedeemReceiptTokens{
+		Redeemer: redeemer.String(),
+		Amount:   amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedeemReceiptTokens) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedeemReceiptTokens) Type() string { return "hard_redeem_receipt_tokens" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemReceiptTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Redeemer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "redeem amount %s", msg.Amount)
+	}
+	for _, coin := range msg.Amount {
+		if _, ok := ParseReceiptDenom(coin.Denom); !ok {
+			return errorsmod.Wrapf(ErrInvalidReceiptDenom, "%s", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemReceiptTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemReceiptTokens) GetSigners() []sdk.AccAddress {
+	redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{redeemer}
+}
+
+// NewMsgTransferReceiptTokens returns a new MsgTransferReceiptTokens
+func NewMsgTransferReceiptTokens(sender, receiver sdk.AccAddress, amount sdk.Coins) MsgTransferReceiptTokens {
+	return MsgTransferReceiptTokens{
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Amount:   amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTransferReceiptTokens) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTransferReceiptTokens) Type() string { return "hard_transfer_receipt_tokens" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTransferReceiptTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Sender == msg.Receiver {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver cannot be the same")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "transfer amount %s", msg.Amount)
+	}
+	for _, coin := range msg.Amount {
+		if _, ok := ParseReceiptDenom(coin.Denom); !ok {
+			return errorsmod.Wrapf(ErrInvalidReceiptDenom, "%s", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTransferReceiptTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTransferReceiptTokens) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -190,6 +190,382 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgFlashLoan() {
+	borrower := sdk.AccAddress("test1")
+	other := sdk.AccAddress("test2")
+	amount := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(10000000)))
+	repay := types.NewMsgRepay(borrower, borrower, amount)
+
+	testCases := []struct {
+		name        string
+		borrower    sdk.AccAddress
+		amount      sdk.Coins
+		msgs        []sdk.Msg
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:       "valid",
+			borrower:   borrower,
+			amount:     amount,
+			msgs:       []sdk.Msg{&repay},
+			expectPass: true,
+		},
+		{
+			name:        "empty amount",
+			borrower:    borrower,
+			amount:      sdk.NewCoins(),
+			msgs:        []sdk.Msg{&repay},
+			expectPass:  false,
+			expectedErr: "flash loan amount",
+		},
+		{
+			name:        "no messages",
+			borrower:    borrower,
+			amount:      amount,
+			msgs:        []sdk.Msg{},
+			expectPass:  false,
+			expectedErr: "flash loan messages cannot be empty",
+		},
+		{
+			name:        "message not signed by borrower",
+			borrower:    other,
+			amount:      amount,
+			msgs:        []sdk.Msg{&repay},
+			expectPass:  false,
+			expectedErr: "message 0 must be signed by the borrower only",
+		},
+		{
+			name:     "nested flash loan",
+			borrower: borrower,
+			amount:   amount,
+			msgs: func() []sdk.Msg {
+				nested := types.NewMsgFlashLoan(borrower, amount, []sdk.Msg{&repay})
+				return []sdk.Msg{&nested}
+			}(),
+			expectPass:  false,
+			expectedErr: "message 0 is a flash loan, flash loans cannot be nested",
+		},
+		{
+			name:     "invalid message",
+			borrower: borrower,
+			amount:   amount,
+			msgs: func() []sdk.Msg {
+				invalid := types.NewMsgRepay(borrower, borrower, sdk.NewCoins())
+				return []sdk.Msg{&invalid}
+			}(),
+			expectPass:  false,
+			expectedErr: "message 0",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgFlashLoan(tc.borrower, tc.amount, tc.msgs)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+				suite.NotPanics(func() { msg.GetSignBytes() })
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgLiquidateBorrow() {
+	type args struct {
+		liquidator      sdk.AccAddress
+		borrower        sdk.AccAddress
+		repay           sdk.Coin
+		collateralDenom string
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				liquidator:      addrs[0],
+				borrower:        addrs[1],
+				repay:           sdk.NewCoin("usdx", sdkmath.NewInt(1000000)),
+				collateralDenom: "bnb",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: self liquidation",
+			args: args{
+				liquidator:      addrs[0],
+				borrower:        addrs[0],
+				repay:           sdk.NewCoin("usdx", sdkmath.NewInt(1000000)),
+				collateralDenom: "bnb",
+			},
+			expectPass:  false,
+			expectedErr: "borrower cannot liquidate their own borrow",
+		},
+		{
+			name: "invalid: zero repay",
+			args: args{
+				liquidator:      addrs[0],
+				borrower:        addrs[1],
+				repay:           sdk.NewCoin("usdx", sdkmath.ZeroInt()),
+				collateralDenom: "bnb",
+			},
+			expectPass:  false,
+			expectedErr: "repay amount 0usdx",
+		},
+		{
+			name: "invalid: collateral denom",
+			args: args{
+				liquidator:      addrs[0],
+				borrower:        addrs[1],
+				repay:           sdk.NewCoin("usdx", sdkmath.NewInt(1000000)),
+				collateralDenom: "",
+			},
+			expectPass:  false,
+			expectedErr: "invalid denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgLiquidateBorrow(tc.args.liquidator, tc.args.borrower, tc.args.repay, tc.args.collateralDenom)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgRebalanceStableRate() {
+	type args struct {
+		sender   sdk.AccAddress
+		borrower sdk.AccAddress
+		denom    string
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				sender:   addrs[0],
+				borrower: addrs[1],
+				denom:    "usdx",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "valid: borrower rebalances their own borrow",
+			args: args{
+				sender:   addrs[0],
+				borrower: addrs[0],
+				denom:    "usdx",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: empty borrower",
+			args: args{
+				sender:   addrs[0],
+				borrower: sdk.AccAddress{},
+				denom:    "usdx",
+			},
+			expectPass:  false,
+			expectedErr: "empty address string is not allowed",
+		},
+		{
+			name: "invalid: denom",
+			args: args{
+				sender:   addrs[0],
+				borrower: addrs[1],
+				denom:    "",
+			},
+			expectPass:  false,
+			expectedErr: "invalid denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgRebalanceStableRate(tc.args.sender, tc.args.borrower, tc.args.denom)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgRedeemReceiptTokens() {
+	type args struct {
+		redeemer sdk.AccAddress
+		amount   sdk.Coins
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				redeemer: addrs[0],
+				amount:   sdk.NewCoins(sdk.NewInt64Coin(types.ReceiptDenom("bnb"), 10000000)),
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: empty redeemer",
+			args: args{
+				redeemer: sdk.AccAddress{},
+				amount:   sdk.NewCoins(sdk.NewInt64Coin(types.ReceiptDenom("bnb"), 10000000)),
+			},
+			expectPass:  false,
+			expectedErr: "empty address string is not allowed",
+		},
+		{
+			name: "invalid: zero amount",
+			args: args{
+				redeemer: addrs[0],
+				amount:   sdk.Coins{},
+			},
+			expectPass:  false,
+			expectedErr: "redeem amount",
+		},
+		{
+			name: "invalid: not a receipt token",
+			args: args{
+				redeemer: addrs[0],
+				amount:   sdk.NewCoins(sdk.NewInt64Coin("bnb", 10000000)),
+			},
+			expectPass:  false,
+			expectedErr: "invalid receipt token denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgRedeemReceiptTokens(tc.args.redeemer, tc.args.amount)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgTransferReceiptTokens() {
+	type args struct {
+		sender   sdk.AccAddress
+		receiver sdk.AccAddress
+		amount   sdk.Coins
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				sender:   addrs[0],
+				receiver: addrs[1],
+				amount:   sdk.NewCoins(sdk.NewInt64Coin(types.ReceiptDenom("bnb"), 10000000)),
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: empty receiver",
+			args: args{
+				sender:   addrs[0],
+				receiver: sdk.AccAddress{},
+				amount:   sdk.NewCoins(sdk.NewInt64Coin(types.ReceiptDenom("bnb"), 10000000)),
+			},
+			expectPass:  false,
+			expectedErr: "empty address string is not allowed",
+		},
+		{
+			name: "invalid: sender is receiver",
+			args: args{
+				sender:   addrs[0],
+				receiver: addrs[0],
+				amount:   sdk.NewCoins(sdk.NewInt64Coin(types.ReceiptDenom("bnb"), 10000000)),
+			},
+			expectPass:  false,
+			expectedErr: "sender and receiver cannot be the same",
+		},
+		{
+			name: "invalid: zero amount",
+			args: args{
+				sender:   addrs[0],
+				receiver: addrs[1],
+				amount:   sdk.Coins{},
+			},
+			expectPass:  false,
+			expectedErr: "transfer amount",
+		},
+		{
+			name: "invalid: not a receipt token",
+			args: args{
+				sender:   addrs[0],
+				receiver: addrs[1],
+				amount:   sdk.NewCoins(sdk.NewInt64Coin("bnb", 10000000)),
+			},
+			expectPass:  false,
+			expectedErr: "invalid receipt token denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgTransferReceiptTokens(tc.args.sender, tc.args.receiver, tc.args.amount)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
@@ -9,6 +9,12 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Auction types that liquidated deposits can be sold with
+const (
+	AuctionTypeCollateral = "collateral"
+	AuctionTypeDutch      = "dutch"
+)
+
 // Parameter keys and default values
 var (
 	KeyMoneyMarkets              = []byte("MoneyMarkets")
@@ -60,18 +66,89 @@ func (bl BorrowLimit) Equal(blCompareTo BorrowLimit) bool {
 	return true
 }
 
+// NewIsolationMode returns a new IsolationMode
+func NewIsolationMode(enabled bool, borrowableDenoms []string, debtCeiling sdk.Dec) IsolationMode {
+	return IsolationMode{
+		Enabled:          enabled,
+		BorrowableDenoms: borrowableDenoms,
+		DebtCeiling:      debtCeiling,
+	}
+}
+
+// Validate IsolationMode
+func (im IsolationMode) Validate() error {
+	if !im.Enabled {
+		return nil
+	}
+
+	if len(im.BorrowableDenoms) == 0 {
+		return fmt.Errorf("isolation mode must have at least one borrowable denom")
+	}
+	seenDenoms := make(map[string]bool)
+	for _, denom := range im.BorrowableDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid isolation mode borrowable denom: %w", err)
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate isolation mode borrowable denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	if im.DebtCeiling.IsNil() || !im.DebtCeiling.IsPositive() {
+		return fmt.Errorf("isolation mode debt ceiling must be positive: %s", im.DebtCeiling)
+	}
+	return nil
+}
+
+// IsBorrowable returns true if the denom can be borrowed against deposits of the isolated money market
+func (im IsolationMode) IsBorrowable(denom string) bool {
+	for _, borrowableDenom := range im.BorrowableDenoms {
+		if borrowableDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns a boolean indicating if an IsolationMode is equal to another IsolationMode
+func (im IsolationMode) Equal(imCompareTo IsolationMode) bool {
+	if im.Enabled != imCompareTo.Enabled {
+		return false
+	}
+	if len(im.BorrowableDenoms) != len(imCompareTo.BorrowableDenoms) {
+		return false
+	}
+	for i := range im.BorrowableDenoms {
+		if im.BorrowableDenoms[i] != imCompareTo.BorrowableDenoms[i] {
+			return false
+		}
+	}
+	if !equalOptionalDec(im.DebtCeiling, imCompareTo.DebtCeiling) {
+		return false
+	}
+	return true
+}
+
 // NewMoneyMarket returns a new MoneyMarket
 func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string, conversionFactor sdkmath.Int,
 	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec,
 ) MoneyMarket {
 	return MoneyMarket{
-		Denom:                  denom,
-		BorrowLimit:            borrowLimit,
-		SpotMarketID:           spotMarketID,
-		ConversionFactor:       conversionFactor,
-		InterestRateModel:      interestRateModel,
-		ReserveFactor:          reserveFactor,
-		KeeperRewardPercentage: keeperRewardPercentage,
+		Denom:                      denom,
+		BorrowLimit:                borrowLimit,
+		SpotMarketID:               spotMarketID,
+		ConversionFactor:           conversionFactor,
+		InterestRateModel:          interestRateModel,
+		ReserveFactor:              reserveFactor,
+		KeeperRewardPercentage:     keeperRewardPercentage,
+		FlashLoanFee:               sdk.ZeroDec(),
+		IsolationMode:              NewIsolationMode(false, nil, sdk.ZeroDec()),
+		EModeLoanToValue:           sdk.ZeroDec(),
+		CloseFactor:                sdk.ZeroDec(),
+		MaxLiquidationBonus:        sdk.ZeroDec(),
+		StableRatePremium:          sdk.ZeroDec(),
+		StableRebalanceUtilization: sdk.ZeroDec(),
 	}
 }
 
@@ -80,6 +157,9 @@ func (mm MoneyMarket) Validate() error {
 	if err := sdk.ValidateDenom(mm.Denom); err != nil {
 		return err
 	}
+	if _, ok := ParseReceiptDenom(mm.Denom); ok {
+		return fmt.Errorf("receipt tokens %s cannot be used as a money market", mm.Denom)
+	}
 
 	if err := mm.BorrowLimit.Validate(); err != nil {
 		return err
@@ -101,9 +181,108 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	if mm.AuctionType != "" && mm.AuctionType != AuctionTypeCollateral && mm.AuctionType != AuctionTypeDutch {
+		return fmt.Errorf("invalid auction type %s", mm.AuctionType)
+	}
+
+	if !mm.FlashLoanFee.IsNil() && (mm.FlashLoanFee.IsNegative() || mm.FlashLoanFee.GTE(sdk.OneDec())) {
+		return fmt.Errorf("flash loan fee must be in the range 0.0-1.0, excluding 1.0")
+	}
+
+	if err := mm.IsolationMode.Validate(); err != nil {
+		return err
+	}
+
+	if mm.EModeCategory == "" {
+		if !isUnsetDec(mm.EModeLoanToValue) {
+			return fmt.Errorf("e-mode loan-to-value cannot be set without an e-mode category")
+		}
+	} else {
+		if isUnsetDec(mm.EModeLoanToValue) {
+			return fmt.Errorf("e-mode loan-to-value must be set for e-mode category %s", mm.EModeCategory)
+		}
+		if mm.EModeLoanToValue.LT(mm.BorrowLimit.LoanToValue) || mm.EModeLoanToValue.GT(sdk.OneDec()) {
+			return fmt.Errorf("e-mode loan-to-value must be between the loan-to-value %s and 1.0: %s",
+				mm.BorrowLimit.LoanToValue, mm.EModeLoanToValue)
+		}
+	}
+
+	if !mm.CloseFactor.IsNil() && (mm.CloseFactor.IsNegative() || mm.CloseFactor.GT(sdk.OneDec())) {
+		return fmt.Errorf("close factor must be between 0.0-1.0")
+	}
+
+	if !isUnsetDec(mm.MaxLiquidationBonus) &&
+		(mm.MaxLiquidationBonus.LT(mm.KeeperRewardPercentage) || mm.MaxLiquidationBonus.GT(sdk.OneDec())) {
+		return fmt.Errorf("max liquidation bonus must be between the keeper reward percentage %s and 1.0: %s",
+			mm.KeeperRewardPercentage, mm.MaxLiquidationBonus)
+	}
+
+	if !mm.StableRatePremium.IsNil() && mm.StableRatePremium.IsNegative() {
+		return fmt.Errorf("stable rate premium cannot be negative: %s", mm.StableRatePremium)
+	}
+	if mm.StableRateEnabled() {
+		if mm.StableRebalanceUtilization.IsNil() {
+			return fmt.Errorf("stable rebalance utilization must be set when stable rate borrows are enabled")
+		}
+		if !mm.StableRebalanceUtilization.IsPositive() || mm.StableRebalanceUtilization.GT(sdk.OneDec()) {
+			return fmt.Errorf("stable rebalance utilization must be between 0.0-1.0: %s", mm.StableRebalanceUtilization)
+		}
+	} else if !isUnsetDec(mm.StableRebalanceUtilization) {
+		return fmt.Errorf("stable rebalance utilization %s cannot be set without a stable rate premium", mm.StableRebalanceUtilization)
+	}
+
+	if mm.ReceiptTokensEnabled {
+		if err := sdk.ValidateDenom(ReceiptDenom(mm.Denom)); err != nil {
+			return fmt.Errorf("invalid receipt token denom for %s: %w", mm.Denom, err)
+		}
+	}
+
 	return nil
 }
 
+// DutchAuctionEnabled returns true if liquidated deposits of this market are sold with dutch auctions
+func (mm MoneyMarket) DutchAuctionEnabled() bool {
+	return mm.AuctionType == AuctionTypeDutch
+}
+
+// FlashLoansEnabled returns true if coins of this market can be lent with flash loans
+func (mm MoneyMarket) FlashLoansEnabled() bool {
+	return !mm.FlashLoanFee.IsNil() && mm.FlashLoanFee.IsPositive()
+}
+
+// EModeEnabled returns true if the money market is in an efficiency mode category
+func (mm MoneyMarket) EModeEnabled() bool {
+	return mm.EModeCategory != ""
+}
+
+// LoanToValue returns the loan-to-value of the money market, which is the e-mode loan-to-value when eMode is true
+func (mm MoneyMarket) LoanToValue(eMode bool) sdk.Dec {
+	if eMode && mm.EModeEnabled() {
+		return mm.EModeLoanToValue
+	}
+	return mm.BorrowLimit.LoanToValue
+}
+
+// DirectLiquidationEnabled returns true if unhealthy borrows of this market can be repaid by direct liquidators
+func (mm MoneyMarket) DirectLiquidationEnabled() bool {
+	return !mm.CloseFactor.IsNil() && mm.CloseFactor.IsPositive()
+}
+
+// LiquidationBonus returns the bonus paid to direct liquidators of deposits of this market at a health factor. The bonus
+// grows from the keeper reward percentage by the amount the health factor is below one, up to the max liquidation bonus.
+func (mm MoneyMarket) LiquidationBonus(healthFactor sdk.Dec) sdk.Dec {
+	bonus := mm.KeeperRewardPercentage
+	if isUnsetDec(mm.MaxLiquidationBonus) || healthFactor.GTE(sdk.OneDec()) {
+		return bonus
+	}
+	return sdk.MinDec(bonus.Add(sdk.OneDec().Sub(healthFactor)), mm.MaxLiquidationBonus)
+}
+
+// StableRateEnabled returns true if coins of this market can be borrowed at stable rates
+func (mm MoneyMarket) StableRateEnabled() bool {
+	return !mm.StableRatePremium.IsNil() && mm.StableRatePremium.IsPositive()
+}
+
 // Equal returns a boolean indicating if a MoneyMarket is equal to another MoneyMarket
 func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if mm.Denom != mmCompareTo.Denom {
@@ -127,9 +306,53 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if mm.AuctionType != mmCompareTo.AuctionType {
+		return false
+	}
+	if !equalOptionalDec(mm.FlashLoanFee, mmCompareTo.FlashLoanFee) {
+		return false
+	}
+	if !mm.IsolationMode.Equal(mmCompareTo.IsolationMode) {
+		return false
+	}
+	if mm.EModeCategory != mmCompareTo.EModeCategory {
+		return false
+	}
+	if !equalOptionalDec(mm.EModeLoanToValue, mmCompareTo.EModeLoanToValue) {
+		return false
+	}
+	if !equalOptionalDec(mm.CloseFactor, mmCompareTo.CloseFactor) {
+		return false
+	}
+	if !equalOptionalDec(mm.MaxLiquidationBonus, mmCompareTo.MaxLiquidationBonus) {
+		return false
+	}
+	if !equalOptionalDec(mm.StableRatePremium, mmCompareTo.StableRatePremium) {
+		return false
+	}
+	if !equalOptionalDec(mm.StableRebalanceUtilization, mmCompareTo.StableRebalanceUtilization) {
+		return false
+	}
+	if mm.ReceiptTokensEnabled != mmCompareTo.ReceiptTokensEnabled {
+		return false
+	}
 	return true
 }
 
+// isUnsetDec returns true if an optional money market parameter is not set. Unset parameters are stored as zero once
+// they have been through a genesis or params round trip, so zero is treated as unset.
+func isUnsetDec(d sdk.Dec) bool {
+	return d.IsNil() || d.IsZero()
+}
+
+// equalOptionalDec compares two optional money market parameters, treating unset parameters as equal
+func equalOptionalDec(a, b sdk.Dec) bool {
+	if isUnsetDec(a) || isUnsetDec(b) {
+		return isUnsetDec(a) && isUnsetDec(b)
+	}
+	return a.Equal(b)
+}
+
 // MoneyMarkets slice of MoneyMarket
 type MoneyMarkets []MoneyMarket
 
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -57,6 +58,533 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			expectPass:  false,
 			expectedErr: "conversion '0' factor must be ≥ one",
 		},
+		{
+			name: "invalid: auction type",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						AuctionType:            "english",
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "invalid auction type english",
+		},
+		{
+			name: "invalid: flash loan fee",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						FlashLoanFee:           sdk.OneDec(),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "flash loan fee must be in the range 0.0-1.0, excluding 1.0",
+		},
+		{
+			name: "valid: isolation mode and e-mode",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						IsolationMode:          types.NewIsolationMode(true, []string{"usdx"}, sdk.NewDec(1000000)),
+						EModeCategory:          "btc",
+						EModeLoanToValue:       sdk.MustNewDecFromStr("0.9"),
+					},
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: isolation mode without borrowable denoms",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						IsolationMode:          types.NewIsolationMode(true, []string{}, sdk.NewDec(1000000)),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "isolation mode must have at least one borrowable denom",
+		},
+		{
+			name: "invalid: isolation mode duplicate borrowable denom",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						IsolationMode:          types.NewIsolationMode(true, []string{"usdx", "usdx"}, sdk.NewDec(1000000)),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate isolation mode borrowable denom usdx",
+		},
+		{
+			name: "invalid: isolation mode debt ceiling",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						IsolationMode:          types.NewIsolationMode(true, []string{"usdx"}, sdk.ZeroDec()),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "isolation mode debt ceiling must be positive",
+		},
+		{
+			name: "invalid: e-mode loan-to-value without category",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						EModeLoanToValue:       sdk.MustNewDecFromStr("0.9"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode loan-to-value cannot be set without an e-mode category",
+		},
+		{
+			name: "invalid: e-mode loan-to-value below loan-to-value",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						EModeCategory:          "btc",
+						EModeLoanToValue:       sdk.MustNewDecFromStr("0.4"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode loan-to-value must be between the loan-to-value 0.500000000000000000 and 1.0",
+		},
+		{
+			name: "valid: direct liquidation",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						CloseFactor:            sdk.MustNewDecFromStr("0.5"),
+						MaxLiquidationBonus:    sdk.MustNewDecFromStr("0.15"),
+					},
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: close factor",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						CloseFactor:            sdk.MustNewDecFromStr("1.5"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "close factor must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: max liquidation bonus below keeper reward",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						MaxLiquidationBonus:    sdk.MustNewDecFromStr("0.01"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "max liquidation bonus must be between the keeper reward percentage 0.050000000000000000 and 1.0",
+		},
+		{
+			name: "valid: zero max liquidation bonus is unset",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						MaxLiquidationBonus:    sdk.ZeroDec(),
+					},
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "valid: stable rate borrows",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:              sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage:     sdk.MustNewDecFromStr("0.05"),
+						StableRatePremium:          sdk.MustNewDecFromStr("0.02"),
+						StableRebalanceUtilization: sdk.MustNewDecFromStr("0.95"),
+					},
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: stable rate premium without rebalance utilization",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						StableRatePremium:      sdk.MustNewDecFromStr("0.02"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "stable rebalance utilization must be set when stable rate borrows are enabled",
+		},
+		{
+			name: "invalid: stable rebalance utilization",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:              sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage:     sdk.MustNewDecFromStr("0.05"),
+						StableRatePremium:          sdk.MustNewDecFromStr("0.02"),
+						StableRebalanceUtilization: sdk.MustNewDecFromStr("1.5"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "stable rebalance utilization must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: stable rebalance utilization without stable rate premium",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:              sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage:     sdk.MustNewDecFromStr("0.05"),
+						StableRebalanceUtilization: sdk.MustNewDecFromStr("0.95"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be set without a stable rate premium",
+		},
+		{
+			name: "invalid: receipt token denom too long",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: strings.Repeat("b", 125),
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						ReceiptTokensEnabled:   true,
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "invalid receipt token denom",
+		},
+		{
+			name: "invalid: receipt token money market",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: types.ReceiptDenom("btcb"),
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:     "btc:usd",
+						ConversionFactor: sdkmath.NewInt(100000000),
+						InterestRateModel: types.NewInterestRateModel(
+							sdk.MustNewDecFromStr("0.05"),
+							sdk.MustNewDecFromStr("2"),
+							sdk.MustNewDecFromStr("0.8"),
+							sdk.MustNewDecFromStr("10"),
+						),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "receipt tokens hard/btcb cannot be used as a money market",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
@@ -72,6 +600,70 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamTestSuite) TestStableRateEnabled() {
+	mm := types.MoneyMarket{Denom: "usdx"}
+	suite.False(mm.StableRateEnabled())
+
+	mm.StableRatePremium = sdk.ZeroDec()
+	suite.False(mm.StableRateEnabled())
+
+	mm.StableRatePremium = sdk.MustNewDecFromStr("0.02")
+	suite.True(mm.StableRateEnabled())
+}
+
+func (suite *ParamTestSuite) TestFlashLoansEnabled() {
+	mm := types.MoneyMarket{Denom: "usdx"}
+	suite.False(mm.FlashLoansEnabled())
+
+	mm.FlashLoanFee = sdk.ZeroDec()
+	suite.False(mm.FlashLoansEnabled())
+
+	mm.FlashLoanFee = sdk.MustNewDecFromStr("0.0009")
+	suite.True(mm.FlashLoansEnabled())
+}
+
+func (suite *ParamTestSuite) TestLoanToValue() {
+	mm := types.MoneyMarket{
+		Denom:       "bkava",
+		BorrowLimit: types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.6")),
+	}
+	suite.False(mm.EModeEnabled())
+	suite.Equal(sdk.MustNewDecFromStr("0.6"), mm.LoanToValue(true))
+
+	mm.EModeCategory = "kava"
+	mm.EModeLoanToValue = sdk.MustNewDecFromStr("0.9")
+	suite.True(mm.EModeEnabled())
+	suite.Equal(sdk.MustNewDecFromStr("0.6"), mm.LoanToValue(false))
+	suite.Equal(sdk.MustNewDecFromStr("0.9"), mm.LoanToValue(true))
+}
+
+func (suite *ParamTestSuite) TestLiquidationBonus() {
+	mm := types.MoneyMarket{
+		Denom:                  "bnb",
+		KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+	}
+	suite.False(mm.DirectLiquidationEnabled())
+	suite.Equal(sdk.MustNewDecFromStr("0.05"), mm.LiquidationBonus(sdk.MustNewDecFromStr("0.5")))
+
+	// an unset max liquidation bonus is stored as zero after a genesis round trip
+	mm.MaxLiquidationBonus = sdk.ZeroDec()
+	suite.Equal(sdk.MustNewDecFromStr("0.05"), mm.LiquidationBonus(sdk.MustNewDecFromStr("0.5")))
+
+	mm.CloseFactor = sdk.MustNewDecFromStr("0.5")
+	mm.MaxLiquidationBonus = sdk.MustNewDecFromStr("0.15")
+	suite.True(mm.DirectLiquidationEnabled())
+	suite.Equal(sdk.MustNewDecFromStr("0.05"), mm.LiquidationBonus(sdk.OneDec()))
+	suite.Equal(sdk.MustNewDecFromStr("0.07"), mm.LiquidationBonus(sdk.MustNewDecFromStr("0.98")))
+	suite.Equal(sdk.MustNewDecFromStr("0.15"), mm.LiquidationBonus(sdk.MustNewDecFromStr("0.8")))
+}
+
+func (suite *ParamTestSuite) TestIsolationModeIsBorrowable() {
+	im := types.NewIsolationMode(true, []string{"usdx", "ukava"}, sdk.NewDec(1000))
+	suite.True(im.IsBorrowable("usdx"))
+	suite.True(im.IsBorrowable("ukava"))
+	suite.False(im.IsBorrowable("bnb"))
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
@@ -792,6 +792,8 @@ func (m *QueryTotalBorrowedRequest) GetDenom() string {
 // QueryTotalBorrowedResponse is the response type for the Query/TotalBorrowed RPC method.
 type QueryTotalBorrowedResponse struct {
 	BorrowedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=borrowed_coins,json=borrowedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"borrowed_coins"`
+	// stable_borrowed_coins are the coins borrowed at stable rates, which are not included in borrowed_coins.
+	StableBorrowedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=stable_borrowed_coins,json=stableBorrowedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"stable_borrowed_coins"`
 }
 
 func (m *QueryTotalBorrowedResponse) Reset()         { *m = QueryTotalBorrowedResponse{} }
@@ -834,6 +836,13 @@ func (m *QueryTotalBorrowedResponse) GetBorrowedCoins() github_com_cosmos_cosmos
 	return nil
 }
 
+func (m *QueryTotalBorrowedResponse) GetStableBorrowedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StableBorrowedCoins
+	}
+	return nil
+}
+
 // QueryInterestRateRequest is the request type for the Query/InterestRate RPC method.
 type QueryInterestRateRequest struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -1221,9 +1230,11 @@ func (m *SupplyInterestFactorResponse) GetValue() string {
 
 // BorrowResponse defines an amount of coins borrowed from a hard module account.
 type BorrowResponse struct {
-	Borrower string                                   `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
-	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	Index    BorrowInterestFactorResponses            `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=BorrowInterestFactorResponses" json:"index"`
+	Borrower     string                                   `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Index        BorrowInterestFactorResponses            `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=BorrowInterestFactorResponses" json:"index"`
+	StableAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=stable_amount,json=stableAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"stable_amount"`
+	StableRates  StableBorrowRateResponses                `protobuf:"bytes,5,rep,name=stable_rates,json=stableRates,proto3,castrepeated=StableBorrowRateResponses" json:"stable_rates"`
 }
 
 func (m *BorrowResponse) Reset()         { *m = BorrowResponse{} }
@@ -1280,6 +1291,74 @@ func (m *BorrowResponse) GetIndex() BorrowInterestFactorResponses {
 	return nil
 }
 
+func (m *BorrowResponse) GetStableAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StableAmount
+	}
+	return nil
+}
+
+func (m *BorrowResponse) GetStableRates() StableBorrowRateResponses {
+	if m != nil {
+		return m.StableRates
+	}
+	return nil
+}
+
+// StableBorrowRateResponse defines the rate locked in by an individual stable rate borrow.
+type StableBorrowRateResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// estimated APY of the locked in rate, sdk.Dec as string
+	Rate string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (m *StableBorrowRateResponse) Reset()         { *m = StableBorrowRateResponse{} }
+func (m *StableBorrowRateResponse) String() string { return proto.CompactTextString(m) }
+func (*StableBorrowRateResponse) ProtoMessage()    {}
+func (*StableBorrowRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1eedf429c9bff7da, []int{25}
+}
+func (m *StableBorrowRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StableBorrowRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StableBorrowRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StableBorrowRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableBorrowRateResponse.Merge(m, src)
+}
+func (m *StableBorrowRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StableBorrowRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableBorrowRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableBorrowRateResponse proto.InternalMessageInfo
+
+func (m *StableBorrowRateResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *StableBorrowRateResponse) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
 // BorrowInterestFactorResponse defines an individual borrow interest factor.
 type BorrowInterestFactorResponse struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -1291,7 +1370,7 @@ func (m *BorrowInterestFactorResponse) Reset()         { *m = BorrowInterestFact
 func (m *BorrowInterestFactorResponse) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactorResponse) ProtoMessage()    {}
 func (*BorrowInterestFactorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{25}
+	return fileDescriptor_1eedf429c9bff7da, []int{26}
 }
 func (m *BorrowInterestFactorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1334,6 +1413,97 @@ func (m *BorrowInterestFactorResponse) GetValue() string {
 	return ""
 }
 
+// QueryHealthFactorRequest is the request type for the Query/HealthFactor RPC method.
+type QueryHealthFactorRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryHealthFactorRequest) Reset()         { *m = QueryHealthFactorRequest{} }
+func (m *QueryHealthFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthFactorRequest) ProtoMessage()    {}
+func (*QueryHealthFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1eedf429c9bff7da, []int{27}
+}
+func (m *QueryHealthFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthFactorRequest.Merge(m, src)
+}
+func (m *QueryHealthFactorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthFactorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthFactorRequest proto.InternalMessageInfo
+
+func (m *QueryHealthFactorRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryHealthFactorResponse is the response type for the Query/HealthFactor RPC method.
+type QueryHealthFactorResponse struct {
+	// sdk.Dec as String
+	HealthFactor string `protobuf:"bytes,1,opt,name=health_factor,json=healthFactor,proto3" json:"health_factor,omitempty"`
+}
+
+func (m *QueryHealthFactorResponse) Reset()         { *m = QueryHealthFactorResponse{} }
+func (m *QueryHealthFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthFactorResponse) ProtoMessage()    {}
+func (*QueryHealthFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1eedf429c9bff7da, []int{28}
+}
+func (m *QueryHealthFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthFactorResponse.Merge(m, src)
+}
+func (m *QueryHealthFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthFactorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthFactorResponse proto.InternalMessageInfo
+
+func (m *QueryHealthFactorResponse) GetHealthFactor() string {
+	if m != nil {
+		return m.HealthFactor
+	}
+	return ""
+}
+
 // MoneyMarketInterestRate is a unique type returned by interest rate queries
 type MoneyMarketInterestRate struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -1341,13 +1511,15 @@ type MoneyMarketInterestRate struct {
 	SupplyInterestRate string `protobuf:"bytes,2,opt,name=supply_interest_rate,json=supplyInterestRate,proto3" json:"supply_interest_rate,omitempty"`
 	// sdk.Dec as String
 	BorrowInterestRate string `protobuf:"bytes,3,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3" json:"borrow_interest_rate,omitempty"`
+	// sdk.Dec as String, empty when stable rate borrows are disabled
+	StableBorrowInterestRate string `protobuf:"bytes,4,opt,name=stable_borrow_interest_rate,json=stableBorrowInterestRate,proto3" json:"stable_borrow_interest_rate,omitempty"`
 }
 
 func (m *MoneyMarketInterestRate) Reset()         { *m = MoneyMarketInterestRate{} }
 func (m *MoneyMarketInterestRate) String() string { return proto.CompactTextString(m) }
 func (*MoneyMarketInterestRate) ProtoMessage()    {}
 func (*MoneyMarketInterestRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{26}
+	return fileDescriptor_1eedf429c9bff7da, []int{29}
 }
 func (m *MoneyMarketInterestRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,6 +1569,13 @@ func (m *MoneyMarketInterestRate) GetBorrowInterestRate() string {
 	return ""
 }
 
+func (m *MoneyMarketInterestRate) GetStableBorrowInterestRate() string {
+	if m != nil {
+		return m.StableBorrowInterestRate
+	}
+	return ""
+}
+
 // InterestFactor is a unique type returned by interest factor queries
 type InterestFactor struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -1410,7 +1589,7 @@ func (m *InterestFactor) Reset()         { *m = InterestFactor{} }
 func (m *InterestFactor) String() string { return proto.CompactTextString(m) }
 func (*InterestFactor) ProtoMessage()    {}
 func (*InterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{27}
+	return fileDescriptor_1eedf429c9bff7da, []int{30}
 }
 func (m *InterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1486,7 +1665,10 @@ func init() {
 	proto.RegisterType((*DepositResponse)(nil), "kava.hard.v1beta1.DepositResponse")
 	proto.RegisterType((*SupplyInterestFactorResponse)(nil), "kava.hard.v1beta1.SupplyInterestFactorResponse")
 	proto.RegisterType((*BorrowResponse)(nil), "kava.hard.v1beta1.BorrowResponse")
+	proto.RegisterType((*StableBorrowRateResponse)(nil), "kava.hard.v1beta1.StableBorrowRateResponse")
 	proto.RegisterType((*BorrowInterestFactorResponse)(nil), "kava.hard.v1beta1.BorrowInterestFactorResponse")
+	proto.RegisterType((*QueryHealthFactorRequest)(nil), "kava.hard.v1beta1.QueryHealthFactorRequest")
+	proto.RegisterType((*QueryHealthFactorResponse)(nil), "kava.hard.v1beta1.QueryHealthFactorResponse")
 	proto.RegisterType((*MoneyMarketInterestRate)(nil), "kava.hard.v1beta1.MoneyMarketInterestRate")
 	proto.RegisterType((*InterestFactor)(nil), "kava.hard.v1beta1.InterestFactor")
 }
@@ -1494,90 +1676,100 @@ func init() {
 func init() { proto.RegisterFile("kava/hard/v1beta1/query.proto", fileDescriptor_1eedf429c9bff7da) }
 
 var fileDescriptor_1eedf429c9bff7da = []byte{
-	// 1318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0x24, 0x4d, 0x5f, 0x9b, 0x0f, 0x06, 0xd3, 0xda, 0x4e, 0xe2, 0x26, 0x9b, 0xe6,
-	0x83, 0x34, 0xf6, 0x26, 0x69, 0x05, 0x57, 0x6a, 0xaa, 0x22, 0x90, 0x82, 0x60, 0x5b, 0x24, 0x84,
-	0x84, 0xa2, 0xb5, 0x77, 0x70, 0x56, 0x71, 0x76, 0xdc, 0x9d, 0x75, 0xda, 0x20, 0xc4, 0xa1, 0x12,
-	0xf7, 0x42, 0x0e, 0x08, 0x81, 0xc4, 0xa1, 0x9c, 0x80, 0x23, 0x5c, 0x90, 0xb8, 0x70, 0xaa, 0x38,
-	0x55, 0x70, 0xe1, 0x04, 0x28, 0xe1, 0x0f, 0x41, 0x3b, 0xf3, 0x66, 0xed, 0x5d, 0xef, 0xae, 0x83,
-	0x44, 0x51, 0x7a, 0x4a, 0x66, 0xe6, 0x7d, 0xfc, 0xde, 0x6f, 0xde, 0xbc, 0x7d, 0xcf, 0x30, 0xbb,
-	0x6b, 0xed, 0x5b, 0xc6, 0x8e, 0xe5, 0xd9, 0xc6, 0xfe, 0x46, 0x9d, 0xfa, 0xd6, 0x86, 0x71, 0xa7,
-	0x43, 0xbd, 0x83, 0x6a, 0xdb, 0x63, 0x3e, 0x23, 0xcf, 0x06, 0xc7, 0xd5, 0xe0, 0xb8, 0x8a, 0xc7,
-	0xa5, 0x72, 0x83, 0xf1, 0x3d, 0xc6, 0x0d, 0xab, 0xe3, 0xef, 0x84, 0x3a, 0xc1, 0x42, 0xaa, 0x94,
-	0x56, 0xf1, 0xbc, 0x6e, 0x71, 0x2a, 0x6d, 0x85, 0x52, 0x6d, 0xab, 0xe9, 0xb8, 0x96, 0xef, 0x30,
-	0x17, 0x65, 0xcb, 0xbd, 0xb2, 0x4a, 0xaa, 0xc1, 0x1c, 0x75, 0x5e, 0x94, 0xe7, 0xdb, 0x62, 0x65,
-	0xc8, 0x05, 0x1e, 0xe5, 0x9b, 0xac, 0xc9, 0xe4, 0x7e, 0xf0, 0x1f, 0xee, 0xce, 0x34, 0x19, 0x6b,
-	0xb6, 0xa8, 0x61, 0xb5, 0x1d, 0xc3, 0x72, 0x5d, 0xe6, 0x0b, 0x6f, 0x4a, 0x67, 0xa6, 0x3f, 0x58,
-	0x11, 0x9a, 0x38, 0xd5, 0xf3, 0x40, 0xde, 0x0a, 0xe0, 0xbe, 0x69, 0x79, 0xd6, 0x1e, 0x37, 0xe9,
-	0x9d, 0x0e, 0xe5, 0xbe, 0xfe, 0x06, 0x3c, 0x17, 0xd9, 0xe5, 0x6d, 0xe6, 0x72, 0x4a, 0x5e, 0x82,
-	0xd1, 0xb6, 0xd8, 0x29, 0x68, 0x73, 0xda, 0xca, 0xb9, 0xcd, 0x62, 0xb5, 0x8f, 0xa9, 0xaa, 0x54,
-	0xa9, 0x3d, 0xf3, 0xe8, 0x8f, 0x4b, 0x43, 0x26, 0x8a, 0xeb, 0x17, 0x20, 0x2f, 0xec, 0x5d, 0x6f,
-	0x34, 0x58, 0xc7, 0xf5, 0x43, 0x3f, 0xef, 0xc1, 0xf3, 0xb1, 0x7d, 0xf4, 0x74, 0x03, 0xc6, 0x2c,
-	0xdc, 0x2b, 0x68, 0x73, 0xc3, 0x2b, 0xe7, 0x36, 0xf5, 0x2a, 0x32, 0x21, 0x58, 0x57, 0xde, 0xb6,
-	0x98, 0xdd, 0x69, 0x51, 0x54, 0x47, 0xa7, 0xa1, 0xa6, 0xfe, 0xb5, 0x86, 0x7e, 0x6f, 0xd0, 0x36,
-	0xe3, 0x4e, 0xe8, 0x97, 0xe4, 0x61, 0xc4, 0xa6, 0x2e, 0xdb, 0x13, 0x71, 0x9c, 0x35, 0xe5, 0x82,
-	0x54, 0x61, 0x84, 0xdd, 0x75, 0xa9, 0x57, 0xc8, 0x05, 0xbb, 0xb5, 0xc2, 0xaf, 0xdf, 0x57, 0xf2,
-	0xe8, 0xf4, 0xba, 0x6d, 0x7b, 0x94, 0xf3, 0x5b, 0xbe, 0xe7, 0xb8, 0x4d, 0x53, 0x8a, 0x91, 0x9b,
-	0x00, 0xdd, 0xcb, 0x2d, 0x0c, 0x0b, 0x4a, 0x96, 0x14, 0xcc, 0xe0, 0x76, 0xab, 0x32, 0xab, 0xba,
-	0xd4, 0x34, 0x29, 0x22, 0x30, 0x7b, 0x34, 0xf5, 0x1f, 0x35, 0xa4, 0xa1, 0x0b, 0x13, 0x69, 0x78,
-	0x07, 0xc6, 0x6c, 0xdc, 0x0b, 0x69, 0xe8, 0xa7, 0x1c, 0xd5, 0x94, 0x56, 0xad, 0x10, 0xd0, 0xf0,
-	0xcd, 0x9f, 0x97, 0xa6, 0x62, 0x07, 0xdc, 0x0c, 0xad, 0x91, 0x57, 0x23, 0xd8, 0x73, 0x02, 0xfb,
-	0xf2, 0x40, 0xec, 0xd2, 0x4e, 0x04, 0xfc, 0x77, 0x1a, 0xcc, 0x08, 0xf0, 0x6f, 0xbb, 0xfc, 0xc0,
-	0x6d, 0x50, 0xfb, 0x74, 0x73, 0xfd, 0xb3, 0x06, 0xb3, 0x29, 0x70, 0x9f, 0x1e, 0xce, 0x37, 0xa1,
-	0x24, 0x62, 0xb8, 0xcd, 0x7c, 0xab, 0x85, 0x0e, 0xa9, 0x9d, 0x49, 0xb8, 0xfe, 0x89, 0x06, 0xd3,
-	0x89, 0x4a, 0x18, 0xb6, 0x07, 0x13, 0xbc, 0xd3, 0x6e, 0xb7, 0x1c, 0x6a, 0x6f, 0x07, 0xc5, 0x88,
-	0x17, 0x72, 0x22, 0xf8, 0x62, 0x04, 0xa0, 0x82, 0xf6, 0x0a, 0x73, 0xdc, 0xda, 0x3a, 0xc6, 0xbc,
-	0xd2, 0x74, 0xfc, 0x9d, 0x4e, 0xbd, 0xda, 0x60, 0x7b, 0x58, 0xae, 0xf0, 0x4f, 0x85, 0xdb, 0xbb,
-	0x86, 0x7f, 0xd0, 0xa6, 0x5c, 0x28, 0x70, 0x73, 0x5c, 0xb9, 0x10, 0x4b, 0xfd, 0xa1, 0x86, 0x75,
-	0xa6, 0xc6, 0x3c, 0x8f, 0xdd, 0x3d, 0xa5, 0x29, 0xf3, 0x83, 0xaa, 0x22, 0x21, 0x4a, 0xa4, 0xec,
-	0x36, 0x9c, 0xa9, 0xcb, 0x2d, 0x4c, 0x94, 0xf9, 0x84, 0x44, 0x91, 0x4a, 0x61, 0x9e, 0x5c, 0x44,
-	0xce, 0x26, 0xa3, 0xfb, 0xdc, 0x54, 0xa6, 0xfe, 0xbb, 0x2c, 0xf9, 0x56, 0xdd, 0xb8, 0x4a, 0xf5,
-	0x53, 0xcd, 0xf2, 0x4f, 0xf1, 0x3a, 0xf2, 0x94, 0xb1, 0xbd, 0x01, 0xc5, 0xee, 0xf3, 0x92, 0xee,
-	0x06, 0x3d, 0xc9, 0x07, 0x5a, 0xef, 0x3b, 0xee, 0xea, 0x74, 0x5f, 0x64, 0x1d, 0xf7, 0x9e, 0xe0,
-	0x8b, 0x54, 0x2e, 0xe4, 0x8b, 0x5c, 0x87, 0x82, 0x40, 0xf4, 0x9a, 0xeb, 0x53, 0x2f, 0xb8, 0x22,
-	0xcb, 0xa7, 0x03, 0x83, 0x28, 0x26, 0xa8, 0x60, 0x0c, 0x1c, 0x26, 0x1c, 0xdc, 0xdf, 0xf6, 0x2c,
-	0x9f, 0xaa, 0xbb, 0x5b, 0x4d, 0xb8, 0xbb, 0x2d, 0xe6, 0xd2, 0x83, 0x2d, 0xcb, 0xdb, 0xa5, 0x7e,
-	0xaf, 0xad, 0xda, 0x1c, 0x06, 0x55, 0x48, 0x11, 0xe0, 0xe6, 0xb8, 0xd3, 0xbb, 0xd4, 0xd7, 0xf0,
-	0xbd, 0x9a, 0x94, 0x53, 0x6f, 0x9f, 0x66, 0x27, 0xbc, 0xfe, 0x21, 0x7e, 0x7c, 0xbb, 0xd2, 0x88,
-	0xbd, 0x01, 0xa3, 0xd6, 0x5e, 0xd0, 0x48, 0x3c, 0x09, 0xde, 0xd1, 0xb4, 0x7e, 0x15, 0xdf, 0xa8,
-	0x0a, 0xe8, 0xa6, 0xd5, 0xf0, 0x99, 0x37, 0x00, 0xf2, 0xc7, 0xea, 0xad, 0xf4, 0x69, 0x21, 0x74,
-	0x0a, 0x53, 0x21, 0xed, 0xef, 0xcb, 0xb3, 0x8c, 0x47, 0x13, 0xb5, 0xd2, 0x7d, 0x34, 0x71, 0xeb,
-	0x93, 0x4e, 0x74, 0x43, 0xff, 0x32, 0x07, 0x93, 0xb1, 0xef, 0x1d, 0x79, 0x11, 0xce, 0xe2, 0x07,
-	0x8f, 0x79, 0x12, 0x75, 0x46, 0x0d, 0xe9, 0x8a, 0xfe, 0x2f, 0x6c, 0x93, 0x16, 0x8c, 0x38, 0xae,
-	0x4d, 0xef, 0x15, 0x86, 0x85, 0x0f, 0x23, 0x81, 0x8c, 0x5b, 0xc1, 0x17, 0x2a, 0x46, 0x6c, 0x58,
-	0x4f, 0x16, 0xd1, 0xf3, 0x6c, 0x96, 0x14, 0x37, 0xa5, 0x13, 0xfd, 0x75, 0x98, 0xc9, 0x92, 0x4b,
-	0x29, 0xc0, 0x79, 0x18, 0xd9, 0xb7, 0x5a, 0x1d, 0x2a, 0x0b, 0xb0, 0x29, 0x17, 0xfa, 0xe7, 0x39,
-	0x98, 0x88, 0x16, 0x31, 0x72, 0x0d, 0xc6, 0xf0, 0xf1, 0x0e, 0x26, 0x3a, 0x94, 0x3c, 0x35, 0x3c,
-	0xcb, 0x60, 0x06, 0xf1, 0x9c, 0x25, 0xd5, 0xcb, 0x73, 0x96, 0xdc, 0xbf, 0xe2, 0xf9, 0x50, 0x83,
-	0x8b, 0x29, 0x75, 0x26, 0xc5, 0xce, 0x3a, 0xe4, 0x45, 0x57, 0x73, 0xb0, 0x1d, 0xa9, 0x74, 0x68,
-	0x96, 0xf0, 0x48, 0x06, 0x08, 0x3b, 0xeb, 0x90, 0x97, 0xd7, 0x11, 0xd3, 0x18, 0x96, 0x1a, 0xf5,
-	0x48, 0x2c, 0x81, 0x86, 0xfe, 0xa9, 0x06, 0x13, 0xd1, 0xe0, 0x52, 0xc0, 0x5c, 0x83, 0x0b, 0x71,
-	0xd3, 0xf2, 0xfd, 0x23, 0x9c, 0x7c, 0x3d, 0x81, 0xa8, 0x40, 0x2b, 0x1e, 0x02, 0x6a, 0x49, 0x48,
-	0x79, 0x9e, 0x90, 0xc6, 0x9b, 0xbf, 0x9c, 0x87, 0x11, 0x51, 0x85, 0xc8, 0x07, 0x30, 0x2a, 0xc7,
-	0x3e, 0xb2, 0x98, 0x70, 0xd3, 0xfd, 0xf3, 0x65, 0x69, 0x69, 0x90, 0x98, 0xbc, 0x39, 0x7d, 0xfe,
-	0xfe, 0x6f, 0x7f, 0x1f, 0xe6, 0xa6, 0x49, 0xd1, 0xe8, 0x1f, 0x62, 0xe5, 0x68, 0x49, 0xee, 0x6b,
-	0x30, 0xa6, 0xc6, 0x47, 0xb2, 0x9c, 0x66, 0x37, 0x36, 0x78, 0x96, 0x56, 0x06, 0x0b, 0x22, 0x84,
-	0x05, 0x01, 0x61, 0x96, 0x4c, 0x27, 0x40, 0x50, 0x83, 0xa6, 0x00, 0xa1, 0x06, 0x89, 0x74, 0x10,
-	0xb1, 0xc9, 0x28, 0x1d, 0x44, 0x7c, 0x26, 0xc9, 0x04, 0x11, 0x8e, 0x17, 0x0f, 0x35, 0x98, 0x8a,
-	0x4f, 0x35, 0xc4, 0x48, 0xf3, 0x91, 0x32, 0xae, 0x95, 0xd6, 0x4f, 0xae, 0x80, 0xe0, 0xd6, 0x04,
-	0xb8, 0x25, 0x72, 0x39, 0x01, 0x5c, 0x07, 0x95, 0x2a, 0x21, 0xca, 0x2f, 0x34, 0x98, 0x88, 0x8e,
-	0x20, 0xa4, 0x92, 0xe6, 0x32, 0x71, 0xbe, 0x29, 0x55, 0x4f, 0x2a, 0x8e, 0xf8, 0x56, 0x05, 0xbe,
-	0xcb, 0x44, 0x4f, 0xc0, 0xe7, 0x07, 0x2a, 0x0a, 0x1c, 0xb5, 0xc9, 0x47, 0x70, 0x06, 0xfb, 0x4e,
-	0x92, 0x9a, 0xa3, 0xd1, 0x36, 0xba, 0xb4, 0x3c, 0x50, 0x0e, 0x71, 0xe8, 0x02, 0xc7, 0x0c, 0x29,
-	0x25, 0xe0, 0x50, 0xed, 0xe8, 0x57, 0x1a, 0x4c, 0xc6, 0x1a, 0x60, 0x52, 0x1d, 0x74, 0x23, 0x31,
-	0x40, 0xc6, 0x89, 0xe5, 0x11, 0xd8, 0x15, 0x01, 0x6c, 0x91, 0x2c, 0x64, 0x5d, 0xa0, 0x42, 0xf8,
-	0x99, 0x06, 0xe3, 0x91, 0x7e, 0x95, 0xac, 0x65, 0xde, 0x47, 0xac, 0x15, 0x2e, 0x55, 0x4e, 0x28,
-	0x8d, 0xd8, 0x5e, 0x10, 0xd8, 0x16, 0xc8, 0x7c, 0xea, 0xe5, 0xa9, 0x06, 0x96, 0x1c, 0x6a, 0x70,
-	0x3e, 0x52, 0x67, 0xaf, 0xa4, 0xb9, 0x4a, 0xe8, 0x6e, 0x4b, 0x6b, 0x27, 0x13, 0x46, 0x58, 0x2b,
-	0x02, 0x96, 0x4e, 0xe6, 0x12, 0x60, 0xa9, 0x1a, 0x5a, 0x09, 0x8a, 0xba, 0x28, 0x0d, 0xaa, 0xb5,
-	0x4c, 0x2f, 0x0d, 0xb1, 0x56, 0x35, 0xbd, 0x34, 0xc4, 0xbb, 0xd4, 0xcc, 0xd2, 0xe0, 0x29, 0xbf,
-	0x41, 0x5a, 0xc5, 0xba, 0xb9, 0xf4, 0xb4, 0x4a, 0x6e, 0x45, 0xd3, 0xd3, 0x2a, 0xa5, 0x09, 0xcd,
-	0x4c, 0xab, 0x90, 0x23, 0xec, 0x4e, 0x6b, 0x2f, 0x3f, 0x3a, 0x2a, 0x6b, 0x8f, 0x8f, 0xca, 0xda,
-	0x5f, 0x47, 0x65, 0xed, 0xc1, 0x71, 0x79, 0xe8, 0xf1, 0x71, 0x79, 0xe8, 0xf7, 0xe3, 0xf2, 0xd0,
-	0xbb, 0x4b, 0x3d, 0xdd, 0x47, 0x60, 0xa8, 0xd2, 0xb2, 0xea, 0x5c, 0x9a, 0xbc, 0x27, 0x8d, 0x8a,
-	0x0e, 0xa4, 0x3e, 0x2a, 0x7e, 0xd0, 0xbc, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0x6b,
-	0x16, 0xfb, 0xdd, 0x15, 0x00, 0x00,
+	// 1477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xe6, 0x57, 0xd3, 0xd7, 0x26, 0xe9, 0x77, 0xea, 0xb4, 0xf6, 0x26, 0x71, 0x93, 0x4d,
+	0x9b, 0xfa, 0xdb, 0xc6, 0xde, 0x34, 0xad, 0xe0, 0x84, 0xd4, 0x9a, 0xaa, 0xfc, 0x90, 0x8a, 0x60,
+	0x5b, 0x24, 0x84, 0x84, 0xa2, 0xb5, 0x3d, 0x38, 0xab, 0x3a, 0x3b, 0xee, 0xce, 0x3a, 0x6d, 0x10,
+	0x02, 0xa9, 0x12, 0xf7, 0x42, 0x0f, 0x1c, 0x40, 0xe2, 0x50, 0x4e, 0xc0, 0x11, 0x2e, 0x48, 0x5c,
+	0x38, 0xf5, 0x58, 0x95, 0x0b, 0x27, 0xa8, 0x5a, 0xfe, 0x0e, 0x84, 0x76, 0xe6, 0xcd, 0x66, 0x77,
+	0xbd, 0xeb, 0x35, 0x52, 0x8b, 0xd2, 0x93, 0x3d, 0x33, 0xef, 0xc7, 0xe7, 0x7d, 0xe6, 0xcd, 0x9b,
+	0x7d, 0x03, 0x8b, 0x37, 0xec, 0x1d, 0xdb, 0xdc, 0xb2, 0xbd, 0x96, 0xb9, 0x73, 0xae, 0x41, 0x7d,
+	0xfb, 0x9c, 0x79, 0xb3, 0x47, 0xbd, 0xdd, 0x5a, 0xd7, 0x63, 0x3e, 0x23, 0xff, 0x0b, 0x96, 0x6b,
+	0xc1, 0x72, 0x0d, 0x97, 0xf5, 0x72, 0x93, 0xf1, 0x6d, 0xc6, 0x4d, 0xbb, 0xe7, 0x6f, 0x85, 0x3a,
+	0xc1, 0x40, 0xaa, 0xe8, 0x67, 0x70, 0xbd, 0x61, 0x73, 0x2a, 0x6d, 0x85, 0x52, 0x5d, 0xbb, 0xed,
+	0xb8, 0xb6, 0xef, 0x30, 0x17, 0x65, 0xcb, 0x51, 0x59, 0x25, 0xd5, 0x64, 0x8e, 0x5a, 0x2f, 0xc9,
+	0xf5, 0x4d, 0x31, 0x32, 0xe5, 0x00, 0x97, 0x0a, 0x6d, 0xd6, 0x66, 0x72, 0x3e, 0xf8, 0x87, 0xb3,
+	0x0b, 0x6d, 0xc6, 0xda, 0x1d, 0x6a, 0xda, 0x5d, 0xc7, 0xb4, 0x5d, 0x97, 0xf9, 0xc2, 0x9b, 0xd2,
+	0x59, 0xe8, 0x0f, 0x56, 0x84, 0x26, 0x56, 0x8d, 0x02, 0x90, 0x77, 0x02, 0xb8, 0x6f, 0xdb, 0x9e,
+	0xbd, 0xcd, 0x2d, 0x7a, 0xb3, 0x47, 0xb9, 0x6f, 0xbc, 0x05, 0x47, 0x63, 0xb3, 0xbc, 0xcb, 0x5c,
+	0x4e, 0xc9, 0xcb, 0x30, 0xd9, 0x15, 0x33, 0x45, 0x6d, 0x49, 0xab, 0x1c, 0xda, 0x28, 0xd5, 0xfa,
+	0x98, 0xaa, 0x49, 0x95, 0xfa, 0xf8, 0x83, 0x3f, 0x4e, 0x8c, 0x58, 0x28, 0x6e, 0x1c, 0x83, 0x82,
+	0xb0, 0x77, 0xa9, 0xd9, 0x64, 0x3d, 0xd7, 0x0f, 0xfd, 0x7c, 0x00, 0x73, 0x89, 0x79, 0xf4, 0x74,
+	0x19, 0xa6, 0x6c, 0x9c, 0x2b, 0x6a, 0x4b, 0x63, 0x95, 0x43, 0x1b, 0x46, 0x0d, 0x99, 0x10, 0xac,
+	0x2b, 0x6f, 0x57, 0x59, 0xab, 0xd7, 0xa1, 0xa8, 0x8e, 0x4e, 0x43, 0x4d, 0xe3, 0x5b, 0x0d, 0xfd,
+	0x5e, 0xa6, 0x5d, 0xc6, 0x9d, 0xd0, 0x2f, 0x29, 0xc0, 0x44, 0x8b, 0xba, 0x6c, 0x5b, 0xc4, 0x71,
+	0xd0, 0x92, 0x03, 0x52, 0x83, 0x09, 0x76, 0xcb, 0xa5, 0x5e, 0x71, 0x34, 0x98, 0xad, 0x17, 0x1f,
+	0xfd, 0x58, 0x2d, 0xa0, 0xd3, 0x4b, 0xad, 0x96, 0x47, 0x39, 0xbf, 0xe6, 0x7b, 0x8e, 0xdb, 0xb6,
+	0xa4, 0x18, 0xb9, 0x02, 0xb0, 0xb7, 0xb9, 0xc5, 0x31, 0x41, 0xc9, 0xaa, 0x82, 0x19, 0xec, 0x6e,
+	0x4d, 0x66, 0xd5, 0x1e, 0x35, 0x6d, 0x8a, 0x08, 0xac, 0x88, 0xa6, 0xf1, 0xb3, 0x06, 0x73, 0x09,
+	0x98, 0x48, 0xc3, 0x7b, 0x30, 0xd5, 0xc2, 0xb9, 0x90, 0x86, 0x7e, 0xca, 0x51, 0x4d, 0x69, 0xd5,
+	0x8b, 0x01, 0x0d, 0xdf, 0xfd, 0x79, 0xe2, 0x48, 0x62, 0x81, 0x5b, 0xa1, 0x35, 0xf2, 0x5a, 0x0c,
+	0xfb, 0xa8, 0xc0, 0x7e, 0x3a, 0x17, 0xbb, 0xb4, 0x13, 0x03, 0xff, 0x83, 0x06, 0x0b, 0x02, 0xfc,
+	0xbb, 0x2e, 0xdf, 0x75, 0x9b, 0xb4, 0xb5, 0xbf, 0xb9, 0xfe, 0x55, 0x83, 0xc5, 0x0c, 0xb8, 0x2f,
+	0x0e, 0xe7, 0x1b, 0xa0, 0x8b, 0x18, 0xae, 0x33, 0xdf, 0xee, 0xa0, 0x43, 0xda, 0x1a, 0x48, 0xb8,
+	0xf1, 0xb9, 0x06, 0xf3, 0xa9, 0x4a, 0x18, 0xb6, 0x07, 0x33, 0xbc, 0xd7, 0xed, 0x76, 0x1c, 0xda,
+	0xda, 0x0c, 0x8a, 0x11, 0x2f, 0x8e, 0x8a, 0xe0, 0x4b, 0x31, 0x80, 0x0a, 0xda, 0xab, 0xcc, 0x71,
+	0xeb, 0xeb, 0x18, 0x73, 0xa5, 0xed, 0xf8, 0x5b, 0xbd, 0x46, 0xad, 0xc9, 0xb6, 0xb1, 0x5c, 0xe1,
+	0x4f, 0x95, 0xb7, 0x6e, 0x98, 0xfe, 0x6e, 0x97, 0x72, 0xa1, 0xc0, 0xad, 0x69, 0xe5, 0x42, 0x0c,
+	0x8d, 0xfb, 0x1a, 0xd6, 0x99, 0x3a, 0xf3, 0x3c, 0x76, 0x6b, 0x9f, 0xa6, 0xcc, 0x4f, 0xaa, 0x8a,
+	0x84, 0x28, 0x91, 0xb2, 0xeb, 0x70, 0xa0, 0x21, 0xa7, 0x30, 0x51, 0x96, 0x53, 0x12, 0x45, 0x2a,
+	0x85, 0x79, 0x72, 0x1c, 0x39, 0x9b, 0x8d, 0xcf, 0x73, 0x4b, 0x99, 0x7a, 0x76, 0x59, 0xf2, 0xbd,
+	0xda, 0x71, 0x95, 0xea, 0xfb, 0x9a, 0xe5, 0x5f, 0x92, 0x75, 0xe4, 0x05, 0x63, 0xfb, 0x1c, 0x94,
+	0xf6, 0x8e, 0x97, 0x74, 0x97, 0x7b, 0x24, 0x47, 0x41, 0x4f, 0xd3, 0xd9, 0x3b, 0x91, 0x0d, 0x9c,
+	0x7b, 0x8e, 0x27, 0x52, 0xb9, 0x10, 0x43, 0xf2, 0x29, 0xcc, 0x71, 0xdf, 0x6e, 0x74, 0xe8, 0x66,
+	0xc2, 0xf5, 0xd8, 0xb3, 0x77, 0x7d, 0x54, 0x7a, 0xaa, 0x47, 0x01, 0x18, 0xeb, 0x50, 0x14, 0x94,
+	0xbc, 0xe1, 0xfa, 0xd4, 0x0b, 0x72, 0xc4, 0xf6, 0xe9, 0x60, 0x16, 0xef, 0x6a, 0x50, 0x4a, 0x51,
+	0x41, 0x12, 0x39, 0xcc, 0x38, 0x38, 0xbf, 0xe9, 0xd9, 0x3e, 0x55, 0xc9, 0x73, 0x26, 0x25, 0x79,
+	0xae, 0x32, 0x97, 0xee, 0x5e, 0xb5, 0xbd, 0x1b, 0xd4, 0x8f, 0xda, 0xaa, 0x2f, 0x61, 0x68, 0xc5,
+	0x0c, 0x01, 0x6e, 0x4d, 0x3b, 0xd1, 0xa1, 0xb1, 0x86, 0x05, 0xc3, 0xa2, 0x9c, 0x7a, 0x3b, 0x74,
+	0xf0, 0x89, 0x33, 0x3e, 0x86, 0xb9, 0x84, 0x34, 0x62, 0x6f, 0xc2, 0xa4, 0xbd, 0x1d, 0x7c, 0xc9,
+	0x3c, 0x8f, 0x8d, 0x47, 0xd3, 0xc6, 0x79, 0x2c, 0x12, 0x2a, 0xa0, 0x2b, 0x76, 0xd3, 0x67, 0x5e,
+	0x0e, 0xe4, 0xcf, 0xd4, 0x61, 0xed, 0xd3, 0x42, 0xe8, 0x14, 0x8e, 0x84, 0xb4, 0x7f, 0x28, 0xd7,
+	0x06, 0x9c, 0xda, 0xb8, 0x95, 0xbd, 0x53, 0x9b, 0xb4, 0x3e, 0xeb, 0xc4, 0x27, 0x8c, 0xaf, 0x47,
+	0x61, 0x36, 0x71, 0xe1, 0x92, 0x97, 0xe0, 0x20, 0xde, 0xb8, 0xcc, 0x2b, 0x6a, 0x39, 0x45, 0x6c,
+	0x4f, 0xf4, 0x3f, 0x61, 0x9b, 0x74, 0x60, 0xc2, 0x71, 0x5b, 0xf4, 0x36, 0x9e, 0x27, 0x33, 0x85,
+	0x8c, 0x6b, 0xc1, 0x15, 0x99, 0x20, 0x36, 0x2c, 0x68, 0xa7, 0xd0, 0xf3, 0xe2, 0x20, 0x29, 0x6e,
+	0x49, 0x27, 0xc6, 0x9b, 0xb0, 0x30, 0x48, 0x2e, 0xe3, 0x06, 0x28, 0xc0, 0xc4, 0x8e, 0xdd, 0xe9,
+	0x51, 0x79, 0x03, 0x58, 0x72, 0x60, 0xfc, 0x3d, 0x06, 0x33, 0xf1, 0x2a, 0x4a, 0x2e, 0xc0, 0x14,
+	0x56, 0x89, 0x7c, 0xa2, 0x43, 0xc9, 0x7d, 0xc3, 0xb3, 0x0c, 0x26, 0x8f, 0xe7, 0x41, 0x52, 0x8a,
+	0x67, 0xd2, 0x85, 0x69, 0xac, 0x9a, 0x18, 0xd9, 0xf8, 0xb3, 0x8f, 0xec, 0xb0, 0xf4, 0x70, 0x49,
+	0xc6, 0x77, 0x13, 0x70, 0x8c, 0x45, 0x6d, 0x42, 0x38, 0x3c, 0x9b, 0x96, 0x4e, 0x91, 0x22, 0x1b,
+	0xad, 0x8c, 0xf5, 0x65, 0x84, 0x50, 0xca, 0x92, 0xe0, 0xd6, 0x21, 0xe9, 0x43, 0x16, 0xb5, 0xcb,
+	0x50, 0xcc, 0x92, 0xcc, 0x48, 0x24, 0x02, 0xe3, 0x01, 0x3a, 0xcc, 0x23, 0xf1, 0x3f, 0x48, 0xc9,
+	0x41, 0x94, 0xfe, 0xab, 0x94, 0x54, 0x77, 0xc5, 0xeb, 0xd4, 0xee, 0xf8, 0x5b, 0xca, 0x50, 0x58,
+	0xb7, 0xe4, 0x67, 0x0c, 0xda, 0x11, 0x03, 0xe3, 0x22, 0x94, 0x52, 0x34, 0xd0, 0xf5, 0x0a, 0x4c,
+	0x6f, 0x89, 0x79, 0xac, 0x58, 0xa8, 0x7a, 0x78, 0x2b, 0x22, 0x6c, 0x3c, 0xd2, 0xe0, 0x78, 0xc6,
+	0x35, 0x90, 0x81, 0x7d, 0x1d, 0x0a, 0xe2, 0xab, 0x77, 0x77, 0x33, 0x76, 0x11, 0x61, 0x28, 0x84,
+	0xc7, 0x0e, 0xa8, 0xb0, 0xb3, 0x0e, 0x05, 0x79, 0x5a, 0x12, 0x1a, 0x63, 0x52, 0xa3, 0x11, 0xe3,
+	0x4f, 0x68, 0xbc, 0x02, 0xf3, 0xb1, 0x6b, 0x3b, 0xa1, 0x38, 0x2e, 0x14, 0x8b, 0xd1, 0xfb, 0x36,
+	0xaa, 0x6e, 0x7c, 0xa1, 0xc1, 0x4c, 0x7c, 0x3f, 0x32, 0x62, 0xb9, 0x00, 0xc7, 0x92, 0x0e, 0x90,
+	0x2b, 0x19, 0x4d, 0xa1, 0x91, 0xb2, 0xb7, 0x81, 0x56, 0x92, 0x01, 0xd4, 0x92, 0x11, 0x15, 0x78,
+	0x4a, 0x91, 0xda, 0x78, 0x3c, 0x0d, 0x13, 0x62, 0xb3, 0xc8, 0x47, 0x30, 0x29, 0x5f, 0x15, 0xc8,
+	0xa9, 0x94, 0x04, 0xef, 0x7f, 0xbe, 0xd0, 0x57, 0xf3, 0xc4, 0xe4, 0x8e, 0x1b, 0xcb, 0x77, 0x7e,
+	0xfb, 0xeb, 0xde, 0xe8, 0x3c, 0x29, 0x99, 0xfd, 0x6f, 0x24, 0xf2, 0xe5, 0x82, 0xdc, 0xd1, 0x60,
+	0x4a, 0xbd, 0x4e, 0x90, 0xd3, 0x59, 0x76, 0x13, 0xef, 0x1a, 0x7a, 0x25, 0x5f, 0x10, 0x21, 0xac,
+	0x08, 0x08, 0x8b, 0x64, 0x3e, 0x05, 0x82, 0x7a, 0xc7, 0x10, 0x20, 0x54, 0x9f, 0x9a, 0x0d, 0x22,
+	0xd1, 0x78, 0xeb, 0x95, 0x7c, 0xc1, 0x21, 0x40, 0x84, 0xdd, 0xeb, 0x7d, 0x0d, 0x8e, 0x24, 0x9b,
+	0x66, 0x62, 0x66, 0xf9, 0xc8, 0x78, 0x0d, 0xd0, 0xd7, 0x87, 0x57, 0x40, 0x70, 0x6b, 0x02, 0xdc,
+	0x2a, 0x39, 0x99, 0x02, 0xae, 0x87, 0x4a, 0xd5, 0x10, 0xe5, 0x57, 0x1a, 0xcc, 0xc4, 0x3b, 0x5c,
+	0x52, 0xcd, 0x72, 0x99, 0xda, 0x3e, 0xeb, 0xb5, 0x61, 0xc5, 0x11, 0xdf, 0x19, 0x81, 0xef, 0x24,
+	0x31, 0x52, 0xf0, 0xf9, 0x81, 0x8a, 0x02, 0x47, 0x5b, 0xe4, 0x13, 0x38, 0x80, 0x6d, 0x0d, 0xc9,
+	0xcc, 0xd1, 0x78, 0x97, 0xa6, 0x9f, 0xce, 0x95, 0x43, 0x1c, 0x86, 0xc0, 0xb1, 0x40, 0xf4, 0x14,
+	0x1c, 0xaa, 0xdb, 0xf9, 0x46, 0x83, 0xd9, 0x44, 0x7f, 0x45, 0x6a, 0x79, 0x3b, 0x92, 0x00, 0x64,
+	0x0e, 0x2d, 0x8f, 0xc0, 0xce, 0x0a, 0x60, 0xa7, 0xc8, 0xca, 0xa0, 0x0d, 0x54, 0x08, 0xbf, 0xd4,
+	0x60, 0x3a, 0xd6, 0x0e, 0x91, 0xb5, 0x81, 0xfb, 0x91, 0xe8, 0xb4, 0xf4, 0xea, 0x90, 0xd2, 0x88,
+	0xed, 0xff, 0x02, 0xdb, 0x0a, 0x59, 0xce, 0xdc, 0x3c, 0xd5, 0x07, 0x91, 0x7b, 0x1a, 0x1c, 0x8e,
+	0x15, 0xdd, 0xb3, 0x59, 0xae, 0x52, 0x7a, 0x17, 0x7d, 0x6d, 0x38, 0x61, 0x84, 0x55, 0x11, 0xb0,
+	0x0c, 0xb2, 0x94, 0x02, 0x4b, 0xd5, 0xd0, 0xaa, 0x17, 0x80, 0x08, 0x4a, 0x83, 0x6a, 0x1c, 0xb2,
+	0x4b, 0x43, 0xa2, 0x11, 0xd1, 0x2b, 0xf9, 0x82, 0x43, 0x94, 0x06, 0x4f, 0xf9, 0x0d, 0xd2, 0x2a,
+	0xf1, 0xad, 0x9e, 0x9d, 0x56, 0xe9, 0x8d, 0x86, 0x6e, 0x0e, 0x2d, 0x3f, 0x44, 0x5a, 0x85, 0x1c,
+	0x61, 0xef, 0x21, 0x36, 0x2f, 0x7a, 0xe9, 0x67, 0x6f, 0x5e, 0xca, 0xc7, 0x84, 0xbe, 0x36, 0x9c,
+	0xf0, 0x10, 0x9b, 0x27, 0xbf, 0x25, 0x10, 0x56, 0xfd, 0xe2, 0x83, 0x27, 0x65, 0xed, 0xe1, 0x93,
+	0xb2, 0xf6, 0xf8, 0x49, 0x59, 0xbb, 0xfb, 0xb4, 0x3c, 0xf2, 0xf0, 0x69, 0x79, 0xe4, 0xf7, 0xa7,
+	0xe5, 0x91, 0xf7, 0x57, 0x23, 0xdf, 0x85, 0x81, 0x95, 0x6a, 0xc7, 0x6e, 0x70, 0x69, 0xef, 0xb6,
+	0xb4, 0x28, 0xbe, 0x0d, 0x1b, 0x93, 0xe2, 0x15, 0xff, 0xfc, 0x3f, 0x03, 0x00, 0xa0, 0xf5, 0x24,
+	0x6d, 0xd2, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1614,6 +1806,8 @@ type QueryClient interface {
 	Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error)
 	// InterestFactors queries hard module interest factors.
 	InterestFactors(ctx context.Context, in *QueryInterestFactorsRequest, opts ...grpc.CallOption) (*QueryInterestFactorsResponse, error)
+	// HealthFactor queries the health factor of an account's borrows.
+	HealthFactor(ctx context.Context, in *QueryHealthFactorRequest, opts ...grpc.CallOption) (*QueryHealthFactorResponse, error)
 }
 
 type queryClient struct {
@@ -1723,6 +1917,15 @@ func (c *queryClient) InterestFactors(ctx context.Context, in *QueryInterestFact
 	return out, nil
 }
 
+func (c *queryClient) HealthFactor(ctx context.Context, in *QueryHealthFactorRequest, opts ...grpc.CallOption) (*QueryHealthFactorResponse, error) {
+	out := new(QueryHealthFactorResponse)
+	err := c.cc.Invoke(ctx, "/kava.hard.v1beta1.Query/HealthFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries module params.
@@ -1747,6 +1950,8 @@ type QueryServer interface {
 	Reserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
 	// InterestFactors queries hard module interest factors.
 	InterestFactors(context.Context, *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error)
+	// HealthFactor queries the health factor of an account's borrows.
+	HealthFactor(context.Context, *QueryHealthFactorRequest) (*QueryHealthFactorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1786,6 +1991,9 @@ func (*UnimplementedQueryServer) Reserves(ctx context.Context, req *QueryReserve
 func (*UnimplementedQueryServer) InterestFactors(ctx context.Context, req *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterestFactors not implemented")
 }
+func (*UnimplementedQueryServer) HealthFactor(ctx context.Context, req *QueryHealthFactorRequest) (*QueryHealthFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthFactor not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1989,6 +2197,24 @@ func _Query_InterestFactors_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HealthFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHealthFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HealthFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.hard.v1beta1.Query/HealthFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HealthFactor(ctx, req.(*QueryHealthFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.hard.v1beta1.Query",
@@ -2038,6 +2264,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InterestFactors",
 			Handler:    _Query_InterestFactors_Handler,
 		},
+		{
+			MethodName: "HealthFactor",
+			Handler:    _Query_HealthFactor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/hard/v1beta1/query.proto",
@@ -2668,6 +2898,20 @@ func (m *QueryTotalBorrowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.StableBorrowedCoins) > 0 {
+		for iNdEx := len(m.StableBorrowedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StableBorrowedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.BorrowedCoins) > 0 {
 		for iNdEx := len(m.BorrowedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3001,6 +3245,34 @@ func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StableRates) > 0 {
+		for iNdEx := len(m.StableRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StableRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StableAmount) > 0 {
+		for iNdEx := len(m.StableAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StableAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Index) > 0 {
 		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3039,6 +3311,43 @@ func (m *BorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StableBorrowRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StableBorrowRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StableBorrowRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BorrowInterestFactorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3076,6 +3385,66 @@ func (m *BorrowInterestFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryHealthFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HealthFactor) > 0 {
+		i -= len(m.HealthFactor)
+		copy(dAtA[i:], m.HealthFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HealthFactor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MoneyMarketInterestRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3096,6 +3465,13 @@ func (m *MoneyMarketInterestRate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.StableBorrowInterestRate) > 0 {
+		i -= len(m.StableBorrowInterestRate)
+		copy(dAtA[i:], m.StableBorrowInterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StableBorrowInterestRate)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.BorrowInterestRate) > 0 {
 		i -= len(m.BorrowInterestRate)
 		copy(dAtA[i:], m.BorrowInterestRate)
@@ -3432,6 +3808,12 @@ func (m *QueryTotalBorrowedResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.StableBorrowedCoins) > 0 {
+		for _, e := range m.StableBorrowedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3583,10 +3965,39 @@ func (m *BorrowResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *BorrowInterestFactorResponse) Size() (n int) {
+	if len(m.StableAmount) > 0 {
+		for _, e := range m.StableAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.StableRates) > 0 {
+		for _, e := range m.StableRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StableBorrowRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BorrowInterestFactorResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3603,6 +4014,32 @@ func (m *BorrowInterestFactorResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHealthFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHealthFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HealthFactor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *MoneyMarketInterestRate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3621,6 +4058,10 @@ func (m *MoneyMarketInterestRate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.StableBorrowInterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -5309,6 +5750,40 @@ func (m *QueryTotalBorrowedResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableBorrowedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableBorrowedCoins = append(m.StableBorrowedCoins, types1.Coin{})
+			if err := m.StableBorrowedCoins[len(m.StableBorrowedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6221,6 +6696,188 @@ func (m *BorrowResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableAmount = append(m.StableAmount, types1.Coin{})
+			if err := m.StableAmount[len(m.StableAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableRates = append(m.StableRates, StableBorrowRateResponse{})
+			if err := m.StableRates[len(m.StableRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StableBorrowRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StableBorrowRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StableBorrowRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6356,6 +7013,170 @@ func (m *BorrowInterestFactorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHealthFactorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthFactorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthFactorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthFactorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MoneyMarketInterestRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6481,6 +7302,38 @@ func (m *MoneyMarketInterestRate) Unmarshal(dAtA []byte) error {
 			}
 			m.BorrowInterestRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableBorrowInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableBorrowInterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
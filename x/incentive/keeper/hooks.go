@@ -75,6 +75,16 @@ func (h Hooks) AfterBorrowModified(ctx sdk.Context, borrow hardtypes.Borrow) {
 	h.k.UpdateHardBorrowIndexDenoms(ctx, borrow)
 }
 
+// BeforeReceiptTokensModified function that runs before receipt tokens are minted, redeemed or transferred
+func (h Hooks) BeforeReceiptTokensModified(ctx sdk.Context, holder sdk.AccAddress) {
+	h.k.SynchronizeHardReceiptReward(ctx, holder)
+}
+
+// AfterReceiptTokensModified function that runs after receipt tokens are minted, redeemed or transferred
+func (h Hooks) AfterReceiptTokensModified(ctx sdk.Context, holder sdk.AccAddress) {
+	h.k.UpdateHardReceiptBalances(ctx, holder)
+}
+
 /* ------------------- Staking Module Hooks -------------------
 
 Rewards are calculated based on total delegated tokens to bonded validators (not shares).
@@ -229,9 +229,18 @@ func NewSwapGenesisState(cdc codec.JSONCodec) app.GenesisState {
 		swaptypes.NewParams(
 			swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("busd", "ukava")),
 			d("0.0"),
+			sdk.ZeroDec(),
+			swaptypes.DefaultPriceHistoryBlocks,
+			swaptypes.DefaultMaxOrderFills,
+			swaptypes.DefaultMinOrderShare,
+			swaptypes.DefaultMaxOrderDuration,
 		),
 		swaptypes.DefaultPoolRecords,
 		swaptypes.DefaultShareRecords,
+		swaptypes.PoolFeeRecords{},
+		swaptypes.DefaultPoolPriceSnapshots,
+		swaptypes.DefaultOrders,
+		swaptypes.DefaultNextOrderID,
 	)
 	return app.GenesisState{
 		swaptypes.ModuleName: cdc.MustMarshalJSON(&genesis),
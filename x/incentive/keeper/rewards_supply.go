@@ -167,6 +167,96 @@ func (k Keeper) UpdateHardSupplyIndexDenoms(ctx sdk.Context, deposit hardtypes.D
 	k.SetHardLiquidityProviderClaim(ctx, claim)
 }
 
+// SynchronizeHardReceiptReward updates the claim of a holder of hard receipt tokens by adding the supply rewards
+// accumulated by their receipt tokens. Receipt tokens are normalized deposits, so they earn rewards like the normalized
+// deposit they represent. The hard receipt bank keeper syncs both accounts of every receipt token send, so the receipt
+// tokens held since the last sync are the recorded balance. Rewards accrue on the lesser of the recorded balance and the
+// receipt tokens held now, and the recorded balance is lowered to that amount, so tokens that arrive by any other route
+// never earn rewards for time they weren't held.
+func (k Keeper) SynchronizeHardReceiptReward(ctx sdk.Context, holder sdk.AccAddress) {
+	claim, found := k.GetHardLiquidityProviderClaim(ctx, holder)
+	if !found {
+		return
+	}
+
+	receiptTokens := k.getHardReceiptTokens(ctx, holder)
+	heldBalances := sdk.NewCoins()
+	for _, coin := range claim.ReceiptBalances {
+		denom, _ := hardtypes.ParseReceiptDenom(coin.Denom)
+		held := sdk.MinInt(coin.Amount, receiptTokens.AmountOf(coin.Denom))
+		claim = k.synchronizeSingleHardReceiptReward(ctx, claim, denom, sdk.NewDecFromInt(held))
+		heldBalances = heldBalances.Add(sdk.NewCoin(coin.Denom, held))
+	}
+	claim.ReceiptBalances = heldBalances
+	k.SetHardLiquidityProviderClaim(ctx, claim)
+}
+
+// synchronizeSingleHardReceiptReward synchronizes the rewards of a single receipt token denom in a hard claim.
+// It returns the claim without setting in the store.
+func (k Keeper) synchronizeSingleHardReceiptReward(ctx sdk.Context, claim types.HardLiquidityProviderClaim, denom string, sourceShares sdk.Dec) types.HardLiquidityProviderClaim {
+	globalRewardIndexes, found := k.GetHardSupplyRewardIndexes(ctx, denom)
+	if !found {
+		return claim
+	}
+
+	userRewardIndexes, found := claim.ReceiptRewardIndexes.Get(denom)
+	if !found {
+		userRewardIndexes = types.RewardIndexes{}
+	}
+
+	newRewards, err := k.CalculateRewards(userRewardIndexes, globalRewardIndexes, sourceShares)
+	if err != nil {
+		panic(fmt.Sprintf("corrupted global reward indexes found: %v", err))
+	}
+
+	claim.Reward = claim.Reward.Add(newRewards...)
+	claim.ReceiptRewardIndexes = claim.ReceiptRewardIndexes.With(denom, globalRewardIndexes)
+
+	return claim
+}
+
+// UpdateHardReceiptBalances records the hard receipt tokens currently held in the holder's claim. Receipt tokens that
+// weren't held at the last sync start accruing rewards from the current global reward indexes.
+func (k Keeper) UpdateHardReceiptBalances(ctx sdk.Context, holder sdk.AccAddress) {
+	receiptTokens := k.getHardReceiptTokens(ctx, holder)
+
+	claim, found := k.GetHardLiquidityProviderClaim(ctx, holder)
+	if !found {
+		if receiptTokens.Empty() {
+			return
+		}
+		claim = types.NewHardLiquidityProviderClaim(holder, sdk.Coins{}, nil, nil)
+	}
+
+	var receiptRewardIndexes types.MultiRewardIndexes
+	for _, coin := range receiptTokens {
+		denom, _ := hardtypes.ParseReceiptDenom(coin.Denom)
+		userRewardIndexes, found := claim.ReceiptRewardIndexes.Get(denom)
+		if !found || claim.ReceiptBalances.AmountOf(coin.Denom).IsZero() {
+			userRewardIndexes, found = k.GetHardSupplyRewardIndexes(ctx, denom)
+			if !found {
+				userRewardIndexes = types.RewardIndexes{}
+			}
+		}
+		receiptRewardIndexes = receiptRewardIndexes.With(denom, userRewardIndexes)
+	}
+
+	claim.ReceiptRewardIndexes = receiptRewardIndexes
+	claim.ReceiptBalances = receiptTokens
+	k.SetHardLiquidityProviderClaim(ctx, claim)
+}
+
+// getHardReceiptTokens returns the hard receipt tokens held by an account
+func (k Keeper) getHardReceiptTokens(ctx sdk.Context, holder sdk.AccAddress) sdk.Coins {
+	receiptTokens := sdk.NewCoins()
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, holder) {
+		if _, ok := hardtypes.ParseReceiptDenom(coin.Denom); ok {
+			receiptTokens = receiptTokens.Add(coin)
+		}
+	}
+	return receiptTokens
+}
+
 // SynchronizeHardLiquidityProviderClaim adds any accumulated rewards
 func (k Keeper) SynchronizeHardLiquidityProviderClaim(ctx sdk.Context, owner sdk.AccAddress) {
 	// Synchronize any hard liquidity supply-side rewards
@@ -180,6 +270,10 @@ func (k Keeper) SynchronizeHardLiquidityProviderClaim(ctx sdk.Context, owner sdk
 	if foundBorrow {
 		k.SynchronizeHardBorrowReward(ctx, borrow)
 	}
+
+	// Synchronize any hard receipt token rewards, and start accruing rewards for receipt tokens received since
+	k.SynchronizeHardReceiptReward(ctx, owner)
+	k.UpdateHardReceiptBalances(ctx, owner)
 }
 
 // SimulateHardSynchronization calculates a user's outstanding hard rewards by simulating reward synchronization
@@ -284,6 +378,14 @@ func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiq
 		}
 	}
 
+	// 3. Simulate Hard receipt token rewards
+	receiptTokens := k.getHardReceiptTokens(ctx, claim.Owner)
+	for _, coin := range claim.ReceiptBalances {
+		denom, _ := hardtypes.ParseReceiptDenom(coin.Denom)
+		sourceShares := sdk.NewDecFromInt(sdk.MinInt(coin.Amount, receiptTokens.AmountOf(coin.Denom)))
+		claim = k.synchronizeSingleHardReceiptReward(ctx, claim, denom, sourceShares)
+	}
+
 	return claim
 }
 
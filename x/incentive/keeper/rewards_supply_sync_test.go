@@ -340,3 +340,77 @@ func (builder HardDepositBuilder) WithArbitrarySourceShares(denoms ...string) Ha
 	}
 	return builder
 }
+
+// SynchronizeHardReceiptRewardTests runs unit tests for the keeper.SynchronizeHardReceiptReward method
+type SynchronizeHardReceiptRewardTests struct {
+	unitTester
+}
+
+func TestSynchronizeHardReceiptReward(t *testing.T) {
+	suite.Run(t, new(SynchronizeHardReceiptRewardTests))
+}
+
+func (suite *SynchronizeHardReceiptRewardTests) TestRewardIsIncrementedOnLesserOfSyncedAndHeldReceiptTokens() {
+	// Receipt tokens transferred away since the last sync don't earn rewards
+
+	owner := arbitraryAddress()
+	receiptDenom := hardtypes.ReceiptDenom("bnb")
+	claim := types.HardLiquidityProviderClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner:  owner,
+			Reward: arbitraryCoins(),
+		},
+		ReceiptRewardIndexes: types.MultiRewardIndexes{{
+			CollateralType: "bnb",
+			RewardIndexes:  types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}},
+		}},
+		ReceiptBalances: cs(c(receiptDenom, 1e9)),
+	}
+	suite.storeHardClaim(claim)
+
+	globalIndexes := types.MultiRewardIndexes{{
+		CollateralType: "bnb",
+		RewardIndexes:  types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.3")}},
+	}}
+	suite.storeGlobalSupplyIndexes(globalIndexes)
+
+	bankKeeper := newFakeBankKeeper().setBalance(owner, c(receiptDenom, 4e8), c("bnb", 1e9))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, bankKeeper, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	suite.keeper.SynchronizeHardReceiptReward(suite.ctx, owner)
+
+	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, owner)
+	// reward is ( new index - old index ) * lesser of synced and held receipt tokens
+	suite.Equal(
+		cs(c("hard", 8e7)).Add(claim.Reward...),
+		syncedClaim.Reward,
+	)
+	suite.Equal(globalIndexes, syncedClaim.ReceiptRewardIndexes)
+	// the recorded balance is lowered, so tokens received later don't earn rewards from the old indexes
+	suite.Equal(cs(c(receiptDenom, 4e8)), syncedClaim.ReceiptBalances)
+}
+
+func (suite *SynchronizeHardReceiptRewardTests) TestNewReceiptTokensStartAtGlobalIndexes() {
+	// Receipt tokens received since the last sync start earning rewards from the current global indexes
+
+	owner := arbitraryAddress()
+	receiptDenom := hardtypes.ReceiptDenom("bnb")
+
+	globalIndexes := types.MultiRewardIndexes{{
+		CollateralType: "bnb",
+		RewardIndexes:  types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.3")}},
+	}}
+	suite.storeGlobalSupplyIndexes(globalIndexes)
+
+	bankKeeper := newFakeBankKeeper().setBalance(owner, c(receiptDenom, 1e9))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, bankKeeper, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	suite.keeper.SynchronizeHardReceiptReward(suite.ctx, owner)
+	suite.keeper.UpdateHardReceiptBalances(suite.ctx, owner)
+
+	syncedClaim, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, owner)
+	suite.True(found)
+	suite.True(syncedClaim.Reward.Empty())
+	suite.Equal(globalIndexes, syncedClaim.ReceiptRewardIndexes)
+	suite.Equal(cs(c(receiptDenom, 1e9)), syncedClaim.ReceiptBalances)
+}
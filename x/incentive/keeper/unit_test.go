@@ -691,14 +691,16 @@ func (k *fakePricefeedKeeper) GetCurrentPrice(ctx sdk.Context, marketID string)
 }
 
 type fakeBankKeeper struct {
-	supply map[string]sdkmath.Int
+	supply   map[string]sdkmath.Int
+	balances map[string]sdk.Coins
 }
 
 var _ types.BankKeeper = newFakeBankKeeper()
 
 func newFakeBankKeeper() *fakeBankKeeper {
 	return &fakeBankKeeper{
-		supply: map[string]sdkmath.Int{},
+		supply:   map[string]sdkmath.Int{},
+		balances: map[string]sdk.Coins{},
 	}
 }
 
@@ -710,6 +712,12 @@ func (k *fakeBankKeeper) setSupply(coins ...sdk.Coin) *fakeBankKeeper {
 	return k
 }
 
+func (k *fakeBankKeeper) setBalance(addr sdk.AccAddress, coins ...sdk.Coin) *fakeBankKeeper {
+	k.balances[addr.String()] = sdk.NewCoins(coins...)
+
+	return k
+}
+
 func (k *fakeBankKeeper) SendCoinsFromModuleToAccount(
 	ctx sdk.Context,
 	senderModule string,
@@ -720,7 +728,7 @@ func (k *fakeBankKeeper) SendCoinsFromModuleToAccount(
 }
 
 func (k *fakeBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
-	panic("not implemented")
+	return k.balances[addr.String()]
 }
 
 func (k *fakeBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
@@ -144,6 +144,14 @@ func (c HardLiquidityProviderClaim) Validate() error {
 		return err
 	}
 
+	if err := c.ReceiptRewardIndexes.Validate(); err != nil {
+		return err
+	}
+
+	if !c.ReceiptBalances.IsValid() {
+		return fmt.Errorf("invalid receipt balances: %s", c.ReceiptBalances)
+	}
+
 	return c.BaseMultiClaim.Validate()
 }
 
@@ -302,6 +302,11 @@ type HardLiquidityProviderClaim struct {
 	BaseMultiClaim      `protobuf:"bytes,1,opt,name=base_claim,json=baseClaim,proto3,embedded=base_claim" json:"base_claim"`
 	SupplyRewardIndexes MultiRewardIndexes `protobuf:"bytes,2,rep,name=supply_reward_indexes,json=supplyRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"supply_reward_indexes"`
 	BorrowRewardIndexes MultiRewardIndexes `protobuf:"bytes,3,rep,name=borrow_reward_indexes,json=borrowRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"borrow_reward_indexes"`
+	// receipt_reward_indexes are the supply reward indexes of the hard receipt tokens held by the owner, keyed by the
+	// denom of their money market.
+	ReceiptRewardIndexes MultiRewardIndexes `protobuf:"bytes,4,rep,name=receipt_reward_indexes,json=receiptRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"receipt_reward_indexes"`
+	// receipt_balances are the hard receipt tokens held by the owner when their receipt rewards were last synced.
+	ReceiptBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=receipt_balances,json=receiptBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"receipt_balances"`
 }
 
 func (m *HardLiquidityProviderClaim) Reset()         { *m = HardLiquidityProviderClaim{} }
@@ -513,51 +518,53 @@ func init() {
 }
 
 var fileDescriptor_5f7515029623a895 = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0x13, 0x4d,
-	0x18, 0xef, 0xc0, 0x0b, 0x79, 0x3b, 0x94, 0xbe, 0x64, 0x81, 0x57, 0xe8, 0x61, 0x8b, 0x25, 0xc1,
-	0x26, 0xa6, 0xbb, 0x82, 0x07, 0x13, 0x6f, 0x2c, 0x68, 0xc0, 0x48, 0x24, 0x5b, 0x4d, 0x8c, 0x07,
-	0x9b, 0xd9, 0xdd, 0xb1, 0x4e, 0xd8, 0xee, 0xd4, 0x99, 0x6d, 0x4b, 0x3f, 0x83, 0x17, 0xfd, 0x02,
-	0x7e, 0x00, 0x2f, 0x5e, 0xf8, 0x10, 0xc4, 0x78, 0x20, 0xc6, 0xc4, 0x3f, 0x87, 0x8a, 0x70, 0xf5,
-	0x13, 0x78, 0x32, 0xf3, 0x07, 0x58, 0xa0, 0x25, 0xc4, 0x14, 0x0f, 0x9c, 0x76, 0xe7, 0x99, 0x67,
-	0x9e, 0xdf, 0x9f, 0x79, 0x76, 0x76, 0xe0, 0xec, 0x06, 0x6a, 0x22, 0x9b, 0x44, 0x3e, 0x8e, 0x62,
-	0xd2, 0xc4, 0x76, 0x73, 0xde, 0xc3, 0x31, 0x9a, 0xb7, 0xfd, 0x10, 0x91, 0x1a, 0xb7, 0xea, 0x8c,
-	0xc6, 0xd4, 0xf8, 0x5f, 0x24, 0x59, 0x87, 0x49, 0x96, 0x4e, 0xca, 0x99, 0x3e, 0xe5, 0x35, 0xca,
-	0x6d, 0x0f, 0xf1, 0xc4, 0x4a, 0x4a, 0x22, 0xb5, 0x2e, 0x37, 0xad, 0xe6, 0x2b, 0x72, 0x64, 0xab,
-	0x81, 0x9e, 0x9a, 0xa8, 0xd2, 0x2a, 0x55, 0x71, 0xf1, 0xa6, 0xa2, 0x85, 0x77, 0x00, 0xa6, 0x1d,
-	0xc4, 0xf1, 0x92, 0x40, 0x37, 0x9e, 0xc2, 0x21, 0xda, 0x8a, 0x30, 0x9b, 0x02, 0x33, 0xa0, 0x98,
-	0x71, 0x56, 0x7e, 0x75, 0xf2, 0xa5, 0x2a, 0x89, 0x9f, 0x37, 0x3c, 0xcb, 0xa7, 0x35, 0x5d, 0x4f,
-	0x3f, 0x4a, 0x3c, 0xd8, 0xb0, 0xe3, 0x76, 0x1d, 0x73, 0x6b, 0xd1, 0xf7, 0x17, 0x83, 0x80, 0x61,
-	0xce, 0x3f, 0x6e, 0x95, 0xc6, 0x35, 0xaa, 0x8e, 0x38, 0xed, 0x18, 0x73, 0x57, 0x95, 0x35, 0x6e,
-	0xc1, 0x61, 0x86, 0x5b, 0x88, 0x05, 0x53, 0x03, 0x33, 0xa0, 0x38, 0xb2, 0x30, 0x6d, 0xe9, 0x64,
-	0xa1, 0xe7, 0x40, 0xa4, 0xb5, 0x44, 0x49, 0xe4, 0xfc, 0xb3, 0xdd, 0xc9, 0xa7, 0x5c, 0x9d, 0x7e,
-	0x3b, 0xfd, 0x7e, 0xab, 0x34, 0x24, 0x39, 0x16, 0x76, 0x01, 0xcc, 0x0a, 0xc6, 0x6b, 0x8d, 0x30,
-	0x26, 0x7f, 0x87, 0xb6, 0x9f, 0xa0, 0x3d, 0x78, 0x36, 0xed, 0x1b, 0x82, 0xf6, 0xdb, 0xef, 0xf9,
-	0xe2, 0x39, 0xf0, 0xc5, 0x02, 0xde, 0x4d, 0xe2, 0x4b, 0x00, 0x47, 0x5c, 0x19, 0x5d, 0x8d, 0x02,
-	0xbc, 0x69, 0x5c, 0x83, 0xff, 0xf9, 0x34, 0x0c, 0x51, 0x8c, 0x19, 0x0a, 0x2b, 0x62, 0xb1, 0x54,
-	0x9a, 0x76, 0xb3, 0x47, 0xe1, 0x87, 0xed, 0x3a, 0x36, 0xca, 0x70, 0x54, 0x55, 0xab, 0x3c, 0x43,
-	0x7e, 0x4c, 0x99, 0xb4, 0x39, 0xe3, 0x58, 0x82, 0xd4, 0xb7, 0x4e, 0x7e, 0xee, 0x1c, 0xa4, 0x96,
-	0xb1, 0xef, 0x66, 0x54, 0x91, 0xbb, 0xb2, 0x46, 0xa1, 0x05, 0x8d, 0x04, 0x19, 0xcc, 0xd7, 0x65,
-	0x87, 0x22, 0x98, 0xd5, 0x50, 0x44, 0x85, 0xa7, 0x80, 0xf4, 0x66, 0xd6, 0xea, 0xde, 0xba, 0x56,
-	0xa2, 0x86, 0x33, 0xa9, 0x5d, 0x1a, 0x3d, 0x56, 0xd8, 0xd5, 0xe4, 0xf5, 0xb0, 0xf0, 0x06, 0xc0,
-	0x31, 0xb9, 0xcb, 0x7f, 0xe4, 0xc5, 0x69, 0x82, 0x03, 0xfd, 0x26, 0xf8, 0x1a, 0xc0, 0x2b, 0x27,
-	0x09, 0x1e, 0xf8, 0xd3, 0x84, 0x13, 0x35, 0x31, 0x55, 0xe9, 0xea, 0x52, 0xb1, 0x17, 0x89, 0x93,
-	0xe5, 0x9c, 0x9c, 0x66, 0x62, 0x9c, 0x06, 0x72, 0x8d, 0xda, 0xa9, 0x58, 0xe1, 0x03, 0x80, 0x63,
-	0x8f, 0xca, 0xcb, 0x8f, 0xd7, 0x48, 0x14, 0x93, 0xa8, 0xaa, 0x3e, 0x90, 0x7b, 0x10, 0x8a, 0x56,
-	0xad, 0xc8, 0x33, 0x46, 0xfa, 0x35, 0xb2, 0x70, 0xb5, 0x17, 0x85, 0xc3, 0xe3, 0xc0, 0xf9, 0x57,
-	0x60, 0xef, 0x74, 0xf2, 0xc0, 0x4d, 0x7b, 0x87, 0x67, 0xc4, 0xc5, 0xfb, 0x9a, 0xfc, 0x14, 0x7e,
-	0x0e, 0xc0, 0xdc, 0x0a, 0x62, 0xc1, 0x7d, 0xf2, 0xa2, 0x41, 0x02, 0x12, 0xb7, 0xd7, 0x19, 0x6d,
-	0x92, 0x00, 0x33, 0x45, 0xe6, 0x41, 0x17, 0x61, 0x73, 0x67, 0x09, 0x3b, 0x3a, 0x35, 0xba, 0xab,
-	0xdb, 0x84, 0x93, 0xbc, 0x51, 0xaf, 0x87, 0xed, 0x4a, 0x57, 0x91, 0xfd, 0xd9, 0xb7, 0x71, 0x05,
-	0x71, 0x2c, 0x28, 0x90, 0x3d, 0xca, 0x18, 0x6d, 0x9d, 0x44, 0x1e, 0xec, 0x27, 0xb2, 0x82, 0x70,
-	0x7b, 0xd9, 0xfd, 0x15, 0xc0, 0xec, 0x32, 0x0e, 0x71, 0x15, 0xc5, 0xf4, 0xa2, 0x2c, 0xde, 0xe8,
-	0xd1, 0x40, 0xfd, 0x51, 0xd8, 0xbb, 0x95, 0x3e, 0x01, 0x98, 0x2e, 0xb7, 0x50, 0xfd, 0x92, 0xc9,
-	0xfa, 0x0c, 0x60, 0xa6, 0x8c, 0x9a, 0x24, 0xaa, 0xf2, 0x4b, 0xb8, 0x61, 0x77, 0x10, 0x8b, 0x2e,
-	0x97, 0x2c, 0x67, 0x75, 0xfb, 0x87, 0x99, 0xda, 0xde, 0x33, 0xc1, 0xce, 0x9e, 0x09, 0x76, 0xf7,
-	0x4c, 0xf0, 0x6a, 0xdf, 0x4c, 0xed, 0xec, 0x9b, 0xa9, 0x2f, 0xfb, 0x66, 0xea, 0xc9, 0xf5, 0xc4,
-	0x3f, 0x5a, 0xf0, 0x28, 0x85, 0xc8, 0xe3, 0xf2, 0xcd, 0xde, 0x4c, 0xdc, 0x1a, 0xe5, 0xcf, 0xda,
-	0x1b, 0x96, 0x97, 0xb8, 0x9b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x88, 0xd7, 0x8a, 0x4f, 0x54,
-	0x0a, 0x00, 0x00,
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0xcb, 0x6e, 0x13, 0x3d,
+	0x14, 0xc7, 0xe3, 0xde, 0xf4, 0xc5, 0x4d, 0xd3, 0x6a, 0x7a, 0xf9, 0xd2, 0x2c, 0x26, 0x25, 0x95,
+	0x4a, 0x24, 0x94, 0x09, 0x2d, 0x0b, 0x24, 0x76, 0x9d, 0x16, 0xd4, 0x22, 0x2a, 0xaa, 0x09, 0x48,
+	0x88, 0x05, 0x91, 0x67, 0xc6, 0x04, 0xab, 0x93, 0x71, 0xb0, 0x27, 0x49, 0xc3, 0x2b, 0xb0, 0x81,
+	0x17, 0xe0, 0x01, 0xd8, 0xb0, 0xe9, 0x43, 0x54, 0x88, 0x45, 0x85, 0x90, 0xb8, 0x2c, 0x42, 0x69,
+	0xdf, 0x82, 0x15, 0xb2, 0xc7, 0x69, 0x27, 0x69, 0x52, 0x55, 0x28, 0x74, 0xd1, 0x55, 0xe2, 0x63,
+	0xfb, 0xfc, 0xfe, 0xff, 0x63, 0x1f, 0x6b, 0xe0, 0xe2, 0x0e, 0xaa, 0xa3, 0x02, 0xf1, 0x1d, 0xec,
+	0x07, 0xa4, 0x8e, 0x0b, 0xf5, 0x65, 0x1b, 0x07, 0x68, 0xb9, 0xe0, 0x78, 0x88, 0x54, 0xb8, 0x51,
+	0x65, 0x34, 0xa0, 0xda, 0x9c, 0x58, 0x64, 0x9c, 0x2c, 0x32, 0xd4, 0xa2, 0xb4, 0xee, 0x50, 0x5e,
+	0xa1, 0xbc, 0x60, 0x23, 0x1e, 0xd9, 0x49, 0x89, 0x1f, 0xee, 0x4b, 0xcf, 0x87, 0xf3, 0x25, 0x39,
+	0x2a, 0x84, 0x03, 0x35, 0x35, 0x53, 0xa6, 0x65, 0x1a, 0xc6, 0xc5, 0xbf, 0x30, 0x9a, 0xfd, 0x00,
+	0x60, 0xdc, 0x44, 0x1c, 0xaf, 0x09, 0xba, 0xf6, 0x0c, 0x8e, 0xd2, 0x86, 0x8f, 0x59, 0x0a, 0x2c,
+	0x80, 0x5c, 0xc2, 0xdc, 0xf8, 0xdd, 0xca, 0xe4, 0xcb, 0x24, 0x78, 0x51, 0xb3, 0x0d, 0x87, 0x56,
+	0x54, 0x3e, 0xf5, 0x93, 0xe7, 0xee, 0x4e, 0x21, 0x68, 0x56, 0x31, 0x37, 0x56, 0x1d, 0x67, 0xd5,
+	0x75, 0x19, 0xe6, 0xfc, 0xf3, 0x5e, 0x7e, 0x5a, 0x51, 0x55, 0xc4, 0x6c, 0x06, 0x98, 0x5b, 0x61,
+	0x5a, 0xed, 0x36, 0x1c, 0x63, 0xb8, 0x81, 0x98, 0x9b, 0x1a, 0x5a, 0x00, 0xb9, 0xf1, 0x95, 0x79,
+	0x43, 0x2d, 0x16, 0x7e, 0xda, 0x26, 0x8d, 0x35, 0x4a, 0x7c, 0x73, 0x64, 0xbf, 0x95, 0x89, 0x59,
+	0x6a, 0xf9, 0x9d, 0xf8, 0xc7, 0xbd, 0xfc, 0xa8, 0xd4, 0x98, 0x3d, 0x04, 0x30, 0x29, 0x14, 0x6f,
+	0xd5, 0xbc, 0x80, 0x5c, 0x8e, 0x6c, 0x27, 0x22, 0x7b, 0xf8, 0x7c, 0xd9, 0x37, 0x85, 0xec, 0xf7,
+	0x3f, 0x33, 0xb9, 0x0b, 0xf0, 0xc5, 0x06, 0xde, 0xcb, 0xe2, 0x6b, 0x00, 0xc7, 0x2d, 0x19, 0xdd,
+	0xf4, 0x5d, 0xbc, 0xab, 0x5d, 0x87, 0x93, 0x0e, 0xf5, 0x3c, 0x14, 0x60, 0x86, 0xbc, 0x92, 0xd8,
+	0x2c, 0x9d, 0xc6, 0xad, 0xe4, 0x69, 0xf8, 0x51, 0xb3, 0x8a, 0xb5, 0x22, 0x9c, 0x08, 0xb3, 0x95,
+	0x9e, 0x23, 0x27, 0xa0, 0x4c, 0x96, 0x39, 0x61, 0x1a, 0x42, 0xd4, 0x8f, 0x56, 0x66, 0xe9, 0x02,
+	0xa2, 0xd6, 0xb1, 0x63, 0x25, 0xc2, 0x24, 0xf7, 0x64, 0x8e, 0x6c, 0x03, 0x6a, 0x11, 0x31, 0x98,
+	0x6f, 0xcb, 0x1b, 0x8a, 0x60, 0x52, 0xa1, 0x48, 0x18, 0x4e, 0x01, 0x59, 0x9b, 0x45, 0xa3, 0xf7,
+	0xd5, 0x35, 0x22, 0x39, 0xcc, 0x59, 0x55, 0xa5, 0x89, 0x8e, 0xc4, 0xd6, 0x04, 0x8b, 0x0e, 0xb3,
+	0xef, 0x00, 0x9c, 0x92, 0xa7, 0xfc, 0x57, 0xb5, 0x38, 0x2b, 0x70, 0x68, 0xd0, 0x02, 0xdf, 0x02,
+	0xf8, 0x7f, 0xb7, 0xc0, 0x76, 0x7d, 0xea, 0x70, 0xa6, 0x22, 0xa6, 0x4a, 0x3d, 0xab, 0x94, 0xeb,
+	0x27, 0xa2, 0x3b, 0x9d, 0x99, 0x56, 0x4a, 0xb4, 0xb3, 0x20, 0x4b, 0xab, 0x9c, 0x89, 0x65, 0x3f,
+	0x01, 0x38, 0xf5, 0xb8, 0xb8, 0xfe, 0x64, 0x8b, 0xf8, 0x01, 0xf1, 0xcb, 0x61, 0x83, 0xdc, 0x87,
+	0x50, 0x5c, 0xd5, 0x92, 0x7c, 0x63, 0x64, 0xbd, 0xc6, 0x57, 0xae, 0xf5, 0x93, 0x70, 0xf2, 0x1c,
+	0x98, 0xff, 0x09, 0xf6, 0x41, 0x2b, 0x03, 0xac, 0xb8, 0xdd, 0x0e, 0x5e, 0x42, 0x5d, 0x3b, 0xba,
+	0x7d, 0x04, 0xa6, 0x37, 0x10, 0x73, 0x1f, 0x90, 0x97, 0x35, 0xe2, 0x92, 0xa0, 0xb9, 0xcd, 0x68,
+	0x9d, 0xb8, 0x98, 0x85, 0x62, 0x1e, 0xf6, 0x30, 0xb6, 0x74, 0x9e, 0xb1, 0xd3, 0x57, 0xa3, 0xb7,
+	0xbb, 0x5d, 0x38, 0xcb, 0x6b, 0xd5, 0xaa, 0xd7, 0x2c, 0xf5, 0x34, 0x39, 0x98, 0x73, 0x9b, 0x0e,
+	0x11, 0x1d, 0x41, 0x41, 0xb6, 0x29, 0x63, 0xb4, 0xd1, 0x4d, 0x1e, 0x1e, 0x24, 0x39, 0x44, 0x74,
+	0x92, 0x5f, 0xc1, 0x39, 0x86, 0x1d, 0x4c, 0xaa, 0x41, 0x37, 0x7a, 0x64, 0x80, 0xe8, 0x19, 0xc5,
+	0xe8, 0x64, 0xd7, 0xe1, 0x54, 0x9b, 0x6d, 0x23, 0x0f, 0xf9, 0x0e, 0xe6, 0xa9, 0xd1, 0xc1, 0x3f,
+	0xb2, 0x93, 0x0a, 0x62, 0x2a, 0x46, 0xf4, 0x8a, 0x7d, 0x07, 0x30, 0xb9, 0x8e, 0x3d, 0x5c, 0x46,
+	0x01, 0xfd, 0x57, 0xd7, 0x6a, 0xa7, 0x4f, 0xd3, 0x0c, 0xa6, 0xb4, 0xfd, 0xdb, 0xe7, 0x0b, 0x80,
+	0xf1, 0x62, 0x03, 0x55, 0xaf, 0x98, 0xad, 0xaf, 0x00, 0x26, 0x8a, 0xa8, 0x4e, 0xfc, 0x32, 0xbf,
+	0x82, 0x07, 0x76, 0x17, 0x31, 0xff, 0x6a, 0xd9, 0x32, 0x37, 0xf7, 0x7f, 0xe9, 0xb1, 0xfd, 0x23,
+	0x1d, 0x1c, 0x1c, 0xe9, 0xe0, 0xf0, 0x48, 0x07, 0x6f, 0x8e, 0xf5, 0xd8, 0xc1, 0xb1, 0x1e, 0xfb,
+	0x76, 0xac, 0xc7, 0x9e, 0xde, 0x88, 0xf4, 0xb1, 0xd0, 0x91, 0xf7, 0x90, 0xcd, 0xe5, 0xbf, 0xc2,
+	0x6e, 0xe4, 0x4b, 0x59, 0x36, 0xb4, 0x3d, 0x26, 0x3f, 0x5c, 0x6f, 0xfd, 0x19, 0x00, 0xe4, 0x70,
+	0xcb, 0xa1, 0x48, 0x0b, 0x00, 0x00,
 }
 
 func (m *BaseClaim) Marshal() (dAtA []byte, err error) {
@@ -869,6 +876,34 @@ func (m *HardLiquidityProviderClaim) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiptBalances) > 0 {
+		for iNdEx := len(m.ReceiptBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ReceiptRewardIndexes) > 0 {
+		for iNdEx := len(m.ReceiptRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.BorrowRewardIndexes) > 0 {
 		for iNdEx := len(m.BorrowRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1244,6 +1279,18 @@ func (m *HardLiquidityProviderClaim) Size() (n int) {
 			n += 1 + l + sovClaims(uint64(l))
 		}
 	}
+	if len(m.ReceiptRewardIndexes) > 0 {
+		for _, e := range m.ReceiptRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	if len(m.ReceiptBalances) > 0 {
+		for _, e := range m.ReceiptBalances {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2202,6 +2249,74 @@ func (m *HardLiquidityProviderClaim) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptRewardIndexes = append(m.ReceiptRewardIndexes, MultiRewardIndex{})
+			if err := m.ReceiptRewardIndexes[len(m.ReceiptRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptBalances = append(m.ReceiptBalances, types.Coin{})
+			if err := m.ReceiptBalances[len(m.ReceiptBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaims(dAtA[iNdEx:])
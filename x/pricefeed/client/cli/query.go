@@ -2,11 +2,13 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -24,8 +26,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPrice(),
+		GetCmdTWAPPrice(),
+		GetCmdEMAPrice(),
 		GetCmdQueryPrices(),
 		GetCmdRawPrices(),
+		GetCmdOracleStats(),
 		GetCmdOracles(),
 		GetCmdMarkets(),
 		GetCmdQueryParams(),
@@ -100,6 +105,66 @@ func GetCmdPrice() *cobra.Command {
 	}
 }
 
+// GetCmdTWAPPrice queries the time-weighted average price of an asset
+func GetCmdTWAPPrice() *cobra.Command {
+	return &cobra.Command{
+		Use:     "twap [marketID] [window]",
+		Short:   "get the time-weighted average price for the input market over a window",
+		Example: fmt.Sprintf("%s q %s twap bnb:usd 30m", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryTWAPPriceRequest{
+				MarketId: args[0],
+				Window:   args[1],
+			}
+
+			res, err := queryClient.TWAPPrice(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdEMAPrice queries the exponential moving average price of an asset
+func GetCmdEMAPrice() *cobra.Command {
+	return &cobra.Command{
+		Use:     "ema [marketID] [window]",
+		Short:   "get the exponential moving average price for the input market over a window",
+		Example: fmt.Sprintf("%s q %s ema bnb:usd 30m", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryEMAPriceRequest{
+				MarketId: args[0],
+				Window:   args[1],
+			}
+
+			res, err := queryClient.EMAPrice(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // GetCmdQueryPrices queries the pricefeed module for current prices
 func GetCmdQueryPrices() *cobra.Command {
 	return &cobra.Command{
@@ -155,6 +220,34 @@ func GetCmdRawPrices() *cobra.Command {
 	}
 }
 
+// GetCmdOracleStats queries the accuracy records of a market's oracles
+func GetCmdOracleStats() *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-stats [marketID]",
+		Short: "get the accuracy records of the oracles for the input market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryOracleStatsRequest{
+				MarketId: args[0],
+			}
+
+			res, err := queryClient.OracleStats(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // GetCmdMarkets queries list of markets in the pricefeed
 func GetCmdMarkets() *cobra.Command {
 	return &cobra.Command{
@@ -1,6 +1,8 @@
 package pricefeed
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/pricefeed/keeper"
@@ -15,12 +17,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	// Iterate through the posted prices and set them in the store if they are not expired
 	for _, pp := range gs.PostedPrices {
 		if pp.Expiry.After(ctx.BlockTime()) {
-			_, err := k.SetPrice(ctx, pp.OracleAddress, pp.MarketID, pp.Price, pp.Expiry)
+			err := k.SetPostedPrice(ctx, pp)
 			if err != nil {
 				panic(err)
 			}
 		}
 	}
+	// Restore the price history before current prices are set so the history stays in time order
+	for _, ps := range gs.PriceSnapshots {
+		k.SetPriceSnapshot(ctx, ps)
+	}
+
 	params := k.GetParams(ctx)
 
 	// Set the current price (if any) based on what's now in the store
@@ -34,10 +41,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 			continue
 		}
 		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil {
+		// markets may lack enough fresh oracle prices for a valid price
+		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
 			panic(err)
 		}
 	}
+
+	// Restore oracle stats after current prices are set, replacing any stats updated by setting the prices
+	for _, stats := range gs.OracleStats {
+		k.SetOracleStats(ctx, stats)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -46,10 +59,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 
 	var postedPrices []types.PostedPrice
+	var priceSnapshots []types.PriceSnapshot
 	for _, market := range k.GetMarkets(ctx) {
 		pp := k.GetRawPrices(ctx, market.MarketID)
 		postedPrices = append(postedPrices, pp...)
+
+		pss := k.GetPriceSnapshots(ctx, market.MarketID)
+		priceSnapshots = append(priceSnapshots, pss...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	return types.NewGenesisState(params, postedPrices, priceSnapshots, k.GetAllOracleStats(ctx))
 }
@@ -13,9 +13,10 @@ func NewPricefeedGen() types.GenesisState {
 	return types.GenesisState{
 		Params: types.Params{
 			Markets: []types.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				types.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{}, true),
+				types.NewMarket("xrp:usd", "xrp", "usd", []sdk.AccAddress{}, true),
 			},
+			OracleDeviationThreshold: sdk.ZeroDec(),
 		},
 		PostedPrices: []types.PostedPrice{
 			{
@@ -43,9 +44,10 @@ func NewPricefeedGenStateWithOracles(addrs []sdk.AccAddress) app.GenesisState {
 	pfGenesis := types.GenesisState{
 		Params: types.Params{
 			Markets: []types.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: addrs, Active: true},
+				types.NewMarket("btc:usd", "btc", "usd", addrs, true),
+				types.NewMarket("xrp:usd", "xrp", "usd", addrs, true),
 			},
+			OracleDeviationThreshold: sdk.ZeroDec(),
 		},
 		PostedPrices: []types.PostedPrice{
 			{
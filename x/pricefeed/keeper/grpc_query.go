@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -120,3 +121,69 @@ func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest)
 		Markets: markets,
 	}, nil
 }
+
+func (s queryServer) TWAPPrice(c context.Context, req *types.QueryTWAPPriceRequest) (*types.QueryTWAPPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	window, err := s.parseWindow(ctx, req.MarketId, req.Window)
+	if err != nil {
+		return nil, err
+	}
+	price, err := s.keeper.GetTWAPPrice(ctx, req.MarketId, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPPriceResponse{
+		Price: types.CurrentPriceResponse(price),
+	}, nil
+}
+
+func (s queryServer) EMAPrice(c context.Context, req *types.QueryEMAPriceRequest) (*types.QueryEMAPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	window, err := s.parseWindow(ctx, req.MarketId, req.Window)
+	if err != nil {
+		return nil, err
+	}
+	price, err := s.keeper.GetEMAPrice(ctx, req.MarketId, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEMAPriceResponse{
+		Price: types.CurrentPriceResponse(price),
+	}, nil
+}
+
+func (s queryServer) OracleStats(c context.Context, req *types.QueryOracleStatsRequest) (*types.QueryOracleStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	var oracleStats types.OracleStatsResponses
+	for _, stats := range s.keeper.GetOracleStatsByMarket(ctx, req.MarketId) {
+		oracleStats = append(oracleStats, stats.ToOracleStatsResponse(ctx.BlockTime()))
+	}
+
+	return &types.QueryOracleStatsResponse{
+		OracleStats: oracleStats,
+	}, nil
+}
+
+// parseWindow checks the market exists and parses an averaging window duration
+func (s queryServer) parseWindow(ctx sdk.Context, marketID string, window string) (time.Duration, error) {
+	_, found := s.keeper.GetMarket(ctx, marketID)
+	if !found {
+		return 0, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid window %s", window)
+	}
+	return duration, nil
+}
@@ -45,8 +45,8 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 
 func (suite *grpcQueryTestSuite) setTestParams() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-	})
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0)
 	suite.keeper.SetParams(suite.ctx, params)
 }
 
@@ -58,8 +58,8 @@ func (suite *grpcQueryTestSuite) TestGrpcParams() {
 	}{
 		{"default params", types.DefaultParams(), true},
 		{"test params", types.NewParams([]types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-		}), true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+		}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0), true},
 	}
 
 	for _, tt := range tests {
@@ -119,9 +119,9 @@ func (suite *grpcQueryTestSuite) TestGrpcPrices() {
 
 func (suite *grpcQueryTestSuite) TestGrpcPrices_NoPriceSet() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tst:usd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-		{MarketID: "other:usd", BaseAsset: "other", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-	})
+		types.NewMarket("tst:usd", "tst", "usd", []sdk.AccAddress{}, true),
+		types.NewMarket("other:usd", "other", "usd", []sdk.AccAddress{}, true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	_, err := suite.keeper.SetPrice(
@@ -186,10 +186,78 @@ func (suite *grpcQueryTestSuite) TestGrpcRawPrices_InvalidMarket() {
 	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcTWAPPrice() {
+	suite.setTestParams()
+	suite.setTstPrice()
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Minute))
+
+	res, err := suite.queryServer.TWAPPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPPriceRequest{MarketId: "tstusd", Window: "30m"})
+	suite.NoError(err)
+	suite.Equal(types.NewCurrentPriceResponse("tstusd", sdk.MustNewDecFromStr("0.34")), res.Price)
+
+	_, err = suite.queryServer.TWAPPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPPriceRequest{MarketId: "invalid", Window: "30m"})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+
+	_, err = suite.queryServer.TWAPPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPPriceRequest{MarketId: "tstusd", Window: "-30m"})
+	suite.Equal("rpc error: code = InvalidArgument desc = invalid window -30m", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcEMAPrice() {
+	suite.setTestParams()
+	suite.setTstPrice()
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Minute))
+
+	res, err := suite.queryServer.EMAPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryEMAPriceRequest{MarketId: "tstusd", Window: "30m"})
+	suite.NoError(err)
+	suite.Equal(types.NewCurrentPriceResponse("tstusd", sdk.MustNewDecFromStr("0.34")), res.Price)
+
+	_, err = suite.queryServer.EMAPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryEMAPriceRequest{MarketId: "tstusd", Window: "forever"})
+	suite.Equal("rpc error: code = InvalidArgument desc = invalid window forever", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcOracleStats() {
+	params := types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", suite.addrs[:4], true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.setTstPrice()
+
+	res, err := suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{MarketId: "tstusd"})
+	suite.NoError(err)
+	suite.Len(res.OracleStats, 4)
+
+	statsByOracle := make(map[string]types.OracleStatsResponse)
+	for _, stats := range res.OracleStats {
+		statsByOracle[stats.OracleAddress] = stats
+	}
+
+	deviation := sdk.MustNewDecFromStr("0.01").Quo(sdk.MustNewDecFromStr("0.34"))
+	suite.Equal(types.OracleStatsResponse{
+		MarketID:         "tstusd",
+		OracleAddress:    suite.strAddrs[1],
+		PricesCounted:    1,
+		LastDeviation:    deviation,
+		AverageDeviation: deviation,
+	}, statsByOracle[suite.strAddrs[1]])
+	suite.Equal(types.OracleStatsResponse{
+		MarketID:           "tstusd",
+		OracleAddress:      suite.strAddrs[3],
+		MissedWindows:      1,
+		TotalMissedWindows: 1,
+		LastDeviation:      sdk.ZeroDec(),
+		AverageDeviation:   sdk.ZeroDec(),
+	}, statsByOracle[suite.strAddrs[3]])
+
+	_, err = suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{MarketId: "invalid"})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-	})
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	res, err := suite.queryServer.Oracles(sdk.WrapSDKContext(suite.ctx), &types.QueryOraclesRequest{MarketId: "tstusd"})
@@ -197,8 +265,8 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 	suite.Empty(res.Oracles)
 
 	params = types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs, Active: true},
-	})
+		types.NewMarket("tstusd", "tst", "usd", suite.addrs, true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	res, err = suite.queryServer.Oracles(sdk.WrapSDKContext(suite.ctx), &types.QueryOraclesRequest{MarketId: "tstusd"})
@@ -211,8 +279,8 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 
 func (suite *grpcQueryTestSuite) TestGrpcOracles() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs, Active: true},
-	})
+		types.NewMarket("tstusd", "tst", "usd", suite.addrs, true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	res, err := suite.queryServer.Oracles(sdk.WrapSDKContext(suite.ctx), &types.QueryOraclesRequest{MarketId: "tstusd"})
@@ -229,9 +297,9 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_InvalidMarket() {
 
 func (suite *grpcQueryTestSuite) TestGrpcMarkets() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-		{MarketID: "btcusd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-	})
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+		types.NewMarket("btcusd", "btc", "usd", []sdk.AccAddress{}, true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	res, err := suite.queryServer.Markets(sdk.WrapSDKContext(suite.ctx), &types.QueryMarketsRequest{})
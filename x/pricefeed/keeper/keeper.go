@@ -24,11 +24,13 @@ type Keeper struct {
 	cdc codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace paramtypes.Subspace
+	// The swap keeper used to read pool prices for markets with a swap price source, can be nil
+	swapKeeper types.SwapKeeper
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
+	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace, swapKeeper types.SwapKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,6 +40,7 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		swapKeeper:    swapKeeper,
 	}
 }
 
@@ -62,6 +65,7 @@ func (k Keeper) SetPrice(
 	store := ctx.KVStore(k.key)
 
 	newRawPrice := types.NewPostedPrice(marketID, oracle, price, expiry)
+	newRawPrice.PostedAt = ctx.BlockTime()
 
 	// Emit an event containing the oracle's new price
 	ctx.EventManager().EmitEvent(
@@ -79,26 +83,87 @@ func (k Keeper) SetPrice(
 	return newRawPrice, nil
 }
 
+// SetPostedPrice stores a posted price as is, keeping the time it was posted
+func (k Keeper) SetPostedPrice(ctx sdk.Context, postedPrice types.PostedPrice) error {
+	if !postedPrice.Expiry.After(ctx.BlockTime()) {
+		return types.ErrExpired
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(types.RawPriceKey(postedPrice.MarketID, postedPrice.OracleAddress), k.cdc.MustMarshal(&postedPrice))
+	return nil
+}
+
 // SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
 	}
+
+	return k.updateCurrentPrice(ctx, market, k.GetRawPrices(ctx, marketID), k.GetParams(ctx))
+}
+
+// SetCurrentPricesForAllMarkets updates the price of an asset to the median of all valid oracle inputs
+func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
+	orderedMarkets := []types.Market{}
+	marketPricesByID := make(map[string]types.PostedPrices)
+
+	params := k.GetParams(ctx)
+	for _, market := range params.Markets {
+		if market.Active {
+			orderedMarkets = append(orderedMarkets, market)
+			marketPricesByID[market.MarketID] = types.PostedPrices{}
+		}
+	}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.RawPriceFeedPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var postedPrice types.PostedPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &postedPrice)
+
+		prices, found := marketPricesByID[postedPrice.MarketID]
+		if !found {
+			continue
+		}
+
+		marketPricesByID[postedPrice.MarketID] = append(prices, postedPrice)
+	}
+	iterator.Close()
+
+	for _, market := range orderedMarkets {
+		// errors leave the market without a new price, which is already reflected in the store
+		_ = k.updateCurrentPrice(ctx, market, marketPricesByID[market.MarketID], params)
+	}
+}
+
+// updateCurrentPrice sets the current price of a market to the median of its unexpired oracle prices
+// and the average price of its swap price source.
+// If the prices fail the market's deviation, oracle count or staleness checks, the market is frozen at
+// its last valid price until a set of prices passes the checks, or a median beyond the max deviation stays
+// stable for the market's price recovery updates. Each new price updates the stats of the market's oracles.
+func (k Keeper) updateCurrentPrice(ctx sdk.Context, market types.Market, rawPrices types.PostedPrices, params types.Params) error {
+	marketID := market.MarketID
+
 	// store current price
 	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
+	prevPrice, err := k.getCurrentPrice(ctx, marketID)
 	if err != nil {
 		validPrevPrice = false
 	}
 
-	prices := k.GetRawPrices(ctx, marketID)
+	var notExpiredPrices, freshPrices []types.CurrentPrice
+	var freshRawPrices types.PostedPrices
+	for _, v := range rawPrices {
+		// filter out expired prices
+		if !v.Expiry.After(ctx.BlockTime()) {
+			continue
+		}
+		notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
 
-	var notExpiredPrices []types.CurrentPrice
-	// filter out expired prices
-	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+		if market.MaxPriceAge == 0 || !ctx.BlockTime().After(v.PostedAt.Add(market.MaxPriceAge)) {
+			freshPrices = append(freshPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+			freshRawPrices = append(freshRawPrices, v)
 		}
 	}
 
@@ -108,10 +173,32 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 		// This zero's out the current price stored value for that market and ensures
 		// that CDP methods that GetCurrentPrice will return error.
 		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		k.setMarketFrozen(ctx, marketID, false)
+		k.deletePriceRecovery(ctx, marketID)
 		return types.ErrNoValidPrice
 	}
 
-	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
+	if len(freshPrices) == 0 {
+		return k.freezeMarket(ctx, marketID, prevPrice, validPrevPrice, types.AttributeValueStalePrices)
+	}
+	if uint64(len(freshPrices)) < market.MinOracleCount {
+		return k.freezeMarket(ctx, marketID, prevPrice, validPrevPrice, types.AttributeValueInsufficientOracles)
+	}
+
+	if swapPrice, ok := k.getSwapPrice(ctx, market); ok {
+		freshPrices = append(freshPrices, types.NewCurrentPrice(marketID, swapPrice))
+	}
+
+	medianPrice := k.CalculateMedianPrice(freshPrices)
+
+	if validPrevPrice && market.DeviationCheckEnabled() {
+		if exceedsDeviation(medianPrice, prevPrice.Price, market.MaxPriceDeviation) {
+			if !k.recoverPrice(ctx, market, medianPrice) {
+				return k.freezeMarket(ctx, marketID, prevPrice, validPrevPrice, types.AttributeValuePriceDeviation)
+			}
+		}
+	}
+	k.deletePriceRecovery(ctx, marketID)
 
 	// check case that market price was not set in genesis
 	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
@@ -127,76 +214,116 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 
 	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
+	k.recordPriceSnapshot(ctx, marketID, medianPrice, params.PriceHistorySize)
+	k.updateOracleStats(ctx, market, freshRawPrices, medianPrice, params)
+
+	if k.IsMarketFrozen(ctx, marketID) {
+		k.setMarketFrozen(ctx, marketID, false)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketRecovered,
+				sdk.NewAttribute(types.AttributeMarketID, marketID),
+				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+			),
+		)
+	}
 
 	return nil
 }
 
-// SetCurrentPricesForAllMarkets updates the price of an asset to the median of all valid oracle inputs
-func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
-	orderedMarkets := []string{}
-	marketPricesByID := make(map[string]types.CurrentPrices)
-
-	for _, market := range k.GetMarkets(ctx) {
-		if market.Active {
-			orderedMarkets = append(orderedMarkets, market.MarketID)
-			marketPricesByID[market.MarketID] = types.CurrentPrices{}
-		}
+// recoverPrice tracks a median price beyond the market's max price deviation, returning true once it has
+// stayed within the max price deviation of its first value for the market's price recovery updates.
+// A genuine price move therefore only freezes the market for a limited number of blocks.
+func (k Keeper) recoverPrice(ctx sdk.Context, market types.Market, medianPrice sdk.Dec) bool {
+	recovery, found := k.getPriceRecovery(ctx, market.MarketID)
+	if found && !exceedsDeviation(medianPrice, recovery.Price, market.MaxPriceDeviation) {
+		recovery.Updates++
+	} else {
+		recovery = types.NewPriceRecovery(market.MarketID, medianPrice, 1)
 	}
 
-	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.RawPriceFeedPrefix)
-	for ; iterator.Valid(); iterator.Next() {
-		var postedPrice types.PostedPrice
-		k.cdc.MustUnmarshal(iterator.Value(), &postedPrice)
+	if recovery.Updates < market.PriceRecoveryUpdates {
+		k.setPriceRecovery(ctx, recovery)
+		return false
+	}
+	return true
+}
 
-		prices, found := marketPricesByID[postedPrice.MarketID]
-		if !found {
-			continue
-		}
+// exceedsDeviation returns true if price differs from refPrice by more than maxDeviation, as a fraction of refPrice
+func exceedsDeviation(price, refPrice, maxDeviation sdk.Dec) bool {
+	return price.Sub(refPrice).Abs().Quo(refPrice).GT(maxDeviation)
+}
 
-		// filter out expired prices
-		if postedPrice.Expiry.After(ctx.BlockTime()) {
-			marketPricesByID[postedPrice.MarketID] = append(prices, types.NewCurrentPrice(postedPrice.MarketID, postedPrice.Price))
-		}
+func (k Keeper) getPriceRecovery(ctx sdk.Context, marketID string) (types.PriceRecovery, bool) {
+	bz := ctx.KVStore(k.key).Get(types.PriceRecoveryKey(marketID))
+	if bz == nil {
+		return types.PriceRecovery{}, false
 	}
-	iterator.Close()
+	var recovery types.PriceRecovery
+	k.cdc.MustUnmarshal(bz, &recovery)
+	return recovery, true
+}
 
-	for _, marketID := range orderedMarkets {
-		// store current price
-		validPrevPrice := true
-		prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-		if err != nil {
-			validPrevPrice = false
-		}
+func (k Keeper) setPriceRecovery(ctx sdk.Context, recovery types.PriceRecovery) {
+	ctx.KVStore(k.key).Set(types.PriceRecoveryKey(recovery.MarketID), k.cdc.MustMarshal(&recovery))
+}
 
-		notExpiredPrices, _ := marketPricesByID[marketID]
+func (k Keeper) deletePriceRecovery(ctx sdk.Context, marketID string) {
+	ctx.KVStore(k.key).Delete(types.PriceRecoveryKey(marketID))
+}
 
-		if len(notExpiredPrices) == 0 {
-			// NOTE: The current price stored will continue storing the most recent (expired)
-			// price if this is not set.
-			// This zero's out the current price stored value for that market and ensures
-			// that CDP methods that GetCurrentPrice will return error.
-			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
-			continue
-		}
+// freezeMarket keeps the last valid price of a market and marks the market as frozen.
+// A market without a valid price has no price to freeze at, so it is left without a price.
+func (k Keeper) freezeMarket(ctx sdk.Context, marketID string, prevPrice types.CurrentPrice, validPrevPrice bool, reason string) error {
+	if !validPrevPrice {
+		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		return types.ErrNoValidPrice
+	}
 
-		medianPrice := k.CalculateMedianPrice(notExpiredPrices)
-
-		// check case that market price was not set in genesis
-		//if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
-		if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
-			// only emit event if price has changed
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeMarketPriceUpdated,
-					sdk.NewAttribute(types.AttributeMarketID, marketID),
-					sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
-				),
-			)
-		}
+	if !k.IsMarketFrozen(ctx, marketID) {
+		k.setMarketFrozen(ctx, marketID, true)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketFrozen,
+				sdk.NewAttribute(types.AttributeMarketID, marketID),
+				sdk.NewAttribute(types.AttributeMarketPrice, prevPrice.Price.String()),
+				sdk.NewAttribute(types.AttributeReason, reason),
+			),
+		)
+	}
+	return nil
+}
 
-		currentPrice := types.NewCurrentPrice(marketID, medianPrice)
-		k.setCurrentPrice(ctx, marketID, currentPrice)
+func (k Keeper) setMarketFrozen(ctx sdk.Context, marketID string, frozen bool) {
+	store := ctx.KVStore(k.key)
+	if frozen {
+		store.Set(types.FrozenMarketKey(marketID), []byte{})
+	} else {
+		store.Delete(types.FrozenMarketKey(marketID))
+	}
+}
+
+// IsMarketFrozen returns true if a market's price is frozen at its last valid price.
+// Virtual markets are frozen when their underlying market is frozen.
+func (k Keeper) IsMarketFrozen(ctx sdk.Context, marketID string) bool {
+	if baseMarketID, _, _, ok := types.ParseVirtualMarketID(marketID); ok {
+		marketID = baseMarketID
 	}
+	return ctx.KVStore(k.key).Has(types.FrozenMarketKey(marketID))
+}
+
+// getSwapPrice returns the average price of the market's swap price source, if the market has one and
+// the swap pool has a price for the window
+func (k Keeper) getSwapPrice(ctx sdk.Context, market types.Market) (sdk.Dec, bool) {
+	source := market.SwapPriceSource
+	if source == nil || k.swapKeeper == nil {
+		return sdk.Dec{}, false
+	}
+	price, err := k.swapKeeper.GetPoolTWAPPrice(ctx, source.PoolID, source.Denom, source.Window)
+	if err != nil || !price.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return price.Mul(source.ConversionFactor), true
 }
 
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
@@ -231,8 +358,20 @@ func (k Keeper) calculateMeanPrice(priceA, priceB types.CurrentPrice) sdk.Dec {
 	return mean
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// GetCurrentPrice fetches the current median price of all oracles for a specific market.
+// Virtual market ids such as "bnb:usd:twap:30m" return the average price of the underlying market over the window.
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	if baseMarketID, method, window, ok := types.ParseVirtualMarketID(marketID); ok {
+		price, err := k.getAveragePrice(ctx, baseMarketID, method, window)
+		if err != nil {
+			return types.CurrentPrice{}, err
+		}
+		return types.NewCurrentPrice(marketID, price.Price), nil
+	}
+	return k.getCurrentPrice(ctx, marketID)
+}
+
+func (k Keeper) getCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
 
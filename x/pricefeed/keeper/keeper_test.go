@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed/keeper"
 	"github.com/kava-labs/kava/x/pricefeed/testutil"
 	"github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // TestKeeper_SetGetMarket tests adding markets to the pricefeed, getting markets from the store
@@ -25,7 +27,7 @@ func TestKeeper_SetGetMarket(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -41,8 +43,8 @@ func TestKeeper_SetGetMarket(t *testing.T) {
 
 	mp = types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-			{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+			types.NewMarket("tst2usd", "tst2", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -64,7 +66,7 @@ func TestKeeper_GetSetPrice(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -117,7 +119,7 @@ func TestKeeper_GetSetCurrentPrice(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -207,7 +209,7 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
 		},
 	}
 	keeper.SetParams(ctx, mp)
@@ -269,3 +271,215 @@ func TestKeeper_SetCurrentPrices_MatchesAllMarketsBehavior(t *testing.T) {
 	testutil.SetCurrentPrices_PriceCalculations(t, testFunc)
 	testutil.SetCurrentPrices_EventEmission(t, testFunc)
 }
+
+// TestKeeper_PriceDeviationCircuitBreaker tests that large price moves freeze a market at its last valid price
+func TestKeeper_PriceDeviationCircuitBreaker(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(startTime)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tst:usd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.MaxPriceDeviation = sdk.MustNewDecFromStr("0.1")
+	market.PriceRecoveryUpdates = 3
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0))
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(100), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	require.False(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+
+	// a move within the limit updates the price
+	_, err = keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(110), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(110), price.Price)
+
+	// a move over the limit freezes the market at the last valid price
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(200), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(110), price.Price)
+	require.True(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+	require.True(t, keeper.IsMarketFrozen(ctx, "tst:usd:twap:30m"), "virtual markets should be frozen with their market")
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketFrozen,
+		sdk.NewAttribute(types.AttributeMarketID, "tst:usd"),
+		sdk.NewAttribute(types.AttributeMarketPrice, sdk.NewDec(110).String()),
+		sdk.NewAttribute(types.AttributeReason, types.AttributeValuePriceDeviation),
+	))
+
+	// prices back within the limit unfreeze the market
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(105), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(105), price.Price)
+	require.False(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketRecovered,
+		sdk.NewAttribute(types.AttributeMarketID, "tst:usd"),
+		sdk.NewAttribute(types.AttributeMarketPrice, sdk.NewDec(105).String()),
+	))
+
+	// a genuine price move is accepted once it stays within the limit for the price recovery updates
+	for _, p := range []int64{200, 210} {
+		_, err = keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(p), startTime.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+		price, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(105), price.Price)
+		require.True(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+	}
+
+	// a move away from the recovery price restarts the count
+	_, err = keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(300), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	require.True(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+	for _, p := range []int64{310, 305} {
+		_, err = keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(p), startTime.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	}
+	price, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(305), price.Price)
+	require.False(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+}
+
+// TestKeeper_MinOracleCountCircuitBreaker tests that markets with too few oracle prices are frozen
+func TestKeeper_MinOracleCountCircuitBreaker(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(startTime)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tst:usd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.MinOracleCount = 2
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0))
+
+	// a market without a previous price cannot be frozen so has no price
+	_, err := keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(10), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "tst:usd"), types.ErrNoValidPrice)
+	require.False(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+
+	_, err = keeper.SetPrice(ctx, addrs[1], "tst:usd", sdk.NewDec(12), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(11), price.Price)
+
+	// one oracle's price expires, leaving too few to price the market
+	_, err = keeper.SetPrice(ctx, addrs[1], "tst:usd", sdk.NewDec(12), startTime.Add(time.Minute))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Minute))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(11), price.Price)
+	require.True(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+
+	_, err = keeper.SetPrice(ctx, addrs[2], "tst:usd", sdk.NewDec(14), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(12), price.Price)
+	require.False(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+}
+
+// TestKeeper_StalePriceCircuitBreaker tests that markets are frozen when oracles stop posting prices
+func TestKeeper_StalePriceCircuitBreaker(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(startTime)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tst:usd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.MaxPriceAge = 10 * time.Minute
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0))
+
+	pp, err := keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(10), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, startTime, pp.PostedAt)
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	require.False(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+
+	ctx = ctx.WithBlockTime(startTime.Add(10 * time.Minute))
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	require.False(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+
+	ctx = ctx.WithBlockTime(startTime.Add(11 * time.Minute))
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	require.True(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), price.Price)
+
+	// expired prices remove the market's price instead of freezing it
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	require.False(t, keeper.IsMarketFrozen(ctx, "tst:usd"))
+	_, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+}
+
+// TestKeeper_SwapPriceSource tests that a swap pool price is included in the median price of a market
+func TestKeeper_SwapPriceSource(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{Height: 1}).WithBlockTime(startTime)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	record := swaptypes.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("tst", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(12e6))), sdkmath.NewInt(1e6))
+	record.PriceLastUpdated = startTime.Add(-time.Hour)
+	tApp.GetSwapKeeper().SetPool(ctx, record)
+
+	market := types.NewMarket("tst:usd", "tst", "usd", []sdk.AccAddress{}, true)
+	market.MinOracleCount = 2
+	market.SwapPriceSource = types.NewSwapPriceSource("tst:usdx", "tst", 30*time.Minute, sdk.OneDec())
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0))
+
+	// the swap price does not count towards the minimum number of oracles
+	_, err := keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(10), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "tst:usd"), types.ErrNoValidPrice)
+
+	_, err = keeper.SetPrice(ctx, addrs[1], "tst:usd", sdk.NewDec(11), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(11), price.Price, "expected median of oracle prices 10, 11 and swap price 12")
+
+	// the conversion factor scales the swap price
+	market.SwapPriceSource.ConversionFactor = sdk.MustNewDecFromStr("0.5")
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), price.Price, "expected median of oracle prices 10, 11 and swap price 6")
+
+	// a missing pool leaves only the oracle prices
+	market.SwapPriceSource = types.NewSwapPriceSource("tst:busd", "tst", 30*time.Minute, sdk.OneDec())
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tst:usd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.5"), price.Price)
+}
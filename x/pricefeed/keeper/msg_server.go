@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
@@ -33,6 +34,10 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 		return nil, err
 	}
 
+	if k.keeper.IsOracleJailed(ctx, msg.MarketID, from) {
+		return nil, errorsmod.Wrap(types.ErrOracleJailed, msg.From)
+	}
+
 	_, err = k.keeper.SetPrice(ctx, from, msg.MarketID, msg.Price, msg.Expiry)
 	if err != nil {
 		return nil, err
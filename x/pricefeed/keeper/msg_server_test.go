@@ -25,7 +25,7 @@ func TestKeeper_PostPrice(t *testing.T) {
 
 	mp := types.Params{
 		Markets: []types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: authorizedOracles, Active: true},
+			types.NewMarket("tstusd", "tst", "usd", authorizedOracles, true),
 		},
 	}
 	k.SetParams(ctx, mp)
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// updateOracleStats records how each of a market's oracles contributed to a new median price.
+// Oracles without a fresh price miss the update, and oracles whose price is far from the median
+// accumulate deviation. Oracles past the params' miss or deviation thresholds are penalized.
+func (k Keeper) updateOracleStats(ctx sdk.Context, market types.Market, freshRawPrices types.PostedPrices, medianPrice sdk.Dec, params types.Params) {
+	pricesByOracle := make(map[string]sdk.Dec, len(freshRawPrices))
+	for _, rp := range freshRawPrices {
+		pricesByOracle[rp.OracleAddress.String()] = rp.Price
+	}
+
+	for _, oracle := range market.Oracles {
+		stats, found := k.GetOracleStats(ctx, market.MarketID, oracle)
+		if !found {
+			stats = types.NewOracleStats(market.MarketID, oracle)
+		}
+		if stats.IsJailed(ctx.BlockTime()) {
+			continue
+		}
+
+		price, posted := pricesByOracle[oracle.String()]
+		if !posted {
+			stats.MissedWindows++
+			stats.TotalMissedWindows++
+			if params.OracleMissThreshold > 0 && stats.MissedWindows >= params.OracleMissThreshold {
+				k.penalizeOracle(ctx, &stats, params, types.AttributeValueMissedWindows)
+			}
+			k.SetOracleStats(ctx, stats)
+			continue
+		}
+
+		deviation := sdk.ZeroDec()
+		if medianPrice.IsPositive() {
+			deviation = price.Sub(medianPrice).Abs().Quo(medianPrice)
+		}
+		stats.PricesCounted++
+		stats.MissedWindows = 0
+		stats.LastDeviation = deviation
+		stats.TotalDeviation = stats.TotalDeviation.Add(deviation)
+		if params.OracleDeviationCheckEnabled() && deviation.GT(params.OracleDeviationThreshold) {
+			k.penalizeOracle(ctx, &stats, params, types.AttributeValuePriceDeviation)
+		}
+		k.SetOracleStats(ctx, stats)
+	}
+}
+
+// penalizeOracle removes an oracle's posted price and jails the oracle for the params' jail duration.
+// If the jail duration is zero the oracle is removed from the market instead.
+func (k Keeper) penalizeOracle(ctx sdk.Context, stats *types.OracleStats, params types.Params, reason string) {
+	stats.MissedWindows = 0
+	ctx.KVStore(k.key).Delete(types.RawPriceKey(stats.MarketID, stats.OracleAddress))
+
+	if params.OracleJailDuration == 0 {
+		k.removeOracle(ctx, stats.MarketID, stats.OracleAddress)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOracleRemoved,
+				sdk.NewAttribute(types.AttributeMarketID, stats.MarketID),
+				sdk.NewAttribute(types.AttributeOracle, stats.OracleAddress.String()),
+				sdk.NewAttribute(types.AttributeReason, reason),
+			),
+		)
+		return
+	}
+
+	stats.JailCount++
+	stats.JailedUntil = ctx.BlockTime().Add(params.OracleJailDuration)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleJailed,
+			sdk.NewAttribute(types.AttributeMarketID, stats.MarketID),
+			sdk.NewAttribute(types.AttributeOracle, stats.OracleAddress.String()),
+			sdk.NewAttribute(types.AttributeReason, reason),
+			sdk.NewAttribute(types.AttributeJailedUntil, stats.JailedUntil.UTC().String()),
+		),
+	)
+}
+
+// removeOracle removes an oracle from a market's params
+func (k Keeper) removeOracle(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	params := k.GetParams(ctx)
+	for i, market := range params.Markets {
+		if market.MarketID != marketID {
+			continue
+		}
+		oracles := []sdk.AccAddress{}
+		for _, addr := range market.Oracles {
+			if !addr.Equals(oracle) {
+				oracles = append(oracles, addr)
+			}
+		}
+		params.Markets[i].Oracles = oracles
+	}
+	k.SetParams(ctx, params)
+}
+
+// IsOracleJailed returns true if an oracle is jailed from posting prices for a market
+func (k Keeper) IsOracleJailed(ctx sdk.Context, marketID string, oracle sdk.AccAddress) bool {
+	stats, found := k.GetOracleStats(ctx, marketID, oracle)
+	return found && stats.IsJailed(ctx.BlockTime())
+}
+
+// GetOracleStats returns an oracle's stats for a market from the store
+func (k Keeper) GetOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleStats, bool) {
+	bz := ctx.KVStore(k.key).Get(types.OracleStatsKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleStats{}, false
+	}
+	var stats types.OracleStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetOracleStats sets an oracle's stats for a market in the store
+func (k Keeper) SetOracleStats(ctx sdk.Context, stats types.OracleStats) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleStatsKey(stats.MarketID, stats.OracleAddress), k.cdc.MustMarshal(&stats))
+}
+
+// IterateOracleStats iterates over all oracle stats in the store and performs a callback function
+func (k Keeper) IterateOracleStats(ctx sdk.Context, cb func(stats types.OracleStats) (stop bool)) {
+	k.iterateOracleStats(ctx, types.OracleStatsPrefix, cb)
+}
+
+// IterateOracleStatsByMarket iterates over a market's oracle stats and performs a callback function
+func (k Keeper) IterateOracleStatsByMarket(ctx sdk.Context, marketID string, cb func(stats types.OracleStats) (stop bool)) {
+	k.iterateOracleStats(ctx, types.OracleStatsIteratorKey(marketID), cb)
+}
+
+func (k Keeper) iterateOracleStats(ctx sdk.Context, prefix []byte, cb func(stats types.OracleStats) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetOracleStatsByMarket returns the stats of a market's oracles
+func (k Keeper) GetOracleStatsByMarket(ctx sdk.Context, marketID string) types.OracleStatsList {
+	var statsList types.OracleStatsList
+	k.IterateOracleStatsByMarket(ctx, marketID, func(stats types.OracleStats) (stop bool) {
+		statsList = append(statsList, stats)
+		return false
+	})
+	return statsList
+}
+
+// GetAllOracleStats returns the stats of all oracles
+func (k Keeper) GetAllOracleStats(ctx sdk.Context) types.OracleStatsList {
+	var statsList types.OracleStatsList
+	k.IterateOracleStats(ctx, func(stats types.OracleStats) (stop bool) {
+		statsList = append(statsList, stats)
+		return false
+	})
+	return statsList
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_OracleStats tests that oracle misses and deviations from the median are recorded
+func TestKeeper_OracleStats(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(startTime)
+	k := tApp.GetPriceFeedKeeper()
+
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tst:usd", "tst", "usd", addrs, true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0))
+
+	for i := 1; i <= 2; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i) * time.Minute))
+		_, err := k.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(10), startTime.Add(time.Hour))
+		require.NoError(t, err)
+		_, err = k.SetPrice(ctx, addrs[1], "tst:usd", sdk.NewDec(12), startTime.Add(time.Hour))
+		require.NoError(t, err)
+		k.SetCurrentPricesForAllMarkets(ctx)
+	}
+
+	// the median of 10 and 12 is 11
+	stats, found := k.GetOracleStats(ctx, "tst:usd", addrs[1])
+	require.True(t, found)
+	require.Equal(t, uint64(2), stats.PricesCounted)
+	require.Equal(t, uint64(0), stats.MissedWindows)
+	require.Equal(t, sdk.OneDec().QuoInt64(11), stats.LastDeviation)
+	require.Equal(t, sdk.OneDec().QuoInt64(11), stats.AverageDeviation())
+
+	stats, found = k.GetOracleStats(ctx, "tst:usd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, uint64(0), stats.PricesCounted)
+	require.Equal(t, uint64(2), stats.MissedWindows)
+	require.Equal(t, uint64(2), stats.TotalMissedWindows)
+
+	// posting a price resets the consecutive misses
+	_, err := k.SetPrice(ctx, addrs[2], "tst:usd", sdk.NewDec(11), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	k.SetCurrentPricesForAllMarkets(ctx)
+
+	stats, found = k.GetOracleStats(ctx, "tst:usd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, uint64(1), stats.PricesCounted)
+	require.Equal(t, uint64(0), stats.MissedWindows)
+	require.Equal(t, uint64(2), stats.TotalMissedWindows)
+	require.Equal(t, sdk.ZeroDec(), stats.LastDeviation)
+
+	require.Len(t, k.GetOracleStatsByMarket(ctx, "tst:usd"), 3)
+	require.Len(t, k.GetAllOracleStats(ctx), 3)
+}
+
+// TestKeeper_JailOracle tests that oracles past the miss or deviation thresholds are jailed
+func TestKeeper_JailOracle(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(startTime)
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tst:usd", "tst", "usd", addrs, true),
+	}, types.DefaultPriceHistorySize, 2, sdk.MustNewDecFromStr("0.2"), time.Hour))
+
+	postPrice := func(oracle sdk.AccAddress, price sdk.Dec) error {
+		msg := types.NewMsgPostPrice(oracle.String(), "tst:usd", price, ctx.BlockTime().Add(time.Hour))
+		_, err := msgSrv.PostPrice(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// addrs[2] posts a price far from the median and addrs[3] never posts
+	require.NoError(t, postPrice(addrs[0], sdk.NewDec(10)))
+	require.NoError(t, postPrice(addrs[1], sdk.NewDec(10)))
+	require.NoError(t, postPrice(addrs[2], sdk.NewDec(20)))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.SetCurrentPricesForAllMarkets(ctx)
+
+	require.True(t, k.IsOracleJailed(ctx, "tst:usd", addrs[2]))
+	require.False(t, k.IsOracleJailed(ctx, "tst:usd", addrs[3]))
+	require.Len(t, k.GetRawPrices(ctx, "tst:usd"), 2, "jailed oracle's price should be removed")
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeOracleJailed,
+		sdk.NewAttribute(types.AttributeMarketID, "tst:usd"),
+		sdk.NewAttribute(types.AttributeOracle, addrs[2].String()),
+		sdk.NewAttribute(types.AttributeReason, types.AttributeValuePriceDeviation),
+		sdk.NewAttribute(types.AttributeJailedUntil, startTime.Add(time.Hour).String()),
+	))
+
+	err := postPrice(addrs[2], sdk.NewDec(10))
+	require.ErrorIs(t, err, types.ErrOracleJailed)
+
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute))
+	k.SetCurrentPricesForAllMarkets(ctx)
+	require.True(t, k.IsOracleJailed(ctx, "tst:usd", addrs[3]))
+
+	stats, found := k.GetOracleStats(ctx, "tst:usd", addrs[3])
+	require.True(t, found)
+	require.Equal(t, uint64(1), stats.JailCount)
+	require.Equal(t, uint64(0), stats.MissedWindows)
+	require.Equal(t, uint64(2), stats.TotalMissedWindows)
+
+	// jailed oracles are released after the jail duration
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	require.False(t, k.IsOracleJailed(ctx, "tst:usd", addrs[2]))
+	require.NoError(t, postPrice(addrs[2], sdk.NewDec(10)))
+}
+
+// TestKeeper_RemoveOracle tests that penalized oracles are removed from their market when the jail duration is zero
+func TestKeeper_RemoveOracle(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	k := tApp.GetPriceFeedKeeper()
+
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tst:usd", "tst", "usd", addrs, true),
+		types.NewMarket("other:usd", "other", "usd", addrs, true),
+	}, types.DefaultPriceHistorySize, 1, sdk.ZeroDec(), 0))
+
+	_, err := k.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(10), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = k.SetPrice(ctx, addrs[1], "tst:usd", sdk.NewDec(10), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.SetCurrentPrices(ctx, "tst:usd"))
+
+	oracles, err := k.GetOracles(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, addrs[:2], oracles)
+	require.False(t, k.IsOracleJailed(ctx, "tst:usd", addrs[2]))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeOracleRemoved,
+		sdk.NewAttribute(types.AttributeMarketID, "tst:usd"),
+		sdk.NewAttribute(types.AttributeOracle, addrs[2].String()),
+		sdk.NewAttribute(types.AttributeReason, types.AttributeValueMissedWindows),
+	))
+
+	// other markets keep the oracle
+	oracles, err = k.GetOracles(ctx, "other:usd")
+	require.NoError(t, err)
+	require.Equal(t, addrs, oracles)
+}
@@ -55,9 +55,9 @@ func (suite *KeeperTestSuite) TestGetAuthorizedAddresses() {
 
 	params := types.Params{
 		Markets: []types.Market{
-			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: oracles[:3], Active: true},
-			{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: oracles[2:], Active: true},
-			{MarketID: "xrp:usd:30", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: nil, Active: true},
+			types.NewMarket("btc:usd", "btc", "usd", oracles[:3], true),
+			types.NewMarket("xrp:usd", "xrp", "usd", oracles[2:], true),
+			types.NewMarket("xrp:usd:30", "xrp", "usd", nil, true),
 		},
 	}
 	suite.keeper.SetParams(suite.ctx, params)
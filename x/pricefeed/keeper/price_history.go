@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordPriceSnapshot appends a market's current price to its price history, dropping the oldest
+// snapshots so that at most historySize are kept. A snapshot already taken this block is replaced.
+func (k Keeper) recordPriceSnapshot(ctx sdk.Context, marketID string, price sdk.Dec, historySize uint64) {
+	latest, latestSequence, found := k.getLatestPriceSnapshot(ctx, marketID)
+
+	if historySize == 0 {
+		if found {
+			k.deletePriceSnapshotsBefore(ctx, marketID, latestSequence+1)
+		}
+		return
+	}
+
+	snapshot := types.NewPriceSnapshot(marketID, price, ctx.BlockTime())
+
+	sequence := uint64(0)
+	if found {
+		sequence = latestSequence + 1
+		if !snapshot.Timestamp.After(latest.Timestamp) {
+			sequence = latestSequence
+		}
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceSnapshotKey(marketID, sequence), k.cdc.MustMarshal(&snapshot))
+
+	if sequence >= historySize {
+		k.deletePriceSnapshotsBefore(ctx, marketID, sequence+1-historySize)
+	}
+}
+
+// SetPriceSnapshot appends a snapshot to a market's price history without pruning it.
+func (k Keeper) SetPriceSnapshot(ctx sdk.Context, snapshot types.PriceSnapshot) {
+	sequence := uint64(0)
+	if _, latestSequence, found := k.getLatestPriceSnapshot(ctx, snapshot.MarketID); found {
+		sequence = latestSequence + 1
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceSnapshotKey(snapshot.MarketID, sequence), k.cdc.MustMarshal(&snapshot))
+}
+
+// getLatestPriceSnapshot returns the most recent snapshot of a market along with its sequence number
+func (k Keeper) getLatestPriceSnapshot(ctx sdk.Context, marketID string) (types.PriceSnapshot, uint64, bool) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceSnapshot{}, 0, false
+	}
+	var snapshot types.PriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, types.SequenceFromPriceSnapshotKey(iterator.Key()), true
+}
+
+// deletePriceSnapshotsBefore removes a market's snapshots with sequence numbers below end
+func (k Keeper) deletePriceSnapshotsBefore(ctx sdk.Context, marketID string, end uint64) {
+	store := ctx.KVStore(k.key)
+	iterator := store.Iterator(types.PriceSnapshotIteratorKey(marketID), types.PriceSnapshotKey(marketID, end))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IteratePriceSnapshotsByMarket iterates over a market's price snapshots from oldest to newest and performs a callback function
+func (k Keeper) IteratePriceSnapshotsByMarket(ctx sdk.Context, marketID string, cb func(snapshot types.PriceSnapshot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetPriceSnapshots returns a market's price snapshots from oldest to newest
+func (k Keeper) GetPriceSnapshots(ctx sdk.Context, marketID string) types.PriceSnapshots {
+	var snapshots types.PriceSnapshots
+	k.IteratePriceSnapshotsByMarket(ctx, marketID, func(snapshot types.PriceSnapshot) (stop bool) {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return snapshots
+}
+
+// GetTWAPPrice returns the time-weighted average of a market's current price over the window ending at the block time.
+func (k Keeper) GetTWAPPrice(ctx sdk.Context, marketID string, window time.Duration) (types.CurrentPrice, error) {
+	return k.getAveragePrice(ctx, marketID, types.VirtualMarketTWAP, window)
+}
+
+// GetEMAPrice returns the exponential moving average of a market's current price over the window ending at the block time.
+func (k Keeper) GetEMAPrice(ctx sdk.Context, marketID string, window time.Duration) (types.CurrentPrice, error) {
+	return k.getAveragePrice(ctx, marketID, types.VirtualMarketEMA, window)
+}
+
+// getAveragePrice calculates an average price from a market's price history.
+// The market must have a valid current price, so an average is not reported for a market whose oracle prices have expired.
+func (k Keeper) getAveragePrice(ctx sdk.Context, marketID string, method string, window time.Duration) (types.CurrentPrice, error) {
+	if window <= 0 {
+		return types.CurrentPrice{}, errorsmod.Wrapf(types.ErrInvalidWindow, "%s", window)
+	}
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || !market.Active {
+		return types.CurrentPrice{}, errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if _, err := k.getCurrentPrice(ctx, marketID); err != nil {
+		return types.CurrentPrice{}, err
+	}
+
+	snapshots := k.GetPriceSnapshots(ctx, marketID)
+
+	var price sdk.Dec
+	var ok bool
+	switch method {
+	case types.VirtualMarketTWAP:
+		price, ok = snapshots.TWAP(ctx.BlockTime(), window)
+	case types.VirtualMarketEMA:
+		price, ok = snapshots.EMA(ctx.BlockTime(), window)
+	}
+	if !ok || !price.IsPositive() {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	return types.NewCurrentPrice(marketID, price), nil
+}
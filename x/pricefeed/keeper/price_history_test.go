@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_PriceHistory tests that current prices are recorded in a bounded price history
+func TestKeeper_PriceHistory(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(startTime)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{}, true),
+	}, 3, 0, sdk.ZeroDec(), 0))
+
+	for i := int64(1); i <= 5; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i) * time.Minute))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(i), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		keeper.SetCurrentPricesForAllMarkets(ctx)
+	}
+
+	snapshots := keeper.GetPriceSnapshots(ctx, "tstusd")
+	require.Equal(t, types.PriceSnapshots{
+		types.NewPriceSnapshot("tstusd", sdk.NewDec(3), startTime.Add(3*time.Minute)),
+		types.NewPriceSnapshot("tstusd", sdk.NewDec(4), startTime.Add(4*time.Minute)),
+		types.NewPriceSnapshot("tstusd", sdk.NewDec(5), startTime.Add(5*time.Minute)),
+	}, snapshots)
+
+	// a second update in the same block replaces the block's snapshot
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(6), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+
+	snapshots = keeper.GetPriceSnapshots(ctx, "tstusd")
+	require.Len(t, snapshots, 3)
+	require.Equal(t, sdk.NewDec(6), snapshots[2].Price)
+
+	// disabling the price history removes existing snapshots
+	params := keeper.GetParams(ctx)
+	params.PriceHistorySize = 0
+	keeper.SetParams(ctx, params)
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+
+	require.Empty(t, keeper.GetPriceSnapshots(ctx, "tstusd"))
+}
+
+// TestKeeper_VirtualMarketPrice tests getting average prices through virtual market ids
+func TestKeeper_VirtualMarketPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(startTime)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tst:usd", "tst", "usd", []sdk.AccAddress{}, true),
+	}, types.DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0))
+
+	_, err := keeper.GetCurrentPrice(ctx, "tst:usd:twap:30m")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	// price is 10 for 20 minutes then 40 for 10 minutes
+	_, err = keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(10), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+
+	ctx = ctx.WithBlockTime(startTime.Add(20 * time.Minute))
+	_, err = keeper.SetPrice(ctx, addrs[0], "tst:usd", sdk.NewDec(40), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+
+	spot, err := keeper.GetCurrentPrice(ctx, "tst:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), spot.Price)
+
+	twap, err := keeper.GetCurrentPrice(ctx, "tst:usd:twap:30m")
+	require.NoError(t, err)
+	require.Equal(t, types.NewCurrentPrice("tst:usd:twap:30m", sdk.NewDec(20)), twap)
+
+	twap, err = keeper.GetTWAPPrice(ctx, "tst:usd", 10*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), twap.Price)
+
+	ema, err := keeper.GetCurrentPrice(ctx, "tst:usd:ema:30m")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), ema.Price)
+
+	_, err = keeper.GetCurrentPrice(ctx, "bad:usd:twap:30m")
+	require.ErrorIs(t, err, types.ErrInvalidMarket)
+
+	// average prices are not available once the market's oracle prices expire
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+
+	_, err = keeper.GetCurrentPrice(ctx, "tst:usd:twap:30m")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+}
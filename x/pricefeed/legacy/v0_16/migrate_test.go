@@ -87,7 +87,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "bnb:usd:30",
@@ -96,7 +101,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "atom:usd",
@@ -105,7 +115,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "atom:usd:30",
@@ -114,7 +129,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "akt:usd",
@@ -123,7 +143,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "akt:usd:30",
@@ -132,7 +157,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "luna:usd",
@@ -141,7 +171,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "luna:usd:30",
@@ -150,7 +185,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "osmo:usd",
@@ -159,7 +199,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "osmo:usd:30",
@@ -168,7 +213,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "ust:usd",
@@ -177,7 +227,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				},
 				{
 					"market_id": "ust:usd:30",
@@ -186,24 +241,37 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"max_price_deviation": "0",
+					"price_recovery_updates": "0",
+					"min_oracle_count": "0",
+					"max_price_age": "0s",
+					"swap_price_source": null
 				}
-			]
+			],
+			"price_history_size": "0",
+			"oracle_miss_threshold": "0",
+			"oracle_deviation_threshold": "0",
+			"oracle_jail_duration": "0s"
 		},
 		"posted_prices": [
 			{
 				"market_id": "bnb:usd",
 				"oracle_address": "kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em",
 				"price": "215.962650000000001782",
-				"expiry": "2022-07-20T00:00:00Z"
+				"expiry": "2022-07-20T00:00:00Z",
+				"posted_at": "0001-01-01T00:00:00Z"
 			},
 			{
 				"market_id": "bnb:usd:30",
 				"oracle_address": "kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em",
 				"price": "217.962650000000001782",
-				"expiry": "2022-07-20T00:00:00Z"
+				"expiry": "2022-07-20T00:00:00Z",
+				"posted_at": "0001-01-01T00:00:00Z"
 			}
-		]
+		],
+		"price_snapshots": [],
+		"oracle_stats": []
 	}`
 
 	err := s.legacyCdc.UnmarshalJSON([]byte(v15Params), &s.v15genstate)
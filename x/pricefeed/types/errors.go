@@ -17,4 +17,8 @@ var (
 	ErrInvalidOracle = errorsmod.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = errorsmod.Register(ModuleName, 7, "asset not found")
+	// ErrInvalidWindow error for averaging windows that are not positive
+	ErrInvalidWindow = errorsmod.Register(ModuleName, 8, "averaging window must be positive")
+	// ErrOracleJailed error for posted price messages from jailed oracles
+	ErrOracleJailed = errorsmod.Register(ModuleName, 9, "oracle is jailed")
 )
@@ -5,10 +5,21 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketFrozen       = "market_frozen"
+	EventTypeMarketRecovered    = "market_recovered"
+	EventTypeOracleJailed       = "oracle_jailed"
+	EventTypeOracleRemoved      = "oracle_removed"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeReason        = "reason"
+	AttributeJailedUntil   = "jailed_until"
+
+	AttributeValuePriceDeviation      = "price_deviation"
+	AttributeValueInsufficientOracles = "insufficient_oracles"
+	AttributeValueStalePrices         = "stale_prices"
+	AttributeValueMissedWindows       = "missed_windows"
 )
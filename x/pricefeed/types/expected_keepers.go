@@ -0,0 +1,12 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapKeeper defines the expected swap keeper interface
+type SwapKeeper interface {
+	GetPoolTWAPPrice(ctx sdk.Context, poolID string, denom string, window time.Duration) (sdk.Dec, error)
+}
@@ -1,10 +1,12 @@
 package types
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, pss []PriceSnapshot, oss []OracleStats) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:         p,
+		PostedPrices:   pp,
+		PriceSnapshots: pss,
+		OracleStats:    oss,
 	}
 }
 
@@ -13,6 +15,8 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]PriceSnapshot{},
+		[]OracleStats{},
 	)
 }
 
@@ -23,5 +27,13 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PriceSnapshots.Validate(); err != nil {
+		return err
+	}
+
+	return gs.OracleStats.Validate()
 }
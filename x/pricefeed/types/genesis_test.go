@@ -32,9 +32,11 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceSnapshot{},
+				OracleStatsList{},
 			),
 			expPass: true,
 		},
@@ -42,9 +44,11 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceSnapshot{},
+				OracleStatsList{},
 			),
 			expPass: false,
 		},
@@ -52,29 +56,81 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceSnapshot{},
+				OracleStatsList{},
 			),
 			expPass: false,
 		},
 		{
 			msg: "invalid posted price",
 			genesisState: NewGenesisState(
-				NewParams([]Market{}),
+				NewParams([]Market{}, DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				[]PriceSnapshot{},
+				OracleStatsList{},
 			),
 			expPass: false,
 		},
 		{
 			msg: "duplicated posted price",
 			genesisState: NewGenesisState(
-				NewParams([]Market{}),
+				NewParams([]Market{}, DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0),
 				[]PostedPrice{
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				[]PriceSnapshot{},
+				OracleStatsList{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price snapshots",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0),
+				[]PostedPrice{},
+				[]PriceSnapshot{
+					NewPriceSnapshot("xrp", sdk.OneDec(), now),
+					NewPriceSnapshot("xrp", sdk.OneDec(), now.Add(time.Minute)),
+				},
+				OracleStatsList{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid price snapshot",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0),
+				[]PostedPrice{},
+				[]PriceSnapshot{NewPriceSnapshot("xrp", sdk.ZeroDec(), now)},
+				OracleStatsList{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "out of order price snapshots",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistorySize, 0, sdk.ZeroDec(), 0),
+				[]PostedPrice{},
+				[]PriceSnapshot{
+					NewPriceSnapshot("xrp", sdk.OneDec(), now),
+					NewPriceSnapshot("xrp", sdk.OneDec(), now),
+				},
+				OracleStatsList{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid price history size",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, MaxPriceHistorySize+1, 0, sdk.ZeroDec(), 0),
+				[]PostedPrice{},
+				[]PriceSnapshot{},
+				OracleStatsList{},
 			),
 			expPass: false,
 		},
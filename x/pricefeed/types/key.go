@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName The name that will be used throughout the module
@@ -22,6 +26,18 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// PriceSnapshotPrefix prefix for the price history of a market
+	PriceSnapshotPrefix = []byte{0x02}
+
+	// FrozenMarketPrefix prefix for markets frozen at their last price
+	FrozenMarketPrefix = []byte{0x03}
+
+	// OracleStatsPrefix prefix for the accuracy records of oracles
+	OracleStatsPrefix = []byte{0x04}
+
+	// PriceRecoveryPrefix prefix for the recovery prices of frozen markets
+	PriceRecoveryPrefix = []byte{0x05}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -45,6 +61,50 @@ func RawPriceKey(marketID string, oracleAddr sdk.AccAddress) []byte {
 	)
 }
 
+// PriceSnapshotIteratorKey returns the prefix for the price snapshots of a single market
+func PriceSnapshotIteratorKey(marketID string) []byte {
+	return append(
+		PriceSnapshotPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// PriceSnapshotKey returns the key for a market's price snapshot with the given sequence number
+func PriceSnapshotKey(marketID string, sequence uint64) []byte {
+	return append(PriceSnapshotIteratorKey(marketID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// SequenceFromPriceSnapshotKey returns the sequence number of a price snapshot key
+func SequenceFromPriceSnapshotKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// FrozenMarketKey returns the key for a market's frozen status
+func FrozenMarketKey(marketID string) []byte {
+	return append(FrozenMarketPrefix, []byte(marketID)...)
+}
+
+// PriceRecoveryKey returns the key for a frozen market's recovery price
+func PriceRecoveryKey(marketID string) []byte {
+	return append(PriceRecoveryPrefix, []byte(marketID)...)
+}
+
+// OracleStatsIteratorKey returns the prefix for the oracle stats of a single market
+func OracleStatsIteratorKey(marketID string) []byte {
+	return append(
+		OracleStatsPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// OracleStatsKey returns the key for an oracle's stats in a market
+func OracleStatsKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		OracleStatsIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
 // lengthPrefixWithByte returns the input bytes prefixes with one byte containing its length.
 // It panics if the input is greater than 255 in length.
 func lengthPrefixWithByte(bz []byte) []byte {
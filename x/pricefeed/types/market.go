@@ -17,6 +17,8 @@ func NewMarket(id, base, quote string, oracles []sdk.AccAddress, active bool) Ma
 		QuoteAsset: quote,
 		Oracles:    oracles,
 		Active:     active,
+		// price deviation checks are disabled by default
+		MaxPriceDeviation: sdk.ZeroDec(),
 	}
 }
 
@@ -25,6 +27,9 @@ func (m Market) Validate() error {
 	if strings.TrimSpace(m.MarketID) == "" {
 		return errors.New("market id cannot be blank")
 	}
+	if _, _, _, ok := ParseVirtualMarketID(m.MarketID); ok {
+		return fmt.Errorf("market id %s is reserved for virtual markets", m.MarketID)
+	}
 	if err := sdk.ValidateDenom(m.BaseAsset); err != nil {
 		return fmt.Errorf("invalid base asset: %w", err)
 	}
@@ -41,12 +46,68 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if !m.MaxPriceDeviation.IsNil() && m.MaxPriceDeviation.IsNegative() {
+		return fmt.Errorf("max price deviation cannot be negative %s", m.MaxPriceDeviation)
+	}
+	if m.DeviationCheckEnabled() && m.PriceRecoveryUpdates == 0 {
+		return errors.New("price recovery updates must be positive when max price deviation is set")
+	}
+	if m.MaxPriceAge < 0 {
+		return fmt.Errorf("max price age cannot be negative %s", m.MaxPriceAge)
+	}
+	if m.SwapPriceSource != nil {
+		if err := m.SwapPriceSource.Validate(); err != nil {
+			return fmt.Errorf("invalid swap price source: %w", err)
+		}
+	}
 	return nil
 }
 
+// DeviationCheckEnabled returns true if price changes between blocks are limited for the market
+func (m Market) DeviationCheckEnabled() bool {
+	return !m.MaxPriceDeviation.IsNil() && m.MaxPriceDeviation.IsPositive()
+}
+
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
-	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	response := NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	response.MaxPriceDeviation = m.MaxPriceDeviation
+	response.MinOracleCount = m.MinOracleCount
+	response.MaxPriceAge = m.MaxPriceAge
+	response.SwapPriceSource = m.SwapPriceSource
+	response.PriceRecoveryUpdates = m.PriceRecoveryUpdates
+	return response
+}
+
+// NewSwapPriceSource returns a new SwapPriceSource
+func NewSwapPriceSource(poolID, denom string, window time.Duration, conversionFactor sdk.Dec) *SwapPriceSource {
+	return &SwapPriceSource{
+		PoolID:           poolID,
+		Denom:            denom,
+		Window:           window,
+		ConversionFactor: conversionFactor,
+	}
+}
+
+// Validate performs a basic validation of the swap price source
+func (s SwapPriceSource) Validate() error {
+	if strings.TrimSpace(s.PoolID) == "" {
+		return errors.New("pool id cannot be blank")
+	}
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	denoms := strings.Split(s.PoolID, ":")
+	if len(denoms) != 2 || (denoms[0] != s.Denom && denoms[1] != s.Denom) {
+		return fmt.Errorf("denom %s is not in pool %s", s.Denom, s.PoolID)
+	}
+	if s.Window <= 0 {
+		return fmt.Errorf("window must be positive %s", s.Window)
+	}
+	if s.ConversionFactor.IsNil() || !s.ConversionFactor.IsPositive() {
+		return fmt.Errorf("conversion factor must be positive %s", s.ConversionFactor)
+	}
+	return nil
 }
 
 // Markets is a slice of Market
@@ -95,6 +156,11 @@ func NewCurrentPrice(marketID string, price sdk.Dec) CurrentPrice {
 // CurrentPrices is a slice of CurrentPrice
 type CurrentPrices []CurrentPrice
 
+// NewPriceRecovery returns an instance of PriceRecovery
+func NewPriceRecovery(marketID string, price sdk.Dec, updates uint64) PriceRecovery {
+	return PriceRecovery{MarketID: marketID, Price: price, Updates: updates}
+}
+
 // NewCurrentPriceResponse returns an instance of CurrentPriceResponse
 func NewCurrentPriceResponse(marketID string, price sdk.Dec) CurrentPriceResponse {
 	return CurrentPriceResponse{MarketID: marketID, Price: price}
@@ -78,6 +78,108 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"virtual market id",
+			Market{
+				MarketID:   "xrp:bnb:twap:30m",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+			},
+			false,
+		},
+		{
+			"valid circuit breaker",
+			Market{
+				MarketID:             "market",
+				BaseAsset:            "xrp",
+				QuoteAsset:           "bnb",
+				MaxPriceDeviation:    sdk.MustNewDecFromStr("0.1"),
+				MinOracleCount:       3,
+				MaxPriceAge:          time.Hour,
+				PriceRecoveryUpdates: 5,
+			},
+			true,
+		},
+		{
+			"max price deviation without price recovery",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"),
+			},
+			false,
+		},
+		{
+			"negative max price deviation",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				MaxPriceDeviation: sdk.MustNewDecFromStr("-0.1"),
+			},
+			false,
+		},
+		{
+			"negative max price age",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				MaxPriceAge: -time.Hour,
+			},
+			false,
+		},
+		{
+			"valid swap price source",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				SwapPriceSource: NewSwapPriceSource("xrp:usdx", "xrp", time.Hour, sdk.OneDec()),
+			},
+			true,
+		},
+		{
+			"swap price source with blank pool id",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				SwapPriceSource: NewSwapPriceSource("", "xrp", time.Hour, sdk.OneDec()),
+			},
+			false,
+		},
+		{
+			"swap price source denom not in pool",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				SwapPriceSource: NewSwapPriceSource("xrp:usdx", "usd", time.Hour, sdk.OneDec()),
+			},
+			false,
+		},
+		{
+			"swap price source without window",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				SwapPriceSource: NewSwapPriceSource("xrp:usdx", "xrp", 0, sdk.OneDec()),
+			},
+			false,
+		},
+		{
+			"swap price source without conversion factor",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				SwapPriceSource: NewSwapPriceSource("xrp:usdx", "xrp", time.Hour, sdk.ZeroDec()),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOracleStats returns a new OracleStats with no recorded price updates
+func NewOracleStats(marketID string, oracle sdk.AccAddress) OracleStats {
+	return OracleStats{
+		MarketID:       marketID,
+		OracleAddress:  oracle,
+		LastDeviation:  sdk.ZeroDec(),
+		TotalDeviation: sdk.ZeroDec(),
+	}
+}
+
+// Validate performs a basic check of an OracleStats.
+func (stats OracleStats) Validate() error {
+	if strings.TrimSpace(stats.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if err := sdk.VerifyAddressFormat(stats.OracleAddress); err != nil {
+		return fmt.Errorf("invalid oracle address: %w", err)
+	}
+	if stats.LastDeviation.IsNil() || stats.LastDeviation.IsNegative() {
+		return fmt.Errorf("last deviation must be non-negative %s", stats.LastDeviation)
+	}
+	if stats.TotalDeviation.IsNil() || stats.TotalDeviation.IsNegative() {
+		return fmt.Errorf("total deviation must be non-negative %s", stats.TotalDeviation)
+	}
+	if stats.MissedWindows > stats.TotalMissedWindows {
+		return fmt.Errorf("missed windows %d exceeds total missed windows %d", stats.MissedWindows, stats.TotalMissedWindows)
+	}
+	return nil
+}
+
+// AverageDeviation returns the oracle's mean deviation from the median price over all counted updates
+func (stats OracleStats) AverageDeviation() sdk.Dec {
+	if stats.PricesCounted == 0 {
+		return sdk.ZeroDec()
+	}
+	return stats.TotalDeviation.QuoInt64(int64(stats.PricesCounted))
+}
+
+// IsJailed returns true if the oracle cannot post prices at the given time
+func (stats OracleStats) IsJailed(blockTime time.Time) bool {
+	return blockTime.Before(stats.JailedUntil)
+}
+
+// ToOracleStatsResponse returns a new OracleStatsResponse from an OracleStats
+func (stats OracleStats) ToOracleStatsResponse(blockTime time.Time) OracleStatsResponse {
+	return OracleStatsResponse{
+		MarketID:           stats.MarketID,
+		OracleAddress:      stats.OracleAddress.String(),
+		PricesCounted:      stats.PricesCounted,
+		MissedWindows:      stats.MissedWindows,
+		TotalMissedWindows: stats.TotalMissedWindows,
+		LastDeviation:      stats.LastDeviation,
+		AverageDeviation:   stats.AverageDeviation(),
+		JailCount:          stats.JailCount,
+		JailedUntil:        stats.JailedUntil,
+		Jailed:             stats.IsJailed(blockTime),
+	}
+}
+
+// OracleStatsList is a slice of OracleStats
+type OracleStatsList []OracleStats
+
+// Validate checks if all the oracle stats are valid and there are no duplicated entries.
+func (oss OracleStatsList) Validate() error {
+	seen := make(map[string]bool)
+	for _, stats := range oss {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+		key := stats.MarketID + ":" + stats.OracleAddress.String()
+		if seen[key] {
+			return fmt.Errorf("duplicated oracle stats for oracle %s in market %s", stats.OracleAddress, stats.MarketID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// OracleStatsResponses is a slice of OracleStatsResponse
+type OracleStatsResponses []OracleStatsResponse
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestOracleStatsList_Validate(t *testing.T) {
+	oracle := sdk.AccAddress("oracle1")
+	valid := NewOracleStats("tst:usd", oracle)
+
+	invalidDeviation := NewOracleStats("tst:usd", oracle)
+	invalidDeviation.TotalDeviation = sdk.NewDec(-1)
+
+	invalidMisses := NewOracleStats("tst:usd", oracle)
+	invalidMisses.MissedWindows = 2
+	invalidMisses.TotalMissedWindows = 1
+
+	testCases := []struct {
+		msg     string
+		stats   OracleStatsList
+		expPass bool
+	}{
+		{"valid", OracleStatsList{valid, NewOracleStats("other:usd", oracle)}, true},
+		{"blank market id", OracleStatsList{NewOracleStats(" ", oracle)}, false},
+		{"empty oracle", OracleStatsList{NewOracleStats("tst:usd", nil)}, false},
+		{"nil deviation", OracleStatsList{{MarketID: "tst:usd", OracleAddress: oracle}}, false},
+		{"negative deviation", OracleStatsList{invalidDeviation}, false},
+		{"missed windows exceed total", OracleStatsList{invalidMisses}, false},
+		{"duplicate", OracleStatsList{valid, valid}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.stats.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestOracleStats_Jailed(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	stats := NewOracleStats("tst:usd", sdk.AccAddress("oracle1"))
+	require.False(t, stats.IsJailed(now))
+
+	stats.JailedUntil = now.Add(time.Hour)
+	require.True(t, stats.IsJailed(now))
+	require.False(t, stats.IsJailed(now.Add(time.Hour)))
+
+	stats.PricesCounted = 4
+	stats.TotalDeviation = sdk.NewDec(2)
+	response := stats.ToOracleStatsResponse(now)
+	require.True(t, response.Jailed)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), response.AverageDeviation)
+}
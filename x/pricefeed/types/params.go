@@ -2,26 +2,53 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = []Market{}
+	KeyMarkets                      = []byte("Markets")
+	KeyPriceHistorySize             = []byte("PriceHistorySize")
+	KeyOracleMissThreshold          = []byte("OracleMissThreshold")
+	KeyOracleDeviationThreshold     = []byte("OracleDeviationThreshold")
+	KeyOracleJailDuration           = []byte("OracleJailDuration")
+	DefaultMarkets                  = []Market{}
+	DefaultPriceHistorySize         = uint64(600)
+	MaxPriceHistorySize             = uint64(100000)
+	DefaultOracleMissThreshold      = uint64(0)
+	DefaultOracleDeviationThreshold = sdk.ZeroDec()
+	DefaultOracleJailDuration       = 24 * time.Hour
 )
 
 // NewParams creates a new AssetParams object
-func NewParams(markets []Market) Params {
+func NewParams(
+	markets []Market,
+	priceHistorySize uint64,
+	oracleMissThreshold uint64,
+	oracleDeviationThreshold sdk.Dec,
+	oracleJailDuration time.Duration,
+) Params {
 	return Params{
-		Markets: markets,
+		Markets:                  markets,
+		PriceHistorySize:         priceHistorySize,
+		OracleMissThreshold:      oracleMissThreshold,
+		OracleDeviationThreshold: oracleDeviationThreshold,
+		OracleJailDuration:       oracleJailDuration,
 	}
 }
 
 // DefaultParams default params for pricefeed
 func DefaultParams() Params {
-	return NewParams(DefaultMarkets)
+	return NewParams(
+		DefaultMarkets,
+		DefaultPriceHistorySize,
+		DefaultOracleMissThreshold,
+		DefaultOracleDeviationThreshold,
+		DefaultOracleJailDuration,
+	)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -34,12 +61,33 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		paramtypes.NewParamSetPair(KeyPriceHistorySize, &p.PriceHistorySize, validatePriceHistorySizeParam),
+		paramtypes.NewParamSetPair(KeyOracleMissThreshold, &p.OracleMissThreshold, validateOracleMissThresholdParam),
+		paramtypes.NewParamSetPair(KeyOracleDeviationThreshold, &p.OracleDeviationThreshold, validateOracleDeviationThresholdParam),
+		paramtypes.NewParamSetPair(KeyOracleJailDuration, &p.OracleJailDuration, validateOracleJailDurationParam),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+	if err := validatePriceHistorySizeParam(p.PriceHistorySize); err != nil {
+		return err
+	}
+	if err := validateOracleMissThresholdParam(p.OracleMissThreshold); err != nil {
+		return err
+	}
+	if err := validateOracleDeviationThresholdParam(p.OracleDeviationThreshold); err != nil {
+		return err
+	}
+	return validateOracleJailDurationParam(p.OracleJailDuration)
+}
+
+// OracleDeviationCheckEnabled returns true if oracles are penalized for prices far from the median
+func (p Params) OracleDeviationCheckEnabled() bool {
+	return !p.OracleDeviationThreshold.IsNil() && p.OracleDeviationThreshold.IsPositive()
 }
 
 func validateMarketParams(i interface{}) error {
@@ -50,3 +98,48 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validatePriceHistorySizeParam(i interface{}) error {
+	size, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if size > MaxPriceHistorySize {
+		return fmt.Errorf("price history size %d exceeds maximum %d", size, MaxPriceHistorySize)
+	}
+	return nil
+}
+
+func validateOracleMissThresholdParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateOracleDeviationThresholdParam(i interface{}) error {
+	threshold, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !threshold.IsNil() && threshold.IsNegative() {
+		return fmt.Errorf("oracle deviation threshold cannot be negative %s", threshold)
+	}
+	return nil
+}
+
+func validateOracleJailDurationParam(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration < 0 {
+		return fmt.Errorf("oracle jail duration cannot be negative %s", duration)
+	}
+	return nil
+}
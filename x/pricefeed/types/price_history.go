@@ -0,0 +1,168 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// VirtualMarketTWAP is the averaging method for virtual markets priced by time-weighted average
+	VirtualMarketTWAP = "twap"
+	// VirtualMarketEMA is the averaging method for virtual markets priced by exponential moving average
+	VirtualMarketEMA = "ema"
+)
+
+// ParseVirtualMarketID splits a virtual market id of the form "<market id>:<method>:<window>",
+// eg "bnb:usd:twap:30m", into the underlying market id, the averaging method and the window.
+// It returns false if the market id is not a virtual market id.
+func ParseVirtualMarketID(marketID string) (string, string, time.Duration, bool) {
+	parts := strings.Split(marketID, ":")
+	if len(parts) < 3 {
+		return "", "", 0, false
+	}
+	method := parts[len(parts)-2]
+	if method != VirtualMarketTWAP && method != VirtualMarketEMA {
+		return "", "", 0, false
+	}
+	window, err := time.ParseDuration(parts[len(parts)-1])
+	if err != nil || window <= 0 {
+		return "", "", 0, false
+	}
+	baseMarketID := strings.Join(parts[:len(parts)-2], ":")
+	if strings.TrimSpace(baseMarketID) == "" {
+		return "", "", 0, false
+	}
+	return baseMarketID, method, window, true
+}
+
+// NewPriceSnapshot returns a new PriceSnapshot
+func NewPriceSnapshot(marketID string, price sdk.Dec, timestamp time.Time) PriceSnapshot {
+	return PriceSnapshot{
+		MarketID:  marketID,
+		Price:     price,
+		Timestamp: timestamp,
+	}
+}
+
+// Validate performs a basic check of a PriceSnapshot.
+func (ps PriceSnapshot) Validate() error {
+	if strings.TrimSpace(ps.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if ps.Price.IsNil() || !ps.Price.IsPositive() {
+		return fmt.Errorf("snapshot price must be positive %s", ps.Price)
+	}
+	if ps.Timestamp.Unix() <= 0 {
+		return errors.New("snapshot timestamp cannot be zero")
+	}
+	return nil
+}
+
+// PriceSnapshots is a slice of PriceSnapshot
+type PriceSnapshots []PriceSnapshot
+
+// Validate checks if all the snapshots are valid and that each market's snapshots are in
+// strictly increasing time order.
+func (pss PriceSnapshots) Validate() error {
+	lastTimestamps := make(map[string]time.Time)
+	for _, ps := range pss {
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+		if last, found := lastTimestamps[ps.MarketID]; found && !ps.Timestamp.After(last) {
+			return fmt.Errorf("price snapshots for market %s are not in increasing time order", ps.MarketID)
+		}
+		lastTimestamps[ps.MarketID] = ps.Timestamp
+	}
+	return nil
+}
+
+// TWAP returns the time-weighted average price over the window ending at blockTime.
+// Snapshots must belong to one market and be sorted by time. Each snapshot's price is weighted
+// by how long it was current, until the next snapshot or blockTime. If the history is shorter
+// than the window the average covers the available history.
+// It returns false if there are no snapshots at or before blockTime.
+func (pss PriceSnapshots) TWAP(blockTime time.Time, window time.Duration) (sdk.Dec, bool) {
+	snapshots, start := pss.inWindow(blockTime, window)
+	if len(snapshots) == 0 {
+		return sdk.Dec{}, false
+	}
+
+	weightedSum := sdk.ZeroDec()
+	totalWeight := sdk.ZeroDec()
+	for i, ps := range snapshots {
+		weight := sdk.NewDec(snapshots.periodAt(i, start, blockTime).Nanoseconds())
+		weightedSum = weightedSum.Add(ps.Price.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	// all snapshots were taken at blockTime, so none have been current for any time yet
+	if totalWeight.IsZero() {
+		return snapshots[len(snapshots)-1].Price, true
+	}
+	return weightedSum.Quo(totalWeight), true
+}
+
+// EMA returns the exponential moving average price over the window ending at blockTime.
+// Snapshots must belong to one market and be sorted by time. The average starts at the oldest
+// snapshot in the window and moves towards each snapshot's price by the fraction of the window
+// that snapshot was current for.
+// It returns false if there are no snapshots at or before blockTime.
+func (pss PriceSnapshots) EMA(blockTime time.Time, window time.Duration) (sdk.Dec, bool) {
+	snapshots, start := pss.inWindow(blockTime, window)
+	if len(snapshots) == 0 {
+		return sdk.Dec{}, false
+	}
+
+	ema := snapshots[0].Price
+	for i, ps := range snapshots {
+		period := snapshots.periodAt(i, start, blockTime)
+		if period > window {
+			period = window
+		}
+		ema = ema.Add(ps.Price.Sub(ema).MulInt64(period.Nanoseconds()).QuoInt64(window.Nanoseconds()))
+	}
+	return ema, true
+}
+
+// inWindow returns the snapshots that were current at some point in the window ending at blockTime,
+// along with the start of the window.
+func (pss PriceSnapshots) inWindow(blockTime time.Time, window time.Duration) (PriceSnapshots, time.Time) {
+	start := blockTime.Add(-window)
+
+	first, end := 0, len(pss)
+	for i, ps := range pss {
+		if ps.Timestamp.After(blockTime) {
+			end = i
+			break
+		}
+		// the latest snapshot at or before the start of the window was current when the window started
+		if !ps.Timestamp.After(start) {
+			first = i
+		}
+	}
+	if end == 0 {
+		return nil, start
+	}
+	return pss[first:end], start
+}
+
+// periodAt returns how long the snapshot at index i was current within the window from start to blockTime.
+func (pss PriceSnapshots) periodAt(i int, start, blockTime time.Time) time.Duration {
+	from := pss[i].Timestamp
+	if from.Before(start) {
+		from = start
+	}
+	to := blockTime
+	if i+1 < len(pss) {
+		to = pss[i+1].Timestamp
+	}
+	if to.Before(from) {
+		return 0
+	}
+	return to.Sub(from)
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseVirtualMarketID(t *testing.T) {
+	testCases := []struct {
+		marketID       string
+		expectedBaseID string
+		expectedMethod string
+		expectedWindow time.Duration
+		expectedOk     bool
+	}{
+		{"bnb:usd:twap:30m", "bnb:usd", VirtualMarketTWAP, 30 * time.Minute, true},
+		{"bnb:usd:ema:1h", "bnb:usd", VirtualMarketEMA, time.Hour, true},
+		{"bnb:usd:30", "", "", 0, false},
+		{"bnb:usd", "", "", 0, false},
+		{"bnb:usd:twap:0s", "", "", 0, false},
+		{"bnb:usd:twap:-1m", "", "", 0, false},
+		{"bnb:usd:twap:forever", "", "", 0, false},
+		{":twap:30m", "", "", 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.marketID, func(t *testing.T) {
+			baseID, method, window, ok := ParseVirtualMarketID(tc.marketID)
+			require.Equal(t, tc.expectedOk, ok)
+			require.Equal(t, tc.expectedBaseID, baseID)
+			require.Equal(t, tc.expectedMethod, method)
+			require.Equal(t, tc.expectedWindow, window)
+		})
+	}
+}
+
+func TestPriceSnapshots_TWAP(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := PriceSnapshots{
+		NewPriceSnapshot("tst:usd", sdk.NewDec(100), start),
+		NewPriceSnapshot("tst:usd", sdk.NewDec(10), start.Add(10*time.Minute)),
+		NewPriceSnapshot("tst:usd", sdk.NewDec(40), start.Add(20*time.Minute)),
+	}
+
+	testCases := []struct {
+		name          string
+		blockTime     time.Time
+		window        time.Duration
+		expectedPrice sdk.Dec
+		expectedOk    bool
+	}{
+		{"window covers all snapshots", start.Add(30 * time.Minute), 30 * time.Minute, sdk.NewDec(50), true},
+		{"window starts between snapshots", start.Add(30 * time.Minute), 15 * time.Minute, sdk.NewDec(30), true},
+		{"window longer than history", start.Add(30 * time.Minute), time.Hour, sdk.NewDec(50), true},
+		{"snapshots after block time are ignored", start.Add(20 * time.Minute), 20 * time.Minute, sdk.NewDec(55), true},
+		{"only snapshot at block time", start, time.Minute, sdk.NewDec(100), true},
+		{"no snapshots before block time", start.Add(-time.Minute), time.Minute, sdk.Dec{}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, ok := snapshots.TWAP(tc.blockTime, tc.window)
+			require.Equal(t, tc.expectedOk, ok)
+			require.Equal(t, tc.expectedPrice, price)
+		})
+	}
+}
+
+func TestPriceSnapshots_EMA(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := PriceSnapshots{
+		NewPriceSnapshot("tst:usd", sdk.NewDec(10), start),
+		NewPriceSnapshot("tst:usd", sdk.NewDec(40), start.Add(20*time.Minute)),
+	}
+
+	price, ok := snapshots.EMA(start.Add(30*time.Minute), 30*time.Minute)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(20), price)
+
+	// a price current for longer than the window replaces the average
+	price, ok = snapshots.EMA(start.Add(time.Hour), 30*time.Minute)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(40), price)
+
+	_, ok = PriceSnapshots{}.EMA(start, 30*time.Minute)
+	require.False(t, ok)
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -33,6 +34,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the pricefeed module.
 type Params struct {
 	Markets Markets `protobuf:"bytes,1,rep,name=markets,proto3,castrepeated=Markets" json:"markets"`
+	// price_history_size is the number of per-block price snapshots kept for each market.
+	// TWAP and EMA prices are calculated from these snapshots. Zero disables price history.
+	PriceHistorySize uint64 `protobuf:"varint,2,opt,name=price_history_size,json=priceHistorySize,proto3" json:"price_history_size,omitempty"`
+	// oracle_miss_threshold is the number of consecutive price updates an oracle can miss before it is penalized.
+	// Zero disables the check.
+	OracleMissThreshold uint64 `protobuf:"varint,3,opt,name=oracle_miss_threshold,json=oracleMissThreshold,proto3" json:"oracle_miss_threshold,omitempty"`
+	// oracle_deviation_threshold is the largest relative difference between an oracle's price and the median
+	// price before the oracle is penalized. Zero disables the check.
+	OracleDeviationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=oracle_deviation_threshold,json=oracleDeviationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oracle_deviation_threshold"`
+	// oracle_jail_duration is how long a penalized oracle is jailed for. Zero removes penalized oracles from
+	// their market instead.
+	OracleJailDuration time.Duration `protobuf:"bytes,5,opt,name=oracle_jail_duration,json=oracleJailDuration,proto3,stdduration" json:"oracle_jail_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -75,6 +88,27 @@ func (m *Params) GetMarkets() Markets {
 	return nil
 }
 
+func (m *Params) GetPriceHistorySize() uint64 {
+	if m != nil {
+		return m.PriceHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetOracleMissThreshold() uint64 {
+	if m != nil {
+		return m.OracleMissThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetOracleJailDuration() time.Duration {
+	if m != nil {
+		return m.OracleJailDuration
+	}
+	return 0
+}
+
 // Market defines an asset in the pricefeed.
 type Market struct {
 	MarketID   string                                          `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -82,6 +116,22 @@ type Market struct {
 	QuoteAsset string                                          `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	Oracles    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=oracles,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracles,omitempty"`
 	Active     bool                                            `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// max_price_deviation is the largest relative change of the current price allowed in one block.
+	// A larger change freezes the market at its last price. Zero disables the check.
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+	// min_oracle_count is the number of fresh oracle prices required for a valid price.
+	// Fewer prices freezes the market at its last price. Zero disables the check.
+	MinOracleCount uint64 `protobuf:"varint,7,opt,name=min_oracle_count,json=minOracleCount,proto3" json:"min_oracle_count,omitempty"`
+	// max_price_age is how long after posting an oracle price counts towards the current price.
+	// If only older prices remain the market freezes at its last price. Zero disables the check.
+	MaxPriceAge time.Duration `protobuf:"bytes,8,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+	// swap_price_source adds the time-weighted average price of a swap pool to the market's oracle prices.
+	// The swap price does not count towards min_oracle_count. Empty disables the swap price.
+	SwapPriceSource *SwapPriceSource `protobuf:"bytes,9,opt,name=swap_price_source,json=swapPriceSource,proto3" json:"swap_price_source,omitempty"`
+	// price_recovery_updates is the number of consecutive updates a median price beyond max_price_deviation
+	// must stay within max_price_deviation of its first value before it replaces the frozen price.
+	// Required when max_price_deviation is set.
+	PriceRecoveryUpdates uint64 `protobuf:"varint,10,opt,name=price_recovery_updates,json=priceRecoveryUpdates,proto3" json:"price_recovery_updates,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -152,19 +202,115 @@ func (m *Market) GetActive() bool {
 	return false
 }
 
+func (m *Market) GetMinOracleCount() uint64 {
+	if m != nil {
+		return m.MinOracleCount
+	}
+	return 0
+}
+
+func (m *Market) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Market) GetSwapPriceSource() *SwapPriceSource {
+	if m != nil {
+		return m.SwapPriceSource
+	}
+	return nil
+}
+
+func (m *Market) GetPriceRecoveryUpdates() uint64 {
+	if m != nil {
+		return m.PriceRecoveryUpdates
+	}
+	return 0
+}
+
+// SwapPriceSource defines a swap pool used as a price input for a market.
+type SwapPriceSource struct {
+	// pool_id is the id of the swap pool, for example "ukava:usdx".
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// denom is the pool token that is priced in units of the other pool token.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window is the duration the pool price is averaged over.
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	// conversion_factor converts the pool price into the market's price, accounting for differences
+	// in denom precision or between the quote asset and the other pool token.
+	ConversionFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_factor"`
+}
+
+func (m *SwapPriceSource) Reset()         { *m = SwapPriceSource{} }
+func (m *SwapPriceSource) String() string { return proto.CompactTextString(m) }
+func (*SwapPriceSource) ProtoMessage()    {}
+func (*SwapPriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{2}
+}
+func (m *SwapPriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapPriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapPriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapPriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapPriceSource.Merge(m, src)
+}
+func (m *SwapPriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapPriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapPriceSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapPriceSource proto.InternalMessageInfo
+
+func (m *SwapPriceSource) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *SwapPriceSource) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SwapPriceSource) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 // PostedPrice defines a price for market posted by a specific oracle.
 type PostedPrice struct {
 	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
 	Price         github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 	Expiry        time.Time                                     `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	PostedAt      time.Time                                     `protobuf:"bytes,5,opt,name=posted_at,json=postedAt,proto3,stdtime" json:"posted_at"`
 }
 
 func (m *PostedPrice) Reset()         { *m = PostedPrice{} }
 func (m *PostedPrice) String() string { return proto.CompactTextString(m) }
 func (*PostedPrice) ProtoMessage()    {}
 func (*PostedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{2}
+	return fileDescriptor_9df40639f5e16f9a, []int{3}
 }
 func (m *PostedPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,6 +360,13 @@ func (m *PostedPrice) GetExpiry() time.Time {
 	return time.Time{}
 }
 
+func (m *PostedPrice) GetPostedAt() time.Time {
+	if m != nil {
+		return m.PostedAt
+	}
+	return time.Time{}
+}
+
 // CurrentPrice defines a current price for a particular market in the pricefeed
 // module.
 type CurrentPrice struct {
@@ -225,7 +378,7 @@ func (m *CurrentPrice) Reset()         { *m = CurrentPrice{} }
 func (m *CurrentPrice) String() string { return proto.CompactTextString(m) }
 func (*CurrentPrice) ProtoMessage()    {}
 func (*CurrentPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{3}
+	return fileDescriptor_9df40639f5e16f9a, []int{4}
 }
 func (m *CurrentPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,11 +414,226 @@ func (m *CurrentPrice) GetMarketID() string {
 	return ""
 }
 
+// PriceRecovery defines a median price beyond a frozen market's max_price_deviation and the number of
+// consecutive updates it has stayed within max_price_deviation of that price.
+type PriceRecovery struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Updates  uint64                                 `protobuf:"varint,3,opt,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (m *PriceRecovery) Reset()         { *m = PriceRecovery{} }
+func (m *PriceRecovery) String() string { return proto.CompactTextString(m) }
+func (*PriceRecovery) ProtoMessage()    {}
+func (*PriceRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{5}
+}
+func (m *PriceRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRecovery.Merge(m, src)
+}
+func (m *PriceRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRecovery proto.InternalMessageInfo
+
+func (m *PriceRecovery) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PriceRecovery) GetUpdates() uint64 {
+	if m != nil {
+		return m.Updates
+	}
+	return 0
+}
+
+// PriceSnapshot defines the current price of a market recorded at a block time.
+type PriceSnapshot struct {
+	MarketID  string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Timestamp time.Time                              `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{6}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
+}
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PriceSnapshot) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// OracleStats defines the accuracy record of an oracle for a market.
+type OracleStats struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	// prices_counted is the number of price updates that included the oracle's price.
+	PricesCounted uint64 `protobuf:"varint,3,opt,name=prices_counted,json=pricesCounted,proto3" json:"prices_counted,omitempty"`
+	// missed_windows is the number of consecutive price updates without a fresh price from the oracle.
+	MissedWindows uint64 `protobuf:"varint,4,opt,name=missed_windows,json=missedWindows,proto3" json:"missed_windows,omitempty"`
+	// total_missed_windows is the number of price updates without a fresh price from the oracle.
+	TotalMissedWindows uint64 `protobuf:"varint,5,opt,name=total_missed_windows,json=totalMissedWindows,proto3" json:"total_missed_windows,omitempty"`
+	// last_deviation is the relative difference between the oracle's price and the median in the last counted update.
+	LastDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=last_deviation,json=lastDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_deviation"`
+	// total_deviation is the sum of the oracle's deviations over all counted updates.
+	TotalDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=total_deviation,json=totalDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_deviation"`
+	// jail_count is the number of times the oracle has been jailed.
+	JailCount uint64 `protobuf:"varint,8,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+	// jailed_until is the time the oracle can post prices again.
+	JailedUntil time.Time `protobuf:"bytes,9,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *OracleStats) Reset()         { *m = OracleStats{} }
+func (m *OracleStats) String() string { return proto.CompactTextString(m) }
+func (*OracleStats) ProtoMessage()    {}
+func (*OracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{7}
+}
+func (m *OracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStats.Merge(m, src)
+}
+func (m *OracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleStats proto.InternalMessageInfo
+
+func (m *OracleStats) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *OracleStats) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return nil
+}
+
+func (m *OracleStats) GetPricesCounted() uint64 {
+	if m != nil {
+		return m.PricesCounted
+	}
+	return 0
+}
+
+func (m *OracleStats) GetMissedWindows() uint64 {
+	if m != nil {
+		return m.MissedWindows
+	}
+	return 0
+}
+
+func (m *OracleStats) GetTotalMissedWindows() uint64 {
+	if m != nil {
+		return m.TotalMissedWindows
+	}
+	return 0
+}
+
+func (m *OracleStats) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
+func (m *OracleStats) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.pricefeed.v1beta1.Params")
 	proto.RegisterType((*Market)(nil), "kava.pricefeed.v1beta1.Market")
+	proto.RegisterType((*SwapPriceSource)(nil), "kava.pricefeed.v1beta1.SwapPriceSource")
 	proto.RegisterType((*PostedPrice)(nil), "kava.pricefeed.v1beta1.PostedPrice")
 	proto.RegisterType((*CurrentPrice)(nil), "kava.pricefeed.v1beta1.CurrentPrice")
+	proto.RegisterType((*PriceRecovery)(nil), "kava.pricefeed.v1beta1.PriceRecovery")
+	proto.RegisterType((*PriceSnapshot)(nil), "kava.pricefeed.v1beta1.PriceSnapshot")
+	proto.RegisterType((*OracleStats)(nil), "kava.pricefeed.v1beta1.OracleStats")
 }
 
 func init() {
@@ -273,39 +641,72 @@ func init() {
 }
 
 var fileDescriptor_9df40639f5e16f9a = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0x6d, 0xfe, 0x5c, 0x02, 0x48, 0x06, 0x55, 0x26, 0x12, 0x76, 0x94, 0x01, 0x19,
-	0xa1, 0x9c, 0xd5, 0xb2, 0xb2, 0xc4, 0x64, 0x20, 0x43, 0xa5, 0xc8, 0x30, 0xb1, 0x44, 0x67, 0xfb,
-	0xd5, 0x58, 0x89, 0x39, 0x73, 0x77, 0x89, 0x9a, 0x89, 0xaf, 0xd0, 0x8f, 0x81, 0x90, 0xd8, 0xf8,
-	0x10, 0x1d, 0x2b, 0x26, 0xc4, 0x90, 0x16, 0xe7, 0x03, 0xb0, 0x33, 0x21, 0xdf, 0xd9, 0x55, 0x07,
-	0x06, 0x2a, 0x98, 0xee, 0xde, 0xef, 0xfd, 0xde, 0xbf, 0xdf, 0xbd, 0xc3, 0xc3, 0x05, 0x5d, 0x53,
-	0x37, 0xe3, 0x49, 0x08, 0x27, 0x00, 0x91, 0xbb, 0x3e, 0x0c, 0x40, 0xd2, 0x43, 0x57, 0x48, 0xc6,
-	0x81, 0x64, 0x9c, 0x49, 0x66, 0x1c, 0x14, 0x1c, 0x72, 0xcd, 0x21, 0x25, 0xa7, 0xff, 0x30, 0x64,
-	0x22, 0x65, 0x62, 0xae, 0x58, 0xae, 0x36, 0x74, 0x48, 0xff, 0x41, 0xcc, 0x62, 0xa6, 0xf1, 0xe2,
-	0x56, 0xa2, 0x76, 0xcc, 0x58, 0xbc, 0x04, 0x57, 0x59, 0xc1, 0xea, 0xc4, 0x95, 0x49, 0x0a, 0x42,
-	0xd2, 0x34, 0xd3, 0x84, 0xe1, 0x2b, 0xdc, 0x9c, 0x51, 0x4e, 0x53, 0x61, 0x4c, 0x71, 0x2b, 0xa5,
-	0x7c, 0x01, 0x52, 0x98, 0x68, 0xd0, 0x70, 0xba, 0x47, 0x16, 0xf9, 0x73, 0x17, 0xe4, 0x58, 0xd1,
-	0xbc, 0x7b, 0xe7, 0x5b, 0xbb, 0xf6, 0xe9, 0xd2, 0x6e, 0x69, 0x5b, 0xf8, 0x55, 0xfc, 0xf0, 0x27,
-	0xc2, 0x4d, 0x0d, 0x1a, 0x4f, 0x70, 0x47, 0xa3, 0xf3, 0x24, 0x32, 0xd1, 0x00, 0x39, 0x1d, 0xaf,
-	0x97, 0x6f, 0xed, 0xb6, 0x76, 0x4f, 0x27, 0x7e, 0x5b, 0xbb, 0xa7, 0x91, 0xf1, 0x08, 0xe3, 0x80,
-	0x0a, 0x98, 0x53, 0x21, 0x40, 0x9a, 0xf5, 0x82, 0xeb, 0x77, 0x0a, 0x64, 0x5c, 0x00, 0x86, 0x8d,
-	0xbb, 0xef, 0x57, 0x4c, 0x56, 0xfe, 0x86, 0xf2, 0x63, 0x05, 0x69, 0x42, 0x80, 0x5b, 0x8c, 0xd3,
-	0x70, 0x09, 0xc2, 0xdc, 0x1b, 0x34, 0x9c, 0x9e, 0xf7, 0xf2, 0xd7, 0xd6, 0x1e, 0xc5, 0x89, 0x7c,
-	0xbb, 0x0a, 0x48, 0xc8, 0xd2, 0x52, 0xaf, 0xf2, 0x18, 0x89, 0x68, 0xe1, 0xca, 0x4d, 0x06, 0x82,
-	0x8c, 0xc3, 0x70, 0x1c, 0x45, 0x1c, 0x84, 0xf8, 0xfa, 0x65, 0x74, 0xbf, 0x54, 0xb5, 0x44, 0xbc,
-	0x8d, 0x04, 0xe1, 0x57, 0x89, 0x8d, 0x03, 0xdc, 0xa4, 0xa1, 0x4c, 0xd6, 0x60, 0xee, 0x0f, 0x90,
-	0xd3, 0xf6, 0x4b, 0x6b, 0xf8, 0xb9, 0x8e, 0xbb, 0x33, 0x26, 0x24, 0x44, 0xb3, 0x42, 0xae, 0xdb,
-	0x8c, 0xcd, 0xf0, 0x5d, 0x9d, 0x7d, 0x4e, 0x75, 0x49, 0x35, 0xfa, 0xff, 0xec, 0xfe, 0x8e, 0xce,
-	0x5f, 0x62, 0xc6, 0x04, 0xef, 0xab, 0x37, 0xd5, 0x12, 0x7a, 0xa4, 0x78, 0xc6, 0xef, 0x5b, 0xfb,
-	0xf1, 0x5f, 0xd4, 0x9a, 0x40, 0xe8, 0xeb, 0x60, 0xe3, 0x39, 0x6e, 0xc2, 0x69, 0x96, 0xf0, 0x8d,
-	0xb9, 0x37, 0x40, 0x4e, 0xf7, 0xa8, 0x4f, 0xf4, 0xaa, 0x91, 0x6a, 0xd5, 0xc8, 0xeb, 0x6a, 0xd5,
-	0xbc, 0x76, 0x51, 0xe2, 0xec, 0xd2, 0x46, 0x7e, 0x19, 0x33, 0xfc, 0x80, 0x7b, 0x2f, 0x56, 0x9c,
-	0xc3, 0x3b, 0x79, 0x6b, 0xbd, 0xae, 0xdb, 0xaf, 0xff, 0x43, 0xfb, 0xde, 0xf1, 0xd5, 0x0f, 0x0b,
-	0x7d, 0xcc, 0x2d, 0x74, 0x9e, 0x5b, 0xe8, 0x22, 0xb7, 0xd0, 0x55, 0x6e, 0xa1, 0xb3, 0x9d, 0x55,
-	0xbb, 0xd8, 0x59, 0xb5, 0x6f, 0x3b, 0xab, 0xf6, 0xe6, 0xe9, 0x8d, 0x84, 0xc5, 0x47, 0x18, 0x2d,
-	0x69, 0x20, 0xd4, 0xcd, 0x3d, 0xbd, 0xf1, 0x7d, 0x55, 0xe6, 0xa0, 0xa9, 0xa6, 0x7e, 0xf6, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0x18, 0xb5, 0x5b, 0xc1, 0xdd, 0x03, 0x00, 0x00,
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x9b, 0xff, 0x2f, 0x4d, 0xda, 0x4e, 0x43, 0xe5, 0xad, 0xb4, 0x4e, 0x14, 0x04, 0x04,
+	0x41, 0x1d, 0xb6, 0x70, 0x83, 0x4b, 0xd2, 0x08, 0x36, 0x48, 0x15, 0x91, 0xb3, 0xd5, 0x4a, 0x20,
+	0x61, 0x4d, 0xec, 0x69, 0x62, 0x6a, 0x7b, 0x8c, 0x67, 0x92, 0x36, 0x7b, 0xe1, 0x2b, 0xec, 0x11,
+	0xf1, 0x09, 0x10, 0x67, 0xbe, 0x00, 0x12, 0x87, 0xbd, 0x20, 0xad, 0x38, 0x21, 0x0e, 0xd9, 0x25,
+	0x95, 0x38, 0x73, 0xde, 0x13, 0xf2, 0x8c, 0x9d, 0x74, 0x0b, 0x48, 0x4d, 0x17, 0xad, 0xf6, 0x94,
+	0xcc, 0xfb, 0xbd, 0xf7, 0xf3, 0x7b, 0xbf, 0xf7, 0x9e, 0xc7, 0x50, 0x3f, 0xc5, 0x13, 0xdc, 0x0c,
+	0x42, 0xc7, 0x22, 0x27, 0x84, 0xd8, 0xcd, 0xc9, 0x9d, 0x01, 0xe1, 0xf8, 0x4e, 0x93, 0x71, 0x1a,
+	0x12, 0x3d, 0x08, 0x29, 0xa7, 0x68, 0x37, 0xf2, 0xd1, 0x17, 0x3e, 0x7a, 0xec, 0xb3, 0x77, 0xcb,
+	0xa2, 0xcc, 0xa3, 0xcc, 0x14, 0x5e, 0x4d, 0x79, 0x90, 0x21, 0x7b, 0x95, 0x21, 0x1d, 0x52, 0x69,
+	0x8f, 0xfe, 0xc5, 0x56, 0x6d, 0x48, 0xe9, 0xd0, 0x25, 0x4d, 0x71, 0x1a, 0x8c, 0x4f, 0x9a, 0xf6,
+	0x38, 0xc4, 0xdc, 0xa1, 0x7e, 0x8c, 0x57, 0xaf, 0xe2, 0xdc, 0xf1, 0x08, 0xe3, 0xd8, 0x0b, 0xa4,
+	0x43, 0xfd, 0xd9, 0x3a, 0x64, 0x7b, 0x38, 0xc4, 0x1e, 0x43, 0x5d, 0xc8, 0x79, 0x38, 0x3c, 0x25,
+	0x9c, 0xa9, 0x4a, 0x2d, 0xd5, 0x28, 0x1e, 0x68, 0xfa, 0xbf, 0xa7, 0xa9, 0x1f, 0x09, 0xb7, 0xf6,
+	0xe6, 0xa3, 0x59, 0x75, 0xed, 0x87, 0x27, 0xd5, 0x9c, 0x3c, 0x33, 0x23, 0x89, 0x47, 0xef, 0x02,
+	0x12, 0x51, 0xe6, 0xc8, 0x89, 0xca, 0x9e, 0x9a, 0xcc, 0x79, 0x40, 0xd4, 0xf5, 0x9a, 0xd2, 0x48,
+	0x1b, 0x5b, 0x02, 0xb9, 0x2b, 0x81, 0xbe, 0xf3, 0x80, 0xa0, 0x03, 0x78, 0x8d, 0x86, 0xd8, 0x72,
+	0x89, 0xe9, 0x39, 0x8c, 0x99, 0x7c, 0x14, 0x12, 0x36, 0xa2, 0xae, 0xad, 0xa6, 0x44, 0xc0, 0x8e,
+	0x04, 0x8f, 0x1c, 0xc6, 0xee, 0x25, 0x10, 0x72, 0x61, 0x2f, 0x8e, 0xb1, 0xc9, 0xc4, 0x11, 0x25,
+	0x5f, 0x0a, 0x4c, 0xd7, 0x94, 0x46, 0xa1, 0xad, 0x47, 0xf9, 0xfd, 0x3e, 0xab, 0xbe, 0x39, 0x74,
+	0xf8, 0x68, 0x3c, 0xd0, 0x2d, 0xea, 0xc5, 0x9a, 0xc6, 0x3f, 0xfb, 0xcc, 0x3e, 0x6d, 0xf2, 0x69,
+	0x40, 0x98, 0xde, 0x21, 0x96, 0xa1, 0x4a, 0xc6, 0x4e, 0x42, 0xb8, 0x7c, 0xda, 0x31, 0x54, 0xe2,
+	0xa7, 0x7d, 0x85, 0x1d, 0xd7, 0x4c, 0x44, 0x56, 0x33, 0x35, 0xa5, 0x51, 0x3c, 0xb8, 0xa5, 0x4b,
+	0x95, 0xf5, 0x44, 0x65, 0xbd, 0x13, 0x3b, 0xb4, 0xf3, 0x51, 0x0a, 0xdf, 0x3e, 0xa9, 0x2a, 0x06,
+	0x92, 0x04, 0x9f, 0x62, 0xc7, 0x4d, 0xd0, 0xfa, 0x2f, 0x69, 0xc8, 0x4a, 0xed, 0xd0, 0xdb, 0x50,
+	0x90, 0xe2, 0x99, 0x8e, 0xad, 0x2a, 0x22, 0xfd, 0x8d, 0xf9, 0xac, 0x9a, 0x97, 0x70, 0xb7, 0x63,
+	0xe4, 0x25, 0xdc, 0xb5, 0xd1, 0x6d, 0x80, 0x01, 0x66, 0xc4, 0xc4, 0x8c, 0x11, 0x2e, 0x44, 0x2d,
+	0x18, 0x85, 0xc8, 0xd2, 0x8a, 0x0c, 0xa8, 0x0a, 0xc5, 0xaf, 0xc7, 0x94, 0x27, 0x78, 0x4a, 0xe0,
+	0x20, 0x4c, 0xd2, 0x61, 0x00, 0x39, 0x99, 0x0b, 0x53, 0xd3, 0xb5, 0x54, 0x63, 0xa3, 0x7d, 0xf7,
+	0xd9, 0xac, 0xba, 0x7f, 0x0d, 0x8d, 0x5a, 0x96, 0xd5, 0xb2, 0xed, 0x90, 0x30, 0xf6, 0xeb, 0x8f,
+	0xfb, 0x3b, 0x12, 0xd6, 0x63, 0x4b, 0x7b, 0xca, 0x09, 0x33, 0x12, 0x62, 0xb4, 0x0b, 0x59, 0x6c,
+	0x71, 0x67, 0x42, 0x84, 0x44, 0x79, 0x23, 0x3e, 0xa1, 0x2f, 0x61, 0xc7, 0xc3, 0xe7, 0xa6, 0x1c,
+	0x8e, 0x45, 0xe7, 0xd4, 0xec, 0x8d, 0xfa, 0xb5, 0xed, 0xe1, 0xf3, 0x5e, 0xc4, 0xb4, 0xe8, 0x18,
+	0x6a, 0xc0, 0x96, 0xe7, 0xf8, 0x66, 0xdc, 0x2c, 0x8b, 0x8e, 0x7d, 0xae, 0xe6, 0xc4, 0x14, 0x95,
+	0x3d, 0xc7, 0xff, 0x4c, 0x98, 0x0f, 0x23, 0x2b, 0xfa, 0x04, 0x4a, 0xcb, 0x4c, 0xf0, 0x90, 0xa8,
+	0xf9, 0xeb, 0xf7, 0xb2, 0x98, 0x3c, 0xb8, 0x35, 0x24, 0xa8, 0x0f, 0xdb, 0xec, 0x0c, 0x07, 0x31,
+	0x13, 0xa3, 0xe3, 0xd0, 0x22, 0x6a, 0x41, 0x90, 0xbd, 0xf5, 0x5f, 0x0b, 0xd4, 0x3f, 0xc3, 0x81,
+	0x20, 0xe8, 0x0b, 0x77, 0x63, 0x93, 0x3d, 0x6f, 0x40, 0x1f, 0xc0, 0xae, 0xe4, 0x0b, 0x89, 0x45,
+	0x27, 0x24, 0x9c, 0x9a, 0xe3, 0xc0, 0xc6, 0x9c, 0x30, 0x15, 0x44, 0x35, 0x15, 0x81, 0x1a, 0x31,
+	0x78, 0x2c, 0xb1, 0xfa, 0x9f, 0x0a, 0x6c, 0x5e, 0xa1, 0x46, 0xaf, 0x43, 0x2e, 0xa0, 0xd4, 0x5d,
+	0x8e, 0x15, 0xcc, 0x67, 0xd5, 0x6c, 0x8f, 0x52, 0xb7, 0xdb, 0x31, 0xb2, 0x11, 0xd4, 0xb5, 0x51,
+	0x05, 0x32, 0x36, 0xf1, 0xa9, 0x17, 0x4f, 0x93, 0x3c, 0xa0, 0x0f, 0x21, 0x7b, 0xe6, 0xf8, 0x36,
+	0x3d, 0x53, 0x53, 0xd7, 0xd7, 0x26, 0x0e, 0x41, 0x5f, 0xc0, 0xb6, 0x45, 0xfd, 0x09, 0x09, 0x59,
+	0xb4, 0x9a, 0x27, 0xd8, 0xe2, 0x34, 0xbc, 0xe1, 0x5e, 0x6e, 0x2d, 0x89, 0x3e, 0x16, 0x3c, 0xf5,
+	0xbf, 0xd6, 0xa1, 0xd8, 0xa3, 0x8c, 0x13, 0x5b, 0x94, 0xba, 0xca, 0xf6, 0x50, 0x28, 0xc7, 0xd3,
+	0x81, 0xe5, 0xe4, 0x8a, 0x9a, 0xff, 0xcf, 0x25, 0x28, 0x49, 0xfe, 0xd8, 0x86, 0x3a, 0x90, 0x11,
+	0xcd, 0x52, 0x53, 0x37, 0x2a, 0x5e, 0x06, 0xa3, 0x8f, 0x20, 0x4b, 0xce, 0x03, 0x27, 0x9c, 0x0a,
+	0x0d, 0x8b, 0x07, 0x7b, 0xff, 0xe8, 0xc5, 0xbd, 0xe4, 0xcd, 0x2e, 0x9b, 0xf1, 0x50, 0x34, 0x43,
+	0xc6, 0xa0, 0x16, 0x14, 0x02, 0x21, 0x97, 0x89, 0xb9, 0x9a, 0x59, 0x81, 0x20, 0x2f, 0xc3, 0x5a,
+	0xbc, 0xfe, 0x0d, 0x6c, 0x1c, 0x8e, 0xc3, 0x90, 0xf8, 0x7c, 0x65, 0xc9, 0x17, 0x0a, 0xac, 0xbf,
+	0x80, 0x02, 0xf5, 0xef, 0x14, 0x28, 0xf5, 0x2e, 0x4f, 0xfd, 0x4b, 0x4f, 0x01, 0xa9, 0x90, 0x4b,
+	0xd6, 0x50, 0x5e, 0x4d, 0xc9, 0xb1, 0xfe, 0x73, 0x92, 0x5c, 0xdf, 0xc7, 0x01, 0x1b, 0x51, 0xfe,
+	0xf2, 0x93, 0x6b, 0x43, 0x61, 0x71, 0xb9, 0xab, 0xa9, 0x15, 0x7a, 0xbc, 0x0c, 0xab, 0xff, 0x94,
+	0x86, 0xa2, 0x7c, 0x49, 0xf6, 0x39, 0xe6, 0xec, 0x95, 0xde, 0xab, 0x37, 0xa0, 0x2c, 0x0a, 0x67,
+	0xf2, 0x35, 0x4f, 0x92, 0xcf, 0x85, 0x92, 0xb4, 0x1e, 0x4a, 0x63, 0xe4, 0x16, 0x7d, 0x55, 0x10,
+	0xdb, 0x94, 0x2f, 0x26, 0x26, 0x16, 0x28, 0x6d, 0x94, 0xa4, 0xf5, 0xbe, 0x34, 0xa2, 0xf7, 0xa0,
+	0xc2, 0x29, 0xc7, 0xae, 0x79, 0xc5, 0x39, 0x23, 0x9c, 0x91, 0xc0, 0x8e, 0x9e, 0x8b, 0x38, 0x86,
+	0xb2, 0x8b, 0x19, 0x7f, 0xe1, 0x5b, 0xac, 0x14, 0xb1, 0x2c, 0x6f, 0xb0, 0xfb, 0xb0, 0x29, 0x13,
+	0x59, 0xf2, 0xe6, 0x6e, 0xc4, 0x5b, 0x16, 0x34, 0x4b, 0xe2, 0xdb, 0x00, 0xe2, 0xe3, 0x45, 0x5e,
+	0x8a, 0x79, 0x51, 0x57, 0x21, 0xb2, 0x24, 0xf7, 0xe1, 0x46, 0x74, 0x20, 0xb6, 0x39, 0xf6, 0xb9,
+	0xe3, 0xaa, 0x85, 0x15, 0x26, 0xa8, 0x28, 0x23, 0x8f, 0xa3, 0xc0, 0xf6, 0xd1, 0xd3, 0x3f, 0x34,
+	0xe5, 0xfb, 0xb9, 0xa6, 0x3c, 0x9a, 0x6b, 0xca, 0xe3, 0xb9, 0xa6, 0x3c, 0x9d, 0x6b, 0xca, 0xc3,
+	0x0b, 0x6d, 0xed, 0xf1, 0x85, 0xb6, 0xf6, 0xdb, 0x85, 0xb6, 0xf6, 0xf9, 0x3b, 0x97, 0x2a, 0x88,
+	0x2e, 0xc8, 0x7d, 0x17, 0x0f, 0x98, 0xf8, 0xd7, 0x3c, 0xbf, 0xf4, 0xe1, 0x2c, 0x4a, 0x19, 0x64,
+	0xc5, 0x93, 0xdf, 0xff, 0x7b, 0x00, 0x61, 0xc9, 0xad, 0x4e, 0x57, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) VerboseEqual(that interface{}) error {
@@ -341,6 +742,18 @@ func (this *Params) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
 		}
 	}
+	if this.PriceHistorySize != that1.PriceHistorySize {
+		return fmt.Errorf("PriceHistorySize this(%v) Not Equal that(%v)", this.PriceHistorySize, that1.PriceHistorySize)
+	}
+	if this.OracleMissThreshold != that1.OracleMissThreshold {
+		return fmt.Errorf("OracleMissThreshold this(%v) Not Equal that(%v)", this.OracleMissThreshold, that1.OracleMissThreshold)
+	}
+	if !this.OracleDeviationThreshold.Equal(that1.OracleDeviationThreshold) {
+		return fmt.Errorf("OracleDeviationThreshold this(%v) Not Equal that(%v)", this.OracleDeviationThreshold, that1.OracleDeviationThreshold)
+	}
+	if this.OracleJailDuration != that1.OracleJailDuration {
+		return fmt.Errorf("OracleJailDuration this(%v) Not Equal that(%v)", this.OracleJailDuration, that1.OracleJailDuration)
+	}
 	return nil
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -370,6 +783,18 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.PriceHistorySize != that1.PriceHistorySize {
+		return false
+	}
+	if this.OracleMissThreshold != that1.OracleMissThreshold {
+		return false
+	}
+	if !this.OracleDeviationThreshold.Equal(that1.OracleDeviationThreshold) {
+		return false
+	}
+	if this.OracleJailDuration != that1.OracleJailDuration {
+		return false
+	}
 	return true
 }
 func (this *Market) VerboseEqual(that interface{}) error {
@@ -417,6 +842,21 @@ func (this *Market) VerboseEqual(that interface{}) error {
 	if this.Active != that1.Active {
 		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return fmt.Errorf("MaxPriceDeviation this(%v) Not Equal that(%v)", this.MaxPriceDeviation, that1.MaxPriceDeviation)
+	}
+	if this.MinOracleCount != that1.MinOracleCount {
+		return fmt.Errorf("MinOracleCount this(%v) Not Equal that(%v)", this.MinOracleCount, that1.MinOracleCount)
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return fmt.Errorf("MaxPriceAge this(%v) Not Equal that(%v)", this.MaxPriceAge, that1.MaxPriceAge)
+	}
+	if !this.SwapPriceSource.Equal(that1.SwapPriceSource) {
+		return fmt.Errorf("SwapPriceSource this(%v) Not Equal that(%v)", this.SwapPriceSource, that1.SwapPriceSource)
+	}
+	if this.PriceRecoveryUpdates != that1.PriceRecoveryUpdates {
+		return fmt.Errorf("PriceRecoveryUpdates this(%v) Not Equal that(%v)", this.PriceRecoveryUpdates, that1.PriceRecoveryUpdates)
+	}
 	return nil
 }
 func (this *Market) Equal(that interface{}) bool {
@@ -458,9 +898,24 @@ func (this *Market) Equal(that interface{}) bool {
 	if this.Active != that1.Active {
 		return false
 	}
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return false
+	}
+	if this.MinOracleCount != that1.MinOracleCount {
+		return false
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if !this.SwapPriceSource.Equal(that1.SwapPriceSource) {
+		return false
+	}
+	if this.PriceRecoveryUpdates != that1.PriceRecoveryUpdates {
+		return false
+	}
 	return true
 }
-func (this *PostedPrice) VerboseEqual(that interface{}) error {
+func (this *SwapPriceSource) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -468,45 +923,45 @@ func (this *PostedPrice) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*PostedPrice)
+	that1, ok := that.(*SwapPriceSource)
 	if !ok {
-		that2, ok := that.(PostedPrice)
+		that2, ok := that.(SwapPriceSource)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *PostedPrice")
+			return fmt.Errorf("that is not of type *SwapPriceSource")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
+		return fmt.Errorf("that is type *SwapPriceSource but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
+		return fmt.Errorf("that is type *SwapPriceSource but is not nil && this == nil")
 	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	if this.PoolID != that1.PoolID {
+		return fmt.Errorf("PoolID this(%v) Not Equal that(%v)", this.PoolID, that1.PoolID)
 	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	if this.Denom != that1.Denom {
+		return fmt.Errorf("Denom this(%v) Not Equal that(%v)", this.Denom, that1.Denom)
 	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	if this.Window != that1.Window {
+		return fmt.Errorf("Window this(%v) Not Equal that(%v)", this.Window, that1.Window)
 	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	if !this.ConversionFactor.Equal(that1.ConversionFactor) {
+		return fmt.Errorf("ConversionFactor this(%v) Not Equal that(%v)", this.ConversionFactor, that1.ConversionFactor)
 	}
 	return nil
 }
-func (this *PostedPrice) Equal(that interface{}) bool {
+func (this *SwapPriceSource) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PostedPrice)
+	that1, ok := that.(*SwapPriceSource)
 	if !ok {
-		that2, ok := that.(PostedPrice)
+		that2, ok := that.(SwapPriceSource)
 		if ok {
 			that1 = &that2
 		} else {
@@ -518,21 +973,21 @@ func (this *PostedPrice) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MarketID != that1.MarketID {
+	if this.PoolID != that1.PoolID {
 		return false
 	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+	if this.Denom != that1.Denom {
 		return false
 	}
-	if !this.Price.Equal(that1.Price) {
+	if this.Window != that1.Window {
 		return false
 	}
-	if !this.Expiry.Equal(that1.Expiry) {
+	if !this.ConversionFactor.Equal(that1.ConversionFactor) {
 		return false
 	}
 	return true
 }
-func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+func (this *PostedPrice) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -540,39 +995,48 @@ func (this *CurrentPrice) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*CurrentPrice)
+	that1, ok := that.(*PostedPrice)
 	if !ok {
-		that2, ok := that.(CurrentPrice)
+		that2, ok := that.(PostedPrice)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *CurrentPrice")
+			return fmt.Errorf("that is not of type *PostedPrice")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
+		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
+		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
 	}
 	if this.MarketID != that1.MarketID {
 		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	}
+	if !this.PostedAt.Equal(that1.PostedAt) {
+		return fmt.Errorf("PostedAt this(%v) Not Equal that(%v)", this.PostedAt, that1.PostedAt)
 	}
 	return nil
 }
-func (this *CurrentPrice) Equal(that interface{}) bool {
+func (this *PostedPrice) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CurrentPrice)
+	that1, ok := that.(*PostedPrice)
 	if !ok {
-		that2, ok := that.(CurrentPrice)
+		that2, ok := that.(PostedPrice)
 		if ok {
 			that1 = &that2
 		} else {
@@ -587,305 +1051,2075 @@ func (this *CurrentPrice) Equal(that interface{}) bool {
 	if this.MarketID != that1.MarketID {
 		return false
 	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
 	if !this.Price.Equal(that1.Price) {
 		return false
 	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	if !this.PostedAt.Equal(that1.PostedAt) {
+		return false
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStore(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CurrentPrice")
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
 	}
-	return dAtA[:n], nil
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	return nil
 }
+func (this *CurrentPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
 }
+func (this *PriceRecovery) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
+	that1, ok := that.(*PriceRecovery)
+	if !ok {
+		that2, ok := that.(PriceRecovery)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return fmt.Errorf("that is not of type *PriceRecovery")
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if len(m.Oracles) > 0 {
-		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Oracles[iNdEx])
-			copy(dAtA[i:], m.Oracles[iNdEx])
-			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *PriceRecovery but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceRecovery but is not nil && this == nil")
 	}
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	if this.Updates != that1.Updates {
+		return fmt.Errorf("Updates this(%v) Not Equal that(%v)", this.Updates, that1.Updates)
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-
-func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PriceRecovery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintStore(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*PriceRecovery)
+	if !ok {
+		that2, ok := that.(PriceRecovery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleAddress) > 0 {
-		i -= len(m.OracleAddress)
-		copy(dAtA[i:], m.OracleAddress)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	if this.MarketID != that1.MarketID {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Price.Equal(that1.Price) {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Updates != that1.Updates {
+		return false
+	}
+	return true
 }
+func (this *PriceSnapshot) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceSnapshot")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceSnapshot but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceSnapshot but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	return nil
+}
+func (this *PriceSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	return true
+}
+func (this *OracleStats) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleStats")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleStats but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleStats but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.PricesCounted != that1.PricesCounted {
+		return fmt.Errorf("PricesCounted this(%v) Not Equal that(%v)", this.PricesCounted, that1.PricesCounted)
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return fmt.Errorf("MissedWindows this(%v) Not Equal that(%v)", this.MissedWindows, that1.MissedWindows)
+	}
+	if this.TotalMissedWindows != that1.TotalMissedWindows {
+		return fmt.Errorf("TotalMissedWindows this(%v) Not Equal that(%v)", this.TotalMissedWindows, that1.TotalMissedWindows)
+	}
+	if !this.LastDeviation.Equal(that1.LastDeviation) {
+		return fmt.Errorf("LastDeviation this(%v) Not Equal that(%v)", this.LastDeviation, that1.LastDeviation)
+	}
+	if !this.TotalDeviation.Equal(that1.TotalDeviation) {
+		return fmt.Errorf("TotalDeviation this(%v) Not Equal that(%v)", this.TotalDeviation, that1.TotalDeviation)
+	}
+	if this.JailCount != that1.JailCount {
+		return fmt.Errorf("JailCount this(%v) Not Equal that(%v)", this.JailCount, that1.JailCount)
+	}
+	if !this.JailedUntil.Equal(that1.JailedUntil) {
+		return fmt.Errorf("JailedUntil this(%v) Not Equal that(%v)", this.JailedUntil, that1.JailedUntil)
+	}
+	return nil
+}
+func (this *OracleStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if this.PricesCounted != that1.PricesCounted {
+		return false
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return false
+	}
+	if this.TotalMissedWindows != that1.TotalMissedWindows {
+		return false
+	}
+	if !this.LastDeviation.Equal(that1.LastDeviation) {
+		return false
+	}
+	if !this.TotalDeviation.Equal(that1.TotalDeviation) {
+		return false
+	}
+	if this.JailCount != that1.JailCount {
+		return false
+	}
+	if !this.JailedUntil.Equal(that1.JailedUntil) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OracleJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleJailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStore(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
 	{
-		size := m.Price.Size()
+		size := m.OracleDeviationThreshold.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.OracleDeviationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OracleMissThreshold != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.OracleMissThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PriceHistorySize != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.PriceHistorySize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriceRecoveryUpdates != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.PriceRecoveryUpdates))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SwapPriceSource != nil {
+		{
+			size, err := m.SwapPriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStore(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	if m.MinOracleCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MinOracleCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapPriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapPriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapPriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionFactor.Size()
+		i -= size
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStore(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PostedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PostedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStore(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStore(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Updates != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Updates))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStore(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStore(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x4a
+	if m.JailCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.TotalDeviation.Size()
+		i -= size
+		if _, err := m.TotalDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LastDeviation.Size()
+		i -= size
+		if _, err := m.LastDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TotalMissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.TotalMissedWindows))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MissedWindows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PricesCounted != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.PricesCounted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.PriceHistorySize != 0 {
+		n += 1 + sovStore(uint64(m.PriceHistorySize))
+	}
+	if m.OracleMissThreshold != 0 {
+		n += 1 + sovStore(uint64(m.OracleMissThreshold))
+	}
+	l = m.OracleDeviationThreshold.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleJailDuration)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.Oracles) > 0 {
+		for _, b := range m.Oracles {
+			l = len(b)
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.MinOracleCount != 0 {
+		n += 1 + sovStore(uint64(m.MinOracleCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovStore(uint64(l))
+	if m.SwapPriceSource != nil {
+		l = m.SwapPriceSource.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.PriceRecoveryUpdates != 0 {
+		n += 1 + sovStore(uint64(m.PriceRecoveryUpdates))
+	}
+	return n
+}
+
+func (m *SwapPriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PostedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PostedAt)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *CurrentPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PriceRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.Updates != 0 {
+		n += 1 + sovStore(uint64(m.Updates))
+	}
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *OracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.PricesCounted != 0 {
+		n += 1 + sovStore(uint64(m.PricesCounted))
+	}
+	if m.MissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.MissedWindows))
+	}
+	if m.TotalMissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.TotalMissedWindows))
+	}
+	l = m.LastDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.TotalDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.JailCount != 0 {
+		n += 1 + sovStore(uint64(m.JailCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func sovStore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStore(x uint64) (n int) {
+	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistorySize", wireType)
+			}
+			m.PriceHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMissThreshold", wireType)
+			}
+			m.OracleMissThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleMissThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDeviationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleDeviationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OracleJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
+			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracleCount", wireType)
+			}
+			m.MinOracleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapPriceSource == nil {
+				m.SwapPriceSource = &SwapPriceSource{}
+			}
+			if err := m.SwapPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRecoveryUpdates", wireType)
+			}
+			m.PriceRecoveryUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceRecoveryUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapPriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapPriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapPriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PostedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStore(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovStore(uint64(l))
+func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
-	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
-	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
-	}
-	if len(m.Oracles) > 0 {
-		for _, b := range m.Oracles {
-			l = len(b)
-			n += 1 + l + sovStore(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Active {
-		n += 2
-	}
-	return n
-}
-
-func (m *PostedPrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
-	}
-	l = len(m.OracleAddress)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
-	n += 1 + l + sovStore(uint64(l))
-	return n
-}
 
-func (m *CurrentPrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	return n
-}
-
-func sovStore(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStore(x uint64) (n int) {
-	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PriceRecovery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -908,17 +3142,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceRecovery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -928,26 +3194,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			m.Updates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -969,7 +3254,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,10 +3277,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1032,7 +3317,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1060,13 +3345,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1076,76 +3363,25 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStore
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStore
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
-			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStore
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -1167,7 +3403,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+func (m *OracleStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,10 +3426,10 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1263,10 +3499,10 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricesCounted", wireType)
 			}
-			var stringLen uint64
+			m.PricesCounted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1276,31 +3512,54 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PricesCounted |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStore
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedWindows", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
+			m.MissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissedWindows", wireType)
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TotalMissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeviation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1310,78 +3569,29 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStore(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStore
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStore
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1409,13 +3619,34 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1425,23 +3656,22 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21,6 +21,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 		k.SetDeposit(ctx, deposit)
 	}
 
+	// Yield that rounded down when it was credited to deposits is left in the module account
+	balance := k.GetSavingsModuleAccountBalances(ctx)
+	deposited := sdk.NewCoins()
+	for _, deposit := range gs.Deposits {
+		deposited = deposited.Add(deposit.Amount...)
+	}
+	for _, coin := range balance {
+		if dust := coin.Amount.Sub(deposited.AmountOf(coin.Denom)); dust.IsPositive() {
+			k.SetYieldDust(ctx, coin.Denom, sdk.NewDecFromInt(dust))
+		}
+	}
+
 	// check if the module account exists
 	SavingsModuleAccount := ak.GetModuleAccount(ctx, types.ModuleAccountName)
 	if SavingsModuleAccount == nil {
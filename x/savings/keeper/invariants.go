@@ -46,7 +46,9 @@ func DepositsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// SolvencyInvariant iterates all deposits and ensures the total amount matches the module account coins
+// SolvencyInvariant iterates all deposits and ensures the total amount, including pending yield, matches the module
+// account coins. The module account also holds the yield that rounded down when it was credited to deposits, and the
+// yield of each deposit that will round down when it is credited, which is less than one coin per deposit.
 func SolvencyInvariant(k Keeper) sdk.Invariant {
 	message := sdk.FormatInvariant(types.ModuleName, "module solvency broken", "total deposited amount does not match module account")
 
@@ -54,14 +56,25 @@ func SolvencyInvariant(k Keeper) sdk.Invariant {
 		balance := k.GetSavingsModuleAccountBalances(ctx)
 
 		deposited := sdk.Coins{}
+		depositCounts := make(map[string]int64)
 		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
 			for _, coin := range deposit.Amount {
 				deposited = deposited.Add(coin)
+				depositCounts[coin.Denom]++
 			}
 			return false
 		})
 
-		broken := !deposited.IsEqual(balance)
-		return message, broken
+		for _, coin := range balance.Add(deposited...) {
+			if deposited.AmountOf(coin.Denom).GT(balance.AmountOf(coin.Denom)) {
+				return message, true
+			}
+			surplus := sdk.NewDecFromInt(balance.AmountOf(coin.Denom).Sub(deposited.AmountOf(coin.Denom))).
+				Sub(k.GetYieldDust(ctx, coin.Denom))
+			if surplus.LTE(sdk.OneDec().Neg()) || surplus.GT(sdk.NewDec(depositCounts[coin.Denom])) {
+				return message, true
+			}
+		}
+		return message, false
 	}
 }
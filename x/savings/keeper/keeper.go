@@ -59,7 +59,8 @@ func (k Keeper) GetSavingsModuleAccountBalances(ctx sdk.Context) sdk.Coins {
 	return k.bankKeeper.GetAllBalances(ctx, savingMacc.GetAddress())
 }
 
-// GetDeposit returns a deposit from the store for a particular depositor address, deposit denom
+// GetDeposit returns a deposit from the store for a particular depositor address, including the yield distributed to
+// it since it was last set
 func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
 	bz := store.Get(depositor.Bytes())
@@ -68,23 +69,28 @@ func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Dep
 	}
 	var deposit types.Deposit
 	k.cdc.MustUnmarshal(bz, &deposit)
-	return deposit, true
+	return k.synchronizeDepositYield(ctx, deposit), true
 }
 
-// SetDeposit sets the input deposit in the store
+// SetDeposit sets the input deposit in the store, along with the current yield factor of each of its denoms
 func (k Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
+	k.recordYieldDust(ctx, deposit.Depositor)
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
 	bz := k.cdc.MustMarshal(&deposit)
 	store.Set(deposit.Depositor.Bytes(), bz)
+	k.setDepositYieldFactors(ctx, deposit)
 }
 
 // DeleteDeposit deletes a deposit from the store
 func (k Keeper) DeleteDeposit(ctx sdk.Context, deposit types.Deposit) {
+	k.recordYieldDust(ctx, deposit.Depositor)
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
 	store.Delete(deposit.Depositor.Bytes())
+	k.deleteDepositYieldFactors(ctx, deposit.Depositor)
 }
 
-// IterateDeposits iterates over all deposit objects in the store and performs a callback function
+// IterateDeposits iterates over all deposit objects in the store, including the yield distributed to each since it was
+// last set, and performs a callback function
 func (k Keeper) IterateDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
@@ -92,7 +98,7 @@ func (k Keeper) IterateDeposits(ctx sdk.Context, cb func(deposit types.Deposit)
 	for ; iterator.Valid(); iterator.Next() {
 		var deposit types.Deposit
 		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
-		if cb(deposit) {
+		if cb(k.synchronizeDepositYield(ctx, deposit)) {
 			break
 		}
 	}
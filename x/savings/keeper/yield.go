@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// DistributeYield sends yield from a module account to the savings module account and adds it to the deposits of the
+// yield denom in proportion to their size. Deposits are not updated here, instead the yield factor of the denom is
+// increased and each deposit is credited its share of the yield when it is next read. It returns the amount distributed,
+// which is zero when there are no deposits of the yield denom.
+func (k Keeper) DistributeYield(ctx sdk.Context, senderModule string, yield sdk.Coin) (sdk.Coin, error) {
+	distributed := sdk.NewCoin(yield.Denom, sdk.ZeroInt())
+	if !yield.IsPositive() || !k.IsDenomSupported(ctx, yield.Denom) {
+		return distributed, nil
+	}
+	// the balance of the module account includes yield that has not been credited to deposits yet
+	totalDeposited := k.GetTotalDeposited(ctx, yield.Denom)
+	if !totalDeposited.IsPositive() {
+		return distributed, nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleAccountName, sdk.NewCoins(yield))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	yieldFactor := k.GetYieldFactor(ctx, yield.Denom)
+	growth := sdk.OneDec().Add(sdk.NewDecFromInt(yield.Amount).QuoInt(totalDeposited))
+	k.SetYieldFactor(ctx, yield.Denom, yieldFactor.Mul(growth))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsYield,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, yield.String()),
+			sdk.NewAttribute(types.AttributeKeySender, senderModule),
+		),
+	)
+
+	return yield, nil
+}
+
+// synchronizeDepositYield returns the input deposit with the yield distributed to it since it was last set in the
+// store. It does not update the store.
+func (k Keeper) synchronizeDepositYield(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	amount := sdk.NewCoins()
+	for _, coin := range deposit.Amount {
+		depositYieldFactor := k.getDepositYieldFactor(ctx, deposit.Depositor, coin.Denom)
+		yieldFactor := k.GetYieldFactor(ctx, coin.Denom)
+		synced := sdk.NewDecFromInt(coin.Amount).Mul(yieldFactor).Quo(depositYieldFactor).TruncateInt()
+		amount = amount.Add(sdk.NewCoin(coin.Denom, synced))
+	}
+	deposit.Amount = amount
+	return deposit
+}
+
+// GetYieldFactor returns the yield factor of a denom, which starts at one and grows with each distribution of yield
+func (k Keeper) GetYieldFactor(ctx sdk.Context, denom string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.YieldFactorPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.OneDec()
+	}
+	var yieldFactor sdk.Dec
+	if err := yieldFactor.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return yieldFactor
+}
+
+// SetYieldFactor sets the yield factor of a denom
+func (k Keeper) SetYieldFactor(ctx sdk.Context, denom string, yieldFactor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.YieldFactorPrefix)
+	bz, err := yieldFactor.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// getDepositYieldFactor returns the yield factor a deposit of a denom was last set at. Deposits set before any
+// yield factor was recorded for them start at one, the initial yield factor.
+func (k Keeper) getDepositYieldFactor(ctx sdk.Context, depositor sdk.AccAddress, denom string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositYieldFactorKeyPrefix)
+	bz := store.Get(types.DepositYieldFactorKey(depositor, denom))
+	if bz == nil {
+		return sdk.OneDec()
+	}
+	var yieldFactor sdk.Dec
+	if err := yieldFactor.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return yieldFactor
+}
+
+// setDepositYieldFactors records the current yield factor of each denom of a deposit
+func (k Keeper) setDepositYieldFactors(ctx sdk.Context, deposit types.Deposit) {
+	k.deleteDepositYieldFactors(ctx, deposit.Depositor)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositYieldFactorKeyPrefix)
+	for _, coin := range deposit.Amount {
+		bz, err := k.GetYieldFactor(ctx, coin.Denom).Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.DepositYieldFactorKey(deposit.Depositor, coin.Denom), bz)
+	}
+}
+
+// deleteDepositYieldFactors deletes the yield factors recorded for a deposit
+func (k Keeper) deleteDepositYieldFactors(ctx sdk.Context, depositor sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositYieldFactorKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositYieldFactorIterKey(depositor))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetYieldDust returns the yield of a denom that rounded down when it was credited to deposits. It stays in the module
+// account without belonging to any deposit.
+func (k Keeper) GetYieldDust(ctx sdk.Context, denom string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.YieldDustPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var dust sdk.Dec
+	if err := dust.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return dust
+}
+
+// SetYieldDust sets the yield of a denom that rounded down when it was credited to deposits
+func (k Keeper) SetYieldDust(ctx sdk.Context, denom string, dust sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.YieldDustPrefix)
+	bz, err := dust.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// recordYieldDust adds the yield that rounds down when a stored deposit is credited its yield to the yield dust of each
+// denom. It must be called before the deposit is overwritten or deleted, as the deposit's fractional yield is lost then.
+func (k Keeper) recordYieldDust(ctx sdk.Context, depositor sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	bz := store.Get(depositor.Bytes())
+	if len(bz) == 0 {
+		return
+	}
+	var deposit types.Deposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	for _, coin := range deposit.Amount {
+		depositYieldFactor := k.getDepositYieldFactor(ctx, depositor, coin.Denom)
+		yieldFactor := k.GetYieldFactor(ctx, coin.Denom)
+		synced := sdk.NewDecFromInt(coin.Amount).Mul(yieldFactor).Quo(depositYieldFactor)
+		dust := synced.Sub(synced.TruncateDec())
+		if dust.IsPositive() {
+			k.SetYieldDust(ctx, coin.Denom, k.GetYieldDust(ctx, coin.Denom).Add(dust))
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/savings/keeper"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+func (suite *KeeperTestSuite) TestDistributeYield() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+
+	type args struct {
+		deposits              []sdk.Coins
+		yield                 sdk.Coin
+		expectedDistributed   sdk.Coin
+		expectedDepositCoins  []sdk.Coins
+		expectedModAccBalance sdk.Coins
+	}
+	testCases := []struct {
+		name string
+		args args
+	}{
+		{
+			"valid: yield split pro-rata between depositors",
+			args{
+				deposits:              []sdk.Coins{cs(c("usdx", 300)), cs(c("usdx", 100))},
+				yield:                 c("usdx", 40),
+				expectedDistributed:   c("usdx", 40),
+				expectedDepositCoins:  []sdk.Coins{cs(c("usdx", 330)), cs(c("usdx", 110))},
+				expectedModAccBalance: cs(c("usdx", 440)),
+			},
+		},
+		{
+			"valid: shares round down, leaving the remainder in the module account",
+			args{
+				deposits:              []sdk.Coins{cs(c("usdx", 100)), cs(c("usdx", 100)), cs(c("usdx", 100))},
+				yield:                 c("usdx", 10),
+				expectedDistributed:   c("usdx", 10),
+				expectedDepositCoins:  []sdk.Coins{cs(c("usdx", 103)), cs(c("usdx", 103)), cs(c("usdx", 103))},
+				expectedModAccBalance: cs(c("usdx", 310)),
+			},
+		},
+		{
+			"valid: only depositors of the yield denom receive yield",
+			args{
+				deposits:              []sdk.Coins{cs(c("usdx", 100)), cs(c("bnb", 100))},
+				yield:                 c("usdx", 10),
+				expectedDistributed:   c("usdx", 10),
+				expectedDepositCoins:  []sdk.Coins{cs(c("usdx", 110)), cs(c("bnb", 100))},
+				expectedModAccBalance: cs(c("usdx", 110), c("bnb", 100)),
+			},
+		},
+		{
+			"valid: nothing distributed without deposits of the yield denom",
+			args{
+				deposits:              []sdk.Coins{cs(c("bnb", 100))},
+				yield:                 c("usdx", 10),
+				expectedDistributed:   c("usdx", 0),
+				expectedDepositCoins:  []sdk.Coins{cs(c("bnb", 100))},
+				expectedModAccBalance: cs(c("bnb", 100)),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+			var depositors []sdk.AccAddress
+			for i := range tc.args.deposits {
+				depositors = append(depositors, addrs[i])
+			}
+			authGS := app.NewFundedGenStateWithSameCoins(tApp.AppCodec(), cs(c("usdx", 1000), c("bnb", 1000)), depositors)
+			savingsGS := types.NewGenesisState(types.NewParams([]string{"usdx", "bnb"}), types.Deposits{})
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&savingsGS)},
+			)
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetSavingsKeeper()
+			bankKeeper := tApp.GetBankKeeper()
+
+			for i, depositor := range depositors {
+				suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, tc.args.deposits[i]))
+			}
+			suite.Require().NoError(bankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.NewCoins(tc.args.yield)))
+
+			distributed, err := suite.keeper.DistributeYield(suite.ctx, minttypes.ModuleName, tc.args.yield)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.args.expectedDistributed, distributed)
+
+			for i, depositor := range depositors {
+				deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.args.expectedDepositCoins[i], deposit.Amount)
+			}
+			mAcc := suite.getModuleAccount(types.ModuleAccountName)
+			suite.Require().True(tc.args.expectedModAccBalance.IsEqual(bankKeeper.GetAllBalances(suite.ctx, mAcc.GetAddress())))
+			remaining := tc.args.yield.Sub(tc.args.expectedDistributed)
+			suite.Require().Equal(remaining.Amount.String(), bankKeeper.GetBalance(suite.ctx, tApp.GetAccountKeeper().GetModuleAddress(minttypes.ModuleName), tc.args.yield.Denom).Amount.String())
+
+			_, broken := keeper.SolvencyInvariant(suite.keeper)(suite.ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeYield_UnsupportedDenom() {
+	distributed, err := suite.keeper.DistributeYield(suite.ctx, minttypes.ModuleName, sdk.NewCoin("xyz", sdkmath.NewInt(10)))
+	suite.Require().NoError(err)
+	suite.Require().True(distributed.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestDistributeYield_CompoundsAndSettlesLazily() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewFundedGenStateWithSameCoins(tApp.AppCodec(), cs(c("usdx", 1000)), addrs)
+	savingsGS := types.NewGenesisState(types.NewParams([]string{"usdx"}), types.Deposits{})
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&savingsGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetSavingsKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, addrs[0], cs(c("usdx", 100))))
+	suite.Require().NoError(bankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, cs(c("usdx", 35))))
+	_, err := suite.keeper.DistributeYield(suite.ctx, minttypes.ModuleName, c("usdx", 10))
+	suite.Require().NoError(err)
+
+	// a deposit made after a distribution does not earn the earlier yield
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, addrs[1], cs(c("usdx", 140))))
+	_, err = suite.keeper.DistributeYield(suite.ctx, minttypes.ModuleName, c("usdx", 25))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.21"), suite.keeper.GetYieldFactor(suite.ctx, "usdx"))
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdx", 121)), deposit.Amount)
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, addrs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdx", 154)), deposit.Amount)
+
+	// withdrawing settles the yield credited to the deposit
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, addrs[0], cs(c("usdx", 21))))
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdx", 100)), deposit.Amount)
+	suite.Require().Equal(c("usdx", 921), bankKeeper.GetBalance(suite.ctx, addrs[0], "usdx"))
+}
+
+func (suite *KeeperTestSuite) TestDistributeYield_RecordsDust() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	authGS := app.NewFundedGenStateWithSameCoins(tApp.AppCodec(), cs(c("usdx", 1000)), addrs)
+	savingsGS := types.NewGenesisState(types.NewParams([]string{"usdx"}), types.Deposits{})
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&savingsGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetSavingsKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+
+	for _, addr := range addrs {
+		suite.Require().NoError(suite.keeper.Deposit(suite.ctx, addr, cs(c("usdx", 100))))
+	}
+	suite.Require().NoError(bankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, cs(c("usdx", 10))))
+	_, err := suite.keeper.DistributeYield(suite.ctx, minttypes.ModuleName, c("usdx", 10))
+	suite.Require().NoError(err)
+
+	// each deposit is credited 3.33 usdx, and the yield that rounds down is recorded when the deposit is updated
+	for _, addr := range addrs {
+		suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, addr, cs(c("usdx", 50))))
+	}
+	suite.Require().Equal("1", suite.keeper.GetYieldDust(suite.ctx, "usdx").Ceil().TruncateInt().String())
+
+	mAcc := suite.getModuleAccount(types.ModuleAccountName)
+	suite.Require().Equal(cs(c("usdx", 160)), bankKeeper.GetAllBalances(suite.ctx, mAcc.GetAddress()))
+	_, broken := keeper.SolvencyInvariant(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
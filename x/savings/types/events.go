@@ -3,8 +3,10 @@ package types
 const (
 	EventTypeSavingsDeposit    = "deposit_savings"
 	EventTypeSavingsWithdrawal = "withdraw_savings"
+	EventTypeSavingsYield      = "savings_yield"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyAmount     = "amount"
 	AttributeKeyDepositor  = "depositor"
+	AttributeKeySender     = "sender"
 )
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "savings"
@@ -17,4 +22,19 @@ const (
 	ModuleAccountName = ModuleName
 )
 
-var DepositsKeyPrefix = []byte{0x01}
+var (
+	DepositsKeyPrefix           = []byte{0x01}
+	YieldFactorPrefix           = []byte{0x02}
+	DepositYieldFactorKeyPrefix = []byte{0x03}
+	YieldDustPrefix             = []byte{0x04}
+)
+
+// DepositYieldFactorKey returns the key of the yield factor a deposit of a denom was last updated at
+func DepositYieldFactorKey(depositor sdk.AccAddress, denom string) []byte {
+	return append(DepositYieldFactorIterKey(depositor), []byte(denom)...)
+}
+
+// DepositYieldFactorIterKey returns the prefix key for iterating over the yield factors of a deposit
+func DepositYieldFactorIterKey(depositor sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(depositor)
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// GetCmdSubmitProtocolFeeTransferProposal implements the command to submit a protocol fee transfer proposal
+func GetCmdSubmitProtocolFeeTransferProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-protocol-fee-transfer [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to transfer swap protocol fees to the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a swap protocol fee transfer proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal swap-protocol-fee-transfer <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Swap Protocol Fee Transfer",
+  "description": "Transfer accumulated swap protocol fees to the community pool",
+  "amount": [
+    {
+      "denom": "usdx",
+      "amount": "100000000"
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "uist",
+      "amount": "1000000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseProtocolFeeTransferProposalJSON(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewProtocolFeeTransferProposal(proposal.Title, proposal.Description, proposal.Amount)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ParseProtocolFeeTransferProposalJSON reads and parses a ProtocolFeeTransferProposalJSON from a file.
+func ParseProtocolFeeTransferProposalJSON(cdc codec.JSONCodec, proposalFile string) (types.ProtocolFeeTransferProposalJSON, error) {
+	proposal := types.ProtocolFeeTransferProposalJSON{}
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -2,12 +2,15 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/swap/types"
 )
@@ -32,6 +35,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		queryParamsCmd(queryRoute),
 		queryDepositsCmd(queryRoute),
 		queryPoolsCmd(queryRoute),
+		querySwapRouteCmd(queryRoute),
+		queryPoolFeesCmd(queryRoute),
+		queryPoolTWAPCmd(queryRoute),
+		queryOrdersCmd(queryRoute),
 	}
 
 	for _, cmd := range cmds {
@@ -152,3 +159,189 @@ func queryPoolsCmd(queryRoute string) *cobra.Command {
 	}
 	return cmd
 }
+
+func querySwapRouteCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "route [tokenIn] [denomOut]",
+		Short: "get the best route of pools for swapping an exact input",
+		Long: strings.TrimSpace(`get the route of pools with the largest output for swapping an exact input for a denom:
+ 		Example:
+ 		$ kvcli q swap route 1000000ukava hard`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SwapRoute(context.Background(), &types.QuerySwapRouteRequest{
+				TokenIn:  tokenIn,
+				DenomOut: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryPoolFeesCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-fees",
+		Short: "get the total swap fees earned by pools",
+		Long: strings.TrimSpace(`get the total swap fees earned by pools, split between liquidity providers and the protocol:
+ 		Example:
+ 		$ kvcli q swap pool-fees
+ 		$ kvcli q swap pool-fees --pool ukava:usdx
+ 		`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pool, err := cmd.Flags().GetString(flagPool)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolFees(context.Background(), &types.QueryPoolFeesRequest{
+				PoolId:     pool,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pool-fees")
+
+	cmd.Flags().String(flagPool, "", "pool name")
+
+	return cmd
+}
+
+func queryPoolTWAPCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "twap [pool-id] [start-height] [end-height]",
+		Short: "get the time-weighted average prices of a pool between two block heights",
+		Long: strings.TrimSpace(`get the time-weighted average prices of a pool between two block heights, the current height is used if the end height is omitted:
+ 		Example:
+ 		$ kvcli q swap twap ukava:usdx 1000
+ 		$ kvcli q swap twap ukava:usdx 1000 2000
+ 		`,
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %w", err)
+			}
+
+			var endHeight int64
+			if len(args) == 3 {
+				endHeight, err = strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid end height: %w", err)
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolTWAP(context.Background(), &types.QueryPoolTWAPRequest{
+				PoolId:      args[0],
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryOrdersCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders",
+		Short: "get resting limit and stop-loss orders",
+		Long: strings.TrimSpace(`get resting limit and stop-loss orders:
+ 		Example:
+ 		$ kvcli q swap orders --pool ukava:usdx
+ 		$ kvcli q swap orders --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+ 		$ kvcli q swap orders --pool ukava:usdx --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+ 		$ kvcli q swap orders --page=2 --limit=100
+ 		`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bechOwnerAddr, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			pool, err := cmd.Flags().GetString(flagPool)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryOrdersRequest{
+				Owner:      bechOwnerAddr,
+				PoolId:     pool,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.Orders(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "orders")
+
+	cmd.Flags().String(flagPool, "", "pool name")
+	cmd.Flags().String(flagOwner, "", "owner of the orders")
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +17,11 @@ import (
 	"github.com/kava-labs/kava/x/swap/types"
 )
 
+// flags for cli transactions
+const (
+	flagStopLoss = "stop-loss"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	swapTxCmd := &cobra.Command{
@@ -31,6 +37,10 @@ func GetTxCmd() *cobra.Command {
 		getCmdWithdraw(),
 		getCmdSwapExactForTokens(),
 		getCmdSwapForExactTokens(),
+		getCmdSwapExactForTokensMultiHop(),
+		getCmdSwapForExactTokensMultiHop(),
+		getCmdPlaceLimitOrder(),
+		getCmdCancelOrder(),
 	}
 
 	for _, cmd := range cmds {
@@ -226,3 +236,190 @@ func getCmdSwapForExactTokens() *cobra.Command {
 		},
 	}
 }
+
+func getCmdSwapExactForTokensMultiHop() *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-exact-for-tokens-multi-hop [exactCoinA] [coinB] [route] [slippage] [deadline]",
+		Short: "swap an exact amount of token a for token b through a route of pools",
+		Long:  "The route is a comma separated list of pool ids, in order from token a to token b.",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-exact-for-tokens-multi-hop 1000000ukava 5000000hard ukava:usdx,hard:usdx 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			route := strings.Split(args[2], ",")
+
+			slippage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapExactForTokensMultiHop(fromAddr.String(), exactTokenA, tokenB, route, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdSwapForExactTokensMultiHop() *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-for-exact-tokens-multi-hop [coinA] [exactCoinB] [route] [slippage] [deadline]",
+		Short: "swap token a for exact amount of token b through a route of pools",
+		Long:  "The route is a comma separated list of pool ids, in order from token a to token b.",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-for-exact-tokens-multi-hop 1000000ukava 5000000hard ukava:usdx,hard:usdx 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			exactTokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			route := strings.Split(args[2], ",")
+
+			slippage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapForExactTokensMultiHop(fromAddr.String(), tokenA, exactTokenB, route, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdPlaceLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order [tokenIn] [denomOut] [triggerPrice] [expiry]",
+		Short: "place an order selling token in for denom out once the pool price of token in crosses the trigger price",
+		Long: `place a resting order that escrows token in until it is filled, cancelled, or expires.
+A limit order is filled while the price of token in is at or above the trigger price, and may be partially filled.
+A stop-loss order, placed with --stop-loss, sells all of token in once its price falls to or below the trigger price.`,
+		Example: fmt.Sprintf(
+			`%s tx %s place-limit-order 1000000ukava usdx 5.5 1624224736 --from <key>
+%s tx %s place-limit-order 1000000ukava usdx 4.5 1624224736 --stop-loss --from <key>`,
+			version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiry, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			stopLoss, err := cmd.Flags().GetBool(flagStopLoss)
+			if err != nil {
+				return err
+			}
+			orderType := types.ORDER_TYPE_LIMIT
+			if stopLoss {
+				orderType = types.ORDER_TYPE_STOP_LOSS
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgPlaceLimitOrder(fromAddr.String(), tokenIn, args[1], orderType, triggerPrice, expiry)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagStopLoss, false, "place a stop-loss order instead of a limit order")
+
+	return cmd
+}
+
+func getCmdCancelOrder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-order [orderID]",
+		Short: "cancel a resting order and return its unfilled input",
+		Example: fmt.Sprintf(
+			`%s tx %s cancel-order 12 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgCancelOrder(fromAddr.String(), orderID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
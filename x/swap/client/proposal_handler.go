@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/kava-labs/kava/x/swap/client/cli"
+)
+
+// ProtocolFeeTransferProposalHandler is the protocol fee transfer proposal handler
+var ProtocolFeeTransferProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProtocolFeeTransferProposal)
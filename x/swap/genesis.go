@@ -22,6 +22,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, sh := range gs.ShareRecords {
 		k.SetDepositorShares(ctx, sh)
 	}
+	for _, fr := range gs.PoolFeeRecords {
+		k.SetPoolFeeRecord(ctx, fr)
+	}
+	for _, ps := range gs.PoolPriceSnapshots {
+		k.SetPoolPriceSnapshot(ctx, ps)
+	}
+	for _, o := range gs.Orders {
+		k.SetOrder(ctx, o)
+	}
+	k.SetNextOrderID(ctx, gs.NextOrderID)
 }
 
 // ExportGenesis exports the genesis state
@@ -29,6 +39,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	pools := k.GetAllPools(ctx)
 	shares := k.GetAllDepositorShares(ctx)
+	fees := k.GetAllPoolFeeRecords(ctx)
+	snapshots := k.GetAllPoolPriceSnapshots(ctx)
+	orders := k.GetAllOrders(ctx)
+	nextOrderID := k.GetNextOrderID(ctx)
 
-	return types.NewGenesisState(params, pools, shares)
+	return types.NewGenesisState(params, pools, shares, fees, snapshots, orders, nextOrderID)
 }
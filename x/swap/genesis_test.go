@@ -1,7 +1,9 @@
 package swap_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/swap"
@@ -20,10 +22,17 @@ type genesisTestSuite struct {
 func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 	invalidState := types.NewGenesisState(
 		types.Params{
-			SwapFee: sdk.NewDec(-1),
+			SwapFee:          sdk.NewDec(-1),
+			ProtocolFeeShare: sdk.ZeroDec(),
+			MinOrderShare:    sdk.ZeroDec(),
+			MaxOrderDuration: 24 * time.Hour,
 		},
 		types.PoolRecords{},
 		types.ShareRecords{},
+		types.PoolFeeRecords{},
+		types.PoolPriceSnapshots{},
+		types.DefaultOrders,
+		types.DefaultNextOrderID,
 	)
 
 	suite.Panics(func() {
@@ -40,8 +49,10 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:     types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:          sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeShare: sdk.ZeroDec(),
+			MinOrderShare:    sdk.ZeroDec(),
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -51,6 +62,20 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PoolFeeRecords{
+			types.NewPoolFeeRecord(types.PoolID("hard", "usdx"), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(100))), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(10)))),
+			types.NewPoolFeeRecord(types.PoolID("ukava", "usdx"), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(500))), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50)))),
+		},
+		types.PoolPriceSnapshots{
+			types.NewPoolPriceSnapshot(types.PoolID("hard", "usdx"), 10, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("120"), sdk.MustNewDecFromStr("30"), sdk.NewDec(2), sdk.MustNewDecFromStr("0.5")),
+			types.NewPoolPriceSnapshot(types.PoolID("hard", "usdx"), 12, time.Date(2022, 1, 1, 0, 0, 12, 0, time.UTC), sdk.MustNewDecFromStr("144"), sdk.MustNewDecFromStr("36"), sdk.NewDec(2), sdk.MustNewDecFromStr("0.5")),
+			types.NewPoolPriceSnapshot(types.PoolID("ukava", "usdx"), 11, time.Date(2022, 1, 1, 0, 0, 6, 0, time.UTC), sdk.MustNewDecFromStr("300"), sdk.MustNewDecFromStr("12"), sdk.NewDec(5), sdk.MustNewDecFromStr("0.2")),
+		},
+		types.Orders{
+			types.NewOrder(1, depositor_1, types.ORDER_TYPE_LIMIT, sdk.NewCoin("ukava", sdkmath.NewInt(1e5)), "usdx", sdk.NewDec(6), time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)),
+			types.NewOrder(3, depositor_2, types.ORDER_TYPE_STOP_LOSS, sdk.NewCoin("hard", sdkmath.NewInt(2e5)), "usdx", sdk.MustNewDecFromStr("1.5"), time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
 	)
 
 	swap.InitGenesis(suite.Ctx, suite.Keeper, state)
@@ -66,6 +91,20 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	shareRecord2, _ := suite.Keeper.GetDepositorShares(suite.Ctx, depositor_1, types.PoolID("ukava", "usdx"))
 	suite.Equal(state.ShareRecords[1], shareRecord2)
 
+	feeRecord1, _ := suite.Keeper.GetPoolFeeRecord(suite.Ctx, types.PoolID("hard", "usdx"))
+	suite.Equal(state.PoolFeeRecords[0], feeRecord1)
+	feeRecord2, _ := suite.Keeper.GetPoolFeeRecord(suite.Ctx, types.PoolID("ukava", "usdx"))
+	suite.Equal(state.PoolFeeRecords[1], feeRecord2)
+
+	snapshot, found := suite.Keeper.GetPoolPriceSnapshotAtHeight(suite.Ctx, types.PoolID("hard", "usdx"), 11)
+	suite.Require().True(found)
+	suite.Equal(state.PoolPriceSnapshots[0], snapshot)
+
+	order, found := suite.Keeper.GetOrder(suite.Ctx, 3)
+	suite.Require().True(found)
+	suite.Equal(state.Orders[1], order)
+	suite.Equal(uint64(4), suite.Keeper.GetNextOrderID(suite.Ctx))
+
 	exportedState := swap.ExportGenesis(suite.Ctx, suite.Keeper)
 	suite.Equal(state, exportedState)
 }
@@ -79,8 +118,10 @@ func (suite *genesisTestSuite) Test_Marshall() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:     types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:          sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeShare: sdk.ZeroDec(),
+			MinOrderShare:    sdk.ZeroDec(),
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -90,6 +131,20 @@ func (suite *genesisTestSuite) Test_Marshall() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PoolFeeRecords{
+			types.NewPoolFeeRecord(types.PoolID("hard", "usdx"), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(100))), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(10)))),
+			types.NewPoolFeeRecord(types.PoolID("ukava", "usdx"), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(500))), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50)))),
+		},
+		types.PoolPriceSnapshots{
+			types.NewPoolPriceSnapshot(types.PoolID("hard", "usdx"), 10, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("120"), sdk.MustNewDecFromStr("30"), sdk.NewDec(2), sdk.MustNewDecFromStr("0.5")),
+			types.NewPoolPriceSnapshot(types.PoolID("hard", "usdx"), 12, time.Date(2022, 1, 1, 0, 0, 12, 0, time.UTC), sdk.MustNewDecFromStr("144"), sdk.MustNewDecFromStr("36"), sdk.NewDec(2), sdk.MustNewDecFromStr("0.5")),
+			types.NewPoolPriceSnapshot(types.PoolID("ukava", "usdx"), 11, time.Date(2022, 1, 1, 0, 0, 6, 0, time.UTC), sdk.MustNewDecFromStr("300"), sdk.MustNewDecFromStr("12"), sdk.NewDec(5), sdk.MustNewDecFromStr("0.2")),
+		},
+		types.Orders{
+			types.NewOrder(1, depositor_1, types.ORDER_TYPE_LIMIT, sdk.NewCoin("ukava", sdkmath.NewInt(1e5)), "usdx", sdk.NewDec(6), time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)),
+			types.NewOrder(3, depositor_2, types.ORDER_TYPE_STOP_LOSS, sdk.NewCoin("hard", sdkmath.NewInt(2e5)), "usdx", sdk.MustNewDecFromStr("1.5"), time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -114,8 +169,10 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:     types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:          sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeShare: sdk.ZeroDec(),
+			MinOrderShare:    sdk.ZeroDec(),
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -125,6 +182,20 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PoolFeeRecords{
+			types.NewPoolFeeRecord(types.PoolID("hard", "usdx"), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(100))), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(10)))),
+			types.NewPoolFeeRecord(types.PoolID("ukava", "usdx"), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(500))), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(50)))),
+		},
+		types.PoolPriceSnapshots{
+			types.NewPoolPriceSnapshot(types.PoolID("hard", "usdx"), 10, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("120"), sdk.MustNewDecFromStr("30"), sdk.NewDec(2), sdk.MustNewDecFromStr("0.5")),
+			types.NewPoolPriceSnapshot(types.PoolID("hard", "usdx"), 12, time.Date(2022, 1, 1, 0, 0, 12, 0, time.UTC), sdk.MustNewDecFromStr("144"), sdk.MustNewDecFromStr("36"), sdk.NewDec(2), sdk.MustNewDecFromStr("0.5")),
+			types.NewPoolPriceSnapshot(types.PoolID("ukava", "usdx"), 11, time.Date(2022, 1, 1, 0, 0, 6, 0, time.UTC), sdk.MustNewDecFromStr("300"), sdk.MustNewDecFromStr("12"), sdk.NewDec(5), sdk.MustNewDecFromStr("0.2")),
+		},
+		types.Orders{
+			types.NewOrder(1, depositor_1, types.ORDER_TYPE_LIMIT, sdk.NewCoin("ukava", sdkmath.NewInt(1e5)), "usdx", sdk.NewDec(6), time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)),
+			types.NewOrder(3, depositor_2, types.ORDER_TYPE_STOP_LOSS, sdk.NewCoin("hard", sdkmath.NewInt(2e5)), "usdx", sdk.MustNewDecFromStr("1.5"), time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		4,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -137,7 +208,9 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 	aminoJson, err := legacyCdc.MarshalJSON(&state)
 	suite.Require().NoError(err, "expected genesis state to marshal amino json without error")
 
-	suite.JSONEq(string(protoJson), string(aminoJson), "expected json outputs to be equal")
+	// amino encodes durations as integer nanoseconds rather than proto's "<seconds>s", so the duration is only
+	// compared through the imported state
+	suite.JSONEq(withoutMaxOrderDuration(suite, protoJson), withoutMaxOrderDuration(suite, aminoJson), "expected json outputs to be equal")
 
 	var importedState types.GenesisState
 	err = cdc.UnmarshalJSON(aminoJson, &importedState)
@@ -146,6 +219,15 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 	suite.Equal(state, importedState, "expected genesis state to be equal")
 }
 
+func withoutMaxOrderDuration(suite *genesisTestSuite, bz []byte) string {
+	var state map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(bz, &state))
+	delete(state["params"].(map[string]interface{}), "max_order_duration")
+	out, err := json.Marshal(state)
+	suite.Require().NoError(err)
+	return string(out)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(genesisTestSuite))
 }
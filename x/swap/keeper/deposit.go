@@ -104,22 +104,26 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 	return nil
 }
 
-func (k Keeper) depositAllowed(ctx sdk.Context, poolID string) bool {
+// getAllowedPool returns the allowed pool params for a pool id
+func (k Keeper) getAllowedPool(ctx sdk.Context, poolID string) (types.AllowedPool, bool) {
 	params := k.GetParams(ctx)
 	for _, p := range params.AllowedPools {
 		if poolID == types.PoolID(p.TokenA, p.TokenB) {
-			return true
+			return p, true
 		}
 	}
-	return false
+	return types.AllowedPool{}, false
 }
 
+// initializePool creates a new pool using the pricing curve of its allowed pool params.
+// The pool type is stored in the pool record, so it does not change if the params are later updated.
 func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.AccAddress, reserves sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	if allowed := k.depositAllowed(ctx, poolID); !allowed {
+	allowedPool, allowed := k.getAllowedPool(ctx, poolID)
+	if !allowed {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), errorsmod.Wrap(types.ErrNotAllowed, fmt.Sprintf("can not create pool '%s'", poolID))
 	}
 
-	pool, err := types.NewDenominatedPool(reserves)
+	pool, err := types.NewDenominatedPoolFromAllowedPool(allowedPool, reserves)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
@@ -128,7 +132,7 @@ func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.Acc
 }
 
 func (k Keeper) addLiquidityToPool(ctx sdk.Context, record types.PoolRecord, depositor sdk.AccAddress, desiredAmount sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	pool, err := types.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(record)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
@@ -59,7 +59,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds() {
 
 			pool := types.NewAllowedPool(tc.depositA.Denom, tc.depositB.Denom)
 			suite.Require().NoError(pool.Validate())
-			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 			balance := sdk.NewCoins(tc.balanceA, tc.balanceB)
 			depositor := suite.CreateAccount(balance)
@@ -123,7 +123,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds_Vesting() {
 
 			pool := types.NewAllowedPool(tc.depositA.Denom, tc.depositB.Denom)
 			suite.Require().NoError(pool.Validate())
-			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 			balance := sdk.NewCoins(tc.balanceA, tc.balanceB)
 			vesting := sdk.NewCoins(tc.vestingA, tc.vestingB)
@@ -147,7 +147,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds_Vesting() {
 func (suite *keeperTestSuite) TestDeposit_CreatePool() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	amountA := sdk.NewCoin(pool.TokenA, sdkmath.NewInt(11e6))
 	amountB := sdk.NewCoin(pool.TokenB, sdkmath.NewInt(51e6))
@@ -174,6 +174,53 @@ func (suite *keeperTestSuite) TestDeposit_CreatePool() {
 	))
 }
 
+func (suite *keeperTestSuite) TestDeposit_CreatePool_StableSwap() {
+	pool := types.NewStableSwapAllowedPool("usdc", "usdx", 100)
+	suite.Require().NoError(pool.Validate())
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
+
+	deposit := sdk.NewCoins(
+		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(100e6)),
+		sdk.NewCoin(pool.TokenB, sdkmath.NewInt(100e6)),
+	)
+	depositor := suite.CreateAccount(deposit)
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), deposit[0], deposit[1], sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+
+	record, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(types.POOL_TYPE_STABLESWAP, record.PoolType)
+	suite.Equal(uint64(100), record.Amplification)
+	suite.PoolLiquidityEqual(deposit)
+
+	// the pool type is fixed when the pool is created
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(types.NewAllowedPool("usdc", "usdx")), sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
+
+	balance := sdk.NewCoins(sdk.NewCoin("usdc", sdkmath.NewInt(10e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("usdc", sdkmath.NewInt(1e6))
+
+	expectedPool, err := types.NewDenominatedStableSwapPoolWithExistingShares(deposit, record.TotalShares, 100)
+	suite.Require().NoError(err)
+	expectedOutput, _ := expectedPool.SwapWithExactInput(coinA, sdk.MustNewDecFromStr("0.003"))
+
+	constantProductPool, err := types.NewDenominatedPool(deposit)
+	suite.Require().NoError(err)
+	constantProductOutput, _ := constantProductPool.SwapWithExactInput(coinA, sdk.MustNewDecFromStr("0.003"))
+	suite.True(expectedOutput.Amount.GT(constantProductOutput.Amount))
+
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, sdk.NewCoin("usdx", sdkmath.NewInt(1e6)), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.PoolLiquidityEqual(deposit.Add(coinA).Sub(expectedOutput))
+
+	record, found = suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(types.POOL_TYPE_STABLESWAP, record.PoolType)
+}
+
 func (suite *keeperTestSuite) TestDeposit_PoolExists() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	reserves := sdk.NewCoins(
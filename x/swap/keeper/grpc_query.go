@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -59,7 +61,7 @@ func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*ty
 		}
 
 		if shouldAccumulate {
-			denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+			denominatedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 			if err != nil {
 				return true, types.ErrInvalidPool
 			}
@@ -148,3 +150,158 @@ func (s queryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 		Pagination: pageRes,
 	}, nil
 }
+
+// SwapRoute implements the Query/SwapRoute gRPC method
+func (s queryServer) SwapRoute(c context.Context, req *types.QuerySwapRouteRequest) (*types.QuerySwapRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !req.TokenIn.IsValid() || !req.TokenIn.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in %s", req.TokenIn)
+	}
+	if err := sdk.ValidateDenom(req.DenomOut); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.TokenIn.Denom == req.DenomOut {
+		return nil, status.Error(codes.InvalidArgument, "denominations can not be equal")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	route, tokenOut, err := s.keeper.GetBestSwapRoute(ctx, req.TokenIn, req.DenomOut)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySwapRouteResponse{
+		Route:    route,
+		TokenOut: tokenOut,
+	}, nil
+}
+
+// PoolFees implements the Query/PoolFees gRPC method
+func (s queryServer) PoolFees(c context.Context, req *types.QueryPoolFeesRequest) (*types.QueryPoolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.PoolFeeKeyPrefix)
+
+	var records []types.PoolFeeRecord
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, shouldAccumulate bool) (bool, error) {
+		var record types.PoolFeeRecord
+		err := s.keeper.cdc.Unmarshal(value, &record)
+		if err != nil {
+			return false, err
+		}
+
+		if (len(req.PoolId) > 0) && strings.Compare(record.PoolID, req.PoolId) != 0 {
+			return false, nil
+		}
+
+		if shouldAccumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryPoolFeesResponse{
+		PoolFees:   records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PoolTWAP implements the Query/PoolTWAP gRPC method
+func (s queryServer) PoolTWAP(c context.Context, req *types.QueryPoolTWAPRequest) (*types.QueryPoolTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == "" {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	twap, err := s.keeper.GetPoolTWAP(ctx, req.PoolId, req.StartHeight, req.EndHeight)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidHeight) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPoolTWAPResponse{
+		PoolId:    twap.PoolID,
+		StartTime: twap.StartTime,
+		EndTime:   twap.EndTime,
+		PriceA:    twap.PriceA,
+		PriceB:    twap.PriceB,
+	}, nil
+}
+
+// Orders implements the Query/Orders gRPC method
+func (s queryServer) Orders(c context.Context, req *types.QueryOrdersRequest) (*types.QueryOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// iterate the owner or pool index if filtered by them, which store order ids instead of orders
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.OrderKeyPrefix)
+	indexed := true
+	switch {
+	case len(req.Owner) > 0:
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		store = prefix.NewStore(prefix.NewStore(ctx.KVStore(s.keeper.key), types.OrderByOwnerKeyPrefix), types.OrderByOwnerIteratorKey(owner))
+	case len(req.PoolId) > 0:
+		store = prefix.NewStore(prefix.NewStore(ctx.KVStore(s.keeper.key), types.OrderByPoolKeyPrefix), types.OrderByPoolIteratorKey(req.PoolId))
+	default:
+		indexed = false
+	}
+
+	orders := types.Orders{}
+	pageRes, err := query.FilteredPaginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var order types.Order
+			if indexed {
+				var found bool
+				order, found = s.keeper.GetOrder(ctx, sdk.BigEndianToUint64(value))
+				if !found {
+					return false, fmt.Errorf("order %d not found", sdk.BigEndianToUint64(value))
+				}
+			} else if err := s.keeper.cdc.Unmarshal(value, &order); err != nil {
+				return false, err
+			}
+
+			// Filter for results match the request's pool ID param if given
+			if len(req.PoolId) > 0 && order.PoolID != req.PoolId {
+				// inform paginate that there was no match on this key
+				return false, nil
+			}
+			if accumulate {
+				// only add to results if paginate tells us to
+				orders = append(orders, order)
+			}
+			// inform paginate that were was a match on this key
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOrdersResponse{
+		Orders:     orders,
+		Pagination: pageRes,
+	}, nil
+}
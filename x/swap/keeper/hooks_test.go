@@ -16,7 +16,7 @@ func (suite *keeperTestSuite) TestHooks_DepositAndWithdraw() {
 
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(1000e6)),
@@ -122,7 +122,7 @@ func (suite *keeperTestSuite) TestHooks_NoPanicsOnNilHooks() {
 
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(1000e6)),
@@ -156,7 +156,7 @@ func (suite *keeperTestSuite) TestHooks_HookOrdering() {
 
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(1000e6)),
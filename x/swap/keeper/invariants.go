@@ -13,6 +13,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "share-records", ShareRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-reserves", PoolReservesInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-shares", PoolSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "order-escrow", OrderEscrowInvariant(k))
 }
 
 // AllInvariants runs all invariants of the swap module
@@ -30,7 +31,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := PoolSharesInvariant(k)(ctx)
+		if res, stop := PoolSharesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := OrderEscrowInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -91,6 +96,24 @@ func PoolReservesInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// OrderEscrowInvariant iterates all orders and ensures their total unfilled input matches the order module account coins
+func OrderEscrowInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "order escrow broken", "order inputs do not match order module account")
+
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.bankKeeper.GetAllBalances(ctx, k.GetOrderModuleAccount(ctx).GetAddress())
+
+		escrowed := sdk.Coins{}
+		k.IterateOrders(ctx, func(order types.Order) bool {
+			escrowed = escrowed.Add(order.TokenIn)
+			return false
+		})
+
+		broken := !escrowed.IsEqual(balance)
+		return message, broken
+	}
+}
+
 type poolShares struct {
 	totalShares      sdkmath.Int
 	totalSharesOwned sdkmath.Int
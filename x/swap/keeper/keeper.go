@@ -16,12 +16,13 @@ import (
 
 // Keeper keeper for the swap module
 type Keeper struct {
-	key           storetypes.StoreKey
-	cdc           codec.Codec
-	paramSubspace paramtypes.Subspace
-	hooks         types.SwapHooks
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
+	key             storetypes.StoreKey
+	cdc             codec.Codec
+	paramSubspace   paramtypes.Subspace
+	hooks           types.SwapHooks
+	accountKeeper   types.AccountKeeper
+	bankKeeper      types.BankKeeper
+	communityKeeper types.CommunityKeeper
 }
 
 // NewKeeper creates a new keeper
@@ -31,17 +32,19 @@ func NewKeeper(
 	paramstore paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	communityKeeper types.CommunityKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		key:           key,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
+		key:             key,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		communityKeeper: communityKeeper,
 	}
 }
 
@@ -76,11 +79,32 @@ func (k Keeper) GetSwapFee(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SwapFee
 }
 
+// GetPoolSwapFee returns the swap fee of a pool. This is the swap fee of the pool's allowed pool
+// if it is set, otherwise the swap fee set in the module parameters.
+func (k Keeper) GetPoolSwapFee(ctx sdk.Context, poolID string) sdk.Dec {
+	return k.GetParams(ctx).PoolSwapFee(poolID)
+}
+
+// GetProtocolFeeShare returns the portion of swap fees sent to the protocol fee account
+func (k Keeper) GetProtocolFeeShare(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ProtocolFeeShare
+}
+
 // GetSwapModuleAccount returns the swap ModuleAccount
 func (k Keeper) GetSwapModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 }
 
+// GetProtocolFeeModuleAccount returns the ModuleAccount holding the protocol share of swap fees
+func (k Keeper) GetProtocolFeeModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.accountKeeper.GetModuleAccount(ctx, types.ProtocolFeeAccountName)
+}
+
+// GetOrderModuleAccount returns the ModuleAccount holding the escrowed input of resting orders
+func (k Keeper) GetOrderModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.accountKeeper.GetModuleAccount(ctx, types.OrderAccountName)
+}
+
 // GetPool retrieves a pool record from the store
 func (k Keeper) GetPool(ctx sdk.Context, poolID string) (types.PoolRecord, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolKeyPrefix)
@@ -243,8 +267,9 @@ func (k Keeper) GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddre
 func (k Keeper) updatePool(ctx sdk.Context, poolID string, pool *types.DenominatedPool) {
 	if pool.TotalShares().IsZero() {
 		k.DeletePool(ctx, poolID)
+		k.deletePoolPriceSnapshots(ctx, poolID)
 	} else {
-		k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+		k.updatePoolRecord(ctx, types.NewPoolRecordFromPool(pool))
 	}
 }
 
@@ -263,9 +288,75 @@ func (k Keeper) loadDenominatedPool(ctx sdk.Context, poolID string) (*types.Deno
 	if !found {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
-	denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	denominatedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
 	return denominatedPool, nil
 }
+
+// GetPoolFeeRecord retrieves the fees earned by a pool from the store
+func (k Keeper) GetPoolFeeRecord(ctx sdk.Context, poolID string) (types.PoolFeeRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolFeeKeyPrefix)
+
+	bz := store.Get(types.PoolKey(poolID))
+	if bz == nil {
+		return types.PoolFeeRecord{}, false
+	}
+
+	var record types.PoolFeeRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetPoolFeeRecord saves the fees earned by a pool to the store and panics if the record is invalid
+func (k Keeper) SetPoolFeeRecord(ctx sdk.Context, record types.PoolFeeRecord) {
+	if err := record.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid pool fee record: %s", err))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolFeeKeyPrefix)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.PoolKey(record.PoolID), bz)
+}
+
+// IteratePoolFeeRecords iterates over all pool fee records in the store and performs a callback function
+func (k Keeper) IteratePoolFeeRecords(ctx sdk.Context, cb func(record types.PoolFeeRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolFeeKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.PoolFeeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllPoolFeeRecords returns all pool fee records from the store
+func (k Keeper) GetAllPoolFeeRecords(ctx sdk.Context) (records types.PoolFeeRecords) {
+	k.IteratePoolFeeRecords(ctx, func(record types.PoolFeeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return
+}
+
+// addPoolFees adds fees paid to the liquidity providers and the protocol to the total fees earned by a pool
+func (k Keeper) addPoolFees(ctx sdk.Context, poolID string, lpFee sdk.Coin, protocolFee sdk.Coin) {
+	record, found := k.GetPoolFeeRecord(ctx, poolID)
+	if !found {
+		record = types.NewPoolFeeRecord(poolID, sdk.NewCoins(), sdk.NewCoins())
+	}
+
+	if lpFee.IsPositive() {
+		record.LPFees = record.LPFees.Add(lpFee)
+	}
+	if protocolFee.IsPositive() {
+		record.ProtocolFees = record.ProtocolFees.Add(protocolFee)
+	}
+
+	k.SetPoolFeeRecord(ctx, record)
+}
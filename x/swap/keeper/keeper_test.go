@@ -63,7 +63,9 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("ukava", "usdx"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.03"),
+		SwapFee:          sdk.MustNewDecFromStr("0.03"),
+		ProtocolFeeShare: sdk.ZeroDec(),
+		MinOrderShare:    sdk.ZeroDec(),
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.Equal(keeper.GetParams(suite.Ctx), params)
@@ -73,7 +75,9 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("hard", "ukava"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.01"),
+		SwapFee:          sdk.MustNewDecFromStr("0.01"),
+		ProtocolFeeShare: sdk.ZeroDec(),
+		MinOrderShare:    sdk.ZeroDec(),
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.NotEqual(keeper.GetParams(suite.Ctx), oldParams)
@@ -84,13 +88,38 @@ func (suite keeperTestSuite) TestParams_GetSwapFee() {
 	keeper := suite.Keeper
 
 	params := types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.00333"),
+		SwapFee:          sdk.MustNewDecFromStr("0.00333"),
+		ProtocolFeeShare: sdk.ZeroDec(),
+		MinOrderShare:    sdk.ZeroDec(),
 	}
 	keeper.SetParams(suite.Ctx, params)
 
 	suite.Equal(keeper.GetSwapFee(suite.Ctx), params.SwapFee)
 }
 
+func (suite *keeperTestSuite) TestParams_GetPoolSwapFee() {
+	keeper := suite.Keeper
+
+	params := types.NewParams(
+		types.NewAllowedPools(
+			types.NewAllowedPool("ukava", "usdx"),
+			types.AllowedPool{TokenA: "hard", TokenB: "usdx", SwapFee: sdk.MustNewDecFromStr("0.0005")},
+		),
+		sdk.MustNewDecFromStr("0.003"),
+		sdk.MustNewDecFromStr("0.1"),
+		types.DefaultPriceHistoryBlocks,
+		types.DefaultMaxOrderFills,
+		types.DefaultMinOrderShare,
+		types.DefaultMaxOrderDuration,
+	)
+	keeper.SetParams(suite.Ctx, params)
+
+	suite.Equal(sdk.MustNewDecFromStr("0.003"), keeper.GetPoolSwapFee(suite.Ctx, types.PoolID("ukava", "usdx")))
+	suite.Equal(sdk.MustNewDecFromStr("0.0005"), keeper.GetPoolSwapFee(suite.Ctx, types.PoolID("hard", "usdx")))
+	suite.Equal(sdk.MustNewDecFromStr("0.003"), keeper.GetPoolSwapFee(suite.Ctx, types.PoolID("busd", "usdx")))
+	suite.Equal(sdk.MustNewDecFromStr("0.1"), keeper.GetProtocolFeeShare(suite.Ctx))
+}
+
 func (suite *keeperTestSuite) TestPool_Persistance() {
 	reserves := sdk.NewCoins(
 		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
@@ -166,6 +195,33 @@ func (suite *keeperTestSuite) TestShare_PanicsWhenInvalid() {
 	}, "expected set depositor shares to panic with invalid record")
 }
 
+func (suite *keeperTestSuite) TestPoolFeeRecord_Persistance() {
+	poolID := types.PoolID("ukava", "usdx")
+
+	_, found := suite.Keeper.GetPoolFeeRecord(suite.Ctx, poolID)
+	suite.False(found)
+
+	record := types.NewPoolFeeRecord(
+		poolID,
+		sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(900)), sdk.NewCoin("usdx", sdkmath.NewInt(4500))),
+		sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100)), sdk.NewCoin("usdx", sdkmath.NewInt(500))),
+	)
+	suite.Keeper.SetPoolFeeRecord(suite.Ctx, record)
+
+	savedRecord, found := suite.Keeper.GetPoolFeeRecord(suite.Ctx, poolID)
+	suite.True(found)
+	suite.Equal(record, savedRecord)
+	suite.Equal(types.PoolFeeRecords{record}, suite.Keeper.GetAllPoolFeeRecords(suite.Ctx))
+}
+
+func (suite *keeperTestSuite) TestPoolFeeRecord_PanicsWhenInvalid() {
+	invalidRecord := types.NewPoolFeeRecord("hard/usdx", sdk.NewCoins(), sdk.NewCoins())
+
+	suite.Panics(func() {
+		suite.Keeper.SetPoolFeeRecord(suite.Ctx, invalidRecord)
+	}, "expected set pool fee record to panic with invalid record")
+}
+
 func (suite *keeperTestSuite) TestHooks() {
 	// ensure no hooks are set
 	suite.Keeper.ClearHooks()
@@ -133,6 +133,111 @@ func (m msgServer) SwapForExactTokens(goCtx context.Context, msg *types.MsgSwapF
 	return &types.MsgSwapForExactTokensResponse{}, nil
 }
 
+// SwapExactForTokensMultiHop handles MsgSwapExactForTokensMultiHop messages
+func (m msgServer) SwapExactForTokensMultiHop(goCtx context.Context, msg *types.MsgSwapExactForTokensMultiHop) (*types.MsgSwapExactForTokensMultiHopResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapExactForTokensMultiHop(ctx, requester, msg.ExactTokenA, msg.TokenB, msg.PoolIDs, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapExactForTokensMultiHopResponse{}, nil
+}
+
+// SwapForExactTokensMultiHop handles MsgSwapForExactTokensMultiHop messages
+func (m msgServer) SwapForExactTokensMultiHop(goCtx context.Context, msg *types.MsgSwapForExactTokensMultiHop) (*types.MsgSwapForExactTokensMultiHopResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapForExactTokensMultiHop(ctx, requester, msg.TokenA, msg.ExactTokenB, msg.PoolIDs, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapForExactTokensMultiHopResponse{}, nil
+}
+
+// PlaceLimitOrder handles MsgPlaceLimitOrder messages
+func (m msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := m.keeper.PlaceOrder(ctx, owner, msg.OrderType, msg.TokenIn, msg.DenomOut, msg.TriggerPrice, msg.GetExpiry())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgPlaceLimitOrderResponse{OrderID: orderID}, nil
+}
+
+// CancelOrder handles MsgCancelOrder messages
+func (m msgServer) CancelOrder(goCtx context.Context, msg *types.MsgCancelOrder) (*types.MsgCancelOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.CancelOrder(ctx, owner, msg.OrderID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgCancelOrderResponse{}, nil
+}
+
 // checkDeadline returns an error if block time exceeds an included deadline
 func checkDeadline(ctx sdk.Context, msg sdk.Msg) error {
 	deadlineMsg, ok := msg.(types.MsgWithDeadline)
@@ -33,7 +33,7 @@ func (suite *msgServerTestSuite) SetupTest() {
 func (suite *msgServerTestSuite) TestDeposit_CreatePool() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(10e6)),
@@ -83,7 +83,7 @@ func (suite *msgServerTestSuite) TestDeposit_CreatePool() {
 func (suite *msgServerTestSuite) TestDeposit_DeadlineExceeded() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(10e6)),
@@ -206,7 +206,7 @@ func (suite *msgServerTestSuite) TestWithdraw_AllShares() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -259,7 +259,7 @@ func (suite *msgServerTestSuite) TestWithdraw_PartialShares() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -317,7 +317,7 @@ func (suite *msgServerTestSuite) TestWithdraw_SlippageFailure() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, sdk.ZeroDec(), types.DefaultPriceHistoryBlocks, types.DefaultMaxOrderFills, types.DefaultMinOrderShare, types.DefaultMaxOrderDuration))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -586,6 +586,130 @@ func (suite *msgServerTestSuite) TestSwapForExactTokens_DeadlineExceeded() {
 	suite.Nil(res)
 }
 
+func (suite *msgServerTestSuite) TestSwapExactForTokensMultiHop() {
+	err := suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	))
+	suite.Require().NoError(err)
+	err = suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(4000e6)),
+	))
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapInput := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	swapMsg := types.NewMsgSwapExactForTokensMultiHop(
+		requester.GetAddress().String(),
+		swapInput,
+		sdk.NewCoin("hard", sdkmath.NewInt(2.5e6)),
+		[]string{types.PoolID("ukava", "usdx"), types.PoolID("hard", "usdx")},
+		sdk.MustNewDecFromStr("0.01"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapExactForTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapExactForTokensMultiHopResponse{}, res)
+	suite.Require().NoError(err)
+
+	expectedSwapOutput := sdk.NewCoin("hard", sdkmath.NewInt(2479468))
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(swapInput).Add(expectedSwapOutput))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapExactForTokensMultiHop_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapExactForTokensMultiHop(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("hard", sdkmath.NewInt(2.5e6)),
+		[]string{types.PoolID("ukava", "usdx"), types.PoolID("hard", "usdx")},
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.SwapExactForTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), swapMsg.GetDeadline().Unix()))
+}
+
+func (suite *msgServerTestSuite) TestSwapForExactTokensMultiHop() {
+	err := suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	))
+	suite.Require().NoError(err)
+	err = suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(4000e6)),
+	))
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapOutput := sdk.NewCoin("hard", sdkmath.NewInt(2.5e6))
+	swapMsg := types.NewMsgSwapForExactTokensMultiHop(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		swapOutput,
+		[]string{types.PoolID("ukava", "usdx"), types.PoolID("hard", "usdx")},
+		sdk.MustNewDecFromStr("0.01"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapForExactTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapForExactTokensMultiHopResponse{}, res)
+	suite.Require().NoError(err)
+
+	expectedSwapInput := sdk.NewCoin("ukava", sdkmath.NewInt(1008299))
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(expectedSwapInput).Add(swapOutput))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapForExactTokensMultiHop_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapForExactTokensMultiHop(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("hard", sdkmath.NewInt(2.5e6)),
+		[]string{types.PoolID("ukava", "usdx"), types.PoolID("hard", "usdx")},
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.SwapForExactTokensMultiHop(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), swapMsg.GetDeadline().Unix()))
+}
+
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(msgServerTestSuite))
 }
@@ -0,0 +1,424 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// PlaceOrder escrows the input of a new order and adds it to the order book of its pool, returning the order id.
+// The order is filled at the end of each block while the pool price crosses its trigger price, until it expires.
+func (k Keeper) PlaceOrder(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	orderType types.OrderType,
+	tokenIn sdk.Coin,
+	denomOut string,
+	triggerPrice sdk.Dec,
+	expiry time.Time,
+) (uint64, error) {
+	if !expiry.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrOrderExpired, "expiry %s is not after block time %s", expiry, ctx.BlockTime())
+	}
+
+	params := k.GetParams(ctx)
+	if params.MaxOrderDuration > 0 && expiry.After(ctx.BlockTime().Add(params.MaxOrderDuration)) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidOrder, "expiry %s is more than %s after block time %s", expiry, params.MaxOrderDuration, ctx.BlockTime())
+	}
+
+	_, pool, err := k.loadPool(ctx, tokenIn.Denom, denomOut)
+	if err != nil {
+		return 0, err
+	}
+	if minAmount := minOrderAmount(pool, tokenIn.Denom, params.MinOrderShare); tokenIn.Amount.LT(minAmount) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidOrder, "input %s is less than minimum order size %s%s", tokenIn, minAmount, tokenIn.Denom)
+	}
+
+	order := types.NewOrder(k.GetNextOrderID(ctx), owner, orderType, tokenIn, denomOut, triggerPrice, expiry)
+	if err := order.Validate(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidOrder, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.OrderAccountName, sdk.NewCoins(tokenIn)); err != nil {
+		return 0, err
+	}
+
+	k.SetOrder(ctx, order)
+	k.SetNextOrderID(ctx, order.ID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapPlaceOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderType, order.OrderType.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, order.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTriggerPrice, order.TriggerPrice.String()),
+		),
+	)
+
+	return order.ID, nil
+}
+
+// CancelOrder removes an order from the order book and returns its unfilled input to the owner
+func (k Keeper) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	order, found := k.GetOrder(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrOrderNotFound, "order %d not found", id)
+	}
+	if !order.Owner.Equals(owner) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "order %d is not owned by %s", id, owner)
+	}
+
+	k.refundOrder(ctx, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapCancelOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, order.TokenIn.String()),
+		),
+	)
+
+	return nil
+}
+
+// ExpireOrders removes all orders whose expiry has passed at the block time, returning their unfilled input to the owners
+func (k Keeper) ExpireOrders(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OrderByExpiryKeyPrefix)
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(ctx.BlockTime()))
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		order, found := k.GetOrder(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("order %d in expiry index not found", id))
+		}
+
+		k.refundOrder(ctx, order)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapExpireOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+				sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+				sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeyRefund, order.TokenIn.String()),
+			),
+		)
+	}
+}
+
+// FillOrders fills the orders of every pool that are triggered by the pool prices. For each token of a pool, limit
+// orders are filled first, then stop-loss orders, with the pool price updated after every fill.
+// At most MaxOrderFills fills are attempted each block. The pools are visited starting from a different pool each
+// block, so a busy pool can not use up the budget of the pools after it.
+func (k Keeper) FillOrders(ctx sdk.Context) {
+	budget := k.GetParams(ctx).MaxOrderFills
+	records := k.GetAllPools(ctx)
+	if len(records) == 0 {
+		return
+	}
+
+	start := int(uint64(ctx.BlockHeight()) % uint64(len(records)))
+	for i := range records {
+		record := records[(start+i)%len(records)]
+		for _, denomIn := range []string{record.ReservesA.Denom, record.ReservesB.Denom} {
+			budget = k.fillOrderBook(ctx, record.PoolID, denomIn, types.ORDER_TYPE_LIMIT, budget)
+			budget = k.fillOrderBook(ctx, record.PoolID, denomIn, types.ORDER_TYPE_STOP_LOSS, budget)
+		}
+	}
+}
+
+// fillOrderBook fills the triggered orders of a pool selling denomIn with an order type, attempting at most budget
+// fills, and returns the remaining budget. Since fills move the pool price, the triggered orders are collected again
+// after each pass, and each order is filled at most once per block.
+func (k Keeper) fillOrderBook(ctx sdk.Context, poolID string, denomIn string, orderType types.OrderType, budget uint64) uint64 {
+	attempted := make(map[uint64]bool)
+	for budget > 0 {
+		orders := k.getTriggeredOrders(ctx, poolID, denomIn, orderType, attempted)
+		if len(orders) == 0 {
+			break
+		}
+
+		for _, order := range orders {
+			if budget == 0 {
+				break
+			}
+			budget--
+			attempted[order.ID] = true
+			k.fillOrder(ctx, order)
+		}
+	}
+	return budget
+}
+
+// getTriggeredOrders returns the orders of a pool selling denomIn with an order type that are triggered at the pool's
+// spot price, excluding any ids in skip. Limit orders are returned from the lowest trigger price and stop-loss orders
+// from the highest, so iteration stops at the first order that is not triggered.
+func (k Keeper) getTriggeredOrders(
+	ctx sdk.Context,
+	poolID string,
+	denomIn string,
+	orderType types.OrderType,
+	skip map[uint64]bool,
+) (orders types.Orders) {
+	pool, err := k.loadDenominatedPool(ctx, poolID)
+	if err != nil {
+		return nil
+	}
+	spotPrice := pool.SpotPriceOf(denomIn)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OrderByPoolKeyPrefix)
+	book := prefix.NewStore(store, types.OrderBookIteratorKey(poolID, denomIn, orderType))
+
+	var iterator sdk.Iterator
+	if orderType == types.ORDER_TYPE_STOP_LOSS {
+		iterator = book.ReverseIterator(nil, nil)
+	} else {
+		iterator = book.Iterator(nil, nil)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Value())
+		order, found := k.GetOrder(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("order %d in order book not found", id))
+		}
+		if !order.IsTriggered(spotPrice) {
+			break
+		}
+		if !skip[order.ID] {
+			orders = append(orders, order)
+		}
+	}
+
+	return orders
+}
+
+// fillOrder swaps as much of the input of an order as its trigger price allows, paying the output to the owner.
+// Limit orders are filled up to the largest input that keeps the average price at or above the trigger price, and
+// stop-loss orders sell all of their input. Orders are removed once all of their input is filled, and evicted with a
+// refund once their input swaps for nothing or falls below the minimum order size.
+func (k Keeper) fillOrder(ctx sdk.Context, order types.Order) {
+	record, found := k.GetPool(ctx, order.PoolID)
+	if !found {
+		return
+	}
+	pool, err := types.NewDenominatedPoolFromRecord(record)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool %s: %s", order.PoolID, err))
+	}
+
+	if !order.IsTriggered(pool.SpotPriceOf(order.TokenIn.Denom)) {
+		return
+	}
+
+	fee := k.GetPoolSwapFee(ctx, order.PoolID)
+	swapInput := order.TokenIn
+	if order.OrderType == types.ORDER_TYPE_LIMIT {
+		swapInput = sdk.NewCoin(order.TokenIn.Denom, limitOrderFillAmount(record, order, fee))
+		if swapInput.IsZero() {
+			return
+		}
+	}
+
+	swapOutput, feePaid := pool.SwapWithExactInput(swapInput, fee)
+	if swapOutput.IsZero() {
+		// the input is too small to swap for anything, so the order can never be filled
+		k.evictOrder(ctx, order)
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.OrderAccountName, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
+		panic(err)
+	}
+	k.settleSwap(ctx, order.PoolID, pool, order.Owner, swapInput, swapOutput, feePaid, "input")
+
+	order.TokenIn = order.TokenIn.Sub(swapInput)
+	order.TokenOut = order.TokenOut.Add(swapOutput)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapFillOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, swapInput.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
+		),
+	)
+
+	switch {
+	case order.TokenIn.IsZero():
+		k.DeleteOrder(ctx, order)
+	case order.TokenIn.Amount.LT(minOrderAmount(pool, order.TokenIn.Denom, k.GetParams(ctx).MinOrderShare)):
+		k.evictOrder(ctx, order)
+	default:
+		k.SetOrder(ctx, order)
+	}
+}
+
+// evictOrder refunds and removes an order that is too small to be filled
+func (k Keeper) evictOrder(ctx sdk.Context, order types.Order) {
+	k.refundOrder(ctx, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapEvictOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, order.TokenIn.String()),
+		),
+	)
+}
+
+// minOrderAmount returns the smallest order input of a denom for a pool, as a share of the pool's reserves of the denom
+func minOrderAmount(pool *types.DenominatedPool, denom string, minOrderShare sdk.Dec) sdkmath.Int {
+	return minOrderShare.MulInt(pool.Reserves().AmountOf(denom)).Ceil().TruncateInt()
+}
+
+// limitOrderFillAmount returns the largest input of a limit order that swaps at an average price, including fees,
+// at or above the trigger price. The average price falls as the input grows, so the amount is found by binary search.
+func limitOrderFillAmount(record types.PoolRecord, order types.Order, fee sdk.Dec) sdkmath.Int {
+	fillsAtTriggerPrice := func(amount sdkmath.Int) bool {
+		pool, err := types.NewDenominatedPoolFromRecord(record)
+		if err != nil {
+			panic(fmt.Sprintf("invalid pool %s: %s", record.PoolID, err))
+		}
+		output, _ := pool.SwapWithExactInput(sdk.NewCoin(order.TokenIn.Denom, amount), fee)
+		return sdk.NewDecFromInt(output.Amount).GTE(sdk.NewDecFromInt(amount).Mul(order.TriggerPrice))
+	}
+
+	if fillsAtTriggerPrice(order.TokenIn.Amount) {
+		return order.TokenIn.Amount
+	}
+
+	// low always fills at the trigger price and high never does
+	low, high := sdk.ZeroInt(), order.TokenIn.Amount
+	for high.Sub(low).GT(sdk.OneInt()) {
+		mid := low.Add(high).QuoRaw(2)
+		if fillsAtTriggerPrice(mid) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	return low
+}
+
+// refundOrder returns the unfilled input of an order to its owner and removes the order from the store
+func (k Keeper) refundOrder(ctx sdk.Context, order types.Order) {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.OrderAccountName, order.Owner, sdk.NewCoins(order.TokenIn)); err != nil {
+		panic(err)
+	}
+
+	k.DeleteOrder(ctx, order)
+}
+
+// GetOrder retrieves an order from the store
+func (k Keeper) GetOrder(ctx sdk.Context, id uint64) (types.Order, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OrderKeyPrefix)
+
+	bz := store.Get(types.OrderKey(id))
+	if bz == nil {
+		return types.Order{}, false
+	}
+
+	var order types.Order
+	k.cdc.MustUnmarshal(bz, &order)
+
+	return order, true
+}
+
+// SetOrder saves an order and its indexes to the store and panics if the order is invalid
+func (k Keeper) SetOrder(ctx sdk.Context, order types.Order) {
+	if err := order.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid order: %s", err))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OrderKeyPrefix)
+	bz := k.cdc.MustMarshal(&order)
+	store.Set(types.OrderKey(order.ID), bz)
+
+	idBytes := types.OrderKey(order.ID)
+	prefix.NewStore(ctx.KVStore(k.key), types.OrderByPoolKeyPrefix).Set(types.OrderByPoolKey(order), idBytes)
+	prefix.NewStore(ctx.KVStore(k.key), types.OrderByOwnerKeyPrefix).Set(types.OrderByOwnerKey(order.Owner, order.ID), idBytes)
+	prefix.NewStore(ctx.KVStore(k.key), types.OrderByExpiryKeyPrefix).Set(types.OrderByExpiryKey(order.Expiry, order.ID), idBytes)
+}
+
+// DeleteOrder deletes an order and its indexes from the store
+func (k Keeper) DeleteOrder(ctx sdk.Context, order types.Order) {
+	prefix.NewStore(ctx.KVStore(k.key), types.OrderKeyPrefix).Delete(types.OrderKey(order.ID))
+	prefix.NewStore(ctx.KVStore(k.key), types.OrderByPoolKeyPrefix).Delete(types.OrderByPoolKey(order))
+	prefix.NewStore(ctx.KVStore(k.key), types.OrderByOwnerKeyPrefix).Delete(types.OrderByOwnerKey(order.Owner, order.ID))
+	prefix.NewStore(ctx.KVStore(k.key), types.OrderByExpiryKeyPrefix).Delete(types.OrderByExpiryKey(order.Expiry, order.ID))
+}
+
+// IterateOrders iterates over all orders in the store and performs a callback function
+func (k Keeper) IterateOrders(ctx sdk.Context, cb func(order types.Order) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OrderKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// GetAllOrders returns all orders from the store
+func (k Keeper) GetAllOrders(ctx sdk.Context) (orders types.Orders) {
+	k.IterateOrders(ctx, func(order types.Order) bool {
+		orders = append(orders, order)
+		return false
+	})
+	return
+}
+
+// GetAllOrdersByOwner returns all orders from the store for a specific address
+func (k Keeper) GetAllOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) (orders types.Orders) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OrderByOwnerKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.OrderByOwnerIteratorKey(owner))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if found {
+			orders = append(orders, order)
+		}
+	}
+	return
+}
+
+// GetNextOrderID returns the id to assign to the next placed order
+func (k Keeper) GetNextOrderID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.NextOrderIDKey)
+	if bz == nil {
+		return types.DefaultNextOrderID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextOrderID stores the id to assign to the next placed order
+func (k Keeper) SetNextOrderID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.key).Set(types.NextOrderIDKey, sdk.Uint64ToBigEndian(id))
+}
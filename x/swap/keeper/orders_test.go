@@ -0,0 +1,367 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) orderAccountBalanceEqual(coins sdk.Coins) {
+	macc := suite.Keeper.GetOrderModuleAccount(suite.Ctx)
+	suite.Equal(coins, suite.BankKeeper.GetAllBalances(suite.Ctx, macc.GetAddress()))
+}
+
+func (suite *keeperTestSuite) TestPlaceOrder() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.CreateAccount(balance)
+	tokenIn := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+
+	id, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_LIMIT, tokenIn, "usdx", sdk.MustNewDecFromStr("6"), expiry)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), id)
+	suite.Equal(uint64(2), suite.Keeper.GetNextOrderID(suite.Ctx))
+
+	order, found := suite.Keeper.GetOrder(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(types.NewOrder(1, owner.GetAddress(), types.ORDER_TYPE_LIMIT, tokenIn, "usdx", sdk.MustNewDecFromStr("6"), expiry), order)
+	suite.Equal(types.Orders{order}, suite.Keeper.GetAllOrdersByOwner(suite.Ctx, owner.GetAddress()))
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(tokenIn))
+	suite.orderAccountBalanceEqual(sdk.NewCoins(tokenIn))
+	suite.PoolLiquidityEqual(reserves)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapPlaceOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID("ukava", "usdx")),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyOrderType, types.ORDER_TYPE_LIMIT.String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTriggerPrice, sdk.MustNewDecFromStr("6").String()),
+	))
+
+	id, err = suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_STOP_LOSS, tokenIn, "usdx", sdk.MustNewDecFromStr("4"), expiry)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2), id)
+	suite.orderAccountBalanceEqual(sdk.NewCoins(tokenIn.Add(tokenIn)))
+}
+
+func (suite *keeperTestSuite) TestPlaceOrder_Errors() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(10e6)), sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.CreateAccount(balance)
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+
+	testCases := []struct {
+		name         string
+		tokenIn      sdk.Coin
+		denomOut     string
+		triggerPrice sdk.Dec
+		expiry       time.Time
+		expectedErr  error
+	}{
+		{
+			name:         "expiry at block time",
+			tokenIn:      sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			denomOut:     "usdx",
+			triggerPrice: sdk.MustNewDecFromStr("5"),
+			expiry:       suite.Ctx.BlockTime(),
+			expectedErr:  types.ErrOrderExpired,
+		},
+		{
+			name:         "pool does not exist",
+			tokenIn:      sdk.NewCoin("hard", sdkmath.NewInt(1e6)),
+			denomOut:     "usdx",
+			triggerPrice: sdk.MustNewDecFromStr("5"),
+			expiry:       expiry,
+			expectedErr:  types.ErrInvalidPool,
+		},
+		{
+			name:         "trigger price too large",
+			tokenIn:      sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			denomOut:     "usdx",
+			triggerPrice: types.MaxTriggerPrice.Add(sdk.OneDec()),
+			expiry:       expiry,
+			expectedErr:  types.ErrInvalidOrder,
+		},
+		{
+			name:         "expiry after max order duration",
+			tokenIn:      sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			denomOut:     "usdx",
+			triggerPrice: sdk.MustNewDecFromStr("5"),
+			expiry:       suite.Ctx.BlockTime().Add(types.DefaultMaxOrderDuration + time.Second),
+			expectedErr:  types.ErrInvalidOrder,
+		},
+		{
+			name:         "input below minimum order size",
+			tokenIn:      sdk.NewCoin("ukava", sdkmath.NewInt(9999)),
+			denomOut:     "usdx",
+			triggerPrice: sdk.MustNewDecFromStr("5"),
+			expiry:       expiry,
+			expectedErr:  types.ErrInvalidOrder,
+		},
+		{
+			name:         "insufficient funds",
+			tokenIn:      sdk.NewCoin("ukava", sdkmath.NewInt(11e6)),
+			denomOut:     "usdx",
+			triggerPrice: sdk.MustNewDecFromStr("5"),
+			expiry:       expiry,
+			expectedErr:  sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_LIMIT, tc.tokenIn, tc.denomOut, tc.triggerPrice, tc.expiry)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+
+			suite.AccountBalanceEqual(owner.GetAddress(), balance)
+			suite.Empty(suite.Keeper.GetAllOrders(suite.Ctx))
+			suite.Equal(types.DefaultNextOrderID, suite.Keeper.GetNextOrderID(suite.Ctx))
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestCancelOrder() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.CreateAccount(balance)
+	other := suite.NewAccountFromAddr(sdk.AccAddress("other---------------"), sdk.Coins{})
+	tokenIn := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+
+	id, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_LIMIT, tokenIn, "usdx", sdk.MustNewDecFromStr("6"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.CancelOrder(suite.Ctx, owner.GetAddress(), id+1)
+	suite.Require().ErrorIs(err, types.ErrOrderNotFound)
+
+	err = suite.Keeper.CancelOrder(suite.Ctx, other.GetAddress(), id)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = suite.Keeper.CancelOrder(suite.Ctx, owner.GetAddress(), id)
+	suite.Require().NoError(err)
+
+	_, found := suite.Keeper.GetOrder(suite.Ctx, id)
+	suite.False(found)
+	suite.Empty(suite.Keeper.GetAllOrdersByOwner(suite.Ctx, owner.GetAddress()))
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+	suite.orderAccountBalanceEqual(sdk.Coins{})
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapCancelOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID("ukava", "usdx")),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, tokenIn.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestExpireOrders() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	owner := suite.CreateAccount(balance)
+	tokenIn := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	startTime := suite.Ctx.BlockTime()
+
+	first, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_LIMIT, tokenIn, "usdx", sdk.MustNewDecFromStr("6"), startTime.Add(time.Hour))
+	suite.Require().NoError(err)
+	second, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_STOP_LOSS, tokenIn, "usdx", sdk.MustNewDecFromStr("4"), startTime.Add(2*time.Hour))
+	suite.Require().NoError(err)
+
+	// orders are not expired at their expiry
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+	suite.Keeper.ExpireOrders(suite.Ctx)
+	suite.Len(suite.Keeper.GetAllOrders(suite.Ctx), 2)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Hour + time.Second))
+	suite.Keeper.ExpireOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetOrder(suite.Ctx, first)
+	suite.False(found)
+	_, found = suite.Keeper.GetOrder(suite.Ctx, second)
+	suite.True(found)
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(tokenIn))
+	suite.orderAccountBalanceEqual(sdk.NewCoins(tokenIn))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapExpireOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID("ukava", "usdx")),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, tokenIn.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestFillOrders_Limit() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	owner := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(200e6))))
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+
+	// spot price of ukava is 5 usdx, below the trigger price
+	id, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_LIMIT, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.1"), expiry)
+	suite.Require().NoError(err)
+	suite.Keeper.FillOrders(suite.Ctx)
+	suite.PoolLiquidityEqual(reserves)
+	suite.Require().NoError(suite.Keeper.CancelOrder(suite.Ctx, owner.GetAddress(), id))
+
+	// a small order fills completely above the trigger price
+	id, err = suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_LIMIT, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("4.9"), expiry)
+	suite.Require().NoError(err)
+	suite.Keeper.FillOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetOrder(suite.Ctx, id)
+	suite.False(found)
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(199e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4980034))))
+	suite.PoolLiquidityEqual(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1001e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4995019966))))
+	suite.orderAccountBalanceEqual(sdk.Coins{})
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapFillOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "2"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID("ukava", "usdx")),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)).String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, sdk.NewCoin("usdx", sdkmath.NewInt(4980034)).String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestFillOrders_LimitPartial() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	owner := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100e6))))
+	triggerPrice := sdk.MustNewDecFromStr("4.9")
+
+	id, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_LIMIT, sdk.NewCoin("ukava", sdkmath.NewInt(100e6)), "usdx", triggerPrice, suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Keeper.FillOrders(suite.Ctx)
+
+	// the order fills until the average price reaches the trigger price
+	filled := sdk.NewCoin("ukava", sdkmath.NewInt(17399086))
+	output := sdk.NewCoin("usdx", sdkmath.NewInt(85255522))
+	suite.True(sdk.NewDecFromInt(output.Amount).GTE(sdk.NewDecFromInt(filled.Amount).Mul(triggerPrice)))
+
+	order, found := suite.Keeper.GetOrder(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoin("ukava", sdkmath.NewInt(100e6)).Sub(filled), order.TokenIn)
+	suite.Equal(output, order.TokenOut)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins(output))
+	suite.orderAccountBalanceEqual(sdk.NewCoins(order.TokenIn))
+	suite.PoolLiquidityEqual(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1017399086)), sdk.NewCoin("usdx", sdkmath.NewInt(4914744478))))
+
+	// the remaining input is not filled again at the lower price
+	suite.Keeper.FillOrders(suite.Ctx)
+	order, found = suite.Keeper.GetOrder(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(output, order.TokenOut)
+}
+
+func (suite *keeperTestSuite) TestFillOrders_EvictsSmallOrders() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	// pool creation resets the params
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.MinOrderShare = sdk.MustNewDecFromStr("0.1")
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	owner := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100e6))))
+	id, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_LIMIT, sdk.NewCoin("ukava", sdkmath.NewInt(100e6)), "usdx", sdk.MustNewDecFromStr("4.9"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Keeper.FillOrders(suite.Ctx)
+
+	// the remaining input is below a tenth of the pool's ukava, so it is refunded
+	refund := sdk.NewCoin("ukava", sdkmath.NewInt(100e6-17399086))
+	_, found := suite.Keeper.GetOrder(suite.Ctx, id)
+	suite.False(found)
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins(refund, sdk.NewCoin("usdx", sdkmath.NewInt(85255522))))
+	suite.orderAccountBalanceEqual(sdk.Coins{})
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapEvictOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID("ukava", "usdx")),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestFillOrders_MaxOrderFills() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	// pool creation resets the params
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.MaxOrderFills = 1
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	// spot price of ukava is 5 usdx, below the trigger price of both stop-loss orders
+	owner := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(2e6))))
+	first, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_STOP_LOSS, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.2"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	second, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_STOP_LOSS, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("5.1"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	// only one order is filled each block, starting from the highest trigger price
+	suite.Keeper.FillOrders(suite.Ctx)
+	_, found := suite.Keeper.GetOrder(suite.Ctx, first)
+	suite.False(found)
+	_, found = suite.Keeper.GetOrder(suite.Ctx, second)
+	suite.True(found)
+
+	suite.Keeper.FillOrders(suite.Ctx)
+	_, found = suite.Keeper.GetOrder(suite.Ctx, second)
+	suite.False(found)
+	suite.orderAccountBalanceEqual(sdk.Coins{})
+}
+
+func (suite *keeperTestSuite) TestFillOrders_StopLoss() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	owner := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	id, err := suite.Keeper.PlaceOrder(suite.Ctx, owner.GetAddress(), types.ORDER_TYPE_STOP_LOSS, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("4.9"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	// spot price of ukava is 5 usdx, above the trigger price
+	suite.Keeper.FillOrders(suite.Ctx)
+	_, found := suite.Keeper.GetOrder(suite.Ctx, id)
+	suite.True(found)
+
+	// a large swap moves the spot price below the trigger price
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(50e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(50e6)), sdk.NewCoin("usdx", sdkmath.NewInt(237414868)), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	suite.Keeper.FillOrders(suite.Ctx)
+
+	_, found = suite.Keeper.GetOrder(suite.Ctx, id)
+	suite.False(found)
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(4517898))))
+	suite.PoolLiquidityEqual(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1051e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4758067234))))
+	suite.orderAccountBalanceEqual(sdk.Coins{})
+}
+
+func (suite *keeperTestSuite) TestGetOrderModuleAccount() {
+	macc := suite.Keeper.GetOrderModuleAccount(suite.Ctx)
+	suite.Equal(authtypes.NewModuleAddress(types.OrderAccountName), macc.GetAddress())
+}
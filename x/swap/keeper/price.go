@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// updatePoolRecord saves a pool record after its reserves have changed. The price accumulators of the stored
+// record are advanced to the block time at the prices before the change, then a price snapshot is recorded.
+func (k Keeper) updatePoolRecord(ctx sdk.Context, record types.PoolRecord) {
+	record.PriceCumulativeA, record.PriceCumulativeB = sdk.ZeroDec(), sdk.ZeroDec()
+
+	if previous, found := k.GetPool(ctx, record.PoolID); found {
+		cumulativeA, cumulativeB, err := previous.CumulativePricesAt(ctx.BlockTime())
+		if err != nil {
+			panic(fmt.Sprintf("invalid pool record: %s", err))
+		}
+		record.PriceCumulativeA, record.PriceCumulativeB = cumulativeA, cumulativeB
+	}
+	record.PriceLastUpdated = ctx.BlockTime()
+
+	k.SetPool(ctx, record)
+	k.recordPoolPriceSnapshot(ctx, record)
+}
+
+// recordPoolPriceSnapshot saves the prices of a pool at the current block, replacing any snapshot already taken this block.
+// Snapshots older than the price history are pruned, keeping the newest snapshot at or before the start of the history
+// so the whole history can be priced.
+func (k Keeper) recordPoolPriceSnapshot(ctx sdk.Context, record types.PoolRecord) {
+	historyBlocks := k.GetParams(ctx).PriceHistoryBlocks
+	if historyBlocks == 0 {
+		k.deletePoolPriceSnapshots(ctx, record.PoolID)
+		return
+	}
+
+	snapshot, err := types.NewPoolPriceSnapshotFromRecord(record, ctx.BlockHeight(), ctx.BlockTime())
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool record: %s", err))
+	}
+	k.SetPoolPriceSnapshot(ctx, snapshot)
+
+	cutoff := ctx.BlockHeight() - int64(historyBlocks)
+	if cutoff <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	iterator := store.Iterator(types.PoolPriceSnapshotIteratorKey(record.PoolID), types.PoolPriceSnapshotKey(record.PoolID, cutoff+1))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// SetPoolPriceSnapshot saves a pool price snapshot to the store and panics if the snapshot is invalid
+func (k Keeper) SetPoolPriceSnapshot(ctx sdk.Context, snapshot types.PoolPriceSnapshot) {
+	if err := snapshot.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid pool price snapshot: %s", err))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.PoolPriceSnapshotKey(snapshot.PoolID, snapshot.Height), bz)
+}
+
+// deletePoolPriceSnapshots deletes all price snapshots of a pool from the store
+func (k Keeper) deletePoolPriceSnapshots(ctx sdk.Context, poolID string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolPriceSnapshotIteratorKey(poolID))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IteratePoolPriceSnapshots iterates over all pool price snapshots in the store and performs a callback function
+func (k Keeper) IteratePoolPriceSnapshots(ctx sdk.Context, cb func(snapshot types.PoolPriceSnapshot) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PoolPriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetAllPoolPriceSnapshots returns all pool price snapshots from the store
+func (k Keeper) GetAllPoolPriceSnapshots(ctx sdk.Context) (snapshots types.PoolPriceSnapshots) {
+	k.IteratePoolPriceSnapshots(ctx, func(snapshot types.PoolPriceSnapshot) bool {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return
+}
+
+// GetPoolPriceSnapshotAtHeight returns the newest price snapshot of a pool taken at or before a block height
+func (k Keeper) GetPoolPriceSnapshotAtHeight(ctx sdk.Context, poolID string, height int64) (types.PoolPriceSnapshot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	iterator := store.ReverseIterator(types.PoolPriceSnapshotIteratorKey(poolID), types.PoolPriceSnapshotKey(poolID, height+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PoolPriceSnapshot{}, false
+	}
+
+	var snapshot types.PoolPriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// getPoolPriceSnapshotAtTime returns the newest price snapshot of a pool taken at or before a time
+func (k Keeper) getPoolPriceSnapshotAtTime(ctx sdk.Context, poolID string, t time.Time) (types.PoolPriceSnapshot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceSnapshotPrefix)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.PoolPriceSnapshotIteratorKey(poolID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PoolPriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if !snapshot.Time.After(t) {
+			return snapshot, true
+		}
+	}
+	return types.PoolPriceSnapshot{}, false
+}
+
+// GetPoolTWAP returns the time-weighted average prices of a pool between two block heights. The end height
+// defaults to the current height when zero. Each height is priced from the pool's last update at or before it,
+// so the average starts at the time of the last update at or before the start height.
+func (k Keeper) GetPoolTWAP(ctx sdk.Context, poolID string, startHeight, endHeight int64) (types.PoolTWAP, error) {
+	if endHeight == 0 {
+		endHeight = ctx.BlockHeight()
+	}
+	if startHeight <= 0 || startHeight > endHeight {
+		return types.PoolTWAP{}, errorsmod.Wrapf(types.ErrInvalidHeight, "start height %d must be positive and not after end height %d", startHeight, endHeight)
+	}
+	if endHeight > ctx.BlockHeight() {
+		return types.PoolTWAP{}, errorsmod.Wrapf(types.ErrInvalidHeight, "end height %d is after the current height %d", endHeight, ctx.BlockHeight())
+	}
+
+	current, err := k.currentPoolPriceSnapshot(ctx, poolID)
+	if err != nil {
+		return types.PoolTWAP{}, err
+	}
+
+	snapshotAt := func(height int64) (types.PoolPriceSnapshot, error) {
+		if height >= ctx.BlockHeight() {
+			return current, nil
+		}
+		snapshot, found := k.GetPoolPriceSnapshotAtHeight(ctx, poolID, height)
+		if !found {
+			return types.PoolPriceSnapshot{}, errorsmod.Wrapf(types.ErrPriceHistoryNotFound, "pool %s at height %d", poolID, height)
+		}
+		return snapshot, nil
+	}
+
+	start, err := snapshotAt(startHeight)
+	if err != nil {
+		return types.PoolTWAP{}, err
+	}
+	end, err := snapshotAt(endHeight)
+	if err != nil {
+		return types.PoolTWAP{}, err
+	}
+
+	return types.NewPoolTWAP(start, end), nil
+}
+
+// GetPoolTWAPOverWindow returns the time-weighted average prices of a pool over the window ending at the block time
+func (k Keeper) GetPoolTWAPOverWindow(ctx sdk.Context, poolID string, window time.Duration) (types.PoolTWAP, error) {
+	if window <= 0 {
+		return types.PoolTWAP{}, errorsmod.Wrapf(types.ErrInvalidWindow, "%s", window)
+	}
+
+	record, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.PoolTWAP{}, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+	current, err := types.NewPoolPriceSnapshotFromRecord(record, ctx.BlockHeight(), ctx.BlockTime())
+	if err != nil {
+		return types.PoolTWAP{}, err
+	}
+
+	startTime := ctx.BlockTime().Add(-window)
+
+	// the prices have not changed during the window
+	if !record.PriceLastUpdated.After(startTime) {
+		twap := types.NewPoolTWAP(current, current)
+		twap.StartTime = startTime
+		return twap, nil
+	}
+
+	snapshot, found := k.getPoolPriceSnapshotAtTime(ctx, poolID, startTime)
+	if !found {
+		return types.PoolTWAP{}, errorsmod.Wrapf(types.ErrPriceHistoryNotFound, "pool %s at time %s", poolID, startTime)
+	}
+
+	return types.NewPoolTWAP(snapshot.At(startTime), current), nil
+}
+
+// GetPoolTWAPPrice returns the time-weighted average price of a pool token in units of the other pool token
+// over the window ending at the block time
+func (k Keeper) GetPoolTWAPPrice(ctx sdk.Context, poolID string, denom string, window time.Duration) (sdk.Dec, error) {
+	twap, err := k.GetPoolTWAPOverWindow(ctx, poolID, window)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return twap.Price(denom)
+}
+
+// currentPoolPriceSnapshot returns the prices of a pool at the current block
+func (k Keeper) currentPoolPriceSnapshot(ctx sdk.Context, poolID string) (types.PoolPriceSnapshot, error) {
+	record, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.PoolPriceSnapshot{}, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+	return types.NewPoolPriceSnapshotFromRecord(record, ctx.BlockHeight(), ctx.BlockTime())
+}
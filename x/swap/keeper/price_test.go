@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) TestPoolPriceAccumulators() {
+	startTime := suite.Ctx.BlockTime()
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolID("ukava", "usdx")
+
+	record, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(sdk.ZeroDec(), record.PriceCumulativeA)
+	suite.Equal(sdk.ZeroDec(), record.PriceCumulativeB)
+	suite.Equal(startTime, record.PriceLastUpdated)
+
+	// a swap accumulates the prices before the swap
+	suite.Ctx = suite.Ctx.WithBlockHeight(11).WithBlockTime(startTime.Add(60 * time.Second))
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e5)), sdk.NewCoin("usdx", sdkmath.NewInt(45e4)), sdk.MustNewDecFromStr("0.1"))
+	suite.Require().NoError(err)
+
+	record, found = suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(300), record.PriceCumulativeA)
+	suite.Equal(sdk.NewDec(12), record.PriceCumulativeB)
+	suite.Equal(startTime.Add(60*time.Second), record.PriceLastUpdated)
+
+	// a deposit accumulates the prices after the swap
+	suite.Ctx = suite.Ctx.WithBlockHeight(21).WithBlockTime(startTime.Add(120 * time.Second))
+	priceA, priceB, err := record.SpotPrices()
+	suite.Require().NoError(err)
+	depositor := suite.CreateAccount(reserves)
+	err = suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e5)), sdk.NewCoin("usdx", sdkmath.NewInt(5e5)), sdk.MustNewDecFromStr("1"))
+	suite.Require().NoError(err)
+
+	record, found = suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(300).Add(priceA.MulInt64(60)), record.PriceCumulativeA)
+	suite.Equal(sdk.NewDec(12).Add(priceB.MulInt64(60)), record.PriceCumulativeB)
+
+	// a snapshot is recorded for each update
+	snapshots := suite.Keeper.GetAllPoolPriceSnapshots(suite.Ctx)
+	suite.Require().Len(snapshots, 3)
+	suite.Equal([]int64{1, 11, 21}, []int64{snapshots[0].Height, snapshots[1].Height, snapshots[2].Height})
+	suite.Equal(record.PriceCumulativeA, snapshots[2].PriceCumulativeA)
+	suite.Equal(priceA, snapshots[1].PriceA)
+}
+
+func (suite *keeperTestSuite) TestPoolPriceSnapshots_Pruning() {
+	startTime := suite.Ctx.BlockTime()
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolID("ukava", "usdx")
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.PriceHistoryBlocks = 10
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	depositor := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6))))
+	deposit := func(height int64) {
+		suite.Ctx = suite.Ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(time.Duration(height) * time.Second))
+		err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e4)), sdk.NewCoin("usdx", sdkmath.NewInt(5e4)), sdk.MustNewDecFromStr("1"))
+		suite.Require().NoError(err)
+	}
+
+	heights := func() (heights []int64) {
+		for _, snapshot := range suite.Keeper.GetAllPoolPriceSnapshots(suite.Ctx) {
+			heights = append(heights, snapshot.Height)
+		}
+		return heights
+	}
+
+	deposit(11)
+	suite.Equal([]int64{1, 11}, heights())
+	deposit(21)
+	suite.Equal([]int64{11, 21}, heights(), "expected the newest snapshot at the start of the history to be kept")
+	deposit(25)
+	deposit(32)
+	suite.Equal([]int64{21, 25, 32}, heights())
+
+	// disabling the price history removes the pool's snapshots on its next update
+	params.PriceHistoryBlocks = 0
+	suite.Keeper.SetParams(suite.Ctx, params)
+	deposit(33)
+	suite.Empty(heights())
+
+	params.PriceHistoryBlocks = 10
+	suite.Keeper.SetParams(suite.Ctx, params)
+	deposit(34)
+	suite.Equal([]int64{34}, heights())
+
+	// withdrawing all shares removes the pool and its snapshots
+	shareRecord, found := suite.Keeper.GetDepositorShares(suite.Ctx, depositor.GetAddress(), poolID)
+	suite.Require().True(found)
+	suite.Require().NoError(suite.Keeper.Withdraw(suite.Ctx, depositor.GetAddress(), shareRecord.SharesOwned, sdk.NewCoin("ukava", sdkmath.NewInt(1)), sdk.NewCoin("usdx", sdkmath.NewInt(1))))
+	suite.PoolDeleted("ukava", "usdx")
+	suite.Empty(heights())
+}
+
+func (suite *keeperTestSuite) TestGetPoolTWAP() {
+	startTime := suite.Ctx.BlockTime()
+	suite.Ctx = suite.Ctx.WithBlockHeight(5)
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolID("ukava", "usdx")
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(15).WithBlockTime(startTime.Add(60 * time.Second))
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e5)), sdk.NewCoin("usdx", sdkmath.NewInt(45e4)), sdk.MustNewDecFromStr("0.1"))
+	suite.Require().NoError(err)
+	record, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	priceA, priceB, err := record.SpotPrices()
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(25).WithBlockTime(startTime.Add(120 * time.Second))
+
+	// the pool was priced at 5 until the swap
+	twap, err := suite.Keeper.GetPoolTWAP(suite.Ctx, poolID, 5, 15)
+	suite.Require().NoError(err)
+	suite.Equal(startTime, twap.StartTime)
+	suite.Equal(startTime.Add(60*time.Second), twap.EndTime)
+	suite.Equal(sdk.NewDec(5), twap.PriceA)
+	suite.Equal(sdk.MustNewDecFromStr("0.2"), twap.PriceB)
+
+	// heights between updates are priced from the last update
+	twap, err = suite.Keeper.GetPoolTWAP(suite.Ctx, poolID, 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(startTime, twap.StartTime)
+	suite.Equal(startTime.Add(120*time.Second), twap.EndTime)
+	suite.Equal(sdk.NewDec(5).Add(priceA).QuoInt64(2), twap.PriceA)
+	suite.Equal(sdk.MustNewDecFromStr("0.2").Add(priceB).QuoInt64(2), twap.PriceB)
+
+	// the same heights return the spot price
+	twap, err = suite.Keeper.GetPoolTWAP(suite.Ctx, poolID, 25, 25)
+	suite.Require().NoError(err)
+	suite.Equal(priceA, twap.PriceA)
+
+	_, err = suite.Keeper.GetPoolTWAP(suite.Ctx, poolID, 4, 15)
+	suite.ErrorIs(err, types.ErrPriceHistoryNotFound)
+	_, err = suite.Keeper.GetPoolTWAP(suite.Ctx, poolID, 0, 15)
+	suite.ErrorIs(err, types.ErrInvalidHeight)
+	_, err = suite.Keeper.GetPoolTWAP(suite.Ctx, poolID, 15, 10)
+	suite.ErrorIs(err, types.ErrInvalidHeight)
+	_, err = suite.Keeper.GetPoolTWAP(suite.Ctx, poolID, 5, 26)
+	suite.ErrorIs(err, types.ErrInvalidHeight)
+	_, err = suite.Keeper.GetPoolTWAP(suite.Ctx, types.PoolID("hard", "usdx"), 5, 15)
+	suite.ErrorIs(err, types.ErrInvalidPool)
+}
+
+func (suite *keeperTestSuite) TestGetPoolTWAPOverWindow() {
+	startTime := suite.Ctx.BlockTime()
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolID("ukava", "usdx")
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(11).WithBlockTime(startTime.Add(60 * time.Second))
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e5)), sdk.NewCoin("usdx", sdkmath.NewInt(45e4)), sdk.MustNewDecFromStr("0.1"))
+	suite.Require().NoError(err)
+	record, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	priceA, _, err := record.SpotPrices()
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(21).WithBlockTime(startTime.Add(120 * time.Second))
+
+	// a window after the last update returns the spot price
+	twap, err := suite.Keeper.GetPoolTWAPOverWindow(suite.Ctx, poolID, 30*time.Second)
+	suite.Require().NoError(err)
+	suite.Equal(startTime.Add(90*time.Second), twap.StartTime)
+	suite.Equal(priceA, twap.PriceA)
+
+	// a window spanning the swap weights each price by its duration
+	twap, err = suite.Keeper.GetPoolTWAPOverWindow(suite.Ctx, poolID, 90*time.Second)
+	suite.Require().NoError(err)
+	suite.Equal(startTime.Add(30*time.Second), twap.StartTime)
+	suite.Equal(sdk.NewDec(150).Add(priceA.MulInt64(60)).QuoInt64(90), twap.PriceA)
+
+	price, err := suite.Keeper.GetPoolTWAPPrice(suite.Ctx, poolID, "ukava", 90*time.Second)
+	suite.Require().NoError(err)
+	suite.Equal(twap.PriceA, price)
+	price, err = suite.Keeper.GetPoolTWAPPrice(suite.Ctx, poolID, "usdx", 90*time.Second)
+	suite.Require().NoError(err)
+	suite.Equal(twap.PriceB, price)
+
+	_, err = suite.Keeper.GetPoolTWAPOverWindow(suite.Ctx, poolID, 3*time.Minute)
+	suite.ErrorIs(err, types.ErrPriceHistoryNotFound)
+	_, err = suite.Keeper.GetPoolTWAPOverWindow(suite.Ctx, poolID, 0)
+	suite.ErrorIs(err, types.ErrInvalidWindow)
+	_, err = suite.Keeper.GetPoolTWAPPrice(suite.Ctx, poolID, "hard", 90*time.Second)
+	suite.Error(err)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// HandleProtocolFeeTransferProposal is a handler for executing a passed protocol fee transfer proposal
+func HandleProtocolFeeTransferProposal(ctx sdk.Context, k Keeper, p *types.ProtocolFeeTransferProposal) error {
+	return k.TransferProtocolFees(ctx, p.Amount)
+}
+
+// TransferProtocolFees transfers accumulated protocol fees from the protocol fee account to the community pool
+func (k Keeper) TransferProtocolFees(ctx sdk.Context, amount sdk.Coins) error {
+	protocolFeeAcc := k.GetProtocolFeeModuleAccount(ctx)
+	if err := k.communityKeeper.FundCommunityPool(ctx, protocolFeeAcc.GetAddress(), amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapProtocolFeeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
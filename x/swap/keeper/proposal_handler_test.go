@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) TestHandleProtocolFeeTransferProposal() {
+	protocolFees := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(500)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(2500)),
+	)
+	err := suite.App.FundModuleAccount(suite.Ctx, types.ProtocolFeeAccountName, protocolFees)
+	suite.Require().NoError(err)
+
+	communityKeeper := suite.App.GetCommunityKeeper()
+	communityBalance := communityKeeper.GetModuleAccountBalance(suite.Ctx)
+
+	amount := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(2000)))
+	proposal := types.NewProtocolFeeTransferProposal("transfer fees", "send swap fees to the community pool", amount)
+	err = keeper.HandleProtocolFeeTransferProposal(suite.Ctx, suite.Keeper, proposal)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(suite.Keeper.GetProtocolFeeModuleAccount(suite.Ctx).GetAddress(), protocolFees.Sub(amount...))
+	suite.Equal(communityBalance.Add(amount...), communityKeeper.GetModuleAccountBalance(suite.Ctx))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapProtocolFeeTransfer,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+}
+
+func (suite *keeperTestSuite) TestHandleProtocolFeeTransferProposal_InsufficientFunds() {
+	protocolFees := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(500)))
+	err := suite.App.FundModuleAccount(suite.Ctx, types.ProtocolFeeAccountName, protocolFees)
+	suite.Require().NoError(err)
+
+	amount := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(501)))
+	proposal := types.NewProtocolFeeTransferProposal("transfer fees", "send swap fees to the community pool", amount)
+	err = keeper.HandleProtocolFeeTransferProposal(suite.Ctx, suite.Keeper, proposal)
+	suite.Require().ErrorContains(err, "insufficient funds")
+
+	suite.AccountBalanceEqual(suite.Keeper.GetProtocolFeeModuleAccount(suite.Ctx).GetAddress(), protocolFees)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// GetBestSwapRoute searches the routes through existing pools for the one with the largest output when
+// swapping an exact input for denomOut. Routes visit each pool at most once and are at most
+// types.MaxSwapRouteLength pools long. If two routes have the same output the shorter one is returned.
+func (k Keeper) GetBestSwapRoute(ctx sdk.Context, tokenIn sdk.Coin, denomOut string) ([]string, sdk.Coin, error) {
+	poolsByDenom := make(map[string]types.PoolRecords)
+	for _, record := range k.GetAllPools(ctx) {
+		for _, denom := range strings.Split(record.PoolID, types.PoolIDSep) {
+			poolsByDenom[denom] = append(poolsByDenom[denom], record)
+		}
+	}
+
+	params := k.GetParams(ctx)
+
+	var bestRoute []string
+	bestOutput := sdk.NewCoin(denomOut, sdk.ZeroInt())
+	visited := make(map[string]bool)
+
+	var search func(route []string, input sdk.Coin)
+	search = func(route []string, input sdk.Coin) {
+		if len(route) == types.MaxSwapRouteLength {
+			return
+		}
+		for _, record := range poolsByDenom[input.Denom] {
+			if visited[record.PoolID] {
+				continue
+			}
+
+			pool, err := types.NewDenominatedPoolFromRecord(record)
+			if err != nil {
+				continue
+			}
+			output, _ := pool.SwapWithExactInput(input, params.PoolSwapFee(record.PoolID))
+			if output.IsZero() {
+				continue
+			}
+
+			next := append(append([]string{}, route...), record.PoolID)
+			if output.Denom == denomOut {
+				if output.Amount.GT(bestOutput.Amount) || (output.Amount.Equal(bestOutput.Amount) && len(next) < len(bestRoute)) {
+					bestRoute, bestOutput = next, output
+				}
+				continue
+			}
+
+			visited[record.PoolID] = true
+			search(next, output)
+			visited[record.PoolID] = false
+		}
+	}
+	search(nil, tokenIn)
+
+	if len(bestRoute) == 0 {
+		return nil, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidRoute, "no route found from %s to %s", tokenIn.Denom, denomOut)
+	}
+
+	return bestRoute, bestOutput, nil
+}
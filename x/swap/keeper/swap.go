@@ -16,7 +16,7 @@ func (k *Keeper) SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, e
 		return err
 	}
 
-	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetSwapFee(ctx))
+	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetPoolSwapFee(ctx, poolID))
 	if swapOutput.IsZero() {
 		return errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
 	}
@@ -47,7 +47,7 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 		)
 	}
 
-	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetSwapFee(ctx))
+	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetPoolSwapFee(ctx, poolID))
 
 	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(swapInput.Sub(feePaid).Amount))
 	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
@@ -61,6 +61,145 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 	return nil
 }
 
+// SwapExactForTokensMultiHop swaps an exact coin a input for a coin b output through a route of pools.
+// The output of each pool is the input of the next, and slippage is checked on the final output.
+func (k *Keeper) SwapExactForTokensMultiHop(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, route []string, slippageLimit sdk.Dec) error {
+	hops, err := k.simulateSwapWithExactInput(ctx, exactCoinA, route)
+	if err != nil {
+		return err
+	}
+
+	swapOutput := hops[len(hops)-1].output
+	if swapOutput.Denom != coinB.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidRoute, "route ends in %s, not %s", swapOutput.Denom, coinB.Denom)
+	}
+
+	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	return k.commitSwapHops(ctx, requester, hops, "input")
+}
+
+// SwapForExactTokensMultiHop swaps a coin a input for an exact coin b output through a route of pools.
+// The input required by each pool is the output of the previous, and slippage is checked on the
+// initial input, which includes the fees paid to every pool in the route.
+func (k *Keeper) SwapForExactTokensMultiHop(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, route []string, slippageLimit sdk.Dec) error {
+	hops, err := k.simulateSwapWithExactOutput(ctx, exactCoinB, route)
+	if err != nil {
+		return err
+	}
+
+	swapInput := hops[0].input
+	if swapInput.Denom != coinA.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidRoute, "route starts with %s, not %s", swapInput.Denom, coinA.Denom)
+	}
+
+	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(swapInput.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	return k.commitSwapHops(ctx, requester, hops, "output")
+}
+
+// swapHop is a simulated swap through a single pool of a route
+type swapHop struct {
+	poolID  string
+	pool    *types.DenominatedPool
+	input   sdk.Coin
+	output  sdk.Coin
+	feePaid sdk.Coin
+}
+
+// simulateSwapWithExactInput swaps an exact input through each pool of a route in order, without
+// committing the swaps to the store.
+func (k Keeper) simulateSwapWithExactInput(ctx sdk.Context, exactInput sdk.Coin, route []string) ([]swapHop, error) {
+	if len(route) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRoute, "route cannot be empty")
+	}
+
+	hops := make([]swapHop, 0, len(route))
+	input := exactInput
+	for _, poolID := range route {
+		pool, err := k.loadRoutePool(ctx, poolID, input.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		output, feePaid := pool.SwapWithExactInput(input, k.GetPoolSwapFee(ctx, poolID))
+		if output.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output of pool %s rounds to zero, increase input amount", poolID)
+		}
+
+		hops = append(hops, swapHop{poolID: poolID, pool: pool, input: input, output: output, feePaid: feePaid})
+		input = output
+	}
+
+	return hops, nil
+}
+
+// simulateSwapWithExactOutput swaps for an exact output through each pool of a route in reverse
+// order, without committing the swaps to the store. The returned hops are in route order.
+func (k Keeper) simulateSwapWithExactOutput(ctx sdk.Context, exactOutput sdk.Coin, route []string) ([]swapHop, error) {
+	if len(route) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRoute, "route cannot be empty")
+	}
+
+	hops := make([]swapHop, len(route))
+	output := exactOutput
+	for i := len(route) - 1; i >= 0; i-- {
+		poolID := route[i]
+		pool, err := k.loadRoutePool(ctx, poolID, output.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if output.Amount.GTE(pool.Reserves().AmountOf(output.Denom)) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInsufficientLiquidity,
+				"output %s >= pool %s reserves %s", output.Amount.String(), poolID, pool.Reserves().AmountOf(output.Denom).String(),
+			)
+		}
+
+		input, feePaid := pool.SwapWithExactOutput(output, k.GetPoolSwapFee(ctx, poolID))
+
+		hops[i] = swapHop{poolID: poolID, pool: pool, input: input, output: output, feePaid: feePaid}
+		output = input
+	}
+
+	return hops, nil
+}
+
+// loadRoutePool loads a pool of a route, returning an error if the pool does not contain denom
+func (k Keeper) loadRoutePool(ctx sdk.Context, poolID string, denom string) (*types.DenominatedPool, error) {
+	pool, err := k.loadDenominatedPool(ctx, poolID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	if pool.Reserves().AmountOf(denom).IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRoute, "pool %s does not contain %s", poolID, denom)
+	}
+
+	return pool, nil
+}
+
+// commitSwapHops commits each simulated swap of a route in order. The swaps are committed
+// atomically, so no state is written if any of them fail.
+func (k Keeper) commitSwapHops(ctx sdk.Context, requester sdk.AccAddress, hops []swapHop, exactDirection string) error {
+	cacheCtx, write := ctx.CacheContext()
+	for _, hop := range hops {
+		if err := k.commitSwap(cacheCtx, hop.poolID, hop.pool, requester, hop.input, hop.output, hop.feePaid, exactDirection); err != nil {
+			return err
+		}
+	}
+	write()
+
+	return nil
+}
+
 func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string, *types.DenominatedPool, error) {
 	poolID := types.PoolID(denomA, denomB)
 
@@ -69,7 +208,7 @@ func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string,
 		return poolID, nil, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
@@ -96,16 +235,58 @@ func (k Keeper) commitSwap(
 	feePaid sdk.Coin,
 	exactDirection string,
 ) error {
-	k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
-
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
 		return err
 	}
 
+	k.settleSwap(ctx, poolID, pool, requester, swapInput, swapOutput, feePaid, exactDirection)
+
+	return nil
+}
+
+// settleSwap saves a pool after a swap whose input has already been paid to the module account, paying the output
+// to the requester and the protocol share of the fee to the protocol fee account.
+func (k Keeper) settleSwap(
+	ctx sdk.Context,
+	poolID string,
+	pool *types.DenominatedPool,
+	requester sdk.AccAddress,
+	swapInput sdk.Coin,
+	swapOutput sdk.Coin,
+	feePaid sdk.Coin,
+	exactDirection string,
+) {
+	// the protocol share of the fee is removed from the pool reserves, leaving the rest for liquidity providers
+	protocolFee := sdk.NewCoin(feePaid.Denom, sdk.NewDecFromInt(feePaid.Amount).Mul(k.GetProtocolFeeShare(ctx)).TruncateInt())
+
+	record := types.NewPoolRecordFromPool(pool)
+	if record.ReservesA.Denom == protocolFee.Denom {
+		record.ReservesA = record.ReservesA.Sub(protocolFee)
+	} else {
+		record.ReservesB = record.ReservesB.Sub(protocolFee)
+	}
+	k.updatePoolRecord(ctx, record)
+
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, requester, sdk.NewCoins(swapOutput)); err != nil {
 		panic(err)
 	}
 
+	if protocolFee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, types.ProtocolFeeAccountName, sdk.NewCoins(protocolFee)); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapProtocolFee,
+				sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, protocolFee.String()),
+			),
+		)
+	}
+
+	k.addPoolFees(ctx, poolID, feePaid.Sub(protocolFee), protocolFee)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSwapTrade,
@@ -117,6 +298,4 @@ func (k Keeper) commitSwap(
 			sdk.NewAttribute(types.AttributeKeyExactDirection, exactDirection),
 		),
 	)
-
-	return nil
 }
@@ -14,7 +14,9 @@ import (
 
 func (suite *keeperTestSuite) TestSwapExactForTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeShare: sdk.ZeroDec(),
+		MinOrderShare:    sdk.ZeroDec(),
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -131,7 +133,9 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:          tc.fee,
+				ProtocolFeeShare: sdk.ZeroDec(),
+				MinOrderShare:    sdk.ZeroDec(),
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -321,7 +325,9 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_PanicOnInsufficientModuleAc
 
 func (suite *keeperTestSuite) TestSwapForExactTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeShare: sdk.ZeroDec(),
+		MinOrderShare:    sdk.ZeroDec(),
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -442,7 +448,9 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:          tc.fee,
+				ProtocolFeeShare: sdk.ZeroDec(),
+				MinOrderShare:    sdk.ZeroDec(),
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -629,3 +637,421 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_PanicOnInsufficientModuleAc
 		_ = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
 	}, "expected panic when module account does not have enough funds")
 }
+
+func (suite *keeperTestSuite) TestSwapExactForTokensMultiHop() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeShare: sdk.ZeroDec(),
+		MinOrderShare:    sdk.ZeroDec(),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(4000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(2.5e6))
+
+	err := suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, coinB, []string{poolIDA, poolIDB}, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	intermediate := sdk.NewCoin("usdx", sdkmath.NewInt(4982529))
+	expectedOutput := sdk.NewCoin("hard", sdkmath.NewInt(2481952))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reservesA.Add(reservesB...).Add(coinA).Sub(expectedOutput))
+	suite.PoolReservesEqual(poolIDA, reservesA.Add(coinA).Sub(intermediate))
+	suite.PoolReservesEqual(poolIDB, reservesB.Add(intermediate).Sub(expectedOutput))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDA),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDB),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "12457usdx"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensMultiHop_Failures() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(4000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	testCases := []struct {
+		name   string
+		coinA  sdk.Coin
+		coinB  sdk.Coin
+		route  []string
+		expErr string
+	}{
+		{
+			name:   "pool not found",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+			route:  []string{poolIDA, types.PoolID("hard", "ukava")},
+			expErr: "pool hard:ukava not found: invalid pool",
+		},
+		{
+			name:   "pool does not contain input",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+			route:  []string{poolIDB, poolIDA},
+			expErr: "pool hard:usdx does not contain ukava: invalid route",
+		},
+		{
+			name:   "route does not end in coin b",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+			route:  []string{poolIDA},
+			expErr: "route ends in usdx, not hard: invalid route",
+		},
+		{
+			name:   "slippage exceeded",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(3e6)),
+			route:  []string{poolIDA, poolIDB},
+			expErr: "slippage 0.168537666666666667 > limit 0.010000000000000000: slippage exceeded",
+		},
+		{
+			name:   "insufficient funds",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(11e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(1e6)),
+			route:  []string{poolIDA, poolIDB},
+			expErr: "spendable balance 10000000ukava is smaller than 11000000ukava: insufficient funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+			err := suite.Keeper.SwapExactForTokensMultiHop(ctx, requester.GetAddress(), tc.coinA, tc.coinB, tc.route, sdk.MustNewDecFromStr("0.01"))
+			suite.EqualError(err, tc.expErr)
+
+			suite.AccountBalanceEqual(requester.GetAddress(), balance)
+			suite.PoolReservesEqual(poolIDA, reservesA)
+			suite.PoolReservesEqual(poolIDB, reservesB)
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensMultiHop() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:          sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeShare: sdk.ZeroDec(),
+		MinOrderShare:    sdk.ZeroDec(),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(4000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(2.5e6))
+
+	err := suite.Keeper.SwapForExactTokensMultiHop(suite.Ctx, requester.GetAddress(), coinA, coinB, []string{poolIDA, poolIDB}, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	intermediate := sdk.NewCoin("usdx", sdkmath.NewInt(5018806))
+	expectedInput := sdk.NewCoin("ukava", sdkmath.NewInt(1007289))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(expectedInput).Add(coinB))
+	suite.ModuleAccountBalanceEqual(reservesA.Add(reservesB...).Add(expectedInput).Sub(coinB))
+	suite.PoolReservesEqual(poolIDA, reservesA.Add(expectedInput).Sub(intermediate))
+	suite.PoolReservesEqual(poolIDB, reservesB.Add(intermediate).Sub(coinB))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDA),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, expectedInput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2519ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolIDB),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediate.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, coinB.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "12548usdx"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensMultiHop_Failures() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reservesA := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	reservesB := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(4000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolIDA := suite.setupPool(reservesA, totalShares, owner.GetAddress())
+	poolIDB := suite.setupPool(reservesB, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	testCases := []struct {
+		name   string
+		coinA  sdk.Coin
+		coinB  sdk.Coin
+		route  []string
+		expErr string
+	}{
+		{
+			name:   "pool not found",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+			route:  []string{types.PoolID("bnb", "usdx"), poolIDB},
+			expErr: "pool bnb:usdx not found: invalid pool",
+		},
+		{
+			name:   "route does not start with coin a",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+			route:  []string{poolIDB},
+			expErr: "route starts with usdx, not ukava: invalid route",
+		},
+		{
+			name:   "output greater than pool reserves",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+			route:  []string{poolIDA, poolIDB},
+			expErr: "output 2000000000 >= pool hard:usdx reserves 2000000000: insufficient liquidity",
+		},
+		{
+			name:   "slippage exceeded",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(3e6)),
+			route:  []string{poolIDA, poolIDB},
+			expErr: "slippage 0.168916824364699243 > limit 0.010000000000000000: slippage exceeded",
+		},
+		{
+			name:   "insufficient funds",
+			coinA:  sdk.NewCoin("ukava", sdkmath.NewInt(11e6)),
+			coinB:  sdk.NewCoin("hard", sdkmath.NewInt(27e6)),
+			route:  []string{poolIDA, poolIDB},
+			expErr: "spendable balance 10000000ukava is smaller than 11068977ukava: insufficient funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+			err := suite.Keeper.SwapForExactTokensMultiHop(ctx, requester.GetAddress(), tc.coinA, tc.coinB, tc.route, sdk.MustNewDecFromStr("0.01"))
+			suite.EqualError(err, tc.expErr)
+
+			suite.AccountBalanceEqual(requester.GetAddress(), balance)
+			suite.PoolReservesEqual(poolIDA, reservesA)
+			suite.PoolReservesEqual(poolIDB, reservesB)
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestGetBestSwapRoute() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	totalShares := sdkmath.NewInt(30e6)
+	suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	), totalShares, owner.GetAddress())
+	suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(4000e6)),
+	), totalShares, owner.GetAddress())
+	// a shallow direct pool gives a worse price than routing through usdx
+	suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(3e6)),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+	), totalShares, owner.GetAddress())
+	suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("bnb", sdkmath.NewInt(100e6)),
+		sdk.NewCoin("busd", sdkmath.NewInt(100e6)),
+	), totalShares, owner.GetAddress())
+
+	route, tokenOut, err := suite.Keeper.GetBestSwapRoute(suite.Ctx, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "hard")
+	suite.Require().NoError(err)
+	suite.Equal([]string{"ukava:usdx", "hard:usdx"}, route)
+	suite.Equal(sdk.NewCoin("hard", sdkmath.NewInt(2494387)), tokenOut)
+
+	// the route with the largest output matches simulating the swap
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err = suite.Keeper.SwapExactForTokensMultiHop(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), tokenOut, route, sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(tokenOut))
+
+	// a small swap is better through the direct pool
+	route, _, err = suite.Keeper.GetBestSwapRoute(suite.Ctx, sdk.NewCoin("ukava", sdkmath.NewInt(1000)), "hard")
+	suite.Require().NoError(err)
+	suite.Equal([]string{"hard:ukava"}, route)
+
+	_, _, err = suite.Keeper.GetBestSwapRoute(suite.Ctx, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "bnb")
+	suite.EqualError(err, "no route found from ukava to bnb: invalid route")
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokens_PoolSwapFeeAndProtocolFee() {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(
+			types.AllowedPool{TokenA: "ukava", TokenB: "usdx", SwapFee: sdk.MustNewDecFromStr("0.0025")},
+		),
+		sdk.MustNewDecFromStr("0.01"),
+		sdk.MustNewDecFromStr("0.2"),
+		types.DefaultPriceHistoryBlocks,
+		types.DefaultMaxOrderFills,
+		types.DefaultMinOrderShare,
+		types.DefaultMaxOrderDuration),
+	)
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolID := suite.setupPool(reserves, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	// the pool swap fee of 0.25% is used instead of the global 1% swap fee
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(4982529))
+	expectedProtocolFee := sdk.NewCoin("ukava", sdkmath.NewInt(500))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(expectedProtocolFee))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(expectedProtocolFee))
+	suite.AccountBalanceEqual(suite.Keeper.GetProtocolFeeModuleAccount(suite.Ctx).GetAddress(), sdk.NewCoins(expectedProtocolFee))
+
+	feeRecord, found := suite.Keeper.GetPoolFeeRecord(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(2000))), feeRecord.LPFees)
+	suite.Equal(sdk.NewCoins(expectedProtocolFee), feeRecord.ProtocolFees)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapProtocolFee,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedProtocolFee.String()),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokens_PoolSwapFeeAndProtocolFee() {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(
+			types.AllowedPool{TokenA: "ukava", TokenB: "usdx", SwapFee: sdk.MustNewDecFromStr("0.0025")},
+		),
+		sdk.MustNewDecFromStr("0.01"),
+		sdk.MustNewDecFromStr("0.2"),
+		types.DefaultPriceHistoryBlocks,
+		types.DefaultMaxOrderFills,
+		types.DefaultMinOrderShare,
+		types.DefaultMaxOrderDuration),
+	)
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolID := suite.setupPool(reserves, totalShares, owner.GetAddress())
+
+	// an existing fee record is added to
+	suite.Keeper.SetPoolFeeRecord(suite.Ctx, types.NewPoolFeeRecord(
+		poolID,
+		sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000))),
+		sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(250))),
+	))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	err := suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	expectedInput := sdk.NewCoin("ukava", sdkmath.NewInt(1003511))
+	expectedProtocolFee := sdk.NewCoin("ukava", sdkmath.NewInt(501))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(expectedInput).Add(coinB))
+	suite.ModuleAccountBalanceEqual(reserves.Add(expectedInput).Sub(coinB).Sub(expectedProtocolFee))
+	suite.PoolLiquidityEqual(reserves.Add(expectedInput).Sub(coinB).Sub(expectedProtocolFee))
+	suite.AccountBalanceEqual(suite.Keeper.GetProtocolFeeModuleAccount(suite.Ctx).GetAddress(), sdk.NewCoins(expectedProtocolFee))
+
+	feeRecord, found := suite.Keeper.GetPoolFeeRecord(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(2008)), sdk.NewCoin("usdx", sdkmath.NewInt(1000))), feeRecord.LPFees)
+	suite.Equal(sdk.NewCoins(expectedProtocolFee, sdk.NewCoin("usdx", sdkmath.NewInt(250))), feeRecord.ProtocolFees)
+}
@@ -1,6 +1,8 @@
 package v0_16
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	v015swap "github.com/kava-labs/kava/x/swap/legacy/v0_15"
 	v016swap "github.com/kava-labs/kava/x/swap/types"
 )
@@ -8,25 +10,29 @@ import (
 func migrateParams(params v015swap.Params) v016swap.Params {
 	allowedPools := make(v016swap.AllowedPools, len(params.AllowedPools))
 	for i, pool := range params.AllowedPools {
-		allowedPools[i] = v016swap.AllowedPool{
-			TokenA: pool.TokenA,
-			TokenB: pool.TokenB,
-		}
-	}
-	return v016swap.Params{
-		AllowedPools: allowedPools,
-		SwapFee:      params.SwapFee,
+		allowedPools[i] = v016swap.NewAllowedPool(pool.TokenA, pool.TokenB)
 	}
+	return v016swap.NewParams(
+		allowedPools,
+		params.SwapFee,
+		v016swap.DefaultProtocolFeeShare,
+		v016swap.DefaultPriceHistoryBlocks,
+		v016swap.DefaultMaxOrderFills,
+		v016swap.DefaultMinOrderShare,
+		v016swap.DefaultMaxOrderDuration,
+	)
 }
 
 func migratePoolRecords(oldRecords v015swap.PoolRecords) v016swap.PoolRecords {
 	newRecords := make(v016swap.PoolRecords, len(oldRecords))
 	for i, oldRecord := range oldRecords {
 		newRecords[i] = v016swap.PoolRecord{
-			PoolID:      oldRecord.PoolID,
-			ReservesA:   oldRecord.ReservesA,
-			ReservesB:   oldRecord.ReservesB,
-			TotalShares: oldRecord.TotalShares,
+			PoolID:           oldRecord.PoolID,
+			ReservesA:        oldRecord.ReservesA,
+			ReservesB:        oldRecord.ReservesB,
+			TotalShares:      oldRecord.TotalShares,
+			PriceCumulativeA: sdk.ZeroDec(),
+			PriceCumulativeB: sdk.ZeroDec(),
 		}
 	}
 	return newRecords
@@ -47,8 +53,12 @@ func migrateShareRecords(oldRecords v015swap.ShareRecords) v016swap.ShareRecords
 // Migrate converts v0.15 swap state and returns it in v0.16 format
 func Migrate(oldState v015swap.GenesisState) *v016swap.GenesisState {
 	return &v016swap.GenesisState{
-		Params:       migrateParams(oldState.Params),
-		PoolRecords:  migratePoolRecords(oldState.PoolRecords),
-		ShareRecords: migrateShareRecords(oldState.ShareRecords),
+		Params:             migrateParams(oldState.Params),
+		PoolRecords:        migratePoolRecords(oldState.PoolRecords),
+		ShareRecords:       migrateShareRecords(oldState.ShareRecords),
+		PoolFeeRecords:     v016swap.PoolFeeRecords{},
+		PoolPriceSnapshots: v016swap.PoolPriceSnapshots{},
+		Orders:             v016swap.Orders{},
+		NextOrderID:        v016swap.DefaultNextOrderID,
 	}
 }
@@ -69,10 +69,15 @@ func (s *migrateTestSuite) TestMigrate_Params() {
 		},
 	}
 	expectedParams := v016swap.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.33"),
+		SwapFee:            sdk.MustNewDecFromStr("0.33"),
+		ProtocolFeeShare:   sdk.ZeroDec(),
+		PriceHistoryBlocks: v016swap.DefaultPriceHistoryBlocks,
+		MaxOrderFills:      v016swap.DefaultMaxOrderFills,
+		MinOrderShare:      v016swap.DefaultMinOrderShare,
+		MaxOrderDuration:   v016swap.DefaultMaxOrderDuration,
 		AllowedPools: v016swap.AllowedPools{
-			{TokenA: "A", TokenB: "B"},
-			{TokenA: "C", TokenB: "D"},
+			{TokenA: "A", TokenB: "B", SwapFee: sdk.ZeroDec()},
+			{TokenA: "C", TokenB: "D", SwapFee: sdk.ZeroDec()},
 		},
 	}
 	s.v15genstate.Params = params
@@ -97,16 +102,20 @@ func (s *migrateTestSuite) TestMigrate_PoolRecords() {
 	}
 	expected := v016swap.PoolRecords{
 		{
-			PoolID:      "pool-1",
-			ReservesA:   sdk.NewCoin("usdx", sdkmath.NewInt(100)),
-			ReservesB:   sdk.NewCoin("xrpb", sdkmath.NewInt(200)),
-			TotalShares: sdkmath.NewInt(300),
+			PoolID:           "pool-1",
+			ReservesA:        sdk.NewCoin("usdx", sdkmath.NewInt(100)),
+			ReservesB:        sdk.NewCoin("xrpb", sdkmath.NewInt(200)),
+			TotalShares:      sdkmath.NewInt(300),
+			PriceCumulativeA: sdk.ZeroDec(),
+			PriceCumulativeB: sdk.ZeroDec(),
 		},
 		{
-			PoolID:      "pool-2",
-			ReservesA:   sdk.NewCoin("usdx", sdkmath.NewInt(500)),
-			ReservesB:   sdk.NewCoin("ukava", sdkmath.NewInt(500)),
-			TotalShares: sdkmath.NewInt(1000),
+			PoolID:           "pool-2",
+			ReservesA:        sdk.NewCoin("usdx", sdkmath.NewInt(500)),
+			ReservesB:        sdk.NewCoin("ukava", sdkmath.NewInt(500)),
+			TotalShares:      sdkmath.NewInt(1000),
+			PriceCumulativeA: sdk.ZeroDec(),
+			PriceCumulativeB: sdk.ZeroDec(),
 		},
 	}
 	genState := Migrate(s.v15genstate)
@@ -80,6 +80,13 @@ func (p *BasePool) ReservesB() sdkmath.Int {
 	return p.reservesB
 }
 
+// SpotPrice returns the marginal price of a in units of b.  Panics if the pool is empty.
+func (p *BasePool) SpotPrice() sdk.Dec {
+	p.assertReservesArePositive()
+
+	return sdk.NewDecFromInt(p.reservesB).Quo(sdk.NewDecFromInt(p.reservesA))
+}
+
 // IsEmpty returns true if all reserves are zero and
 // returns false if reserveA or reserveB is not empty
 func (p *BasePool) IsEmpty() bool {
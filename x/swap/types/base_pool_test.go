@@ -589,3 +589,25 @@ func TestBasePool_Panics_Swap_ExactOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestBasePool_SpotPrice(t *testing.T) {
+	testCases := []struct {
+		reservesA sdkmath.Int
+		reservesB sdkmath.Int
+		expected  sdk.Dec
+	}{
+		{i(1e6), i(1e6), d("1")},
+		{i(1e6), i(5e6), d("5")},
+		{i(5e6), i(1e6), d("0.2")},
+		{i(3), i(1), d("0.333333333333333333")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s", tc.reservesA, tc.reservesB), func(t *testing.T) {
+			pool, err := types.NewBasePool(tc.reservesA, tc.reservesB)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, pool.SpotPrice())
+		})
+	}
+}
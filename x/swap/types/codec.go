@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
@@ -16,6 +17,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdraw{}, "swap/MsgWithdraw", nil)
 	cdc.RegisterConcrete(&MsgSwapExactForTokens{}, "swap/MsgSwapExactForTokens", nil)
 	cdc.RegisterConcrete(&MsgSwapForExactTokens{}, "swap/MsgSwapForExactTokens", nil)
+	cdc.RegisterConcrete(&MsgSwapExactForTokensMultiHop{}, "swap/MsgSwapExactForTokensMultiHop", nil)
+	cdc.RegisterConcrete(&MsgSwapForExactTokensMultiHop{}, "swap/MsgSwapForExactTokensMultiHop", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "swap/MsgPlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelOrder{}, "swap/MsgCancelOrder", nil)
+	cdc.RegisterConcrete(&ProtocolFeeTransferProposal{}, "kava/ProtocolFeeTransferProposal", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -26,6 +32,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdraw{},
 		&MsgSwapExactForTokens{},
 		&MsgSwapForExactTokens{},
+		&MsgSwapExactForTokensMultiHop{},
+		&MsgSwapForExactTokensMultiHop{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelOrder{},
+	)
+	registry.RegisterImplementations((*govv1beta1.Content)(nil),
+		&ProtocolFeeTransferProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
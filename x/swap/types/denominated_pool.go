@@ -8,16 +8,40 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// DenominatedPool implements a denominated constant-product liquidity pool
+// liquidityPool is a unitless two asset liquidity pool that a DenominatedPool tracks the units of
+type liquidityPool interface {
+	ReservesA() sdkmath.Int
+	ReservesB() sdkmath.Int
+	TotalShares() sdkmath.Int
+	IsEmpty() bool
+	AddLiquidity(desiredA sdkmath.Int, desiredB sdkmath.Int) (sdkmath.Int, sdkmath.Int, sdkmath.Int)
+	RemoveLiquidity(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int)
+	ShareValue(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int)
+	SpotPrice() sdk.Dec
+	SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+}
+
+var (
+	_ liquidityPool = &BasePool{}
+	_ liquidityPool = &StableSwapPool{}
+)
+
+// DenominatedPool implements a denominated liquidity pool
 type DenominatedPool struct {
 	// all pool operations are implemented in a unitless base pool
-	pool *BasePool
+	pool liquidityPool
 	// track units of the reserveA and reserveB in base pool
 	denomA string
 	denomB string
+	// track the pricing curve of the base pool
+	poolType      PoolType
+	amplification uint64
 }
 
-// NewDenominatedPool creates a new denominated pool from reserve coins
+// NewDenominatedPool creates a new denominated constant-product pool from reserve coins
 func NewDenominatedPool(reserves sdk.Coins) (*DenominatedPool, error) {
 	if len(reserves) != 2 {
 		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
@@ -34,13 +58,14 @@ func NewDenominatedPool(reserves sdk.Coins) (*DenominatedPool, error) {
 	}
 
 	return &DenominatedPool{
-		pool:   pool,
-		denomA: reservesA.Denom,
-		denomB: reservesB.Denom,
+		pool:     pool,
+		denomA:   reservesA.Denom,
+		denomB:   reservesB.Denom,
+		poolType: POOL_TYPE_CONSTANT_PRODUCT,
 	}, nil
 }
 
-// NewDenominatedPoolWithExistingShares creates a new denominated pool from reserve coins
+// NewDenominatedPoolWithExistingShares creates a new denominated constant-product pool from reserve coins
 func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmath.Int) (*DenominatedPool, error) {
 	if len(reserves) != 2 {
 		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
@@ -57,17 +82,120 @@ func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmat
 	}
 
 	return &DenominatedPool{
-		pool:   pool,
-		denomA: reservesA.Denom,
-		denomB: reservesB.Denom,
+		pool:     pool,
+		denomA:   reservesA.Denom,
+		denomB:   reservesB.Denom,
+		poolType: POOL_TYPE_CONSTANT_PRODUCT,
+	}, nil
+}
+
+// NewDenominatedStableSwapPool creates a new denominated stableswap pool from reserve coins
+func NewDenominatedStableSwapPool(reserves sdk.Coins, amplification uint64) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	reservesA := reserves[0]
+	reservesB := reserves[1]
+
+	pool, err := NewStableSwapPool(reservesA.Amount, reservesB.Amount, amplification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:          pool,
+		denomA:        reservesA.Denom,
+		denomB:        reservesB.Denom,
+		poolType:      POOL_TYPE_STABLESWAP,
+		amplification: amplification,
 	}, nil
 }
 
+// NewDenominatedStableSwapPoolWithExistingShares creates a new denominated stableswap pool from reserve coins
+func NewDenominatedStableSwapPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmath.Int, amplification uint64) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	reservesA := reserves[0]
+	reservesB := reserves[1]
+
+	pool, err := NewStableSwapPoolWithExistingShares(reservesA.Amount, reservesB.Amount, totalShares, amplification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:          pool,
+		denomA:        reservesA.Denom,
+		denomB:        reservesB.Denom,
+		poolType:      POOL_TYPE_STABLESWAP,
+		amplification: amplification,
+	}, nil
+}
+
+// NewDenominatedPoolFromAllowedPool creates a new denominated pool from reserve coins, using the pricing curve
+// of an allowed pool
+func NewDenominatedPoolFromAllowedPool(allowedPool AllowedPool, reserves sdk.Coins) (*DenominatedPool, error) {
+	switch allowedPool.PoolType {
+	case POOL_TYPE_CONSTANT_PRODUCT:
+		return NewDenominatedPool(reserves)
+	case POOL_TYPE_STABLESWAP:
+		return NewDenominatedStableSwapPool(reserves, allowedPool.Amplification)
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "unknown pool type %s", allowedPool.PoolType)
+	}
+}
+
+// NewDenominatedPoolFromRecord creates a denominated pool from a stored pool record
+func NewDenominatedPoolFromRecord(record PoolRecord) (*DenominatedPool, error) {
+	switch record.PoolType {
+	case POOL_TYPE_CONSTANT_PRODUCT:
+		return NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	case POOL_TYPE_STABLESWAP:
+		return NewDenominatedStableSwapPoolWithExistingShares(record.Reserves(), record.TotalShares, record.Amplification)
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "unknown pool type %s", record.PoolType)
+	}
+}
+
+// PoolType returns the pricing curve of the pool
+func (p *DenominatedPool) PoolType() PoolType {
+	return p.poolType
+}
+
+// Amplification returns the amplification coefficient of a stableswap pool, and zero for other pools
+func (p *DenominatedPool) Amplification() uint64 {
+	return p.amplification
+}
+
 // Reserves returns the reserves held in the pool
 func (p *DenominatedPool) Reserves() sdk.Coins {
 	return p.coins(p.pool.ReservesA(), p.pool.ReservesB())
 }
 
+// SpotPrices returns the marginal price of token a in token b, and of token b in token a
+func (p *DenominatedPool) SpotPrices() (sdk.Dec, sdk.Dec) {
+	priceA := p.pool.SpotPrice()
+	return priceA, sdk.OneDec().Quo(priceA)
+}
+
+// SpotPriceOf returns the marginal price of a token in the other token of the pool.
+// It panics if the denom does not match the pool reserves.
+func (p *DenominatedPool) SpotPriceOf(denom string) sdk.Dec {
+	priceA, priceB := p.SpotPrices()
+
+	switch denom {
+	case p.denomA:
+		return priceA
+	case p.denomB:
+		return priceB
+	default:
+		panic(fmt.Sprintf("invalid denomination: denom '%s' does not match pool reserves", denom))
+	}
+}
+
 // TotalShares returns the total shares for the pool
 func (p *DenominatedPool) TotalShares() sdkmath.Int {
 	return p.pool.TotalShares()
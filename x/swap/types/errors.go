@@ -15,4 +15,11 @@ var (
 	ErrDepositNotFound       = errorsmod.Register(ModuleName, 10, "deposit not found")
 	ErrInvalidCoin           = errorsmod.Register(ModuleName, 11, "invalid coin")
 	ErrNotImplemented        = errorsmod.Register(ModuleName, 12, "not implemented")
+	ErrInvalidRoute          = errorsmod.Register(ModuleName, 13, "invalid route")
+	ErrPriceHistoryNotFound  = errorsmod.Register(ModuleName, 14, "price history not found")
+	ErrInvalidHeight         = errorsmod.Register(ModuleName, 15, "invalid height")
+	ErrInvalidWindow         = errorsmod.Register(ModuleName, 16, "invalid window")
+	ErrInvalidOrder          = errorsmod.Register(ModuleName, 17, "invalid order")
+	ErrOrderNotFound         = errorsmod.Register(ModuleName, 18, "order not found")
+	ErrOrderExpired          = errorsmod.Register(ModuleName, 19, "order expired")
 )
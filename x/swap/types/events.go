@@ -2,17 +2,28 @@ package types
 
 // Event types for swap module
 const (
-	AttributeValueCategory     = ModuleName
-	EventTypeSwapDeposit       = "swap_deposit"
-	EventTypeSwapWithdraw      = "swap_withdraw"
-	EventTypeSwapTrade         = "swap_trade"
-	AttributeKeyPoolID         = "pool_id"
-	AttributeKeyDepositor      = "depositor"
-	AttributeKeyShares         = "shares"
-	AttributeKeyOwner          = "owner"
-	AttributeKeyRequester      = "requester"
-	AttributeKeySwapInput      = "input"
-	AttributeKeySwapOutput     = "output"
-	AttributeKeyFeePaid        = "fee"
-	AttributeKeyExactDirection = "exact"
+	AttributeValueCategory           = ModuleName
+	EventTypeSwapDeposit             = "swap_deposit"
+	EventTypeSwapWithdraw            = "swap_withdraw"
+	EventTypeSwapTrade               = "swap_trade"
+	EventTypeSwapProtocolFee         = "swap_protocol_fee"
+	EventTypeSwapProtocolFeeTransfer = "swap_protocol_fee_transfer"
+	EventTypeSwapPlaceOrder          = "swap_place_order"
+	EventTypeSwapCancelOrder         = "swap_cancel_order"
+	EventTypeSwapExpireOrder         = "swap_expire_order"
+	EventTypeSwapFillOrder           = "swap_fill_order"
+	EventTypeSwapEvictOrder          = "swap_evict_order"
+	AttributeKeyPoolID               = "pool_id"
+	AttributeKeyDepositor            = "depositor"
+	AttributeKeyShares               = "shares"
+	AttributeKeyOwner                = "owner"
+	AttributeKeyRequester            = "requester"
+	AttributeKeySwapInput            = "input"
+	AttributeKeySwapOutput           = "output"
+	AttributeKeyFeePaid              = "fee"
+	AttributeKeyExactDirection       = "exact"
+	AttributeKeyOrderID              = "order_id"
+	AttributeKeyOrderType            = "order_type"
+	AttributeKeyTriggerPrice         = "trigger_price"
+	AttributeKeyRefund               = "refund"
 )
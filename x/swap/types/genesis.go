@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
@@ -17,14 +18,32 @@ var (
 	DefaultPoolRecords = PoolRecords{}
 	// DefaultShareRecords is used to set default records in default genesis state
 	DefaultShareRecords = ShareRecords{}
+	// DefaultPoolFeeRecords is used to set default records in default genesis state
+	DefaultPoolFeeRecords = PoolFeeRecords{}
+	// DefaultPoolPriceSnapshots is used to set default snapshots in default genesis state
+	DefaultPoolPriceSnapshots = PoolPriceSnapshots{}
+	// DefaultOrders is used to set default orders in default genesis state
+	DefaultOrders = Orders{}
 )
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, poolRecords PoolRecords, shareRecords ShareRecords) GenesisState {
+func NewGenesisState(
+	params Params,
+	poolRecords PoolRecords,
+	shareRecords ShareRecords,
+	poolFeeRecords PoolFeeRecords,
+	poolPriceSnapshots PoolPriceSnapshots,
+	orders Orders,
+	nextOrderID uint64,
+) GenesisState {
 	return GenesisState{
-		Params:       params,
-		PoolRecords:  poolRecords,
-		ShareRecords: shareRecords,
+		Params:             params,
+		PoolRecords:        poolRecords,
+		ShareRecords:       shareRecords,
+		PoolFeeRecords:     poolFeeRecords,
+		PoolPriceSnapshots: poolPriceSnapshots,
+		Orders:             orders,
+		NextOrderID:        nextOrderID,
 	}
 }
 
@@ -39,6 +58,15 @@ func (gs GenesisState) Validate() error {
 	if err := gs.ShareRecords.Validate(); err != nil {
 		return err
 	}
+	if err := gs.PoolFeeRecords.Validate(); err != nil {
+		return err
+	}
+	if err := gs.PoolPriceSnapshots.Validate(); err != nil {
+		return err
+	}
+	if err := gs.Orders.Validate(); err != nil {
+		return err
+	}
 
 	totalShares := make(map[string]poolShares)
 	for _, pr := range gs.PoolRecords {
@@ -65,6 +93,21 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, snapshot := range gs.PoolPriceSnapshots {
+		if _, found := totalShares[snapshot.PoolID]; !found {
+			return fmt.Errorf("price snapshot for pool '%s' that does not exist", snapshot.PoolID)
+		}
+	}
+
+	if gs.NextOrderID == 0 {
+		return errors.New("next order id cannot be zero")
+	}
+	for _, order := range gs.Orders {
+		if order.ID >= gs.NextOrderID {
+			return fmt.Errorf("order id %d must be less than the next order id %d", order.ID, gs.NextOrderID)
+		}
+	}
+
 	return nil
 }
 
@@ -74,5 +117,9 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		DefaultPoolRecords,
 		DefaultShareRecords,
+		DefaultPoolFeeRecords,
+		DefaultPoolPriceSnapshots,
+		DefaultOrders,
+		DefaultNextOrderID,
 	)
 }
@@ -31,6 +31,14 @@ type GenesisState struct {
 	PoolRecords PoolRecords `protobuf:"bytes,2,rep,name=pool_records,json=poolRecords,proto3,castrepeated=PoolRecords" json:"pool_records"`
 	// share_records defines the owned shares of each pool
 	ShareRecords ShareRecords `protobuf:"bytes,3,rep,name=share_records,json=shareRecords,proto3,castrepeated=ShareRecords" json:"share_records"`
+	// pool_fee_records defines the fees earned by each pool
+	PoolFeeRecords PoolFeeRecords `protobuf:"bytes,4,rep,name=pool_fee_records,json=poolFeeRecords,proto3,castrepeated=PoolFeeRecords" json:"pool_fee_records"`
+	// pool_price_snapshots defines the price history of each pool
+	PoolPriceSnapshots PoolPriceSnapshots `protobuf:"bytes,5,rep,name=pool_price_snapshots,json=poolPriceSnapshots,proto3,castrepeated=PoolPriceSnapshots" json:"pool_price_snapshots"`
+	// orders defines the resting orders escrowed in the module
+	Orders Orders `protobuf:"bytes,6,rep,name=orders,proto3,castrepeated=Orders" json:"orders"`
+	// next_order_id defines the id assigned to the next placed order
+	NextOrderID uint64 `protobuf:"varint,7,opt,name=next_order_id,json=nextOrderId,proto3" json:"next_order_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +95,34 @@ func (m *GenesisState) GetShareRecords() ShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetPoolFeeRecords() PoolFeeRecords {
+	if m != nil {
+		return m.PoolFeeRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolPriceSnapshots() PoolPriceSnapshots {
+	if m != nil {
+		return m.PoolPriceSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrders() Orders {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextOrderID() uint64 {
+	if m != nil {
+		return m.NextOrderID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.swap.v1beta1.GenesisState")
 }
@@ -94,25 +130,33 @@ func init() {
 func init() { proto.RegisterFile("kava/swap/v1beta1/genesis.proto", fileDescriptor_b1a1a1687f484a21) }
 
 var fileDescriptor_b1a1a1687f484a21 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x32, 0x98, 0x26, 0x81, 0x75, 0x81, 0x65, 0x95, 0x7e, 0x32, 0x72, 0xf1, 0xb8,
-	0x43, 0x0c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe7, 0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0xc3, 0xb0, 0x48, 0x2f, 0x00, 0xac,
-	0xc0, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x72, 0xa1, 0x50, 0x2e, 0x9e, 0x82, 0xfc,
-	0xfc, 0x9c, 0xf8, 0xa2, 0xd4, 0xe4, 0xfc, 0xa2, 0x94, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e,
-	0x23, 0x59, 0x6c, 0xda, 0xf3, 0xf3, 0x73, 0x82, 0xc0, 0xaa, 0x9c, 0x84, 0x41, 0x46, 0xac, 0xba,
-	0x2f, 0xcf, 0x8d, 0x10, 0x2b, 0x0e, 0xe2, 0x2e, 0x40, 0x70, 0x84, 0x22, 0xb9, 0x78, 0x8b, 0x33,
-	0x12, 0x8b, 0x52, 0xe1, 0xe6, 0x32, 0x83, 0xcd, 0x95, 0xc3, 0x62, 0x6e, 0x30, 0x48, 0x1d, 0xd4,
-	0x60, 0x11, 0xa8, 0xc1, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0xc5, 0x48, 0x3c, 0x27, 0x87, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0xd9, 0xa3, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0x66, 0xe9, 0x57,
-	0x40, 0x82, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x88, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xca, 0xb8, 0xb9, 0x95, 0xae, 0x01, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x56, 0x82, 0xe4, 0x74, 0x05, 0x4c, 0x85, 0x42, 0x05, 0x69, 0x85, 0x10, 0xea,
+	0x85, 0x44, 0xdb, 0x0e, 0x5c, 0xa7, 0x08, 0x81, 0xb8, 0xc0, 0xe4, 0x8a, 0x03, 0x5c, 0x22, 0xa7,
+	0x31, 0x69, 0x44, 0x17, 0x5b, 0xfe, 0xcc, 0x28, 0x6f, 0xc1, 0x73, 0xf0, 0x24, 0x3d, 0xee, 0xc8,
+	0x69, 0xa0, 0xf4, 0x45, 0x90, 0xbf, 0x7a, 0xeb, 0xa6, 0x74, 0x37, 0xff, 0x3f, 0xff, 0xfc, 0xfb,
+	0x7f, 0x07, 0x93, 0xd1, 0x37, 0x7e, 0xc6, 0x13, 0xf8, 0xc1, 0x55, 0x72, 0x76, 0x90, 0x0b, 0xc3,
+	0x0f, 0x92, 0x52, 0xd4, 0x02, 0x2a, 0x88, 0x95, 0x96, 0x46, 0xd2, 0x87, 0x16, 0x88, 0x2d, 0x10,
+	0x3b, 0x60, 0x38, 0x28, 0x65, 0x29, 0xf1, 0x36, 0xb1, 0xa7, 0x0d, 0x38, 0x7c, 0xda, 0x36, 0xe1,
+	0x2b, 0xbc, 0x7d, 0xbe, 0xea, 0x92, 0xde, 0xbb, 0x8d, 0x78, 0x6a, 0xb8, 0x11, 0xf4, 0x35, 0xf1,
+	0x15, 0xd7, 0xfc, 0x14, 0x42, 0x6f, 0xec, 0x4d, 0x82, 0xc3, 0x27, 0x71, 0xab, 0x28, 0x3e, 0x41,
+	0x20, 0xed, 0xae, 0x2e, 0x46, 0x1d, 0xe6, 0x70, 0xfa, 0x89, 0xf4, 0x94, 0x94, 0x8b, 0x4c, 0x8b,
+	0x99, 0xd4, 0x05, 0x84, 0x77, 0xc6, 0x7b, 0x93, 0xe0, 0xf0, 0xd9, 0xae, 0xe7, 0x52, 0x2e, 0x18,
+	0x52, 0xe9, 0x23, 0xab, 0xf8, 0xfd, 0x77, 0x14, 0x6c, 0x67, 0xc0, 0x02, 0xb5, 0x0d, 0xf4, 0x33,
+	0xd9, 0x87, 0x39, 0xd7, 0xe2, 0xca, 0xbb, 0x87, 0xde, 0x68, 0x87, 0x77, 0x6a, 0x39, 0x27, 0x1e,
+	0x38, 0x71, 0xef, 0xda, 0x10, 0x58, 0x0f, 0xae, 0x25, 0x9a, 0x93, 0x07, 0xb8, 0xf1, 0x57, 0xb1,
+	0xb5, 0x77, 0xd1, 0x3e, 0xbe, 0x65, 0xeb, 0xb7, 0xe2, 0xd2, 0xff, 0xd8, 0xf9, 0xfb, 0x37, 0xc6,
+	0xc0, 0xfa, 0xea, 0x46, 0xa6, 0x9a, 0x0c, 0xb0, 0x43, 0xe9, 0x6a, 0x26, 0x32, 0xa8, 0xb9, 0x82,
+	0xb9, 0x34, 0x10, 0xde, 0xc5, 0x9e, 0x17, 0xb7, 0xf4, 0x9c, 0x58, 0x7a, 0xea, 0xe0, 0x74, 0xe8,
+	0xba, 0x68, 0xeb, 0x0a, 0x18, 0x55, 0xad, 0x19, 0x3d, 0x26, 0xbe, 0xd4, 0x85, 0xd0, 0x10, 0xfa,
+	0xd8, 0x12, 0xee, 0x68, 0xf9, 0x68, 0x81, 0xb4, 0xef, 0xcc, 0x3e, 0x46, 0x60, 0xee, 0x1d, 0x3d,
+	0x22, 0xfb, 0xb5, 0x58, 0x9a, 0x0c, 0x63, 0x56, 0x15, 0xe1, 0xbd, 0xb1, 0x37, 0xe9, 0xa6, 0xf7,
+	0x9b, 0x8b, 0x51, 0xf0, 0x41, 0x2c, 0x0d, 0xe2, 0xef, 0xdf, 0xb0, 0xa0, 0xbe, 0x0a, 0x45, 0x7a,
+	0xbc, 0x6a, 0x22, 0xef, 0xbc, 0x89, 0xbc, 0x7f, 0x4d, 0xe4, 0xfd, 0x5a, 0x47, 0x9d, 0xf3, 0x75,
+	0xd4, 0xf9, 0xb3, 0x8e, 0x3a, 0x5f, 0x5e, 0x96, 0x95, 0x99, 0x7f, 0xcf, 0xe3, 0x99, 0x3c, 0x4d,
+	0xec, 0x2a, 0xaf, 0x16, 0x3c, 0x07, 0x3c, 0x25, 0xcb, 0xcd, 0xcf, 0x34, 0x3f, 0x95, 0x80, 0xdc,
+	0xc7, 0x3f, 0x79, 0xf4, 0x7f, 0x00, 0xf9, 0xce, 0x4b, 0xfa, 0xfd, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +179,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextOrderID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PoolPriceSnapshots) > 0 {
+		for iNdEx := len(m.PoolPriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolPriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PoolFeeRecords) > 0 {
+		for iNdEx := len(m.PoolFeeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolFeeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ShareRecords) > 0 {
 		for iNdEx := len(m.ShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +298,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolFeeRecords) > 0 {
+		for _, e := range m.PoolFeeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolPriceSnapshots) > 0 {
+		for _, e := range m.PoolPriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOrderID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOrderID))
+	}
 	return n
 }
 
@@ -346,6 +458,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFeeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolFeeRecords = append(m.PoolFeeRecords, PoolFeeRecord{})
+			if err := m.PoolFeeRecords[len(m.PoolFeeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolPriceSnapshots = append(m.PoolPriceSnapshots, PoolPriceSnapshot{})
+			if err := m.PoolPriceSnapshots[len(m.PoolPriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderID", wireType)
+			}
+			m.NextOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -50,9 +51,12 @@ func TestGenesis_Validate_SwapFee(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: types.DefaultAllowedPools,
-					SwapFee:      tc.swapFee,
+					AllowedPools:     types.DefaultAllowedPools,
+					SwapFee:          tc.swapFee,
+					ProtocolFeeShare: sdk.ZeroDec(),
+					MinOrderShare:    sdk.ZeroDec(),
 				},
+				NextOrderID: types.DefaultNextOrderID,
 			}
 
 			err := genesisState.Validate()
@@ -94,9 +98,12 @@ func TestGenesis_Validate_AllowedPools(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: tc.pairs,
-					SwapFee:      types.DefaultSwapFee,
+					AllowedPools:     tc.pairs,
+					SwapFee:          types.DefaultSwapFee,
+					ProtocolFeeShare: sdk.ZeroDec(),
+					MinOrderShare:    sdk.ZeroDec(),
 				},
+				NextOrderID: types.DefaultNextOrderID,
 			}
 
 			err := genesisState.Validate()
@@ -163,15 +170,35 @@ func TestGenesis_JSONEncoding(t *testing.T) {
 }
 
 func TestGenesis_YAMLEncoding(t *testing.T) {
-	expected := `params:
+	expected := `next_order_id: 1
+orders: []
+params:
   allowed_pools:
-  - token_a: ukava
+  - amplification: 0
+    pool_type: POOL_TYPE_CONSTANT_PRODUCT
+    swap_fee: "0.000000000000000000"
+    token_a: ukava
     token_b: usdx
-  - token_a: hard
+  - amplification: 0
+    pool_type: POOL_TYPE_CONSTANT_PRODUCT
+    swap_fee: "0.000000000000000000"
+    token_a: hard
     token_b: busd
+  max_order_duration: 2592000000000000
+  max_order_fills: 100
+  min_order_share: "0.000010000000000000"
+  price_history_blocks: 14400
+  protocol_fee_share: "0.000000000000000000"
   swap_fee: "0.003000000000000000"
+pool_fee_records: []
+pool_price_snapshots: []
 pool_records:
-- pool_id: ukava:usdx
+- amplification: 0
+  pool_id: ukava:usdx
+  pool_type: POOL_TYPE_CONSTANT_PRODUCT
+  price_cumulative_a: "0.000000000000000000"
+  price_cumulative_b: "0.000000000000000000"
+  price_last_updated: "0001-01-01T00:00:00Z"
   reserves_a:
     amount: "1000000"
     denom: ukava
@@ -179,7 +206,12 @@ pool_records:
     amount: "5000000"
     denom: usdx
   total_shares: "3000000"
-- pool_id: hard:usdx
+- amplification: 0
+  pool_id: hard:usdx
+  pool_type: POOL_TYPE_CONSTANT_PRODUCT
+  price_cumulative_a: "0.000000000000000000"
+  price_cumulative_b: "0.000000000000000000"
+  price_last_updated: "0001-01-01T00:00:00Z"
   reserves_a:
     amount: "1000000"
     denom: hard
@@ -208,6 +240,11 @@ share_records:
 				types.NewAllowedPool("hard", "busd"),
 			),
 			sdk.MustNewDecFromStr("0.003"),
+			sdk.ZeroDec(),
+			types.DefaultPriceHistoryBlocks,
+			types.DefaultMaxOrderFills,
+			types.DefaultMinOrderShare,
+			types.DefaultMaxOrderDuration,
 		),
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6)),
@@ -217,6 +254,10 @@ share_records:
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), i(1e5)),
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), i(2e5)),
 		},
+		types.PoolFeeRecords{},
+		types.PoolPriceSnapshots{},
+		types.DefaultOrders,
+		types.DefaultNextOrderID,
 	)
 
 	data, err := yaml.Marshal(state)
@@ -232,6 +273,10 @@ func TestGenesis_ValidatePoolRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{invalidPoolRecord},
 		types.ShareRecords{},
+		types.PoolFeeRecords{},
+		types.PoolPriceSnapshots{},
+		types.DefaultOrders,
+		types.DefaultNextOrderID,
 	)
 
 	assert.Error(t, state.Validate())
@@ -247,11 +292,78 @@ func TestGenesis_ValidateShareRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{},
 		types.ShareRecords{invalidShareRecord},
+		types.PoolFeeRecords{},
+		types.PoolPriceSnapshots{},
+		types.DefaultOrders,
+		types.DefaultNextOrderID,
 	)
 
 	assert.Error(t, state.Validate())
 }
 
+func TestGenesis_ValidatePoolPriceSnapshots(t *testing.T) {
+	depositor, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	snapshotTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	poolRecords := types.PoolRecords{types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6))}
+	shareRecords := types.ShareRecords{types.NewShareRecord(depositor, types.PoolID("ukava", "usdx"), i(3e6))}
+
+	state := types.NewGenesisState(
+		types.DefaultParams(),
+		poolRecords,
+		shareRecords,
+		types.PoolFeeRecords{},
+		types.PoolPriceSnapshots{
+			types.NewPoolPriceSnapshot(types.PoolID("ukava", "usdx"), 1, snapshotTime, d("0"), d("0"), d("5"), d("0.2")),
+		},
+		types.DefaultOrders,
+		types.DefaultNextOrderID,
+	)
+	assert.NoError(t, state.Validate())
+
+	state.PoolPriceSnapshots = types.PoolPriceSnapshots{
+		types.NewPoolPriceSnapshot(types.PoolID("ukava", "usdx"), 0, snapshotTime, d("0"), d("0"), d("5"), d("0.2")),
+	}
+	assert.EqualError(t, state.Validate(), "pool 'ukava:usdx' snapshot has invalid height: 0")
+
+	state.PoolPriceSnapshots = types.PoolPriceSnapshots{
+		types.NewPoolPriceSnapshot(types.PoolID("hard", "usdx"), 1, snapshotTime, d("0"), d("0"), d("2"), d("0.5")),
+	}
+	assert.EqualError(t, state.Validate(), "price snapshot for pool 'hard:usdx' that does not exist")
+}
+
+func TestGenesis_ValidateOrders(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := types.NewGenesisState(
+		types.DefaultParams(),
+		types.PoolRecords{},
+		types.ShareRecords{},
+		types.PoolFeeRecords{},
+		types.PoolPriceSnapshots{},
+		types.Orders{
+			types.NewOrder(1, owner, types.ORDER_TYPE_LIMIT, ukava(1e6), "usdx", d("5"), expiry),
+			types.NewOrder(2, owner, types.ORDER_TYPE_STOP_LOSS, ukava(1e6), "usdx", d("4"), expiry),
+		},
+		3,
+	)
+	assert.NoError(t, state.Validate())
+
+	state.NextOrderID = 2
+	assert.EqualError(t, state.Validate(), "order id 2 must be less than the next order id 2")
+
+	state.NextOrderID = 0
+	state.Orders = types.Orders{}
+	assert.EqualError(t, state.Validate(), "next order id cannot be zero")
+
+	state.NextOrderID = 3
+	state.Orders = types.Orders{types.NewOrder(1, owner, types.ORDER_TYPE_LIMIT, ukava(0), "usdx", d("5"), expiry)}
+	assert.EqualError(t, state.Validate(), "order 1 has invalid input: 0ukava")
+}
+
 func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 	depositor_1, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
 	require.NoError(t, err)
@@ -323,7 +435,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords)
+			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords, types.PoolFeeRecords{}, types.PoolPriceSnapshots{}, types.DefaultOrders, types.DefaultNextOrderID)
 			err := state.Validate()
 
 			if tc.expectedErr == "" {
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,6 +14,12 @@ const (
 	// ModuleAccountName name of module account used to hold liquidity
 	ModuleAccountName = "swap"
 
+	// ProtocolFeeAccountName name of module account used to hold the protocol share of swap fees
+	ProtocolFeeAccountName = "swap_protocol_fees"
+
+	// OrderAccountName name of module account used to escrow the input of resting orders
+	OrderAccountName = "swap_orders"
+
 	// StoreKey Top level store key where all module items will be stored
 	StoreKey = ModuleName
 
@@ -25,6 +34,13 @@ const (
 var (
 	PoolKeyPrefix             = []byte{0x01}
 	DepositorPoolSharesPrefix = []byte{0x02}
+	PoolFeeKeyPrefix          = []byte{0x03}
+	PoolPriceSnapshotPrefix   = []byte{0x04}
+	OrderKeyPrefix            = []byte{0x05}
+	OrderByPoolKeyPrefix      = []byte{0x06}
+	OrderByOwnerKeyPrefix     = []byte{0x07}
+	OrderByExpiryKeyPrefix    = []byte{0x08}
+	NextOrderIDKey            = []byte{0x09}
 
 	sep = []byte("|")
 )
@@ -39,6 +55,66 @@ func DepositorPoolSharesKey(depositor sdk.AccAddress, poolID string) []byte {
 	return createKey(depositor, sep, []byte(poolID))
 }
 
+// PoolPriceSnapshotIteratorKey returns the prefix for the price snapshots of a single pool
+func PoolPriceSnapshotIteratorKey(poolID string) []byte {
+	return createKey([]byte(poolID), sep)
+}
+
+// PoolPriceSnapshotKey returns the key for a pool's price snapshot at a block height
+func PoolPriceSnapshotKey(poolID string, height int64) []byte {
+	return createKey(PoolPriceSnapshotIteratorKey(poolID), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// OrderKey returns the key for an order id
+func OrderKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// OrderByPoolIteratorKey returns the prefix for the orders of a single pool
+func OrderByPoolIteratorKey(poolID string) []byte {
+	return createKey([]byte(poolID), sep)
+}
+
+// OrderBookIteratorKey returns the prefix for the orders of a pool selling denomIn with an order type,
+// which are sorted by trigger price and then id
+func OrderBookIteratorKey(poolID string, denomIn string, orderType OrderType) []byte {
+	return createKey(OrderByPoolIteratorKey(poolID), []byte(denomIn), sep, []byte{byte(orderType)}, sep)
+}
+
+// OrderByPoolKey returns the key for an order in the order book of its pool
+func OrderByPoolKey(order Order) []byte {
+	return createKey(
+		OrderBookIteratorKey(order.PoolID, order.TokenIn.Denom, order.OrderType),
+		TriggerPriceBytes(order.TriggerPrice),
+		sep,
+		OrderKey(order.ID),
+	)
+}
+
+// OrderByOwnerIteratorKey returns the prefix for the orders of a single owner
+func OrderByOwnerIteratorKey(owner sdk.AccAddress) []byte {
+	return createKey(owner, sep)
+}
+
+// OrderByOwnerKey returns the key for an order of an owner
+func OrderByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return createKey(OrderByOwnerIteratorKey(owner), OrderKey(id))
+}
+
+// OrderByExpiryKey returns the key for an order sorted by its expiry
+func OrderByExpiryKey(expiry time.Time, id uint64) []byte {
+	return createKey(sdk.FormatTimeBytes(expiry), sep, OrderKey(id))
+}
+
+// TriggerPriceBytes returns a byte representation of a trigger price that sorts in price order.
+// The price is left padded with 0s, so it must be positive and no greater than MaxTriggerPrice.
+func TriggerPriceBytes(price sdk.Dec) []byte {
+	if !price.IsPositive() || price.GT(MaxTriggerPrice) {
+		panic(fmt.Sprintf("trigger price %s out of bounds", price))
+	}
+	return []byte(fmt.Sprintf(fmt.Sprintf("%%0%ds", sdk.Precision*2+2), price.String()))
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
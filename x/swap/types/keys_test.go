@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -16,3 +18,31 @@ func TestKeys(t *testing.T) {
 	key = types.DepositorPoolSharesKey(sdk.AccAddress("testaddress1"), types.PoolID("ukava", "usdx"))
 	assert.Equal(t, string(sdk.AccAddress("testaddress1"))+"|"+types.PoolID("ukava", "usdx"), string(key))
 }
+
+func TestKeys_OrderByPoolKey(t *testing.T) {
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	order := types.NewOrder(3, sdk.AccAddress("testaddress1"), types.ORDER_TYPE_STOP_LOSS, sdk.NewInt64Coin("ukava", 1e6), "usdx", sdk.MustNewDecFromStr("5.5"), expiry)
+
+	key := types.OrderByPoolKey(order)
+	assert.Equal(t, "ukava:usdx|ukava|\x01|0000000000000000005.500000000000000000|\x00\x00\x00\x00\x00\x00\x00\x03", string(key))
+	assert.True(t, bytes.HasPrefix(key, types.OrderBookIteratorKey("ukava:usdx", "ukava", types.ORDER_TYPE_STOP_LOSS)))
+	assert.True(t, bytes.HasPrefix(key, types.OrderByPoolIteratorKey("ukava:usdx")))
+}
+
+func TestKeys_TriggerPriceBytes(t *testing.T) {
+	prices := []sdk.Dec{
+		sdk.SmallestDec(),
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.OneDec(),
+		sdk.MustNewDecFromStr("9.99"),
+		sdk.NewDec(10),
+		sdk.NewDec(123456789),
+		types.MaxTriggerPrice,
+	}
+	for i := 1; i < len(prices); i++ {
+		assert.Equal(t, -1, bytes.Compare(types.TriggerPriceBytes(prices[i-1]), types.TriggerPriceBytes(prices[i])))
+	}
+
+	assert.Panics(t, func() { types.TriggerPriceBytes(sdk.ZeroDec()) })
+	assert.Panics(t, func() { types.TriggerPriceBytes(types.MaxTriggerPrice.Add(sdk.SmallestDec())) })
+}
@@ -18,6 +18,17 @@ const (
 	TypeSwapExactForTokens = "swap_exact_for_tokens"
 	// TypeSwapForExactTokens represents the type string for MsgSwapForExactTokens
 	TypeSwapForExactTokens = "swap_for_exact_tokens"
+	// TypeSwapExactForTokensMultiHop represents the type string for MsgSwapExactForTokensMultiHop
+	TypeSwapExactForTokensMultiHop = "swap_exact_for_tokens_multi_hop"
+	// TypeSwapForExactTokensMultiHop represents the type string for MsgSwapForExactTokensMultiHop
+	TypeSwapForExactTokensMultiHop = "swap_for_exact_tokens_multi_hop"
+	// TypeMsgPlaceLimitOrder represents the type string for MsgPlaceLimitOrder
+	TypeMsgPlaceLimitOrder = "swap_place_limit_order"
+	// TypeMsgCancelOrder represents the type string for MsgCancelOrder
+	TypeMsgCancelOrder = "swap_cancel_order"
+
+	// MaxSwapRouteLength is the maximum number of pools a multi-hop swap can route through
+	MaxSwapRouteLength = 5
 )
 
 var (
@@ -29,6 +40,12 @@ var (
 	_ MsgWithDeadline = &MsgSwapExactForTokens{}
 	_ sdk.Msg         = &MsgSwapForExactTokens{}
 	_ MsgWithDeadline = &MsgSwapForExactTokens{}
+	_ sdk.Msg         = &MsgSwapExactForTokensMultiHop{}
+	_ MsgWithDeadline = &MsgSwapExactForTokensMultiHop{}
+	_ sdk.Msg         = &MsgSwapForExactTokensMultiHop{}
+	_ MsgWithDeadline = &MsgSwapForExactTokensMultiHop{}
+	_ sdk.Msg         = &MsgPlaceLimitOrder{}
+	_ sdk.Msg         = &MsgCancelOrder{}
 )
 
 // MsgWithDeadline allows messages to define a deadline of when they are considered invalid
@@ -340,3 +357,280 @@ func (msg MsgSwapForExactTokens) GetDeadline() time.Time {
 func (msg MsgSwapForExactTokens) DeadlineExceeded(blockTime time.Time) bool {
 	return blockTime.Unix() >= msg.Deadline
 }
+
+// NewMsgSwapExactForTokensMultiHop returns a new MsgSwapExactForTokensMultiHop
+func NewMsgSwapExactForTokensMultiHop(requester string, exactTokenA sdk.Coin, tokenB sdk.Coin, poolIDs []string, slippage sdk.Dec, deadline int64) *MsgSwapExactForTokensMultiHop {
+	return &MsgSwapExactForTokensMultiHop{
+		Requester:   requester,
+		ExactTokenA: exactTokenA,
+		TokenB:      tokenB,
+		PoolIDs:     poolIDs,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapExactForTokensMultiHop) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapExactForTokensMultiHop) Type() string { return TypeSwapExactForTokensMultiHop }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapExactForTokensMultiHop) ValidateBasic() error {
+	if msg.Requester == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester address: %s", err)
+	}
+
+	if !msg.ExactTokenA.IsValid() || msg.ExactTokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "exact token a deposit amount %s", msg.ExactTokenA)
+	}
+
+	if !msg.TokenB.IsValid() || msg.TokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token b deposit amount %s", msg.TokenB)
+	}
+
+	if msg.ExactTokenA.Denom == msg.TokenB.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	if err := ValidateSwapRoute(msg.PoolIDs, msg.ExactTokenA.Denom, msg.TokenB.Denom); err != nil {
+		return err
+	}
+
+	if msg.Slippage.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapExactForTokensMultiHop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapExactForTokensMultiHop) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapExactForTokensMultiHop) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapExactForTokensMultiHop) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgSwapForExactTokensMultiHop returns a new MsgSwapForExactTokensMultiHop
+func NewMsgSwapForExactTokensMultiHop(requester string, tokenA sdk.Coin, exactTokenB sdk.Coin, poolIDs []string, slippage sdk.Dec, deadline int64) *MsgSwapForExactTokensMultiHop {
+	return &MsgSwapForExactTokensMultiHop{
+		Requester:   requester,
+		TokenA:      tokenA,
+		ExactTokenB: exactTokenB,
+		PoolIDs:     poolIDs,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapForExactTokensMultiHop) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapForExactTokensMultiHop) Type() string { return TypeSwapForExactTokensMultiHop }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapForExactTokensMultiHop) ValidateBasic() error {
+	if msg.Requester == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester address: %s", err)
+	}
+
+	if !msg.TokenA.IsValid() || msg.TokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token a deposit amount %s", msg.TokenA)
+	}
+
+	if !msg.ExactTokenB.IsValid() || msg.ExactTokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "exact token b deposit amount %s", msg.ExactTokenB)
+	}
+
+	if msg.TokenA.Denom == msg.ExactTokenB.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	if err := ValidateSwapRoute(msg.PoolIDs, msg.TokenA.Denom, msg.ExactTokenB.Denom); err != nil {
+		return err
+	}
+
+	if msg.Slippage.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapForExactTokensMultiHop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapForExactTokensMultiHop) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapForExactTokensMultiHop) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapForExactTokensMultiHop) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgPlaceLimitOrder returns a new MsgPlaceLimitOrder
+func NewMsgPlaceLimitOrder(owner string, tokenIn sdk.Coin, denomOut string, orderType OrderType, triggerPrice sdk.Dec, expiry int64) *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Owner:        owner,
+		TokenIn:      tokenIn,
+		DenomOut:     denomOut,
+		OrderType:    orderType,
+		TriggerPrice: triggerPrice,
+		Expiry:       expiry,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceLimitOrder) Type() string { return TypeMsgPlaceLimitOrder }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if !msg.TokenIn.IsValid() || msg.TokenIn.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token in amount %s", msg.TokenIn)
+	}
+
+	if err := sdk.ValidateDenom(msg.DenomOut); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "denom out: %s", err)
+	}
+
+	if msg.TokenIn.Denom == msg.DenomOut {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	if err := validateOrderType(msg.OrderType); err != nil {
+		return errorsmod.Wrap(ErrInvalidOrder, err.Error())
+	}
+
+	if err := validateTriggerPrice(msg.TriggerPrice); err != nil {
+		return errorsmod.Wrap(ErrInvalidOrder, err.Error())
+	}
+
+	if msg.Expiry <= 0 {
+		return errorsmod.Wrapf(ErrInvalidOrder, "expiry %d", msg.Expiry)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// GetExpiry returns the time after which the unfilled input of the order is returned
+func (msg MsgPlaceLimitOrder) GetExpiry() time.Time {
+	return time.Unix(msg.Expiry, 0)
+}
+
+// NewMsgCancelOrder returns a new MsgCancelOrder
+func NewMsgCancelOrder(owner string, orderID uint64) *MsgCancelOrder {
+	return &MsgCancelOrder{
+		Owner:   owner,
+		OrderID: orderID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCancelOrder) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCancelOrder) Type() string { return TypeMsgCancelOrder }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCancelOrder) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if msg.OrderID == 0 {
+		return errorsmod.Wrap(ErrInvalidOrder, "order id cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelOrder) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
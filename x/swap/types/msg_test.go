@@ -764,3 +764,397 @@ func TestMsgSwapForExactTokens_Deadline(t *testing.T) {
 		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
 	}
 }
+
+func TestMsgSwapExactForTokensMultiHop_Attributes(t *testing.T) {
+	msg := types.MsgSwapExactForTokensMultiHop{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_exact_for_tokens_multi_hop", msg.Type())
+}
+
+func TestMsgSwapExactForTokensMultiHop_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgSwapExactForTokensMultiHop","value":{"deadline":"1623606299","exact_token_a":{"amount":"1000000","denom":"ukava"},"pool_ids":["ukava:usdx","hard:usdx"],"requester":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","slippage":"0.010000000000000000","token_b":{"amount":"2500000","denom":"hard"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgSwapExactForTokensMultiHop(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("hard", sdkmath.NewInt(2.5e6)), []string{"ukava:usdx", "hard:usdx"}, sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgSwapExactForTokensMultiHop_Validation(t *testing.T) {
+	validMsg := types.NewMsgSwapExactForTokensMultiHop(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("hard", sdkmath.NewInt(2.5e6)),
+		[]string{"ukava:usdx", "hard:usdx"},
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		requester   string
+		exactTokenA sdk.Coin
+		tokenB      sdk.Coin
+		route       []string
+		slippage    sdk.Dec
+		deadline    int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			requester:   sdk.AccAddress("").String(),
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       validMsg.PoolIDs,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "requester address cannot be empty: invalid address",
+		},
+		{
+			name:        "zero token a",
+			requester:   validMsg.Requester,
+			exactTokenA: sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			tokenB:      validMsg.TokenB,
+			route:       validMsg.PoolIDs,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "exact token a deposit amount 0ukava: invalid coins",
+		},
+		{
+			name:        "denoms can not be the same",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(1e6)},
+			route:       validMsg.PoolIDs,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+		{
+			name:        "empty route",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       []string{},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route cannot be empty: invalid route",
+		},
+		{
+			name:        "route too long",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       []string{"a:ukava", "a:b", "b:c", "c:d", "d:e", "e:hard"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route length 6 exceeds maximum of 5: invalid route",
+		},
+		{
+			name:        "duplicate pool",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       []string{"ukava:usdx", "ukava:usdx", "hard:usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "duplicate pool ukava:usdx: invalid route",
+		},
+		{
+			name:        "unsorted pool id",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       []string{"usdx:ukava", "hard:usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "invalid pool id usdx:ukava: invalid route",
+		},
+		{
+			name:        "disconnected route",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       []string{"ukava:usdx", "bnb:busd"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "pool bnb:busd does not contain usdx: invalid route",
+		},
+		{
+			name:        "route does not end in token b",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       []string{"ukava:usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "route ends in usdx, not hard: invalid route",
+		},
+		{
+			name:        "nil slippage",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       validMsg.PoolIDs,
+			slippage:    sdk.Dec{},
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage must be set: invalid slippage",
+		},
+		{
+			name:        "zero deadline",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			route:       validMsg.PoolIDs,
+			slippage:    validMsg.Slippage,
+			deadline:    0,
+			expectedErr: "deadline 0: invalid deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapExactForTokensMultiHop(tc.requester, tc.exactTokenA, tc.tokenB, tc.route, tc.slippage, tc.deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgSwapForExactTokensMultiHop_Attributes(t *testing.T) {
+	msg := types.MsgSwapForExactTokensMultiHop{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_for_exact_tokens_multi_hop", msg.Type())
+}
+
+func TestMsgSwapForExactTokensMultiHop_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgSwapForExactTokensMultiHop","value":{"deadline":"1623606299","exact_token_b":{"amount":"2500000","denom":"hard"},"pool_ids":["ukava:usdx","hard:usdx"],"requester":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","slippage":"0.010000000000000000","token_a":{"amount":"1000000","denom":"ukava"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgSwapForExactTokensMultiHop(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("hard", sdkmath.NewInt(2.5e6)), []string{"ukava:usdx", "hard:usdx"}, sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgSwapForExactTokensMultiHop_Validation(t *testing.T) {
+	validMsg := types.NewMsgSwapForExactTokensMultiHop(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("hard", sdkmath.NewInt(2.5e6)),
+		[]string{"ukava:usdx", "hard:usdx"},
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		exactTokenB sdk.Coin
+		route       []string
+		expectedErr string
+	}{
+		{
+			name:        "zero token b",
+			exactTokenB: sdk.Coin{Denom: "hard", Amount: sdkmath.NewInt(0)},
+			route:       validMsg.PoolIDs,
+			expectedErr: "exact token b deposit amount 0hard: invalid coins",
+		},
+		{
+			name:        "empty route",
+			exactTokenB: validMsg.ExactTokenB,
+			route:       nil,
+			expectedErr: "route cannot be empty: invalid route",
+		},
+		{
+			name:        "route does not start with token a",
+			exactTokenB: validMsg.ExactTokenB,
+			route:       []string{"hard:usdx"},
+			expectedErr: "pool hard:usdx does not contain ukava: invalid route",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapForExactTokensMultiHop(validMsg.Requester, validMsg.TokenA, tc.exactTokenB, tc.route, validMsg.Slippage, validMsg.Deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgPlaceLimitOrder_Attributes(t *testing.T) {
+	msg := types.MsgPlaceLimitOrder{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_place_limit_order", msg.Type())
+}
+
+func TestMsgPlaceLimitOrder_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgPlaceLimitOrder","value":{"denom_out":"usdx","expiry":"1623606299","order_type":"ORDER_TYPE_STOP_LOSS","owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","token_in":{"amount":"1000000","denom":"ukava"},"trigger_price":"4.500000000000000000"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgPlaceLimitOrder(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", types.ORDER_TYPE_STOP_LOSS, sdk.MustNewDecFromStr("4.5"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+	assert.Equal(t, time.Unix(1623606299, 0), msg.GetExpiry())
+}
+
+func TestMsgPlaceLimitOrder_Validation(t *testing.T) {
+	validMsg := types.NewMsgPlaceLimitOrder(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		"usdx",
+		types.ORDER_TYPE_LIMIT,
+		sdk.MustNewDecFromStr("5.5"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		tokenIn     sdk.Coin
+		denomOut    string
+		orderType   types.OrderType
+		price       sdk.Dec
+		expiry      int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       sdk.AccAddress("").String(),
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			orderType:   validMsg.OrderType,
+			price:       validMsg.TriggerPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			owner:       "kava1abc0",
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			orderType:   validMsg.OrderType,
+			price:       validMsg.TriggerPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "invalid owner address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "zero token in",
+			owner:       validMsg.Owner,
+			tokenIn:     sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			denomOut:    validMsg.DenomOut,
+			orderType:   validMsg.OrderType,
+			price:       validMsg.TriggerPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "token in amount 0ukava: invalid coins",
+		},
+		{
+			name:        "invalid denom out",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    "",
+			orderType:   validMsg.OrderType,
+			price:       validMsg.TriggerPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "denom out: invalid denom: : invalid coins",
+		},
+		{
+			name:        "denoms can not be the same",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    "ukava",
+			orderType:   validMsg.OrderType,
+			price:       validMsg.TriggerPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+		{
+			name:        "invalid order type",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			orderType:   5,
+			price:       validMsg.TriggerPrice,
+			expiry:      validMsg.Expiry,
+			expectedErr: "invalid order type: 5: invalid order",
+		},
+		{
+			name:        "nil trigger price",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			orderType:   validMsg.OrderType,
+			price:       sdk.Dec{},
+			expiry:      validMsg.Expiry,
+			expectedErr: "trigger price must be positive, got <nil>: invalid order",
+		},
+		{
+			name:        "negative trigger price",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			orderType:   validMsg.OrderType,
+			price:       sdk.MustNewDecFromStr("-1"),
+			expiry:      validMsg.Expiry,
+			expectedErr: "trigger price must be positive, got -1.000000000000000000: invalid order",
+		},
+		{
+			name:        "zero expiry",
+			owner:       validMsg.Owner,
+			tokenIn:     validMsg.TokenIn,
+			denomOut:    validMsg.DenomOut,
+			orderType:   validMsg.OrderType,
+			price:       validMsg.TriggerPrice,
+			expiry:      0,
+			expectedErr: "expiry 0: invalid order",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgPlaceLimitOrder(tc.owner, tc.tokenIn, tc.denomOut, tc.orderType, tc.price, tc.expiry)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgCancelOrder_Attributes(t *testing.T) {
+	msg := types.MsgCancelOrder{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_cancel_order", msg.Type())
+}
+
+func TestMsgCancelOrder_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgCancelOrder","value":{"order_id":"12","owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgCancelOrder(addr.String(), 12)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgCancelOrder_Validation(t *testing.T) {
+	validMsg := types.NewMsgCancelOrder(sdk.AccAddress("test1").String(), 1)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	msg := types.NewMsgCancelOrder("", 1)
+	assert.EqualError(t, msg.ValidateBasic(), "owner address cannot be empty: invalid address")
+
+	msg = types.NewMsgCancelOrder(validMsg.Owner, 0)
+	assert.EqualError(t, msg.ValidateBasic(), "order id cannot be zero: invalid order")
+}
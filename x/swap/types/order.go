@@ -0,0 +1,143 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultNextOrderID is the id assigned to the first order placed
+const DefaultNextOrderID uint64 = 1
+
+// MaxTriggerPrice is the largest trigger price an order can be placed at
+var MaxTriggerPrice = sdk.NewDec(1_000_000_000_000_000_000)
+
+// NewOrder returns a new order selling tokenIn for denomOut that has not been filled
+func NewOrder(
+	id uint64,
+	owner sdk.AccAddress,
+	orderType OrderType,
+	tokenIn sdk.Coin,
+	denomOut string,
+	triggerPrice sdk.Dec,
+	expiry time.Time,
+) Order {
+	return Order{
+		ID:           id,
+		Owner:        owner,
+		PoolID:       PoolID(tokenIn.Denom, denomOut),
+		OrderType:    orderType,
+		TokenIn:      tokenIn,
+		TokenOut:     sdk.NewCoin(denomOut, sdk.ZeroInt()),
+		TriggerPrice: triggerPrice,
+		Expiry:       expiry,
+	}
+}
+
+// Validate performs basic validation checks of the order data
+func (o Order) Validate() error {
+	if o.ID == 0 {
+		return errors.New("order id cannot be zero")
+	}
+	if o.Owner.Empty() {
+		return fmt.Errorf("order %d cannot have empty owner address", o.ID)
+	}
+	if !o.TokenIn.IsValid() || !o.TokenIn.IsPositive() {
+		return fmt.Errorf("order %d has invalid input: %s", o.ID, o.TokenIn)
+	}
+	if !o.TokenOut.IsValid() {
+		return fmt.Errorf("order %d has invalid output: %s", o.ID, o.TokenOut)
+	}
+	if o.TokenIn.Denom == o.TokenOut.Denom {
+		return fmt.Errorf("order %d input and output denominations cannot be equal", o.ID)
+	}
+	if o.PoolID != PoolID(o.TokenIn.Denom, o.TokenOut.Denom) {
+		return fmt.Errorf("order %d has invalid poolID '%s'", o.ID, o.PoolID)
+	}
+	if err := validateOrderType(o.OrderType); err != nil {
+		return fmt.Errorf("order %d is invalid: %s", o.ID, err)
+	}
+	if err := validateTriggerPrice(o.TriggerPrice); err != nil {
+		return fmt.Errorf("order %d is invalid: %s", o.ID, err)
+	}
+	if o.Expiry.IsZero() {
+		return fmt.Errorf("order %d expiry cannot be zero", o.ID)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the expiry of the order has passed at the block time
+func (o Order) IsExpired(blockTime time.Time) bool {
+	return blockTime.After(o.Expiry)
+}
+
+// IsTriggered returns true if the order can be filled at the spot price of its input token.
+// Limit orders are triggered at or above the trigger price, and stop-loss orders at or below it.
+func (o Order) IsTriggered(spotPrice sdk.Dec) bool {
+	if o.OrderType == ORDER_TYPE_STOP_LOSS {
+		return spotPrice.LTE(o.TriggerPrice)
+	}
+	return spotPrice.GTE(o.TriggerPrice)
+}
+
+// Orders is a slice of Order
+type Orders []Order
+
+// Validate performs basic validation checks on all orders and checks for duplicate ids
+func (os Orders) Validate() error {
+	seenIDs := make(map[uint64]bool)
+	for _, o := range os {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		if seenIDs[o.ID] {
+			return fmt.Errorf("duplicate order id %d", o.ID)
+		}
+		seenIDs[o.ID] = true
+	}
+
+	return nil
+}
+
+func validateOrderType(orderType OrderType) error {
+	switch orderType {
+	case ORDER_TYPE_LIMIT, ORDER_TYPE_STOP_LOSS:
+		return nil
+	default:
+		return fmt.Errorf("invalid order type: %s", orderType)
+	}
+}
+
+func validateTriggerPrice(price sdk.Dec) error {
+	if price.IsNil() || !price.IsPositive() {
+		return fmt.Errorf("trigger price must be positive, got %s", price)
+	}
+	if price.GT(MaxTriggerPrice) {
+		return fmt.Errorf("trigger price must not exceed %s, got %s", MaxTriggerPrice, price)
+	}
+	return nil
+}
+
+// MarshalJSON encodes an order type as its name
+func (t OrderType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes an order type from its name
+func (t *OrderType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	value, ok := OrderType_value[name]
+	if !ok {
+		return fmt.Errorf("invalid order type: %s", name)
+	}
+	*t = OrderType(value)
+	return nil
+}
@@ -0,0 +1,163 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_NewOrder(t *testing.T) {
+	owner := sdk.AccAddress("owner1")
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	order := types.NewOrder(1, owner, types.ORDER_TYPE_LIMIT, usdx(5e6), "ukava", d("0.25"), expiry)
+
+	assert.Equal(t, uint64(1), order.ID)
+	assert.Equal(t, owner, order.Owner)
+	assert.Equal(t, types.PoolID("ukava", "usdx"), order.PoolID)
+	assert.Equal(t, types.ORDER_TYPE_LIMIT, order.OrderType)
+	assert.Equal(t, usdx(5e6), order.TokenIn)
+	assert.Equal(t, ukava(0), order.TokenOut)
+	assert.Equal(t, d("0.25"), order.TriggerPrice)
+	assert.Equal(t, expiry, order.Expiry)
+}
+
+func TestOrder_Validate(t *testing.T) {
+	owner := sdk.AccAddress("owner1")
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	validOrder := func() types.Order {
+		return types.NewOrder(1, owner, types.ORDER_TYPE_LIMIT, ukava(1e6), "usdx", d("5"), expiry)
+	}
+
+	testCases := []struct {
+		name        string
+		modify      func(*types.Order)
+		expectedErr string
+	}{
+		{
+			name:   "valid limit order",
+			modify: func(o *types.Order) {},
+		},
+		{
+			name:   "valid stop-loss order",
+			modify: func(o *types.Order) { o.OrderType = types.ORDER_TYPE_STOP_LOSS },
+		},
+		{
+			name:   "valid partially filled order",
+			modify: func(o *types.Order) { o.TokenOut = usdx(2e6) },
+		},
+		{
+			name:        "zero id",
+			modify:      func(o *types.Order) { o.ID = 0 },
+			expectedErr: "order id cannot be zero",
+		},
+		{
+			name:        "empty owner",
+			modify:      func(o *types.Order) { o.Owner = sdk.AccAddress{} },
+			expectedErr: "order 1 cannot have empty owner address",
+		},
+		{
+			name:        "zero input",
+			modify:      func(o *types.Order) { o.TokenIn = ukava(0) },
+			expectedErr: "order 1 has invalid input: 0ukava",
+		},
+		{
+			name:        "equal denoms",
+			modify:      func(o *types.Order) { o.TokenOut = ukava(0) },
+			expectedErr: "order 1 input and output denominations cannot be equal",
+		},
+		{
+			name:        "mismatched pool id",
+			modify:      func(o *types.Order) { o.PoolID = types.PoolID("hard", "usdx") },
+			expectedErr: "order 1 has invalid poolID 'hard:usdx'",
+		},
+		{
+			name:        "invalid order type",
+			modify:      func(o *types.Order) { o.OrderType = 2 },
+			expectedErr: "order 1 is invalid: invalid order type: 2",
+		},
+		{
+			name:        "zero trigger price",
+			modify:      func(o *types.Order) { o.TriggerPrice = sdk.ZeroDec() },
+			expectedErr: "order 1 is invalid: trigger price must be positive, got 0.000000000000000000",
+		},
+		{
+			name:        "trigger price too large",
+			modify:      func(o *types.Order) { o.TriggerPrice = types.MaxTriggerPrice.Add(sdk.SmallestDec()) },
+			expectedErr: "order 1 is invalid: trigger price must not exceed 1000000000000000000.000000000000000000, got 1000000000000000000.000000000000000001",
+		},
+		{
+			name:        "zero expiry",
+			modify:      func(o *types.Order) { o.Expiry = time.Time{} },
+			expectedErr: "order 1 expiry cannot be zero",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := validOrder()
+			tc.modify(&order)
+
+			err := order.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestOrder_IsTriggered(t *testing.T) {
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	limit := types.NewOrder(1, sdk.AccAddress("owner1"), types.ORDER_TYPE_LIMIT, ukava(1e6), "usdx", d("5"), expiry)
+	assert.True(t, limit.IsTriggered(d("5.1")))
+	assert.True(t, limit.IsTriggered(d("5")))
+	assert.False(t, limit.IsTriggered(d("4.9")))
+
+	stopLoss := types.NewOrder(2, sdk.AccAddress("owner1"), types.ORDER_TYPE_STOP_LOSS, ukava(1e6), "usdx", d("5"), expiry)
+	assert.False(t, stopLoss.IsTriggered(d("5.1")))
+	assert.True(t, stopLoss.IsTriggered(d("5")))
+	assert.True(t, stopLoss.IsTriggered(d("4.9")))
+}
+
+func TestOrder_IsExpired(t *testing.T) {
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	order := types.NewOrder(1, sdk.AccAddress("owner1"), types.ORDER_TYPE_LIMIT, ukava(1e6), "usdx", d("5"), expiry)
+
+	assert.False(t, order.IsExpired(expiry.Add(-time.Second)))
+	assert.False(t, order.IsExpired(expiry))
+	assert.True(t, order.IsExpired(expiry.Add(time.Second)))
+}
+
+func TestOrders_Validate(t *testing.T) {
+	expiry := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	orders := types.Orders{
+		types.NewOrder(1, sdk.AccAddress("owner1"), types.ORDER_TYPE_LIMIT, ukava(1e6), "usdx", d("5"), expiry),
+		types.NewOrder(2, sdk.AccAddress("owner2"), types.ORDER_TYPE_STOP_LOSS, usdx(1e6), "ukava", d("0.1"), expiry),
+	}
+	assert.NoError(t, orders.Validate())
+
+	orders = append(orders, types.NewOrder(2, sdk.AccAddress("owner3"), types.ORDER_TYPE_LIMIT, hard(1e6), "usdx", d("2"), expiry))
+	assert.EqualError(t, orders.Validate(), "duplicate order id 2")
+}
+
+func TestOrderType_JSONEncoding(t *testing.T) {
+	bz, err := json.Marshal(types.ORDER_TYPE_STOP_LOSS)
+	require.NoError(t, err)
+	assert.Equal(t, `"ORDER_TYPE_STOP_LOSS"`, string(bz))
+
+	var orderType types.OrderType
+	require.NoError(t, json.Unmarshal(bz, &orderType))
+	assert.Equal(t, types.ORDER_TYPE_STOP_LOSS, orderType)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`"ORDER_TYPE_MARKET"`), &orderType), "invalid order type: ORDER_TYPE_MARKET")
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -10,18 +12,45 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyAllowedPools     = []byte("AllowedPools")
-	KeySwapFee          = []byte("SwapFee")
-	DefaultAllowedPools = AllowedPools{}
-	DefaultSwapFee      = sdk.ZeroDec()
-	MaxSwapFee          = sdk.OneDec()
+	KeyAllowedPools           = []byte("AllowedPools")
+	KeySwapFee                = []byte("SwapFee")
+	KeyProtocolFeeShare       = []byte("ProtocolFeeShare")
+	KeyPriceHistoryBlocks     = []byte("PriceHistoryBlocks")
+	KeyMaxOrderFills          = []byte("MaxOrderFills")
+	KeyMinOrderShare          = []byte("MinOrderShare")
+	KeyMaxOrderDuration       = []byte("MaxOrderDuration")
+	DefaultAllowedPools       = AllowedPools{}
+	DefaultSwapFee            = sdk.ZeroDec()
+	DefaultProtocolFeeShare   = sdk.ZeroDec()
+	DefaultPriceHistoryBlocks = uint64(14400)
+	DefaultMaxOrderFills      = uint64(100)
+	DefaultMinOrderShare      = sdk.MustNewDecFromStr("0.00001")
+	DefaultMaxOrderDuration   = 30 * 24 * time.Hour
+	MaxSwapFee                = sdk.OneDec()
+	MaxProtocolFeeShare       = sdk.OneDec()
+	MaxPriceHistoryBlocks     = uint64(1000000)
+	MaxMaxOrderFills          = uint64(10000)
+	MaxMinOrderShare          = sdk.OneDec()
 )
 
 // NewParams returns a new params object
-func NewParams(pairs AllowedPools, swapFee sdk.Dec) Params {
+func NewParams(
+	pairs AllowedPools,
+	swapFee sdk.Dec,
+	protocolFeeShare sdk.Dec,
+	priceHistoryBlocks uint64,
+	maxOrderFills uint64,
+	minOrderShare sdk.Dec,
+	maxOrderDuration time.Duration,
+) Params {
 	return Params{
-		AllowedPools: pairs,
-		SwapFee:      swapFee,
+		AllowedPools:       pairs,
+		SwapFee:            swapFee,
+		ProtocolFeeShare:   protocolFeeShare,
+		PriceHistoryBlocks: priceHistoryBlocks,
+		MaxOrderFills:      maxOrderFills,
+		MinOrderShare:      minOrderShare,
+		MaxOrderDuration:   maxOrderDuration,
 	}
 }
 
@@ -30,6 +59,11 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultAllowedPools,
 		DefaultSwapFee,
+		DefaultProtocolFeeShare,
+		DefaultPriceHistoryBlocks,
+		DefaultMaxOrderFills,
+		DefaultMinOrderShare,
+		DefaultMaxOrderDuration,
 	)
 }
 
@@ -37,8 +71,24 @@ func DefaultParams() Params {
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	AllowedPools: %s
-	SwapFee: %s`,
-		p.AllowedPools, p.SwapFee)
+	SwapFee: %s
+	ProtocolFeeShare: %s
+	PriceHistoryBlocks: %d
+	MaxOrderFills: %d
+	MinOrderShare: %s
+	MaxOrderDuration: %s`,
+		p.AllowedPools, p.SwapFee, p.ProtocolFeeShare, p.PriceHistoryBlocks, p.MaxOrderFills, p.MinOrderShare, p.MaxOrderDuration)
+}
+
+// PoolSwapFee returns the swap fee of a pool. This is the swap fee of the pool's allowed pool
+// if it is set, otherwise the global swap fee.
+func (p Params) PoolSwapFee(poolID string) sdk.Dec {
+	for _, allowedPool := range p.AllowedPools {
+		if allowedPool.Name() == poolID && allowedPool.SwapFee.IsPositive() {
+			return allowedPool.SwapFee
+		}
+	}
+	return p.SwapFee
 }
 
 // ParamKeyTable for swap module.
@@ -51,6 +101,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedPools, &p.AllowedPools, validateAllowedPoolsParams),
 		paramtypes.NewParamSetPair(KeySwapFee, &p.SwapFee, validateSwapFee),
+		paramtypes.NewParamSetPair(KeyProtocolFeeShare, &p.ProtocolFeeShare, validateProtocolFeeShare),
+		paramtypes.NewParamSetPair(KeyPriceHistoryBlocks, &p.PriceHistoryBlocks, validatePriceHistoryBlocks),
+		paramtypes.NewParamSetPair(KeyMaxOrderFills, &p.MaxOrderFills, validateMaxOrderFills),
+		paramtypes.NewParamSetPair(KeyMinOrderShare, &p.MinOrderShare, validateMinOrderShare),
+		paramtypes.NewParamSetPair(KeyMaxOrderDuration, &p.MaxOrderDuration, validateMaxOrderDuration),
 	}
 }
 
@@ -60,7 +115,27 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateSwapFee(p.SwapFee)
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeShare(p.ProtocolFeeShare); err != nil {
+		return err
+	}
+
+	if err := validatePriceHistoryBlocks(p.PriceHistoryBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMaxOrderFills(p.MaxOrderFills); err != nil {
+		return err
+	}
+
+	if err := validateMinOrderShare(p.MinOrderShare); err != nil {
+		return err
+	}
+
+	return validateMaxOrderDuration(p.MaxOrderDuration)
 }
 
 func validateAllowedPoolsParams(i interface{}) error {
@@ -85,11 +160,89 @@ func validateSwapFee(i interface{}) error {
 	return nil
 }
 
-// NewAllowedPool returns a new AllowedPool object
+func validateProtocolFeeShare(i interface{}) error {
+	share, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if share.IsNil() || share.IsNegative() || share.GT(MaxProtocolFeeShare) {
+		return fmt.Errorf("invalid protocol fee share: %s", share)
+	}
+
+	return nil
+}
+
+func validatePriceHistoryBlocks(i interface{}) error {
+	blocks, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if blocks > MaxPriceHistoryBlocks {
+		return fmt.Errorf("price history blocks %d exceeds maximum %d", blocks, MaxPriceHistoryBlocks)
+	}
+
+	return nil
+}
+
+func validateMaxOrderFills(i interface{}) error {
+	fills, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fills > MaxMaxOrderFills {
+		return fmt.Errorf("max order fills %d exceeds maximum %d", fills, MaxMaxOrderFills)
+	}
+
+	return nil
+}
+
+func validateMinOrderShare(i interface{}) error {
+	share, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if share.IsNil() || share.IsNegative() || share.GT(MaxMinOrderShare) {
+		return fmt.Errorf("invalid min order share: %s", share)
+	}
+
+	return nil
+}
+
+func validateMaxOrderDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration < 0 {
+		return fmt.Errorf("max order duration cannot be negative: %s", duration)
+	}
+
+	return nil
+}
+
+// NewAllowedPool returns a new AllowedPool object for a constant-product pool
 func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	return AllowedPool{
-		TokenA: tokenA,
-		TokenB: tokenB,
+		TokenA:   tokenA,
+		TokenB:   tokenB,
+		PoolType: POOL_TYPE_CONSTANT_PRODUCT,
+		SwapFee:  sdk.ZeroDec(),
+	}
+}
+
+// NewStableSwapAllowedPool returns a new AllowedPool object for a stableswap pool
+func NewStableSwapAllowedPool(tokenA, tokenB string, amplification uint64) AllowedPool {
+	return AllowedPool{
+		TokenA:        tokenA,
+		TokenB:        tokenB,
+		PoolType:      POOL_TYPE_STABLESWAP,
+		Amplification: amplification,
+		SwapFee:       sdk.ZeroDec(),
 	}
 }
 
@@ -129,6 +282,14 @@ func (p AllowedPool) Validate() error {
 		)
 	}
 
+	if err := validatePoolType(p.PoolType, p.Amplification); err != nil {
+		return err
+	}
+
+	if p.SwapFee.IsNil() || p.SwapFee.IsNegative() || p.SwapFee.GTE(MaxSwapFee) {
+		return fmt.Errorf("invalid swap fee: %s", p.SwapFee)
+	}
+
 	return nil
 }
 
@@ -139,11 +300,21 @@ func (p AllowedPool) Name() string {
 
 // String pretty prints the allowedPool
 func (p AllowedPool) String() string {
-	return fmt.Sprintf(`AllowedPool:
+	out := fmt.Sprintf(`AllowedPool:
   Name: %s
 	Token A: %s
 	Token B: %s
 `, p.Name(), p.TokenA, p.TokenB)
+	if p.PoolType == POOL_TYPE_STABLESWAP {
+		out += fmt.Sprintf(`	Pool Type: %s
+	Amplification: %d
+`, p.PoolType, p.Amplification)
+	}
+	if !p.SwapFee.IsNil() && p.SwapFee.IsPositive() {
+		out += fmt.Sprintf(`	Swap Fee: %s
+`, p.SwapFee)
+	}
+	return out
 }
 
 // AllowedPools is a slice of AllowedPool
@@ -171,3 +342,39 @@ func (p AllowedPools) Validate() error {
 
 	return nil
 }
+
+// validatePoolType returns an error if the pool type is unknown or its amplification is invalid
+func validatePoolType(poolType PoolType, amplification uint64) error {
+	switch poolType {
+	case POOL_TYPE_CONSTANT_PRODUCT:
+		if amplification != 0 {
+			return fmt.Errorf("constant product pool cannot have an amplification, got %d", amplification)
+		}
+	case POOL_TYPE_STABLESWAP:
+		if err := validateAmplification(amplification); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid pool type: %s", poolType)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the pool type as its name so amino json matches proto json
+func (t PoolType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes a pool type from its name
+func (t *PoolType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	value, ok := PoolType_value[name]
+	if !ok {
+		return fmt.Errorf("invalid pool type: %s", name)
+	}
+	*t = PoolType(value)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -52,8 +53,10 @@ func TestParams_MarshalYAML(t *testing.T) {
 	require.NoError(t, err)
 
 	p := types.Params{
-		AllowedPools: pools,
-		SwapFee:      fee,
+		AllowedPools:     pools,
+		SwapFee:          fee,
+		ProtocolFeeShare: sdk.ZeroDec(),
+		MinOrderShare:    sdk.ZeroDec(),
 	}
 
 	data, err := yaml.Marshal(p)
@@ -178,6 +181,118 @@ func TestParams_Validation(t *testing.T) {
 			},
 			expectedErr: "invalid swap fee: 1.000000000000000000",
 		},
+		{
+			name: "nil protocol fee share",
+			key:  types.KeyProtocolFeeShare,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeShare = sdk.Dec{}
+			},
+			expectedErr: "invalid protocol fee share: <nil>",
+		},
+		{
+			name: "negative protocol fee share",
+			key:  types.KeyProtocolFeeShare,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeShare = sdk.NewDec(-1)
+			},
+			expectedErr: "invalid protocol fee share: -1.000000000000000000",
+		},
+		{
+			name: "protocol fee share greater than 1",
+			key:  types.KeyProtocolFeeShare,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeShare = sdk.MustNewDecFromStr("1.000000000000000001")
+			},
+			expectedErr: "invalid protocol fee share: 1.000000000000000001",
+		},
+		{
+			name: "1 protocol fee share",
+			key:  types.KeyProtocolFeeShare,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeShare = sdk.OneDec()
+			},
+			expectedErr: "",
+		},
+		{
+			name: "zero price history blocks",
+			key:  types.KeyPriceHistoryBlocks,
+			testFn: func(params *types.Params) {
+				params.PriceHistoryBlocks = 0
+			},
+			expectedErr: "",
+		},
+		{
+			name: "max price history blocks",
+			key:  types.KeyPriceHistoryBlocks,
+			testFn: func(params *types.Params) {
+				params.PriceHistoryBlocks = types.MaxPriceHistoryBlocks
+			},
+			expectedErr: "",
+		},
+		{
+			name: "price history blocks greater than max",
+			key:  types.KeyPriceHistoryBlocks,
+			testFn: func(params *types.Params) {
+				params.PriceHistoryBlocks = types.MaxPriceHistoryBlocks + 1
+			},
+			expectedErr: "price history blocks 1000001 exceeds maximum 1000000",
+		},
+		{
+			name: "zero max order fills",
+			key:  types.KeyMaxOrderFills,
+			testFn: func(params *types.Params) {
+				params.MaxOrderFills = 0
+			},
+			expectedErr: "",
+		},
+		{
+			name: "max order fills greater than max",
+			key:  types.KeyMaxOrderFills,
+			testFn: func(params *types.Params) {
+				params.MaxOrderFills = types.MaxMaxOrderFills + 1
+			},
+			expectedErr: "max order fills 10001 exceeds maximum 10000",
+		},
+		{
+			name: "nil min order share",
+			key:  types.KeyMinOrderShare,
+			testFn: func(params *types.Params) {
+				params.MinOrderShare = sdk.Dec{}
+			},
+			expectedErr: "invalid min order share: <nil>",
+		},
+		{
+			name: "negative min order share",
+			key:  types.KeyMinOrderShare,
+			testFn: func(params *types.Params) {
+				params.MinOrderShare = sdk.NewDec(-1)
+			},
+			expectedErr: "invalid min order share: -1.000000000000000000",
+		},
+		{
+			name: "zero min order share",
+			key:  types.KeyMinOrderShare,
+			testFn: func(params *types.Params) {
+				params.MinOrderShare = sdk.ZeroDec()
+			},
+			expectedErr: "",
+		},
+		{
+			name: "zero max order duration",
+			key:  types.KeyMaxOrderDuration,
+			testFn: func(params *types.Params) {
+				params.MaxOrderDuration = 0
+			},
+			expectedErr: "",
+		},
+		{
+			name: "negative max order duration",
+			key:  types.KeyMaxOrderDuration,
+			testFn: func(params *types.Params) {
+				params.MaxOrderDuration = -time.Hour
+			},
+			expectedErr: "max order duration cannot be negative: -1h0m0s",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -216,6 +331,11 @@ func TestParams_String(t *testing.T) {
 			types.NewAllowedPool("ukava", "usdx"),
 		),
 		sdk.MustNewDecFromStr("0.5"),
+		sdk.ZeroDec(),
+		types.DefaultPriceHistoryBlocks,
+		types.DefaultMaxOrderFills,
+		types.DefaultMinOrderShare,
+		types.DefaultMaxOrderDuration,
 	)
 
 	require.NoError(t, params.Validate())
@@ -277,6 +397,61 @@ func TestAllowedPool_Validation(t *testing.T) {
 			allowedPool: types.NewAllowedPool("ukava", "u:kava"),
 			expectedErr: "tokenB cannot have colons in the denom: u:kava",
 		},
+		{
+			name: "constant product pool with amplification",
+			allowedPool: types.AllowedPool{
+				TokenA:        "ukava",
+				TokenB:        "usdx",
+				PoolType:      types.POOL_TYPE_CONSTANT_PRODUCT,
+				Amplification: 100,
+			},
+			expectedErr: "constant product pool cannot have an amplification, got 100",
+		},
+		{
+			name:        "stableswap pool with zero amplification",
+			allowedPool: types.NewStableSwapAllowedPool("usdc", "usdx", 0),
+			expectedErr: "amplification must be between 1 and 1000000, got 0",
+		},
+		{
+			name:        "stableswap pool with amplification too large",
+			allowedPool: types.NewStableSwapAllowedPool("usdc", "usdx", types.MaxAmplification+1),
+			expectedErr: "amplification must be between 1 and 1000000, got 1000001",
+		},
+		{
+			name: "unknown pool type",
+			allowedPool: types.AllowedPool{
+				TokenA:   "ukava",
+				TokenB:   "usdx",
+				PoolType: types.PoolType(2),
+			},
+			expectedErr: "invalid pool type: 2",
+		},
+		{
+			name: "nil swap fee",
+			allowedPool: types.AllowedPool{
+				TokenA: "ukava",
+				TokenB: "usdx",
+			},
+			expectedErr: "invalid swap fee: <nil>",
+		},
+		{
+			name: "negative swap fee",
+			allowedPool: types.AllowedPool{
+				TokenA:  "ukava",
+				TokenB:  "usdx",
+				SwapFee: sdk.NewDec(-1),
+			},
+			expectedErr: "invalid swap fee: -1.000000000000000000",
+		},
+		{
+			name: "1 swap fee",
+			allowedPool: types.AllowedPool{
+				TokenA:  "ukava",
+				TokenB:  "usdx",
+				SwapFee: sdk.OneDec(),
+			},
+			expectedErr: "invalid swap fee: 1.000000000000000000",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -313,6 +488,57 @@ func TestAllowedPool_String(t *testing.T) {
 	assert.Equal(t, output, allowedPool.String())
 }
 
+func TestAllowedPool_String_StableSwap(t *testing.T) {
+	allowedPool := types.NewStableSwapAllowedPool("usdc", "usdx", 200)
+	require.NoError(t, allowedPool.Validate())
+
+	output := `AllowedPool:
+  Name: usdc:usdx
+	Token A: usdc
+	Token B: usdx
+	Pool Type: POOL_TYPE_STABLESWAP
+	Amplification: 200
+`
+	assert.Equal(t, output, allowedPool.String())
+}
+
+func TestAllowedPool_String_SwapFee(t *testing.T) {
+	allowedPool := types.NewAllowedPool("hard", "ukava")
+	allowedPool.SwapFee = sdk.MustNewDecFromStr("0.001")
+	require.NoError(t, allowedPool.Validate())
+
+	output := `AllowedPool:
+  Name: hard:ukava
+	Token A: hard
+	Token B: ukava
+	Swap Fee: 0.001000000000000000
+`
+	assert.Equal(t, output, allowedPool.String())
+}
+
+func TestParams_PoolSwapFee(t *testing.T) {
+	poolWithFee := types.NewAllowedPool("hard", "ukava")
+	poolWithFee.SwapFee = sdk.MustNewDecFromStr("0.001")
+
+	params := types.NewParams(
+		types.NewAllowedPools(
+			poolWithFee,
+			types.NewAllowedPool("ukava", "usdx"),
+		),
+		sdk.MustNewDecFromStr("0.003"),
+		sdk.ZeroDec(),
+		types.DefaultPriceHistoryBlocks,
+		types.DefaultMaxOrderFills,
+		types.DefaultMinOrderShare,
+		types.DefaultMaxOrderDuration,
+	)
+	require.NoError(t, params.Validate())
+
+	assert.Equal(t, sdk.MustNewDecFromStr("0.001"), params.PoolSwapFee(types.PoolID("hard", "ukava")))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.003"), params.PoolSwapFee(types.PoolID("ukava", "usdx")))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.003"), params.PoolSwapFee(types.PoolID("hard", "usdx")))
+}
+
 func TestAllowedPool_Name(t *testing.T) {
 	testCases := []struct {
 		tokens string
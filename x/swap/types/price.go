@@ -0,0 +1,156 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPoolPriceSnapshot returns a new pool price snapshot
+func NewPoolPriceSnapshot(
+	poolID string,
+	height int64,
+	blockTime time.Time,
+	priceCumulativeA, priceCumulativeB sdk.Dec,
+	priceA, priceB sdk.Dec,
+) PoolPriceSnapshot {
+	return PoolPriceSnapshot{
+		PoolID:           poolID,
+		Height:           height,
+		Time:             blockTime,
+		PriceCumulativeA: priceCumulativeA,
+		PriceCumulativeB: priceCumulativeB,
+		PriceA:           priceA,
+		PriceB:           priceB,
+	}
+}
+
+// NewPoolPriceSnapshotFromRecord returns a snapshot of a pool's prices at a block height and time,
+// advancing the pool's price accumulators to the block time.
+func NewPoolPriceSnapshotFromRecord(record PoolRecord, height int64, blockTime time.Time) (PoolPriceSnapshot, error) {
+	cumulativeA, cumulativeB, err := record.CumulativePricesAt(blockTime)
+	if err != nil {
+		return PoolPriceSnapshot{}, err
+	}
+
+	priceA, priceB, err := record.SpotPrices()
+	if err != nil {
+		return PoolPriceSnapshot{}, err
+	}
+
+	return NewPoolPriceSnapshot(record.PoolID, height, blockTime, cumulativeA, cumulativeB, priceA, priceB), nil
+}
+
+// Validate performs basic validation checks of the snapshot data
+func (s PoolPriceSnapshot) Validate() error {
+	if s.PoolID == "" {
+		return errors.New("poolID must be set")
+	}
+	if s.Height <= 0 {
+		return fmt.Errorf("pool '%s' snapshot has invalid height: %d", s.PoolID, s.Height)
+	}
+	if s.Time.IsZero() {
+		return fmt.Errorf("pool '%s' snapshot time cannot be zero", s.PoolID)
+	}
+	for _, cumulative := range []sdk.Dec{s.PriceCumulativeA, s.PriceCumulativeB} {
+		if cumulative.IsNil() || cumulative.IsNegative() {
+			return fmt.Errorf("pool '%s' snapshot has invalid price accumulator: %s", s.PoolID, cumulative)
+		}
+	}
+	for _, price := range []sdk.Dec{s.PriceA, s.PriceB} {
+		if price.IsNil() || !price.IsPositive() {
+			return fmt.Errorf("pool '%s' snapshot has invalid price: %s", s.PoolID, price)
+		}
+	}
+	return nil
+}
+
+// At returns the snapshot with its price accumulators advanced to time t at the snapshot prices.
+// The pool's prices do not change between the snapshot and the pool's next update, so t must be before the next snapshot.
+func (s PoolPriceSnapshot) At(t time.Time) PoolPriceSnapshot {
+	elapsed := durationToSeconds(t.Sub(s.Time))
+
+	s.PriceCumulativeA = s.PriceCumulativeA.Add(s.PriceA.Mul(elapsed))
+	s.PriceCumulativeB = s.PriceCumulativeB.Add(s.PriceB.Mul(elapsed))
+	s.Time = t
+	return s
+}
+
+// PoolPriceSnapshots is a slice of PoolPriceSnapshot
+type PoolPriceSnapshots []PoolPriceSnapshot
+
+// Validate performs basic validation checks on all snapshots in the slice
+func (ss PoolPriceSnapshots) Validate() error {
+	seenSnapshots := make(map[string]bool)
+
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", s.PoolID, s.Height)
+		if seenSnapshots[key] {
+			return fmt.Errorf("duplicate snapshot for pool '%s' at height %d", s.PoolID, s.Height)
+		}
+
+		seenSnapshots[key] = true
+	}
+
+	return nil
+}
+
+// PoolTWAP holds the time-weighted average prices of a pool between two times
+type PoolTWAP struct {
+	PoolID    string
+	StartTime time.Time
+	EndTime   time.Time
+	// PriceA is the average price of token a in token b
+	PriceA sdk.Dec
+	// PriceB is the average price of token b in token a
+	PriceB sdk.Dec
+}
+
+// NewPoolTWAP returns the time-weighted average prices of a pool between two snapshots.
+// When the snapshots are taken at the same time, the prices of the end snapshot are returned.
+func NewPoolTWAP(start, end PoolPriceSnapshot) PoolTWAP {
+	twap := PoolTWAP{
+		PoolID:    end.PoolID,
+		StartTime: start.Time,
+		EndTime:   end.Time,
+		PriceA:    end.PriceA,
+		PriceB:    end.PriceB,
+	}
+
+	if end.Time.After(start.Time) {
+		elapsed := durationToSeconds(end.Time.Sub(start.Time))
+		twap.PriceA = end.PriceCumulativeA.Sub(start.PriceCumulativeA).Quo(elapsed)
+		twap.PriceB = end.PriceCumulativeB.Sub(start.PriceCumulativeB).Quo(elapsed)
+	}
+
+	return twap
+}
+
+// Price returns the average price of a pool token in units of the other pool token
+func (t PoolTWAP) Price(denom string) (sdk.Dec, error) {
+	denoms := strings.Split(t.PoolID, PoolIDSep)
+	if len(denoms) != 2 {
+		return sdk.Dec{}, fmt.Errorf("poolID '%s' is invalid", t.PoolID)
+	}
+
+	switch denom {
+	case denoms[0]:
+		return t.PriceA, nil
+	case denoms[1]:
+		return t.PriceB, nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("denom '%s' is not in pool '%s'", denom, t.PoolID)
+	}
+}
+
+// durationToSeconds returns a duration as a decimal number of seconds
+func durationToSeconds(d time.Duration) sdk.Dec {
+	return sdk.NewDecWithPrec(d.Nanoseconds(), 9)
+}
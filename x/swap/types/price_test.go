@@ -0,0 +1,157 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_PoolRecord_CumulativePricesAt(t *testing.T) {
+	lastUpdated := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	record := types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6))
+	record.PriceCumulativeA = d("10")
+	record.PriceCumulativeB = d("1")
+	record.PriceLastUpdated = lastUpdated
+
+	cumulativeA, cumulativeB, err := record.CumulativePricesAt(lastUpdated.Add(10 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, d("60"), cumulativeA)
+	assert.Equal(t, d("3"), cumulativeB)
+
+	// prices do not accumulate before the last update
+	cumulativeA, cumulativeB, err = record.CumulativePricesAt(lastUpdated)
+	require.NoError(t, err)
+	assert.Equal(t, d("10"), cumulativeA)
+	assert.Equal(t, d("1"), cumulativeB)
+
+	cumulativeA, cumulativeB, err = record.CumulativePricesAt(lastUpdated.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, d("10"), cumulativeA)
+	assert.Equal(t, d("1"), cumulativeB)
+
+	// a record that has never been updated has nothing to accumulate
+	record = types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6))
+	cumulativeA, cumulativeB, err = record.CumulativePricesAt(lastUpdated)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.ZeroDec(), cumulativeA)
+	assert.Equal(t, sdk.ZeroDec(), cumulativeB)
+}
+
+func TestPoolPriceSnapshot_Validate(t *testing.T) {
+	validTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		snapshot    types.PoolPriceSnapshot
+		expectedErr string
+	}{
+		{
+			name:     "valid snapshot",
+			snapshot: types.NewPoolPriceSnapshot("ukava:usdx", 1, validTime, d("0"), d("0"), d("5"), d("0.2")),
+		},
+		{
+			name:        "empty pool id",
+			snapshot:    types.NewPoolPriceSnapshot("", 1, validTime, d("0"), d("0"), d("5"), d("0.2")),
+			expectedErr: "poolID must be set",
+		},
+		{
+			name:        "zero height",
+			snapshot:    types.NewPoolPriceSnapshot("ukava:usdx", 0, validTime, d("0"), d("0"), d("5"), d("0.2")),
+			expectedErr: "pool 'ukava:usdx' snapshot has invalid height: 0",
+		},
+		{
+			name:        "zero time",
+			snapshot:    types.NewPoolPriceSnapshot("ukava:usdx", 1, time.Time{}, d("0"), d("0"), d("5"), d("0.2")),
+			expectedErr: "pool 'ukava:usdx' snapshot time cannot be zero",
+		},
+		{
+			name:        "negative accumulator",
+			snapshot:    types.NewPoolPriceSnapshot("ukava:usdx", 1, validTime, d("0"), d("-1"), d("5"), d("0.2")),
+			expectedErr: "pool 'ukava:usdx' snapshot has invalid price accumulator: -1.000000000000000000",
+		},
+		{
+			name:        "zero price",
+			snapshot:    types.NewPoolPriceSnapshot("ukava:usdx", 1, validTime, d("0"), d("0"), d("0"), d("0.2")),
+			expectedErr: "pool 'ukava:usdx' snapshot has invalid price: 0.000000000000000000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.snapshot.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPoolPriceSnapshots_Validate(t *testing.T) {
+	snapshotTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	snapshots := types.PoolPriceSnapshots{
+		types.NewPoolPriceSnapshot("ukava:usdx", 1, snapshotTime, d("0"), d("0"), d("5"), d("0.2")),
+		types.NewPoolPriceSnapshot("ukava:usdx", 2, snapshotTime, d("0"), d("0"), d("5"), d("0.2")),
+		types.NewPoolPriceSnapshot("hard:usdx", 1, snapshotTime, d("0"), d("0"), d("2"), d("0.5")),
+	}
+	assert.NoError(t, snapshots.Validate())
+
+	snapshots = append(snapshots, types.NewPoolPriceSnapshot("hard:usdx", 1, snapshotTime, d("0"), d("0"), d("2"), d("0.5")))
+	assert.EqualError(t, snapshots.Validate(), "duplicate snapshot for pool 'hard:usdx' at height 1")
+}
+
+func TestPoolPriceSnapshot_At(t *testing.T) {
+	snapshotTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := types.NewPoolPriceSnapshot("ukava:usdx", 1, snapshotTime, d("100"), d("10"), d("5"), d("0.2"))
+
+	advanced := snapshot.At(snapshotTime.Add(1500 * time.Millisecond))
+	assert.Equal(t, snapshotTime.Add(1500*time.Millisecond), advanced.Time)
+	assert.Equal(t, d("107.5"), advanced.PriceCumulativeA)
+	assert.Equal(t, d("10.3"), advanced.PriceCumulativeB)
+	assert.Equal(t, snapshot.PriceA, advanced.PriceA)
+	assert.Equal(t, snapshot.PriceB, advanced.PriceB)
+
+	// the original snapshot is not modified
+	assert.Equal(t, d("100"), snapshot.PriceCumulativeA)
+}
+
+func TestNewPoolTWAP(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	start := types.NewPoolPriceSnapshot("ukava:usdx", 1, startTime, d("100"), d("10"), d("5"), d("0.2"))
+	end := types.NewPoolPriceSnapshot("ukava:usdx", 5, startTime.Add(20*time.Second), d("160"), d("25"), d("4"), d("0.25"))
+
+	twap := types.NewPoolTWAP(start, end)
+	assert.Equal(t, "ukava:usdx", twap.PoolID)
+	assert.Equal(t, startTime, twap.StartTime)
+	assert.Equal(t, startTime.Add(20*time.Second), twap.EndTime)
+	assert.Equal(t, d("3"), twap.PriceA)
+	assert.Equal(t, d("0.75"), twap.PriceB)
+
+	// snapshots at the same time use the end prices
+	twap = types.NewPoolTWAP(end, end)
+	assert.Equal(t, d("4"), twap.PriceA)
+	assert.Equal(t, d("0.25"), twap.PriceB)
+}
+
+func TestPoolTWAP_Price(t *testing.T) {
+	twap := types.PoolTWAP{PoolID: "ukava:usdx", PriceA: d("5"), PriceB: d("0.2")}
+
+	price, err := twap.Price("ukava")
+	require.NoError(t, err)
+	assert.Equal(t, d("5"), price)
+
+	price, err = twap.Price("usdx")
+	require.NoError(t, err)
+	assert.Equal(t, d("0.2"), price)
+
+	_, err = twap.Price("hard")
+	assert.EqualError(t, err, "denom 'hard' is not in pool 'ukava:usdx'")
+}
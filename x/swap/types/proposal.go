@@ -0,0 +1,68 @@
+package types
+
+import (
+	fmt "fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeProtocolFeeTransfer defines the type for a ProtocolFeeTransferProposal
+	ProposalTypeProtocolFeeTransfer = "ProtocolFeeTransfer"
+)
+
+// Assert ProtocolFeeTransferProposal implements govtypes.Content at compile-time
+var _ govv1beta1.Content = &ProtocolFeeTransferProposal{}
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeProtocolFeeTransfer)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&ProtocolFeeTransferProposal{}, "kava/ProtocolFeeTransferProposal", nil)
+}
+
+// NewProtocolFeeTransferProposal creates a new protocol fee transfer proposal.
+func NewProtocolFeeTransferProposal(title, description string, amount sdk.Coins) *ProtocolFeeTransferProposal {
+	return &ProtocolFeeTransferProposal{
+		Title:       title,
+		Description: description,
+		Amount:      amount,
+	}
+}
+
+// GetTitle returns the title of a protocol fee transfer proposal.
+func (p *ProtocolFeeTransferProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a protocol fee transfer proposal.
+func (p *ProtocolFeeTransferProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a protocol fee transfer proposal.
+func (p *ProtocolFeeTransferProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a protocol fee transfer proposal.
+func (p *ProtocolFeeTransferProposal) ProposalType() string {
+	return ProposalTypeProtocolFeeTransfer
+}
+
+// String implements fmt.Stringer
+func (p *ProtocolFeeTransferProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Protocol Fee Transfer Proposal:
+  Title:       %s
+  Description: %s
+  Amount:      %s
+`, p.Title, p.Description, p.Amount))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of a protocol fee transfer proposal.
+func (p *ProtocolFeeTransferProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return fmt.Errorf("invalid amount: %s", p.Amount)
+	}
+	return nil
+}
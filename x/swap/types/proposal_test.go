@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestProtocolFeeTransferProposal_ValidateBasic(t *testing.T) {
+	validAmount := sdk.NewCoins(sdk.NewCoin("ukava", i(1e6)))
+
+	testCases := []struct {
+		name        string
+		proposal    *types.ProtocolFeeTransferProposal
+		expectedErr string
+	}{
+		{
+			name:        "valid proposal",
+			proposal:    types.NewProtocolFeeTransferProposal("title", "description", validAmount),
+			expectedErr: "",
+		},
+		{
+			name:        "blank title",
+			proposal:    types.NewProtocolFeeTransferProposal("", "description", validAmount),
+			expectedErr: "proposal title cannot be blank: invalid proposal content",
+		},
+		{
+			name:        "blank description",
+			proposal:    types.NewProtocolFeeTransferProposal("title", "", validAmount),
+			expectedErr: "proposal description cannot be blank: invalid proposal content",
+		},
+		{
+			name:        "empty amount",
+			proposal:    types.NewProtocolFeeTransferProposal("title", "description", sdk.NewCoins()),
+			expectedErr: "invalid amount: ",
+		},
+		{
+			name:        "invalid amount",
+			proposal:    types.NewProtocolFeeTransferProposal("title", "description", sdk.Coins{sdk.Coin{Denom: "ukava", Amount: i(-1)}}),
+			expectedErr: "invalid amount: -1ukava",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestProtocolFeeTransferProposal_Route(t *testing.T) {
+	proposal := types.NewProtocolFeeTransferProposal("title", "description", sdk.NewCoins(sdk.NewCoin("ukava", i(1e6))))
+	assert.Equal(t, types.RouterKey, proposal.ProposalRoute())
+	assert.Equal(t, types.ProposalTypeProtocolFeeTransfer, proposal.ProposalType())
+}
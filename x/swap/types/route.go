@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateSwapRoute checks that a route of pool ids is not empty or too long, does not visit a pool
+// more than once, and connects denomIn to denomOut with each pool sharing a denom with the next.
+func ValidateSwapRoute(route []string, denomIn string, denomOut string) error {
+	if len(route) == 0 {
+		return errorsmod.Wrap(ErrInvalidRoute, "route cannot be empty")
+	}
+	if len(route) > MaxSwapRouteLength {
+		return errorsmod.Wrapf(ErrInvalidRoute, "route length %d exceeds maximum of %d", len(route), MaxSwapRouteLength)
+	}
+
+	seenPoolIDs := make(map[string]bool)
+	denom := denomIn
+	for _, poolID := range route {
+		if seenPoolIDs[poolID] {
+			return errorsmod.Wrapf(ErrInvalidRoute, "duplicate pool %s", poolID)
+		}
+		seenPoolIDs[poolID] = true
+
+		denoms := strings.Split(poolID, PoolIDSep)
+		if len(denoms) != 2 || denoms[0] == "" || denoms[1] == "" || PoolID(denoms[0], denoms[1]) != poolID {
+			return errorsmod.Wrapf(ErrInvalidRoute, "invalid pool id %s", poolID)
+		}
+
+		switch denom {
+		case denoms[0]:
+			denom = denoms[1]
+		case denoms[1]:
+			denom = denoms[0]
+		default:
+			return errorsmod.Wrapf(ErrInvalidRoute, "pool %s does not contain %s", poolID, denom)
+		}
+	}
+
+	if denom != denomOut {
+		return errorsmod.Wrapf(ErrInvalidRoute, "route ends in %s, not %s", denom, denomOut)
+	}
+
+	return nil
+}
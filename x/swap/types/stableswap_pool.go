@@ -0,0 +1,340 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAmplification is the largest amplification coefficient a stableswap pool can use
+const MaxAmplification = 1_000_000
+
+// maxStableSwapIterations bounds the newton's method iterations used to solve the stableswap invariant
+const maxStableSwapIterations = 255
+
+// precisionMultiplier scales big integer division results to the precision of sdk.Dec
+var precisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+
+// StableSwapPool implements a unitless liquidity pool using the two asset StableSwap invariant
+//
+//	4A(x + y) + D = 4AD + D^3 / 4xy
+//
+// where A is the amplification coefficient. A higher amplification flattens the curve around the
+// 1:1 price, giving lower slippage for assets that trade near a peg, while the pool still prices like
+// a constant-product pool as the reserves become imbalanced.
+//
+// Deposits, withdraws and shares are handled by the embedded BasePool, so liquidity is always added
+// and removed in the ratio of the pool reserves. Only swaps are priced by the StableSwap invariant.
+//
+// Swap amounts are rounded in favor of the pool, so the invariant D of the pool never decreases.
+type StableSwapPool struct {
+	*BasePool
+	amplification sdkmath.Int
+}
+
+// NewStableSwapPool returns a pointer to a stableswap pool with reserves and total shares initialized
+func NewStableSwapPool(reservesA, reservesB sdkmath.Int, amplification uint64) (*StableSwapPool, error) {
+	if err := validateAmplification(amplification); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	pool, err := NewBasePool(reservesA, reservesB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StableSwapPool{
+		BasePool:      pool,
+		amplification: sdkmath.NewIntFromUint64(amplification),
+	}, nil
+}
+
+// NewStableSwapPoolWithExistingShares returns a pointer to a stableswap pool with existing shares
+func NewStableSwapPoolWithExistingShares(reservesA, reservesB, totalShares sdkmath.Int, amplification uint64) (*StableSwapPool, error) {
+	if err := validateAmplification(amplification); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	pool, err := NewBasePoolWithExistingShares(reservesA, reservesB, totalShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StableSwapPool{
+		BasePool:      pool,
+		amplification: sdkmath.NewIntFromUint64(amplification),
+	}, nil
+}
+
+// Amplification returns the amplification coefficient of the pool
+func (p *StableSwapPool) Amplification() sdkmath.Int {
+	return p.amplification
+}
+
+// SpotPrice returns the marginal price of a in units of b, the slope of the curve at the current reserves.
+// Panics if the pool is empty.
+func (p *StableSwapPool) SpotPrice() sdk.Dec {
+	p.assertReservesArePositive()
+
+	x := p.reservesA.BigInt()
+	y := p.reservesB.BigInt()
+	d := p.invariant(x, y)
+
+	// the ratio of the partial derivatives of the invariant with respect to x and y
+	//
+	//	price = (4 * 4A * x^2 * y^2 + D^3 * y) / (4 * 4A * x^2 * y^2 + D^3 * x)
+	xy := new(big.Int).Mul(x, y)
+	base := new(big.Int).Mul(xy, xy)
+	base.Mul(base, p.ann())
+	base.Lsh(base, 2)
+
+	dCubed := new(big.Int).Mul(d, d)
+	dCubed.Mul(dCubed, d)
+
+	num := new(big.Int).Add(base, new(big.Int).Mul(dCubed, y))
+	den := new(big.Int).Add(base, new(big.Int).Mul(dCubed, x))
+
+	num.Mul(num, precisionMultiplier)
+	return sdk.NewDecFromBigIntWithPrec(num.Quo(num, den), sdk.Precision)
+}
+
+// SwapExactAForB trades an exact value of a for b.  Returns the positive amount b
+// that is removed from the pool and the portion of a that is used for paying the fee.
+func (p *StableSwapPool) SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	b, feeValue := p.calculateOutputForExactInput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return b, feeValue
+}
+
+// SwapExactBForA trades an exact value of b for a.  Returns the positive amount a
+// that is removed from the pool and the portion of b that is used for paying the fee.
+func (p *StableSwapPool) SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	a, feeValue := p.calculateOutputForExactInput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return a, feeValue
+}
+
+// SwapAForExactB trades a for an exact b.  Returns the positive amount a
+// that is added to the pool, and the portion of a that is used to pay the fee.
+func (p *StableSwapPool) SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	a, feeValue := p.calculateInputForExactOutput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return a, feeValue
+}
+
+// SwapBForExactA trades b for an exact a.  Returns the positive amount b
+// that is added to the pool, and the portion of b that is used to pay the fee.
+func (p *StableSwapPool) SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	b, feeValue := p.calculateInputForExactOutput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return b, feeValue
+}
+
+// calculateOutputForExactInput calculates the output amount of a swap using a fixed input, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is ceiled in the same way as the constant-product pool. The output is the amount that leaves the
+// reserves exactly on or above the current invariant curve.
+func (p *StableSwapPool) calculateOutputForExactInput(in, inReserves, outReserves sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapInputIsValid(in)
+	p.assertFeeIsValid(fee)
+
+	inAfterFee := sdk.NewDecFromInt(in).Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+	feeValue := in.Sub(inAfterFee)
+
+	d := p.invariant(p.reservesA.BigInt(), p.reservesB.BigInt())
+	newInReserves := new(big.Int).Add(inReserves.BigInt(), inAfterFee.BigInt())
+	newOutReserves := p.solveReserves(newInReserves, d)
+
+	out := new(big.Int).Sub(outReserves.BigInt(), newOutReserves)
+	if out.Sign() < 0 {
+		out.SetInt64(0)
+	}
+
+	return sdkmath.NewIntFromBigInt(out), feeValue
+}
+
+// calculateInputForExactOutput calculates the input amount of a swap using a fixed output, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The fee is ceiled in the same way as the constant-product pool. The input is the smallest amount that leaves
+// the reserves on or above the current invariant curve, and is at least one.
+func (p *StableSwapPool) calculateInputForExactOutput(out, outReserves, inReserves sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapOutputIsValid(out, outReserves)
+	p.assertFeeIsValid(fee)
+
+	d := p.invariant(p.reservesA.BigInt(), p.reservesB.BigInt())
+	newOutReserves := new(big.Int).Sub(outReserves.BigInt(), out.BigInt())
+	newInReserves := p.solveReserves(newOutReserves, d)
+
+	inWithoutFee := sdkmath.NewIntFromBigInt(new(big.Int).Sub(newInReserves, inReserves.BigInt()))
+	if !inWithoutFee.IsPositive() {
+		inWithoutFee = sdk.OneInt()
+	}
+
+	in := sdk.NewDecFromInt(inWithoutFee).Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+	feeValue := in.Sub(inWithoutFee)
+
+	return in, feeValue
+}
+
+// invariant returns the invariant D of reserves x and y, rounded up to the smallest integer
+// where the reserves are on or below the curve.
+func (p *StableSwapPool) invariant(x, y *big.Int) *big.Int {
+	ann := p.ann()
+	sum := new(big.Int).Add(x, y)
+
+	// newton's method starting from D = x + y, which is always greater than or equal to D
+	//
+	//	D' = (4A(x + y) + 2Dp) * D / ((4A - 1) * D + 3Dp), where Dp = D^3 / 4xy
+	d := new(big.Int).Set(sum)
+	for i := 0; i < maxStableSwapIterations; i++ {
+		dp := new(big.Int).Mul(d, d)
+		dp.Quo(dp, new(big.Int).Lsh(x, 1))
+		dp.Mul(dp, d)
+		dp.Quo(dp, new(big.Int).Lsh(y, 1))
+
+		num := new(big.Int).Mul(ann, sum)
+		num.Add(num, new(big.Int).Lsh(dp, 1))
+		num.Mul(num, d)
+
+		den := new(big.Int).Sub(ann, big.NewInt(1))
+		den.Mul(den, d)
+		den.Add(den, new(big.Int).Mul(big.NewInt(3), dp))
+
+		prev := d
+		d = num.Quo(num, den)
+		if new(big.Int).Sub(d, prev).CmpAbs(big.NewInt(1)) <= 0 {
+			break
+		}
+	}
+
+	// the curve excess decreases as D increases, so step to the smallest D with a non-positive excess
+	one := big.NewInt(1)
+	for p.curveExcess(x, y, d).Sign() > 0 {
+		d.Add(d, one)
+	}
+	for d.Sign() > 0 && p.curveExcess(x, y, new(big.Int).Sub(d, one)).Sign() <= 0 {
+		d.Sub(d, one)
+	}
+
+	return d
+}
+
+// solveReserves returns the smallest reserves of one asset that, given the reserves x of the other asset,
+// are on or above the curve of invariant D.
+func (p *StableSwapPool) solveReserves(x, d *big.Int) *big.Int {
+	ann := p.ann()
+
+	// newton's method for y^2 + (b - D)y = c, starting from y = D
+	//
+	//	c = D^3 / (4x * 4A), b = x + D / 4A
+	c := new(big.Int).Mul(d, d)
+	c.Quo(c, new(big.Int).Lsh(x, 1))
+	c.Mul(c, d)
+	c.Quo(c, new(big.Int).Lsh(ann, 1))
+
+	b := new(big.Int).Quo(d, ann)
+	b.Add(b, x)
+
+	y := new(big.Int).Set(d)
+	for i := 0; i < maxStableSwapIterations; i++ {
+		num := new(big.Int).Mul(y, y)
+		num.Add(num, c)
+
+		den := new(big.Int).Lsh(y, 1)
+		den.Add(den, b)
+		den.Sub(den, d)
+		if den.Sign() <= 0 {
+			break
+		}
+
+		prev := y
+		y = num.Quo(num, den)
+		if new(big.Int).Sub(y, prev).CmpAbs(big.NewInt(1)) <= 0 {
+			break
+		}
+	}
+
+	// the curve excess increases with y, so step to the smallest y with a non-negative excess
+	one := big.NewInt(1)
+	for p.curveExcess(x, y, d).Sign() < 0 {
+		y.Add(y, one)
+	}
+	for y.Cmp(one) > 0 && p.curveExcess(x, new(big.Int).Sub(y, one), d).Sign() >= 0 {
+		y.Sub(y, one)
+	}
+
+	return y
+}
+
+// curveExcess returns 4xy(4A(x + y) + D - 4AD) - D^3, which is zero when reserves x and y are on the
+// curve of invariant D, positive when they are above it, and negative when they are below it.
+func (p *StableSwapPool) curveExcess(x, y, d *big.Int) *big.Int {
+	ann := p.ann()
+
+	inner := new(big.Int).Add(x, y)
+	inner.Mul(inner, ann)
+	inner.Add(inner, d)
+	inner.Sub(inner, new(big.Int).Mul(ann, d))
+
+	excess := new(big.Int).Mul(x, y)
+	excess.Lsh(excess, 2)
+	excess.Mul(excess, inner)
+
+	dCubed := new(big.Int).Mul(d, d)
+	dCubed.Mul(dCubed, d)
+
+	return excess.Sub(excess, dCubed)
+}
+
+// ann returns the amplification coefficient multiplied by n^n, where n = 2 is the number of assets
+func (p *StableSwapPool) ann() *big.Int {
+	return new(big.Int).Lsh(p.amplification.BigInt(), 2)
+}
+
+// assertInvariantAndUpdateReserves asserts the new reserves, excluding fees, are on or above the curve of the
+// current invariant, then updates the pool reserves.  Panics if invariant is violated.
+func (p *StableSwapPool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdkmath.Int) {
+	d := p.invariant(p.reservesA.BigInt(), p.reservesB.BigInt())
+
+	x := newReservesA.Sub(feeA)
+	y := newReservesB.Sub(feeB)
+	if !x.IsPositive() || !y.IsPositive() {
+		panic("invalid state: reserves must be positive")
+	}
+
+	if p.curveExcess(x.BigInt(), y.BigInt(), d).Sign() < 0 {
+		panic(fmt.Sprintf("invalid state: reserves %s, %s are below invariant %s", x, y, d))
+	}
+
+	p.reservesA = newReservesA
+	p.reservesB = newReservesB
+}
+
+// validateAmplification returns an error if the amplification coefficient is out of bounds
+func validateAmplification(amplification uint64) error {
+	if amplification == 0 || amplification > MaxAmplification {
+		return fmt.Errorf("amplification must be between 1 and %d, got %d", MaxAmplification, amplification)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableSwapPool_NewPool_Validation(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		expectedErr   string
+	}{
+		{i(0), i(1e6), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(-1), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(1e6), 0, "amplification must be between 1 and 1000000, got 0: invalid pool"},
+		{i(1e6), i(1e6), types.MaxAmplification + 1, "amplification must be between 1 and 1000000, got 1000001: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amp=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+
+			pool, err = types.NewStableSwapPoolWithExistingShares(tc.reservesA, tc.reservesB, i(1e6), tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+}
+
+func TestStableSwapPool_InitialState(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(4e6), 100)
+	require.NoError(t, err)
+
+	basePool, err := types.NewBasePool(i(1e6), i(4e6))
+	require.NoError(t, err)
+
+	assert.Equal(t, basePool.TotalShares(), pool.TotalShares())
+	assert.Equal(t, i(1e6), pool.ReservesA())
+	assert.Equal(t, i(4e6), pool.ReservesB())
+	assert.Equal(t, i(100), pool.Amplification())
+}
+
+func TestStableSwapPool_Swap_LowerSlippageThanConstantProduct(t *testing.T) {
+	for _, amp := range []uint64{1, 10, 100, 1000} {
+		t.Run(fmt.Sprintf("amp=%d", amp), func(t *testing.T) {
+			stablePool, err := types.NewStableSwapPool(i(1e12), i(1e12), amp)
+			require.NoError(t, err)
+			basePool, err := types.NewBasePool(i(1e12), i(1e12))
+			require.NoError(t, err)
+
+			stableOut, stableFee := stablePool.SwapExactAForB(i(1e10), d("0.003"))
+			baseOut, baseFee := basePool.SwapExactAForB(i(1e10), d("0.003"))
+
+			assert.Equal(t, baseFee, stableFee)
+			assert.True(t, stableOut.GT(baseOut), "expected stableswap output %s to be greater than %s", stableOut, baseOut)
+			assert.True(t, stableOut.LT(i(1e10)), "expected stableswap output %s to be less than input", stableOut)
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_ExactInput(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		exactInput    sdkmath.Int
+		fee           sdk.Dec
+	}{
+		{i(1e6), i(1e6), 100, i(1e3), d("0.003")},
+		{i(1e6), i(1e6), 100, i(5e5), d("0.003")},
+		{i(1e6), i(5e6), 100, i(1e4), d("0.003")},
+		{i(5e6), i(1e6), 10, i(1e4), d("0")},
+		{i(1e12), i(3e12), 1000, i(1e11), d("0.01")},
+		{i(1e18), i(1e18), types.MaxAmplification, i(1e17), d("0.003")},
+		{i(100), i(100), 1, i(1), d("0.003")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amp=%d exactInput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactInput, tc.fee), func(t *testing.T) {
+			poolA, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			outB, feeA := poolA.SwapExactAForB(tc.exactInput, tc.fee)
+			assert.Equal(t, tc.reservesA.Add(tc.exactInput), poolA.ReservesA())
+			assert.Equal(t, tc.reservesB.Sub(outB), poolA.ReservesB())
+			assert.Equal(t, sdk.NewDecFromInt(tc.exactInput).Mul(tc.fee).Ceil().TruncateInt().String(), feeA.String())
+
+			poolB, err := types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			outA, feeB := poolB.SwapExactBForA(tc.exactInput, tc.fee)
+			assert.Equal(t, outB, outA, "expected swap to be symmetric")
+			assert.Equal(t, feeA, feeB, "expected swap to be symmetric")
+
+			// swapping the output back can not return more than the original input
+			if outB.IsPositive() {
+				back, _ := poolA.SwapExactBForA(outB, sdk.ZeroDec())
+				assert.True(t, back.LTE(tc.exactInput), "expected round trip %s to be no more than %s", back, tc.exactInput)
+			}
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_ExactOutput(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		exactOutput   sdkmath.Int
+		fee           sdk.Dec
+	}{
+		{i(1e6), i(1e6), 100, i(1e3), d("0.003")},
+		{i(1e6), i(1e6), 100, i(5e5), d("0.003")},
+		{i(1e6), i(5e6), 100, i(1e4), d("0.003")},
+		{i(5e6), i(1e6), 10, i(1e4), d("0")},
+		{i(1e12), i(3e12), 1000, i(1e11), d("0.01")},
+		{i(1e18), i(1e18), types.MaxAmplification, i(1e17), d("0.003")},
+		{i(100), i(100), 1, i(1), d("0.003")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amp=%d exactOutput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactOutput, tc.fee), func(t *testing.T) {
+			poolA, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			inA, feeA := poolA.SwapAForExactB(tc.exactOutput, tc.fee)
+			assert.True(t, inA.IsPositive())
+			assert.True(t, feeA.LT(inA))
+			assert.Equal(t, tc.reservesA.Add(inA), poolA.ReservesA())
+			assert.Equal(t, tc.reservesB.Sub(tc.exactOutput), poolA.ReservesB())
+
+			poolB, err := types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			inB, feeB := poolB.SwapBForExactA(tc.exactOutput, tc.fee)
+			assert.Equal(t, inA, inB, "expected swap to be symmetric")
+			assert.Equal(t, feeA, feeB, "expected swap to be symmetric")
+
+			// the required input must buy at least the exact output on an unchanged pool
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			outB, _ := pool.SwapExactAForB(inA, tc.fee)
+			assert.True(t, outB.GTE(tc.exactOutput), "expected output %s to be at least %s", outB, tc.exactOutput)
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_RepeatedSwapsDoNotDrainPool(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 1000)
+	require.NoError(t, err)
+
+	// alternating small swaps with no fee must never let the pool lose value to rounding
+	for n := 0; n < 100; n++ {
+		outB, _ := pool.SwapExactAForB(i(7), sdk.ZeroDec())
+		if outB.IsPositive() {
+			pool.SwapExactBForA(outB, sdk.ZeroDec())
+		}
+	}
+
+	assert.True(t, pool.ReservesA().Add(pool.ReservesB()).GTE(i(2e6)))
+}
+
+func TestStableSwapPool_Panics_Swap(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+
+	assert.PanicsWithValue(t, "invalid value: swap input must be positive", func() {
+		pool.SwapExactAForB(i(0), d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: fee must be between 0 and 1", func() {
+		pool.SwapExactBForA(i(1e3), d("1"))
+	})
+	assert.PanicsWithValue(t, "invalid value: swap output must be less than reserves", func() {
+		pool.SwapAForExactB(i(1e6), d("0.003"))
+	})
+	assert.PanicsWithValue(t, "invalid value: swap output must be positive", func() {
+		pool.SwapBForExactA(i(0), d("0.003"))
+	})
+}
+
+func TestStableSwapPool_SpotPrice(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+	assert.Equal(t, d("1"), pool.SpotPrice())
+
+	for _, amp := range []uint64{1, 10, 100, 1000} {
+		t.Run(fmt.Sprintf("amp=%d", amp), func(t *testing.T) {
+			stablePool, err := types.NewStableSwapPool(i(1e6), i(4e6), amp)
+			require.NoError(t, err)
+			basePool, err := types.NewBasePool(i(1e6), i(4e6))
+			require.NoError(t, err)
+
+			// the stableswap curve keeps the price closer to one than the constant product curve
+			price := stablePool.SpotPrice()
+			assert.True(t, price.GT(d("1")), "expected price %s to be greater than 1", price)
+			assert.True(t, price.LT(basePool.SpotPrice()), "expected price %s to be less than %s", price, basePool.SpotPrice())
+
+			// the price is the inverse when reserves are swapped
+			inversePool, err := types.NewStableSwapPool(i(4e6), i(1e6), amp)
+			require.NoError(t, err)
+			assert.True(t, price.Mul(inversePool.SpotPrice()).Sub(d("1")).Abs().LT(d("0.000000001")))
+
+			// the spot price is the limit of the price of small swaps
+			out, _ := stablePool.SwapExactAForB(i(10), sdk.ZeroDec())
+			swapPrice := sdk.NewDecFromInt(out).QuoInt64(10)
+			assert.True(t, swapPrice.Sub(price).Abs().LT(d("0.2")), "expected swap price %s to be close to %s", swapPrice, price)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,10 +38,12 @@ func NewPoolRecord(reserves sdk.Coins, totalShares sdkmath.Int) PoolRecord {
 	poolID := PoolIDFromCoins(reserves)
 
 	return PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: totalShares,
+		PoolID:           poolID,
+		ReservesA:        reserves[0],
+		ReservesB:        reserves[1],
+		TotalShares:      totalShares,
+		PriceCumulativeA: sdk.ZeroDec(),
+		PriceCumulativeB: sdk.ZeroDec(),
 	}
 }
 
@@ -51,10 +54,14 @@ func NewPoolRecordFromPool(pool *DenominatedPool) PoolRecord {
 	poolID := PoolIDFromCoins(reserves)
 
 	return PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: pool.TotalShares(),
+		PoolID:           poolID,
+		ReservesA:        reserves[0],
+		ReservesB:        reserves[1],
+		TotalShares:      pool.TotalShares(),
+		PoolType:         pool.PoolType(),
+		Amplification:    pool.Amplification(),
+		PriceCumulativeA: sdk.ZeroDec(),
+		PriceCumulativeB: sdk.ZeroDec(),
 	}
 }
 
@@ -87,6 +94,18 @@ func (p PoolRecord) Validate() error {
 		return fmt.Errorf("pool '%s' has invalid total shares: %s", p.PoolID, p.TotalShares)
 	}
 
+	if err := validatePoolType(p.PoolType, p.Amplification); err != nil {
+		return fmt.Errorf("pool '%s' is invalid: %w", p.PoolID, err)
+	}
+
+	if !p.PriceCumulativeA.IsNil() && p.PriceCumulativeA.IsNegative() {
+		return fmt.Errorf("pool '%s' has invalid price accumulator: %s", p.PoolID, p.PriceCumulativeA)
+	}
+
+	if !p.PriceCumulativeB.IsNil() && p.PriceCumulativeB.IsNegative() {
+		return fmt.Errorf("pool '%s' has invalid price accumulator: %s", p.PoolID, p.PriceCumulativeB)
+	}
+
 	return nil
 }
 
@@ -95,6 +114,41 @@ func (p PoolRecord) Reserves() sdk.Coins {
 	return sdk.NewCoins(p.ReservesA, p.ReservesB)
 }
 
+// SpotPrices returns the marginal price of token a in token b, and of token b in token a
+func (p PoolRecord) SpotPrices() (sdk.Dec, sdk.Dec, error) {
+	pool, err := NewDenominatedPoolFromRecord(p)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	priceA, priceB := pool.SpotPrices()
+	return priceA, priceB, nil
+}
+
+// CumulativePricesAt returns the price accumulators of the pool advanced to time t at the current spot prices.
+// Unset accumulators are counted from zero, and a pool whose prices have never been updated does not accumulate.
+func (p PoolRecord) CumulativePricesAt(t time.Time) (sdk.Dec, sdk.Dec, error) {
+	cumulativeA, cumulativeB := p.PriceCumulativeA, p.PriceCumulativeB
+	if cumulativeA.IsNil() {
+		cumulativeA = sdk.ZeroDec()
+	}
+	if cumulativeB.IsNil() {
+		cumulativeB = sdk.ZeroDec()
+	}
+
+	if p.PriceLastUpdated.IsZero() || !t.After(p.PriceLastUpdated) {
+		return cumulativeA, cumulativeB, nil
+	}
+
+	priceA, priceB, err := p.SpotPrices()
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	elapsed := durationToSeconds(t.Sub(p.PriceLastUpdated))
+	return cumulativeA.Add(priceA.Mul(elapsed)), cumulativeB.Add(priceB.Mul(elapsed)), nil
+}
+
 // PoolRecords is a slice of PoolRecord
 type PoolRecords []PoolRecord
 
@@ -178,3 +232,64 @@ func (srs ShareRecords) Validate() error {
 
 	return nil
 }
+
+// NewPoolFeeRecord takes a poolID and the total fees earned by the pool, returning
+// a new pool fee record for storage in state.
+func NewPoolFeeRecord(poolID string, lpFees, protocolFees sdk.Coins) PoolFeeRecord {
+	return PoolFeeRecord{
+		PoolID:       poolID,
+		LPFees:       lpFees,
+		ProtocolFees: protocolFees,
+	}
+}
+
+// Validate performs basic validation checks of the record data
+func (fr PoolFeeRecord) Validate() error {
+	if fr.PoolID == "" {
+		return errors.New("poolID must be set")
+	}
+
+	tokens := strings.Split(fr.PoolID, PoolIDSep)
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[1] < tokens[0] || tokens[0] == tokens[1] {
+		return fmt.Errorf("poolID '%s' is invalid", fr.PoolID)
+	}
+	if sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
+		return fmt.Errorf("poolID '%s' is invalid", fr.PoolID)
+	}
+
+	if err := fr.LPFees.Validate(); err != nil {
+		return fmt.Errorf("pool '%s' has invalid lp fees: %w", fr.PoolID, err)
+	}
+	if err := fr.ProtocolFees.Validate(); err != nil {
+		return fmt.Errorf("pool '%s' has invalid protocol fees: %w", fr.PoolID, err)
+	}
+
+	return nil
+}
+
+// TotalFees returns the total fees earned by the pool
+func (fr PoolFeeRecord) TotalFees() sdk.Coins {
+	return fr.LPFees.Add(fr.ProtocolFees...)
+}
+
+// PoolFeeRecords is a slice of PoolFeeRecord
+type PoolFeeRecords []PoolFeeRecord
+
+// Validate performs basic validation checks on all records in the slice
+func (frs PoolFeeRecords) Validate() error {
+	seenPoolIDs := make(map[string]bool)
+
+	for _, fr := range frs {
+		if err := fr.Validate(); err != nil {
+			return err
+		}
+
+		if seenPoolIDs[fr.PoolID] {
+			return fmt.Errorf("duplicate poolID '%s'", fr.PoolID)
+		}
+
+		seenPoolIDs[fr.PoolID] = true
+	}
+
+	return nil
+}
@@ -78,6 +78,60 @@ func TestState_NewPoolRecordFromPool(t *testing.T) {
 	assert.Nil(t, record.Validate())
 }
 
+func TestState_NewPoolRecordFromPool_StableSwap(t *testing.T) {
+	reserves := sdk.NewCoins(usdx(50e6), ukava(10e6))
+
+	pool, err := types.NewDenominatedStableSwapPool(reserves, 100)
+	require.NoError(t, err)
+
+	record := types.NewPoolRecordFromPool(pool)
+
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, record.PoolType)
+	assert.Equal(t, uint64(100), record.Amplification)
+	assert.Equal(t, pool.TotalShares(), record.TotalShares)
+	assert.Nil(t, record.Validate())
+
+	loaded, err := types.NewDenominatedPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, loaded.PoolType())
+	assert.Equal(t, uint64(100), loaded.Amplification())
+	assert.Equal(t, pool.Reserves(), loaded.Reserves())
+	assert.Equal(t, pool.TotalShares(), loaded.TotalShares())
+}
+
+func TestState_PoolRecord_PoolTypeValidation(t *testing.T) {
+	record := types.NewPoolRecord(sdk.NewCoins(usdx(50e6), ukava(10e6)), i(20e6))
+	record.Amplification = 100
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' is invalid: constant product pool cannot have an amplification, got 100")
+
+	record.PoolType = types.POOL_TYPE_STABLESWAP
+	assert.NoError(t, record.Validate())
+
+	record.Amplification = 0
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' is invalid: amplification must be between 1 and 1000000, got 0")
+
+	_, err := types.NewDenominatedPoolFromRecord(record)
+	assert.Error(t, err)
+}
+
+func TestState_PoolRecord_JSONEncoding_StableSwap(t *testing.T) {
+	raw := `{
+		"pool_id": "usdc:usdx",
+		"reserves_a": { "denom": "usdc", "amount": "1000000" },
+		"reserves_b": { "denom": "usdx", "amount": "1000000" },
+		"total_shares": "1000000",
+		"pool_type": "POOL_TYPE_STABLESWAP",
+		"amplification": 100
+	}`
+
+	var record types.PoolRecord
+	err := json.Unmarshal([]byte(raw), &record)
+	require.NoError(t, err)
+
+	assert.Equal(t, types.POOL_TYPE_STABLESWAP, record.PoolType)
+	assert.Equal(t, uint64(100), record.Amplification)
+}
+
 func TestState_PoolRecord_JSONEncoding(t *testing.T) {
 	raw := `{
 		"pool_id": "ukava:usdx",
@@ -97,7 +151,12 @@ func TestState_PoolRecord_JSONEncoding(t *testing.T) {
 }
 
 func TestState_PoolRecord_YamlEncoding(t *testing.T) {
-	expected := `pool_id: ukava:usdx
+	expected := `amplification: 0
+pool_id: ukava:usdx
+pool_type: POOL_TYPE_CONSTANT_PRODUCT
+price_cumulative_a: "0.000000000000000000"
+price_cumulative_b: "0.000000000000000000"
+price_last_updated: "0001-01-01T00:00:00Z"
 reserves_a:
   amount: "1000000"
   denom: ukava
@@ -529,3 +588,78 @@ func TestState_ShareRecords_ValidateUniqueShareRecords(t *testing.T) {
 	invalidRecords := types.ShareRecords{record_1, record_3, record_2, record_4}
 	assert.EqualError(t, invalidRecords.Validate(), "duplicate depositor 'kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w' and poolID 'ukava:usdx'")
 }
+
+func TestState_PoolFeeRecord_Validations(t *testing.T) {
+	validFees := sdk.NewCoins(sdk.NewCoin("hard", i(100)), sdk.NewCoin("usdx", i(200)))
+	invalidFees := sdk.Coins{sdk.Coin{Denom: "usdx", Amount: i(-1)}}
+
+	testCases := []struct {
+		name        string
+		record      types.PoolFeeRecord
+		expectedErr string
+	}{
+		{
+			name:        "valid record",
+			record:      types.NewPoolFeeRecord(types.PoolID("hard", "usdx"), validFees, validFees),
+			expectedErr: "",
+		},
+		{
+			name:        "empty fees",
+			record:      types.NewPoolFeeRecord(types.PoolID("hard", "usdx"), sdk.NewCoins(), sdk.NewCoins()),
+			expectedErr: "",
+		},
+		{
+			name:        "empty pool id",
+			record:      types.NewPoolFeeRecord("", validFees, validFees),
+			expectedErr: "poolID must be set",
+		},
+		{
+			name:        "invalid pool id",
+			record:      types.NewPoolFeeRecord("usdx:hard", validFees, validFees),
+			expectedErr: "poolID 'usdx:hard' is invalid",
+		},
+		{
+			name:        "invalid lp fees",
+			record:      types.NewPoolFeeRecord(types.PoolID("hard", "usdx"), invalidFees, validFees),
+			expectedErr: "pool 'hard:usdx' has invalid lp fees: coin -1usdx amount is not positive",
+		},
+		{
+			name:        "invalid protocol fees",
+			record:      types.NewPoolFeeRecord(types.PoolID("hard", "usdx"), validFees, invalidFees),
+			expectedErr: "pool 'hard:usdx' has invalid protocol fees: coin -1usdx amount is not positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.record.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestState_PoolFeeRecord_TotalFees(t *testing.T) {
+	record := types.NewPoolFeeRecord(
+		types.PoolID("hard", "usdx"),
+		sdk.NewCoins(sdk.NewCoin("hard", i(900)), sdk.NewCoin("usdx", i(1800))),
+		sdk.NewCoins(sdk.NewCoin("hard", i(100))),
+	)
+
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("hard", i(1000)), sdk.NewCoin("usdx", i(1800))), record.TotalFees())
+}
+
+func TestState_PoolFeeRecords_ValidateUniquePools(t *testing.T) {
+	record_1 := types.NewPoolFeeRecord("hard:usdx", sdk.NewCoins(sdk.NewCoin("usdx", i(100))), sdk.NewCoins())
+	record_2 := types.NewPoolFeeRecord("ukava:usdx", sdk.NewCoins(sdk.NewCoin("usdx", i(100))), sdk.NewCoins())
+	record_3 := types.NewPoolFeeRecord("hard:usdx", sdk.NewCoins(sdk.NewCoin("hard", i(100))), sdk.NewCoins())
+
+	validRecords := types.PoolFeeRecords{record_1, record_2}
+	assert.NoError(t, validRecords.Validate())
+
+	invalidRecords := types.PoolFeeRecords{record_1, record_2, record_3}
+	assert.EqualError(t, invalidRecords.Validate(), "duplicate poolID 'hard:usdx'")
+}
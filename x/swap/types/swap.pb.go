@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,12 +31,81 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PoolType defines the pricing curve used by a pool
+type PoolType int32
+
+const (
+	// POOL_TYPE_CONSTANT_PRODUCT represents a pool priced by the constant product invariant
+	POOL_TYPE_CONSTANT_PRODUCT PoolType = 0
+	// POOL_TYPE_STABLESWAP represents a pool priced by the StableSwap invariant, for assets that trade near 1:1
+	POOL_TYPE_STABLESWAP PoolType = 1
+)
+
+var PoolType_name = map[int32]string{
+	0: "POOL_TYPE_CONSTANT_PRODUCT",
+	1: "POOL_TYPE_STABLESWAP",
+}
+
+var PoolType_value = map[string]int32{
+	"POOL_TYPE_CONSTANT_PRODUCT": 0,
+	"POOL_TYPE_STABLESWAP":       1,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{0}
+}
+
+// OrderType defines the condition under which a resting order is filled
+type OrderType int32
+
+const (
+	// ORDER_TYPE_LIMIT represents an order filled while the price of the input token is at or above the trigger price,
+	// never filling at an average price below it
+	ORDER_TYPE_LIMIT OrderType = 0
+	// ORDER_TYPE_STOP_LOSS represents an order that sells all of its input at market once the price of the input token
+	// falls to or below the trigger price
+	ORDER_TYPE_STOP_LOSS OrderType = 1
+)
+
+var OrderType_name = map[int32]string{
+	0: "ORDER_TYPE_LIMIT",
+	1: "ORDER_TYPE_STOP_LOSS",
+}
+
+var OrderType_value = map[string]int32{
+	"ORDER_TYPE_LIMIT":     0,
+	"ORDER_TYPE_STOP_LOSS": 1,
+}
+
+func (x OrderType) String() string {
+	return proto.EnumName(OrderType_name, int32(x))
+}
+
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{1}
+}
+
 // Params defines the parameters for the swap module.
 type Params struct {
 	// allowed_pools defines that pools that are allowed to be created
 	AllowedPools AllowedPools `protobuf:"bytes,1,rep,name=allowed_pools,json=allowedPools,proto3,castrepeated=AllowedPools" json:"allowed_pools"`
 	// swap_fee defines the swap fee for all pools
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
+	// protocol_fee_share defines the portion of each swap fee sent to the protocol fee account
+	ProtocolFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=protocol_fee_share,json=protocolFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_share"`
+	// price_history_blocks defines the number of blocks of price snapshots kept for each pool, zero disables price history
+	PriceHistoryBlocks uint64 `protobuf:"varint,4,opt,name=price_history_blocks,json=priceHistoryBlocks,proto3" json:"price_history_blocks"`
+	// max_order_fills defines the number of order fills attempted across all pools each block, zero pauses order filling
+	MaxOrderFills uint64 `protobuf:"varint,5,opt,name=max_order_fills,json=maxOrderFills,proto3" json:"max_order_fills"`
+	// min_order_share defines the smallest order input as a fraction of the pool reserves of the input token,
+	// orders whose remaining input falls below it are refunded
+	MinOrderShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_order_share,json=minOrderShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_share"`
+	// max_order_duration defines the longest time from placing an order to its expiry, zero disables the limit
+	MaxOrderDuration time.Duration `protobuf:"bytes,7,opt,name=max_order_duration,json=maxOrderDuration,proto3,stdduration" json:"max_order_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,12 +147,39 @@ func (m *Params) GetAllowedPools() AllowedPools {
 	return nil
 }
 
+func (m *Params) GetPriceHistoryBlocks() uint64 {
+	if m != nil {
+		return m.PriceHistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrderFills() uint64 {
+	if m != nil {
+		return m.MaxOrderFills
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrderDuration() time.Duration {
+	if m != nil {
+		return m.MaxOrderDuration
+	}
+	return 0
+}
+
 // AllowedPool defines a pool that is allowed to be created
 type AllowedPool struct {
 	// token_a represents the a token allowed
 	TokenA string `protobuf:"bytes,1,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
 	// token_b represents the b token allowed
 	TokenB string `protobuf:"bytes,2,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	// pool_type represents the pricing curve of the pool
+	PoolType PoolType `protobuf:"varint,3,opt,name=pool_type,json=poolType,proto3,enum=kava.swap.v1beta1.PoolType" json:"pool_type"`
+	// amplification represents the amplification coefficient of a stableswap pool
+	Amplification uint64 `protobuf:"varint,4,opt,name=amplification,proto3" json:"amplification"`
+	// swap_fee overrides the swap fee of the pool, the global swap fee is used when zero
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
 }
 
 func (m *AllowedPool) Reset()      { *m = AllowedPool{} }
@@ -127,6 +228,20 @@ func (m *AllowedPool) GetTokenB() string {
 	return ""
 }
 
+func (m *AllowedPool) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return POOL_TYPE_CONSTANT_PRODUCT
+}
+
+func (m *AllowedPool) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
 // PoolRecord represents the state of a liquidity pool
 // and is used to store the state of a denominated pool
 type PoolRecord struct {
@@ -138,6 +253,16 @@ type PoolRecord struct {
 	ReservesB types.Coin `protobuf:"bytes,3,opt,name=reserves_b,json=reservesB,proto3" json:"reserves_b"`
 	// total_shares is the total distrubuted shares of the pool
 	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares"`
+	// pool_type represents the pricing curve of the pool
+	PoolType PoolType `protobuf:"varint,5,opt,name=pool_type,json=poolType,proto3,enum=kava.swap.v1beta1.PoolType" json:"pool_type"`
+	// amplification represents the amplification coefficient of a stableswap pool
+	Amplification uint64 `protobuf:"varint,6,opt,name=amplification,proto3" json:"amplification"`
+	// price_cumulative_a is the sum of the price of token a in token b multiplied by the seconds it was held
+	PriceCumulativeA github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price_cumulative_a,json=priceCumulativeA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_a"`
+	// price_cumulative_b is the sum of the price of token b in token a multiplied by the seconds it was held
+	PriceCumulativeB github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=price_cumulative_b,json=priceCumulativeB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_b"`
+	// price_last_updated is the block time the price accumulators were last updated
+	PriceLastUpdated time.Time `protobuf:"bytes,9,opt,name=price_last_updated,json=priceLastUpdated,proto3,stdtime" json:"price_last_updated"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -194,6 +319,27 @@ func (m *PoolRecord) GetReservesB() types.Coin {
 	return types.Coin{}
 }
 
+func (m *PoolRecord) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return POOL_TYPE_CONSTANT_PRODUCT
+}
+
+func (m *PoolRecord) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
+func (m *PoolRecord) GetPriceLastUpdated() time.Time {
+	if m != nil {
+		return m.PriceLastUpdated
+	}
+	return time.Time{}
+}
+
 // ShareRecord stores the shares owned for a depositor and pool
 type ShareRecord struct {
 	// depositor represents the owner of the shares
@@ -251,50 +397,337 @@ func (m *ShareRecord) GetPoolID() string {
 	return ""
 }
 
+// PoolFeeRecord tracks the total swap fees earned by a pool
+type PoolFeeRecord struct {
+	// pool_id represents the pool the fees were earned by
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// lp_fees represents the total fees paid to the liquidity providers of the pool
+	LPFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=lp_fees,json=lpFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lp_fees"`
+	// protocol_fees represents the total fees sent to the protocol fee account
+	ProtocolFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=protocol_fees,json=protocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_fees"`
+}
+
+func (m *PoolFeeRecord) Reset()         { *m = PoolFeeRecord{} }
+func (m *PoolFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolFeeRecord) ProtoMessage()    {}
+func (*PoolFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{4}
+}
+func (m *PoolFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFeeRecord.Merge(m, src)
+}
+func (m *PoolFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolFeeRecord proto.InternalMessageInfo
+
+func (m *PoolFeeRecord) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *PoolFeeRecord) GetLPFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LPFees
+	}
+	return nil
+}
+
+func (m *PoolFeeRecord) GetProtocolFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolFees
+	}
+	return nil
+}
+
+// PoolPriceSnapshot stores the price accumulators of a pool at the end of a block where the pool was updated
+type PoolPriceSnapshot struct {
+	// pool_id represents the pool of the snapshot
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// height represents the block height of the snapshot
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time represents the block time of the snapshot
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// price_cumulative_a is the price accumulator of token a at the block time
+	PriceCumulativeA github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price_cumulative_a,json=priceCumulativeA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_a"`
+	// price_cumulative_b is the price accumulator of token b at the block time
+	PriceCumulativeB github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price_cumulative_b,json=priceCumulativeB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_b"`
+	// price_a is the price of token a in token b at the end of the block
+	PriceA github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price_a,json=priceA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_a"`
+	// price_b is the price of token b in token a at the end of the block
+	PriceB github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price_b,json=priceB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_b"`
+}
+
+func (m *PoolPriceSnapshot) Reset()         { *m = PoolPriceSnapshot{} }
+func (m *PoolPriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PoolPriceSnapshot) ProtoMessage()    {}
+func (*PoolPriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{5}
+}
+func (m *PoolPriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPriceSnapshot.Merge(m, src)
+}
+func (m *PoolPriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPriceSnapshot proto.InternalMessageInfo
+
+func (m *PoolPriceSnapshot) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *PoolPriceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PoolPriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// Order represents a resting order escrowed in the swap module until it is filled, cancelled, or expires
+type Order struct {
+	// id represents the unique id of the order
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner represents the address that placed the order and receives its output
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// pool_id represents the pool the order trades against
+	PoolID string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// order_type represents the condition under which the order is filled
+	OrderType OrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=kava.swap.v1beta1.OrderType" json:"order_type"`
+	// token_in represents the remaining unfilled input of the order held in escrow
+	TokenIn types.Coin `protobuf:"bytes,5,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	// token_out represents the total output paid to the owner by fills of the order
+	TokenOut types.Coin `protobuf:"bytes,6,opt,name=token_out,json=tokenOut,proto3" json:"token_out"`
+	// trigger_price represents the price of the input token in the output token the order is filled at
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	// expiry represents the time after which the unfilled input is returned to the owner
+	Expiry time.Time `protobuf:"bytes,8,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{6}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
+}
+func (m *Order) XXX_Size() int {
+	return m.Size()
+}
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order proto.InternalMessageInfo
+
+func (m *Order) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Order) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Order) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *Order) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return ORDER_TYPE_LIMIT
+}
+
+func (m *Order) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *Order) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *Order) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("kava.swap.v1beta1.PoolType", PoolType_name, PoolType_value)
+	proto.RegisterEnum("kava.swap.v1beta1.OrderType", OrderType_name, OrderType_value)
 	proto.RegisterType((*Params)(nil), "kava.swap.v1beta1.Params")
 	proto.RegisterType((*AllowedPool)(nil), "kava.swap.v1beta1.AllowedPool")
 	proto.RegisterType((*PoolRecord)(nil), "kava.swap.v1beta1.PoolRecord")
 	proto.RegisterType((*ShareRecord)(nil), "kava.swap.v1beta1.ShareRecord")
+	proto.RegisterType((*PoolFeeRecord)(nil), "kava.swap.v1beta1.PoolFeeRecord")
+	proto.RegisterType((*PoolPriceSnapshot)(nil), "kava.swap.v1beta1.PoolPriceSnapshot")
+	proto.RegisterType((*Order)(nil), "kava.swap.v1beta1.Order")
 }
 
 func init() { proto.RegisterFile("kava/swap/v1beta1/swap.proto", fileDescriptor_9df359be90eb28cb) }
 
 var fileDescriptor_9df359be90eb28cb = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0xd3, 0x5e,
-	0x14, 0x8d, 0xd3, 0x28, 0xf9, 0xe5, 0x25, 0xbf, 0x01, 0x53, 0x89, 0xb4, 0x42, 0x76, 0x15, 0x24,
-	0xd4, 0x25, 0xb6, 0x5a, 0x36, 0x84, 0x10, 0x31, 0x01, 0x91, 0x89, 0xca, 0x0c, 0x08, 0x96, 0xa7,
-	0x67, 0xfb, 0x36, 0xb5, 0xe2, 0xf8, 0x5a, 0x7e, 0x8f, 0x84, 0x7e, 0x0b, 0xc4, 0xc4, 0xc8, 0xcc,
-	0xdc, 0x4f, 0xc0, 0xd4, 0xb1, 0xea, 0x84, 0x18, 0x02, 0x4a, 0xbe, 0x05, 0x2c, 0xe8, 0xfd, 0xa1,
-	0x35, 0x42, 0x48, 0x54, 0x4c, 0xbe, 0xf7, 0x9e, 0x77, 0xce, 0xbd, 0xf7, 0x58, 0x97, 0xdc, 0x9c,
-	0xb2, 0x39, 0xf3, 0xf9, 0x82, 0x15, 0xfe, 0x7c, 0x2f, 0x02, 0xc1, 0xf6, 0x54, 0xe2, 0x15, 0x25,
-	0x0a, 0xb4, 0xaf, 0x49, 0xd4, 0x53, 0x05, 0x83, 0x6e, 0x3b, 0x31, 0xf2, 0x19, 0x72, 0x3f, 0x62,
-	0x1c, 0x2e, 0x28, 0x31, 0xa6, 0xb9, 0xa6, 0x6c, 0x6f, 0x69, 0x9c, 0xaa, 0xcc, 0xd7, 0x89, 0x81,
-	0x36, 0x27, 0x38, 0x41, 0x5d, 0x97, 0x91, 0xae, 0xf6, 0x3f, 0x5a, 0xa4, 0x79, 0xc0, 0x4a, 0x36,
-	0xe3, 0xf6, 0x0b, 0xf2, 0x3f, 0xcb, 0x32, 0x5c, 0x40, 0x42, 0x0b, 0xc4, 0x8c, 0xf7, 0xac, 0x9d,
-	0x8d, 0xdd, 0xce, 0xbe, 0xe3, 0xfd, 0x36, 0x86, 0x37, 0xd4, 0xef, 0x0e, 0x10, 0xb3, 0x60, 0xf3,
-	0x74, 0xe9, 0xd6, 0x3e, 0x7c, 0x71, 0xbb, 0x95, 0x22, 0x0f, 0xbb, 0xac, 0x92, 0xd9, 0xcf, 0xc9,
-	0x7f, 0x92, 0x4f, 0x0f, 0x01, 0x7a, 0xf5, 0x1d, 0x6b, 0xb7, 0x1d, 0xdc, 0x93, 0xac, 0xcf, 0x4b,
-	0xf7, 0xf6, 0x24, 0x15, 0x47, 0xaf, 0x22, 0x2f, 0xc6, 0x99, 0x19, 0xd7, 0x7c, 0x06, 0x3c, 0x99,
-	0xfa, 0xe2, 0xb8, 0x00, 0xee, 0x8d, 0x20, 0x3e, 0x3f, 0x19, 0x10, 0xb3, 0xcd, 0x08, 0xe2, 0xb0,
-	0x25, 0xd5, 0x1e, 0x03, 0xdc, 0x6d, 0xbc, 0x7b, 0xef, 0xd6, 0xfa, 0x8f, 0x48, 0xa7, 0xd2, 0xdc,
-	0xbe, 0x41, 0x5a, 0x02, 0xa7, 0x90, 0x53, 0xd6, 0xb3, 0x64, 0xb3, 0xb0, 0xa9, 0xd2, 0xe1, 0x25,
-	0x10, 0xe9, 0x29, 0x0c, 0x10, 0x18, 0x99, 0xb7, 0x75, 0x42, 0xa4, 0x40, 0x08, 0x31, 0x96, 0x89,
-	0x7d, 0x8b, 0xb4, 0xa4, 0x0f, 0x34, 0x4d, 0xb4, 0x4c, 0x40, 0x56, 0x4b, 0xb7, 0x29, 0x1f, 0x8c,
-	0x47, 0x61, 0x53, 0x42, 0xe3, 0xc4, 0xbe, 0x4f, 0x48, 0x09, 0x1c, 0xca, 0x39, 0x70, 0xca, 0x94,
-	0x6a, 0x67, 0x7f, 0xcb, 0x33, 0xa3, 0xca, 0xbf, 0x74, 0xe1, 0xd9, 0x43, 0x4c, 0xf3, 0xa0, 0x21,
-	0xd7, 0x0e, 0xdb, 0x3f, 0x29, 0xc3, 0x5f, 0xf8, 0x51, 0x6f, 0xe3, 0x8a, 0xfc, 0xc0, 0xa6, 0xa4,
-	0x2b, 0x50, 0xb0, 0x8c, 0xf2, 0x23, 0x56, 0x02, 0xef, 0x35, 0xae, 0xec, 0xee, 0x38, 0x17, 0x15,
-	0x77, 0xc7, 0xb9, 0x08, 0x3b, 0x4a, 0xf1, 0x99, 0x12, 0xec, 0x7f, 0xb7, 0x48, 0x47, 0x85, 0xc6,
-	0x95, 0x43, 0xd2, 0x4e, 0xa0, 0x40, 0x9e, 0x0a, 0x2c, 0x95, 0x2f, 0xdd, 0xe0, 0xc9, 0xb7, 0xa5,
-	0x3b, 0xf8, 0x8b, 0x4e, 0xc3, 0x38, 0x1e, 0x26, 0x49, 0x09, 0x9c, 0x9f, 0x9f, 0x0c, 0xae, 0x9b,
-	0x86, 0xa6, 0x12, 0x1c, 0x0b, 0xe0, 0xe1, 0xa5, 0x74, 0xd5, 0xfd, 0xfa, 0x1f, 0xdd, 0xa7, 0xa4,
-	0xab, 0xf7, 0xa6, 0xb8, 0xc8, 0x21, 0x51, 0xfe, 0xfd, 0xf3, 0xf6, 0x5a, 0xf1, 0xa9, 0x14, 0x0c,
-	0x1e, 0x9c, 0xae, 0x1c, 0xeb, 0x6c, 0xe5, 0x58, 0x5f, 0x57, 0x8e, 0xf5, 0x66, 0xed, 0xd4, 0xce,
-	0xd6, 0x4e, 0xed, 0xd3, 0xda, 0xa9, 0xbd, 0xac, 0x8a, 0xcb, 0x03, 0x19, 0x64, 0x2c, 0xe2, 0x2a,
-	0xf2, 0x5f, 0xeb, 0x8b, 0x56, 0x0d, 0xa2, 0xa6, 0xba, 0xb3, 0x3b, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0x4a, 0x16, 0x69, 0xeb, 0x03, 0x00, 0x00,
+	// 1230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0xd7, 0x0f, 0x8a, 0x92, 0xce, 0x52, 0xe2, 0x5c, 0x8c, 0x7c, 0x19, 0x7f, 0x03, 0xd1, 0x50,
+	0x81, 0xc2, 0x08, 0x60, 0xa9, 0x49, 0x87, 0x16, 0x69, 0x50, 0x54, 0xb4, 0xe2, 0x46, 0xa9, 0x1a,
+	0xa9, 0x94, 0x82, 0x20, 0x1d, 0x4a, 0x9c, 0xc8, 0xb3, 0xc4, 0x9a, 0xe2, 0x11, 0xbc, 0x93, 0x63,
+	0xff, 0x07, 0x1d, 0x3a, 0x64, 0x2a, 0x32, 0x06, 0xe8, 0x96, 0x39, 0x7f, 0x40, 0xc7, 0x8c, 0x41,
+	0xa6, 0xa2, 0x83, 0x52, 0x38, 0x9b, 0xa7, 0xce, 0xed, 0x52, 0xdc, 0x1d, 0x69, 0xd1, 0x89, 0xd3,
+	0x4a, 0x80, 0xd2, 0xc9, 0x7a, 0xf7, 0xf8, 0x3e, 0xef, 0xd7, 0xe7, 0xdd, 0x3b, 0x83, 0x2b, 0x7b,
+	0x68, 0x1f, 0xd5, 0xe9, 0x43, 0x14, 0xd4, 0xf7, 0xaf, 0x0d, 0x30, 0x43, 0xd7, 0x84, 0x50, 0x0b,
+	0x42, 0xc2, 0x08, 0xbc, 0xc0, 0xb5, 0x35, 0x71, 0x10, 0x69, 0xd7, 0x2b, 0x36, 0xa1, 0x63, 0x42,
+	0xeb, 0x03, 0x44, 0xf1, 0x89, 0x89, 0x4d, 0x5c, 0x5f, 0x9a, 0xac, 0x5f, 0x96, 0x7a, 0x4b, 0x48,
+	0x75, 0x29, 0x44, 0xaa, 0xb5, 0x21, 0x19, 0x12, 0x79, 0xce, 0x7f, 0x45, 0xa7, 0x95, 0x21, 0x21,
+	0x43, 0x0f, 0xd7, 0x85, 0x34, 0x98, 0xec, 0xd6, 0x9d, 0x49, 0x88, 0x98, 0x4b, 0x62, 0x40, 0xfd,
+	0x4d, 0x3d, 0x73, 0xc7, 0x98, 0x32, 0x34, 0x8e, 0x82, 0xac, 0xfe, 0xa1, 0x00, 0xb5, 0x8b, 0x42,
+	0x34, 0xa6, 0xf0, 0x01, 0x28, 0x23, 0xcf, 0x23, 0x0f, 0xb1, 0x63, 0x05, 0x84, 0x78, 0x54, 0x4b,
+	0x6f, 0x64, 0x37, 0x57, 0xae, 0x57, 0x6a, 0x6f, 0xe5, 0x51, 0x6b, 0xc8, 0xef, 0xba, 0x84, 0x78,
+	0xc6, 0xda, 0xf3, 0xa9, 0x9e, 0x7a, 0xfa, 0x4a, 0x2f, 0x25, 0x0e, 0xa9, 0x59, 0x42, 0x09, 0x09,
+	0xde, 0x07, 0x05, 0x6e, 0x6f, 0xed, 0x62, 0xac, 0x65, 0x36, 0xd2, 0x9b, 0x45, 0xe3, 0x26, 0xb7,
+	0xfa, 0x6d, 0xaa, 0x7f, 0x38, 0x74, 0xd9, 0x68, 0x32, 0xa8, 0xd9, 0x64, 0x1c, 0xe5, 0x1b, 0xfd,
+	0xd9, 0xa2, 0xce, 0x5e, 0x9d, 0x1d, 0x06, 0x98, 0xd6, 0x9a, 0xd8, 0x7e, 0xf9, 0x6c, 0x0b, 0x44,
+	0xe5, 0x68, 0x62, 0xdb, 0xcc, 0x73, 0xb4, 0x1d, 0x8c, 0xe1, 0xf7, 0x00, 0x8a, 0x3c, 0x6c, 0xe2,
+	0x71, 0x70, 0x8b, 0x8e, 0x50, 0x88, 0xb5, 0xec, 0x12, 0x5c, 0xac, 0xc6, 0xb8, 0x3b, 0x18, 0xf7,
+	0x38, 0x2a, 0xbc, 0x03, 0xd6, 0x82, 0xd0, 0xb5, 0xb1, 0x35, 0x72, 0x29, 0x23, 0xe1, 0xa1, 0x35,
+	0xf0, 0x88, 0xbd, 0x47, 0x35, 0x65, 0x23, 0xbd, 0xa9, 0x18, 0xda, 0xf1, 0x54, 0x3f, 0x53, 0x6f,
+	0x42, 0x71, 0x7a, 0x5b, 0x1e, 0x1a, 0xe2, 0x0c, 0x7e, 0x06, 0xce, 0x8f, 0xd1, 0x81, 0x45, 0x42,
+	0x07, 0x87, 0xd6, 0xae, 0xeb, 0x79, 0x54, 0xcb, 0x09, 0x98, 0x8b, 0xc7, 0x53, 0xfd, 0x4d, 0x95,
+	0x59, 0x1e, 0xa3, 0x83, 0x0e, 0x97, 0x77, 0xb8, 0x08, 0x1d, 0x70, 0x7e, 0xec, 0xfa, 0xd1, 0x17,
+	0x32, 0x63, 0x75, 0x09, 0x19, 0x97, 0xc7, 0xae, 0x2f, 0xbc, 0xc8, 0x74, 0xbf, 0x01, 0x70, 0x16,
+	0x47, 0x4c, 0x2b, 0x2d, 0xbf, 0x91, 0xde, 0x5c, 0xb9, 0x7e, 0xb9, 0x26, 0x79, 0x55, 0x8b, 0x79,
+	0x55, 0x6b, 0x46, 0x1f, 0x18, 0x05, 0x1e, 0xc3, 0xe3, 0x57, 0x7a, 0xda, 0x5c, 0x8d, 0xa3, 0x8e,
+	0x75, 0x37, 0x94, 0xc7, 0x4f, 0xf4, 0x54, 0xf5, 0x49, 0x06, 0xac, 0x24, 0xb8, 0x02, 0xff, 0x07,
+	0xf2, 0x8c, 0xec, 0x61, 0xdf, 0x42, 0x5a, 0x9a, 0xa7, 0x61, 0xaa, 0x42, 0x6c, 0xcc, 0x14, 0x03,
+	0x2d, 0x93, 0x50, 0x18, 0xf0, 0x4b, 0x50, 0xe4, 0x0c, 0xb5, 0x78, 0x26, 0xa2, 0xd9, 0xe7, 0xae,
+	0xff, 0xff, 0x0c, 0x96, 0x72, 0xf4, 0xfe, 0x61, 0x80, 0x8d, 0xf2, 0xf1, 0x54, 0x9f, 0x59, 0x98,
+	0x85, 0x20, 0x52, 0xc0, 0x4f, 0x40, 0x19, 0x8d, 0x03, 0xcf, 0xdd, 0x75, 0x6d, 0x99, 0x9e, 0xec,
+	0xe5, 0x85, 0xe3, 0xa9, 0x7e, 0x5a, 0x61, 0x9e, 0x16, 0x4f, 0x11, 0x3a, 0xb7, 0x44, 0x42, 0x47,
+	0x25, 0xfa, 0x25, 0x07, 0x00, 0x8f, 0xde, 0xc4, 0x36, 0x09, 0x1d, 0xf8, 0x01, 0xc8, 0x8b, 0xe8,
+	0x5d, 0x47, 0x56, 0xc8, 0x00, 0x47, 0x53, 0x5d, 0xe5, 0x1f, 0xb4, 0x9a, 0xa6, 0xca, 0x55, 0x2d,
+	0x07, 0x7e, 0x0e, 0x40, 0x88, 0x29, 0x0e, 0xf7, 0x31, 0xb5, 0x90, 0x96, 0x89, 0xfa, 0x14, 0xf9,
+	0xe0, 0x17, 0xce, 0x49, 0x5d, 0xb6, 0x89, 0xeb, 0x1b, 0x0a, 0x8f, 0xd7, 0x2c, 0xc6, 0x26, 0x8d,
+	0x53, 0xf6, 0x03, 0x2d, 0xbb, 0xa0, 0xbd, 0x01, 0x2d, 0x50, 0x62, 0x84, 0x21, 0x4f, 0x32, 0x52,
+	0x8e, 0xc5, 0x62, 0x65, 0x69, 0xf9, 0x2c, 0x51, 0x96, 0x96, 0xcf, 0xcc, 0x15, 0x81, 0x28, 0xf8,
+	0x48, 0x4f, 0x77, 0x3d, 0xb7, 0xcc, 0xae, 0xab, 0x73, 0x76, 0x5d, 0xdc, 0x36, 0x7c, 0xc2, 0xed,
+	0xc9, 0x78, 0xe2, 0x21, 0xe6, 0xee, 0x63, 0x0b, 0x69, 0xf9, 0x85, 0x13, 0x3d, 0xf3, 0xb6, 0x71,
+	0x6d, 0xbc, 0x7d, 0x02, 0xdb, 0x38, 0xd3, 0xd7, 0x40, 0x2b, 0xbc, 0x07, 0x5f, 0x06, 0x34, 0x63,
+	0x5f, 0x1e, 0xa2, 0xcc, 0x9a, 0x04, 0x0e, 0x62, 0xd8, 0xd1, 0x8a, 0x82, 0x02, 0xeb, 0x6f, 0x8d,
+	0x7a, 0x3f, 0x5e, 0x21, 0x72, 0xd6, 0x1f, 0x89, 0x59, 0x17, 0xf6, 0x6d, 0x44, 0xd9, 0x3d, 0x69,
+	0x5d, 0xfd, 0x2b, 0x0d, 0x56, 0x44, 0xe3, 0x22, 0x0e, 0xef, 0x82, 0xa2, 0x83, 0x03, 0x42, 0x5d,
+	0x46, 0x42, 0xc1, 0xe2, 0x92, 0x71, 0xfb, 0xcf, 0xa9, 0xbe, 0x35, 0x47, 0x0a, 0x0d, 0xdb, 0x6e,
+	0x38, 0x4e, 0x88, 0x29, 0x7d, 0xf9, 0x6c, 0xeb, 0x62, 0x94, 0x49, 0x74, 0x62, 0x1c, 0x32, 0x4c,
+	0xcd, 0x19, 0x74, 0x72, 0x56, 0x32, 0xef, 0x9c, 0x15, 0x0b, 0x94, 0x24, 0x4b, 0x2d, 0xf2, 0xd0,
+	0xc7, 0x8e, 0x96, 0x5d, 0x06, 0x57, 0x25, 0x62, 0x87, 0x03, 0x56, 0x7f, 0xca, 0x80, 0x32, 0xf7,
+	0xb9, 0x83, 0xf1, 0x22, 0x33, 0xec, 0x83, 0xbc, 0x27, 0x2e, 0x15, 0xaa, 0x65, 0x36, 0xb2, 0xff,
+	0x3c, 0x80, 0x37, 0x78, 0xb4, 0x1c, 0xa3, 0xdd, 0xdd, 0xc1, 0x98, 0x3e, 0x7d, 0xa5, 0x6f, 0xce,
+	0x11, 0x37, 0x37, 0xa5, 0xa6, 0xea, 0xf1, 0xcb, 0x86, 0xc2, 0x00, 0x94, 0x93, 0xeb, 0x93, 0x6a,
+	0xd9, 0x7f, 0xf3, 0xfa, 0x51, 0xb4, 0xed, 0xe7, 0xf7, 0x55, 0x4a, 0x2c, 0x52, 0x5a, 0x7d, 0xa4,
+	0x80, 0x0b, 0x3c, 0xe9, 0x2e, 0xe7, 0x4b, 0xcf, 0x47, 0x01, 0x1d, 0x11, 0x36, 0x5f, 0x71, 0x2e,
+	0x01, 0x75, 0x84, 0xdd, 0xe1, 0x88, 0x89, 0xc6, 0x66, 0xcd, 0x48, 0x82, 0x9f, 0x02, 0x85, 0xbf,
+	0x6a, 0xb4, 0xec, 0x02, 0x7c, 0x15, 0x16, 0xef, 0x98, 0x67, 0xe5, 0x3f, 0x9c, 0xe7, 0xdc, 0x7b,
+	0x99, 0xe7, 0x7b, 0x20, 0x2f, 0x7d, 0xa1, 0xa5, 0x3c, 0x0c, 0x54, 0x01, 0xd6, 0x98, 0xc1, 0x0e,
+	0xb4, 0xfc, 0xd2, 0x60, 0x8d, 0xea, 0x8f, 0x0a, 0xc8, 0x89, 0x77, 0x02, 0xbc, 0x04, 0x32, 0x11,
+	0x03, 0x14, 0x43, 0x3d, 0x9a, 0xea, 0x99, 0x56, 0xd3, 0xcc, 0xb8, 0x0e, 0xfc, 0x0e, 0xe4, 0xf8,
+	0x9c, 0x86, 0x5a, 0x66, 0xc9, 0xf7, 0x86, 0x84, 0x4d, 0xd2, 0x2f, 0xfb, 0x4e, 0xfa, 0x7d, 0x05,
+	0x80, 0x7c, 0x0b, 0x89, 0xfd, 0xa3, 0x88, 0xfd, 0x73, 0xe5, 0x8c, 0xfd, 0x23, 0x52, 0x11, 0x0b,
+	0xe8, 0xdc, 0xf1, 0x54, 0x4f, 0xd8, 0x98, 0x45, 0x12, 0xab, 0xe0, 0x0d, 0x50, 0x90, 0x4f, 0x1b,
+	0xd7, 0x17, 0x1c, 0x98, 0x63, 0xd5, 0xca, 0xb7, 0x50, 0xcb, 0x87, 0x37, 0x41, 0x51, 0xda, 0x92,
+	0x09, 0xd3, 0xd4, 0xf9, 0x8c, 0xa5, 0xb7, 0xce, 0x84, 0x41, 0x04, 0xca, 0x2c, 0x74, 0x87, 0x43,
+	0x1c, 0x5a, 0xa2, 0xfe, 0x4b, 0x69, 0x65, 0x29, 0x82, 0x14, 0x53, 0x0d, 0x6f, 0x02, 0x15, 0x1f,
+	0x04, 0x6e, 0x78, 0xa8, 0x15, 0x16, 0x18, 0xc9, 0xc8, 0xe6, 0xea, 0x1d, 0x50, 0x88, 0x57, 0x38,
+	0xac, 0x80, 0xf5, 0x6e, 0xa7, 0xd3, 0xb6, 0xfa, 0x0f, 0xba, 0xb7, 0xac, 0xed, 0xce, 0xdd, 0x5e,
+	0xbf, 0x71, 0xb7, 0x6f, 0x75, 0xcd, 0x4e, 0xf3, 0xde, 0x76, 0x7f, 0x35, 0x05, 0x35, 0xb0, 0x36,
+	0xd3, 0xf7, 0xfa, 0x0d, 0xa3, 0x7d, 0xab, 0x77, 0xbf, 0xd1, 0x5d, 0x4d, 0xaf, 0x2b, 0x3f, 0xfc,
+	0x5c, 0x49, 0x5d, 0x6d, 0x80, 0xe2, 0x49, 0x3b, 0xe0, 0x1a, 0x58, 0xed, 0x98, 0xcd, 0x5b, 0xa6,
+	0xfc, 0xba, 0xdd, 0xfa, 0xba, 0x15, 0x41, 0x24, 0x4e, 0x7b, 0xfd, 0x4e, 0xd7, 0x6a, 0x77, 0x7a,
+	0xbd, 0x18, 0xc2, 0xf8, 0xe2, 0xf9, 0x51, 0x25, 0xfd, 0xe2, 0xa8, 0x92, 0xfe, 0xfd, 0xa8, 0x92,
+	0x7e, 0xf4, 0xba, 0x92, 0x7a, 0xf1, 0xba, 0x92, 0xfa, 0xf5, 0x75, 0x25, 0xf5, 0x6d, 0xb2, 0x54,
+	0x9c, 0x06, 0x5b, 0x1e, 0x1a, 0x50, 0xf1, 0xab, 0x7e, 0x20, 0xff, 0x29, 0x14, 0xe5, 0x1a, 0xa8,
+	0x22, 0xed, 0x8f, 0xff, 0x1e, 0x00, 0x9f, 0x4f, 0x0f, 0x8c, 0x2e, 0x0e, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -317,6 +750,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxOrderDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxOrderDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSwap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinOrderShare.Size()
+		i -= size
+		if _, err := m.MinOrderShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxOrderFills != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.MaxOrderFills))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PriceHistoryBlocks != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PriceHistoryBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ProtocolFeeShare.Size()
+		i -= size
+		if _, err := m.ProtocolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.SwapFee.Size()
 		i -= size
@@ -364,6 +835,26 @@ func (m *AllowedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TokenB) > 0 {
 		i -= len(m.TokenB)
 		copy(dAtA[i:], m.TokenB)
@@ -401,6 +892,44 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PriceLastUpdated, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PriceLastUpdated):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSwap(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.PriceCumulativeB.Size()
+		i -= size
+		if _, err := m.PriceCumulativeB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PriceCumulativeA.Size()
+		i -= size
+		if _, err := m.PriceCumulativeA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.TotalShares.Size()
 		i -= size
@@ -488,20 +1017,246 @@ func (m *ShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PoolFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolFees) > 0 {
+		for iNdEx := len(m.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LPFees) > 0 {
+		for iNdEx := len(m.LPFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LPFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolPriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceB.Size()
+		i -= size
+		if _, err := m.PriceB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PriceA.Size()
+		i -= size
+		if _, err := m.PriceA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PriceCumulativeB.Size()
+		i -= size
+		if _, err := m.PriceCumulativeB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PriceCumulativeA.Size()
+		i -= size
+		if _, err := m.PriceCumulativeA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSwap(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSwap(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OrderType != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -513,6 +1268,18 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.SwapFee.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	l = m.ProtocolFeeShare.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if m.PriceHistoryBlocks != 0 {
+		n += 1 + sovSwap(uint64(m.PriceHistoryBlocks))
+	}
+	if m.MaxOrderFills != 0 {
+		n += 1 + sovSwap(uint64(m.MaxOrderFills))
+	}
+	l = m.MinOrderShare.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxOrderDuration)
+	n += 1 + l + sovSwap(uint64(l))
 	return n
 }
 
@@ -530,6 +1297,14 @@ func (m *AllowedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSwap(uint64(l))
 	}
+	if m.PoolType != 0 {
+		n += 1 + sovSwap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovSwap(uint64(l))
 	return n
 }
 
@@ -549,6 +1324,18 @@ func (m *PoolRecord) Size() (n int) {
 	n += 1 + l + sovSwap(uint64(l))
 	l = m.TotalShares.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	if m.PoolType != 0 {
+		n += 1 + sovSwap(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
+	l = m.PriceCumulativeA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceCumulativeB.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PriceLastUpdated)
+	n += 1 + l + sovSwap(uint64(l))
 	return n
 }
 
@@ -571,6 +1358,88 @@ func (m *ShareRecord) Size() (n int) {
 	return n
 }
 
+func (m *PoolFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.LPFees) > 0 {
+		for _, e := range m.LPFees {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if len(m.ProtocolFees) > 0 {
+		for _, e := range m.ProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolPriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSwap(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceCumulativeA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceCumulativeB.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceB.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovSwap(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovSwap(uint64(m.OrderType))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
 func sovSwap(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -659,18 +1528,964 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryBlocks", wireType)
+			}
+			m.PriceHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderFills", wireType)
+			}
+			m.MaxOrderFills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderFills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxOrderDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservesA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservesB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulativeA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulativeB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLastUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PriceLastUpdated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesOwned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LPFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LPFees = append(m.LPFees, types.Coin{})
+			if err := m.LPFees[len(m.LPFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProtocolFees = append(m.ProtocolFees, types.Coin{})
+			if err := m.ProtocolFees[len(m.ProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -695,7 +2510,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedPool) Unmarshal(dAtA []byte) error {
+func (m *PoolPriceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -718,15 +2533,15 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolPriceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolPriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -754,13 +2569,32 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
+			m.PoolID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -770,77 +2604,28 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwap(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -868,13 +2653,15 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolID = string(dAtA[iNdEx:postIndex])
+			if err := m.PriceCumulativeA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservesA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeB", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -884,30 +2671,31 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservesA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceCumulativeB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservesB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -917,28 +2705,29 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservesB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceB", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,7 +2755,7 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -991,7 +2780,7 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShareRecord) Unmarshal(dAtA []byte) error {
+func (m *Order) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,15 +2803,34 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1049,12 +2857,12 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
@@ -1086,9 +2894,94 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.PoolID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1116,7 +3009,40 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesOwned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex